@@ -15,7 +15,9 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/archive"
 	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -71,6 +73,10 @@ type BaseApp struct { // nolint: maligned
 	snapshotInterval   uint64 // block interval between state sync snapshots
 	snapshotKeepRecent uint32 // recent state sync snapshots to keep
 
+	// snapshot output settings, left to the snapshot manager and store defaults if unset
+	snapshotFormat      uint32
+	snapshotCompression snapshottypes.Compression
+
 	// volatile states:
 	//
 	// checkState is set on InitChain and reset on Commit
@@ -128,6 +134,26 @@ type BaseApp struct { // nolint: maligned
 	// indexEvents defines the set of events in the form {eventType}.{attributeKey},
 	// which informs Tendermint what to index. If empty, all events will be indexed.
 	indexEvents map[string]struct{}
+
+	// abciListeners for hooking into the ABCI message processing of the BaseApp
+	// and exposing the requests and responses to external consumers
+	abciListeners []ABCIListener
+
+	// streamingListeners are registered on the deliverState multi-store so that
+	// the state changes of each BeginBlock, DeliverTx and EndBlock reach the
+	// streaming services before the corresponding ABCIListener hook is called
+	streamingListeners map[sdk.StoreKey][]sdk.WriteListener
+
+	// archive, if set, receives the committed state changes and serves queries
+	// at heights pruned from the multi-store
+	archive *archive.Store
+
+	// gasProfiling enables gas profiling of all the txs
+	gasProfiling bool
+
+	// blockGasProfile aggregates the gas profiles of the txs delivered in the
+	// current block when gas profiling is enabled
+	blockGasProfile map[blockGasProfileKey]uint64
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -183,6 +209,11 @@ func (app *BaseApp) Logger() log.Logger {
 // MsgServiceRouter returns the MsgServiceRouter of a BaseApp.
 func (app *BaseApp) MsgServiceRouter() *MsgServiceRouter { return app.msgServiceRouter }
 
+// SnapshotManager returns the snapshot manager of a BaseApp, or nil if no
+// snapshot store is set. Modules keeping state outside of the multistore
+// register their extension snapshotters with it.
+func (app *BaseApp) SnapshotManager() *snapshots.Manager { return app.snapshotManager }
+
 // MountStores mounts all IAVL or DB stores to the provided keys in the BaseApp
 // multistore.
 func (app *BaseApp) MountStores(keys ...sdk.StoreKey) {
@@ -288,12 +319,42 @@ func (app *BaseApp) init() error {
 	app.setCheckState(tmproto.Header{})
 	app.Seal()
 
-	// make sure the snapshot interval is a multiple of the pruning KeepEvery interval
+	if app.archive != nil {
+		rms, ok := app.cms.(*rootmulti.Store)
+		if !ok {
+			return errors.New("the archive store requires a rootmulti store")
+		}
+		if err := rms.SetArchive(app.archive); err != nil {
+			return err
+		}
+	}
+
+	if app.snapshotManager != nil {
+		if app.snapshotFormat != 0 {
+			app.snapshotManager.SetFormat(app.snapshotFormat)
+		}
+
+		if app.snapshotCompression != "" {
+			rms, ok := app.cms.(*rootmulti.Store)
+			if !ok {
+				return errors.New("state sync snapshot compression requires a rootmulti store")
+			}
+			if err := rms.SetSnapshotCompression(app.snapshotCompression); err != nil {
+				return err
+			}
+		}
+	}
+
+	// make sure the stores can be snapshotted and that the snapshot interval is
+	// a multiple of the pruning KeepEvery interval
 	if app.snapshotManager != nil && app.snapshotInterval > 0 {
 		rms, ok := app.cms.(*rootmulti.Store)
 		if !ok {
 			return errors.New("state sync snapshots require a rootmulti store")
 		}
+		if err := rms.ValidateSnapshotStores(); err != nil {
+			return err
+		}
 		pruningOpts := rms.GetPruning()
 		if pruningOpts.KeepEvery > 0 && app.snapshotInterval%pruningOpts.KeepEvery != 0 {
 			return fmt.Errorf(
@@ -372,9 +433,13 @@ func (app *BaseApp) setCheckState(header tmproto.Header) {
 // setDeliverState sets the BaseApp's deliverState with a branched multi-store
 // (i.e. a CacheMultiStore) and a new Context with the same multi-store branch,
 // and provided header. It is set on InitChain and BeginBlock and set to nil on
-// Commit.
+// Commit. Any streaming listeners are registered on the branch, so only the
+// state changes of the delivered block are streamed.
 func (app *BaseApp) setDeliverState(header tmproto.Header) {
 	ms := app.cms.CacheMultiStore()
+	for key, listeners := range app.streamingListeners {
+		ms.AddListeners(key, listeners)
+	}
 	app.deliverState = &state{
 		ms:  ms,
 		ctx: sdk.NewContext(ms, header, false, app.logger),
@@ -558,12 +623,17 @@ func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Context
 // returned if the tx does not run out of gas and if all the messages are valid
 // and execute successfully. An error is returned otherwise.
 func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, err error) {
+	return app.runTxWithContext(app.getContextForTx(mode, txBytes), mode, txBytes)
+}
+
+// runTxWithContext processes a transaction like runTx, but against the given
+// Context instead of the one of the state of the execution mode.
+func (app *BaseApp) runTxWithContext(ctx sdk.Context, mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
@@ -577,6 +647,10 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		startingGas = ctx.BlockGasMeter().GasConsumed()
 	}
 
+	if app.gasProfiling && ctx.GasProfiler() == nil {
+		ctx = ctx.WithGasProfiler(sdk.NewGasProfiler())
+	}
+
 	defer func() {
 		if r := recover(); r != nil {
 			recoveryMW := newOutOfGasRecoveryMiddleware(gasWanted, ctx, app.runTxRecoveryMiddleware)
@@ -584,6 +658,9 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		}
 
 		gInfo = sdk.GasInfo{GasWanted: gasWanted, GasUsed: ctx.GasMeter().GasConsumed()}
+		if profiler := ctx.GasProfiler(); profiler != nil {
+			gInfo.Profile = profiler.Profile()
+		}
 	}()
 
 	// If BlockGasMeter() panics it will be caught by the above recover and will
@@ -700,6 +777,10 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 			err       error
 		)
 
+		if profiler := ctx.GasProfiler(); profiler != nil {
+			profiler.SetMsg(i, sdk.MsgTypeURL(msg))
+		}
+
 		if svcMsg, ok := msg.(sdk.ServiceMsg); ok {
 			msgFqName = svcMsg.MethodName
 			handler := app.msgServiceRouter.Handler(msgFqName)
@@ -738,6 +819,10 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 		msgLogs = append(msgLogs, sdk.NewABCIMessageLog(uint32(i), msgResult.Log, msgEvents))
 	}
 
+	if profiler := ctx.GasProfiler(); profiler != nil {
+		profiler.SetMsg(-1, "")
+	}
+
 	data, err := proto.Marshal(txMsgData)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "failed to marshal tx data")
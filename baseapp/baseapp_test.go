@@ -392,6 +392,31 @@ func TestLoadVersionInvalid(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestLoadVersionSMTStore(t *testing.T) {
+	smtKey := sdk.NewKVStoreKey("smt")
+	newApp := func(options ...func(*BaseApp)) *BaseApp {
+		app := NewBaseApp(t.Name(), log.NewNopLogger(), dbm.NewMemDB(), nil, options...)
+		app.MountStore(smtKey, sdk.StoreTypeSMT)
+		return app
+	}
+
+	snapshotDir, err := ioutil.TempDir("", "baseapp")
+	require.NoError(t, err)
+	defer os.RemoveAll(snapshotDir)
+	snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), snapshotDir)
+	require.NoError(t, err)
+
+	// an SMT store can be loaded without pruning nor snapshots
+	app := newApp(SetPruning(store.PruneNothing), SetSnapshotStore(snapshotStore))
+	require.NoError(t, app.LoadLatestVersion())
+
+	app = newApp(SetPruning(store.PruneDefault))
+	require.Error(t, app.LoadLatestVersion())
+
+	app = newApp(SetPruning(store.PruneNothing), SetSnapshotStore(snapshotStore), SetSnapshotInterval(2))
+	require.Error(t, app.LoadLatestVersion())
+}
+
 func TestLoadVersionPruning(t *testing.T) {
 	logger := log.NewNopLogger()
 	pruningOptions := store.PruningOptions{
@@ -1149,7 +1174,10 @@ func TestSimulateTx(t *testing.T) {
 		var simRes sdk.SimulationResponse
 		require.NoError(t, jsonpb.Unmarshal(strings.NewReader(string(queryResult.Value)), &simRes))
 
-		require.Equal(t, gInfo, simRes.GasInfo)
+		// the JSON encoding does not distinguish an empty profile from no profile
+		require.Equal(t, gInfo.GasWanted, simRes.GasInfo.GasWanted)
+		require.Equal(t, gInfo.GasUsed, simRes.GasInfo.GasUsed)
+		require.Empty(t, simRes.GasInfo.Profile)
 		require.Equal(t, result.Log, simRes.Result.Log)
 		require.Equal(t, result.Events, simRes.Result.Events)
 		require.True(t, bytes.Equal(result.Data, simRes.Result.Data))
@@ -1159,6 +1187,101 @@ func TestSimulateTx(t *testing.T) {
 	}
 }
 
+func TestSimulateTxGasProfile(t *testing.T) {
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
+			newCtx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+			newCtx.GasMeter().ConsumeGas(3, "ante")
+			newCtx.KVStore(capKey1).Get([]byte("key"))
+			return
+		})
+	}
+
+	routerOpt := func(bapp *BaseApp) {
+		r := sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			ctx.KVStore(capKey2).Set([]byte("key"), []byte("value"))
+			ctx.KVStore(capKey2).Has([]byte("key"))
+			return &sdk.Result{}, nil
+		})
+		bapp.Router().AddRoute(r)
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+
+	header := tmproto.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	txBytes, err := cdc.MarshalBinaryBare(newTxCounter(1, 1))
+	require.NoError(t, err)
+
+	// no profile unless requested
+	gInfo, _, err := app.SimulateWithGasProfile(txBytes, false)
+	require.NoError(t, err)
+	require.Empty(t, gInfo.Profile)
+
+	gInfo, _, err = app.SimulateWithGasProfile(txBytes, true)
+	require.NoError(t, err)
+
+	gasConfig := store.KVGasConfig()
+	msgTypeURL := sdk.MsgTypeURL(msgCounter{})
+	require.Equal(t, []sdk.GasProfileEntry{
+		{MsgIndex: -1, Operation: "ante", GasUsed: 3},
+		{MsgIndex: -1, StoreKey: capKey1.Name(), Operation: sdk.GasOperationRead, GasUsed: gasConfig.ReadCostFlat},
+		{MsgIndex: 0, MsgTypeURL: msgTypeURL, StoreKey: capKey2.Name(), Operation: sdk.GasOperationHas, GasUsed: gasConfig.HasCost},
+		{MsgIndex: 0, MsgTypeURL: msgTypeURL, StoreKey: capKey2.Name(), Operation: sdk.GasOperationWrite, GasUsed: gasConfig.WriteCostFlat + 5*gasConfig.WriteCostPerByte},
+	}, gInfo.Profile)
+
+	var total uint64
+	for _, entry := range gInfo.Profile {
+		total += entry.GasUsed
+	}
+	require.Equal(t, gInfo.GasUsed, total)
+}
+
+func TestGasProfiling(t *testing.T) {
+	routerOpt := func(bapp *BaseApp) {
+		r := sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			ctx.KVStore(capKey2).Delete([]byte("key"))
+			return &sdk.Result{}, nil
+		})
+		bapp.Router().AddRoute(r)
+	}
+
+	app := setupBaseApp(t, routerOpt, SetGasProfiling(true))
+	app.InitChain(abci.RequestInitChain{})
+
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+
+	header := tmproto.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	txBytes, err := cdc.MarshalBinaryBare(newTxCounter(1, 1))
+	require.NoError(t, err)
+
+	// every tx is profiled
+	gInfo, _, err := app.Simulate(txBytes)
+	require.NoError(t, err)
+	require.Len(t, gInfo.Profile, 1)
+
+	for i := 0; i < 2; i++ {
+		res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+		require.True(t, res.IsOK(), res.Log)
+	}
+
+	// the profiles of the delivered txs are aggregated for the block
+	key := blockGasProfileKey{msgTypeURL: sdk.MsgTypeURL(msgCounter{}), storeKey: capKey2.Name(), operation: sdk.GasOperationDelete}
+	require.Equal(t, map[blockGasProfileKey]uint64{key: 2 * store.KVGasConfig().DeleteCost}, app.blockGasProfile)
+
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+	require.Nil(t, app.blockGasProfile)
+}
+
 func TestRunInvalidTransaction(t *testing.T) {
 	anteOpt := func(bapp *BaseApp) {
 		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
@@ -1794,8 +1917,8 @@ func TestListSnapshots(t *testing.T) {
 		s.Metadata = nil
 	}
 	assert.Equal(t, abci.ResponseListSnapshots{Snapshots: []*abci.Snapshot{
-		{Height: 4, Format: 1, Chunks: 2},
-		{Height: 2, Format: 1, Chunks: 1},
+		{Height: 4, Format: 2, Chunks: 3},
+		{Height: 2, Format: 2, Chunks: 2},
 	}}, resp)
 }
 
@@ -1809,13 +1932,13 @@ func TestLoadSnapshotChunk(t *testing.T) {
 		chunk       uint32
 		expectEmpty bool
 	}{
-		"Existing snapshot": {2, 1, 1, false},
-		"Missing height":    {100, 1, 1, true},
-		"Missing format":    {2, 2, 1, true},
-		"Missing chunk":     {2, 1, 9, true},
-		"Zero height":       {0, 1, 1, true},
+		"Existing snapshot": {2, 2, 1, false},
+		"Missing height":    {100, 2, 1, true},
+		"Missing format":    {2, 1, 1, true},
+		"Missing chunk":     {2, 2, 9, true},
+		"Zero height":       {0, 2, 1, true},
 		"Zero format":       {2, 0, 1, true},
-		"Zero chunk":        {2, 1, 0, false},
+		"Zero chunk":        {2, 2, 0, false},
 	}
 	for name, tc := range testcases {
 		tc := tc
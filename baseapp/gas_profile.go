@@ -0,0 +1,85 @@
+package baseapp
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// blockGasProfileKey identifies the gas of the txs of a block aggregated by
+// message type, store key and operation.
+type blockGasProfileKey struct {
+	msgTypeURL string
+	storeKey   string
+	operation  string
+}
+
+// SimulateWithGasProfile simulates a tx like Simulate. If profile is true, or
+// gas profiling is enabled on the app, the returned GasInfo breaks the gas used
+// down by message, store key and operation.
+func (app *BaseApp) SimulateWithGasProfile(txBytes []byte, profile bool) (sdk.GasInfo, *sdk.Result, error) {
+	ctx := app.getContextForTx(runTxModeSimulate, txBytes)
+	if profile {
+		ctx = ctx.WithGasProfiler(sdk.NewGasProfiler())
+	}
+
+	return app.runTxWithContext(ctx, runTxModeSimulate, txBytes)
+}
+
+// recordBlockGasProfile adds the gas profile of a delivered tx to the profile
+// of the block.
+func (app *BaseApp) recordBlockGasProfile(gInfo sdk.GasInfo) {
+	if !app.gasProfiling {
+		return
+	}
+
+	if app.blockGasProfile == nil {
+		app.blockGasProfile = make(map[blockGasProfileKey]uint64)
+	}
+
+	for _, entry := range gInfo.Profile {
+		key := blockGasProfileKey{
+			msgTypeURL: entry.MsgTypeURL,
+			storeKey:   entry.StoreKey,
+			operation:  entry.Operation,
+		}
+		app.blockGasProfile[key] += entry.GasUsed
+	}
+}
+
+// logBlockGasProfile logs the gas profile of the block at the given height and
+// resets it.
+func (app *BaseApp) logBlockGasProfile(height int64) {
+	if !app.gasProfiling {
+		return
+	}
+
+	keys := make([]blockGasProfileKey, 0, len(app.blockGasProfile))
+	for key := range app.blockGasProfile {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		switch {
+		case a.msgTypeURL != b.msgTypeURL:
+			return a.msgTypeURL < b.msgTypeURL
+		case a.storeKey != b.storeKey:
+			return a.storeKey < b.storeKey
+		default:
+			return a.operation < b.operation
+		}
+	})
+
+	var total uint64
+	entries := make([]string, len(keys))
+	for i, key := range keys {
+		gas := app.blockGasProfile[key]
+		total += gas
+		entries[i] = fmt.Sprintf("%s/%s/%s=%d", key.msgTypeURL, key.storeKey, key.operation, gas)
+	}
+
+	app.logger.Info("block gas profile", "height", height, "gas", total, "profile", strings.Join(entries, ","))
+	app.blockGasProfile = nil
+}
@@ -8,7 +8,9 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/archive"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -20,6 +22,28 @@ func SetPruning(opts sdk.PruningOptions) func(*BaseApp) {
 	return func(bap *BaseApp) { bap.cms.SetPruning(opts) }
 }
 
+// SetIAVLCacheSize sets the number of nodes cached by the IAVL stores of the
+// multistore associated with the app. A size of 0 keeps the default.
+func SetIAVLCacheSize(size int) func(*BaseApp) {
+	return func(bap *BaseApp) {
+		if size > 0 {
+			bap.cms.SetIAVLCacheSize(size)
+		}
+	}
+}
+
+// SetIAVLCacheSizes sets the number of nodes cached by the IAVL stores with the
+// given names, overriding SetIAVLCacheSize.
+func SetIAVLCacheSizes(sizes map[string]int) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.cms.SetIAVLCacheSizes(sizes) }
+}
+
+// SetIAVLFastNode enables the index of the latest version of the IAVL stores of
+// the multistore associated with the app.
+func SetIAVLFastNode(enabled bool) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.cms.SetIAVLFastNode(enabled) }
+}
+
 // SetMinGasPrices returns an option that sets the minimum gas prices on the app.
 func SetMinGasPrices(gasPricesStr string) func(*BaseApp) {
 	gasPrices, err := sdk.ParseDecCoins(gasPricesStr)
@@ -73,11 +97,39 @@ func SetSnapshotKeepRecent(keepRecent uint32) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetSnapshotKeepRecent(keepRecent) }
 }
 
+// SetSnapshotFormat sets the format of the snapshots taken.
+func SetSnapshotFormat(format uint32) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetSnapshotFormat(format) }
+}
+
+// SetSnapshotCompression sets the compression of the snapshot chunks.
+func SetSnapshotCompression(compression snapshottypes.Compression) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetSnapshotCompression(compression) }
+}
+
 // SetSnapshotStore sets the snapshot store.
 func SetSnapshotStore(snapshotStore *snapshots.Store) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetSnapshotStore(snapshotStore) }
 }
 
+// SetStreamingService provides a BaseApp option function that registers a
+// streaming service with the BaseApp.
+func SetStreamingService(s StreamingService) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetStreamingService(s) }
+}
+
+// SetArchive returns a BaseApp option function that sets the archive store
+// which keeps every committed version of the state for historical queries.
+func SetArchive(archive *archive.Store) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetArchive(archive) }
+}
+
+// SetGasProfiling returns a BaseApp option function that enables gas profiling
+// of all the txs run by the app.
+func SetGasProfiling(enabled bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetGasProfiling(enabled) }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -227,9 +279,69 @@ func (app *BaseApp) SetSnapshotKeepRecent(snapshotKeepRecent uint32) {
 	app.snapshotKeepRecent = snapshotKeepRecent
 }
 
+// SetSnapshotFormat sets the format of the snapshots taken, which defaults to
+// the current snapshot format. A value of 0 keeps the default.
+func (app *BaseApp) SetSnapshotFormat(format uint32) {
+	if app.sealed {
+		panic("SetSnapshotFormat() on sealed BaseApp")
+	}
+	app.snapshotFormat = format
+}
+
+// SetSnapshotCompression sets the compression of the snapshot chunks, for the
+// snapshot formats supporting a choice. An empty value keeps the default.
+func (app *BaseApp) SetSnapshotCompression(compression snapshottypes.Compression) {
+	if app.sealed {
+		panic("SetSnapshotCompression() on sealed BaseApp")
+	}
+	app.snapshotCompression = compression
+}
+
 // SetInterfaceRegistry sets the InterfaceRegistry.
 func (app *BaseApp) SetInterfaceRegistry(registry types.InterfaceRegistry) {
 	app.interfaceRegistry = registry
 	app.grpcQueryRouter.SetInterfaceRegistry(registry)
 	app.msgServiceRouter.SetInterfaceRegistry(registry)
 }
+
+// SetStreamingService is used to set a streaming service into the BaseApp hooks and load the listeners into the multistore
+func (app *BaseApp) SetStreamingService(s StreamingService) {
+	if app.sealed {
+		panic("SetStreamingService() on sealed BaseApp")
+	}
+
+	if app.streamingListeners == nil {
+		app.streamingListeners = make(map[sdk.StoreKey][]sdk.WriteListener)
+	}
+
+	// add the listeners for each StoreKey
+	for key, lis := range s.Listeners() {
+		app.streamingListeners[key] = append(app.streamingListeners[key], lis...)
+	}
+
+	// register the StreamingService within the BaseApp
+	// BaseApp will pass BeginBlock, DeliverTx, and EndBlock requests and responses to the streaming services to update their ABCI context
+	app.abciListeners = append(app.abciListeners, s)
+}
+
+// SetArchive sets the archive store. The archive receives the state changes
+// committed to the IAVL stores and serves queries at heights they pruned.
+func (app *BaseApp) SetArchive(archive *archive.Store) {
+	if app.sealed {
+		panic("SetArchive() on sealed BaseApp")
+	}
+
+	app.archive = archive
+}
+
+// SetGasProfiling enables gas profiling of all the txs run by the app. Their
+// GasInfo then breaks the gas used down by message, store key and operation, and
+// the profiles of the txs delivered in a block are logged along with the commit
+// of the block.
+func (app *BaseApp) SetGasProfiling(enabled bool) {
+	if app.sealed {
+		panic("SetGasProfiling() on sealed BaseApp")
+	}
+
+	app.gasProfiling = enabled
+}
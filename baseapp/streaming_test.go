@@ -0,0 +1,151 @@
+package baseapp
+
+import (
+	"encoding/binary"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	store "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ StreamingService = (*mockStreamingService)(nil)
+
+// mockStreamingService records the state changes of every ABCI phase it is
+// notified about.
+type mockStreamingService struct {
+	pending   []store.StoreKVPair
+	initChain [][]store.StoreKVPair
+	begin     [][]store.StoreKVPair
+	txs       [][]store.StoreKVPair
+	end       [][]store.StoreKVPair
+}
+
+func (m *mockStreamingService) OnWrite(storeKey store.StoreKey, key []byte, value []byte, delete bool) error {
+	m.pending = append(m.pending, store.StoreKVPair{StoreKey: storeKey.Name(), Key: key, Value: value, Delete: delete})
+	return nil
+}
+
+func (m *mockStreamingService) flush() []store.StoreKVPair {
+	pairs := m.pending
+	m.pending = nil
+	return pairs
+}
+
+func (m *mockStreamingService) ListenInitChain(_ sdk.Context, _ abci.RequestInitChain, _ abci.ResponseInitChain) error {
+	m.initChain = append(m.initChain, m.flush())
+	return nil
+}
+
+func (m *mockStreamingService) ListenBeginBlock(_ sdk.Context, _ abci.RequestBeginBlock, _ abci.ResponseBeginBlock) error {
+	m.begin = append(m.begin, m.flush())
+	return nil
+}
+
+func (m *mockStreamingService) ListenEndBlock(_ sdk.Context, _ abci.RequestEndBlock, _ abci.ResponseEndBlock) error {
+	m.end = append(m.end, m.flush())
+	return nil
+}
+
+func (m *mockStreamingService) ListenDeliverTx(_ sdk.Context, _ abci.RequestDeliverTx, _ abci.ResponseDeliverTx) error {
+	m.txs = append(m.txs, m.flush())
+	return nil
+}
+
+func (m *mockStreamingService) Stream(_ *sync.WaitGroup) error { return nil }
+
+func (m *mockStreamingService) Listeners() map[store.StoreKey][]store.WriteListener {
+	return map[store.StoreKey][]store.WriteListener{capKey1: {m}}
+}
+
+func (m *mockStreamingService) Close() error { return nil }
+
+func encodeInt(i int64) []byte {
+	bz := make([]byte, 8)
+	n := binary.PutVarint(bz, i)
+	return bz[:n]
+}
+
+func TestStreamingService(t *testing.T) {
+	initKey := []byte("init-key")
+	anteKey := []byte("ante-key")
+	deliverKey := []byte("deliver-key")
+	beginKey, endKey := []byte("begin-key"), []byte("end-key")
+
+	streamer := &mockStreamingService{}
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey)))
+	}
+	blockOpt := func(bapp *BaseApp) {
+		bapp.SetInitChainer(func(ctx sdk.Context, _ abci.RequestInitChain) abci.ResponseInitChain {
+			ctx.KVStore(capKey1).Set(initKey, []byte("init"))
+			return abci.ResponseInitChain{}
+		})
+		bapp.SetBeginBlocker(func(ctx sdk.Context, _ abci.RequestBeginBlock) abci.ResponseBeginBlock {
+			ctx.KVStore(capKey1).Set(beginKey, []byte("begin"))
+			return abci.ResponseBeginBlock{}
+		})
+		bapp.SetEndBlocker(func(ctx sdk.Context, _ abci.RequestEndBlock) abci.ResponseEndBlock {
+			ctx.KVStore(capKey1).Delete(beginKey)
+			ctx.KVStore(capKey1).Set(endKey, []byte("end"))
+			return abci.ResponseEndBlock{}
+		})
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt, blockOpt, SetStreamingService(streamer))
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+
+	// CheckTx state changes must never be streamed
+	txBytes, err := codec.MarshalBinaryBare(newTxCounter(0, 0))
+	require.NoError(t, err)
+	require.True(t, app.CheckTx(abci.RequestCheckTx{Tx: txBytes}).IsOK())
+
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), "%v", res)
+
+	// a tx failing in the message handler only streams its ante handler changes
+	failTx := newTxCounter(1, 1)
+	failTx.Msgs[0] = msgCounter{Counter: 1, FailOnHandler: true}
+	failBytes, err := codec.MarshalBinaryBare(failTx)
+	require.NoError(t, err)
+	res = app.DeliverTx(abci.RequestDeliverTx{Tx: failBytes})
+	require.False(t, res.IsOK())
+
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	app.Commit()
+
+	name := capKey1.Name()
+	require.Equal(t, [][]store.StoreKVPair{
+		{{StoreKey: name, Key: initKey, Value: []byte("init")}},
+	}, streamer.initChain)
+	require.Equal(t, [][]store.StoreKVPair{
+		{{StoreKey: name, Key: beginKey, Value: []byte("begin")}},
+	}, streamer.begin)
+	require.Equal(t, [][]store.StoreKVPair{
+		{
+			{StoreKey: name, Key: anteKey, Value: encodeInt(1)},
+			{StoreKey: name, Key: deliverKey, Value: encodeInt(1)},
+		},
+		{
+			{StoreKey: name, Key: anteKey, Value: encodeInt(2)},
+		},
+	}, streamer.txs)
+	require.Equal(t, [][]store.StoreKVPair{
+		{
+			{StoreKey: name, Key: beginKey, Delete: true},
+			{StoreKey: name, Key: endKey, Value: []byte("end")},
+		},
+	}, streamer.end)
+	require.Empty(t, streamer.pending, "commit must not stream state changes twice")
+}
@@ -244,9 +244,11 @@ func readTxCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Context, err
 		clientCtx = clientCtx.WithFrom(from).WithFromAddress(fromAddr).WithFromName(fromName)
 
 		// If the `from` signer account is a ledger key, we need to use
-		// SIGN_MODE_AMINO_JSON, because ledger doesn't support proto yet.
+		// SIGN_MODE_AMINO_JSON or SIGN_MODE_TEXTUAL, because ledger doesn't
+		// support proto yet.
 		// ref: https://github.com/cosmos/cosmos-sdk/issues/8109
-		if keyType == keyring.TypeLedger && clientCtx.SignModeStr != flags.SignModeLegacyAminoJSON {
+		if keyType == keyring.TypeLedger && clientCtx.SignModeStr != flags.SignModeLegacyAminoJSON &&
+			clientCtx.SignModeStr != flags.SignModeTextual {
 			fmt.Println("Default sign-mode 'direct' not supported by Ledger, using sign-mode 'amino-json'.")
 			clientCtx = clientCtx.WithSignModeStr(flags.SignModeLegacyAminoJSON)
 		}
@@ -263,7 +265,7 @@ func readTxCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Context, err
 // - client.Context field pre-populated & flag not set: uses pre-populated value
 // - client.Context field pre-populated & flag set: uses set flag value
 func GetClientQueryContext(cmd *cobra.Command) (Context, error) {
-	ctx := GetClientContextFromCmd(cmd)
+	ctx := GetClientContextFromCmd(cmd).WithCmdContext(cmd.Context())
 	return readQueryCommandFlags(ctx, cmd.Flags())
 }
 
@@ -275,7 +277,7 @@ func GetClientQueryContext(cmd *cobra.Command) (Context, error) {
 // - client.Context field pre-populated & flag not set: uses pre-populated value
 // - client.Context field pre-populated & flag set: uses set flag value
 func GetClientTxContext(cmd *cobra.Command) (Context, error) {
-	ctx := GetClientContextFromCmd(cmd)
+	ctx := GetClientContextFromCmd(cmd).WithCmdContext(cmd.Context())
 	return readTxCommandFlags(ctx, cmd.Flags())
 }
 
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"os"
@@ -45,6 +46,9 @@ type Context struct {
 	AccountRetriever  AccountRetriever
 	NodeURI           string
 	FeeGranter        sdk.AccAddress
+	// CmdContext is the context.Context of the command the Context was built
+	// for, if any.
+	CmdContext context.Context
 
 	// TODO: Deprecated (remove).
 	LegacyAmino *codec.LegacyAmino
@@ -174,6 +178,13 @@ func (ctx Context) WithFeeGranterAddress(addr sdk.AccAddress) Context {
 	return ctx
 }
 
+// WithCmdContext returns a copy of the context with an updated command
+// context.Context.
+func (ctx Context) WithCmdContext(c context.Context) Context {
+	ctx.CmdContext = c
+	return ctx
+}
+
 // WithBroadcastMode returns a copy of the context with an updated broadcast
 // mode.
 func (ctx Context) WithBroadcastMode(mode string) Context {
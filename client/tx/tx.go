@@ -2,6 +2,7 @@ package tx
 
 import (
 	"bufio"
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -118,7 +119,7 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 	}
 
 	tx.SetFeeGranter(clientCtx.GetFeeGranterAddress())
-	err = Sign(txf, clientCtx.GetFromName(), tx, true)
+	err = Sign(clientCtx.CmdContext, txf, clientCtx.GetFromName(), tx, true)
 	if err != nil {
 		return err
 	}
@@ -242,6 +243,7 @@ func BuildUnsignedTx(txf Factory, msgs ...sdk.Msg) (client.TxBuilder, error) {
 	tx.SetFeeAmount(fees)
 	tx.SetGasLimit(txf.gas)
 	tx.SetTimeoutHeight(txf.TimeoutHeight())
+	tx.SetUnordered(txf.Unordered())
 
 	return tx, nil
 }
@@ -381,9 +383,10 @@ func checkMultipleSigners(mode signing.SignMode, tx authsigning.Tx) error {
 // The resulting signature will be added to the transaction builder overwriting the previous
 // ones if overwrite=true (otherwise, the signature will be appended).
 // Signing a transaction with mutltiple signers in the DIRECT mode is not supprted and will
-// return an error.
+// return an error. The context is passed to the SignModeHandler, which may use it to query
+// the chain, e.g. for SIGN_MODE_TEXTUAL.
 // An error is returned upon failure.
-func Sign(txf Factory, name string, txBuilder client.TxBuilder, overwriteSig bool) error {
+func Sign(ctx context.Context, txf Factory, name string, txBuilder client.TxBuilder, overwriteSig bool) error {
 	if txf.keybase == nil {
 		return errors.New("keybase must be set prior to signing a transaction")
 	}
@@ -437,7 +440,7 @@ func Sign(txf Factory, name string, txBuilder client.TxBuilder, overwriteSig boo
 	}
 
 	// Generate the bytes to be signed.
-	bytesToSign, err := txf.txConfig.SignModeHandler().GetSignBytes(signMode, signerData, txBuilder.GetTx())
+	bytesToSign, err := authsigning.GetSignBytesWithContext(ctx, txf.txConfig.SignModeHandler(), signMode, signerData, txBuilder.GetTx())
 	if err != nil {
 		return err
 	}
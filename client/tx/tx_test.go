@@ -1,6 +1,7 @@
 package tx_test
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -205,7 +206,7 @@ func TestSign(t *testing.T) {
 	var prevSigs []signingtypes.SignatureV2
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			err = tx.Sign(tc.txf, tc.from, tc.txb, tc.overwrite)
+			err = tx.Sign(context.Background(), tc.txf, tc.from, tc.txb, tc.overwrite)
 			if len(tc.expectedPKs) == 0 {
 				requireT.Error(err)
 			} else {
@@ -0,0 +1,67 @@
+package server
+
+// DONTCOVER
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/store/archive"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+)
+
+// BackfillArchiveCmd copies the state versions held by the application
+// database into the archive store.
+func BackfillArchiveCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backfill-archive",
+		Short: "Copy the state versions of the application database into the archive store",
+		Long: `Copy the state versions of the application database that are more recent than the
+latest archived version into the archive store (data/archive.db). The first version
+copied to an empty archive is copied in full, and every following one as the changes
+since the previous one. Versions already pruned from the application database are
+not archived.
+
+The node must be stopped. Once backfilled, the archive is kept up to date by starting
+the node with the archive enabled (see the [store] section of app.toml).`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			db, err := openDB(config.RootDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			archiveDB, err := OpenArchiveDB(config.RootDir)
+			if err != nil {
+				return err
+			}
+			defer archiveDB.Close()
+
+			first, last, err := rootmulti.BackfillArchive(db, archive.NewStore(archiveDB))
+			if err != nil {
+				return fmt.Errorf("failed to backfill the archive: %w", err)
+			}
+
+			if first == 0 {
+				cmd.Println("The archive is up to date")
+				return nil
+			}
+
+			cmd.Printf("Archived versions %d to %d\n", first, last)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
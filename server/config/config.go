@@ -4,8 +4,11 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/spf13/cast"
 	"github.com/spf13/viper"
 
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -151,6 +154,54 @@ type StateSyncConfig struct {
 	// SnapshotKeepRecent sets the number of recent state sync snapshots to keep.
 	// 0 keeps all snapshots.
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
+
+	// SnapshotFormat sets the format of the state sync snapshots taken. Snapshots
+	// of any supported format can be restored.
+	SnapshotFormat uint32 `mapstructure:"snapshot-format"`
+
+	// SnapshotCompression sets the compression of the chunks of the state sync
+	// snapshots taken in format 2, either "zstd" or "none".
+	SnapshotCompression string `mapstructure:"snapshot-compression"`
+}
+
+// StoreConfig defines the multi-store configuration.
+type StoreConfig struct {
+	// Streamers defines the state streaming services to enable. Supported
+	// services: "file".
+	Streamers []string `mapstructure:"streamers"`
+
+	// Archive enables the archive store, which keeps every committed version of
+	// the state in a separate database to serve queries at pruned heights.
+	Archive bool `mapstructure:"archive"`
+
+	// IAVLCacheSize defines the number of nodes cached by each IAVL store.
+	IAVLCacheSize int `mapstructure:"iavl-cache-size"`
+
+	// IAVLCacheSizes defines the number of nodes cached by the IAVL stores with
+	// the given names, overriding IAVLCacheSize.
+	IAVLCacheSizes map[string]int `mapstructure:"iavl-cache-sizes"`
+
+	// IAVLFastNode enables the index of the latest state of the IAVL stores,
+	// which serves reads of the latest state without walking the trees.
+	IAVLFastNode bool `mapstructure:"iavl-fast-node"`
+}
+
+// FileStreamerConfig defines the configuration of the file streaming service.
+type FileStreamerConfig struct {
+	// Keys defines the store keys whose state changes are streamed, "*" selects
+	// all KVStores.
+	Keys []string `mapstructure:"keys"`
+
+	// WriteDir defines the directory the block files are written to.
+	WriteDir string `mapstructure:"write_dir"`
+
+	// Prefix defines an optional prefix for the names of the block files.
+	Prefix string `mapstructure:"prefix"`
+}
+
+// StreamersConfig defines the configuration of the state streaming services.
+type StreamersConfig struct {
+	File FileStreamerConfig `mapstructure:"file"`
 }
 
 // Config defines the server's top level configuration
@@ -164,6 +215,8 @@ type Config struct {
 	Rosetta   RosettaConfig    `mapstructure:"rosetta"`
 	GRPCWeb   GRPCWebConfig    `mapstructure:"grpc-web"`
 	StateSync StateSyncConfig  `mapstructure:"state-sync"`
+	Store     StoreConfig      `mapstructure:"store"`
+	Streamers StreamersConfig  `mapstructure:"streamers"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -235,8 +288,20 @@ func DefaultConfig() *Config {
 			Address: DefaultGRPCWebAddress,
 		},
 		StateSync: StateSyncConfig{
-			SnapshotInterval:   0,
-			SnapshotKeepRecent: 2,
+			SnapshotInterval:    0,
+			SnapshotKeepRecent:  2,
+			SnapshotFormat:      snapshottypes.CurrentFormat,
+			SnapshotCompression: string(snapshottypes.DefaultCompression),
+		},
+		Store: StoreConfig{
+			Streamers:      []string{},
+			IAVLCacheSize:  iavl.DefaultIAVLCacheSize,
+			IAVLCacheSizes: map[string]int{},
+		},
+		Streamers: StreamersConfig{
+			File: FileStreamerConfig{
+				Keys: []string{"*"},
+			},
 		},
 	}
 }
@@ -301,8 +366,24 @@ func GetConfig(v *viper.Viper) Config {
 			Address: v.GetString("grpc-web.address"),
 		},
 		StateSync: StateSyncConfig{
-			SnapshotInterval:   v.GetUint64("state-sync.snapshot-interval"),
-			SnapshotKeepRecent: v.GetUint32("state-sync.snapshot-keep-recent"),
+			SnapshotInterval:    v.GetUint64("state-sync.snapshot-interval"),
+			SnapshotKeepRecent:  v.GetUint32("state-sync.snapshot-keep-recent"),
+			SnapshotFormat:      v.GetUint32("state-sync.snapshot-format"),
+			SnapshotCompression: v.GetString("state-sync.snapshot-compression"),
+		},
+		Store: StoreConfig{
+			Streamers:      v.GetStringSlice("store.streamers"),
+			Archive:        v.GetBool("store.archive"),
+			IAVLCacheSize:  v.GetInt("store.iavl-cache-size"),
+			IAVLCacheSizes: cast.ToStringMapInt(v.Get("store.iavl-cache-sizes")),
+			IAVLFastNode:   v.GetBool("store.iavl-fast-node"),
+		},
+		Streamers: StreamersConfig{
+			File: FileStreamerConfig{
+				Keys:     v.GetStringSlice("streamers.file.keys"),
+				WriteDir: v.GetString("streamers.file.write_dir"),
+				Prefix:   v.GetString("streamers.file.prefix"),
+			},
 		},
 	}
 }
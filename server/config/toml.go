@@ -198,6 +198,56 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
+
+# snapshot-format specifies the format of the snapshots taken. Format 2 exports and restores the
+# stores in parallel; format 1 can be used to serve nodes that do not support format 2. Snapshots
+# of both formats can be restored.
+snapshot-format = {{ .StateSync.SnapshotFormat }}
+
+# snapshot-compression specifies the compression of the chunks of format 2 snapshots: "zstd" or
+# "none". Nodes using different compressions produce distinct snapshots.
+snapshot-compression = "{{ .StateSync.SnapshotCompression }}"
+
+###############################################################################
+###                         Store / State Streaming                         ###
+###############################################################################
+
+[store]
+
+# streamers defines the state streaming services to enable, e.g. ["file"].
+# Enabled services receive the state changes of every BeginBlock, DeliverTx and EndBlock.
+streamers = [{{ range .Store.Streamers }}{{ printf "%q, " . }}{{end}}]
+
+# archive enables the archive store: the state changes of every block are also
+# written to data/archive.db, which serves queries at heights already pruned from
+# the state. An existing node must run the backfill-archive command first.
+archive = {{ .Store.Archive }}
+
+# iavl-cache-size defines the number of nodes cached by each IAVL store, unless set for
+# the store in the [store.iavl-cache-sizes] table below.
+iavl-cache-size = {{ .Store.IAVLCacheSize }}
+
+# iavl-fast-node enables an index of the latest state of the IAVL stores, which serves
+# reads and iteration at the current height without walking the trees. The index is
+# built on the first start with it enabled.
+iavl-fast-node = {{ .Store.IAVLFastNode }}
+
+# iavl-cache-sizes defines the number of nodes cached by the IAVL stores with the given
+# names, e.g. bank = 100000.
+[store.iavl-cache-sizes]
+{{ range $name, $size := .Store.IAVLCacheSizes }}{{ $name }} = {{ $size }}
+{{ end }}
+[streamers]
+[streamers.file]
+
+# keys defines the store keys whose state changes are streamed ("*" for all KVStores).
+keys = [{{ range .Streamers.File.Keys }}{{ printf "%q, " . }}{{end}}]
+
+# write_dir defines the directory the block files are written to.
+write_dir = "{{ .Streamers.File.WriteDir }}"
+
+# prefix defines an optional prefix for the names of the block files.
+prefix = "{{ .Streamers.File.Prefix }}"
 `
 
 var configTemplate *template.Template
@@ -43,6 +43,14 @@ func (ms multiStore) SetTracer(w io.Writer) sdk.MultiStore {
 	panic("not implemented")
 }
 
+func (ms multiStore) ListeningEnabled(key sdk.StoreKey) bool {
+	panic("not implemented")
+}
+
+func (ms multiStore) AddListeners(key sdk.StoreKey, listeners []store.WriteListener) {
+	panic("not implemented")
+}
+
 func (ms multiStore) Commit() sdk.CommitID {
 	panic("not implemented")
 }
@@ -107,6 +115,18 @@ func (ms multiStore) SetInitialVersion(version int64) error {
 	panic("not implemented")
 }
 
+func (ms multiStore) SetIAVLCacheSize(size int) {
+	panic("not implemented")
+}
+
+func (ms multiStore) SetIAVLCacheSizes(sizes map[string]int) {
+	panic("not implemented")
+}
+
+func (ms multiStore) SetIAVLFastNode(enabled bool) {
+	panic("not implemented")
+}
+
 func (ms multiStore) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, error) {
 	panic("not implemented")
 }
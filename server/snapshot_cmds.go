@@ -0,0 +1,512 @@
+package server
+
+// DONTCOVER
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmcfg "github.com/tendermint/tendermint/config"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+)
+
+const (
+	// FlagSnapshotOutput is the path of the archive written by the snapshots dump command.
+	FlagSnapshotOutput = "output"
+
+	// snapshotArchiveMetadata is the name of the archive entry holding the snapshot metadata.
+	snapshotArchiveMetadata = "metadata"
+)
+
+// SnapshotCmd returns the snapshots command, whose subcommands manage the state
+// sync snapshots of a stopped node.
+func SnapshotCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshots",
+		Short: "Manage the state sync snapshots of a stopped node",
+	}
+
+	cmd.AddCommand(
+		listSnapshotsCmd(),
+		deleteSnapshotCmd(),
+		exportSnapshotCmd(appCreator),
+		dumpSnapshotCmd(),
+		loadSnapshotCmd(),
+		restoreSnapshotCmd(appCreator),
+	)
+
+	cmd.PersistentFlags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
+
+func listSnapshotsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the local snapshots",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			store, err := openSnapshotStoreFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			list, err := store.List()
+			if err != nil {
+				return fmt.Errorf("failed to list snapshots: %w", err)
+			}
+
+			for _, snapshot := range list {
+				cmd.Printf("height: %d format: %d chunks: %d\n", snapshot.Height, snapshot.Format, snapshot.Chunks)
+			}
+
+			return nil
+		},
+	}
+}
+
+func deleteSnapshotCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <height> <format>",
+		Short: "Delete a local snapshot",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseSnapshotArgs(args)
+			if err != nil {
+				return err
+			}
+
+			store, err := openSnapshotStoreFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			return store.Delete(height, format)
+		},
+	}
+}
+
+func exportSnapshotCmd(appCreator types.AppCreator) *cobra.Command {
+	return &cobra.Command{
+		Use:   "export",
+		Short: "Export a snapshot of the app state at the current height to the local snapshot store",
+		Long: `Export a snapshot of the app state at the current height to the local snapshot store,
+in the snapshot format configured in the [state-sync] section of app.toml. The node must
+be stopped.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			app, closeDB, err := openAppFromCmd(cmd, appCreator)
+			if err != nil {
+				return err
+			}
+			defer closeDB()
+
+			height := app.LastBlockHeight()
+			if height == 0 {
+				return fmt.Errorf("the app has no committed state to snapshot")
+			}
+
+			snapshot, err := app.SnapshotManager().Create(uint64(height))
+			if err != nil {
+				return fmt.Errorf("failed to export snapshot: %w", err)
+			}
+
+			cmd.Printf("Exported snapshot at height %d format %d with %d chunks\n",
+				snapshot.Height, snapshot.Format, snapshot.Chunks)
+			return nil
+		},
+	}
+}
+
+func dumpSnapshotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dump <height> <format>",
+		Short: "Dump a local snapshot to a single archive file",
+		Long: `Dump a local snapshot to a single gzipped tar archive, holding the snapshot metadata,
+the Tendermint state and commit at the snapshot height, and the snapshot chunks. The
+archive can be loaded into the snapshot store of another node with the load command.
+
+The Tendermint state is the one loaded along with the snapshot or, failing that, is built
+from the Tendermint state and block store of the node, which must hold the snapshot height
+and the following one. The node must be stopped.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseSnapshotArgs(args)
+			if err != nil {
+				return err
+			}
+
+			store, err := openSnapshotStoreFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			stateBz, commitBz, err := snapshotTendermintState(configFromCmd(cmd), height)
+			if err != nil {
+				return fmt.Errorf("failed to get the Tendermint state at height %d: %w", height, err)
+			}
+
+			output, _ := cmd.Flags().GetString(FlagSnapshotOutput)
+			if output == "" {
+				output = fmt.Sprintf("%d-%d.tar.gz", height, format)
+			}
+
+			if err := dumpSnapshot(store, height, format, stateBz, commitBz, output); err != nil {
+				return fmt.Errorf("failed to dump snapshot: %w", err)
+			}
+
+			cmd.Printf("Dumped snapshot at height %d format %d to %s\n", height, format, output)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP(FlagSnapshotOutput, "o", "", "The archive file to write (default <height>-<format>.tar.gz)")
+
+	return cmd
+}
+
+func loadSnapshotCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "load <archive>",
+		Short: "Load a snapshot archive file into the local snapshot store",
+		Long: `Load a snapshot archive file written by the dump command into the local snapshot store.
+The chunks are verified against the snapshot metadata, and the Tendermint state and commit
+at the snapshot height are kept for the restore command.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, err := openSnapshotStoreFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			snapshot, stateBz, commitBz, err := loadSnapshot(store, args[0])
+			if err != nil {
+				return fmt.Errorf("failed to load snapshot: %w", err)
+			}
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			if err := saveSnapshotTendermintState(homeDir, snapshot.Height, stateBz, commitBz); err != nil {
+				return fmt.Errorf("failed to save the Tendermint state: %w", err)
+			}
+
+			cmd.Printf("Loaded snapshot at height %d format %d with %d chunks\n",
+				snapshot.Height, snapshot.Format, snapshot.Chunks)
+			return nil
+		},
+	}
+}
+
+func restoreSnapshotCmd(appCreator types.AppCreator) *cobra.Command {
+	return &cobra.Command{
+		Use:   "restore <height> <format>",
+		Short: "Restore the node state from a local snapshot",
+		Long: `Restore the app state from a local snapshot into an empty application database, then
+bootstrap the empty Tendermint state and block store at the snapshot height, as state sync
+does, so that the node can be started without peers serving snapshots. The node must be
+stopped.
+
+The Tendermint state and commit at the snapshot height are the ones kept when the snapshot
+was loaded from an archive with the load command.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseSnapshotArgs(args)
+			if err != nil {
+				return err
+			}
+
+			config := configFromCmd(cmd)
+			stateBz, commitBz, err := loadSnapshotTendermintState(config.RootDir, height)
+			if err != nil {
+				return err
+			}
+			if stateBz == nil {
+				return fmt.Errorf("the Tendermint state at height %d is unknown, the snapshot must be loaded from an archive", height)
+			}
+
+			state, commit, err := unmarshalTendermintState(stateBz, commitBz)
+			if err != nil {
+				return err
+			}
+			if uint64(state.LastBlockHeight) != height {
+				return fmt.Errorf("the Tendermint state is at height %d instead of %d", state.LastBlockHeight, height)
+			}
+
+			tmStores, err := openTendermintStores(config)
+			if err != nil {
+				return err
+			}
+			defer tmStores.Close()
+
+			empty, err := tmStores.Empty()
+			if err != nil {
+				return err
+			}
+			if !empty {
+				return fmt.Errorf("the Tendermint state and block store are not empty")
+			}
+
+			app, closeDB, err := openAppFromCmd(cmd, appCreator)
+			if err != nil {
+				return err
+			}
+			defer closeDB()
+
+			if err := app.SnapshotManager().RestoreLocalSnapshot(height, format); err != nil {
+				return fmt.Errorf("failed to restore snapshot: %w", err)
+			}
+
+			appHash := app.Info(abci.RequestInfo{}).LastBlockAppHash
+			if !bytes.Equal(appHash, state.AppHash) {
+				return fmt.Errorf("the restored app hash %X does not match the Tendermint state app hash %X",
+					appHash, state.AppHash)
+			}
+
+			if err := tmStores.Bootstrap(state, commit); err != nil {
+				return err
+			}
+
+			cmd.Printf("Restored the app and Tendermint state at height %d\n", height)
+			return nil
+		},
+	}
+}
+
+// openSnapshotStoreFromCmd opens the snapshot store of the node home given to the command.
+func openSnapshotStoreFromCmd(cmd *cobra.Command) (*snapshots.Store, error) {
+	homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+	return OpenSnapshotStore(homeDir)
+}
+
+// configFromCmd returns the Tendermint config of the node home given to the command.
+func configFromCmd(cmd *cobra.Command) *tmcfg.Config {
+	config := GetServerContextFromCmd(cmd).Config
+
+	homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+	config.SetRoot(homeDir)
+
+	return config
+}
+
+// openAppFromCmd creates the application on the database of the node home given to the
+// command, returning it along with a function closing the database.
+func openAppFromCmd(cmd *cobra.Command, appCreator types.AppCreator) (types.Application, func(), error) {
+	serverCtx := GetServerContextFromCmd(cmd)
+	config := configFromCmd(cmd)
+
+	db, err := openDB(config.RootDir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	app := appCreator(serverCtx.Logger, db, nil, serverCtx.Viper)
+	if app.SnapshotManager() == nil {
+		db.Close()
+		return nil, nil, fmt.Errorf("the app has no snapshot store configured")
+	}
+
+	return app, func() { db.Close() }, nil
+}
+
+// parseSnapshotArgs parses the height and format arguments of a command.
+func parseSnapshotArgs(args []string) (uint64, uint32, error) {
+	height, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid snapshot height %q: %w", args[0], err)
+	}
+
+	format, err := strconv.ParseUint(args[1], 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid snapshot format %q: %w", args[1], err)
+	}
+
+	return height, uint32(format), nil
+}
+
+// dumpSnapshot writes a snapshot to a gzipped tar archive, holding the snapshot metadata
+// and the encoded Tendermint state and commit, followed by the chunks, named by their index.
+func dumpSnapshot(store *snapshots.Store, height uint64, format uint32, stateBz, commitBz []byte, output string) error {
+	snapshot, chunks, err := store.Load(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return fmt.Errorf("snapshot at height %d format %d does not exist", height, format)
+	}
+	defer snapshots.DrainChunks(chunks)
+
+	file, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	gzipWriter := gzip.NewWriter(file)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	metadata, err := proto.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	if err := writeArchiveEntry(tarWriter, snapshotArchiveMetadata, metadata); err != nil {
+		return err
+	}
+	if err := writeArchiveEntry(tarWriter, snapshotArchiveTendermintState, stateBz); err != nil {
+		return err
+	}
+	if err := writeArchiveEntry(tarWriter, snapshotArchiveTendermintCommit, commitBz); err != nil {
+		return err
+	}
+
+	index := 0
+	for chunk := range chunks {
+		body, err := ioutil.ReadAll(chunk)
+		chunk.Close()
+		if err != nil {
+			return fmt.Errorf("failed to read chunk %d: %w", index, err)
+		}
+
+		if err := writeArchiveEntry(tarWriter, strconv.Itoa(index), body); err != nil {
+			return err
+		}
+		index++
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return err
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return err
+	}
+
+	return file.Close()
+}
+
+// writeArchiveEntry writes an entry to a tar archive.
+func writeArchiveEntry(tarWriter *tar.Writer, name string, body []byte) error {
+	header := &tar.Header{
+		Name: name,
+		Mode: 0644,
+		Size: int64(len(body)),
+	}
+	if err := tarWriter.WriteHeader(header); err != nil {
+		return err
+	}
+
+	_, err := tarWriter.Write(body)
+	return err
+}
+
+// loadSnapshot imports a snapshot from an archive written by dumpSnapshot into the store,
+// returning it along with the encoded Tendermint state and commit.
+func loadSnapshot(store *snapshots.Store, input string) (*snapshottypes.Snapshot, []byte, []byte, error) {
+	file, err := os.Open(input)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	defer file.Close()
+
+	gzipReader, err := gzip.NewReader(file)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	defer gzipReader.Close()
+
+	tarReader := tar.NewReader(gzipReader)
+
+	metadata, err := readArchiveEntry(tarReader, snapshotArchiveMetadata)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to read snapshot metadata: %w", err)
+	}
+
+	snapshot := &snapshottypes.Snapshot{}
+	if err := proto.Unmarshal(metadata, snapshot); err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to decode snapshot metadata: %w", err)
+	}
+
+	stateBz, err := readArchiveEntry(tarReader, snapshotArchiveTendermintState)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to read the Tendermint state: %w", err)
+	}
+
+	commitBz, err := readArchiveEntry(tarReader, snapshotArchiveTendermintCommit)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to read the Tendermint commit: %w", err)
+	}
+
+	state, _, err := unmarshalTendermintState(stateBz, commitBz)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if uint64(state.LastBlockHeight) != snapshot.Height {
+		return nil, nil, nil, fmt.Errorf("the Tendermint state is at height %d instead of %d",
+			state.LastBlockHeight, snapshot.Height)
+	}
+
+	// the reader stops when the import returns early, on an invalid chunk
+	chunks := make(chan io.ReadCloser)
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		defer close(chunks)
+
+		for index := 0; ; index++ {
+			var chunk io.ReadCloser
+
+			body, err := readArchiveEntry(tarReader, strconv.Itoa(index))
+			switch {
+			case err == io.EOF:
+				return
+			case err != nil:
+				pr, pw := io.Pipe()
+				_ = pw.CloseWithError(err)
+				chunk = pr
+			default:
+				chunk = ioutil.NopCloser(bytes.NewReader(body))
+			}
+
+			select {
+			case chunks <- chunk:
+			case <-done:
+				return
+			}
+
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	if err := store.Import(snapshot, chunks); err != nil {
+		return nil, nil, nil, err
+	}
+
+	return snapshot, stateBz, commitBz, nil
+}
+
+// readArchiveEntry reads the next tar archive entry, which must have the given name,
+// returning io.EOF at the end of the archive.
+func readArchiveEntry(tarReader *tar.Reader, name string) ([]byte, error) {
+	header, err := tarReader.Next()
+	if err != nil {
+		return nil, err
+	}
+	if header.Name != name {
+		return nil, fmt.Errorf("expected archive entry %q, got %q", name, header.Name)
+	}
+
+	return ioutil.ReadAll(tarReader)
+}
@@ -0,0 +1,270 @@
+package server
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	tmcfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/node"
+	tmstate "github.com/tendermint/tendermint/proto/tendermint/state"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+const (
+	// snapshotArchiveTendermintState is the name of the archive entry holding the
+	// Tendermint state at the snapshot height.
+	snapshotArchiveTendermintState = "tendermint-state"
+
+	// snapshotArchiveTendermintCommit is the name of the archive entry holding the
+	// commit of the block at the snapshot height.
+	snapshotArchiveTendermintCommit = "tendermint-commit"
+)
+
+// tendermintStores holds the Tendermint state and block stores of a node.
+type tendermintStores struct {
+	stateDB      dbm.DB
+	blockStoreDB dbm.DB
+
+	stateStore sm.Store
+	blockStore *store.BlockStore
+}
+
+// openTendermintStores opens the Tendermint state and block stores of the node with
+// the given config.
+func openTendermintStores(config *tmcfg.Config) (*tendermintStores, error) {
+	stateDB, err := node.DefaultDBProvider(&node.DBContext{ID: "state", Config: config})
+	if err != nil {
+		return nil, err
+	}
+
+	blockStoreDB, err := node.DefaultDBProvider(&node.DBContext{ID: "blockstore", Config: config})
+	if err != nil {
+		stateDB.Close()
+		return nil, err
+	}
+
+	return &tendermintStores{
+		stateDB:      stateDB,
+		blockStoreDB: blockStoreDB,
+		stateStore:   sm.NewStore(stateDB),
+		blockStore:   store.NewBlockStore(blockStoreDB),
+	}, nil
+}
+
+// Close closes the databases of the stores.
+func (s *tendermintStores) Close() {
+	s.stateDB.Close()
+	s.blockStoreDB.Close()
+}
+
+// State returns the Tendermint state after the block at the given height, along with
+// the commit of that block. As in state sync, the state at a past height is built
+// from the blocks at the height and the next one, and from the validator sets and
+// consensus params saved for the following heights.
+func (s *tendermintStores) State(height int64) (sm.State, *tmtypes.Commit, error) {
+	state, err := s.stateStore.Load()
+	if err != nil {
+		return sm.State{}, nil, err
+	}
+
+	switch {
+	case state.IsEmpty() || height > state.LastBlockHeight:
+		return sm.State{}, nil, fmt.Errorf("the Tendermint state is behind height %d", height)
+
+	case height == state.LastBlockHeight:
+		commit := s.blockStore.LoadSeenCommit(height)
+		if commit == nil {
+			return sm.State{}, nil, fmt.Errorf("the commit of block %d is not in the block store", height)
+		}
+
+		return state, commit, nil
+	}
+
+	lastBlock := s.blockStore.LoadBlockMeta(height)
+	currentBlock := s.blockStore.LoadBlockMeta(height + 1)
+	commit := s.blockStore.LoadBlockCommit(height)
+	if lastBlock == nil || currentBlock == nil || commit == nil {
+		return sm.State{}, nil, fmt.Errorf("the blocks %d and %d are not in the block store", height, height+1)
+	}
+
+	lastValidators, err := s.stateStore.LoadValidators(height)
+	if err != nil {
+		return sm.State{}, nil, err
+	}
+	validators, err := s.stateStore.LoadValidators(height + 1)
+	if err != nil {
+		return sm.State{}, nil, err
+	}
+	nextValidators, err := s.stateStore.LoadValidators(height + 2)
+	if err != nil {
+		return sm.State{}, nil, err
+	}
+	consensusParams, err := s.stateStore.LoadConsensusParams(height + 1)
+	if err != nil {
+		return sm.State{}, nil, err
+	}
+
+	state.LastBlockHeight = height
+	state.LastBlockTime = lastBlock.Header.Time
+	state.LastBlockID = lastBlock.BlockID
+	state.AppHash = currentBlock.Header.AppHash
+	state.LastResultsHash = currentBlock.Header.LastResultsHash
+	state.LastValidators = lastValidators
+	state.Validators = validators
+	state.NextValidators = nextValidators
+	state.LastHeightValidatorsChanged = height + 2
+	state.ConsensusParams = consensusParams
+	state.LastHeightConsensusParamsChanged = height + 1
+
+	return state, commit, nil
+}
+
+// Empty returns true if the stores hold neither a Tendermint state nor blocks.
+func (s *tendermintStores) Empty() (bool, error) {
+	state, err := s.stateStore.Load()
+	if err != nil {
+		return false, err
+	}
+
+	return state.IsEmpty() && s.blockStore.Height() == 0, nil
+}
+
+// Bootstrap saves the Tendermint state and the commit of the block at its height into
+// empty stores, as state sync does, so that the node starts at that height.
+func (s *tendermintStores) Bootstrap(state sm.State, commit *tmtypes.Commit) error {
+	if err := s.stateStore.Bootstrap(state); err != nil {
+		return fmt.Errorf("failed to bootstrap the Tendermint state: %w", err)
+	}
+
+	if err := s.blockStore.SaveSeenCommit(state.LastBlockHeight, commit); err != nil {
+		return fmt.Errorf("failed to save the commit of block %d: %w", state.LastBlockHeight, err)
+	}
+
+	return nil
+}
+
+// marshalTendermintState encodes a Tendermint state and a commit to protobuf.
+func marshalTendermintState(state sm.State, commit *tmtypes.Commit) ([]byte, []byte, error) {
+	statePb, err := state.ToProto()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stateBz, err := statePb.Marshal()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	commitBz, err := commit.ToProto().Marshal()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return stateBz, commitBz, nil
+}
+
+// unmarshalTendermintState decodes a Tendermint state and a commit encoded by
+// marshalTendermintState.
+func unmarshalTendermintState(stateBz, commitBz []byte) (sm.State, *tmtypes.Commit, error) {
+	statePb := &tmstate.State{}
+	if err := statePb.Unmarshal(stateBz); err != nil {
+		return sm.State{}, nil, fmt.Errorf("failed to decode the Tendermint state: %w", err)
+	}
+
+	state, err := sm.StateFromProto(statePb)
+	if err != nil {
+		return sm.State{}, nil, fmt.Errorf("invalid Tendermint state: %w", err)
+	}
+
+	commitPb := &tmproto.Commit{}
+	if err := commitPb.Unmarshal(commitBz); err != nil {
+		return sm.State{}, nil, fmt.Errorf("failed to decode the Tendermint commit: %w", err)
+	}
+
+	commit, err := tmtypes.CommitFromProto(commitPb)
+	if err != nil {
+		return sm.State{}, nil, fmt.Errorf("invalid Tendermint commit: %w", err)
+	}
+
+	if commit.Height != state.LastBlockHeight {
+		return sm.State{}, nil, fmt.Errorf("the commit of block %d does not match the Tendermint state at height %d",
+			commit.Height, state.LastBlockHeight)
+	}
+
+	return *state, commit, nil
+}
+
+// snapshotTendermintStatePaths returns the files holding the encoded Tendermint state
+// and commit of the snapshots at the given height, for the node with the given root
+// directory. They are written when a snapshot archive is loaded.
+func snapshotTendermintStatePaths(rootDir string, height uint64) (string, string) {
+	dir := filepath.Join(rootDir, "data", "snapshots", "tendermint")
+	return filepath.Join(dir, fmt.Sprintf("%d.state", height)), filepath.Join(dir, fmt.Sprintf("%d.commit", height))
+}
+
+// saveSnapshotTendermintState writes the encoded Tendermint state and commit of the
+// snapshots at the given height.
+func saveSnapshotTendermintState(rootDir string, height uint64, stateBz, commitBz []byte) error {
+	statePath, commitPath := snapshotTendermintStatePaths(rootDir, height)
+	if err := os.MkdirAll(filepath.Dir(statePath), 0755); err != nil {
+		return err
+	}
+
+	if err := ioutil.WriteFile(statePath, stateBz, 0644); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(commitPath, commitBz, 0644)
+}
+
+// loadSnapshotTendermintState reads the encoded Tendermint state and commit of the
+// snapshots at the given height, returning nil if they were not saved.
+func loadSnapshotTendermintState(rootDir string, height uint64) ([]byte, []byte, error) {
+	statePath, commitPath := snapshotTendermintStatePaths(rootDir, height)
+
+	stateBz, err := ioutil.ReadFile(statePath)
+	if os.IsNotExist(err) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	commitBz, err := ioutil.ReadFile(commitPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return stateBz, commitBz, nil
+}
+
+// snapshotTendermintState returns the encoded Tendermint state and commit at the height
+// of a snapshot, as saved when the snapshot was loaded or, failing that, as built from
+// the Tendermint stores of the node.
+func snapshotTendermintState(config *tmcfg.Config, height uint64) ([]byte, []byte, error) {
+	stateBz, commitBz, err := loadSnapshotTendermintState(config.RootDir, height)
+	if err != nil || stateBz != nil {
+		return stateBz, commitBz, err
+	}
+
+	stores, err := openTendermintStores(config)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer stores.Close()
+
+	state, commit, err := stores.State(int64(height))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return marshalTendermintState(state, commit)
+}
@@ -4,6 +4,7 @@ package server
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"runtime/pprof"
@@ -32,6 +33,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
 	"github.com/cosmos/cosmos-sdk/server/types"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 )
 
@@ -48,6 +51,7 @@ const (
 	FlagInterBlockCache    = "inter-block-cache"
 	FlagUnsafeSkipUpgrades = "unsafe-skip-upgrades"
 	FlagTrace              = "trace"
+	FlagGasProfiling       = "gas-profiling"
 	FlagInvCheckPeriod     = "inv-check-period"
 
 	FlagPruning           = "pruning"
@@ -56,6 +60,10 @@ const (
 	FlagPruningInterval   = "pruning-interval"
 	FlagIndexEvents       = "index-events"
 	FlagMinRetainBlocks   = "min-retain-blocks"
+	FlagArchive           = "store.archive"
+	FlagIAVLCacheSize     = "store.iavl-cache-size"
+	FlagIAVLCacheSizes    = "store.iavl-cache-sizes"
+	FlagIAVLFastNode      = "store.iavl-fast-node"
 )
 
 // GRPC-related flags.
@@ -68,8 +76,10 @@ const (
 
 // State sync-related flags.
 const (
-	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
-	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
+	FlagStateSyncSnapshotInterval    = "state-sync.snapshot-interval"
+	FlagStateSyncSnapshotKeepRecent  = "state-sync.snapshot-keep-recent"
+	FlagStateSyncSnapshotFormat      = "state-sync.snapshot-format"
+	FlagStateSyncSnapshotCompression = "state-sync.snapshot-compression"
 )
 
 // StartCmd runs the service passed in, either stand-alone or in-process with
@@ -149,12 +159,16 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Bool(FlagInterBlockCache, true, "Enable inter-block caching")
 	cmd.Flags().String(flagCPUProfile, "", "Enable CPU profiling and write to the provided file")
 	cmd.Flags().Bool(FlagTrace, false, "Provide full stack traces for errors in ABCI Log")
+	cmd.Flags().Bool(FlagGasProfiling, false, "Break the gas used by each tx down by message, store key and operation, and log the gas profile of each block")
 	cmd.Flags().String(FlagPruning, storetypes.PruningOptionDefault, "Pruning strategy (default|nothing|everything|custom)")
 	cmd.Flags().Uint64(FlagPruningKeepRecent, 0, "Number of recent heights to keep on disk (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint64(FlagPruningKeepEvery, 0, "Offset heights to keep on disk after 'keep-every' (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint64(FlagPruningInterval, 0, "Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint(FlagInvCheckPeriod, 0, "Assert registered invariants every N blocks")
 	cmd.Flags().Uint64(FlagMinRetainBlocks, 0, "Minimum block height offset during ABCI commit to prune Tendermint blocks")
+	cmd.Flags().Bool(FlagArchive, false, "Keep every committed version of the state in an archive store to serve queries at pruned heights")
+	cmd.Flags().Int(FlagIAVLCacheSize, iavl.DefaultIAVLCacheSize, "Number of nodes cached by each IAVL store")
+	cmd.Flags().Bool(FlagIAVLFastNode, false, "Index the latest state of the IAVL stores to serve reads without walking the trees")
 
 	cmd.Flags().Bool(flagGRPCEnable, true, "Define if the gRPC server should be enabled")
 	cmd.Flags().String(flagGRPCAddress, config.DefaultGRPCAddress, "the gRPC server address to listen on")
@@ -164,6 +178,8 @@ which accepts a path for the resulting pprof file.
 
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
+	cmd.Flags().Uint32(FlagStateSyncSnapshotFormat, snapshottypes.CurrentFormat, "State sync snapshot format")
+	cmd.Flags().String(FlagStateSyncSnapshotCompression, string(snapshottypes.DefaultCompression), "State sync snapshot chunk compression (zstd|none)")
 
 	// add support for all Tendermint-specific command line options
 	tcmd.AddNodeFlags(cmd)
@@ -204,6 +220,8 @@ func startStandAlone(ctx *Context, appCreator types.AppCreator) error {
 		if err = svr.Stop(); err != nil {
 			tmos.Exit(err.Error())
 		}
+
+		closeApp(ctx, app)
 	}()
 
 	// Wait for SIGINT or SIGTERM signal
@@ -372,6 +390,8 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 			_ = tmNode.Stop()
 		}
 
+		closeApp(ctx, app)
+
 		if cpuProfileCleanup != nil {
 			cpuProfileCleanup()
 		}
@@ -393,3 +413,16 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 	// Wait for SIGINT or SIGTERM signal
 	return WaitForQuitSignals()
 }
+
+// closeApp closes the app, once the ABCI server or the Tendermint node has
+// stopped calling it, if the app implements io.Closer.
+func closeApp(ctx *Context, app types.Application) {
+	closer, ok := app.(io.Closer)
+	if !ok {
+		return
+	}
+
+	if err := closer.Close(); err != nil {
+		ctx.Logger.Error("failed to close the app", "err", err)
+	}
+}
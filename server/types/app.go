@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server/api"
 	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/snapshots"
 )
 
 type (
@@ -46,6 +47,13 @@ type (
 
 		// RegisterTendermintService registers the gRPC Query service for tendermint queries.
 		RegisterTendermintService(clientCtx client.Context)
+
+		// LastBlockHeight returns the height of the last committed block.
+		LastBlockHeight() int64
+
+		// SnapshotManager returns the snapshot manager of the application, or nil
+		// if state sync snapshots are not enabled.
+		SnapshotManager() *snapshots.Manager
 	}
 
 	// AppCreator is a function that allows us to lazily initialize an
@@ -26,6 +26,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/snapshots"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 )
@@ -267,6 +268,8 @@ func AddCommands(rootCmd *cobra.Command, defaultNodeHome string, appCreator type
 		flags.LineBreak,
 		tendermintCmd,
 		ExportCmd(appExport, defaultNodeHome),
+		BackfillArchiveCmd(defaultNodeHome),
+		SnapshotCmd(appCreator, defaultNodeHome),
 		flags.LineBreak,
 		version.NewVersionCommand(),
 	)
@@ -365,6 +368,25 @@ func openDB(rootDir string) (dbm.DB, error) {
 	return sdk.NewLevelDB("application", dataDir)
 }
 
+// OpenArchiveDB opens the database of the archive store of the node with the
+// given root directory.
+func OpenArchiveDB(rootDir string) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	return sdk.NewLevelDB("archive", dataDir)
+}
+
+// OpenSnapshotStore opens the state sync snapshot store of the node with the
+// given root directory.
+func OpenSnapshotStore(rootDir string) (*snapshots.Store, error) {
+	snapshotDir := filepath.Join(rootDir, "data", "snapshots")
+	snapshotDB, err := sdk.NewLevelDB("metadata", snapshotDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshots.NewStore(snapshotDB, snapshotDir)
+}
+
 func openTraceWriter(traceWriterFile string) (w io.Writer, err error) {
 	if traceWriterFile == "" {
 		return
@@ -0,0 +1,45 @@
+package simapp
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	feeconvertante "github.com/cosmos/cosmos-sdk/x/feeconvert/ante"
+	feeconvertkeeper "github.com/cosmos/cosmos-sdk/x/feeconvert/keeper"
+	feegrantante "github.com/cosmos/cosmos-sdk/x/feegrant/ante"
+	feegrantkeeper "github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant/types"
+	feemarketante "github.com/cosmos/cosmos-sdk/x/feemarket/ante"
+	feemarketkeeper "github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+)
+
+// NewAnteHandler returns the AnteHandler of the SimApp. It extends the feegrant
+// AnteHandler with the enforcement of the feemarket base fee, checked before
+// the fees are deducted, and values the fees through the feeconvert exchange
+// rates when checking both the minimum gas prices and the base fee.
+func NewAnteHandler(
+	ak authkeeper.AccountKeeper, bankKeeper feegranttypes.BankKeeper, feeGrantKeeper feegrantkeeper.Keeper,
+	feeMarketKeeper feemarketkeeper.Keeper, feeConvertKeeper feeconvertkeeper.Keeper,
+	sigGasConsumer authante.SignatureVerificationGasConsumer,
+	signModeHandler signing.SignModeHandler,
+) sdk.AnteHandler {
+
+	return sdk.ChainAnteDecorators(
+		authante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		authante.NewRejectExtensionOptionsDecorator(),
+		feeconvertante.NewMempoolFeeDecorator(feeConvertKeeper),
+		feemarketante.NewBaseFeeDecorator(feeMarketKeeper, feeConvertKeeper),
+		authante.NewValidateBasicDecorator(),
+		authante.TxTimeoutHeightDecorator{},
+		authante.NewUnorderedTxDecorator(ak, authante.DefaultMaxUnorderedTimeoutDelta),
+		authante.NewValidateMemoDecorator(ak),
+		authante.NewConsumeGasForTxSizeDecorator(ak),
+		feegrantante.NewDeductGrantedFeeDecorator(ak, bankKeeper, feeGrantKeeper),
+		authante.NewSetPubKeyDecorator(ak), // SetPubKeyDecorator must be called before all signature verification decorators
+		authante.NewValidateSigCountDecorator(ak),
+		authante.NewSigGasConsumeDecorator(ak, sigGasConsumer),
+		authante.NewSigVerificationDecorator(ak, signModeHandler),
+		authante.NewIncrementSequenceDecorator(ak), // innermost AnteDecorator
+	)
+}
@@ -20,7 +20,7 @@ func MakeTestEncodingConfig() EncodingConfig {
 	return EncodingConfig{
 		InterfaceRegistry: interfaceRegistry,
 		Marshaler:         marshaler,
-		TxConfig:          tx.NewTxConfig(marshaler, tx.DefaultSignModes),
+		TxConfig:          tx.NewTxConfigWithTextual(marshaler, tx.DefaultSignModes, tx.ClientCoinMetadataQueryFn),
 		Amino:             cdc,
 	}
 }
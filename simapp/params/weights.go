@@ -8,6 +8,7 @@ const (
 	DefaultWeightMsgWithdrawDelegationReward    int = 50
 	DefaultWeightMsgWithdrawValidatorCommission int = 50
 	DefaultWeightMsgFundCommunityPool           int = 50
+	DefaultWeightMsgSetAutoCompound             int = 25
 	DefaultWeightMsgDeposit                     int = 100
 	DefaultWeightMsgVote                        int = 67
 	DefaultWeightMsgVoteWeighted                int = 33
@@ -17,6 +18,9 @@ const (
 	DefaultWeightMsgDelegate                    int = 100
 	DefaultWeightMsgUndelegate                  int = 100
 	DefaultWeightMsgBeginRedelegate             int = 100
+	DefaultWeightMsgCancelUnbondingDelegation   int = 100
+	DefaultWeightMsgTokenizeShares              int = 25
+	DefaultWeightMsgRedeemTokensForShares       int = 25
 
 	DefaultWeightCommunitySpendProposal int = 5
 	DefaultWeightTextProposal           int = 5
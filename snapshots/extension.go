@@ -0,0 +1,196 @@
+package snapshots
+
+import (
+	"io"
+	"sort"
+
+	protoio "github.com/gogo/protobuf/io"
+
+	"github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// sortedExtensions returns the registered extension snapshotters sorted by name, which is the
+// order their payloads are appended to a snapshot in.
+func (m *Manager) sortedExtensions() []types.ExtensionSnapshotter {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	extensions := make([]types.ExtensionSnapshotter, 0, len(m.extensions))
+	for _, extension := range m.extensions {
+		extensions = append(extensions, extension)
+	}
+
+	sort.Slice(extensions, func(i, j int) bool {
+		return extensions[i].SnapshotName() < extensions[j].SnapshotName()
+	})
+
+	return extensions
+}
+
+// snapshotExtensions returns the registered extension snapshotters restoring the extension
+// payloads of a snapshot, in the order of the payloads. It errors if an extension is missing or
+// does not support the payload format, or if the payloads do not fit in the snapshot chunks.
+// It must be called with the mutex held.
+func (m *Manager) snapshotExtensions(snapshot types.Snapshot) ([]types.ExtensionSnapshotter, error) {
+	extensions := make([]types.ExtensionSnapshotter, 0, len(snapshot.Metadata.Extensions))
+	chunks := uint64(0)
+
+	for _, metadata := range snapshot.Metadata.Extensions {
+		extension, ok := m.extensions[metadata.Name]
+		if !ok {
+			return nil, sdkerrors.Wrapf(types.ErrUnknownFormat, "unknown snapshot extension %q", metadata.Name)
+		}
+
+		supported := false
+		for _, format := range extension.SupportedFormats() {
+			if format == metadata.Format {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			return nil, sdkerrors.Wrapf(types.ErrUnknownFormat, "snapshot extension %q format %v",
+				metadata.Name, metadata.Format)
+		}
+
+		extensions = append(extensions, extension)
+		chunks += uint64(metadata.Chunks)
+	}
+
+	if chunks > uint64(snapshot.Chunks) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidMetadata, "snapshot has %v extension chunks, but %v chunks",
+			chunks, snapshot.Chunks)
+	}
+
+	return extensions, nil
+}
+
+// appendExtensionChunks returns a channel passing on the chunks of the multistore snapshot,
+// followed by the chunks of the payloads of the extensions. The metadata of the extension
+// payloads is filled in before the returned channel is closed.
+func appendExtensionChunks(
+	height uint64, chunks <-chan io.ReadCloser, extensions []types.ExtensionSnapshotter,
+	metadata []types.ExtensionMetadata,
+) <-chan io.ReadCloser {
+	ch := make(chan io.ReadCloser)
+
+	go func() {
+		defer close(ch)
+
+		for chunk := range chunks {
+			ch <- chunk
+		}
+
+		for i, extension := range extensions {
+			metadata[i] = types.ExtensionMetadata{
+				Name:   extension.SnapshotName(),
+				Format: extension.SnapshotFormat(),
+			}
+
+			// The extension writes its payloads as length-delimited messages, which are
+			// split into chunks.
+			extensionChunks := make(chan io.ReadCloser)
+			extensionErr := make(chan error, 1)
+			go func(extension types.ExtensionSnapshotter) {
+				chunkWriter := NewChunkWriter(extensionChunks, extensionChunkSize)
+				protoWriter := protoio.NewDelimitedWriter(chunkWriter)
+
+				err := extension.SnapshotExtension(height, func(payload []byte) error {
+					return protoWriter.WriteMsg(&types.ExtensionPayload{Payload: payload})
+				})
+				if err != nil {
+					err = sdkerrors.Wrapf(err, "failed to snapshot extension %q", extension.SnapshotName())
+					extensionErr <- err
+					chunkWriter.CloseWithError(err)
+					return
+				}
+
+				extensionErr <- chunkWriter.Close()
+			}(extension)
+
+			for chunk := range extensionChunks {
+				metadata[i].Chunks++
+				ch <- chunk
+			}
+
+			// The chunk writer does not pass the error on if no chunk was written, so it is
+			// passed on in a chunk of its own.
+			if err := <-extensionErr; err != nil {
+				pr, pw := io.Pipe()
+				_ = pw.CloseWithError(err)
+				ch <- pr
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// restore restores a snapshot from its chunks: the multistore is restored by the target from
+// the leading chunks, and the given extensions from their payloads in the following chunks.
+func (m *Manager) restore(
+	snapshot types.Snapshot, extensions []types.ExtensionSnapshotter, chunks <-chan io.ReadCloser,
+	ready chan<- struct{},
+) error {
+	if len(extensions) == 0 {
+		return m.target.Restore(snapshot.Height, snapshot.Format, chunks, ready)
+	}
+	defer DrainChunks(chunks)
+
+	targetChunks := snapshot.Chunks
+	for _, metadata := range snapshot.Metadata.Extensions {
+		targetChunks -= metadata.Chunks
+	}
+
+	storeChunks := takeChunks(chunks, targetChunks)
+	err := m.target.Restore(snapshot.Height, snapshot.Format, storeChunks, ready)
+	DrainChunks(storeChunks)
+	if err != nil {
+		return err
+	}
+
+	for i, metadata := range snapshot.Metadata.Extensions {
+		extension := extensions[i]
+		chunkReader := NewChunkReader(takeChunks(chunks, metadata.Chunks))
+		protoReader := protoio.NewDelimitedReader(chunkReader, extensionMaxPayloadSize)
+
+		err := extension.RestoreExtension(snapshot.Height, metadata.Format, func() ([]byte, error) {
+			payload := &types.ExtensionPayload{}
+			if err := protoReader.ReadMsg(payload); err != nil {
+				return nil, err
+			}
+			return payload.Payload, nil
+		})
+
+		// drain the payloads the extension did not read
+		if e := chunkReader.Close(); e != nil && err == nil {
+			err = e
+		}
+		if err != nil {
+			return sdkerrors.Wrapf(err, "failed to restore extension %q", metadata.Name)
+		}
+	}
+
+	return nil
+}
+
+// takeChunks returns a channel passing on the next n chunks of the given channel.
+func takeChunks(chunks <-chan io.ReadCloser, n uint32) <-chan io.ReadCloser {
+	ch := make(chan io.ReadCloser)
+
+	go func() {
+		defer close(ch)
+
+		for i := uint32(0); i < n; i++ {
+			chunk, ok := <-chunks
+			if !ok {
+				return
+			}
+			ch <- chunk
+		}
+	}()
+
+	return ch
+}
@@ -98,6 +98,54 @@ func (m *mockSnapshotter) Snapshot(height uint64, format uint32) (<-chan io.Read
 	return ch, nil
 }
 
+type mockExtensionSnapshotter struct {
+	name     string
+	payloads [][]byte
+	err      error
+}
+
+func (m *mockExtensionSnapshotter) SnapshotName() string {
+	return m.name
+}
+
+func (m *mockExtensionSnapshotter) SnapshotFormat() uint32 {
+	return 1
+}
+
+func (m *mockExtensionSnapshotter) SupportedFormats() []uint32 {
+	return []uint32{1}
+}
+
+func (m *mockExtensionSnapshotter) SnapshotExtension(height uint64, payloadWriter types.ExtensionPayloadWriter) error {
+	if m.err != nil {
+		return m.err
+	}
+	for _, payload := range m.payloads {
+		if err := payloadWriter(payload); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *mockExtensionSnapshotter) RestoreExtension(
+	height uint64, format uint32, payloadReader types.ExtensionPayloadReader,
+) error {
+	if format != 1 {
+		return types.ErrUnknownFormat
+	}
+	m.payloads = [][]byte{}
+	for {
+		payload, err := payloadReader()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		m.payloads = append(m.payloads, payload)
+	}
+}
+
 // setupBusyManager creates a manager with an empty store that is busy creating a snapshot at height 1.
 // The snapshot will complete when the returned closer is called.
 func setupBusyManager(t *testing.T) *snapshots.Manager {
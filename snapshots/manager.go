@@ -18,6 +18,12 @@ const (
 	opRestore  operation = "restore"
 
 	chunkBufferSize = 4
+
+	// extensionChunkSize is the size of the chunks holding extension payloads
+	extensionChunkSize = uint64(10e6)
+
+	// extensionMaxPayloadSize is the maximum size of an extension payload
+	extensionMaxPayloadSize = int(64e6)
 )
 
 // operation represents a Manager operation. Only one operation can be in progress at a time.
@@ -43,8 +49,10 @@ type restoreDone struct {
 // 2) io.ReadCloser streams automatically propagate IO errors, and can pass arbitrary
 //    errors via io.Pipe.CloseWithError().
 type Manager struct {
-	store  *Store
-	target types.Snapshotter
+	store      *Store
+	target     types.Snapshotter
+	format     uint32
+	extensions map[string]types.ExtensionSnapshotter
 
 	mtx                sync.Mutex
 	operation          operation
@@ -57,11 +65,43 @@ type Manager struct {
 // NewManager creates a new manager.
 func NewManager(store *Store, target types.Snapshotter) *Manager {
 	return &Manager{
-		store:  store,
-		target: target,
+		store:      store,
+		target:     target,
+		format:     types.CurrentFormat,
+		extensions: make(map[string]types.ExtensionSnapshotter),
 	}
 }
 
+// RegisterExtensions registers extension snapshotters, whose payloads are appended to the
+// snapshots taken and are required to restore a snapshot including them. It errors if an
+// extension with the same name is already registered.
+func (m *Manager) RegisterExtensions(extensions ...types.ExtensionSnapshotter) error {
+	if m == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "no snapshot store configured")
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	for _, extension := range extensions {
+		name := extension.SnapshotName()
+		if _, ok := m.extensions[name]; ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrConflict, "duplicate snapshot extension %q", name)
+		}
+
+		m.extensions[name] = extension
+	}
+
+	return nil
+}
+
+// SetFormat sets the format of the snapshots taken by Create, which defaults to
+// types.CurrentFormat. Snapshots of every format known to the target can be
+// restored regardless.
+func (m *Manager) SetFormat(format uint32) {
+	m.format = format
+}
+
 // begin starts an operation, or errors if one is in progress. It manages the mutex itself.
 func (m *Manager) begin(op operation) error {
 	m.mtx.Lock()
@@ -120,11 +160,28 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 			"a more recent snapshot already exists at height %v", latest.Height)
 	}
 
-	chunks, err := m.target.Snapshot(height, types.CurrentFormat)
+	chunks, err := m.target.Snapshot(height, m.format)
 	if err != nil {
 		return nil, err
 	}
-	return m.store.Save(height, types.CurrentFormat, chunks)
+
+	extensions := m.sortedExtensions()
+	if len(extensions) == 0 {
+		return m.store.Save(height, m.format, chunks)
+	}
+
+	metadata := make([]types.ExtensionMetadata, len(extensions))
+	snapshot, err := m.store.Save(height, m.format, appendExtensionChunks(height, chunks, extensions, metadata))
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot.Metadata.Extensions = metadata
+	if err := m.store.saveSnapshot(snapshot); err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
 }
 
 // List lists snapshots, mirroring ABCI ListSnapshots. It can be concurrent with other operations.
@@ -170,7 +227,13 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	}
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
-	err := m.beginLocked(opRestore)
+
+	extensions, err := m.snapshotExtensions(snapshot)
+	if err != nil {
+		return err
+	}
+
+	err = m.beginLocked(opRestore)
 	if err != nil {
 		return err
 	}
@@ -180,7 +243,7 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	chReady := make(chan struct{}, 1)
 	chDone := make(chan restoreDone, 1)
 	go func() {
-		err := m.target.Restore(snapshot.Height, snapshot.Format, chChunks, chReady)
+		err := m.restore(snapshot, extensions, chChunks, chReady)
 		chDone <- restoreDone{
 			complete: err == nil,
 			err:      err,
@@ -206,6 +269,40 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	return nil
 }
 
+// RestoreLocalSnapshot restores the app state from a snapshot in the local snapshot store,
+// blocking until the restore is complete. Unlike Restore, it does not go through ABCI, so the
+// Tendermint state is not restored along with it.
+func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
+	if m == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "no snapshot store configured")
+	}
+
+	snapshot, chunks, err := m.store.Load(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "snapshot at height %v format %v", height, format)
+	}
+	defer DrainChunks(chunks)
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	extensions, err := m.snapshotExtensions(*snapshot)
+	if err != nil {
+		return err
+	}
+
+	err = m.beginLocked(opRestore)
+	if err != nil {
+		return err
+	}
+	defer m.endLocked()
+
+	return m.restore(*snapshot, extensions, chunks, nil)
+}
+
 // RestoreChunk adds a chunk to an active snapshot restoration, mirroring ABCI ApplySnapshotChunk.
 // Chunks must be given until the restore is complete, returning true, or a chunk errors.
 func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
@@ -89,6 +89,12 @@ func TestManager_Take(t *testing.T) {
 	assert.Equal(t, snapshot, storeSnapshot)
 	assert.Equal(t, [][]byte{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}, readChunks(chunks))
 
+	// snapshots are taken in the format set on the manager
+	manager.SetFormat(types.FormatStream)
+	snapshot, err = manager.Create(6)
+	require.NoError(t, err)
+	assert.Equal(t, types.FormatStream, snapshot.Format)
+
 	// creating a snapshot while a different snapshot is being created should error
 	manager = setupBusyManager(t)
 	_, err = manager.Create(9)
@@ -207,3 +213,83 @@ func TestManager_Restore(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestManager_RestoreLocalSnapshot(t *testing.T) {
+	store := setupStore(t)
+	target := &mockSnapshotter{}
+	manager := snapshots.NewManager(store, target)
+
+	// Restoring a missing snapshot should error
+	err := manager.RestoreLocalSnapshot(4, 1)
+	require.Error(t, err)
+
+	// Restoring a local snapshot should restore all of its chunks
+	err = manager.RestoreLocalSnapshot(2, 2)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{{2, 2, 0}, {2, 2, 1}, {2, 2, 2}}, target.chunks)
+
+	// The operation should have ended, so other operations can run
+	_, err = manager.Prune(1)
+	require.NoError(t, err)
+
+	// Restoring again should fail, because the target already has contents
+	err = manager.RestoreLocalSnapshot(3, 2)
+	require.Error(t, err)
+}
+
+func TestManager_Extensions(t *testing.T) {
+	store := setupStore(t)
+	target := &mockSnapshotter{
+		chunks: [][]byte{
+			{1, 2, 3},
+			{4, 5, 6},
+		},
+	}
+	extensionA := &mockExtensionSnapshotter{name: "a", payloads: [][]byte{{7}, {8, 9}}}
+	extensionB := &mockExtensionSnapshotter{name: "b"}
+
+	manager := snapshots.NewManager(store, target)
+	require.NoError(t, manager.RegisterExtensions(extensionB, extensionA))
+	require.Error(t, manager.RegisterExtensions(&mockExtensionSnapshotter{name: "a"}))
+
+	// the extension payloads follow the target chunks, in extension name order
+	snapshot, err := manager.Create(5)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, snapshot.Chunks)
+	assert.Equal(t, []types.ExtensionMetadata{
+		{Name: "a", Format: 1, Chunks: 1},
+		{Name: "b", Format: 1, Chunks: 0},
+	}, snapshot.Metadata.Extensions)
+
+	storeSnapshot, chunks, err := store.Load(snapshot.Height, snapshot.Format)
+	require.NoError(t, err)
+	assert.Equal(t, snapshot, storeSnapshot)
+	bodies := readChunks(chunks)
+
+	// restoring the snapshot requires the extensions
+	restoreTarget := &mockSnapshotter{}
+	restoreManager := snapshots.NewManager(setupStore(t), restoreTarget)
+	err = restoreManager.Restore(*snapshot)
+	require.True(t, errors.Is(err, types.ErrUnknownFormat))
+
+	restoreA := &mockExtensionSnapshotter{name: "a"}
+	restoreB := &mockExtensionSnapshotter{name: "b"}
+	require.NoError(t, restoreManager.RegisterExtensions(restoreA, restoreB))
+	require.NoError(t, restoreManager.Restore(*snapshot))
+
+	for i, body := range bodies {
+		done, err := restoreManager.RestoreChunk(body)
+		require.NoError(t, err)
+		assert.Equal(t, i == len(bodies)-1, done)
+	}
+
+	assert.Equal(t, target.chunks, restoreTarget.chunks)
+	assert.Equal(t, extensionA.payloads, restoreA.payloads)
+	assert.Equal(t, [][]byte{}, restoreB.payloads)
+
+	// an extension failing fails the snapshot
+	failing := snapshots.NewManager(setupStore(t), target)
+	require.NoError(t, failing.RegisterExtensions(&mockExtensionSnapshotter{name: "a", err: errors.New("boom")}))
+	_, err = failing.Create(6)
+	require.Error(t, err)
+}
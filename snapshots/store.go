@@ -1,6 +1,7 @@
 package snapshots
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/binary"
 	"io"
@@ -293,6 +294,51 @@ func (s *Store) Save(
 	return snapshot, s.saveSnapshot(snapshot)
 }
 
+// Import saves a snapshot taken elsewhere to disk, e.g. one loaded from a snapshot archive. The
+// chunks are verified against the given snapshot metadata, and the snapshot is removed again if
+// they do not match.
+func (s *Store) Import(snapshot *types.Snapshot, chunks <-chan io.ReadCloser) error {
+	saved, err := s.Save(snapshot.Height, snapshot.Format, chunks)
+	if err != nil {
+		return err
+	}
+
+	err = verifyImport(snapshot, saved)
+	if err != nil {
+		if e := s.Delete(saved.Height, saved.Format); e != nil {
+			return sdkerrors.Wrapf(err, "failed to remove snapshot: %v", e)
+		}
+		return err
+	}
+
+	// the metadata of the extension payloads is not derived from the chunks
+	saved.Metadata.Extensions = snapshot.Metadata.Extensions
+	return s.saveSnapshot(saved)
+}
+
+// verifyImport verifies that the chunks of an imported snapshot match its metadata.
+func verifyImport(expected *types.Snapshot, saved *types.Snapshot) error {
+	if saved.Chunks != expected.Chunks {
+		return sdkerrors.Wrapf(types.ErrInvalidMetadata, "snapshot has %v chunks, but %v were imported",
+			expected.Chunks, saved.Chunks)
+	}
+	if len(expected.Metadata.ChunkHashes) != len(saved.Metadata.ChunkHashes) {
+		return sdkerrors.Wrapf(types.ErrInvalidMetadata, "snapshot has %v chunk hashes, but %v chunks",
+			len(expected.Metadata.ChunkHashes), saved.Chunks)
+	}
+	for i, hash := range saved.Metadata.ChunkHashes {
+		if !bytes.Equal(hash, expected.Metadata.ChunkHashes[i]) {
+			return sdkerrors.Wrapf(types.ErrChunkHashMismatch, "chunk %v: expected %x, got %x",
+				i, expected.Metadata.ChunkHashes[i], hash)
+		}
+	}
+	if !bytes.Equal(saved.Hash, expected.Hash) {
+		return sdkerrors.Wrapf(types.ErrInvalidMetadata, "snapshot hash: expected %x, got %x",
+			expected.Hash, saved.Hash)
+	}
+	return nil
+}
+
 // saveSnapshot saves snapshot metadata to the database.
 func (s *Store) saveSnapshot(snapshot *types.Snapshot) error {
 	value, err := proto.Marshal(snapshot)
@@ -333,3 +333,42 @@ func TestStore_Save(t *testing.T) {
 	require.NoError(t, err)
 	close(ch)
 }
+
+func TestStore_Import(t *testing.T) {
+	store := setupStore(t)
+	chunks := [][]byte{{1}, {2}}
+	snapshot := &types.Snapshot{
+		Height: 4,
+		Format: 1,
+		Chunks: 2,
+		Hash:   hash(chunks),
+		Metadata: types.Metadata{
+			ChunkHashes: checksums(chunks),
+			Extensions:  []types.ExtensionMetadata{{Name: "ext", Format: 1, Chunks: 1}},
+		},
+	}
+
+	// Importing a snapshot should work, keeping the extension metadata
+	err := store.Import(snapshot, makeChunks(chunks))
+	require.NoError(t, err)
+	loaded, err := store.Get(4, 1)
+	require.NoError(t, err)
+	assert.Equal(t, snapshot, loaded)
+
+	// Importing chunks not matching the metadata should error and remove the snapshot
+	snapshot.Height = 5
+	err = store.Import(snapshot, makeChunks([][]byte{{1}, {3}}))
+	require.Error(t, err)
+	require.True(t, errors.Is(err, types.ErrChunkHashMismatch))
+	loaded, err = store.Get(5, 1)
+	require.NoError(t, err)
+	assert.Nil(t, loaded)
+
+	// As should importing too few chunks
+	err = store.Import(snapshot, makeChunks([][]byte{{1}}))
+	require.Error(t, err)
+	require.True(t, errors.Is(err, types.ErrInvalidMetadata))
+	loaded, err = store.Get(5, 1)
+	require.NoError(t, err)
+	assert.Nil(t, loaded)
+}
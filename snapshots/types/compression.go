@@ -0,0 +1,29 @@
+package types
+
+import "fmt"
+
+// Compression is the compression applied to the chunks of a snapshot, for the formats that
+// support a choice. Nodes using different compressions produce different snapshots for the
+// same height, which are told apart by their hash.
+type Compression string
+
+const (
+	// CompressionNone stores chunks uncompressed.
+	CompressionNone Compression = "none"
+
+	// CompressionZstd compresses chunks with zstd.
+	CompressionZstd Compression = "zstd"
+)
+
+// DefaultCompression is the compression used when none is configured.
+const DefaultCompression = CompressionZstd
+
+// Validate returns an error if the compression is unknown.
+func (c Compression) Validate() error {
+	switch c {
+	case CompressionNone, CompressionZstd:
+		return nil
+	default:
+		return fmt.Errorf("unknown snapshot compression %q", string(c))
+	}
+}
@@ -1,6 +1,19 @@
 package types
 
+const (
+	// FormatStream is the snapshot format in which the stores are serialized one after the
+	// other as a single zlib-compressed stream of delimited Protobuf items, which is split
+	// into chunks of a fixed size.
+	FormatStream uint32 = 1
+
+	// FormatParallel is the snapshot format in which every chunk holds the items of a single
+	// store, such that stores can be exported and restored in parallel. A chunk starts with a
+	// header naming its compression and store, followed by the delimited Protobuf items of the
+	// store, compressed as a whole.
+	FormatParallel uint32 = 2
+)
+
 // CurrentFormat is the currently used format for snapshots. Snapshots using the same format
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
-const CurrentFormat uint32 = 1
+const CurrentFormat uint32 = FormatParallel
@@ -103,6 +103,9 @@ func (m *Snapshot) GetMetadata() Metadata {
 // Metadata contains SDK-specific snapshot metadata.
 type Metadata struct {
 	ChunkHashes [][]byte `protobuf:"bytes,1,rep,name=chunk_hashes,json=chunkHashes,proto3" json:"chunk_hashes,omitempty"`
+	// extensions describes the payloads of the extension snapshotters, whose chunks follow
+	// the multistore chunks in this order.
+	Extensions []ExtensionMetadata `protobuf:"bytes,2,rep,name=extensions,proto3" json:"extensions"`
 }
 
 func (m *Metadata) Reset()         { *m = Metadata{} }
@@ -145,9 +148,125 @@ func (m *Metadata) GetChunkHashes() [][]byte {
 	return nil
 }
 
+func (m *Metadata) GetExtensions() []ExtensionMetadata {
+	if m != nil {
+		return m.Extensions
+	}
+	return nil
+}
+
+// ExtensionMetadata describes the payloads of an extension snapshotter in a snapshot.
+type ExtensionMetadata struct {
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Format uint32 `protobuf:"varint,2,opt,name=format,proto3" json:"format,omitempty"`
+	Chunks uint32 `protobuf:"varint,3,opt,name=chunks,proto3" json:"chunks,omitempty"`
+}
+
+func (m *ExtensionMetadata) Reset()         { *m = ExtensionMetadata{} }
+func (m *ExtensionMetadata) String() string { return proto.CompactTextString(m) }
+func (*ExtensionMetadata) ProtoMessage()    {}
+func (*ExtensionMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{2}
+}
+func (m *ExtensionMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtensionMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtensionMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtensionMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtensionMetadata.Merge(m, src)
+}
+func (m *ExtensionMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtensionMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtensionMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtensionMetadata proto.InternalMessageInfo
+
+func (m *ExtensionMetadata) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ExtensionMetadata) GetFormat() uint32 {
+	if m != nil {
+		return m.Format
+	}
+	return 0
+}
+
+func (m *ExtensionMetadata) GetChunks() uint32 {
+	if m != nil {
+		return m.Chunks
+	}
+	return 0
+}
+
+// ExtensionPayload is a payload of an extension snapshotter. The payloads are written to the
+// extension chunks as length-delimited messages.
+type ExtensionPayload struct {
+	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (m *ExtensionPayload) Reset()         { *m = ExtensionPayload{} }
+func (m *ExtensionPayload) String() string { return proto.CompactTextString(m) }
+func (*ExtensionPayload) ProtoMessage()    {}
+func (*ExtensionPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{3}
+}
+func (m *ExtensionPayload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtensionPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtensionPayload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtensionPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtensionPayload.Merge(m, src)
+}
+func (m *ExtensionPayload) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtensionPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtensionPayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtensionPayload proto.InternalMessageInfo
+
+func (m *ExtensionPayload) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Snapshot)(nil), "cosmos.base.snapshots.v1beta1.Snapshot")
 	proto.RegisterType((*Metadata)(nil), "cosmos.base.snapshots.v1beta1.Metadata")
+	proto.RegisterType((*ExtensionMetadata)(nil), "cosmos.base.snapshots.v1beta1.ExtensionMetadata")
+	proto.RegisterType((*ExtensionPayload)(nil), "cosmos.base.snapshots.v1beta1.ExtensionPayload")
 }
 
 func init() {
@@ -155,25 +274,30 @@ func init() {
 }
 
 var fileDescriptor_dd7a3c9b0a19e1ee = []byte{
-	// 288 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0x31, 0x4e, 0xc3, 0x30,
-	0x14, 0x86, 0x63, 0x1a, 0xaa, 0xc8, 0x0d, 0x8b, 0x85, 0x90, 0x85, 0x84, 0x09, 0x5d, 0xc8, 0xd0,
-	0x3a, 0x2a, 0xdc, 0xa0, 0x03, 0x82, 0x81, 0x25, 0x6c, 0x2c, 0xc8, 0x49, 0x4d, 0x5c, 0x55, 0xa9,
-	0xa3, 0x3e, 0x17, 0x89, 0x5b, 0x70, 0x15, 0x6e, 0xd1, 0xb1, 0x23, 0x13, 0x42, 0xc9, 0x45, 0x50,
-	0x1c, 0x13, 0x31, 0x75, 0xca, 0xfb, 0xbf, 0x7c, 0x4f, 0xcf, 0xfa, 0xf1, 0x24, 0xd7, 0x50, 0x6a,
-	0x48, 0x32, 0x01, 0x32, 0x81, 0xb5, 0xa8, 0x40, 0x69, 0x03, 0xc9, 0xdb, 0x2c, 0x93, 0x46, 0xcc,
-	0x7a, 0xc2, 0xab, 0x8d, 0x36, 0x9a, 0x5c, 0x74, 0x36, 0x6f, 0x6d, 0xde, 0xdb, 0xdc, 0xd9, 0xe7,
-	0xa7, 0x85, 0x2e, 0xb4, 0x35, 0x93, 0x76, 0xea, 0x96, 0xc6, 0x9f, 0x08, 0x07, 0x4f, 0xce, 0x25,
-	0x67, 0x78, 0xa8, 0xe4, 0xb2, 0x50, 0x86, 0xa2, 0x08, 0xc5, 0x7e, 0xea, 0x52, 0xcb, 0x5f, 0xf5,
-	0xa6, 0x14, 0x86, 0x1e, 0x45, 0x28, 0x3e, 0x49, 0x5d, 0x6a, 0x79, 0xae, 0xb6, 0xeb, 0x15, 0xd0,
-	0x41, 0xc7, 0xbb, 0x44, 0x08, 0xf6, 0x95, 0x00, 0x45, 0xfd, 0x08, 0xc5, 0x61, 0x6a, 0x67, 0xf2,
-	0x80, 0x83, 0x52, 0x1a, 0xb1, 0x10, 0x46, 0xd0, 0xe3, 0x08, 0xc5, 0xa3, 0x9b, 0x6b, 0x7e, 0xf0,
-	0xc1, 0xfc, 0xd1, 0xe9, 0x73, 0x7f, 0xf7, 0x7d, 0xe9, 0xa5, 0xfd, 0xfa, 0x78, 0x8a, 0x83, 0xbf,
-	0x7f, 0xe4, 0x0a, 0x87, 0xf6, 0xe8, 0x4b, 0x7b, 0x44, 0x02, 0x45, 0xd1, 0x20, 0x0e, 0xd3, 0x91,
-	0x65, 0xf7, 0x16, 0xcd, 0xef, 0x76, 0x35, 0x43, 0xfb, 0x9a, 0xa1, 0x9f, 0x9a, 0xa1, 0x8f, 0x86,
-	0x79, 0xfb, 0x86, 0x79, 0x5f, 0x0d, 0xf3, 0x9e, 0x27, 0xc5, 0xd2, 0xa8, 0x6d, 0xc6, 0x73, 0x5d,
-	0x26, 0xae, 0xea, 0xee, 0x33, 0x85, 0xc5, 0xea, 0x5f, 0xe1, 0xe6, 0xbd, 0x92, 0x90, 0x0d, 0x6d,
-	0x63, 0xb7, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb8, 0x89, 0x2e, 0x8f, 0x96, 0x01, 0x00, 0x00,
+	// 363 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xc1, 0x4a, 0xeb, 0x40,
+	0x14, 0x86, 0x33, 0x6d, 0x6e, 0x6f, 0xef, 0x34, 0x17, 0xee, 0x1d, 0x44, 0x06, 0xc1, 0x18, 0xb3,
+	0x31, 0x8b, 0x3a, 0xb1, 0xf5, 0x0d, 0x0a, 0x8a, 0x2e, 0x04, 0x19, 0x41, 0xc1, 0x8d, 0x4c, 0xda,
+	0x31, 0x53, 0x6a, 0x32, 0xa1, 0x33, 0x15, 0xfb, 0x00, 0xee, 0x7d, 0x15, 0xdf, 0xa2, 0xcb, 0x2e,
+	0x5d, 0x89, 0xb4, 0x2f, 0x22, 0x99, 0xa4, 0xa1, 0x28, 0x28, 0xae, 0xf2, 0xff, 0x7f, 0xbe, 0x73,
+	0xce, 0xcc, 0x70, 0x60, 0xbb, 0x2f, 0x55, 0x22, 0x55, 0x18, 0x31, 0xc5, 0x43, 0x95, 0xb2, 0x4c,
+	0x09, 0xa9, 0x55, 0x78, 0xdf, 0x89, 0xb8, 0x66, 0x9d, 0x2a, 0x21, 0xd9, 0x58, 0x6a, 0x89, 0xb6,
+	0x0b, 0x9a, 0xe4, 0x34, 0xa9, 0x68, 0x52, 0xd2, 0x5b, 0x1b, 0xb1, 0x8c, 0xa5, 0x21, 0xc3, 0x5c,
+	0x15, 0x45, 0xfe, 0x33, 0x80, 0xcd, 0x8b, 0x92, 0x45, 0x9b, 0xb0, 0x21, 0xf8, 0x30, 0x16, 0x1a,
+	0x03, 0x0f, 0x04, 0x36, 0x2d, 0x5d, 0x9e, 0xdf, 0xca, 0x71, 0xc2, 0x34, 0xae, 0x79, 0x20, 0xf8,
+	0x4b, 0x4b, 0x97, 0xe7, 0x7d, 0x31, 0x49, 0x47, 0x0a, 0xd7, 0x8b, 0xbc, 0x70, 0x08, 0x41, 0x5b,
+	0x30, 0x25, 0xb0, 0xed, 0x81, 0xc0, 0xa1, 0x46, 0xa3, 0x53, 0xd8, 0x4c, 0xb8, 0x66, 0x03, 0xa6,
+	0x19, 0xfe, 0xe5, 0x81, 0xa0, 0xd5, 0xdd, 0x23, 0x5f, 0x1e, 0x98, 0x9c, 0x95, 0x78, 0xcf, 0x9e,
+	0xbd, 0xee, 0x58, 0xb4, 0x2a, 0xf7, 0x1f, 0x01, 0x6c, 0xae, 0x7e, 0xa2, 0x5d, 0xe8, 0x98, 0xa9,
+	0x37, 0xf9, 0x14, 0xae, 0x30, 0xf0, 0xea, 0x81, 0x43, 0x5b, 0x26, 0x3b, 0x31, 0x11, 0xba, 0x84,
+	0x90, 0x3f, 0x68, 0x9e, 0xaa, 0xa1, 0x4c, 0x15, 0xae, 0x79, 0xf5, 0xa0, 0xd5, 0x3d, 0xf8, 0x66,
+	0xf8, 0xd1, 0xaa, 0xe0, 0xc3, 0x29, 0xd6, 0x3a, 0xf9, 0x57, 0xf0, 0xff, 0x27, 0x2c, 0xbf, 0x7b,
+	0xca, 0x12, 0x6e, 0x5e, 0xf0, 0x0f, 0x35, 0xfa, 0xa7, 0xef, 0xe7, 0xb7, 0xe1, 0xbf, 0xaa, 0xf1,
+	0x39, 0x9b, 0xde, 0x49, 0x36, 0x40, 0x18, 0xfe, 0xce, 0x0a, 0x69, 0x5a, 0x3b, 0x74, 0x65, 0x7b,
+	0xc7, 0xb3, 0x85, 0x0b, 0xe6, 0x0b, 0x17, 0xbc, 0x2d, 0x5c, 0xf0, 0xb4, 0x74, 0xad, 0xf9, 0xd2,
+	0xb5, 0x5e, 0x96, 0xae, 0x75, 0xdd, 0x8e, 0x87, 0x5a, 0x4c, 0x22, 0xd2, 0x97, 0x49, 0x58, 0xae,
+	0x52, 0xf1, 0xd9, 0x57, 0x83, 0xd1, 0xda, 0x42, 0xe9, 0x69, 0xc6, 0x55, 0xd4, 0x30, 0x1b, 0x71,
+	0xf8, 0x3e, 0x00, 0x04, 0xf9, 0xfc, 0xb9, 0x76, 0x02, 0x00, 0x00,
 }
 
 func (m *Snapshot) Marshal() (dAtA []byte, err error) {
@@ -251,6 +375,20 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Extensions) > 0 {
+		for iNdEx := len(m.Extensions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Extensions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSnapshot(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.ChunkHashes) > 0 {
 		for iNdEx := len(m.ChunkHashes) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ChunkHashes[iNdEx])
@@ -263,6 +401,76 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ExtensionMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtensionMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Chunks != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Chunks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Format != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Format))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtensionPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtensionPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSnapshot(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSnapshot(v)
 	base := offset
@@ -310,6 +518,44 @@ func (m *Metadata) Size() (n int) {
 			n += 1 + l + sovSnapshot(uint64(l))
 		}
 	}
+	if len(m.Extensions) > 0 {
+		for _, e := range m.Extensions {
+			l = e.Size()
+			n += 1 + l + sovSnapshot(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExtensionMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	if m.Format != 0 {
+		n += 1 + sovSnapshot(uint64(m.Format))
+	}
+	if m.Chunks != 0 {
+		n += 1 + sovSnapshot(uint64(m.Chunks))
+	}
+	return n
+}
+
+func (m *ExtensionPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
 	return n
 }
 
@@ -554,6 +800,244 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			m.ChunkHashes = append(m.ChunkHashes, make([]byte, postIndex-iNdEx))
 			copy(m.ChunkHashes[len(m.ChunkHashes)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extensions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extensions = append(m.Extensions, ExtensionMetadata{})
+			if err := m.Extensions[len(m.Extensions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtensionMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtensionMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtensionMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			m.Format = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Format |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
+			}
+			m.Chunks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Chunks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtensionPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtensionPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtensionPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSnapshot(dAtA[iNdEx:])
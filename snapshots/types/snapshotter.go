@@ -14,3 +14,32 @@ type Snapshotter interface {
 	// restorer is ready to accept chunks.
 	Restore(height uint64, format uint32, chunks <-chan io.ReadCloser, ready chan<- struct{}) error
 }
+
+// ExtensionPayloadReader reads the next payload of an extension snapshot. It returns io.EOF
+// once all the payloads have been read.
+type ExtensionPayloadReader = func() ([]byte, error)
+
+// ExtensionPayloadWriter writes a payload of an extension snapshot.
+type ExtensionPayloadWriter = func([]byte) error
+
+// ExtensionSnapshotter snapshots and restores state kept outside of the multistore, e.g. by a
+// module storing files on disk. Its payloads are appended to the snapshots taken by the
+// snapshot manager it is registered with, after the multistore chunks.
+type ExtensionSnapshotter interface {
+	// SnapshotName returns the name of the snapshotter, which must be unique among the
+	// extensions of a snapshot manager.
+	SnapshotName() string
+
+	// SnapshotFormat returns the format the snapshotter writes its payloads in.
+	SnapshotFormat() uint32
+
+	// SupportedFormats returns the payload formats the snapshotter can restore.
+	SupportedFormats() []uint32
+
+	// SnapshotExtension writes the payloads of the state at the given height.
+	SnapshotExtension(height uint64, payloadWriter ExtensionPayloadWriter) error
+
+	// RestoreExtension restores the state at the given height from payloads of the given
+	// format, once the multistore has been restored.
+	RestoreExtension(height uint64, format uint32, payloadReader ExtensionPayloadReader) error
+}
@@ -0,0 +1,307 @@
+package archive
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+// The archive database holds two kinds of entries:
+//
+//   - version markers, keyed by versionPrefix | version, for every version whose
+//     changes have been fully archived.
+//   - changes, keyed by entryPrefix | uvarint(len(store name)) | store name | escaped key | version,
+//     holding either a tombstone or the value set at that version.
+//
+// The keys of a store are escaped such that their lexicographic order is
+// preserved, so that the changes of a store are sorted by key and then by
+// version.
+var (
+	versionPrefix = []byte{0x00}
+	entryPrefix   = []byte{0x01}
+)
+
+const (
+	valueDeleted byte = 0x00
+	valueSet     byte = 0x01
+)
+
+var _ types.WriteListener = (*Store)(nil)
+
+// Store is an append-only, versioned key-value database holding the changesets
+// committed to the IAVL stores of a multi-store. It is fed through the
+// WriteListener interface, and serves the state of any archived version even
+// after the IAVL stores have pruned it.
+type Store struct {
+	db dbm.DB
+
+	mtx     sync.Mutex
+	pending map[string]map[string]*types.StoreKVPair
+}
+
+// NewStore returns a reference to a new archive Store persisted in db.
+func NewStore(db dbm.DB) *Store {
+	return &Store{
+		db:      db,
+		pending: make(map[string]map[string]*types.StoreKVPair),
+	}
+}
+
+// OnWrite implements the WriteListener interface. The change is kept in memory
+// until the version it belongs to is committed.
+func (s *Store) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	changes, ok := s.pending[storeKey.Name()]
+	if !ok {
+		changes = make(map[string]*types.StoreKVPair)
+		s.pending[storeKey.Name()] = changes
+	}
+
+	changes[string(key)] = &types.StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Delete:   delete,
+		Key:      append([]byte{}, key...),
+		Value:    append([]byte{}, value...),
+	}
+
+	return nil
+}
+
+// Flush writes the pending changes to the database as changes made at the given
+// version, without marking the version as archived.
+func (s *Store) Flush(version int64) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	if err := s.writePending(batch, version); err != nil {
+		return err
+	}
+
+	return batch.Write()
+}
+
+// Commit writes the pending changes to the database as changes made at the
+// given version, and marks the version as archived.
+func (s *Store) Commit(version int64) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if version <= 0 {
+		return fmt.Errorf("invalid version %d", version)
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	if err := s.writePending(batch, version); err != nil {
+		return err
+	}
+
+	if err := batch.Set(versionKey(version), []byte{valueSet}); err != nil {
+		return err
+	}
+
+	return batch.WriteSync()
+}
+
+func (s *Store) writePending(batch dbm.Batch, version int64) error {
+	for storeName, changes := range s.pending {
+		for _, pair := range changes {
+			value := []byte{valueDeleted}
+			if !pair.Delete {
+				value = append([]byte{valueSet}, pair.Value...)
+			}
+
+			if err := batch.Set(entryKey(storeName, pair.Key, version), value); err != nil {
+				return err
+			}
+		}
+	}
+
+	s.pending = make(map[string]map[string]*types.StoreKVPair)
+
+	return nil
+}
+
+// HasVersion returns true if the given version has been archived.
+func (s *Store) HasVersion(version int64) (bool, error) {
+	if version <= 0 {
+		return false, nil
+	}
+
+	return s.db.Has(versionKey(version))
+}
+
+// LatestVersion returns the latest archived version, or 0 if the archive is
+// empty.
+func (s *Store) LatestVersion() (int64, error) {
+	itr, err := s.db.ReverseIterator(versionPrefix, types.PrefixEndBytes(versionPrefix))
+	if err != nil {
+		return 0, err
+	}
+	defer itr.Close()
+
+	if !itr.Valid() {
+		return 0, itr.Error()
+	}
+
+	return int64(binary.BigEndian.Uint64(itr.Key()[len(versionPrefix):])), nil
+}
+
+// GetVersioned returns a read-only view of the named store at the given
+// version. The caller is responsible for checking that the version has been
+// archived, see HasVersion.
+func (s *Store) GetVersioned(storeName string, version int64) *VersionedStore {
+	return &VersionedStore{
+		db:      s.db,
+		prefix:  storePrefix(storeName),
+		version: uint64(version),
+	}
+}
+
+// Query serves a "/key" or "/subspace" query, as handled by the IAVL stores,
+// against the named store at an archived version. Proofs cannot be provided.
+func (s *Store) Query(storeName string, req abci.RequestQuery) (res abci.ResponseQuery) {
+	if len(req.Data) == 0 {
+		return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrTxDecode, "query cannot be zero length"))
+	}
+
+	if req.Prove {
+		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "proofs are not available for archived height %d", req.Height))
+	}
+
+	ok, err := s.HasVersion(req.Height)
+	if err != nil {
+		return sdkerrors.QueryResult(err)
+	}
+	if !ok {
+		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "height %d is not archived", req.Height))
+	}
+
+	store := s.GetVersioned(storeName, req.Height)
+	res.Height = req.Height
+
+	switch req.Path {
+	case "/key":
+		res.Key = req.Data
+		res.Value = store.Get(req.Data)
+
+	case "/subspace":
+		pairs := kv.Pairs{
+			Pairs: make([]kv.Pair, 0),
+		}
+
+		res.Key = req.Data
+
+		iterator := types.KVStorePrefixIterator(store, req.Data)
+		for ; iterator.Valid(); iterator.Next() {
+			pairs.Pairs = append(pairs.Pairs, kv.Pair{Key: iterator.Key(), Value: iterator.Value()})
+		}
+		iterator.Close()
+
+		bz, err := pairs.Marshal()
+		if err != nil {
+			panic(fmt.Errorf("failed to marshal KV pairs: %w", err))
+		}
+
+		res.Value = bz
+
+	default:
+		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unexpected query path: %v", req.Path))
+	}
+
+	return res
+}
+
+func versionKey(version int64) []byte {
+	key := make([]byte, len(versionPrefix)+8)
+	copy(key, versionPrefix)
+	binary.BigEndian.PutUint64(key[len(versionPrefix):], uint64(version))
+
+	return key
+}
+
+func storePrefix(storeName string) []byte {
+	var bz [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(bz[:], uint64(len(storeName)))
+
+	prefix := append([]byte{}, entryPrefix...)
+	prefix = append(prefix, bz[:n]...)
+
+	return append(prefix, storeName...)
+}
+
+func entryKey(storeName string, key []byte, version int64) []byte {
+	return entryKeyWithPrefix(storePrefix(storeName), key, uint64(version))
+}
+
+func entryKeyWithPrefix(prefix, key []byte, version uint64) []byte {
+	entry := escapeKey(append([]byte{}, prefix...), key)
+	entry = append(entry, 0x00, 0x00)
+
+	var bz [8]byte
+	binary.BigEndian.PutUint64(bz[:], version)
+
+	return append(entry, bz[:]...)
+}
+
+// escapeKey appends key to dst with every 0x00 byte replaced by 0x00 0xFF, such
+// that 0x00 0x00 can terminate the key while keeping the lexicographic order.
+func escapeKey(dst, key []byte) []byte {
+	for _, b := range key {
+		if b == 0x00 {
+			dst = append(dst, 0x00, 0xFF)
+		} else {
+			dst = append(dst, b)
+		}
+	}
+
+	return dst
+}
+
+// splitEntryKey splits an entry key, stripped of its store prefix, into the
+// store key and the version.
+func splitEntryKey(entry []byte) ([]byte, uint64, error) {
+	key := make([]byte, 0, len(entry))
+
+	for i := 0; i < len(entry); i++ {
+		if entry[i] != 0x00 {
+			key = append(key, entry[i])
+			continue
+		}
+
+		if i+1 >= len(entry) {
+			break
+		}
+
+		switch entry[i+1] {
+		case 0xFF:
+			key = append(key, 0x00)
+			i++
+
+		case 0x00:
+			if len(entry) != i+2+8 {
+				return nil, 0, errors.New("invalid archive entry version")
+			}
+			return key, binary.BigEndian.Uint64(entry[i+2:]), nil
+
+		default:
+			return nil, 0, errors.New("invalid archive entry key escaping")
+		}
+	}
+
+	return nil, 0, errors.New("invalid archive entry key")
+}
@@ -0,0 +1,219 @@
+package archive
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+var (
+	testStoreKey1 = types.NewKVStoreKey("store1")
+	testStoreKey2 = types.NewKVStoreKey("store12")
+)
+
+func commitChanges(t *testing.T, s *Store, version int64, changes ...*types.StoreKVPair) {
+	for _, change := range changes {
+		key := testStoreKey1
+		if change.StoreKey == testStoreKey2.Name() {
+			key = testStoreKey2
+		}
+		require.NoError(t, s.OnWrite(key, change.Key, change.Value, change.Delete))
+	}
+
+	require.NoError(t, s.Commit(version))
+}
+
+func set(storeKey types.StoreKey, key, value string) *types.StoreKVPair {
+	return &types.StoreKVPair{StoreKey: storeKey.Name(), Key: []byte(key), Value: []byte(value)}
+}
+
+func del(storeKey types.StoreKey, key string) *types.StoreKVPair {
+	return &types.StoreKVPair{StoreKey: storeKey.Name(), Key: []byte(key), Delete: true}
+}
+
+func collect(itr types.Iterator) []kv.Pair {
+	defer itr.Close()
+
+	var pairs []kv.Pair
+	for ; itr.Valid(); itr.Next() {
+		pairs = append(pairs, kv.Pair{Key: itr.Key(), Value: itr.Value()})
+	}
+
+	return pairs
+}
+
+func TestStoreVersions(t *testing.T) {
+	s := NewStore(dbm.NewMemDB())
+
+	latest, err := s.LatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, int64(0), latest)
+
+	commitChanges(t, s, 1, set(testStoreKey1, "a", "1"))
+	commitChanges(t, s, 3)
+	require.Error(t, s.Commit(0))
+
+	latest, err = s.LatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), latest)
+
+	for version, expected := range map[int64]bool{0: false, 1: true, 2: false, 3: true, 4: false} {
+		ok, err := s.HasVersion(version)
+		require.NoError(t, err)
+		require.Equal(t, expected, ok, "version %d", version)
+	}
+
+	// flushed changes are not visible as a version until committed
+	require.NoError(t, s.OnWrite(testStoreKey1, []byte("a"), []byte("2"), false))
+	require.NoError(t, s.Flush(4))
+
+	ok, err := s.HasVersion(4)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	require.NoError(t, s.Commit(4))
+	require.Equal(t, []byte("2"), s.GetVersioned(testStoreKey1.Name(), 4).Get([]byte("a")))
+}
+
+func TestVersionedStore(t *testing.T) {
+	s := NewStore(dbm.NewMemDB())
+
+	commitChanges(t, s, 1,
+		set(testStoreKey1, "a", "a1"),
+		set(testStoreKey1, "b", "b1"),
+		set(testStoreKey1, "a\x00b", "ab1"),
+		set(testStoreKey2, "a", "other"),
+	)
+	commitChanges(t, s, 2,
+		set(testStoreKey1, "a", "a2"),
+		del(testStoreKey1, "b"),
+		set(testStoreKey1, "c", "c2"),
+	)
+	commitChanges(t, s, 3,
+		set(testStoreKey1, "b", "b3"),
+		del(testStoreKey1, "a\x00b"),
+	)
+
+	testCases := []struct {
+		version  int64
+		expected []kv.Pair
+	}{
+		{0, nil},
+		{1, []kv.Pair{
+			{Key: []byte("a"), Value: []byte("a1")},
+			{Key: []byte("a\x00b"), Value: []byte("ab1")},
+			{Key: []byte("b"), Value: []byte("b1")},
+		}},
+		{2, []kv.Pair{
+			{Key: []byte("a"), Value: []byte("a2")},
+			{Key: []byte("a\x00b"), Value: []byte("ab1")},
+			{Key: []byte("c"), Value: []byte("c2")},
+		}},
+		{3, []kv.Pair{
+			{Key: []byte("a"), Value: []byte("a2")},
+			{Key: []byte("b"), Value: []byte("b3")},
+			{Key: []byte("c"), Value: []byte("c2")},
+		}},
+		{10, []kv.Pair{
+			{Key: []byte("a"), Value: []byte("a2")},
+			{Key: []byte("b"), Value: []byte("b3")},
+			{Key: []byte("c"), Value: []byte("c2")},
+		}},
+	}
+
+	for _, tc := range testCases {
+		store := s.GetVersioned(testStoreKey1.Name(), tc.version)
+
+		require.Equal(t, tc.expected, collect(store.Iterator(nil, nil)), "version %d", tc.version)
+
+		var reversed []kv.Pair
+		for i := len(tc.expected) - 1; i >= 0; i-- {
+			reversed = append(reversed, tc.expected[i])
+		}
+		require.Equal(t, reversed, collect(store.ReverseIterator(nil, nil)), "version %d", tc.version)
+
+		for _, pair := range tc.expected {
+			require.Equal(t, pair.Value, store.Get(pair.Key), "version %d", tc.version)
+			require.True(t, store.Has(pair.Key))
+		}
+	}
+
+	store := s.GetVersioned(testStoreKey1.Name(), 2)
+	require.Nil(t, store.Get([]byte("b")))
+	require.False(t, store.Has([]byte("b")))
+	require.Nil(t, store.Get([]byte("a\x00")))
+
+	// bounded iteration, with the end excluded
+	require.Equal(t, []kv.Pair{
+		{Key: []byte("a\x00b"), Value: []byte("ab1")},
+	}, collect(store.Iterator([]byte("a\x00"), []byte("c"))))
+	require.Equal(t, []kv.Pair{
+		{Key: []byte("c"), Value: []byte("c2")},
+		{Key: []byte("a\x00b"), Value: []byte("ab1")},
+	}, collect(store.ReverseIterator([]byte("a\x00"), nil)))
+
+	// the stores are isolated even when one's name prefixes the other's
+	require.Equal(t, []kv.Pair{
+		{Key: []byte("a"), Value: []byte("other")},
+	}, collect(s.GetVersioned(testStoreKey2.Name(), 3).Iterator(nil, nil)))
+
+	require.Panics(t, func() { store.Set([]byte("a"), []byte("x")) })
+	require.Panics(t, func() { store.Delete([]byte("a")) })
+
+	// writes to a cache wrapped store are not persisted
+	cache := store.CacheWrap().(types.KVStore)
+	cache.Set([]byte("a"), []byte("x"))
+	require.Equal(t, []byte("x"), cache.Get([]byte("a")))
+	require.Equal(t, []byte("a2"), store.Get([]byte("a")))
+}
+
+func TestStoreQuery(t *testing.T) {
+	s := NewStore(dbm.NewMemDB())
+
+	commitChanges(t, s, 1,
+		set(testStoreKey1, "p/a", "1"),
+		set(testStoreKey1, "p/b", "2"),
+		set(testStoreKey1, "q", "3"),
+	)
+	commitChanges(t, s, 2, del(testStoreKey1, "p/a"))
+
+	res := s.Query(testStoreKey1.Name(), abci.RequestQuery{Path: "/key", Data: []byte("p/a"), Height: 1})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, []byte("1"), res.Value)
+	require.Equal(t, int64(1), res.Height)
+
+	res = s.Query(testStoreKey1.Name(), abci.RequestQuery{Path: "/key", Data: []byte("p/a"), Height: 2})
+	require.True(t, res.IsOK(), res.Log)
+	require.Nil(t, res.Value)
+
+	res = s.Query(testStoreKey1.Name(), abci.RequestQuery{Path: "/subspace", Data: []byte("p/"), Height: 1})
+	require.True(t, res.IsOK(), res.Log)
+
+	var pairs kv.Pairs
+	require.NoError(t, pairs.Unmarshal(res.Value))
+	require.Equal(t, []kv.Pair{
+		{Key: []byte("p/a"), Value: []byte("1")},
+		{Key: []byte("p/b"), Value: []byte("2")},
+	}, pairs.Pairs)
+
+	testCases := []struct {
+		req  abci.RequestQuery
+		code uint32
+	}{
+		{abci.RequestQuery{Path: "/key", Height: 1}, sdkerrors.ErrTxDecode.ABCICode()},
+		{abci.RequestQuery{Path: "/key", Data: []byte("q"), Height: 1, Prove: true}, sdkerrors.ErrInvalidRequest.ABCICode()},
+		{abci.RequestQuery{Path: "/key", Data: []byte("q"), Height: 3}, sdkerrors.ErrInvalidRequest.ABCICode()},
+		{abci.RequestQuery{Path: "/unknown", Data: []byte("q"), Height: 1}, sdkerrors.ErrUnknownRequest.ABCICode()},
+	}
+
+	for _, tc := range testCases {
+		res := s.Query(testStoreKey1.Name(), tc.req)
+		require.Equal(t, tc.code, res.Code, "%v", tc.req)
+	}
+}
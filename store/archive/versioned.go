@@ -0,0 +1,243 @@
+package archive
+
+import (
+	"bytes"
+	"io"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var _ types.KVStore = (*VersionedStore)(nil)
+
+// VersionedStore is a read-only view of an archived store at a given version.
+// The value of a key is the one of its latest change at or before the version.
+type VersionedStore struct {
+	db      dbm.DB
+	prefix  []byte
+	version uint64
+}
+
+// GetStoreType implements Store. An archived store stands in for an IAVL store.
+func (vs *VersionedStore) GetStoreType() types.StoreType {
+	return types.StoreTypeIAVL
+}
+
+// Get implements KVStore.
+func (vs *VersionedStore) Get(key []byte) []byte {
+	types.AssertValidKey(key)
+
+	// the changes of the key at versions [0, version]
+	start := entryKeyWithPrefix(vs.prefix, key, 0)
+	end := entryKeyWithPrefix(vs.prefix, key, vs.version+1)
+
+	itr, err := vs.db.ReverseIterator(start, end)
+	if err != nil {
+		panic(err)
+	}
+	defer itr.Close()
+
+	if !itr.Valid() {
+		return nil
+	}
+
+	return decodeValue(itr.Value())
+}
+
+// Has implements KVStore.
+func (vs *VersionedStore) Has(key []byte) bool {
+	return vs.Get(key) != nil
+}
+
+// Set implements KVStore. It panics as an archived store is read-only.
+func (vs *VersionedStore) Set(_, _ []byte) {
+	panic("cannot call 'Set' on an archived store")
+}
+
+// Delete implements KVStore. It panics as an archived store is read-only.
+func (vs *VersionedStore) Delete(_ []byte) {
+	panic("cannot call 'Delete' on an archived store")
+}
+
+// Iterator implements KVStore.
+func (vs *VersionedStore) Iterator(start, end []byte) types.Iterator {
+	return vs.iterator(start, end, true)
+}
+
+// ReverseIterator implements KVStore.
+func (vs *VersionedStore) ReverseIterator(start, end []byte) types.Iterator {
+	return vs.iterator(start, end, false)
+}
+
+// CacheWrap implements CacheWrapper.
+func (vs *VersionedStore) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(vs)
+}
+
+// CacheWrapWithTrace implements CacheWrapper.
+func (vs *VersionedStore) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(vs, w, tc))
+}
+
+func (vs *VersionedStore) iterator(start, end []byte, ascending bool) types.Iterator {
+	// As the keys are escaped preserving their order, the changes of the keys
+	// in [start, end) lie between the escaped start and end.
+	dbStart := escapeKey(append([]byte{}, vs.prefix...), start)
+
+	var dbEnd []byte
+	if end != nil {
+		dbEnd = escapeKey(append([]byte{}, vs.prefix...), end)
+	} else {
+		dbEnd = types.PrefixEndBytes(vs.prefix)
+	}
+
+	var (
+		source dbm.Iterator
+		err    error
+	)
+
+	if ascending {
+		source, err = vs.db.Iterator(dbStart, dbEnd)
+	} else {
+		source, err = vs.db.ReverseIterator(dbStart, dbEnd)
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	itr := &iterator{
+		source:    source,
+		prefixLen: len(vs.prefix),
+		version:   vs.version,
+		start:     start,
+		end:       end,
+	}
+	itr.next()
+
+	return itr
+}
+
+// iterator iterates over the keys of an archived store at a given version. The
+// changes of a key are consecutive in the underlying iterator, so every step
+// consumes all the changes of a key and retains the latest one at or before
+// the version, skipping the keys that were deleted or not set yet.
+type iterator struct {
+	source    dbm.Iterator
+	prefixLen int
+	version   uint64
+	start     []byte
+	end       []byte
+
+	key   []byte
+	value []byte
+	valid bool
+	err   error
+}
+
+var _ types.Iterator = (*iterator)(nil)
+
+func (itr *iterator) next() {
+	itr.valid = false
+
+	for itr.source.Valid() {
+		key, _, err := splitEntryKey(itr.source.Key()[itr.prefixLen:])
+		if err != nil {
+			itr.err = err
+			return
+		}
+
+		var (
+			latest uint64
+			value  []byte
+			found  bool
+		)
+
+		for itr.source.Valid() {
+			changeKey, version, err := splitEntryKey(itr.source.Key()[itr.prefixLen:])
+			if err != nil {
+				itr.err = err
+				return
+			}
+
+			if !bytes.Equal(changeKey, key) {
+				break
+			}
+
+			if version <= itr.version && (!found || version > latest) {
+				latest, value, found = version, itr.source.Value(), true
+			}
+
+			itr.source.Next()
+		}
+
+		if found {
+			if decoded := decodeValue(value); decoded != nil {
+				itr.key, itr.value, itr.valid = key, decoded, true
+				return
+			}
+		}
+	}
+}
+
+// Domain implements Iterator.
+func (itr *iterator) Domain() ([]byte, []byte) {
+	return itr.start, itr.end
+}
+
+// Valid implements Iterator.
+func (itr *iterator) Valid() bool {
+	return itr.valid
+}
+
+// Next implements Iterator.
+func (itr *iterator) Next() {
+	if !itr.valid {
+		panic("iterator is invalid")
+	}
+
+	itr.next()
+}
+
+// Key implements Iterator.
+func (itr *iterator) Key() []byte {
+	if !itr.valid {
+		panic("iterator is invalid")
+	}
+
+	return itr.key
+}
+
+// Value implements Iterator.
+func (itr *iterator) Value() []byte {
+	if !itr.valid {
+		panic("iterator is invalid")
+	}
+
+	return itr.value
+}
+
+// Error implements Iterator.
+func (itr *iterator) Error() error {
+	if itr.err != nil {
+		return itr.err
+	}
+
+	return itr.source.Error()
+}
+
+// Close implements Iterator.
+func (itr *iterator) Close() error {
+	return itr.source.Close()
+}
+
+// decodeValue returns the value of a change, or nil for a deletion.
+func decodeValue(bz []byte) []byte {
+	if len(bz) == 0 || bz[0] == valueDeleted {
+		return nil
+	}
+
+	return append([]byte{}, bz[1:]...)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store/cachekv"
 	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
 	"github.com/cosmos/cosmos-sdk/store/types"
 )
 
@@ -25,16 +26,20 @@ type Store struct {
 
 	traceWriter  io.Writer
 	traceContext types.TraceContext
+
+	listeners map[types.StoreKey][]types.WriteListener
 }
 
 var _ types.CacheMultiStore = Store{}
 
 // NewFromKVStore creates a new Store object from a mapping of store keys to
 // CacheWrapper objects and a KVStore as the database. Each CacheWrapper store
-// is a branched store.
+// is a branched store. Writes that land in the branch for a given store key are
+// passed on to the listeners registered for that key.
 func NewFromKVStore(
 	store types.KVStore, stores map[types.StoreKey]types.CacheWrapper,
 	keys map[string]types.StoreKey, traceWriter io.Writer, traceContext types.TraceContext,
+	listeners map[types.StoreKey][]types.WriteListener,
 ) Store {
 	cms := Store{
 		db:           cachekv.NewStore(store),
@@ -42,6 +47,11 @@ func NewFromKVStore(
 		keys:         keys,
 		traceWriter:  traceWriter,
 		traceContext: traceContext,
+		listeners:    make(map[types.StoreKey][]types.WriteListener, len(listeners)),
+	}
+
+	for key, ls := range listeners {
+		cms.listeners[key] = ls
 	}
 
 	for key, store := range stores {
@@ -59,19 +69,26 @@ func NewFromKVStore(
 // CacheWrapper objects. Each CacheWrapper store is a branched store.
 func NewStore(
 	db dbm.DB, stores map[types.StoreKey]types.CacheWrapper, keys map[string]types.StoreKey,
-	traceWriter io.Writer, traceContext types.TraceContext,
+	traceWriter io.Writer, traceContext types.TraceContext, listeners map[types.StoreKey][]types.WriteListener,
 ) Store {
 
-	return NewFromKVStore(dbadapter.Store{DB: db}, stores, keys, traceWriter, traceContext)
+	return NewFromKVStore(dbadapter.Store{DB: db}, stores, keys, traceWriter, traceContext, listeners)
 }
 
+// newCacheMultiStoreFromCMS branches a Store. The listeners of the parent are
+// not inherited by the branch; instead the parent stores are wrapped so that
+// the listeners observe the writes once the branch is written back.
 func newCacheMultiStoreFromCMS(cms Store) Store {
 	stores := make(map[types.StoreKey]types.CacheWrapper)
 	for k, v := range cms.stores {
-		stores[k] = v
+		if cms.ListeningEnabled(k) {
+			stores[k] = listenkv.NewStore(v.(types.KVStore), k, cms.listeners[k])
+		} else {
+			stores[k] = v
+		}
 	}
 
-	return NewFromKVStore(cms.db, stores, nil, cms.traceWriter, cms.traceContext)
+	return NewFromKVStore(cms.db, stores, nil, cms.traceWriter, cms.traceContext, nil)
 }
 
 // SetTracer sets the tracer for the MultiStore that the underlying
@@ -102,6 +119,23 @@ func (cms Store) TracingEnabled() bool {
 	return cms.traceWriter != nil
 }
 
+// ListeningEnabled returns if listening is enabled for a specific KVStore.
+func (cms Store) ListeningEnabled(key types.StoreKey) bool {
+	if ls, ok := cms.listeners[key]; ok {
+		return len(ls) != 0
+	}
+	return false
+}
+
+// AddListeners adds listeners for a specific KVStore.
+func (cms Store) AddListeners(key types.StoreKey, listeners []types.WriteListener) {
+	if ls, ok := cms.listeners[key]; ok {
+		cms.listeners[key] = append(ls, listeners...)
+	} else {
+		cms.listeners[key] = listeners
+	}
+}
+
 // GetStoreType returns the type of the store.
 func (cms Store) GetStoreType() types.StoreType {
 	return types.StoreTypeMulti
@@ -144,11 +178,15 @@ func (cms Store) GetStore(key types.StoreKey) types.Store {
 	return cms.stores[key].(types.Store)
 }
 
-// GetKVStore returns an underlying KVStore by key.
+// GetKVStore returns an underlying KVStore by key. If listening is enabled for
+// the key, the KVStore is wrapped so that its writes reach the listeners.
 func (cms Store) GetKVStore(key types.StoreKey) types.KVStore {
 	store := cms.stores[key]
 	if key == nil {
 		panic(fmt.Sprintf("kv store with key %v has not been registered in stores", key))
 	}
+	if cms.ListeningEnabled(key) {
+		return listenkv.NewStore(store.(types.KVStore), key, cms.listeners[key])
+	}
 	return store.(types.KVStore)
 }
@@ -0,0 +1,252 @@
+package iavl
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cosmos/iavl"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+const (
+	// fastNodeBatchSize is the number of index entries written per batch while
+	// rebuilding the index.
+	fastNodeBatchSize = 10000
+)
+
+var (
+	// fastNodePrefix prefixes the index entries, which are stored next to the
+	// nodes of the tree.
+	fastNodePrefix = []byte("f/")
+
+	// fastNodeVersionKey is the key of the tree version the index is at.
+	fastNodeVersionKey = []byte("fv")
+)
+
+// fastNodeIndex is an index of the key-value pairs of the latest version of an
+// IAVL tree, stored in the database of the tree. It serves reads of the latest
+// state without walking the tree. Changes not committed yet are kept in memory
+// and written to the index along with the version they are committed in.
+type fastNodeIndex struct {
+	db      dbm.DB // the database of the tree
+	entries dbm.DB // the index entries, a prefixed view of db
+
+	// pending holds the changes since the last commit, a nil value marking a
+	// deleted key.
+	pending map[string][]byte
+}
+
+// loadFastNodeIndex loads the index of the given tree, rebuilding it if it is
+// missing or not at the version of the tree.
+func loadFastNodeIndex(db dbm.DB, tree *iavl.MutableTree) (*fastNodeIndex, error) {
+	index := &fastNodeIndex{
+		db:      db,
+		entries: dbm.NewPrefixDB(db, fastNodePrefix),
+		pending: make(map[string][]byte),
+	}
+
+	version, err := index.version()
+	if err != nil {
+		return nil, err
+	}
+
+	if version != tree.Version() {
+		if err := index.rebuild(tree.ImmutableTree); err != nil {
+			return nil, fmt.Errorf("failed to rebuild the fast node index: %w", err)
+		}
+	}
+
+	return index, nil
+}
+
+// version returns the tree version the index is at, or -1 if there is no index.
+func (idx *fastNodeIndex) version() (int64, error) {
+	bz, err := idx.db.Get(fastNodeVersionKey)
+	if err != nil {
+		return 0, err
+	}
+	if bz == nil {
+		return -1, nil
+	}
+	if len(bz) != 8 {
+		return 0, fmt.Errorf("invalid fast node index version %X", bz)
+	}
+
+	return int64(binary.BigEndian.Uint64(bz)), nil
+}
+
+// rebuild replaces the index entries with the key-value pairs of the tree.
+func (idx *fastNodeIndex) rebuild(tree *iavl.ImmutableTree) error {
+	// remove the version first, so that an interrupted rebuild is started over
+	if err := idx.db.DeleteSync(fastNodeVersionKey); err != nil {
+		return err
+	}
+
+	if err := idx.clear(); err != nil {
+		return err
+	}
+
+	batch := idx.db.NewBatch()
+	defer func() { batch.Close() }()
+
+	entries := 0
+	var err error
+	tree.Iterate(func(key, value []byte) bool {
+		if err = batch.Set(fastNodeKey(key), value); err != nil {
+			return true
+		}
+
+		entries++
+		if entries%fastNodeBatchSize == 0 {
+			if err = batch.Write(); err != nil {
+				return true
+			}
+			batch.Close()
+			batch = idx.db.NewBatch()
+		}
+
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := batch.Set(fastNodeVersionKey, encodeFastNodeVersion(tree.Version())); err != nil {
+		return err
+	}
+
+	return batch.WriteSync()
+}
+
+// clear removes all the index entries.
+func (idx *fastNodeIndex) clear() error {
+	for {
+		keys, err := idx.entryKeys(fastNodeBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(keys) == 0 {
+			return nil
+		}
+
+		batch := idx.entries.NewBatch()
+		for _, key := range keys {
+			if err := batch.Delete(key); err != nil {
+				batch.Close()
+				return err
+			}
+		}
+
+		err = batch.Write()
+		batch.Close()
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// entryKeys returns the keys of up to limit index entries.
+func (idx *fastNodeIndex) entryKeys(limit int) ([][]byte, error) {
+	iter, err := idx.entries.Iterator(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid() && len(keys) < limit; iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+
+	return keys, iter.Error()
+}
+
+// get returns the latest value of a key, including the changes not committed.
+func (idx *fastNodeIndex) get(key []byte) []byte {
+	if value, ok := idx.pending[string(key)]; ok {
+		return value
+	}
+
+	value, err := idx.entries.Get(key)
+	if err != nil {
+		panic(err)
+	}
+
+	return value
+}
+
+// set records a key set in the tree.
+func (idx *fastNodeIndex) set(key, value []byte) {
+	idx.pending[string(key)] = value
+}
+
+// remove records a key removed from the tree.
+func (idx *fastNodeIndex) remove(key []byte) {
+	idx.pending[string(key)] = nil
+}
+
+// iterator returns an iterator over the index entries. It returns false if
+// there are changes not committed yet, which the index cannot iterate over.
+func (idx *fastNodeIndex) iterator(start, end []byte, ascending bool) (types.Iterator, bool) {
+	if len(idx.pending) > 0 {
+		return nil, false
+	}
+
+	var (
+		iter types.Iterator
+		err  error
+	)
+	if ascending {
+		iter, err = idx.entries.Iterator(start, end)
+	} else {
+		iter, err = idx.entries.ReverseIterator(start, end)
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	return iter, true
+}
+
+// commit writes the changes not committed yet to the index, moving it to the
+// given version of the tree.
+func (idx *fastNodeIndex) commit(version int64) error {
+	batch := idx.db.NewBatch()
+	defer batch.Close()
+
+	for key, value := range idx.pending {
+		var err error
+		if value == nil {
+			err = batch.Delete(fastNodeKey([]byte(key)))
+		} else {
+			err = batch.Set(fastNodeKey([]byte(key)), value)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := batch.Set(fastNodeVersionKey, encodeFastNodeVersion(version)); err != nil {
+		return err
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+
+	idx.pending = make(map[string][]byte)
+	return nil
+}
+
+// fastNodeKey returns the database key of the index entry of a key.
+func fastNodeKey(key []byte) []byte {
+	return append(append(make([]byte, 0, len(fastNodePrefix)+len(key)), fastNodePrefix...), key...)
+}
+
+// encodeFastNodeVersion encodes a tree version.
+func encodeFastNodeVersion(version int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(version))
+	return bz
+}
@@ -23,7 +23,8 @@ import (
 )
 
 const (
-	defaultIAVLCacheSize = 10000
+	// DefaultIAVLCacheSize is the default number of nodes an IAVL store caches.
+	DefaultIAVLCacheSize = 10000
 )
 
 var (
@@ -37,21 +38,30 @@ var (
 // Store Implements types.KVStore and CommitKVStore.
 type Store struct {
 	tree Tree
+
+	// fastNode is the index of the latest version of the tree, if enabled.
+	fastNode *fastNodeIndex
 }
 
 // LoadStore returns an IAVL Store as a CommitKVStore. Internally, it will load the
 // store's version (id) from the provided DB. An error is returned if the version
 // fails to load, or if called with a positive version on an empty tree.
-func LoadStore(db dbm.DB, id types.CommitID, lazyLoading bool) (types.CommitKVStore, error) {
-	return LoadStoreWithInitialVersion(db, id, lazyLoading, 0)
+//
+// The tree caches up to cacheSize nodes. If fastNode is true, reads of the latest
+// version are served by an index of its key-value pairs, which is rebuilt if it is
+// missing.
+func LoadStore(db dbm.DB, id types.CommitID, lazyLoading bool, cacheSize int, fastNode bool) (types.CommitKVStore, error) {
+	return LoadStoreWithInitialVersion(db, id, lazyLoading, 0, cacheSize, fastNode)
 }
 
 // LoadStoreWithInitialVersion returns an IAVL Store as a CommitKVStore setting its initialVersion
 // to the one given. Internally, it will load the store's version (id) from the
 // provided DB. An error is returned if the version fails to load, or if called with a positive
 // version on an empty tree.
-func LoadStoreWithInitialVersion(db dbm.DB, id types.CommitID, lazyLoading bool, initialVersion uint64) (types.CommitKVStore, error) {
-	tree, err := iavl.NewMutableTreeWithOpts(db, defaultIAVLCacheSize, &iavl.Options{InitialVersion: initialVersion})
+func LoadStoreWithInitialVersion(
+	db dbm.DB, id types.CommitID, lazyLoading bool, initialVersion uint64, cacheSize int, fastNode bool,
+) (types.CommitKVStore, error) {
+	tree, err := iavl.NewMutableTreeWithOpts(db, cacheSize, &iavl.Options{InitialVersion: initialVersion})
 	if err != nil {
 		return nil, err
 	}
@@ -66,9 +76,18 @@ func LoadStoreWithInitialVersion(db dbm.DB, id types.CommitID, lazyLoading bool,
 		return nil, err
 	}
 
-	return &Store{
+	store := &Store{
 		tree: tree,
-	}, nil
+	}
+
+	if fastNode {
+		store.fastNode, err = loadFastNodeIndex(db, tree)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return store, nil
 }
 
 // UnsafeNewStore returns a reference to a new IAVL Store with a given mutable
@@ -113,6 +132,12 @@ func (st *Store) Commit() types.CommitID {
 		panic(err)
 	}
 
+	if st.fastNode != nil {
+		if err := st.fastNode.commit(version); err != nil {
+			panic(err)
+		}
+	}
+
 	return types.CommitID{
 		Version: version,
 		Hash:    hash,
@@ -144,6 +169,16 @@ func (st *Store) VersionExists(version int64) bool {
 	return st.tree.VersionExists(version)
 }
 
+// AvailableVersions returns the versions of the tree that have not been
+// pruned, in ascending order.
+func (st *Store) AvailableVersions() []int {
+	if tree, ok := st.tree.(*iavl.MutableTree); ok {
+		return tree.AvailableVersions()
+	}
+
+	return []int{int(st.tree.Version())}
+}
+
 // Implements Store.
 func (st *Store) GetStoreType() types.StoreType {
 	return types.StoreTypeIAVL
@@ -164,11 +199,17 @@ func (st *Store) Set(key, value []byte) {
 	types.AssertValidKey(key)
 	types.AssertValidValue(value)
 	st.tree.Set(key, value)
+	if st.fastNode != nil {
+		st.fastNode.set(key, value)
+	}
 }
 
 // Implements types.KVStore.
 func (st *Store) Get(key []byte) []byte {
 	defer telemetry.MeasureSince(time.Now(), "store", "iavl", "get")
+	if st.fastNode != nil {
+		return st.fastNode.get(key)
+	}
 	_, value := st.tree.Get(key)
 	return value
 }
@@ -176,6 +217,9 @@ func (st *Store) Get(key []byte) []byte {
 // Implements types.KVStore.
 func (st *Store) Has(key []byte) (exists bool) {
 	defer telemetry.MeasureSince(time.Now(), "store", "iavl", "has")
+	if st.fastNode != nil {
+		return st.fastNode.get(key) != nil
+	}
 	return st.tree.Has(key)
 }
 
@@ -183,6 +227,9 @@ func (st *Store) Has(key []byte) (exists bool) {
 func (st *Store) Delete(key []byte) {
 	defer telemetry.MeasureSince(time.Now(), "store", "iavl", "delete")
 	st.tree.Remove(key)
+	if st.fastNode != nil {
+		st.fastNode.remove(key)
+	}
 }
 
 // DeleteVersions deletes a series of versions from the MutableTree. An error
@@ -194,6 +241,12 @@ func (st *Store) DeleteVersions(versions ...int64) error {
 
 // Implements types.KVStore.
 func (st *Store) Iterator(start, end []byte) types.Iterator {
+	if st.fastNode != nil {
+		if iter, ok := st.fastNode.iterator(start, end, true); ok {
+			return iter
+		}
+	}
+
 	var iTree *iavl.ImmutableTree
 
 	switch tree := st.tree.(type) {
@@ -208,6 +261,12 @@ func (st *Store) Iterator(start, end []byte) types.Iterator {
 
 // Implements types.KVStore.
 func (st *Store) ReverseIterator(start, end []byte) types.Iterator {
+	if st.fastNode != nil {
+		if iter, ok := st.fastNode.iterator(start, end, false); ok {
+			return iter
+		}
+	}
+
 	var iTree *iavl.ImmutableTree
 
 	switch tree := st.tree.(type) {
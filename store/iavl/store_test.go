@@ -91,17 +91,17 @@ func TestLoadStore(t *testing.T) {
 	require.Equal(t, string(hcStore.Get([]byte("hello"))), "ciao")
 
 	// Querying a new store at some previous non-pruned height H
-	newHStore, err := LoadStore(db, cIDH, false)
+	newHStore, err := LoadStore(db, cIDH, false, DefaultIAVLCacheSize, false)
 	require.NoError(t, err)
 	require.Equal(t, string(newHStore.Get([]byte("hello"))), "hallo")
 
 	// Querying a new store at some previous pruned height Hp
-	newHpStore, err := LoadStore(db, cIDHp, false)
+	newHpStore, err := LoadStore(db, cIDHp, false, DefaultIAVLCacheSize, false)
 	require.NoError(t, err)
 	require.Equal(t, string(newHpStore.Get([]byte("hello"))), "hola")
 
 	// Querying a new store at current height H
-	newHcStore, err := LoadStore(db, cIDHc, false)
+	newHcStore, err := LoadStore(db, cIDHc, false, DefaultIAVLCacheSize, false)
 	require.NoError(t, err)
 	require.Equal(t, string(newHcStore.Get([]byte("hello"))), "ciao")
 }
@@ -634,3 +634,76 @@ func TestSetInitialVersion(t *testing.T) {
 		})
 	}
 }
+
+func collectPairs(iter types.Iterator) []kv.Pair {
+	defer iter.Close()
+
+	var pairs []kv.Pair
+	for ; iter.Valid(); iter.Next() {
+		pairs = append(pairs, kv.Pair{Key: iter.Key(), Value: iter.Value()})
+	}
+
+	return pairs
+}
+
+func TestFastNodeIndex(t *testing.T) {
+	db := dbm.NewMemDB()
+	cstore, err := LoadStore(db, types.CommitID{}, false, DefaultIAVLCacheSize, true)
+	require.NoError(t, err)
+	store := cstore.(*Store)
+	require.NotNil(t, store.fastNode)
+
+	store.Set([]byte("a"), []byte("1"))
+	store.Set([]byte("b"), []byte("2"))
+	store.Set([]byte("c"), []byte("3"))
+
+	// changes not committed yet are read from memory, and iterated over in the tree
+	require.Equal(t, []byte("1"), store.Get([]byte("a")))
+	require.True(t, store.Has([]byte("b")))
+	_, ok := store.fastNode.iterator(nil, nil, true)
+	require.False(t, ok)
+	require.Len(t, collectPairs(store.Iterator(nil, nil)), 3)
+
+	store.Commit()
+	store.Delete([]byte("b"))
+	store.Set([]byte("c"), []byte("4"))
+	require.Nil(t, store.Get([]byte("b")))
+	require.False(t, store.Has([]byte("b")))
+	require.Equal(t, []byte("4"), store.Get([]byte("c")))
+	cid := store.Commit()
+
+	// once committed, the index is iterated over
+	_, ok = store.fastNode.iterator(nil, nil, true)
+	require.True(t, ok)
+	expected := []kv.Pair{
+		{Key: []byte("a"), Value: []byte("1")},
+		{Key: []byte("c"), Value: []byte("4")},
+	}
+	require.Equal(t, expected, collectPairs(store.Iterator(nil, nil)))
+	require.Equal(t, []kv.Pair{expected[1], expected[0]}, collectPairs(store.ReverseIterator(nil, nil)))
+	require.Equal(t, expected[1:], collectPairs(store.Iterator([]byte("b"), nil)))
+
+	// the index is kept on reload
+	version, err := store.fastNode.version()
+	require.NoError(t, err)
+	require.Equal(t, cid.Version, version)
+
+	cstore, err = LoadStore(db, cid, false, DefaultIAVLCacheSize, true)
+	require.NoError(t, err)
+	require.Equal(t, expected, collectPairs(cstore.Iterator(nil, nil)))
+
+	// the index is rebuilt when it is missing
+	require.NoError(t, db.Delete(fastNodeVersionKey))
+	require.NoError(t, db.Delete(fastNodeKey([]byte("a"))))
+	cstore, err = LoadStore(db, cid, false, DefaultIAVLCacheSize, true)
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), cstore.Get([]byte("a")))
+	require.Equal(t, expected, collectPairs(cstore.Iterator(nil, nil)))
+
+	// or at another version of the tree
+	cstore, err = LoadStore(db, types.CommitID{Version: 1}, false, DefaultIAVLCacheSize, true)
+	require.NoError(t, err)
+	require.Equal(t, []byte("2"), cstore.Get([]byte("b")))
+	require.Equal(t, []byte("3"), cstore.Get([]byte("c")))
+	require.Len(t, collectPairs(cstore.Iterator(nil, nil)), 3)
+}
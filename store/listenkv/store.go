@@ -0,0 +1,94 @@
+package listenkv
+
+import (
+	"io"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var _ types.KVStore = &Store{}
+
+// Store implements the KVStore interface with listening enabled.
+// Set and Delete operations are delegated to the parent KVStore and then
+// passed on to each of the underlying listeners together with the StoreKey.
+type Store struct {
+	parent         types.KVStore
+	listeners      []types.WriteListener
+	parentStoreKey types.StoreKey
+}
+
+// NewStore returns a reference to a new listenkv.Store given a parent
+// KVStore implementation, the StoreKey it is mounted under and the listeners
+// that should receive its writes.
+func NewStore(parent types.KVStore, parentStoreKey types.StoreKey, listeners []types.WriteListener) *Store {
+	return &Store{parent: parent, listeners: listeners, parentStoreKey: parentStoreKey}
+}
+
+// Get implements the KVStore interface. It delegates the Get call to the
+// parent KVStore.
+func (s *Store) Get(key []byte) []byte {
+	return s.parent.Get(key)
+}
+
+// Set implements the KVStore interface. It delegates the Set call to the
+// parent KVStore and notifies the listeners of the write.
+func (s *Store) Set(key []byte, value []byte) {
+	types.AssertValidKey(key)
+	s.parent.Set(key, value)
+	s.onWrite(false, key, value)
+}
+
+// Delete implements the KVStore interface. It delegates the Delete call to the
+// parent KVStore and notifies the listeners of the delete.
+func (s *Store) Delete(key []byte) {
+	s.parent.Delete(key)
+	s.onWrite(true, key, nil)
+}
+
+// Has implements the KVStore interface. It delegates the Has call to the
+// parent KVStore.
+func (s *Store) Has(key []byte) bool {
+	return s.parent.Has(key)
+}
+
+// Iterator implements the KVStore interface. It delegates the Iterator call
+// the to the parent KVStore.
+func (s *Store) Iterator(start, end []byte) types.Iterator {
+	return s.parent.Iterator(start, end)
+}
+
+// ReverseIterator implements the KVStore interface. It delegates the
+// ReverseIterator call the to the parent KVStore.
+func (s *Store) ReverseIterator(start, end []byte) types.Iterator {
+	return s.parent.ReverseIterator(start, end)
+}
+
+// GetStoreType implements the KVStore interface. It returns the underlying
+// KVStore type.
+func (s *Store) GetStoreType() types.StoreType {
+	return s.parent.GetStoreType()
+}
+
+// CacheWrap implements the KVStore interface. It branches the Store so that
+// writes flushed from the branch are delivered to the listeners.
+func (s *Store) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements the KVStore interface. It branches the Store
+// with tracing enabled on the branch.
+func (s *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// onWrite writes a KVStore operation to all of the WriteListeners. A failing
+// listener panics, as silently dropping a state change would corrupt the stream.
+func (s *Store) onWrite(delete bool, key, value []byte) {
+	for _, l := range s.listeners {
+		if err := l.OnWrite(s.parentStoreKey, key, value, delete); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package listenkv_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func bz(s string) []byte { return []byte(s) }
+
+func keyFmt(i int) []byte { return bz(fmt.Sprintf("key%0.8d", i)) }
+func valFmt(i int) []byte { return bz(fmt.Sprintf("value%0.8d", i)) }
+
+var kvPairs = []types.KVPair{
+	{Key: keyFmt(1), Value: valFmt(1)},
+	{Key: keyFmt(2), Value: valFmt(2)},
+	{Key: keyFmt(3), Value: valFmt(3)},
+}
+
+var testStoreKey = types.NewKVStoreKey("listen_test")
+
+func newListenKVStore(w io.Writer) *listenkv.Store {
+	store := newEmptyListenKVStore(w)
+
+	for _, kvPair := range kvPairs {
+		store.Set(kvPair.Key, kvPair.Value)
+	}
+
+	return store
+}
+
+func newEmptyListenKVStore(w io.Writer) *listenkv.Store {
+	listener := types.NewStoreKVPairWriteListener(w)
+	memDB := dbadapter.Store{DB: dbm.NewMemDB()}
+
+	return listenkv.NewStore(memDB, testStoreKey, []types.WriteListener{listener})
+}
+
+func readKVPairs(t *testing.T, buf *bytes.Buffer) []types.StoreKVPair {
+	reader := protoio.NewDelimitedReader(buf, 1<<20)
+	var pairs []types.StoreKVPair
+	for {
+		var pair types.StoreKVPair
+		err := reader.ReadMsg(&pair)
+		if err == io.EOF {
+			return pairs
+		}
+		require.NoError(t, err)
+		pairs = append(pairs, pair)
+	}
+}
+
+func TestListenKVStoreGet(t *testing.T) {
+	var buf bytes.Buffer
+
+	store := newListenKVStore(&buf)
+	buf.Reset()
+
+	require.Equal(t, kvPairs[0].Value, store.Get(kvPairs[0].Key))
+	require.Nil(t, store.Get(bz("does-not-exist")))
+	require.True(t, store.Has(kvPairs[1].Key))
+	require.Zero(t, buf.Len(), "reads must not be streamed")
+}
+
+func TestListenKVStoreSet(t *testing.T) {
+	var buf bytes.Buffer
+
+	store := newEmptyListenKVStore(&buf)
+	store.Set(kvPairs[0].Key, kvPairs[0].Value)
+
+	pairs := readKVPairs(t, &buf)
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: testStoreKey.Name(), Delete: false, Key: kvPairs[0].Key, Value: kvPairs[0].Value},
+	}, pairs)
+
+	require.Panics(t, func() { store.Set([]byte(""), []byte("value")) }, "setting an empty key should panic")
+	require.Panics(t, func() { store.Set(nil, []byte("value")) }, "setting a nil key should panic")
+}
+
+func TestListenKVStoreDelete(t *testing.T) {
+	var buf bytes.Buffer
+
+	store := newListenKVStore(&buf)
+	buf.Reset()
+	store.Delete(kvPairs[0].Key)
+
+	pairs := readKVPairs(t, &buf)
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: testStoreKey.Name(), Delete: true, Key: kvPairs[0].Key},
+	}, pairs)
+	require.False(t, store.Has(kvPairs[0].Key))
+}
+
+func TestListenKVStoreCacheWrap(t *testing.T) {
+	var buf bytes.Buffer
+
+	store := newEmptyListenKVStore(&buf)
+	cache := store.CacheWrap().(*cachekv.Store)
+	cache.Set(kvPairs[0].Key, kvPairs[0].Value)
+	cache.Set(kvPairs[1].Key, kvPairs[1].Value)
+	cache.Delete(kvPairs[1].Key)
+	require.Zero(t, buf.Len(), "writes must not be streamed before the branch is written")
+
+	cache.Write()
+	pairs := readKVPairs(t, &buf)
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: testStoreKey.Name(), Delete: false, Key: kvPairs[0].Key, Value: kvPairs[0].Value},
+		{StoreKey: testStoreKey.Name(), Delete: true, Key: kvPairs[1].Key},
+	}, pairs)
+}
+
+func TestListenKVStoreGetStoreType(t *testing.T) {
+	memDB := dbadapter.Store{DB: dbm.NewMemDB()}
+	store := newEmptyListenKVStore(nil)
+	require.Equal(t, memDB.GetStoreType(), store.GetStoreType())
+}
@@ -0,0 +1,230 @@
+package rootmulti
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/archive"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// backfillFlushSize is the number of changes after which BackfillArchive
+// flushes the changes of the version being archived to the archive database.
+const backfillFlushSize = 10000
+
+// SetArchive sets an archive that the changes committed to the IAVL stores are
+// copied to on every Commit, and that serves the versions pruned from the IAVL
+// stores to CacheMultiStoreWithVersion and Query. It must be called once the
+// stores are loaded, and returns an error unless the latest archived version
+// is the latest version of the store, as the archive would otherwise miss
+// changes. An existing store is brought up to date with BackfillArchive.
+func (rs *Store) SetArchive(a *archive.Store) error {
+	latest, err := a.LatestVersion()
+	if err != nil {
+		return err
+	}
+
+	if version := rs.LastCommitID().Version; latest != version {
+		return fmt.Errorf(
+			"archive is at version %d while the store is at version %d; the archive must be backfilled first",
+			latest, version,
+		)
+	}
+
+	for key, store := range rs.stores {
+		if store.GetStoreType() == types.StoreTypeIAVL {
+			rs.AddListeners(key, []types.WriteListener{a})
+		}
+	}
+
+	rs.archive = a
+
+	return nil
+}
+
+// isArchived returns true if the given version has been pruned from an IAVL
+// store and can be served from the archive.
+func (rs *Store) isArchived(store types.Store, version int64) (bool, error) {
+	iavlStore, ok := store.(*iavl.Store)
+	if rs.archive == nil || !ok || version <= 0 || iavlStore.VersionExists(version) {
+		return false, nil
+	}
+
+	return rs.archive.HasVersion(version)
+}
+
+// BackfillArchive copies the versions of the IAVL stores persisted in db that
+// are more recent than the latest archived version into the archive, in order,
+// and returns the first and last versions copied. The first version copied to
+// an empty archive is copied in full, and every following one as the changes
+// since the previous one. Versions pruned from the stores are skipped, and are
+// thus not served by the archive.
+func BackfillArchive(db dbm.DB, a *archive.Store) (first, last int64, err error) {
+	latest := getLatestVersion(db)
+	if latest == 0 {
+		return 0, 0, fmt.Errorf("no versions found in the database")
+	}
+
+	archived, err := a.LatestVersion()
+	if err != nil {
+		return 0, 0, err
+	}
+	if archived > latest {
+		return 0, 0, fmt.Errorf("archive is at version %d, ahead of the database at version %d", archived, latest)
+	}
+
+	cInfo, err := getCommitInfo(db, latest)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// Mount the IAVL stores of the latest version. Other stores, such as
+	// memory stores, report an empty commit ID.
+	rs := NewStore(db)
+	for _, storeInfo := range cInfo.StoreInfos {
+		if storeInfo.CommitId.Version != 0 {
+			rs.MountStoreWithDB(types.NewKVStoreKey(storeInfo.Name), types.StoreTypeIAVL, nil)
+		}
+	}
+
+	if err := rs.LoadLatestVersion(); err != nil {
+		return 0, 0, err
+	}
+
+	return rs.backfillArchive(a)
+}
+
+// backfillArchive copies the versions of the loaded IAVL stores that are more
+// recent than the latest archived version into the archive, as described in
+// BackfillArchive. A store is skipped at the versions it does not hold, such
+// as the versions before it was added by a store upgrade, at which it was
+// empty.
+func (rs *Store) backfillArchive(a *archive.Store) (first, last int64, err error) {
+	archived, err := a.LatestVersion()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	iavlStores := make(map[types.StoreKey]*iavl.Store)
+	for key := range rs.stores {
+		if iavlStore, ok := rs.GetCommitKVStore(key).(*iavl.Store); ok {
+			iavlStores[key] = iavlStore
+		}
+	}
+
+	// gather the versions that can be archived
+	versionSet := make(map[int64]struct{})
+	for _, store := range iavlStores {
+		for _, v := range store.AvailableVersions() {
+			if int64(v) > archived {
+				versionSet[int64(v)] = struct{}{}
+			}
+		}
+	}
+
+	versions := make([]int64, 0, len(versionSet))
+	for v := range versionSet {
+		versions = append(versions, v)
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+
+	for _, version := range versions {
+		for key, store := range iavlStores {
+			if !store.VersionExists(version) {
+				continue
+			}
+
+			current, err := store.GetImmutable(version)
+			if err != nil {
+				return first, last, err
+			}
+
+			var previous types.KVStore
+			if archived > 0 {
+				previous = a.GetVersioned(key.Name(), archived)
+			}
+
+			if err := archiveChanges(a, key, previous, current, version); err != nil {
+				return first, last, err
+			}
+		}
+
+		if err := a.Commit(version); err != nil {
+			return first, last, err
+		}
+
+		if first == 0 {
+			first = version
+		}
+		last, archived = version, version
+	}
+
+	return first, last, nil
+}
+
+// archiveChanges passes the changes between the previous and current states
+// of a store on to the archive, walking both states in key order. A nil
+// previous state stands for an empty store.
+func archiveChanges(a *archive.Store, key types.StoreKey, previous, current types.KVStore, version int64) error {
+	curr := current.Iterator(nil, nil)
+	defer curr.Close()
+
+	var prev types.Iterator
+	if previous != nil {
+		prev = previous.Iterator(nil, nil)
+		defer prev.Close()
+	}
+
+	changes := 0
+	onWrite := func(k, v []byte, delete bool) error {
+		if err := a.OnWrite(key, k, v, delete); err != nil {
+			return err
+		}
+
+		if changes++; changes%backfillFlushSize == 0 {
+			return a.Flush(version)
+		}
+
+		return nil
+	}
+
+	for curr.Valid() || (prev != nil && prev.Valid()) {
+		var cmp int
+		switch {
+		case prev == nil || !prev.Valid():
+			cmp = -1
+		case !curr.Valid():
+			cmp = 1
+		default:
+			cmp = bytes.Compare(curr.Key(), prev.Key())
+		}
+
+		var err error
+		switch {
+		case cmp < 0: // set since the previous version
+			err = onWrite(curr.Key(), curr.Value(), false)
+			curr.Next()
+
+		case cmp > 0: // deleted since the previous version
+			err = onWrite(prev.Key(), nil, true)
+			prev.Next()
+
+		default:
+			if !bytes.Equal(curr.Value(), prev.Value()) {
+				err = onWrite(curr.Key(), curr.Value(), false)
+			}
+			curr.Next()
+			prev.Next()
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,208 @@
+package rootmulti
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/archive"
+	"github.com/cosmos/cosmos-sdk/store/cache"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// commitVersions sets key "k" of store1 to "v<version>" and commits, for every
+// version in [from, to].
+func commitVersions(t *testing.T, multi *Store, from, to int64) {
+	key := multi.keysByName["store1"]
+
+	for version := from; version <= to; version++ {
+		cms := multi.CacheMultiStore()
+		store := cms.GetKVStore(key)
+		store.Set([]byte("k"), []byte(fmt.Sprintf("v%d", version)))
+		store.Set([]byte(fmt.Sprintf("k%d", version)), []byte("set"))
+		store.Delete([]byte(fmt.Sprintf("k%d", version-1)))
+		cms.Write()
+
+		require.Equal(t, version, multi.Commit().Version)
+	}
+}
+
+func requireArchivedVersion(t *testing.T, multi *Store, version int64) {
+	cms, err := multi.CacheMultiStoreWithVersion(version)
+	require.NoError(t, err)
+
+	store := cms.GetKVStore(multi.keysByName["store1"])
+	require.Equal(t, []byte(fmt.Sprintf("v%d", version)), store.Get([]byte("k")))
+	require.Equal(t, []byte("set"), store.Get([]byte(fmt.Sprintf("k%d", version))))
+	require.Nil(t, store.Get([]byte(fmt.Sprintf("k%d", version-1))))
+
+	res := multi.Query(abci.RequestQuery{Path: "/store1/key", Data: []byte("k"), Height: version})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, []byte(fmt.Sprintf("v%d", version)), res.Value)
+}
+
+func TestMultiStoreArchive(t *testing.T) {
+	db := dbm.NewMemDB()
+	multi := newMultiStoreWithMounts(db, types.NewPruningOptions(0, 0, 1))
+	require.NoError(t, multi.LoadLatestVersion())
+
+	a := archive.NewStore(dbm.NewMemDB())
+	require.NoError(t, multi.SetArchive(a))
+
+	commitVersions(t, multi, 1, 5)
+
+	latest, err := a.LatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, int64(5), latest)
+
+	// the versions pruned from the stores are served by the archive
+	iavlStore := multi.GetCommitKVStore(multi.keysByName["store1"]).(*iavl.Store)
+	require.False(t, iavlStore.VersionExists(2))
+
+	for version := int64(1); version <= 5; version++ {
+		requireArchivedVersion(t, multi, version)
+	}
+
+	// the archive cannot provide proofs
+	res := multi.Query(abci.RequestQuery{Path: "/store1/key", Data: []byte("k"), Height: 2, Prove: true})
+	require.False(t, res.IsOK())
+
+	// an archive that is not up to date is rejected
+	multi = newMultiStoreWithMounts(db, types.NewPruningOptions(0, 0, 1))
+	require.NoError(t, multi.LoadLatestVersion())
+	require.Error(t, multi.SetArchive(archive.NewStore(dbm.NewMemDB())))
+	require.NoError(t, multi.SetArchive(a))
+
+	commitVersions(t, multi, 6, 6)
+	requireArchivedVersion(t, multi, 3)
+	requireArchivedVersion(t, multi, 6)
+}
+
+func TestBackfillArchive(t *testing.T) {
+	db := dbm.NewMemDB()
+	multi := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, multi.LoadLatestVersion())
+	commitVersions(t, multi, 1, 3)
+
+	a := archive.NewStore(dbm.NewMemDB())
+
+	first, last, err := BackfillArchive(db, a)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), first)
+	require.Equal(t, int64(3), last)
+
+	commitVersions(t, multi, 4, 5)
+
+	first, last, err = BackfillArchive(db, a)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), first)
+	require.Equal(t, int64(5), last)
+
+	first, last, err = BackfillArchive(db, a)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), first)
+	require.Equal(t, int64(0), last)
+
+	for version := int64(1); version <= 5; version++ {
+		store := a.GetVersioned("store1", version)
+		require.Equal(t, []byte(fmt.Sprintf("v%d", version)), store.Get([]byte("k")))
+		require.Equal(t, []byte("set"), store.Get([]byte(fmt.Sprintf("k%d", version))))
+		require.Nil(t, store.Get([]byte(fmt.Sprintf("k%d", version-1))))
+	}
+
+	// the backfilled archive keeps up with the store
+	multi = newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, multi.LoadLatestVersion())
+	require.NoError(t, multi.SetArchive(a))
+	commitVersions(t, multi, 6, 6)
+
+	ok, err := a.HasVersion(6)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	_, _, err = BackfillArchive(dbm.NewMemDB(), a)
+	require.Error(t, err)
+}
+
+func TestBackfillArchiveAddedStore(t *testing.T) {
+	db := dbm.NewMemDB()
+	multi := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, multi.LoadLatestVersion())
+	commitVersions(t, multi, 1, 2)
+
+	// store4 is added by a store upgrade at version 3
+	multi = newMultiStoreWithMounts(db, types.PruneNothing)
+	multi.MountStoreWithDB(types.NewKVStoreKey("store4"), types.StoreTypeIAVL, nil)
+	require.NoError(t, multi.LoadLatestVersionAndUpgrade(&types.StoreUpgrades{Added: []string{"store4"}}))
+
+	cms := multi.CacheMultiStore()
+	cms.GetKVStore(multi.keysByName["store4"]).Set([]byte("added"), []byte("v3"))
+	cms.Write()
+	commitVersions(t, multi, 3, 4)
+
+	a := archive.NewStore(dbm.NewMemDB())
+	first, last, err := BackfillArchive(db, a)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), first)
+	require.Equal(t, int64(4), last)
+
+	for version := int64(1); version <= 4; version++ {
+		store := a.GetVersioned("store1", version)
+		require.Equal(t, []byte(fmt.Sprintf("v%d", version)), store.Get([]byte("k")))
+	}
+
+	// the added store is empty before the upgrade
+	require.Nil(t, a.GetVersioned("store4", 2).Get([]byte("added")))
+	require.Equal(t, []byte("v3"), a.GetVersioned("store4", 3).Get([]byte("added")))
+	require.Equal(t, []byte("v3"), a.GetVersioned("store4", 4).Get([]byte("added")))
+}
+
+func TestRestoreArchive(t *testing.T) {
+	source := newMultiStoreWithMounts(dbm.NewMemDB(), types.PruneNothing)
+	require.NoError(t, source.LoadLatestVersion())
+	commitVersions(t, source, 1, 3)
+
+	for _, tc := range []struct {
+		format          uint32
+		interBlockCache bool
+	}{
+		{snapshottypes.FormatStream, false},
+		{snapshottypes.FormatParallel, false},
+		{snapshottypes.FormatStream, true},
+	} {
+		target := newMultiStoreWithMounts(dbm.NewMemDB(), types.PruneNothing)
+		if tc.interBlockCache {
+			// the loaded stores are wrapped by the cache
+			target.SetInterBlockCache(cache.NewCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize))
+		}
+		require.NoError(t, target.LoadLatestVersion())
+
+		a := archive.NewStore(dbm.NewMemDB())
+		require.NoError(t, target.SetArchive(a))
+
+		chunks, err := source.Snapshot(3, tc.format)
+		require.NoError(t, err)
+		require.NoError(t, target.Restore(3, tc.format, chunks, nil))
+
+		// the restored version is archived in full, and the archive keeps up
+		// with the following versions
+		ok, err := a.HasVersion(3)
+		require.NoError(t, err)
+		require.True(t, ok)
+
+		store := a.GetVersioned("store1", 3)
+		require.Equal(t, []byte("v3"), store.Get([]byte("k")))
+		require.Equal(t, []byte("set"), store.Get([]byte("k3")))
+
+		commitVersions(t, target, 4, 4)
+
+		store = a.GetVersioned("store1", 4)
+		require.Equal(t, []byte("v4"), store.Get([]byte("k")))
+		require.Nil(t, store.Get([]byte("k3")))
+	}
+}
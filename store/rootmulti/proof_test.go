@@ -8,13 +8,14 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/smt"
 	"github.com/cosmos/cosmos-sdk/store/types"
 )
 
 func TestVerifyIAVLStoreQueryProof(t *testing.T) {
 	// Create main tree for testing.
 	db := dbm.NewMemDB()
-	iStore, err := iavl.LoadStore(db, types.CommitID{}, false)
+	iStore, err := iavl.LoadStore(db, types.CommitID{}, false, iavl.DefaultIAVLCacheSize, false)
 	store := iStore.(*iavl.Store)
 	require.Nil(t, err)
 	store.Set([]byte("MYKEY"), []byte("MYVALUE"))
@@ -146,3 +147,57 @@ func TestVerifyMultiStoreQueryProofAbsence(t *testing.T) {
 	err = prt.VerifyValue(res.ProofOps, cid.Hash, "/iavlStoreKey/MYABSENTKEY", []byte(""))
 	require.NotNil(t, err)
 }
+
+func TestVerifyMultiStoreQueryProofSMT(t *testing.T) {
+	// Create main tree for testing.
+	db := dbm.NewMemDB()
+	store := NewStore(db)
+	iavlStoreKey := types.NewKVStoreKey("iavlStoreKey")
+	smtStoreKey := types.NewKVStoreKey("smtStoreKey")
+
+	store.MountStoreWithDB(iavlStoreKey, types.StoreTypeIAVL, nil)
+	store.MountStoreWithDB(smtStoreKey, types.StoreTypeSMT, nil)
+	require.NoError(t, store.LoadVersion(0))
+
+	smtStore := store.GetCommitStore(smtStoreKey).(*smt.Store)
+	smtStore.Set([]byte("MYKEY"), []byte("MYVALUE"))
+	smtStore.Set([]byte("MYOTHERKEY"), []byte("MYOTHERVALUE"))
+	cid := store.Commit()
+
+	// Get Proof
+	res := store.Query(abci.RequestQuery{
+		Path:  "/smtStoreKey/key", // required path to get key/value+proof
+		Data:  []byte("MYKEY"),
+		Prove: true,
+	})
+	require.NotNil(t, res.ProofOps)
+
+	// Verify proof.
+	prt := DefaultProofRuntime()
+	err := prt.VerifyValue(res.ProofOps, cid.Hash, "/smtStoreKey/MYKEY", []byte("MYVALUE"))
+	require.Nil(t, err)
+
+	// Verify (bad) proof.
+	err = prt.VerifyValue(res.ProofOps, cid.Hash, "/smtStoreKey/MYKEY_NOT", []byte("MYVALUE"))
+	require.NotNil(t, err)
+
+	// Verify (bad) proof.
+	err = prt.VerifyValue(res.ProofOps, cid.Hash, "/smtStoreKey/MYKEY", []byte("MYVALUE_NOT"))
+	require.NotNil(t, err)
+
+	// Get absence proof
+	res = store.Query(abci.RequestQuery{
+		Path:  "/smtStoreKey/key", // required path to get key/value+proof
+		Data:  []byte("MYABSENTKEY"),
+		Prove: true,
+	})
+	require.NotNil(t, res.ProofOps)
+
+	// Verify proof.
+	err = prt.VerifyAbsence(res.ProofOps, cid.Hash, "/smtStoreKey/MYABSENTKEY")
+	require.Nil(t, err)
+
+	// Verify (bad) proof.
+	err = prt.VerifyAbsence(res.ProofOps, cid.Hash, "/smtStoreKey/MYKEY")
+	require.NotNil(t, err)
+}
@@ -0,0 +1,461 @@
+package rootmulti
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"math"
+	"runtime"
+	"sync"
+
+	iavltree "github.com/cosmos/iavl"
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// The compression of a FormatParallel chunk, as written in its header.
+const (
+	chunkCompressionNone byte = 0x00
+	chunkCompressionZstd byte = 0x01
+)
+
+// restoreChunkBuffer is the number of FormatParallel chunks buffered for every
+// store being restored.
+const restoreChunkBuffer = 4
+
+// namedStore is an IAVL store to snapshot, along with its name.
+type namedStore struct {
+	*iavl.Store
+	name string
+}
+
+// storeChunk is a FormatParallel chunk of a store, or the error that ended its
+// export.
+type storeChunk struct {
+	chunk []byte
+	err   error
+}
+
+// snapshotParallel exports the given stores, sorted by name, in the FormatParallel format.
+// The stores are exported concurrently, each into its own sequence of chunks, and the chunks
+// are passed on in store order. A store's chunk is cut once its uncompressed items reach
+// snapshotChunkSize, so that the output is identical across nodes using the same compression.
+func (rs *Store) snapshotParallel(height uint64, stores []namedStore) <-chan io.ReadCloser {
+	ch := make(chan io.ReadCloser)
+	compression := rs.snapshotCompression
+
+	go func() {
+		defer close(ch)
+
+		encoder, err := newChunkEncoder(compression)
+		if err != nil {
+			ch <- errorChunk(err)
+			return
+		}
+
+		// done is closed when the export ends, to stop the exports still in progress
+		done := make(chan struct{})
+		defer close(done)
+
+		// Exports start in store order, such that the store whose chunks are being passed
+		// on never waits for a worker.
+		outputs := make([]chan storeChunk, len(stores))
+		for i := range outputs {
+			outputs[i] = make(chan storeChunk, 1)
+		}
+
+		go func() {
+			workers := make(chan struct{}, runtime.NumCPU())
+			for i, store := range stores {
+				select {
+				case workers <- struct{}{}:
+				case <-done:
+					return
+				}
+
+				go func(store namedStore, output chan<- storeChunk) {
+					defer func() { <-workers }()
+					defer close(output)
+
+					exportStoreChunks(store, height, encoder, output, done)
+				}(store, outputs[i])
+			}
+		}()
+
+		for _, output := range outputs {
+			for c := range output {
+				if c.err != nil {
+					ch <- errorChunk(c.err)
+					return
+				}
+
+				ch <- ioutil.NopCloser(bytes.NewReader(c.chunk))
+			}
+		}
+	}()
+
+	return ch
+}
+
+// exportStoreChunks exports a store at the given height and sends its chunks to output, until
+// the export is complete or done is closed. Every chunk holds the delimited SnapshotItems of
+// IAVL nodes. A store with no nodes is exported as a single chunk with no items, which still
+// identifies the store.
+func exportStoreChunks(
+	store namedStore, height uint64, encoder *chunkEncoder, output chan<- storeChunk, done <-chan struct{},
+) {
+	send := func(c storeChunk) bool {
+		select {
+		case output <- c:
+			return true
+		case <-done:
+			return false
+		}
+	}
+
+	exporter, err := store.Export(int64(height))
+	if err != nil {
+		send(storeChunk{err: err})
+		return
+	}
+	defer exporter.Close()
+
+	var (
+		payload = new(bytes.Buffer)
+		writer  = protoio.NewDelimitedWriter(payload)
+		chunks  = 0
+	)
+
+	flush := func() bool {
+		chunk, err := encoder.encode(store.name, payload.Bytes())
+		payload.Reset()
+		chunks++
+
+		return send(storeChunk{chunk: chunk, err: err}) && err == nil
+	}
+
+	for {
+		node, err := exporter.Next()
+		if err == iavltree.ExportDone {
+			break
+		} else if err != nil {
+			send(storeChunk{err: err})
+			return
+		}
+
+		err = writer.WriteMsg(&types.SnapshotItem{
+			Item: &types.SnapshotItem_IAVL{
+				IAVL: &types.SnapshotIAVLItem{
+					Key:     node.Key,
+					Value:   node.Value,
+					Height:  int32(node.Height),
+					Version: node.Version,
+				},
+			},
+		})
+		if err != nil {
+			send(storeChunk{err: err})
+			return
+		}
+
+		if uint64(payload.Len()) >= snapshotChunkSize && !flush() {
+			return
+		}
+	}
+
+	if payload.Len() > 0 || chunks == 0 {
+		flush()
+	}
+}
+
+// restoreParallel imports the chunks of a FormatParallel snapshot into the stores. Every store
+// is imported by its own goroutine, which decompresses and imports the store's chunks in the
+// order they are received.
+func (rs *Store) restoreParallel(height uint64, chunks <-chan io.ReadCloser) error {
+	defer snapshots.DrainChunks(chunks)
+
+	var (
+		wg        sync.WaitGroup
+		mtx       sync.Mutex
+		importErr error
+		failed    = make(chan struct{})
+		importers = make(map[string]chan []byte)
+	)
+
+	fail := func(err error) {
+		mtx.Lock()
+		defer mtx.Unlock()
+
+		if importErr == nil {
+			importErr = err
+			close(failed)
+		}
+	}
+
+	// dispatch passes the chunks on to the goroutine importing their store, starting it on the
+	// first chunk of the store.
+	dispatch := func() error {
+		for reader := range chunks {
+			chunk, err := ioutil.ReadAll(reader)
+			if err != nil {
+				return err
+			}
+			if err := reader.Close(); err != nil {
+				return err
+			}
+
+			storeName, err := decodeChunkStoreName(chunk)
+			if err != nil {
+				return err
+			}
+
+			importer, ok := importers[storeName]
+			if !ok {
+				store, ok := rs.getStoreByName(storeName).(*iavl.Store)
+				if !ok || store == nil {
+					return sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot import into non-IAVL store %q", storeName)
+				}
+
+				importer = make(chan []byte, restoreChunkBuffer)
+				importers[storeName] = importer
+
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+
+					if err := importStoreChunks(store, height, importer); err != nil {
+						fail(sdkerrors.Wrapf(err, "failed to restore store %q", storeName))
+					}
+				}()
+			}
+
+			select {
+			case importer <- chunk:
+			case <-failed:
+				return nil
+			}
+		}
+
+		return nil
+	}
+
+	err := dispatch()
+
+	for _, importer := range importers {
+		close(importer)
+	}
+	wg.Wait()
+
+	if err != nil {
+		return err
+	}
+
+	return importErr
+}
+
+// importStoreChunks imports the chunks of a store at the given height, and commits the import
+// once the chunks channel is closed. The remaining chunks are drained on failure.
+func importStoreChunks(store *iavl.Store, height uint64, chunks <-chan []byte) error {
+	defer func() {
+		for range chunks {
+			// drain the chunks left after a failure
+		}
+	}()
+
+	decoder, err := newChunkDecoder()
+	if err != nil {
+		return err
+	}
+	defer decoder.Close()
+
+	importer, err := store.Import(int64(height))
+	if err != nil {
+		return sdkerrors.Wrap(err, "import failed")
+	}
+	defer importer.Close()
+
+	for chunk := range chunks {
+		payload, err := decodeChunkPayload(decoder, chunk)
+		if err != nil {
+			return err
+		}
+
+		protoReader := protoio.NewDelimitedReader(bytes.NewReader(payload), snapshotMaxItemSize)
+		for {
+			item := &types.SnapshotItem{}
+			err := protoReader.ReadMsg(item)
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				return sdkerrors.Wrap(err, "invalid protobuf message")
+			}
+
+			iavlItem, ok := item.Item.(*types.SnapshotItem_IAVL)
+			if !ok {
+				return sdkerrors.Wrapf(sdkerrors.ErrLogic, "unexpected snapshot item %T", item.Item)
+			}
+
+			node, err := exportNodeFromItem(iavlItem.IAVL)
+			if err != nil {
+				return err
+			}
+
+			if err := importer.Add(node); err != nil {
+				return sdkerrors.Wrap(err, "IAVL node import failed")
+			}
+		}
+	}
+
+	if err := importer.Commit(); err != nil {
+		return sdkerrors.Wrap(err, "IAVL commit failed")
+	}
+
+	return nil
+}
+
+// exportNodeFromItem converts a snapshot item back into the IAVL node it was exported from.
+func exportNodeFromItem(item *types.SnapshotIAVLItem) (*iavltree.ExportNode, error) {
+	if item.Height > math.MaxInt8 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "node height %v cannot exceed %v",
+			item.Height, math.MaxInt8)
+	}
+
+	node := &iavltree.ExportNode{
+		Key:     item.Key,
+		Value:   item.Value,
+		Height:  int8(item.Height),
+		Version: item.Version,
+	}
+
+	// Protobuf does not differentiate between []byte{} as nil, but fortunately IAVL does
+	// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
+	if node.Key == nil {
+		node.Key = []byte{}
+	}
+	if node.Height == 0 && node.Value == nil {
+		node.Value = []byte{}
+	}
+
+	return node, nil
+}
+
+// chunkEncoder encodes FormatParallel chunks with a given compression. It can be used
+// concurrently.
+type chunkEncoder struct {
+	compression byte
+	zstd        *zstd.Encoder
+}
+
+func newChunkEncoder(compression snapshottypes.Compression) (*chunkEncoder, error) {
+	switch compression {
+	case snapshottypes.CompressionNone:
+		return &chunkEncoder{compression: chunkCompressionNone}, nil
+
+	case snapshottypes.CompressionZstd:
+		// a single-threaded encoder produces the same output on every node
+		encoder, err := zstd.NewWriter(nil,
+			zstd.WithEncoderConcurrency(1),
+			zstd.WithEncoderLevel(zstd.SpeedDefault))
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "zstd failure")
+		}
+
+		return &chunkEncoder{compression: chunkCompressionZstd, zstd: encoder}, nil
+
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "unknown snapshot compression %q", string(compression))
+	}
+}
+
+// encode returns a chunk holding the given payload of the named store. A chunk consists of
+// the compression byte, the uvarint-prefixed store name and the compressed payload.
+func (e *chunkEncoder) encode(storeName string, payload []byte) ([]byte, error) {
+	var bz [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(bz[:], uint64(len(storeName)))
+
+	chunk := make([]byte, 0, 1+n+len(storeName)+len(payload))
+	chunk = append(chunk, e.compression)
+	chunk = append(chunk, bz[:n]...)
+	chunk = append(chunk, storeName...)
+
+	switch e.compression {
+	case chunkCompressionZstd:
+		return e.zstd.EncodeAll(payload, chunk), nil
+	default:
+		return append(chunk, payload...), nil
+	}
+}
+
+// newChunkDecoder returns a zstd decoder for FormatParallel chunks. The decoder decompresses
+// a single chunk at a time, so every goroutine importing a store uses its own.
+func newChunkDecoder() (*zstd.Decoder, error) {
+	decoder, err := zstd.NewReader(nil,
+		zstd.WithDecoderConcurrency(1),
+		zstd.WithDecoderMaxMemory(uint64(snapshotChunkSize)+uint64(snapshotMaxItemSize)))
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "zstd failure")
+	}
+
+	return decoder, nil
+}
+
+// decodeChunkStoreName returns the name of the store a FormatParallel chunk belongs to.
+func decodeChunkStoreName(chunk []byte) (string, error) {
+	name, _, err := splitChunk(chunk)
+	return name, err
+}
+
+// decodeChunkPayload returns the decompressed payload of a FormatParallel chunk.
+func decodeChunkPayload(decoder *zstd.Decoder, chunk []byte) ([]byte, error) {
+	_, payload, err := splitChunk(chunk)
+	if err != nil {
+		return nil, err
+	}
+
+	switch chunk[0] {
+	case chunkCompressionNone:
+		return payload, nil
+
+	case chunkCompressionZstd:
+		payload, err := decoder.DecodeAll(payload, nil)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "zstd failure")
+		}
+		return payload, nil
+
+	default:
+		return nil, sdkerrors.Wrapf(snapshottypes.ErrInvalidMetadata, "unknown chunk compression %x", chunk[0])
+	}
+}
+
+// splitChunk splits a FormatParallel chunk into the store name and the compressed payload.
+func splitChunk(chunk []byte) (string, []byte, error) {
+	if len(chunk) == 0 {
+		return "", nil, sdkerrors.Wrap(snapshottypes.ErrInvalidMetadata, "empty chunk")
+	}
+
+	size, n := binary.Uvarint(chunk[1:])
+	if n <= 0 || size > uint64(len(chunk)-1-n) {
+		return "", nil, sdkerrors.Wrap(snapshottypes.ErrInvalidMetadata, "invalid chunk header")
+	}
+
+	start := 1 + n
+	end := start + int(size)
+
+	return string(chunk[start:end]), chunk[end:], nil
+}
+
+// errorChunk returns a chunk whose reader fails with err.
+func errorChunk(err error) io.ReadCloser {
+	pr, pw := io.Pipe()
+	pw.CloseWithError(err)
+
+	return pr
+}
@@ -19,10 +19,13 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/archive"
 	"github.com/cosmos/cosmos-sdk/store/cachemulti"
 	"github.com/cosmos/cosmos-sdk/store/dbadapter"
 	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
 	"github.com/cosmos/cosmos-sdk/store/mem"
+	"github.com/cosmos/cosmos-sdk/store/smt"
 	"github.com/cosmos/cosmos-sdk/store/tracekv"
 	"github.com/cosmos/cosmos-sdk/store/transient"
 	"github.com/cosmos/cosmos-sdk/store/types"
@@ -54,10 +57,26 @@ type Store struct {
 	pruneHeights   []int64
 	initialVersion int64
 
+	// iavlCacheSize is the node cache size of the IAVL stores, unless set for
+	// the store in iavlCacheSizes
+	iavlCacheSize  int
+	iavlCacheSizes map[string]int
+	iavlFastNode   bool
+
 	traceWriter  io.Writer
 	traceContext types.TraceContext
 
 	interBlockCache types.MultiStorePersistentCache
+
+	listeners map[types.StoreKey][]types.WriteListener
+
+	// archive, if set, receives the changes committed to the IAVL stores and
+	// serves the versions pruned from them
+	archive *archive.Store
+
+	// snapshotCompression is the compression of the chunks of FormatParallel
+	// snapshots
+	snapshotCompression snapshottypes.Compression
 }
 
 var (
@@ -77,6 +96,12 @@ func NewStore(db dbm.DB) *Store {
 		stores:       make(map[types.StoreKey]types.CommitKVStore),
 		keysByName:   make(map[string]types.StoreKey),
 		pruneHeights: make([]int64, 0),
+		listeners:    make(map[types.StoreKey][]types.WriteListener),
+
+		iavlCacheSize:  iavl.DefaultIAVLCacheSize,
+		iavlCacheSizes: make(map[string]int),
+
+		snapshotCompression: snapshottypes.DefaultCompression,
 	}
 }
 
@@ -92,11 +117,40 @@ func (rs *Store) SetPruning(pruningOpts types.PruningOptions) {
 	rs.pruningOpts = pruningOpts
 }
 
+// SetSnapshotCompression sets the compression of the chunks of the snapshots
+// taken in a format supporting a choice of compression.
+func (rs *Store) SetSnapshotCompression(compression snapshottypes.Compression) error {
+	if err := compression.Validate(); err != nil {
+		return err
+	}
+
+	rs.snapshotCompression = compression
+
+	return nil
+}
+
 // SetLazyLoading sets if the iavl store should be loaded lazily or not
 func (rs *Store) SetLazyLoading(lazyLoading bool) {
 	rs.lazyLoading = lazyLoading
 }
 
+// SetIAVLCacheSize implements CommitMultiStore.
+func (rs *Store) SetIAVLCacheSize(size int) {
+	rs.iavlCacheSize = size
+}
+
+// SetIAVLCacheSizes implements CommitMultiStore.
+func (rs *Store) SetIAVLCacheSizes(sizes map[string]int) {
+	for name, size := range sizes {
+		rs.iavlCacheSizes[name] = size
+	}
+}
+
+// SetIAVLFastNode implements CommitMultiStore.
+func (rs *Store) SetIAVLFastNode(enabled bool) {
+	rs.iavlFastNode = enabled
+}
+
 // GetStoreType implements Store.
 func (rs *Store) GetStoreType() types.StoreType {
 	return types.StoreTypeMulti
@@ -312,6 +366,23 @@ func (rs *Store) TracingEnabled() bool {
 	return rs.traceWriter != nil
 }
 
+// AddListeners adds listeners for a specific KVStore
+func (rs *Store) AddListeners(key types.StoreKey, listeners []types.WriteListener) {
+	if ls, ok := rs.listeners[key]; ok {
+		rs.listeners[key] = append(ls, listeners...)
+	} else {
+		rs.listeners[key] = listeners
+	}
+}
+
+// ListeningEnabled returns if listening is enabled for a specific KVStore
+func (rs *Store) ListeningEnabled(key types.StoreKey) bool {
+	if ls, ok := rs.listeners[key]; ok {
+		return len(ls) != 0
+	}
+	return false
+}
+
 // LastCommitID implements Committer/CommitStore.
 func (rs *Store) LastCommitID() types.CommitID {
 	if rs.lastCommitInfo == nil {
@@ -364,6 +435,12 @@ func (rs *Store) Commit() types.CommitID {
 
 	flushMetadata(rs.db, version, rs.lastCommitInfo, rs.pruneHeights)
 
+	if rs.archive != nil {
+		if err := rs.archive.Commit(version); err != nil {
+			panic(fmt.Errorf("failed to archive version %d: %w", version, err))
+		}
+	}
+
 	return types.CommitID{
 		Version: version,
 		Hash:    rs.lastCommitInfo.Hash(),
@@ -405,14 +482,19 @@ func (rs *Store) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.Cac
 }
 
 // CacheMultiStore creates ephemeral branch of the multi-store and returns a CacheMultiStore.
-// It implements the MultiStore interface.
+// It implements the MultiStore interface. If listening is enabled for a store,
+// its listeners observe the writes of the branch once it is written back.
 func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 	stores := make(map[types.StoreKey]types.CacheWrapper)
 	for k, v := range rs.stores {
-		stores[k] = v
+		if rs.ListeningEnabled(k) {
+			stores[k] = listenkv.NewStore(v, k, rs.listeners[k])
+		} else {
+			stores[k] = v
+		}
 	}
 
-	return cachemulti.NewStore(rs.db, stores, rs.keysByName, rs.traceWriter, rs.traceContext)
+	return cachemulti.NewStore(rs.db, stores, rs.keysByName, rs.traceWriter, rs.traceContext, nil)
 }
 
 // CacheMultiStoreWithVersion is analogous to CacheMultiStore except that it
@@ -437,12 +519,29 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 
 			cachedStores[key] = iavlStore
 
+			// Serve the version from the archive if it has been pruned.
+			archived, err := rs.isArchived(store, version)
+			if err != nil {
+				return nil, err
+			}
+			if archived {
+				cachedStores[key] = rs.archive.GetVersioned(key.Name(), version)
+			}
+
+		case types.StoreTypeSMT:
+			smtStore, err := store.(*smt.Store).GetImmutable(version)
+			if err != nil {
+				return nil, err
+			}
+
+			cachedStores[key] = smtStore
+
 		default:
 			cachedStores[key] = store
 		}
 	}
 
-	return cachemulti.NewStore(rs.db, cachedStores, rs.keysByName, rs.traceWriter, rs.traceContext), nil
+	return cachemulti.NewStore(rs.db, cachedStores, rs.keysByName, rs.traceWriter, rs.traceContext, nil), nil
 }
 
 // GetStore returns a mounted Store for a given StoreKey. If the StoreKey does
@@ -462,13 +561,18 @@ func (rs *Store) GetStore(key types.StoreKey) types.Store {
 
 // GetKVStore returns a mounted KVStore for a given StoreKey. If tracing is
 // enabled on the KVStore, a wrapped TraceKVStore will be returned with the root
-// store's tracer, otherwise, the original KVStore will be returned.
+// store's tracer, otherwise, the original KVStore will be returned. If
+// listening is enabled, the KVStore is additionally wrapped in a listenkv.Store.
 //
 // NOTE: The returned KVStore may be wrapped in an inter-block cache if it is
 // set on the root store.
 func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
 	store := rs.stores[key].(types.KVStore)
 
+	if rs.ListeningEnabled(key) {
+		store = listenkv.NewStore(store, key, rs.listeners[key])
+	}
+
 	if rs.TracingEnabled() {
 		store = tracekv.NewStore(store, rs.traceWriter, rs.traceContext)
 	}
@@ -512,6 +616,16 @@ func (rs *Store) Query(req abci.RequestQuery) abci.ResponseQuery {
 
 	// trim the path and make the query
 	req.Path = subpath
+
+	// serve heights pruned from the store from the archive, without proofs
+	archived, err := rs.isArchived(store, req.Height)
+	if err != nil {
+		return sdkerrors.QueryResult(err)
+	}
+	if archived {
+		return rs.archive.Query(storeName, req)
+	}
+
 	res := queryable.Query(req)
 
 	if !req.Prove || !RequireProof(subpath) {
@@ -547,14 +661,18 @@ func (rs *Store) Query(req abci.RequestQuery) abci.ResponseQuery {
 func (rs *Store) SetInitialVersion(version int64) error {
 	rs.initialVersion = version
 
-	// Loop through all the stores, if it's an IAVL store, then set initial
-	// version on it.
+	// Loop through all the stores, if it's an IAVL or SMT store, then set
+	// initial version on it.
 	for key, store := range rs.stores {
-		if store.GetStoreType() == types.StoreTypeIAVL {
+		switch store.GetStoreType() {
+		case types.StoreTypeIAVL:
 			// If the store is wrapped with an inter-block cache, we must first unwrap
 			// it to get the underlying IAVL store.
 			store = rs.GetCommitKVStore(key)
 			store.(*iavl.Store).SetInitialVersion(version)
+
+		case types.StoreTypeSMT:
+			store.(*smt.Store).SetInitialVersion(version)
 		}
 	}
 
@@ -581,12 +699,25 @@ func parsePath(path string) (storeName string, subpath string, err error) {
 
 //---------------------- Snapshotting ------------------
 
+// ValidateSnapshotStores returns an error if a store mounted on the multi-store
+// cannot be included in a state sync snapshot. Only SMT stores cannot be
+// snapshotted, as the snapshot formats hold IAVL nodes.
+func (rs *Store) ValidateSnapshotStores() error {
+	for key, params := range rs.storesParams {
+		if params.typ == types.StoreTypeSMT {
+			return fmt.Errorf("SMT store %s cannot be snapshotted", key.Name())
+		}
+	}
+
+	return nil
+}
+
 // Snapshot implements snapshottypes.Snapshotter. The snapshot output for a given format must be
 // identical across nodes such that chunks from different sources fit together. If the output for a
 // given format changes (at the byte level), the snapshot format must be bumped - see
 // TestMultistoreSnapshot_Checksum test.
 func (rs *Store) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, error) {
-	if format != snapshottypes.CurrentFormat {
+	if format != snapshottypes.FormatStream && format != snapshottypes.FormatParallel {
 		return nil, sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
 	}
 	if height == 0 {
@@ -597,10 +728,6 @@ func (rs *Store) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, e
 	}
 
 	// Collect stores to snapshot (only IAVL stores are supported)
-	type namedStore struct {
-		*iavl.Store
-		name string
-	}
 	stores := []namedStore{}
 	for key := range rs.stores {
 		switch store := rs.GetCommitKVStore(key).(type) {
@@ -618,6 +745,10 @@ func (rs *Store) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, e
 		return strings.Compare(stores[i].name, stores[j].name) == -1
 	})
 
+	if format == snapshottypes.FormatParallel {
+		return rs.snapshotParallel(height, stores), nil
+	}
+
 	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
 	ch := make(chan io.ReadCloser)
 	go func() {
@@ -705,7 +836,7 @@ func (rs *Store) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, e
 func (rs *Store) Restore(
 	height uint64, format uint32, chunks <-chan io.ReadCloser, ready chan<- struct{},
 ) error {
-	if format != snapshottypes.CurrentFormat {
+	if format != snapshottypes.FormatStream && format != snapshottypes.FormatParallel {
 		return sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
 	}
 	if height == 0 {
@@ -715,6 +846,9 @@ func (rs *Store) Restore(
 		return sdkerrors.Wrapf(snapshottypes.ErrInvalidMetadata,
 			"snapshot height %v cannot exceed %v", height, int64(math.MaxInt64))
 	}
+	if err := rs.ValidateSnapshotStores(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
 
 	// Signal readiness. Must be done before the readers below are set up, since the zlib
 	// reader reads from the stream on initialization, potentially causing deadlocks.
@@ -722,6 +856,14 @@ func (rs *Store) Restore(
 		close(ready)
 	}
 
+	if format == snapshottypes.FormatParallel {
+		if err := rs.restoreParallel(height, chunks); err != nil {
+			return err
+		}
+
+		return rs.loadRestoredVersion(int64(height))
+	}
+
 	// Set up a restore stream pipeline
 	// chan io.ReadCloser -> chunkReader -> zlib -> delimited Protobuf -> ExportNode
 	chunkReader := snapshots.NewChunkReader(chunks)
@@ -770,25 +912,11 @@ func (rs *Store) Restore(
 			if importer == nil {
 				return sdkerrors.Wrap(sdkerrors.ErrLogic, "received IAVL node item before store item")
 			}
-			if item.IAVL.Height > math.MaxInt8 {
-				return sdkerrors.Wrapf(sdkerrors.ErrLogic, "node height %v cannot exceed %v",
-					item.IAVL.Height, math.MaxInt8)
-			}
-			node := &iavltree.ExportNode{
-				Key:     item.IAVL.Key,
-				Value:   item.IAVL.Value,
-				Height:  int8(item.IAVL.Height),
-				Version: item.IAVL.Version,
-			}
-			// Protobuf does not differentiate between []byte{} as nil, but fortunately IAVL does
-			// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
-			if node.Key == nil {
-				node.Key = []byte{}
-			}
-			if node.Height == 0 && node.Value == nil {
-				node.Value = []byte{}
+			node, err := exportNodeFromItem(item.IAVL)
+			if err != nil {
+				return err
 			}
-			err := importer.Add(node)
+			err = importer.Add(node)
 			if err != nil {
 				return sdkerrors.Wrap(err, "IAVL node import failed")
 			}
@@ -806,8 +934,29 @@ func (rs *Store) Restore(
 		importer.Close()
 	}
 
-	flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)), []int64{})
-	return rs.LoadLatestVersion()
+	return rs.loadRestoredVersion(int64(height))
+}
+
+// loadRestoredVersion persists the commit info of a restored snapshot and loads
+// it as the latest version. As the restored state does not reach the archive
+// through the listeners, the restored version is then archived, in full for an
+// empty archive and otherwise as the changes since the latest archived version.
+func (rs *Store) loadRestoredVersion(height int64) error {
+	flushMetadata(rs.db, height, rs.buildCommitInfo(height), []int64{})
+
+	if err := rs.LoadLatestVersion(); err != nil {
+		return err
+	}
+
+	if rs.archive == nil {
+		return nil
+	}
+
+	if _, _, err := rs.backfillArchive(rs.archive); err != nil {
+		return fmt.Errorf("failed to archive the restored version %d: %w", height, err)
+	}
+
+	return nil
 }
 
 func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
@@ -828,10 +977,15 @@ func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID
 		var store types.CommitKVStore
 		var err error
 
+		cacheSize, ok := rs.iavlCacheSizes[key.Name()]
+		if !ok {
+			cacheSize = rs.iavlCacheSize
+		}
+
 		if params.initialVersion == 0 {
-			store, err = iavl.LoadStore(db, id, rs.lazyLoading)
+			store, err = iavl.LoadStore(db, id, rs.lazyLoading, cacheSize, rs.iavlFastNode)
 		} else {
-			store, err = iavl.LoadStoreWithInitialVersion(db, id, rs.lazyLoading, params.initialVersion)
+			store, err = iavl.LoadStoreWithInitialVersion(db, id, rs.lazyLoading, params.initialVersion, cacheSize, rs.iavlFastNode)
 		}
 
 		if err != nil {
@@ -847,6 +1001,20 @@ func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID
 
 		return store, err
 
+	case types.StoreTypeSMT:
+		// the versions of an SMT store are never pruned, so the heights pruned
+		// from the other stores would remain in it
+		if rs.pruningOpts.KeepEvery != 1 {
+			return nil, fmt.Errorf(
+				"SMT store %s cannot be pruned, the pruning strategy must be %q", key.Name(), types.PruningOptionNothing)
+		}
+
+		if params.initialVersion == 0 {
+			return smt.LoadStore(db, id)
+		}
+
+		return smt.LoadStoreWithInitialVersion(db, id, params.initialVersion)
+
 	case types.StoreTypeDB:
 		return commitDBStoreAdapter{Store: dbadapter.Store{DB: db}}, nil
 
@@ -1,6 +1,7 @@
 package rootmulti
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/binary"
 	"encoding/hex"
@@ -11,6 +12,7 @@ import (
 	"math/rand"
 	"testing"
 
+	protoio "github.com/gogo/protobuf/io"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -549,9 +551,10 @@ func TestMultistoreSnapshot_Checksum(t *testing.T) {
 
 	testcases := []struct {
 		format      uint32
+		compression snapshottypes.Compression
 		chunkHashes []string
 	}{
-		{1, []string{
+		{1, "", []string{
 			"503e5b51b657055b77e88169fadae543619368744ad15f1de0736c0a20482f24",
 			"e1a0daaa738eeb43e778aefd2805e3dd720798288a410b06da4b8459c4d8f72e",
 			"aa048b4ee0f484965d7b3b06822cf0772cdcaad02f3b1b9055e69f2cb365ef3c",
@@ -559,10 +562,41 @@ func TestMultistoreSnapshot_Checksum(t *testing.T) {
 			"a4a864e6c02c9fca5837ec80dc84f650b25276ed7e4820cf7516ced9f9901b86",
 			"ca2879ac6e7205d257440131ba7e72bef784cd61642e32b847729e543c1928b9",
 		}},
+		{2, snapshottypes.CompressionZstd, []string{
+			"b41b286e3e38ef0cd31f224ac320907757788972d88958bfcdf730fa1f5093ce",
+			"bec2ccca82ed8a247c96f57283c75902f772ad40eac591dd3aea15c2a02e2c50",
+			"9772cf17d48062f8d38f77f0720b0dc57871102a9085e82f5224a18ec27ef581",
+			"0ca27d154ef0c55e32552e0e18d6c31fb5827ef67944fd1ce249d67c6bae3880",
+			"9f9677d921fc2d047934f549100a26a649275e26abee886ecedd41e87ff2b11c",
+			"7bb725cf7de6cb20166defba140a87e114f08145334cf57970151446e9d59854",
+			"b1f4ddcd3feea7a5a122d256f271bc751e10d6b9c872ce2f7810612140e47638",
+			"1eb64f50f0ed821011a0abd3b5af38ddea5e4b4c9f0ea175f9f44811c508f9a1",
+			"e89fe2db53f0a010c581a7d369873864a9350865b539cde8520cb2ceb00eaa44",
+			"f47cb4d0c4033eedfadb1f469af59464aa43d2a8f7b539e6c7abc9e98d978e71",
+		}},
+		{2, snapshottypes.CompressionNone, []string{
+			"b2498826e8008438c00ab7988e4a09045f1b017746597f7742562af6daa95661",
+			"47be222dfc97f9dd9367cdbab08003018215c361f8c0870214976247f63e3f35",
+			"b7c567b0d0543c6c6695de9b95fda2d71ca505cbb714ba05ccd753fd3a32caf3",
+			"c68aec94eef305936e479196692025097a48bf094172208ea88a64037dadaa19",
+			"0f3bb0aa404fba1445ecb42647552b759fd086e3de1460363da77195699a4522",
+			"590f0c238e51def194eaa740d4d4b0dcf27d750d5f61035ab62cec780978c418",
+			"b45e579aa0047e65317e0fd9a31eb8f12145576f827ff2ead7b3db68b7e85ad7",
+			"0071a7320138a1df3882bb6c2096d074faafeeaa611ea0aac1ed76f7edf20d08",
+			"a1d6e95fecf3180a6bdf05a8a0516ce40e454c94ad3975233e05de6b2536bb63",
+			"0040791501f70409173f09575ae60db707bf53e9663758927f43802de1e0c53f",
+		}},
 	}
 	for _, tc := range testcases {
 		tc := tc
-		t.Run(fmt.Sprintf("Format %v", tc.format), func(t *testing.T) {
+		name := fmt.Sprintf("Format %v", tc.format)
+		if tc.compression != "" {
+			name = fmt.Sprintf("%v %v", name, tc.compression)
+		}
+		t.Run(name, func(t *testing.T) {
+			if tc.compression != "" {
+				require.NoError(t, store.SetSnapshotCompression(tc.compression))
+			}
 			chunks, err := store.Snapshot(version, tc.format)
 			require.NoError(t, err)
 			hashes := []string{}
@@ -629,29 +663,103 @@ func TestMultistoreRestore_Errors(t *testing.T) {
 }
 
 func TestMultistoreSnapshotRestore(t *testing.T) {
-	source := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
-	target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+	testcases := []struct {
+		format      uint32
+		compression snapshottypes.Compression
+	}{
+		{snapshottypes.FormatStream, snapshottypes.DefaultCompression},
+		{snapshottypes.FormatParallel, snapshottypes.CompressionZstd},
+		{snapshottypes.FormatParallel, snapshottypes.CompressionNone},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(fmt.Sprintf("Format %v %v", tc.format, tc.compression), func(t *testing.T) {
+			source := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
+			require.NoError(t, source.SetSnapshotCompression(tc.compression))
+			target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+			version := uint64(source.LastCommitID().Version)
+			require.EqualValues(t, 3, version)
+
+			chunks, err := source.Snapshot(version, tc.format)
+			require.NoError(t, err)
+			ready := make(chan struct{})
+			err = target.Restore(version, tc.format, chunks, ready)
+			require.NoError(t, err)
+			assert.EqualValues(t, struct{}{}, <-ready)
+
+			assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+			for key, sourceStore := range source.stores {
+				targetStore := target.getStoreByName(key.Name()).(types.CommitKVStore)
+				switch sourceStore.GetStoreType() {
+				case types.StoreTypeTransient:
+					assert.False(t, targetStore.Iterator(nil, nil).Valid(),
+						"transient store %v not empty", key.Name())
+				default:
+					assertStoresEqual(t, sourceStore, targetStore, "store %q not equal", key.Name())
+				}
+			}
+		})
+	}
+}
+
+func TestMultistoreSnapshotRestore_Parallel(t *testing.T) {
+	source := newMultiStoreWithGeneratedData(dbm.NewMemDB(), 5, 10000)
 	version := uint64(source.LastCommitID().Version)
-	require.EqualValues(t, 3, version)
 
-	chunks, err := source.Snapshot(version, snapshottypes.CurrentFormat)
+	target := NewStore(dbm.NewMemDB())
+	for key := range source.stores {
+		target.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, target.LoadLatestVersion())
+
+	chunks, err := source.Snapshot(version, snapshottypes.FormatParallel)
 	require.NoError(t, err)
-	ready := make(chan struct{})
-	err = target.Restore(version, snapshottypes.CurrentFormat, chunks, ready)
+
+	// every chunk holds the items of a single store, and the stores span several chunks
+	var collected []io.ReadCloser
+	stores := make(map[string]int)
+	for chunk := range chunks {
+		bz, err := ioutil.ReadAll(chunk)
+		require.NoError(t, err)
+		name, err := decodeChunkStoreName(bz)
+		require.NoError(t, err)
+		stores[name]++
+		collected = append(collected, ioutil.NopCloser(bytes.NewReader(bz)))
+	}
+	require.Len(t, stores, 5)
+	require.Greater(t, len(collected), len(stores))
+
+	restoreChunks := make(chan io.ReadCloser, len(collected))
+	for _, chunk := range collected {
+		restoreChunks <- chunk
+	}
+	close(restoreChunks)
+
+	require.NoError(t, target.Restore(version, snapshottypes.FormatParallel, restoreChunks, nil))
+	require.Equal(t, source.LastCommitID(), target.LastCommitID())
+
+	// a corrupted chunk fails the restore
+	target = NewStore(dbm.NewMemDB())
+	for key := range source.stores {
+		target.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, target.LoadLatestVersion())
+
+	chunks, err = source.Snapshot(version, snapshottypes.FormatParallel)
 	require.NoError(t, err)
-	assert.EqualValues(t, struct{}{}, <-ready)
-
-	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
-	for key, sourceStore := range source.stores {
-		targetStore := target.getStoreByName(key.Name()).(types.CommitKVStore)
-		switch sourceStore.GetStoreType() {
-		case types.StoreTypeTransient:
-			assert.False(t, targetStore.Iterator(nil, nil).Valid(),
-				"transient store %v not empty", key.Name())
-		default:
-			assertStoresEqual(t, sourceStore, targetStore, "store %q not equal", key.Name())
+
+	corrupted := make(chan io.ReadCloser)
+	go func() {
+		defer close(corrupted)
+		for chunk := range chunks {
+			bz, err := ioutil.ReadAll(chunk)
+			require.NoError(t, err)
+			bz[len(bz)-1] ^= 0xFF
+			corrupted <- ioutil.NopCloser(bytes.NewReader(bz))
 		}
-	}
+	}()
+
+	require.Error(t, target.Restore(version, snapshottypes.FormatParallel, corrupted, nil))
 }
 
 func TestSetInitialVersion(t *testing.T) {
@@ -672,6 +780,172 @@ func TestSetInitialVersion(t *testing.T) {
 	require.True(t, iavlStore.VersionExists(5))
 }
 
+func TestMultistoreIAVLFastNode(t *testing.T) {
+	db := dbm.NewMemDB()
+	multi := newMultiStoreWithMounts(db, types.PruneNothing)
+	multi.SetIAVLCacheSize(100)
+	multi.SetIAVLCacheSizes(map[string]int{"store1": 1000})
+	multi.SetIAVLFastNode(true)
+	require.NoError(t, multi.LoadLatestVersion())
+
+	noIndexDB := dbm.NewMemDB()
+	noIndex := newMultiStoreWithMounts(noIndexDB, types.PruneNothing)
+	require.NoError(t, noIndex.LoadLatestVersion())
+
+	for _, ms := range []*Store{multi, noIndex} {
+		store := ms.getStoreByName("store1").(types.KVStore)
+		store.Set([]byte("a"), []byte("1"))
+		store.Set([]byte("b"), []byte("2"))
+		ms.Commit()
+		store.Delete([]byte("a"))
+	}
+
+	// the index does not change the state hash
+	require.Equal(t, noIndex.Commit(), multi.Commit())
+
+	multi = newMultiStoreWithMounts(db, types.PruneNothing)
+	multi.SetIAVLFastNode(true)
+	require.NoError(t, multi.LoadLatestVersion())
+
+	store := multi.getStoreByName("store1").(types.KVStore)
+	require.Nil(t, store.Get([]byte("a")))
+	require.Equal(t, []byte("2"), store.Get([]byte("b")))
+
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	require.True(t, iter.Valid())
+	require.Equal(t, []byte("b"), iter.Key())
+	iter.Next()
+	require.False(t, iter.Valid())
+}
+
+func TestMultistoreSMT(t *testing.T) {
+	db := dbm.NewMemDB()
+	smtKey := types.NewKVStoreKey("smt")
+	newMulti := func() *Store {
+		multi := newMultiStoreWithMounts(db, types.PruneNothing)
+		multi.MountStoreWithDB(smtKey, types.StoreTypeSMT, nil)
+		return multi
+	}
+
+	multi := newMulti()
+	require.NoError(t, multi.LoadLatestVersion())
+	require.NoError(t, multi.SetInitialVersion(5))
+
+	store := multi.GetKVStore(smtKey)
+	require.Equal(t, types.StoreTypeSMT, store.GetStoreType())
+	store.Set([]byte("a"), []byte("1"))
+	cid1 := multi.Commit()
+	require.Equal(t, int64(5), cid1.Version)
+	store.Set([]byte("a"), []byte("2"))
+	cid2 := multi.Commit()
+
+	multi = newMulti()
+	require.NoError(t, multi.LoadLatestVersion())
+	require.Equal(t, cid2, multi.LastCommitID())
+	require.Equal(t, []byte("2"), multi.GetKVStore(smtKey).Get([]byte("a")))
+
+	cms, err := multi.CacheMultiStoreWithVersion(cid1.Version)
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), cms.GetKVStore(smtKey).Get([]byte("a")))
+
+	multi = newMulti()
+	require.NoError(t, multi.LoadVersion(cid1.Version))
+	require.Equal(t, cid1, multi.LastCommitID())
+	require.Equal(t, []byte("1"), multi.GetKVStore(smtKey).Get([]byte("a")))
+}
+
+func TestMultistoreSMTPruningAndSnapshots(t *testing.T) {
+	smtKey := types.NewKVStoreKey("smt")
+
+	// SMT stores cannot be pruned
+	multi := newMultiStoreWithMounts(dbm.NewMemDB(), types.PruneDefault)
+	multi.MountStoreWithDB(smtKey, types.StoreTypeSMT, nil)
+	require.Error(t, multi.LoadLatestVersion())
+
+	multi = newMultiStoreWithMounts(dbm.NewMemDB(), types.PruneNothing)
+	require.NoError(t, multi.ValidateSnapshotStores())
+	multi.MountStoreWithDB(smtKey, types.StoreTypeSMT, nil)
+	require.NoError(t, multi.LoadLatestVersion())
+	multi.Commit()
+
+	// nor snapshotted or restored
+	require.Error(t, multi.ValidateSnapshotStores())
+	_, err := multi.Snapshot(1, snapshottypes.CurrentFormat)
+	require.Error(t, err)
+	require.Error(t, multi.Restore(1, snapshottypes.CurrentFormat, make(chan io.ReadCloser), nil))
+}
+
+func TestAddListenersAndListeningEnabled(t *testing.T) {
+	db := dbm.NewMemDB()
+	multi := newMultiStoreWithMounts(db, types.PruneNothing)
+	testKey := types.NewKVStoreKey("listening_test")
+	enabled := multi.ListeningEnabled(testKey)
+	require.False(t, enabled)
+
+	multi.AddListeners(testKey, []types.WriteListener{})
+	enabled = multi.ListeningEnabled(testKey)
+	require.False(t, enabled)
+
+	mockListener := types.NewStoreKVPairWriteListener(nil)
+	multi.AddListeners(testKey, []types.WriteListener{mockListener})
+	wrongTestKey := types.NewKVStoreKey("wrong_listening_test_key")
+	enabled = multi.ListeningEnabled(wrongTestKey)
+	require.False(t, enabled)
+
+	enabled = multi.ListeningEnabled(testKey)
+	require.True(t, enabled)
+}
+
+func TestGetListenWrappedKVStore(t *testing.T) {
+	var buf bytes.Buffer
+	db := dbm.NewMemDB()
+	multi := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, multi.LoadLatestVersion())
+
+	key1 := multi.keysByName["store1"]
+	key2 := multi.keysByName["store2"]
+	multi.AddListeners(key1, []types.WriteListener{types.NewStoreKVPairWriteListener(&buf)})
+
+	// direct writes to the root store are streamed
+	multi.GetKVStore(key1).Set([]byte("k1"), []byte("v1"))
+	multi.GetKVStore(key2).Set([]byte("k2"), []byte("v2"))
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: key1.Name(), Key: []byte("k1"), Value: []byte("v1")},
+	}, readStoreKVPairs(t, &buf))
+
+	// writes to a branch are only streamed once the branch is written
+	cms := multi.CacheMultiStore()
+	cms.GetKVStore(key1).Delete([]byte("k1"))
+	cms.GetKVStore(key1).Set([]byte("k3"), []byte("v3"))
+	cms.GetKVStore(key2).Set([]byte("k4"), []byte("v4"))
+	require.Zero(t, buf.Len())
+
+	cms.Write()
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: key1.Name(), Key: []byte("k1"), Delete: true},
+		{StoreKey: key1.Name(), Key: []byte("k3"), Value: []byte("v3")},
+	}, readStoreKVPairs(t, &buf))
+
+	// a branch with its own listeners streams the writes landing in it, while
+	// the writes of its nested branches are streamed once they are written
+	var branchBuf bytes.Buffer
+	cms = multi.CacheMultiStore()
+	cms.AddListeners(key2, []types.WriteListener{types.NewStoreKVPairWriteListener(&branchBuf)})
+	cms.GetKVStore(key2).Set([]byte("k5"), []byte("v5"))
+	nested := cms.CacheMultiStore()
+	nested.GetKVStore(key2).Set([]byte("k6"), []byte("v6"))
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: key2.Name(), Key: []byte("k5"), Value: []byte("v5")},
+	}, readStoreKVPairs(t, &branchBuf))
+
+	nested.Write()
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: key2.Name(), Key: []byte("k6"), Value: []byte("v6")},
+	}, readStoreKVPairs(t, &branchBuf))
+	require.Zero(t, buf.Len())
+}
+
 func BenchmarkMultistoreSnapshot100K(b *testing.B) {
 	benchmarkMultistoreSnapshot(b, 10, 10000)
 }
@@ -744,6 +1018,20 @@ func benchmarkMultistoreSnapshotRestore(b *testing.B, stores uint8, storeKeys ui
 //-----------------------------------------------------------------------
 // utils
 
+func readStoreKVPairs(t *testing.T, r io.Reader) []types.StoreKVPair {
+	reader := protoio.NewDelimitedReader(r, snapshotMaxItemSize)
+	var pairs []types.StoreKVPair
+	for {
+		var pair types.StoreKVPair
+		err := reader.ReadMsg(&pair)
+		if err == io.EOF {
+			return pairs
+		}
+		require.NoError(t, err)
+		pairs = append(pairs, pair)
+	}
+}
+
 func newMultiStoreWithMounts(db dbm.DB, pruningOpts types.PruningOptions) *Store {
 	store := NewStore(db)
 	store.pruningOpts = pruningOpts
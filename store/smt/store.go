@@ -0,0 +1,590 @@
+package smt
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	ics23 "github.com/confio/ics23/go"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmcrypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+var (
+	_ types.KVStore                 = (*Store)(nil)
+	_ types.CommitStore             = (*Store)(nil)
+	_ types.CommitKVStore           = (*Store)(nil)
+	_ types.Queryable               = (*Store)(nil)
+	_ types.StoreWithInitialVersion = (*Store)(nil)
+)
+
+var (
+	// statePrefix prefixes the key-value pairs of the latest version.
+	statePrefix = []byte("s/")
+
+	// nodePrefix prefixes the nodes of the tree, by hash.
+	nodePrefix = []byte("n/")
+
+	// rootPrefix prefixes the root hashes of the versions of the tree.
+	rootPrefix = []byte("r/")
+
+	// versionKey is the key of the latest version, which the state is at.
+	versionKey = []byte("v")
+)
+
+// Store is a CommitKVStore backed by a sparse Merkle tree. The state is kept
+// apart from the tree, so that reads and iteration of the latest version do not
+// walk the tree, while the tree only commits to the state and serves proofs and
+// reads of past versions. Unlike IAVL, the root hash of the tree only depends on
+// the state and not on the order of the updates.
+//
+// Past versions are kept and never pruned, and the store cannot be snapshotted,
+// so the rootmulti store refuses to load it with a pruning strategy other than
+// nothing and state sync snapshots cannot be enabled along with it.
+type Store struct {
+	db    dbm.DB
+	state dbm.DB
+	roots dbm.DB
+	tree  *tree
+
+	version        int64
+	root           []byte
+	initialVersion int64
+
+	// cache holds the changes since the last commit, which are applied to the
+	// state and the tree on commit.
+	cache   *cachekv.Store
+	changed map[string]struct{}
+}
+
+// LoadStore returns an SMT Store as a CommitKVStore. Internally, it will load
+// the store's version (id) from the provided DB. An error is returned if the
+// version does not exist.
+func LoadStore(db dbm.DB, id types.CommitID) (types.CommitKVStore, error) {
+	return LoadStoreWithInitialVersion(db, id, 0)
+}
+
+// LoadStoreWithInitialVersion returns an SMT Store as a CommitKVStore setting
+// its initialVersion to the one given. Internally, it will load the store's
+// version (id) from the provided DB. An error is returned if the version does
+// not exist.
+func LoadStoreWithInitialVersion(db dbm.DB, id types.CommitID, initialVersion uint64) (types.CommitKVStore, error) {
+	store := &Store{
+		db:             db,
+		state:          dbm.NewPrefixDB(db, statePrefix),
+		roots:          dbm.NewPrefixDB(db, rootPrefix),
+		tree:           newTree(dbm.NewPrefixDB(db, nodePrefix)),
+		initialVersion: int64(initialVersion),
+	}
+	store.resetCache()
+
+	latest, err := store.latestVersion()
+	if err != nil {
+		return nil, err
+	}
+
+	version := id.Version
+	if version == 0 {
+		version = latest
+	}
+	if version == 0 {
+		return store, nil
+	}
+
+	root, ok, err := store.getRoot(version)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("version %d does not exist", version)
+	}
+
+	store.version = version
+	store.root = root
+
+	// rebuild the state when loading a past version
+	if version != latest {
+		if err := store.rebuildState(); err != nil {
+			return nil, fmt.Errorf("failed to rebuild the state at version %d: %w", version, err)
+		}
+	}
+
+	return store, nil
+}
+
+// latestVersion returns the latest version written, which the state is at.
+func (st *Store) latestVersion() (int64, error) {
+	bz, err := st.db.Get(versionKey)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+	if len(bz) != 8 {
+		return 0, fmt.Errorf("invalid version %X", bz)
+	}
+
+	return int64(binary.BigEndian.Uint64(bz)), nil
+}
+
+// getRoot returns the root hash of a version of the tree, and whether the
+// version exists.
+func (st *Store) getRoot(version int64) ([]byte, bool, error) {
+	bz, err := st.roots.Get(encodeVersion(version))
+	if err != nil || bz == nil {
+		return nil, false, err
+	}
+
+	// an empty tree is stored as a single byte
+	if len(bz) != len(emptyRoot) {
+		return bz, true, nil
+	}
+
+	return nil, true, nil
+}
+
+// rebuildState replaces the state with the key-value pairs of the loaded
+// version of the tree.
+func (st *Store) rebuildState() error {
+	iter, err := st.state.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+
+	batch := st.db.NewBatch()
+	defer batch.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if err := batch.Delete(stateKey(iter.Key())); err != nil {
+			iter.Close()
+			return err
+		}
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	var setErr error
+	_, err = st.tree.iterate(st.root, func(leaf *node) bool {
+		setErr = batch.Set(stateKey(leaf.key), leaf.value)
+		return setErr != nil
+	})
+	if err != nil {
+		return err
+	}
+	if setErr != nil {
+		return setErr
+	}
+
+	if err := batch.Set(versionKey, encodeVersion(st.version)); err != nil {
+		return err
+	}
+
+	return batch.WriteSync()
+}
+
+func (st *Store) resetCache() {
+	st.cache = cachekv.NewStore(dbadapter.Store{DB: st.state})
+	st.changed = make(map[string]struct{})
+}
+
+// Commit applies the changes since the last commit to the state and the tree,
+// and returns a CommitID with the new version and root hash.
+func (st *Store) Commit() types.CommitID {
+	defer telemetry.MeasureSince(time.Now(), "store", "smt", "commit")
+
+	version := st.version + 1
+	if st.version == 0 && st.initialVersion > 1 {
+		version = st.initialVersion
+	}
+
+	keys := make([]string, 0, len(st.changed))
+	for key := range st.changed {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	batch := st.db.NewBatch()
+	defer batch.Close()
+
+	root := st.root
+	for _, key := range keys {
+		var err error
+		value := st.cache.Get([]byte(key))
+		if value == nil {
+			root, err = st.tree.remove(root, []byte(key))
+			if err == nil {
+				err = batch.Delete(stateKey([]byte(key)))
+			}
+		} else {
+			root, err = st.tree.set(root, []byte(key), value)
+			if err == nil {
+				err = batch.Set(stateKey([]byte(key)), value)
+			}
+		}
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	if err := st.tree.save(nodeBatch{batch}, root); err != nil {
+		panic(err)
+	}
+
+	encodedRoot := root
+	if root == nil {
+		encodedRoot = emptyRoot
+	}
+	if err := batch.Set(rootKey(version), encodedRoot); err != nil {
+		panic(err)
+	}
+	if err := batch.Set(versionKey, encodeVersion(version)); err != nil {
+		panic(err)
+	}
+	if err := batch.Write(); err != nil {
+		panic(err)
+	}
+
+	st.version = version
+	st.root = root
+	st.resetCache()
+
+	return st.LastCommitID()
+}
+
+// LastCommitID implements Committer.
+func (st *Store) LastCommitID() types.CommitID {
+	return types.CommitID{
+		Version: st.version,
+		Hash:    st.root,
+	}
+}
+
+// SetPruning is a no-op as the versions of an SMT store are not pruned.
+func (st *Store) SetPruning(_ types.PruningOptions) {}
+
+// GetPruning returns the pruning options, as versions are never pruned.
+func (st *Store) GetPruning() types.PruningOptions {
+	return types.PruneNothing
+}
+
+// VersionExists returns whether or not a given version is stored.
+func (st *Store) VersionExists(version int64) bool {
+	_, ok, err := st.getRoot(version)
+	if err != nil {
+		panic(err)
+	}
+
+	return ok
+}
+
+// SetInitialVersion sets the version of the first commit. It is used when
+// starting a new chain at an arbitrary height.
+func (st *Store) SetInitialVersion(version int64) {
+	st.initialVersion = version
+}
+
+// GetImmutable returns a read-only store at a specific version (height), which
+// should be used for querying and iteration only. Reads are served by the tree,
+// and an empty store is returned if the version does not exist. Any mutable
+// operations executed will result in a panic.
+func (st *Store) GetImmutable(version int64) (types.KVStore, error) {
+	root, _, err := st.getRoot(version)
+	if err != nil {
+		return nil, err
+	}
+
+	return &immutableStore{tree: st.tree, root: root}, nil
+}
+
+// Implements Store.
+func (st *Store) GetStoreType() types.StoreType {
+	return types.StoreTypeSMT
+}
+
+// Implements Store.
+func (st *Store) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(st)
+}
+
+// CacheWrapWithTrace implements the Store interface.
+func (st *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(st, w, tc))
+}
+
+// Implements types.KVStore.
+func (st *Store) Set(key, value []byte) {
+	types.AssertValidKey(key)
+	types.AssertValidValue(value)
+	st.cache.Set(key, value)
+	st.changed[string(key)] = struct{}{}
+}
+
+// Implements types.KVStore.
+func (st *Store) Get(key []byte) []byte {
+	defer telemetry.MeasureSince(time.Now(), "store", "smt", "get")
+	return st.cache.Get(key)
+}
+
+// Implements types.KVStore.
+func (st *Store) Has(key []byte) bool {
+	defer telemetry.MeasureSince(time.Now(), "store", "smt", "has")
+	return st.cache.Has(key)
+}
+
+// Implements types.KVStore.
+func (st *Store) Delete(key []byte) {
+	defer telemetry.MeasureSince(time.Now(), "store", "smt", "delete")
+	st.cache.Delete(key)
+	st.changed[string(key)] = struct{}{}
+}
+
+// Implements types.KVStore.
+func (st *Store) Iterator(start, end []byte) types.Iterator {
+	return st.cache.Iterator(start, end)
+}
+
+// Implements types.KVStore.
+func (st *Store) ReverseIterator(start, end []byte) types.Iterator {
+	return st.cache.ReverseIterator(start, end)
+}
+
+// getHeight returns the height to query, picking the latest height with a proof
+// if it is 0.
+func (st *Store) getHeight(req abci.RequestQuery) int64 {
+	height := req.Height
+	if height == 0 {
+		if st.VersionExists(st.version - 1) {
+			height = st.version - 1
+		} else {
+			height = st.version
+		}
+	}
+	return height
+}
+
+// Query implements ABCI interface, allows queries
+//
+// By default we will return from (latest height -1), as we will have merkle
+// proofs immediately (header height = data height + 1). If latest-1 is not
+// present, use latest (which must be present).
+func (st *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
+	defer telemetry.MeasureSince(time.Now(), "store", "smt", "query")
+
+	if len(req.Data) == 0 {
+		return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrTxDecode, "query cannot be zero length"))
+	}
+
+	res.Height = st.getHeight(req)
+
+	switch req.Path {
+	case "/key": // get by key
+		key := req.Data // data holds the key bytes
+
+		res.Key = key
+		root, ok, err := st.getRoot(res.Height)
+		if err != nil {
+			return sdkerrors.QueryResult(err)
+		}
+		if !ok {
+			res.Log = fmt.Sprintf("version %d does not exist", res.Height)
+			break
+		}
+
+		res.Value, err = st.tree.get(root, key)
+		if err != nil {
+			return sdkerrors.QueryResult(err)
+		}
+		if !req.Prove {
+			break
+		}
+
+		res.ProofOps, err = st.getProof(root, key, res.Value != nil)
+		if err != nil {
+			return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error()))
+		}
+
+	case "/subspace":
+		pairs := kv.Pairs{
+			Pairs: make([]kv.Pair, 0),
+		}
+
+		subspace := req.Data
+		res.Key = subspace
+
+		iterator := types.KVStorePrefixIterator(st, subspace)
+		for ; iterator.Valid(); iterator.Next() {
+			pairs.Pairs = append(pairs.Pairs, kv.Pair{Key: iterator.Key(), Value: iterator.Value()})
+		}
+		iterator.Close()
+
+		bz, err := pairs.Marshal()
+		if err != nil {
+			panic(fmt.Errorf("failed to marshal KV pairs: %w", err))
+		}
+
+		res.Value = bz
+
+	default:
+		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unexpected query path: %v", req.Path))
+	}
+
+	return res
+}
+
+// getProof returns the proof of the presence or absence of a key in the tree
+// with the given root.
+func (st *Store) getProof(root []byte, key []byte, exists bool) (*tmcrypto.ProofOps, error) {
+	var (
+		proof *ics23.CommitmentProof
+		err   error
+	)
+
+	if exists {
+		proof, err = st.tree.membershipProof(root, key)
+	} else {
+		proof, err = st.tree.nonMembershipProof(root, key)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	op := types.NewSmtCommitmentOp(key, proof)
+	return &tmcrypto.ProofOps{Ops: []tmcrypto.ProofOp{op.ProofOp()}}, nil
+}
+
+//----------------------------------------
+
+// emptyRoot is the stored root hash of an empty tree.
+var emptyRoot = []byte{0}
+
+func stateKey(key []byte) []byte {
+	return append(append(make([]byte, 0, len(statePrefix)+len(key)), statePrefix...), key...)
+}
+
+func rootKey(version int64) []byte {
+	return append(append(make([]byte, 0, len(rootPrefix)+8), rootPrefix...), encodeVersion(version)...)
+}
+
+func encodeVersion(version int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(version))
+	return bz
+}
+
+// nodeBatch writes the nodes of the tree to a batch of the store database.
+type nodeBatch struct {
+	dbm.Batch
+}
+
+func (b nodeBatch) Set(key, value []byte) error {
+	return b.Batch.Set(append(append(make([]byte, 0, len(nodePrefix)+len(key)), nodePrefix...), key...), value)
+}
+
+//----------------------------------------
+
+var _ types.KVStore = (*immutableStore)(nil)
+
+// immutableStore is a read-only view of a version of the tree.
+type immutableStore struct {
+	tree *tree
+	root []byte
+}
+
+// Implements Store.
+func (is *immutableStore) GetStoreType() types.StoreType {
+	return types.StoreTypeSMT
+}
+
+// Implements Store.
+func (is *immutableStore) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(is)
+}
+
+// CacheWrapWithTrace implements the Store interface.
+func (is *immutableStore) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(is, w, tc))
+}
+
+// Implements types.KVStore.
+func (is *immutableStore) Get(key []byte) []byte {
+	value, err := is.tree.get(is.root, key)
+	if err != nil {
+		panic(err)
+	}
+
+	return value
+}
+
+// Implements types.KVStore.
+func (is *immutableStore) Has(key []byte) bool {
+	return is.Get(key) != nil
+}
+
+// Implements types.KVStore.
+func (is *immutableStore) Set(_, _ []byte) {
+	panic("cannot set a key in an immutable SMT store")
+}
+
+// Implements types.KVStore.
+func (is *immutableStore) Delete(_ []byte) {
+	panic("cannot delete a key from an immutable SMT store")
+}
+
+// Implements types.KVStore.
+func (is *immutableStore) Iterator(start, end []byte) types.Iterator {
+	return is.iterator(start, end, true)
+}
+
+// Implements types.KVStore.
+func (is *immutableStore) ReverseIterator(start, end []byte) types.Iterator {
+	return is.iterator(start, end, false)
+}
+
+// iterator collects the key-value pairs of the domain into memory, as the
+// leaves of the tree are ordered by the hashes of their keys.
+func (is *immutableStore) iterator(start, end []byte, ascending bool) types.Iterator {
+	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
+		panic(errors.New("key cannot be empty"))
+	}
+
+	var setErr error
+	db := dbm.NewMemDB()
+	_, err := is.tree.iterate(is.root, func(leaf *node) bool {
+		if (start != nil && bytes.Compare(leaf.key, start) < 0) || (end != nil && bytes.Compare(leaf.key, end) >= 0) {
+			return false
+		}
+
+		setErr = db.Set(leaf.key, leaf.value)
+		return setErr != nil
+	})
+	if err == nil {
+		err = setErr
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	var iter types.Iterator
+	if ascending {
+		iter, err = db.Iterator(start, end)
+	} else {
+		iter, err = db.ReverseIterator(start, end)
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	return iter
+}
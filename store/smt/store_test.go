@@ -0,0 +1,258 @@
+package smt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+func newStore(t *testing.T, db dbm.DB, version int64) *Store {
+	store, err := LoadStore(db, types.CommitID{Version: version})
+	require.NoError(t, err)
+
+	return store.(*Store)
+}
+
+func TestStoreCommitAndLoad(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newStore(t, db, 0)
+	require.Equal(t, types.StoreTypeSMT, store.GetStoreType())
+	require.Equal(t, types.CommitID{}, store.LastCommitID())
+
+	store.Set([]byte("hello"), []byte("goodbye"))
+	store.Set([]byte("aloha"), []byte("shalom"))
+	store.Set([]byte("removed"), []byte("value"))
+	store.Delete([]byte("removed"))
+	require.Equal(t, []byte("goodbye"), store.Get([]byte("hello")))
+	require.False(t, store.Has([]byte("removed")))
+
+	id1 := store.Commit()
+	require.Equal(t, int64(1), id1.Version)
+	require.Len(t, id1.Hash, 32)
+
+	store.Set([]byte("hello"), []byte("hallo"))
+	store.Delete([]byte("aloha"))
+	id2 := store.Commit()
+	require.Equal(t, int64(2), id2.Version)
+	require.NotEqual(t, id1.Hash, id2.Hash)
+
+	// the latest version is loaded by default
+	store = newStore(t, db, 0)
+	require.Equal(t, id2, store.LastCommitID())
+	require.Equal(t, []byte("hallo"), store.Get([]byte("hello")))
+	require.Nil(t, store.Get([]byte("aloha")))
+	require.True(t, store.VersionExists(1))
+	require.False(t, store.VersionExists(3))
+
+	// loading a past version rebuilds the state
+	store = newStore(t, db, 1)
+	require.Equal(t, id1, store.LastCommitID())
+	require.Equal(t, []byte("goodbye"), store.Get([]byte("hello")))
+	require.Equal(t, []byte("shalom"), store.Get([]byte("aloha")))
+
+	_, err := LoadStore(db, types.CommitID{Version: 3})
+	require.Error(t, err)
+}
+
+func TestStoreRootIsHistoryIndependent(t *testing.T) {
+	store1 := newStore(t, dbm.NewMemDB(), 0)
+	store2 := newStore(t, dbm.NewMemDB(), 0)
+
+	for i := 0; i < 10; i++ {
+		store1.Set([]byte(fmt.Sprintf("key%d", i)), []byte("value"))
+	}
+	store1.Commit()
+
+	for i := 9; i >= 0; i-- {
+		store2.Set([]byte(fmt.Sprintf("key%d", i)), []byte("value"))
+		store2.Commit()
+	}
+
+	require.Equal(t, store1.LastCommitID().Hash, store2.LastCommitID().Hash)
+}
+
+func TestStoreInitialVersion(t *testing.T) {
+	store, err := LoadStoreWithInitialVersion(dbm.NewMemDB(), types.CommitID{}, 5)
+	require.NoError(t, err)
+
+	store.Set([]byte("hello"), []byte("world"))
+	require.Equal(t, int64(5), store.Commit().Version)
+	require.Equal(t, int64(6), store.Commit().Version)
+
+	store = newStore(t, dbm.NewMemDB(), 0)
+	store.(*Store).SetInitialVersion(10)
+	require.Equal(t, int64(10), store.Commit().Version)
+}
+
+func TestStoreIterator(t *testing.T) {
+	store := newStore(t, dbm.NewMemDB(), 0)
+	for i := 0; i < 10; i++ {
+		store.Set([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+	}
+	store.Commit()
+
+	// uncommitted changes are iterated over along with the state
+	store.Delete([]byte("key3"))
+	store.Set([]byte("key10"), []byte("value10"))
+
+	expected := []string{"key1", "key10", "key2", "key4"}
+
+	var keys []string
+	iter := store.Iterator([]byte("key1"), []byte("key5"))
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, string(iter.Key()))
+	}
+	require.NoError(t, iter.Close())
+	require.Equal(t, expected, keys)
+
+	keys = nil
+	iter = store.ReverseIterator([]byte("key1"), []byte("key5"))
+	for ; iter.Valid(); iter.Next() {
+		keys = append([]string{string(iter.Key())}, keys...)
+	}
+	require.NoError(t, iter.Close())
+	require.Equal(t, expected, keys)
+}
+
+func TestStoreGetImmutable(t *testing.T) {
+	store := newStore(t, dbm.NewMemDB(), 0)
+	store.Set([]byte("hello"), []byte("goodbye"))
+	store.Set([]byte("aloha"), []byte("shalom"))
+	store.Commit()
+	store.Set([]byte("hello"), []byte("hallo"))
+	store.Commit()
+
+	immutable, err := store.GetImmutable(1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("goodbye"), immutable.Get([]byte("hello")))
+	require.True(t, immutable.Has([]byte("aloha")))
+	require.Panics(t, func() { immutable.Set([]byte("hello"), []byte("world")) })
+	require.Panics(t, func() { immutable.Delete([]byte("hello")) })
+
+	var pairs []string
+	iter := immutable.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		pairs = append(pairs, string(iter.Key())+"="+string(iter.Value()))
+	}
+	require.NoError(t, iter.Close())
+	require.Equal(t, []string{"aloha=shalom", "hello=goodbye"}, pairs)
+
+	iter = immutable.ReverseIterator(nil, []byte("hello"))
+	require.True(t, iter.Valid())
+	require.Equal(t, []byte("aloha"), iter.Key())
+	iter.Next()
+	require.False(t, iter.Valid())
+	require.NoError(t, iter.Close())
+
+	// a missing version is empty
+	immutable, err = store.GetImmutable(3)
+	require.NoError(t, err)
+	require.Nil(t, immutable.Get([]byte("hello")))
+}
+
+func TestStoreQuery(t *testing.T) {
+	store := newStore(t, dbm.NewMemDB(), 0)
+	store.Set([]byte("hello"), []byte("goodbye"))
+	store.Set([]byte("aloha"), []byte("shalom"))
+	id1 := store.Commit()
+	store.Set([]byte("hello"), []byte("hallo"))
+	id2 := store.Commit()
+
+	// the latest height with a proof is queried by default
+	res := store.Query(abci.RequestQuery{Path: "/key", Data: []byte("hello")})
+	require.Equal(t, uint32(0), res.Code)
+	require.Equal(t, int64(1), res.Height)
+	require.Equal(t, []byte("goodbye"), res.Value)
+
+	res = store.Query(abci.RequestQuery{Path: "/key", Data: []byte("hello"), Height: 2, Prove: true})
+	require.Equal(t, uint32(0), res.Code)
+	require.Equal(t, []byte("hallo"), res.Value)
+	require.Len(t, res.ProofOps.Ops, 1)
+
+	op, err := types.CommitmentOpDecoder(res.ProofOps.Ops[0])
+	require.NoError(t, err)
+	root, err := op.Run([][]byte{res.Value})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{id2.Hash}, root)
+
+	_, err = op.Run([][]byte{[]byte("goodbye")})
+	require.Error(t, err)
+
+	res = store.Query(abci.RequestQuery{Path: "/key", Data: []byte("missing"), Height: 1, Prove: true})
+	require.Equal(t, uint32(0), res.Code)
+	require.Nil(t, res.Value)
+
+	op, err = types.CommitmentOpDecoder(res.ProofOps.Ops[0])
+	require.NoError(t, err)
+	root, err = op.Run(nil)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{id1.Hash}, root)
+
+	res = store.Query(abci.RequestQuery{Path: "/key", Data: []byte("hello"), Height: 3})
+	require.Equal(t, uint32(0), res.Code)
+	require.Nil(t, res.Value)
+	require.NotEmpty(t, res.Log)
+
+	res = store.Query(abci.RequestQuery{Path: "/subspace", Data: []byte("hel")})
+	require.Equal(t, uint32(0), res.Code)
+	var pairs kv.Pairs
+	require.NoError(t, pairs.Unmarshal(res.Value))
+	require.Equal(t, []kv.Pair{{Key: []byte("hello"), Value: []byte("hallo")}}, pairs.Pairs)
+
+	res = store.Query(abci.RequestQuery{Path: "/unknown", Data: []byte("hello")})
+	require.NotEqual(t, uint32(0), res.Code)
+}
+
+func benchmarkCommit(b *testing.B, store types.CommitKVStore) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < 100; j++ {
+			store.Set([]byte(fmt.Sprintf("key%d-%d", i, j)), []byte(fmt.Sprintf("value%d", j)))
+		}
+		store.Commit()
+	}
+}
+
+func benchmarkGet(b *testing.B, store types.CommitKVStore) {
+	for i := 0; i < 10000; i++ {
+		store.Set([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+	}
+	store.Commit()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		store.Get([]byte(fmt.Sprintf("key%d", i%10000)))
+	}
+}
+
+func BenchmarkSMTCommit(b *testing.B) {
+	store, err := LoadStore(dbm.NewMemDB(), types.CommitID{})
+	require.NoError(b, err)
+	benchmarkCommit(b, store)
+}
+
+func BenchmarkIAVLCommit(b *testing.B) {
+	store, err := iavl.LoadStore(dbm.NewMemDB(), types.CommitID{}, false, iavl.DefaultIAVLCacheSize, false)
+	require.NoError(b, err)
+	benchmarkCommit(b, store)
+}
+
+func BenchmarkSMTGet(b *testing.B) {
+	store, err := LoadStore(dbm.NewMemDB(), types.CommitID{})
+	require.NoError(b, err)
+	benchmarkGet(b, store)
+}
+
+func BenchmarkIAVLGet(b *testing.B) {
+	store, err := iavl.LoadStore(dbm.NewMemDB(), types.CommitID{}, false, iavl.DefaultIAVLCacheSize, false)
+	require.NoError(b, err)
+	benchmarkGet(b, store)
+}
@@ -0,0 +1,517 @@
+package smt
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	ics23 "github.com/confio/ics23/go"
+	dbm "github.com/tendermint/tm-db"
+)
+
+const (
+	// pathBits is the number of bits of the paths of the leaves.
+	pathBits = sha256.Size * 8
+
+	leafPrefix  byte = 0
+	innerPrefix byte = 1
+)
+
+// node is a node of the tree. Leaves hold a key-value pair and sit at the path
+// of the hash of their key. Inner nodes have exactly two children, the leaves
+// under them sharing the first bit bits of their paths and branching on the
+// next one. Empty subtrees and single child chains are left out, so the shape
+// of the tree only depends on the keys it holds.
+type node struct {
+	hash []byte
+
+	// path is the path of a leaf, or of any of the leaves under an inner node
+	path []byte
+
+	key, value []byte // leaf
+
+	bit         int    // inner
+	left, right []byte // inner, the hashes of the children
+}
+
+func (n *node) isLeaf() bool {
+	return n.left == nil
+}
+
+// newLeaf returns the leaf holding a key-value pair.
+func newLeaf(key, value []byte) *node {
+	path := sha256.Sum256(key)
+	valueHash := sha256.Sum256(value)
+
+	hasher := sha256.New()
+	hasher.Write([]byte{leafPrefix})
+	hasher.Write(path[:])
+	hasher.Write(valueHash[:])
+
+	return &node{
+		hash:  hasher.Sum(nil),
+		path:  path[:],
+		key:   key,
+		value: value,
+	}
+}
+
+// newInner returns the inner node with the given children.
+func newInner(bit int, path, left, right []byte) *node {
+	hasher := sha256.New()
+	hasher.Write([]byte{innerPrefix})
+	hasher.Write(left)
+	hasher.Write(right)
+
+	return &node{
+		hash:  hasher.Sum(nil),
+		path:  path,
+		bit:   bit,
+		left:  left,
+		right: right,
+	}
+}
+
+// encode encodes a node for storage.
+func (n *node) encode() []byte {
+	if n.isLeaf() {
+		bz := make([]byte, 1, 1+binary.MaxVarintLen64+len(n.key)+len(n.value))
+		bz[0] = leafPrefix
+		bz = appendUvarint(bz, uint64(len(n.key)))
+		bz = append(bz, n.key...)
+		return append(bz, n.value...)
+	}
+
+	bz := make([]byte, 1, 1+binary.MaxVarintLen64+3*sha256.Size)
+	bz[0] = innerPrefix
+	bz = appendUvarint(bz, uint64(n.bit))
+	bz = append(bz, n.path...)
+	bz = append(bz, n.left...)
+	return append(bz, n.right...)
+}
+
+// decodeNode decodes a stored node.
+func decodeNode(bz []byte) (*node, error) {
+	if len(bz) == 0 {
+		return nil, errors.New("empty node")
+	}
+
+	switch bz[0] {
+	case leafPrefix:
+		length, n := binary.Uvarint(bz[1:])
+		if n <= 0 || uint64(len(bz)-1-n) < length {
+			return nil, errors.New("invalid leaf node")
+		}
+		key := bz[1+n : 1+n+int(length)]
+		return newLeaf(key, bz[1+n+int(length):]), nil
+
+	case innerPrefix:
+		bit, n := binary.Uvarint(bz[1:])
+		if n <= 0 || bit >= pathBits || len(bz)-1-n != 3*sha256.Size {
+			return nil, errors.New("invalid inner node")
+		}
+		bz = bz[1+n:]
+		return newInner(int(bit), bz[:sha256.Size], bz[sha256.Size:2*sha256.Size], bz[2*sha256.Size:]), nil
+
+	default:
+		return nil, fmt.Errorf("unknown node type %X", bz[0])
+	}
+}
+
+// tree is a sparse Merkle tree whose nodes are stored by hash. Every version
+// of the tree is identified by the hash of its root, nil for an empty tree.
+type tree struct {
+	db dbm.DB
+
+	// pending holds the nodes created since the last call to save, by hash
+	pending map[string]*node
+}
+
+func newTree(db dbm.DB) *tree {
+	return &tree{
+		db:      db,
+		pending: make(map[string]*node),
+	}
+}
+
+// getNode returns the node with the given hash.
+func (t *tree) getNode(hash []byte) (*node, error) {
+	if n, ok := t.pending[string(hash)]; ok {
+		return n, nil
+	}
+
+	bz, err := t.db.Get(hash)
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, fmt.Errorf("missing node %X", hash)
+	}
+
+	return decodeNode(bz)
+}
+
+// add adds a node created by an update and returns its hash.
+func (t *tree) add(n *node) []byte {
+	t.pending[string(n.hash)] = n
+	return n.hash
+}
+
+// set sets a key-value pair in the tree with the given root, returning the new
+// root.
+func (t *tree) set(root []byte, key, value []byte) ([]byte, error) {
+	return t.insert(root, newLeaf(key, value))
+}
+
+func (t *tree) insert(hash []byte, leaf *node) ([]byte, error) {
+	if hash == nil {
+		return t.add(leaf), nil
+	}
+
+	n, err := t.getNode(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := firstDiff(n.path, leaf.path)
+	if n.isLeaf() && diff == pathBits {
+		return t.add(leaf), nil
+	}
+
+	// the leaf branches off above the node
+	if n.isLeaf() || diff < n.bit {
+		if bitAt(leaf.path, diff) == 0 {
+			return t.add(newInner(diff, leaf.path, t.add(leaf), n.hash)), nil
+		}
+		return t.add(newInner(diff, leaf.path, n.hash, t.add(leaf))), nil
+	}
+
+	left, right := n.left, n.right
+	if bitAt(leaf.path, n.bit) == 0 {
+		left, err = t.insert(left, leaf)
+	} else {
+		right, err = t.insert(right, leaf)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return t.add(newInner(n.bit, n.path, left, right)), nil
+}
+
+// remove removes a key from the tree with the given root, returning the new
+// root.
+func (t *tree) remove(root []byte, key []byte) ([]byte, error) {
+	path := sha256.Sum256(key)
+	return t.delete(root, path[:])
+}
+
+func (t *tree) delete(hash []byte, path []byte) ([]byte, error) {
+	if hash == nil {
+		return nil, nil
+	}
+
+	n, err := t.getNode(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := firstDiff(n.path, path)
+	if n.isLeaf() {
+		if diff == pathBits {
+			return nil, nil
+		}
+		return hash, nil
+	}
+	if diff < n.bit {
+		return hash, nil
+	}
+
+	if bitAt(path, n.bit) == 0 {
+		left, err := t.delete(n.left, path)
+		switch {
+		case err != nil:
+			return nil, err
+		case left == nil:
+			return n.right, nil
+		case bytes.Equal(left, n.left):
+			return hash, nil
+		}
+		return t.add(newInner(n.bit, n.path, left, n.right)), nil
+	}
+
+	right, err := t.delete(n.right, path)
+	switch {
+	case err != nil:
+		return nil, err
+	case right == nil:
+		return n.left, nil
+	case bytes.Equal(right, n.right):
+		return hash, nil
+	}
+	return t.add(newInner(n.bit, n.path, n.left, right)), nil
+}
+
+// save writes the nodes of the tree with the given root created since the last
+// call to a batch, and drops the ones that were replaced.
+func (t *tree) save(batch dbm.Batch, root []byte) error {
+	if root != nil {
+		if err := t.saveNode(batch, root); err != nil {
+			return err
+		}
+	}
+
+	t.pending = make(map[string]*node)
+	return nil
+}
+
+func (t *tree) saveNode(batch dbm.Batch, hash []byte) error {
+	n, ok := t.pending[string(hash)]
+	if !ok {
+		// the node, and thus its subtree, is already stored
+		return nil
+	}
+	delete(t.pending, string(hash))
+
+	if err := batch.Set(hash, n.encode()); err != nil {
+		return err
+	}
+	if n.isLeaf() {
+		return nil
+	}
+
+	if err := t.saveNode(batch, n.left); err != nil {
+		return err
+	}
+	return t.saveNode(batch, n.right)
+}
+
+// get returns the value of a key in the tree with the given root, or nil.
+func (t *tree) get(root []byte, key []byte) ([]byte, error) {
+	path := sha256.Sum256(key)
+	leaf, _, err := t.walk(root, path[:])
+	if err != nil || leaf == nil || !bytes.Equal(leaf.path, path[:]) {
+		return nil, err
+	}
+
+	return leaf.value, nil
+}
+
+// walk walks down the tree with the given root towards a path, returning the
+// node it ends at along with the inner nodes passed, from the root down. The
+// node is the leaf at the path if there is one.
+func (t *tree) walk(root []byte, path []byte) (*node, []*node, error) {
+	if root == nil {
+		return nil, nil, nil
+	}
+
+	var parents []*node
+	hash := root
+	for {
+		n, err := t.getNode(hash)
+		if err != nil {
+			return nil, nil, err
+		}
+		if n.isLeaf() || firstDiff(n.path, path) < n.bit {
+			return n, parents, nil
+		}
+
+		parents = append(parents, n)
+		if bitAt(path, n.bit) == 0 {
+			hash = n.left
+		} else {
+			hash = n.right
+		}
+	}
+}
+
+// edge returns the leftmost or rightmost leaf of the subtree with the given root.
+func (t *tree) edge(hash []byte, rightmost bool) (*node, error) {
+	for {
+		n, err := t.getNode(hash)
+		if err != nil || n.isLeaf() {
+			return n, err
+		}
+
+		if rightmost {
+			hash = n.right
+		} else {
+			hash = n.left
+		}
+	}
+}
+
+// iterate calls fn for the leaves of the tree with the given root, in the order
+// of their paths, until fn returns true.
+func (t *tree) iterate(hash []byte, fn func(leaf *node) bool) (bool, error) {
+	if hash == nil {
+		return false, nil
+	}
+
+	n, err := t.getNode(hash)
+	if err != nil {
+		return false, err
+	}
+	if n.isLeaf() {
+		return fn(n), nil
+	}
+
+	stop, err := t.iterate(n.left, fn)
+	if err != nil || stop {
+		return stop, err
+	}
+
+	return t.iterate(n.right, fn)
+}
+
+// existenceProof returns the proof of the leaf at a path in the tree with the
+// given root.
+func (t *tree) existenceProof(root []byte, path []byte) (*ics23.ExistenceProof, error) {
+	leaf, parents, err := t.walk(root, path)
+	if err != nil {
+		return nil, err
+	}
+	if leaf == nil || !leaf.isLeaf() || !bytes.Equal(leaf.path, path) {
+		return nil, fmt.Errorf("no leaf at path %X", path)
+	}
+
+	steps := make([]*ics23.InnerOp, len(parents))
+	for i, parent := range parents {
+		step := &ics23.InnerOp{Hash: ics23.HashOp_SHA256}
+		if bitAt(path, parent.bit) == 0 {
+			step.Prefix = []byte{innerPrefix}
+			step.Suffix = parent.right
+		} else {
+			step.Prefix = append([]byte{innerPrefix}, parent.left...)
+		}
+
+		// the steps go from the leaf up
+		steps[len(parents)-1-i] = step
+	}
+
+	return &ics23.ExistenceProof{
+		Key:   leaf.path,
+		Value: leaf.value,
+		Leaf: &ics23.LeafOp{
+			Prefix:       []byte{leafPrefix},
+			Hash:         ics23.HashOp_SHA256,
+			PrehashKey:   ics23.HashOp_NO_HASH,
+			PrehashValue: ics23.HashOp_SHA256,
+			Length:       ics23.LengthOp_NO_PREFIX,
+		},
+		Path: steps,
+	}, nil
+}
+
+// membershipProof returns the proof of the presence of a key in the tree with
+// the given root.
+func (t *tree) membershipProof(root []byte, key []byte) (*ics23.CommitmentProof, error) {
+	path := sha256.Sum256(key)
+	proof, err := t.existenceProof(root, path[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return &ics23.CommitmentProof{
+		Proof: &ics23.CommitmentProof_Exist{Exist: proof},
+	}, nil
+}
+
+// nonMembershipProof returns the proof of the absence of a key from the tree with
+// the given root, made of the proofs of the leaves next to its path.
+func (t *tree) nonMembershipProof(root []byte, key []byte) (*ics23.CommitmentProof, error) {
+	if root == nil {
+		return nil, errors.New("cannot prove the absence of a key from an empty tree")
+	}
+
+	path := sha256.Sum256(key)
+	left, right, err := t.neighbors(root, path[:])
+	if err != nil {
+		return nil, err
+	}
+
+	proof := &ics23.NonExistenceProof{Key: path[:]}
+	if left != nil {
+		if proof.Left, err = t.existenceProof(root, left.path); err != nil {
+			return nil, err
+		}
+	}
+	if right != nil {
+		if proof.Right, err = t.existenceProof(root, right.path); err != nil {
+			return nil, err
+		}
+	}
+
+	return &ics23.CommitmentProof{
+		Proof: &ics23.CommitmentProof_Nonexist{Nonexist: proof},
+	}, nil
+}
+
+// neighbors returns the leaves with the closest paths left and right of a path
+// not in the tree with the given root.
+func (t *tree) neighbors(root []byte, path []byte) (left, right *node, err error) {
+	n, parents, err := t.walk(root, path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The walk ends at the subtree the path branches off from, which is either
+	// entirely left or right of the path.
+	diff := firstDiff(n.path, path)
+	if diff == pathBits {
+		return nil, nil, fmt.Errorf("path %X is in the tree", path)
+	}
+	if bitAt(path, diff) == 0 {
+		right, err = t.edge(n.hash, false)
+	} else {
+		left, err = t.edge(n.hash, true)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The other neighbor is at the edge of the closest sibling subtree on its side.
+	for i := len(parents) - 1; i >= 0 && (left == nil || right == nil); i-- {
+		parent := parents[i]
+		if bitAt(path, parent.bit) == 0 && right == nil {
+			right, err = t.edge(parent.right, false)
+		} else if bitAt(path, parent.bit) == 1 && left == nil {
+			left, err = t.edge(parent.left, true)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return left, right, nil
+}
+
+// bitAt returns the bit of a path at the given index.
+func bitAt(path []byte, index int) int {
+	return int(path[index/8]>>(7-uint(index%8))) & 1
+}
+
+// firstDiff returns the index of the first bit two paths differ in, or pathBits
+// if they are equal.
+func firstDiff(a, b []byte) int {
+	for i := range a {
+		if x := a[i] ^ b[i]; x != 0 {
+			bit := 0
+			for x&0x80 == 0 {
+				x <<= 1
+				bit++
+			}
+			return i*8 + bit
+		}
+	}
+
+	return pathBits
+}
+
+func appendUvarint(bz []byte, x uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], x)
+	return append(bz, buf[:n]...)
+}
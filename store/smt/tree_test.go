@@ -0,0 +1,160 @@
+package smt
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	ics23 "github.com/confio/ics23/go"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func TestTreeRootIsHistoryIndependent(t *testing.T) {
+	keys := make([][]byte, 100)
+	for i := range keys {
+		keys[i] = []byte(fmt.Sprintf("key%d", i))
+	}
+
+	tree1 := newTree(dbm.NewMemDB())
+	tree2 := newTree(dbm.NewMemDB())
+
+	var root1, root2 []byte
+	var err error
+	for _, key := range keys {
+		root1, err = tree1.set(root1, key, []byte("value"))
+		require.NoError(t, err)
+	}
+	for _, i := range rand.Perm(len(keys)) {
+		root2, err = tree2.set(root2, keys[i], []byte("value"))
+		require.NoError(t, err)
+	}
+	require.Equal(t, root1, root2)
+
+	// a key set and removed again leaves the root unchanged
+	root, err := tree1.set(root1, []byte("other"), []byte("value"))
+	require.NoError(t, err)
+	require.NotEqual(t, root1, root)
+	root, err = tree1.remove(root, []byte("other"))
+	require.NoError(t, err)
+	require.Equal(t, root1, root)
+
+	// removing a missing key leaves the root unchanged
+	root, err = tree1.remove(root1, []byte("missing"))
+	require.NoError(t, err)
+	require.Equal(t, root1, root)
+
+	// removing all the keys leaves an empty tree
+	root = root1
+	for _, key := range keys {
+		root, err = tree1.remove(root, key)
+		require.NoError(t, err)
+	}
+	require.Nil(t, root)
+}
+
+func TestTreeSaveAndGet(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree := newTree(db)
+
+	var root []byte
+	var err error
+	for i := 0; i < 50; i++ {
+		root, err = tree.set(root, []byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+		require.NoError(t, err)
+	}
+	root, err = tree.set(root, []byte("key0"), []byte("updated"))
+	require.NoError(t, err)
+
+	batch := db.NewBatch()
+	require.NoError(t, tree.save(batch, root))
+	require.NoError(t, batch.Write())
+	require.Empty(t, tree.pending)
+
+	// reads are served by the stored nodes
+	tree = newTree(db)
+	value, err := tree.get(root, []byte("key0"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("updated"), value)
+	value, err = tree.get(root, []byte("key49"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value49"), value)
+	value, err = tree.get(root, []byte("missing"))
+	require.NoError(t, err)
+	require.Nil(t, value)
+
+	leaves := 0
+	_, err = tree.iterate(root, func(_ *node) bool {
+		leaves++
+		return false
+	})
+	require.NoError(t, err)
+	require.Equal(t, 50, leaves)
+}
+
+func TestTreeProofs(t *testing.T) {
+	tree := newTree(dbm.NewMemDB())
+
+	// an empty tree cannot prove absence
+	_, err := tree.nonMembershipProof(nil, []byte("key"))
+	require.Error(t, err)
+
+	for _, size := range []int{1, 2, 10, 200} {
+		var root []byte
+		for i := 0; i < size; i++ {
+			root, err = tree.set(root, []byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+			require.NoError(t, err)
+		}
+
+		for i := 0; i < size; i++ {
+			key := []byte(fmt.Sprintf("key%d", i))
+			path := sha256.Sum256(key)
+
+			proof, err := tree.membershipProof(root, key)
+			require.NoError(t, err)
+			require.True(t, ics23.VerifyMembership(types.SmtSpec, root, proof, path[:], []byte(fmt.Sprintf("value%d", i))))
+			require.False(t, ics23.VerifyMembership(types.SmtSpec, root, proof, path[:], []byte("other")))
+		}
+
+		for i := 0; i < 100; i++ {
+			key := []byte(fmt.Sprintf("missing%d", i))
+			path := sha256.Sum256(key)
+
+			_, err := tree.membershipProof(root, key)
+			require.Error(t, err)
+
+			proof, err := tree.nonMembershipProof(root, key)
+			require.NoError(t, err)
+			require.True(t, ics23.VerifyNonMembership(types.SmtSpec, root, proof, path[:]), "size %d, key %s", size, key)
+		}
+
+		// a proof of absence cannot be made for a key in the tree
+		_, err = tree.nonMembershipProof(root, []byte("key0"))
+		require.Error(t, err)
+	}
+}
+
+func TestDecodeNode(t *testing.T) {
+	leaf := newLeaf([]byte("key"), []byte("value"))
+	decoded, err := decodeNode(leaf.encode())
+	require.NoError(t, err)
+	require.Equal(t, leaf, decoded)
+
+	left := newLeaf([]byte("a"), []byte("1"))
+	inner := newInner(3, left.path, left.hash, leaf.hash)
+	decoded, err = decodeNode(inner.encode())
+	require.NoError(t, err)
+	require.Equal(t, inner, decoded)
+
+	_, err = decodeNode(nil)
+	require.Error(t, err)
+	_, err = decodeNode([]byte{2})
+	require.Error(t, err)
+	_, err = decodeNode([]byte{leafPrefix, 10, 1})
+	require.Error(t, err)
+	_, err = decodeNode(inner.encode()[:40])
+	require.Error(t, err)
+}
@@ -0,0 +1,174 @@
+package file
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ baseapp.StreamingService = &StreamingService{}
+
+// StreamingService is a concrete implementation of StreamingService that writes
+// state changes out to files.
+//
+// The service writes one file for InitChain and, for every block, one file for
+// BeginBlock, one file per DeliverTx and one file for EndBlock:
+//
+//	{prefix}-init-chain
+//	{prefix}-block-{N}-begin
+//	{prefix}-block-{N}-tx-{i}
+//	{prefix}-block-{N}-end
+//
+// Each file holds the length-prefixed protobuf encoded ABCI request, followed
+// by the length-prefixed StoreKVPairs written while processing it, followed by
+// the length-prefixed protobuf encoded ABCI response.
+type StreamingService struct {
+	listeners          map[types.StoreKey][]types.WriteListener // the listeners that will be initialized with BaseApp
+	filePrefix         string                                   // optional prefix for each of the generated files
+	writeDir           string                                   // directory to write files into
+	stateCache         *bytes.Buffer                            // cache the length-prefixed StoreKVPairs in the order they are received
+	stateCacheLock     *sync.Mutex                              // mutex for the state cache
+	currentBlockNumber int64                                    // the current block number
+	currentTxIndex     int64                                    // the index of the current tx
+}
+
+// NewStreamingService creates a new StreamingService for the provided writeDir,
+// (optional) filePrefix, and storeKeys.
+func NewStreamingService(writeDir, filePrefix string, storeKeys []types.StoreKey) (*StreamingService, error) {
+	if err := isDirWriteable(writeDir); err != nil {
+		return nil, err
+	}
+
+	fs := &StreamingService{
+		listeners:      make(map[types.StoreKey][]types.WriteListener, len(storeKeys)),
+		filePrefix:     filePrefix,
+		writeDir:       writeDir,
+		stateCache:     new(bytes.Buffer),
+		stateCacheLock: new(sync.Mutex),
+	}
+
+	// all listeners share the same state cache, StoreKVPairs carry the store key
+	// they originate from
+	listener := types.NewStoreKVPairWriteListener(&cacheWriter{fs})
+	for _, key := range storeKeys {
+		fs.listeners[key] = []types.WriteListener{listener}
+	}
+
+	return fs, nil
+}
+
+// Listeners returns the StreamingService's underlying WriteListeners, use for
+// registering them with the BaseApp.
+func (fs *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	return fs.listeners
+}
+
+// ListenInitChain satisfies the baseapp.ABCIListener interface. It writes the
+// received InitChain request and response and the genesis state changes to a
+// new file.
+func (fs *StreamingService) ListenInitChain(_ sdk.Context, req abci.RequestInitChain, res abci.ResponseInitChain) error {
+	return fs.writeFile("init-chain", &req, &res)
+}
+
+// ListenBeginBlock satisfies the baseapp.ABCIListener interface. It writes the
+// received BeginBlock request and response and the state changes of the
+// BeginBlock to a new file.
+func (fs *StreamingService) ListenBeginBlock(_ sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	fs.currentBlockNumber = req.GetHeader().Height
+	fs.currentTxIndex = 0
+
+	return fs.writeFile(fmt.Sprintf("block-%d-begin", fs.currentBlockNumber), &req, &res)
+}
+
+// ListenDeliverTx satisfies the baseapp.ABCIListener interface. It writes the
+// received DeliverTx request and response and the state changes of the
+// transaction to a new file.
+func (fs *StreamingService) ListenDeliverTx(_ sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	name := fmt.Sprintf("block-%d-tx-%d", fs.currentBlockNumber, fs.currentTxIndex)
+	fs.currentTxIndex++
+
+	return fs.writeFile(name, &req, &res)
+}
+
+// ListenEndBlock satisfies the baseapp.ABCIListener interface. It writes the
+// received EndBlock request and response and the state changes of the EndBlock
+// to a new file.
+func (fs *StreamingService) ListenEndBlock(_ sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	return fs.writeFile(fmt.Sprintf("block-%d-end", fs.currentBlockNumber), &req, &res)
+}
+
+// Stream satisfies the baseapp.StreamingService interface. The file service
+// writes synchronously from the ABCI hooks, so there is no background work to
+// start.
+func (fs *StreamingService) Stream(_ *sync.WaitGroup) error {
+	return nil
+}
+
+// Close satisfies the io.Closer interface.
+func (fs *StreamingService) Close() error {
+	return nil
+}
+
+// writeFile writes the request, the cached state changes and the response to
+// a new file and resets the state cache.
+func (fs *StreamingService) writeFile(name string, req, res proto.Message) error {
+	fs.stateCacheLock.Lock()
+	defer fs.stateCacheLock.Unlock()
+	defer fs.stateCache.Reset()
+
+	if fs.filePrefix != "" {
+		name = fmt.Sprintf("%s-%s", fs.filePrefix, name)
+	}
+
+	f, err := os.OpenFile(filepath.Join(fs.writeDir, name), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := protoio.NewDelimitedWriter(f)
+	if err := w.WriteMsg(req); err != nil {
+		return err
+	}
+	if _, err := f.Write(fs.stateCache.Bytes()); err != nil {
+		return err
+	}
+
+	return w.WriteMsg(res)
+}
+
+// cacheWriter is the io.Writer the WriteListeners write the length-prefixed
+// StoreKVPairs to.
+type cacheWriter struct {
+	fs *StreamingService
+}
+
+// Write implements io.Writer.
+func (cw *cacheWriter) Write(p []byte) (int, error) {
+	cw.fs.stateCacheLock.Lock()
+	defer cw.fs.stateCacheLock.Unlock()
+
+	return cw.fs.stateCache.Write(p)
+}
+
+// isDirWriteable checks if dir is writable by writing and removing a file
+// to dir. It returns nil if dir is writable.
+func isDirWriteable(dir string) error {
+	f := filepath.Join(dir, ".touch")
+	if err := ioutil.WriteFile(f, []byte(""), 0600); err != nil {
+		return err
+	}
+
+	return os.Remove(f)
+}
@@ -0,0 +1,134 @@
+package file
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	mockStoreKey1 = types.NewKVStoreKey("mockStore1")
+	mockStoreKey2 = types.NewKVStoreKey("mockStore2")
+
+	testInitChainReq  = abci.RequestInitChain{ChainId: "test-chain"}
+	testInitChainRes  = abci.ResponseInitChain{AppHash: []byte("hash")}
+	testBeginBlockReq = abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}}
+	testBeginBlockRes = abci.ResponseBeginBlock{Events: []abci.Event{{Type: "begin"}}}
+	testDeliverTxReq  = abci.RequestDeliverTx{Tx: []byte("tx")}
+	testDeliverTxRes  = abci.ResponseDeliverTx{Code: 1, Log: "log"}
+	testEndBlockReq   = abci.RequestEndBlock{Height: 1}
+	testEndBlockRes   = abci.ResponseEndBlock{Events: []abci.Event{{Type: "end"}}}
+)
+
+func TestFileStreamingService(t *testing.T) {
+	dir, err := ioutil.TempDir("", "file-streaming")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	svc, err := NewStreamingService(dir, "pre", []types.StoreKey{mockStoreKey1, mockStoreKey2})
+	require.NoError(t, err)
+	require.Len(t, svc.Listeners(), 2)
+
+	write := func(key types.StoreKey, k, v string, del bool) {
+		for _, l := range svc.Listeners()[key] {
+			require.NoError(t, l.OnWrite(key, []byte(k), []byte(v), del))
+		}
+	}
+
+	ctx := sdk.Context{}
+	write(mockStoreKey2, "genesis", "v0", false)
+	require.NoError(t, svc.ListenInitChain(ctx, testInitChainReq, testInitChainRes))
+
+	write(mockStoreKey1, "k1", "v1", false)
+	require.NoError(t, svc.ListenBeginBlock(ctx, testBeginBlockReq, testBeginBlockRes))
+
+	write(mockStoreKey2, "k2", "v2", false)
+	write(mockStoreKey1, "k1", "", true)
+	require.NoError(t, svc.ListenDeliverTx(ctx, testDeliverTxReq, testDeliverTxRes))
+	require.NoError(t, svc.ListenDeliverTx(ctx, testDeliverTxReq, testDeliverTxRes))
+
+	require.NoError(t, svc.ListenEndBlock(ctx, testEndBlockReq, testEndBlockRes))
+
+	// InitChain
+	r := openFile(t, filepath.Join(dir, "pre-init-chain"))
+	var initReq abci.RequestInitChain
+	require.NoError(t, r.ReadMsg(&initReq))
+	require.Equal(t, testInitChainReq, initReq)
+	requireKVPair(t, r, types.StoreKVPair{StoreKey: mockStoreKey2.Name(), Key: []byte("genesis"), Value: []byte("v0")})
+	var initRes abci.ResponseInitChain
+	require.NoError(t, r.ReadMsg(&initRes))
+	require.Equal(t, testInitChainRes, initRes)
+	requireEOF(t, r)
+
+	// BeginBlock
+	r = openFile(t, filepath.Join(dir, "pre-block-1-begin"))
+	var beginReq abci.RequestBeginBlock
+	require.NoError(t, r.ReadMsg(&beginReq))
+	require.Equal(t, testBeginBlockReq, beginReq)
+	requireKVPair(t, r, types.StoreKVPair{StoreKey: mockStoreKey1.Name(), Key: []byte("k1"), Value: []byte("v1")})
+	var beginRes abci.ResponseBeginBlock
+	require.NoError(t, r.ReadMsg(&beginRes))
+	require.Equal(t, testBeginBlockRes, beginRes)
+	requireEOF(t, r)
+
+	// first DeliverTx carries the state changes, the second one is empty
+	r = openFile(t, filepath.Join(dir, "pre-block-1-tx-0"))
+	var txReq abci.RequestDeliverTx
+	require.NoError(t, r.ReadMsg(&txReq))
+	require.Equal(t, testDeliverTxReq, txReq)
+	requireKVPair(t, r, types.StoreKVPair{StoreKey: mockStoreKey2.Name(), Key: []byte("k2"), Value: []byte("v2")})
+	requireKVPair(t, r, types.StoreKVPair{StoreKey: mockStoreKey1.Name(), Key: []byte("k1"), Delete: true})
+	var txRes abci.ResponseDeliverTx
+	require.NoError(t, r.ReadMsg(&txRes))
+	require.Equal(t, testDeliverTxRes, txRes)
+	requireEOF(t, r)
+
+	r = openFile(t, filepath.Join(dir, "pre-block-1-tx-1"))
+	require.NoError(t, r.ReadMsg(&txReq))
+	require.NoError(t, r.ReadMsg(&txRes))
+	require.Equal(t, testDeliverTxRes, txRes)
+	requireEOF(t, r)
+
+	// EndBlock
+	r = openFile(t, filepath.Join(dir, "pre-block-1-end"))
+	var endReq abci.RequestEndBlock
+	require.NoError(t, r.ReadMsg(&endReq))
+	require.Equal(t, testEndBlockReq, endReq)
+	var endRes abci.ResponseEndBlock
+	require.NoError(t, r.ReadMsg(&endRes))
+	require.Equal(t, testEndBlockRes, endRes)
+	requireEOF(t, r)
+}
+
+func TestFileStreamingService_NotWriteable(t *testing.T) {
+	_, err := NewStreamingService(filepath.Join(os.TempDir(), "does", "not", "exist"), "", nil)
+	require.Error(t, err)
+}
+
+func openFile(t *testing.T, path string) protoio.ReadCloser {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { f.Close() })
+	return protoio.NewDelimitedReader(f, 1<<20)
+}
+
+func requireKVPair(t *testing.T, r protoio.Reader, expected types.StoreKVPair) {
+	var pair types.StoreKVPair
+	require.NoError(t, r.ReadMsg(&pair))
+	require.Equal(t, expected, pair)
+}
+
+func requireEOF(t *testing.T, r protoio.Reader) {
+	var pair types.StoreKVPair
+	require.Equal(t, io.EOF, r.ReadMsg(&pair))
+}
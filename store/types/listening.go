@@ -0,0 +1,43 @@
+package types
+
+import (
+	"io"
+
+	protoio "github.com/gogo/protobuf/io"
+)
+
+// WriteListener interface for streaming data out from a listenkv.Store
+type WriteListener interface {
+	// if value is nil then it was deleted
+	// storeKey indicates the source KVStore, to facilitate using the same WriteListener across separate KVStores
+	// delete bool indicates if it was a delete; true: delete, false: set
+	OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool) error
+}
+
+// StoreKVPairWriteListener is used to configure listening to a KVStore by
+// writing out length-prefixed protobuf encoded StoreKVPairs to an underlying
+// io.Writer object.
+type StoreKVPairWriteListener struct {
+	writer protoio.WriteCloser
+}
+
+// NewStoreKVPairWriteListener creates a StoreKVPairWriteListener with a
+// provided io.Writer.
+func NewStoreKVPairWriteListener(w io.Writer) *StoreKVPairWriteListener {
+	return &StoreKVPairWriteListener{
+		writer: protoio.NewDelimitedWriter(w),
+	}
+}
+
+// OnWrite satisfies the WriteListener interface by writing length-prefixed
+// protobuf encoded StoreKVPairs.
+func (wl *StoreKVPairWriteListener) OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool) error {
+	kvPair := &StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Key:      key,
+		Value:    value,
+		Delete:   delete,
+	}
+
+	return wl.writer.WriteMsg(kvPair)
+}
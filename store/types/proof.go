@@ -1,6 +1,8 @@
 package types
 
 import (
+	"crypto/sha256"
+
 	ics23 "github.com/confio/ics23/go"
 	"github.com/tendermint/tendermint/crypto/merkle"
 	tmmerkle "github.com/tendermint/tendermint/proto/tendermint/crypto"
@@ -11,8 +13,30 @@ import (
 const (
 	ProofOpIAVLCommitment         = "ics23:iavl"
 	ProofOpSimpleMerkleCommitment = "ics23:simple"
+	ProofOpSMTCommitment          = "ics23:smt"
 )
 
+// SmtSpec constrains the format of the proofs of the sparse Merkle tree of
+// StoreTypeSMT stores. The tree is keyed by the SHA-256 hash of the store keys,
+// which is the key of its leaves: the proofs prove the hashed key.
+var SmtSpec = &ics23.ProofSpec{
+	LeafSpec: &ics23.LeafOp{
+		Prefix:       []byte{0},
+		Hash:         ics23.HashOp_SHA256,
+		PrehashKey:   ics23.HashOp_NO_HASH,
+		PrehashValue: ics23.HashOp_SHA256,
+		Length:       ics23.LengthOp_NO_PREFIX,
+	},
+	InnerSpec: &ics23.InnerSpec{
+		ChildOrder:      []int32{0, 1},
+		MinPrefixLength: 1,
+		MaxPrefixLength: 1,
+		ChildSize:       32, // (no length byte)
+		Hash:            ics23.HashOp_SHA256,
+	},
+	MaxDepth: 256,
+}
+
 // CommitmentOp implements merkle.ProofOperator by wrapping an ics23 CommitmentProof
 // It also contains a Key field to determine which key the proof is proving.
 // NOTE: CommitmentProof currently can either be ExistenceProof or NonexistenceProof
@@ -46,6 +70,17 @@ func NewSimpleMerkleCommitmentOp(key []byte, proof *ics23.CommitmentProof) Commi
 	}
 }
 
+// NewSmtCommitmentOp returns a CommitmentOp proving the given key with a proof of
+// the sparse Merkle tree of a StoreTypeSMT store, which proves the hashed key.
+func NewSmtCommitmentOp(key []byte, proof *ics23.CommitmentProof) CommitmentOp {
+	return CommitmentOp{
+		Type:  ProofOpSMTCommitment,
+		Spec:  SmtSpec,
+		Key:   key,
+		Proof: proof,
+	}
+}
+
 // CommitmentOpDecoder takes a merkle.ProofOp and attempts to decode it into a CommitmentOp ProofOperator
 // The proofOp.Data is just a marshalled CommitmentProof. The Key of the CommitmentOp is extracted
 // from the unmarshalled proof.
@@ -56,8 +91,10 @@ func CommitmentOpDecoder(pop tmmerkle.ProofOp) (merkle.ProofOperator, error) {
 		spec = ics23.IavlSpec
 	case ProofOpSimpleMerkleCommitment:
 		spec = ics23.TendermintSpec
+	case ProofOpSMTCommitment:
+		spec = SmtSpec
 	default:
-		return nil, sdkerrors.Wrapf(ErrInvalidProof, "unexpected ProofOp.Type; got %s, want supported ics23 subtypes 'ProofOpIAVLCommitment' or 'ProofOpSimpleMerkleCommitment'", pop.Type)
+		return nil, sdkerrors.Wrapf(ErrInvalidProof, "unexpected ProofOp.Type; got %s, want supported ics23 subtypes 'ProofOpIAVLCommitment', 'ProofOpSimpleMerkleCommitment' or 'ProofOpSMTCommitment'", pop.Type)
 	}
 
 	proof := &ics23.CommitmentProof{}
@@ -79,6 +116,17 @@ func (op CommitmentOp) GetKey() []byte {
 	return op.Key
 }
 
+// proofKey returns the key proven by the embedded CommitmentProof, which is the
+// hash of the key for proofs of sparse Merkle trees.
+func (op CommitmentOp) proofKey() []byte {
+	if op.Type == ProofOpSMTCommitment {
+		hash := sha256.Sum256(op.Key)
+		return hash[:]
+	}
+
+	return op.Key
+}
+
 // Run takes in a list of arguments and attempts to run the proof op against these arguments
 // Returns the root wrapped in [][]byte if the proof op succeeds with given args. If not,
 // it will return an error.
@@ -98,14 +146,14 @@ func (op CommitmentOp) Run(args [][]byte) ([][]byte, error) {
 	switch len(args) {
 	case 0:
 		// Args are nil, so we verify the absence of the key.
-		absent := ics23.VerifyNonMembership(op.Spec, root, op.Proof, op.Key)
+		absent := ics23.VerifyNonMembership(op.Spec, root, op.Proof, op.proofKey())
 		if !absent {
 			return nil, sdkerrors.Wrapf(ErrInvalidProof, "proof did not verify absence of key: %s", string(op.Key))
 		}
 
 	case 1:
 		// Args is length 1, verify existence of key with value args[0]
-		if !ics23.VerifyMembership(op.Spec, root, op.Proof, op.Key, args[0]) {
+		if !ics23.VerifyMembership(op.Spec, root, op.Proof, op.proofKey(), args[0]) {
 			return nil, sdkerrors.Wrapf(ErrInvalidProof, "proof did not verify existence of key %s with given value %x", op.Key, args[0])
 		}
 	default:
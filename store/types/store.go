@@ -130,6 +130,16 @@ type MultiStore interface {
 	// implied that the caller should update the context when necessary between
 	// tracing operations. The modified MultiStore is returned.
 	SetTracingContext(TraceContext) MultiStore
+
+	// ListeningEnabled returns if listening is enabled for the KVStore belonging
+	// to the provided StoreKey.
+	ListeningEnabled(key StoreKey) bool
+
+	// AddListeners adds WriteListeners for the KVStore belonging to the provided
+	// StoreKey. It appends the listeners to a current set, if one already exists.
+	// Listeners observe every write that lands in the MultiStore, either directly
+	// through GetKVStore or flushed from one of its branches.
+	AddListeners(key StoreKey, listeners []WriteListener)
 }
 
 // From MultiStore.CacheMultiStore()....
@@ -181,6 +191,19 @@ type CommitMultiStore interface {
 	// SetInitialVersion sets the initial version of the IAVL tree. It is used when
 	// starting a new chain at an arbitrary height.
 	SetInitialVersion(version int64) error
+
+	// SetIAVLCacheSize sets the number of nodes cached by the IAVL stores
+	// without a cache size of their own. It must be called before loading.
+	SetIAVLCacheSize(size int)
+
+	// SetIAVLCacheSizes sets the number of nodes cached by the IAVL stores with
+	// the given names. It must be called before loading.
+	SetIAVLCacheSizes(sizes map[string]int)
+
+	// SetIAVLFastNode enables the index of the latest version of the IAVL
+	// stores, which serves reads of the latest state without walking the
+	// tree. It must be called before loading.
+	SetIAVLFastNode(enabled bool)
 }
 
 //---------subsp-------------------------------
@@ -283,6 +306,7 @@ const (
 	StoreTypeIAVL
 	StoreTypeTransient
 	StoreTypeMemory
+	StoreTypeSMT
 )
 
 func (st StoreType) String() string {
@@ -301,6 +325,9 @@ func (st StoreType) String() string {
 
 	case StoreTypeMemory:
 		return "StoreTypeMemory"
+
+	case StoreTypeSMT:
+		return "StoreTypeSMT"
 	}
 
 	return "unknown store type"
@@ -265,6 +265,9 @@ type GasInfo struct {
 	GasWanted uint64 `protobuf:"varint,1,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty" yaml:"gas_wanted"`
 	// GasUsed is the amount of gas actually consumed.
 	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty" yaml:"gas_used"`
+	// Profile is the breakdown of the gas used, only set when gas profiling is
+	// enabled.
+	Profile []GasProfileEntry `protobuf:"bytes,3,rep,name=profile,proto3" json:"profile" yaml:"profile,omitempty"`
 }
 
 func (m *GasInfo) Reset()      { *m = GasInfo{} }
@@ -313,6 +316,98 @@ func (m *GasInfo) GetGasUsed() uint64 {
 	return 0
 }
 
+func (m *GasInfo) GetProfile() []GasProfileEntry {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+// GasProfileEntry defines the gas consumed by a tx for one kind of operation,
+// on a store or not, within a message or outside of the messages.
+type GasProfileEntry struct {
+	// MsgIndex is the index of the message the gas was consumed in, or -1 for
+	// the gas consumed outside of the messages, e.g. by the AnteHandler.
+	MsgIndex int32 `protobuf:"varint,1,opt,name=msg_index,json=msgIndex,proto3" json:"msg_index,omitempty" yaml:"msg_index"`
+	// MsgTypeURL is the type URL of the message, empty outside of the messages.
+	MsgTypeURL string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty" yaml:"msg_type_url"`
+	// StoreKey is the name of the store the gas was consumed on, empty for gas
+	// not consumed by a store operation.
+	StoreKey string `protobuf:"bytes,3,opt,name=store_key,json=storeKey,proto3" json:"store_key,omitempty" yaml:"store_key"`
+	// Operation is the store operation (read, write, iterate, has or delete),
+	// or the gas descriptor for gas not consumed by a store operation.
+	Operation string `protobuf:"bytes,4,opt,name=operation,proto3" json:"operation,omitempty"`
+	// GasUsed is the amount of gas consumed.
+	GasUsed uint64 `protobuf:"varint,5,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty" yaml:"gas_used"`
+}
+
+func (m *GasProfileEntry) Reset()      { *m = GasProfileEntry{} }
+func (*GasProfileEntry) ProtoMessage() {}
+func (*GasProfileEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e37629bc7eb0df8, []int{5}
+}
+func (m *GasProfileEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasProfileEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasProfileEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasProfileEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasProfileEntry.Merge(m, src)
+}
+func (m *GasProfileEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasProfileEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasProfileEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasProfileEntry proto.InternalMessageInfo
+
+func (m *GasProfileEntry) GetMsgIndex() int32 {
+	if m != nil {
+		return m.MsgIndex
+	}
+	return 0
+}
+
+func (m *GasProfileEntry) GetMsgTypeURL() string {
+	if m != nil {
+		return m.MsgTypeURL
+	}
+	return ""
+}
+
+func (m *GasProfileEntry) GetStoreKey() string {
+	if m != nil {
+		return m.StoreKey
+	}
+	return ""
+}
+
+func (m *GasProfileEntry) GetOperation() string {
+	if m != nil {
+		return m.Operation
+	}
+	return ""
+}
+
+func (m *GasProfileEntry) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
 // Result is the union of ResponseFormat and ResponseCheckTx.
 type Result struct {
 	// Data is any data returned from message or handler execution. It MUST be
@@ -328,7 +423,7 @@ type Result struct {
 func (m *Result) Reset()      { *m = Result{} }
 func (*Result) ProtoMessage() {}
 func (*Result) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e37629bc7eb0df8, []int{5}
+	return fileDescriptor_4e37629bc7eb0df8, []int{6}
 }
 func (m *Result) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -367,7 +462,7 @@ type SimulationResponse struct {
 func (m *SimulationResponse) Reset()      { *m = SimulationResponse{} }
 func (*SimulationResponse) ProtoMessage() {}
 func (*SimulationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e37629bc7eb0df8, []int{6}
+	return fileDescriptor_4e37629bc7eb0df8, []int{7}
 }
 func (m *SimulationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -413,7 +508,7 @@ type MsgData struct {
 func (m *MsgData) Reset()      { *m = MsgData{} }
 func (*MsgData) ProtoMessage() {}
 func (*MsgData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e37629bc7eb0df8, []int{7}
+	return fileDescriptor_4e37629bc7eb0df8, []int{8}
 }
 func (m *MsgData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -465,7 +560,7 @@ type TxMsgData struct {
 func (m *TxMsgData) Reset()      { *m = TxMsgData{} }
 func (*TxMsgData) ProtoMessage() {}
 func (*TxMsgData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e37629bc7eb0df8, []int{8}
+	return fileDescriptor_4e37629bc7eb0df8, []int{9}
 }
 func (m *TxMsgData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -520,7 +615,7 @@ type SearchTxsResult struct {
 func (m *SearchTxsResult) Reset()      { *m = SearchTxsResult{} }
 func (*SearchTxsResult) ProtoMessage() {}
 func (*SearchTxsResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e37629bc7eb0df8, []int{9}
+	return fileDescriptor_4e37629bc7eb0df8, []int{10}
 }
 func (m *SearchTxsResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -597,6 +692,7 @@ func init() {
 	proto.RegisterType((*StringEvent)(nil), "cosmos.base.abci.v1beta1.StringEvent")
 	proto.RegisterType((*Attribute)(nil), "cosmos.base.abci.v1beta1.Attribute")
 	proto.RegisterType((*GasInfo)(nil), "cosmos.base.abci.v1beta1.GasInfo")
+	proto.RegisterType((*GasProfileEntry)(nil), "cosmos.base.abci.v1beta1.GasProfileEntry")
 	proto.RegisterType((*Result)(nil), "cosmos.base.abci.v1beta1.Result")
 	proto.RegisterType((*SimulationResponse)(nil), "cosmos.base.abci.v1beta1.SimulationResponse")
 	proto.RegisterType((*MsgData)(nil), "cosmos.base.abci.v1beta1.MsgData")
@@ -609,65 +705,74 @@ func init() {
 }
 
 var fileDescriptor_4e37629bc7eb0df8 = []byte{
-	// 922 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x55, 0x31, 0x73, 0x1b, 0x45,
-	0x14, 0xd6, 0x49, 0xca, 0xc9, 0x7a, 0x72, 0x30, 0x2c, 0x26, 0x39, 0x27, 0xa0, 0x13, 0xe7, 0x64,
-	0x46, 0x0d, 0xa7, 0x89, 0x13, 0x18, 0xc6, 0x05, 0x43, 0x2e, 0x10, 0xe2, 0x99, 0x84, 0x62, 0xad,
-	0x0c, 0x33, 0x34, 0x9a, 0x95, 0xb4, 0x59, 0x1d, 0xd1, 0xdd, 0x6a, 0x6e, 0x57, 0xb6, 0xd4, 0x51,
-	0x52, 0x52, 0xa5, 0xa0, 0xa2, 0xe6, 0x97, 0xa4, 0xc3, 0x65, 0x0a, 0x46, 0x80, 0xdd, 0xa5, 0xf4,
-	0x2f, 0x60, 0xf6, 0xed, 0x59, 0x3a, 0x93, 0x91, 0x2b, 0xed, 0xfb, 0xde, 0xdb, 0xb7, 0x6f, 0xbf,
-	0xef, 0xd3, 0x1e, 0xec, 0x0e, 0xa4, 0x4a, 0xa4, 0xea, 0xf4, 0x99, 0xe2, 0x1d, 0xd6, 0x1f, 0xc4,
-	0x9d, 0xa3, 0x7b, 0x7d, 0xae, 0xd9, 0x3d, 0x0c, 0xc2, 0x49, 0x26, 0xb5, 0x24, 0x9e, 0x2d, 0x0a,
-	0x4d, 0x51, 0x88, 0x78, 0x5e, 0x74, 0x6b, 0x5b, 0x48, 0x21, 0xb1, 0xa8, 0x63, 0x56, 0xb6, 0xfe,
-	0xd6, 0x6d, 0xcd, 0xd3, 0x21, 0xcf, 0x92, 0x38, 0xd5, 0xb6, 0xa7, 0x9e, 0x4f, 0xb8, 0xca, 0x93,
-	0x3b, 0x42, 0x4a, 0x31, 0xe6, 0x1d, 0x8c, 0xfa, 0xd3, 0x17, 0x1d, 0x96, 0xce, 0x6d, 0x2a, 0x78,
-	0x55, 0x01, 0xe8, 0xce, 0x28, 0x57, 0x13, 0x99, 0x2a, 0x4e, 0x6e, 0x80, 0x3b, 0xe2, 0xb1, 0x18,
-	0x69, 0xcf, 0x69, 0x39, 0xed, 0x0a, 0xcd, 0x23, 0x12, 0x80, 0xab, 0x67, 0x23, 0xa6, 0x46, 0x5e,
-	0xb9, 0xe5, 0xb4, 0xeb, 0x11, 0x9c, 0x2e, 0x7c, 0xb7, 0x3b, 0x7b, 0xc2, 0xd4, 0x88, 0xe6, 0x19,
-	0xf2, 0x31, 0xd4, 0x07, 0x72, 0xc8, 0xd5, 0x84, 0x0d, 0xb8, 0x57, 0x31, 0x65, 0x74, 0x05, 0x10,
-	0x02, 0x55, 0x13, 0x78, 0xd5, 0x96, 0xd3, 0xbe, 0x4e, 0x71, 0x6d, 0xb0, 0x21, 0xd3, 0xcc, 0xbb,
-	0x86, 0xc5, 0xb8, 0x26, 0x37, 0xa1, 0x96, 0xb1, 0xe3, 0xde, 0x58, 0x0a, 0xcf, 0x45, 0xd8, 0xcd,
-	0xd8, 0xf1, 0x53, 0x29, 0xc8, 0x73, 0xa8, 0x8e, 0xa5, 0x50, 0x5e, 0xad, 0x55, 0x69, 0x37, 0xf6,
-	0xda, 0xe1, 0x3a, 0x82, 0xc2, 0x87, 0xd1, 0xa3, 0x83, 0x67, 0x5c, 0x29, 0x26, 0xf8, 0x53, 0x29,
-	0xa2, 0x9b, 0xaf, 0x17, 0x7e, 0xe9, 0x8f, 0xbf, 0xfd, 0xad, 0xcb, 0xb8, 0xa2, 0xd8, 0xce, 0xcc,
-	0x10, 0xa7, 0x2f, 0xa4, 0xb7, 0x61, 0x67, 0x30, 0x6b, 0xf2, 0x09, 0x80, 0x60, 0xaa, 0x77, 0xcc,
-	0x52, 0xcd, 0x87, 0x5e, 0x1d, 0x99, 0xa8, 0x0b, 0xa6, 0x7e, 0x40, 0x80, 0xec, 0xc0, 0x86, 0x49,
-	0x4f, 0x15, 0x1f, 0x7a, 0x80, 0xc9, 0x9a, 0x60, 0xea, 0xb9, 0xe2, 0x43, 0x72, 0x07, 0xca, 0x7a,
-	0xe6, 0x35, 0x5a, 0x4e, 0xbb, 0xb1, 0xb7, 0x1d, 0x5a, 0xda, 0xc3, 0x0b, 0xda, 0xc3, 0x87, 0xe9,
-	0x9c, 0x96, 0xf5, 0xcc, 0x30, 0xa5, 0xe3, 0x84, 0x2b, 0xcd, 0x92, 0x89, 0xb7, 0x69, 0x99, 0x5a,
-	0x02, 0xfb, 0xd5, 0x5f, 0x7e, 0xf7, 0x4b, 0xc1, 0x6f, 0x0e, 0xbc, 0x77, 0x79, 0x62, 0x72, 0x1b,
-	0xea, 0x89, 0x12, 0xbd, 0x38, 0x1d, 0xf2, 0x19, 0xea, 0x73, 0x9d, 0x6e, 0x24, 0x4a, 0x1c, 0x98,
-	0x98, 0xbc, 0x0f, 0x15, 0xc3, 0x19, 0xca, 0x43, 0xcd, 0x92, 0x1c, 0x82, 0xcb, 0x8f, 0x78, 0xaa,
-	0x95, 0x57, 0x41, 0xca, 0xee, 0xae, 0xa7, 0xec, 0x50, 0x67, 0x71, 0x2a, 0xbe, 0x35, 0xd5, 0xd1,
-	0x76, 0xce, 0xd7, 0x66, 0x01, 0x54, 0x34, 0x6f, 0xb5, 0x5f, 0xfd, 0xf9, 0xaf, 0x96, 0x13, 0x64,
-	0xd0, 0x28, 0x64, 0x0d, 0x87, 0xc6, 0x6e, 0x38, 0x53, 0x9d, 0xe2, 0x9a, 0x1c, 0x00, 0x30, 0xad,
-	0xb3, 0xb8, 0x3f, 0xd5, 0x5c, 0x79, 0x65, 0x9c, 0x60, 0xf7, 0x0a, 0xd1, 0x2e, 0x6a, 0xa3, 0xaa,
-	0x39, 0x9f, 0x16, 0x36, 0xe7, 0x67, 0xde, 0x87, 0xfa, 0xb2, 0xc8, 0xdc, 0xf6, 0x25, 0x9f, 0xe7,
-	0x07, 0x9a, 0x25, 0xd9, 0x86, 0x6b, 0x47, 0x6c, 0x3c, 0xe5, 0x39, 0x03, 0x36, 0x08, 0x24, 0xd4,
-	0xbe, 0x63, 0xea, 0xc0, 0x88, 0xfa, 0xe0, 0x92, 0xa8, 0x66, 0x67, 0x35, 0xfa, 0xe8, 0x7c, 0xe1,
-	0x7f, 0x30, 0x67, 0xc9, 0x78, 0x3f, 0x58, 0xe5, 0x82, 0xa2, 0xd6, 0x61, 0x41, 0xeb, 0x32, 0xee,
-	0xf9, 0xf0, 0x7c, 0xe1, 0x6f, 0xad, 0xf6, 0x98, 0x4c, 0xb0, 0x34, 0x40, 0xf0, 0x13, 0xb8, 0x94,
-	0xab, 0xe9, 0x58, 0x2f, 0xcd, 0x6d, 0x4e, 0xda, 0xcc, 0xcd, 0xfd, 0xae, 0x48, 0x0f, 0xfe, 0x27,
-	0xd2, 0x8d, 0x70, 0xf5, 0x47, 0xb6, 0x0c, 0x59, 0x55, 0x2c, 0x2b, 0x4b, 0x15, 0xd0, 0x22, 0xaf,
-	0x1c, 0x20, 0x87, 0x71, 0x32, 0x1d, 0x33, 0x1d, 0xcb, 0x74, 0xf9, 0x1f, 0x7e, 0x6c, 0x47, 0x46,
-	0x57, 0x3b, 0xe8, 0xc4, 0x4f, 0xd7, 0xf3, 0x9e, 0xb3, 0x13, 0x6d, 0x98, 0xfe, 0x27, 0x0b, 0xdf,
-	0xc1, 0xab, 0x20, 0x61, 0x5f, 0x82, 0x9b, 0xe1, 0x55, 0x70, 0xde, 0xc6, 0x5e, 0x6b, 0x7d, 0x17,
-	0x7b, 0x65, 0x9a, 0xd7, 0x07, 0x5f, 0x41, 0xed, 0x99, 0x12, 0xdf, 0x98, 0x1b, 0xef, 0x80, 0xb1,
-	0x68, 0xaf, 0x60, 0x8f, 0x5a, 0xa2, 0x44, 0xd7, 0x38, 0xe4, 0x82, 0xa0, 0xf2, 0x8a, 0xa0, 0x5c,
-	0xea, 0x27, 0x50, 0xef, 0xce, 0x2e, 0x3a, 0x7c, 0xbe, 0xe4, 0xb1, 0x72, 0xf5, 0x55, 0xf2, 0x0d,
-	0x97, 0x3a, 0xfd, 0x59, 0x86, 0xad, 0x43, 0xce, 0xb2, 0xc1, 0xa8, 0x3b, 0x53, 0xb9, 0x30, 0x8f,
-	0xa1, 0xa1, 0xa5, 0x66, 0xe3, 0xde, 0x40, 0x4e, 0x53, 0x9d, 0x3b, 0xe1, 0xee, 0xdb, 0x85, 0x5f,
-	0x84, 0xcf, 0x17, 0x3e, 0xb1, 0x22, 0x17, 0xc0, 0x80, 0x02, 0x46, 0x8f, 0x4c, 0x60, 0x1c, 0x67,
-	0x3b, 0xa0, 0x2f, 0xa8, 0x0d, 0x4c, 0xf7, 0x09, 0x13, 0xbc, 0x97, 0x4e, 0x93, 0x3e, 0xcf, 0xf0,
-	0x1d, 0xcc, 0xbb, 0x17, 0xe0, 0x55, 0xf7, 0x02, 0x18, 0x50, 0x30, 0xd1, 0xf7, 0x18, 0x90, 0x08,
-	0x30, 0xea, 0xe1, 0x81, 0xf8, 0x6a, 0x56, 0xa3, 0xdd, 0xb7, 0x0b, 0xbf, 0x80, 0xae, 0xcc, 0xbb,
-	0xc2, 0x02, 0x5a, 0x37, 0x41, 0xd7, 0xac, 0xcd, 0x84, 0xe3, 0x38, 0x89, 0x35, 0x3e, 0xb0, 0x55,
-	0x6a, 0x03, 0xf2, 0x05, 0x54, 0xf4, 0x4c, 0x79, 0x2e, 0xf2, 0x79, 0x67, 0x3d, 0x9f, 0xab, 0xcf,
-	0x02, 0x35, 0x1b, 0x2c, 0xa3, 0xd1, 0xd7, 0x6f, 0xfe, 0x6d, 0x96, 0x5e, 0x9f, 0x36, 0x9d, 0x93,
-	0xd3, 0xa6, 0xf3, 0xcf, 0x69, 0xd3, 0xf9, 0xf5, 0xac, 0x59, 0x3a, 0x39, 0x6b, 0x96, 0xde, 0x9c,
-	0x35, 0x4b, 0x3f, 0x06, 0x22, 0xd6, 0xa3, 0x69, 0x3f, 0x1c, 0xc8, 0xa4, 0x93, 0x7f, 0xe6, 0xec,
-	0xcf, 0x67, 0x6a, 0xf8, 0xd2, 0x7e, 0x93, 0xfa, 0x2e, 0xbe, 0x87, 0xf7, 0xff, 0x0b, 0x00, 0x00,
-	0xff, 0xff, 0xd8, 0xa9, 0x21, 0xb7, 0x08, 0x07, 0x00, 0x00,
+	// 1069 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf7, 0xda, 0x8e, 0x7f, 0x8c, 0xd3, 0x6f, 0xfa, 0x9d, 0x86, 0x76, 0xd3, 0x82, 0xd7, 0x6c,
+	0x1a, 0xc9, 0x48, 0xb0, 0x56, 0xd2, 0x82, 0x50, 0x0e, 0x88, 0x6e, 0x69, 0xd3, 0x88, 0x04, 0xa1,
+	0x89, 0x23, 0x24, 0x2e, 0xd6, 0xd8, 0x9e, 0xac, 0x97, 0xee, 0xee, 0x58, 0x3b, 0xb3, 0x89, 0x7d,
+	0xe3, 0xc8, 0x09, 0x71, 0xea, 0x81, 0x13, 0x67, 0xfe, 0x92, 0xde, 0xc8, 0x09, 0xf5, 0x80, 0x16,
+	0x48, 0x6e, 0x3d, 0xfa, 0x2f, 0x40, 0xf3, 0xc3, 0xde, 0x4d, 0x90, 0xc3, 0xc9, 0xef, 0x7d, 0xde,
+	0x9b, 0x37, 0x6f, 0x3e, 0xef, 0x87, 0x17, 0x6c, 0x0e, 0x28, 0x0b, 0x29, 0xeb, 0xf4, 0x31, 0x23,
+	0x1d, 0xdc, 0x1f, 0xf8, 0x9d, 0xd3, 0xed, 0x3e, 0xe1, 0x78, 0x5b, 0x2a, 0xce, 0x38, 0xa6, 0x9c,
+	0x42, 0x53, 0x39, 0x39, 0xc2, 0xc9, 0x91, 0xb8, 0x76, 0xba, 0xbf, 0xee, 0x51, 0x8f, 0x4a, 0xa7,
+	0x8e, 0x90, 0x94, 0xff, 0xfd, 0x07, 0x9c, 0x44, 0x43, 0x12, 0x87, 0x7e, 0xc4, 0x55, 0x4c, 0x3e,
+	0x1d, 0x13, 0xa6, 0x8d, 0x1b, 0x1e, 0xa5, 0x5e, 0x40, 0x3a, 0x52, 0xeb, 0x27, 0x27, 0x1d, 0x1c,
+	0x4d, 0x95, 0xc9, 0x7e, 0x55, 0x02, 0xa0, 0x3b, 0x41, 0x84, 0x8d, 0x69, 0xc4, 0x08, 0xbc, 0x0b,
+	0x2a, 0x23, 0xe2, 0x7b, 0x23, 0x6e, 0x1a, 0x2d, 0xa3, 0x5d, 0x42, 0x5a, 0x83, 0x36, 0xa8, 0xf0,
+	0xc9, 0x08, 0xb3, 0x91, 0x59, 0x6c, 0x19, 0xed, 0xba, 0x0b, 0x2e, 0x52, 0xab, 0xd2, 0x9d, 0xbc,
+	0xc0, 0x6c, 0x84, 0xb4, 0x05, 0xbe, 0x0b, 0xea, 0x03, 0x3a, 0x24, 0x6c, 0x8c, 0x07, 0xc4, 0x2c,
+	0x09, 0x37, 0x94, 0x01, 0x10, 0x82, 0xb2, 0x50, 0xcc, 0x72, 0xcb, 0x68, 0xdf, 0x42, 0x52, 0x16,
+	0xd8, 0x10, 0x73, 0x6c, 0xae, 0x48, 0x67, 0x29, 0xc3, 0x7b, 0xa0, 0x1a, 0xe3, 0xb3, 0x5e, 0x40,
+	0x3d, 0xb3, 0x22, 0xe1, 0x4a, 0x8c, 0xcf, 0x0e, 0xa8, 0x07, 0x8f, 0x41, 0x39, 0xa0, 0x1e, 0x33,
+	0xab, 0xad, 0x52, 0xbb, 0xb1, 0xd3, 0x76, 0x96, 0x11, 0xe4, 0x3c, 0x71, 0x9f, 0xee, 0x1f, 0x12,
+	0xc6, 0xb0, 0x47, 0x0e, 0xa8, 0xe7, 0xde, 0x7b, 0x9d, 0x5a, 0x85, 0x5f, 0xff, 0xb4, 0xd6, 0xae,
+	0xe2, 0x0c, 0xc9, 0x70, 0x22, 0x07, 0x3f, 0x3a, 0xa1, 0x66, 0x4d, 0xe5, 0x20, 0x64, 0xf8, 0x1e,
+	0x00, 0x1e, 0x66, 0xbd, 0x33, 0x1c, 0x71, 0x32, 0x34, 0xeb, 0x92, 0x89, 0xba, 0x87, 0xd9, 0x37,
+	0x12, 0x80, 0x1b, 0xa0, 0x26, 0xcc, 0x09, 0x23, 0x43, 0x13, 0x48, 0x63, 0xd5, 0xc3, 0xec, 0x98,
+	0x91, 0x21, 0x7c, 0x08, 0x8a, 0x7c, 0x62, 0x36, 0x5a, 0x46, 0xbb, 0xb1, 0xb3, 0xee, 0x28, 0xda,
+	0x9d, 0x39, 0xed, 0xce, 0x93, 0x68, 0x8a, 0x8a, 0x7c, 0x22, 0x98, 0xe2, 0x7e, 0x48, 0x18, 0xc7,
+	0xe1, 0xd8, 0x5c, 0x55, 0x4c, 0x2d, 0x80, 0xdd, 0xf2, 0x0f, 0xbf, 0x58, 0x05, 0xfb, 0x67, 0x03,
+	0xfc, 0xef, 0x6a, 0xc6, 0xf0, 0x01, 0xa8, 0x87, 0xcc, 0xeb, 0xf9, 0xd1, 0x90, 0x4c, 0x64, 0x7d,
+	0x6e, 0xa1, 0x5a, 0xc8, 0xbc, 0x7d, 0xa1, 0xc3, 0xdb, 0xa0, 0x24, 0x38, 0x93, 0xe5, 0x41, 0x42,
+	0x84, 0x47, 0xa0, 0x42, 0x4e, 0x49, 0xc4, 0x99, 0x59, 0x92, 0x94, 0x6d, 0x2d, 0xa7, 0xec, 0x88,
+	0xc7, 0x7e, 0xe4, 0x3d, 0x13, 0xde, 0xee, 0xba, 0xe6, 0x6b, 0x35, 0x07, 0x32, 0xa4, 0x43, 0xed,
+	0x96, 0xbf, 0xff, 0xa3, 0x65, 0xd8, 0x31, 0x68, 0xe4, 0xac, 0x82, 0x43, 0xd1, 0x6e, 0x32, 0xa7,
+	0x3a, 0x92, 0x32, 0xdc, 0x07, 0x00, 0x73, 0x1e, 0xfb, 0xfd, 0x84, 0x13, 0x66, 0x16, 0x65, 0x06,
+	0x9b, 0x37, 0x14, 0x6d, 0xee, 0xeb, 0x96, 0xc5, 0xfd, 0x28, 0x77, 0x58, 0xdf, 0xf9, 0x08, 0xd4,
+	0x17, 0x4e, 0xe2, 0xb5, 0x2f, 0xc9, 0x54, 0x5f, 0x28, 0x44, 0xb8, 0x0e, 0x56, 0x4e, 0x71, 0x90,
+	0x10, 0xcd, 0x80, 0x52, 0xec, 0xdf, 0x0d, 0x50, 0xdd, 0xc3, 0x6c, 0x5f, 0x54, 0xf5, 0xf1, 0x95,
+	0xaa, 0x8a, 0xa3, 0x65, 0xf7, 0x9d, 0x59, 0x6a, 0xfd, 0x7f, 0x8a, 0xc3, 0x60, 0xd7, 0xce, 0x6c,
+	0x76, 0xbe, 0xd8, 0x4e, 0xae, 0xd8, 0x45, 0x79, 0xe6, 0xce, 0x2c, 0xb5, 0xd6, 0xb2, 0x33, 0xc2,
+	0x62, 0x67, 0x1d, 0x40, 0x40, 0x75, 0x1c, 0xd3, 0x13, 0x3f, 0x20, 0x9a, 0xf6, 0x0f, 0x96, 0x3f,
+	0x7a, 0x0f, 0xb3, 0xaf, 0x95, 0xef, 0xb3, 0x88, 0xc7, 0x53, 0xb7, 0x25, 0x9e, 0x3e, 0x4b, 0x2d,
+	0x53, 0x45, 0xd7, 0x71, 0x3e, 0xa4, 0xa1, 0xcf, 0x49, 0x38, 0xe6, 0x53, 0x1b, 0xcd, 0x63, 0xdb,
+	0x3f, 0x16, 0xc1, 0xda, 0xb5, 0xe3, 0x70, 0xfb, 0x7a, 0x7f, 0xac, 0xb8, 0xeb, 0xb3, 0xd4, 0xba,
+	0xad, 0xa2, 0x2d, 0x4c, 0x76, 0xae, 0x6b, 0xf6, 0xc0, 0xaa, 0xc0, 0x45, 0xc5, 0x7a, 0x49, 0x1c,
+	0xe8, 0xe9, 0xde, 0xba, 0x48, 0x2d, 0x70, 0xc8, 0xbc, 0xee, 0x74, 0x4c, 0x8e, 0xd1, 0xc1, 0x2c,
+	0xb5, 0xee, 0x64, 0x31, 0xe6, 0xbe, 0x36, 0x02, 0xa1, 0x76, 0x89, 0x03, 0x71, 0x37, 0xe3, 0x34,
+	0x26, 0x3d, 0x51, 0x16, 0x39, 0xfc, 0xf9, 0xbb, 0x17, 0x26, 0x1b, 0xd5, 0xa4, 0xfc, 0x25, 0x99,
+	0x8a, 0x29, 0xa0, 0x63, 0x12, 0x63, 0xee, 0xd3, 0x48, 0xae, 0x85, 0x3a, 0xca, 0x80, 0x2b, 0xbc,
+	0xaf, 0xfc, 0x37, 0xef, 0xf6, 0x77, 0xa0, 0x82, 0x08, 0x4b, 0x02, 0xbe, 0xd8, 0x2a, 0x82, 0x81,
+	0x55, 0xbd, 0x55, 0xfe, 0x3d, 0x1d, 0x8f, 0xaf, 0x4d, 0xc7, 0x5d, 0x27, 0xdb, 0xa0, 0xaa, 0x4a,
+	0x6a, 0x1c, 0x54, 0x3b, 0x2e, 0xda, 0x5f, 0xce, 0xe6, 0x2b, 0x03, 0xc0, 0x23, 0x3f, 0x4c, 0x02,
+	0x99, 0xea, 0x62, 0x79, 0x3e, 0x57, 0x29, 0xcb, 0x75, 0x62, 0xc8, 0x15, 0xf0, 0xfe, 0x8d, 0xb5,
+	0x17, 0x5d, 0xe9, 0xd6, 0x44, 0xfc, 0xf3, 0xd4, 0x32, 0xe4, 0x53, 0x04, 0x04, 0x3f, 0x05, 0x95,
+	0x58, 0x3e, 0x45, 0xe6, 0xdb, 0xd8, 0x69, 0x2d, 0x8f, 0xa2, 0x9e, 0x8c, 0xb4, 0xbf, 0xfd, 0x19,
+	0xa8, 0x1e, 0x32, 0xef, 0x0b, 0xf1, 0xe2, 0x0d, 0x50, 0x9b, 0x57, 0x4b, 0x8f, 0x49, 0x55, 0x97,
+	0x6b, 0x41, 0x50, 0x31, 0x23, 0x48, 0xcf, 0xd8, 0x0b, 0x50, 0xef, 0x4e, 0xe6, 0x11, 0x3e, 0x5e,
+	0xf0, 0x58, 0xba, 0xf9, 0x29, 0xfa, 0xc0, 0x95, 0x48, 0xbf, 0x15, 0xc1, 0xda, 0x11, 0xc1, 0xf1,
+	0x60, 0xd4, 0x9d, 0x30, 0x5d, 0x98, 0xe7, 0xa0, 0xc1, 0x29, 0xc7, 0x41, 0x6f, 0x40, 0x93, 0x88,
+	0xeb, 0x09, 0xdc, 0x7a, 0x9b, 0x5a, 0x79, 0x78, 0x96, 0x5a, 0x50, 0x15, 0x39, 0x07, 0xda, 0x08,
+	0x48, 0xed, 0xa9, 0x50, 0xc4, 0xa8, 0xab, 0x08, 0x72, 0x1e, 0x91, 0x52, 0x44, 0xf4, 0x31, 0xf6,
+	0x48, 0x2f, 0x4a, 0xc2, 0x3e, 0x89, 0xcd, 0x52, 0x16, 0x3d, 0x07, 0x67, 0xd1, 0x73, 0xa0, 0x8d,
+	0x80, 0xd0, 0xbe, 0x92, 0x0a, 0x74, 0x81, 0xd4, 0x7a, 0xf2, 0x42, 0xd9, 0x97, 0x65, 0x77, 0xf3,
+	0x6d, 0x6a, 0xe5, 0xd0, 0x6c, 0x69, 0x64, 0x98, 0x8d, 0xea, 0x42, 0xe9, 0x0a, 0x59, 0x64, 0x18,
+	0xf8, 0xa1, 0xcf, 0x55, 0xe7, 0x22, 0xa5, 0xc0, 0x4f, 0x40, 0x89, 0x4f, 0x98, 0x59, 0x91, 0x7c,
+	0x3e, 0x5c, 0xce, 0x67, 0xf6, 0x7f, 0x8c, 0xc4, 0x01, 0xc5, 0xa8, 0xfb, 0xf9, 0x9b, 0xbf, 0x9b,
+	0x85, 0xd7, 0x17, 0x4d, 0xe3, 0xfc, 0xa2, 0x69, 0xfc, 0x75, 0xd1, 0x34, 0x7e, 0xba, 0x6c, 0x16,
+	0xce, 0x2f, 0x9b, 0x85, 0x37, 0x97, 0xcd, 0xc2, 0xb7, 0xb6, 0xe7, 0xf3, 0x51, 0xd2, 0x77, 0x06,
+	0x34, 0xec, 0xe8, 0xef, 0x0b, 0xf5, 0xf3, 0x11, 0x1b, 0xbe, 0x54, 0x1f, 0x03, 0xfd, 0x8a, 0xfc,
+	0x23, 0x7a, 0xf4, 0xcf, 0x00, 0xe6, 0xe1, 0x99, 0xee, 0x81, 0x08, 0x00, 0x00,
 }
 
 func (m *TxResponse) Marshal() (dAtA []byte, err error) {
@@ -931,6 +1036,20 @@ func (m *GasInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Profile) > 0 {
+		for iNdEx := len(m.Profile) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Profile[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAbci(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.GasUsed != 0 {
 		i = encodeVarintAbci(dAtA, i, uint64(m.GasUsed))
 		i--
@@ -944,6 +1063,60 @@ func (m *GasInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GasProfileEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasProfileEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasProfileEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintAbci(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Operation) > 0 {
+		i -= len(m.Operation)
+		copy(dAtA[i:], m.Operation)
+		i = encodeVarintAbci(dAtA, i, uint64(len(m.Operation)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.StoreKey) > 0 {
+		i -= len(m.StoreKey)
+		copy(dAtA[i:], m.StoreKey)
+		i = encodeVarintAbci(dAtA, i, uint64(len(m.StoreKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MsgTypeURL) > 0 {
+		i -= len(m.MsgTypeURL)
+		copy(dAtA[i:], m.MsgTypeURL)
+		i = encodeVarintAbci(dAtA, i, uint64(len(m.MsgTypeURL)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MsgIndex != 0 {
+		i = encodeVarintAbci(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Result) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1312,6 +1485,39 @@ func (m *GasInfo) Size() (n int) {
 	if m.GasUsed != 0 {
 		n += 1 + sovAbci(uint64(m.GasUsed))
 	}
+	if len(m.Profile) > 0 {
+		for _, e := range m.Profile {
+			l = e.Size()
+			n += 1 + l + sovAbci(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GasProfileEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgIndex != 0 {
+		n += 1 + sovAbci(uint64(m.MsgIndex))
+	}
+	l = len(m.MsgTypeURL)
+	if l > 0 {
+		n += 1 + l + sovAbci(uint64(l))
+	}
+	l = len(m.StoreKey)
+	if l > 0 {
+		n += 1 + l + sovAbci(uint64(l))
+	}
+	l = len(m.Operation)
+	if l > 0 {
+		n += 1 + l + sovAbci(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovAbci(uint64(m.GasUsed))
+	}
 	return n
 }
 
@@ -2328,6 +2534,224 @@ func (m *GasInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Profile = append(m.Profile, GasProfileEntry{})
+			if err := m.Profile[len(m.Profile)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAbci(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasProfileEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAbci
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasProfileEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasProfileEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAbci(dAtA[iNdEx:])
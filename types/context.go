@@ -36,6 +36,7 @@ type Context struct {
 	minGasPrice   DecCoins
 	consParams    *abci.ConsensusParams
 	eventManager  *EventManager
+	gasProfiler   *GasProfiler
 }
 
 // Proposed rename, not done to avoid API breakage
@@ -56,6 +57,7 @@ func (c Context) IsCheckTx() bool             { return c.checkTx }
 func (c Context) IsReCheckTx() bool           { return c.recheckTx }
 func (c Context) MinGasPrices() DecCoins      { return c.minGasPrice }
 func (c Context) EventManager() *EventManager { return c.eventManager }
+func (c Context) GasProfiler() *GasProfiler   { return c.gasProfiler }
 
 // clone the header before returning
 func (c Context) BlockHeader() tmproto.Header {
@@ -150,12 +152,27 @@ func (c Context) WithVoteInfos(voteInfo []abci.VoteInfo) Context {
 	return c
 }
 
-// WithGasMeter returns a Context with an updated transaction GasMeter.
+// WithGasMeter returns a Context with an updated transaction GasMeter. The gas
+// meter reports to the GasProfiler of the Context, if any.
 func (c Context) WithGasMeter(meter GasMeter) Context {
+	if c.gasProfiler != nil {
+		meter = c.gasProfiler.GasMeter(meter)
+	}
+
 	c.gasMeter = meter
 	return c
 }
 
+// WithGasProfiler returns a Context with an updated GasProfiler, which the gas
+// consumed through the Context is reported to.
+func (c Context) WithGasProfiler(profiler *GasProfiler) Context {
+	c.gasProfiler = profiler
+	if profiler != nil && c.gasMeter != nil {
+		c.gasMeter = profiler.GasMeter(c.gasMeter)
+	}
+	return c
+}
+
 // WithBlockGasMeter returns a Context with an updated block GasMeter
 func (c Context) WithBlockGasMeter(meter GasMeter) Context {
 	c.blockGasMeter = meter
@@ -226,12 +243,22 @@ func (c Context) Value(key interface{}) interface{} {
 
 // KVStore fetches a KVStore from the MultiStore.
 func (c Context) KVStore(key StoreKey) KVStore {
-	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), stypes.KVGasConfig())
+	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.storeGasMeter(key), stypes.KVGasConfig())
 }
 
 // TransientStore fetches a TransientStore from the MultiStore.
 func (c Context) TransientStore(key StoreKey) KVStore {
-	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), stypes.TransientGasConfig())
+	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.storeGasMeter(key), stypes.TransientGasConfig())
+}
+
+// storeGasMeter returns the gas meter of the operations on a store, which
+// reports to the GasProfiler of the Context, if any.
+func (c Context) storeGasMeter(key StoreKey) GasMeter {
+	if c.gasProfiler == nil {
+		return c.GasMeter()
+	}
+
+	return c.gasProfiler.StoreGasMeter(c.GasMeter(), key.Name())
 }
 
 // CacheContext returns a new Context with the multi-store cached and a new
@@ -11,6 +11,7 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/tests/mocks"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/types"
@@ -51,6 +52,31 @@ func (s *contextTestSuite) TestCacheContext() {
 	s.Require().Equal(v2, store.Get(k2))
 }
 
+func (s *contextTestSuite) TestGasProfiler() {
+	key := types.NewKVStoreKey(s.T().Name() + "_TestGasProfiler")
+	ctx := testutil.DefaultContext(key, types.NewTransientStoreKey("transient_"+s.T().Name()))
+
+	profiler := types.NewGasProfiler()
+	ctx = ctx.WithGasProfiler(profiler).WithGasMeter(types.NewGasMeter(100000))
+	s.Require().Equal(profiler, ctx.GasProfiler())
+
+	ctx.GasMeter().ConsumeGas(10, "ante")
+	profiler.SetMsg(0, "/test.Msg")
+	ctx.KVStore(key).Set([]byte("key"), []byte("value"))
+	ctx.KVStore(key).Delete([]byte("key"))
+	profiler.SetMsg(-1, "")
+
+	// the gas is consumed once from the meter of the context
+	gasConfig := storetypes.KVGasConfig()
+	writeGas := gasConfig.WriteCostFlat + 5*gasConfig.WriteCostPerByte
+	s.Require().Equal(10+writeGas+gasConfig.DeleteCost, ctx.GasMeter().GasConsumed())
+	s.Require().Equal([]types.GasProfileEntry{
+		{MsgIndex: -1, Operation: "ante", GasUsed: 10},
+		{MsgIndex: 0, MsgTypeURL: "/test.Msg", StoreKey: key.Name(), Operation: types.GasOperationDelete, GasUsed: gasConfig.DeleteCost},
+		{MsgIndex: 0, MsgTypeURL: "/test.Msg", StoreKey: key.Name(), Operation: types.GasOperationWrite, GasUsed: writeGas},
+	}, profiler.Profile())
+}
+
 func (s *contextTestSuite) TestLogContext() {
 	key := types.NewKVStoreKey(s.T().Name())
 	ctx := testutil.DefaultContext(key, types.NewTransientStoreKey("transient_"+s.T().Name()))
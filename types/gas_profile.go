@@ -0,0 +1,157 @@
+package types
+
+import (
+	"sort"
+
+	yaml "gopkg.in/yaml.v2"
+
+	stypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// Store operations of a gas profile.
+const (
+	GasOperationRead    = "read"
+	GasOperationWrite   = "write"
+	GasOperationIterate = "iterate"
+	GasOperationHas     = "has"
+	GasOperationDelete  = "delete"
+)
+
+// gasOperations maps the descriptors of the gas consumed by the gas KVStore to
+// their store operation.
+var gasOperations = map[string]string{
+	stypes.GasReadCostFlatDesc:     GasOperationRead,
+	stypes.GasReadPerByteDesc:      GasOperationRead,
+	stypes.GasWriteCostFlatDesc:    GasOperationWrite,
+	stypes.GasWritePerByteDesc:     GasOperationWrite,
+	stypes.GasIterNextCostFlatDesc: GasOperationIterate,
+	stypes.GasValuePerByteDesc:     GasOperationIterate,
+	stypes.GasHasDesc:              GasOperationHas,
+	stypes.GasDeleteDesc:           GasOperationDelete,
+}
+
+func (e GasProfileEntry) String() string {
+	bz, _ := yaml.Marshal(e)
+	return string(bz)
+}
+
+type gasProfileKey struct {
+	msgIndex   int32
+	msgTypeURL string
+	storeKey   string
+	operation  string
+}
+
+// GasProfiler records the gas consumed by a tx broken down by message, store key
+// and operation. The gas meters of a Context with a GasProfiler report to it, and
+// the gas is attributed to the message set with SetMsg.
+type GasProfiler struct {
+	msgIndex   int32
+	msgTypeURL string
+
+	entries map[gasProfileKey]uint64
+}
+
+// NewGasProfiler returns a GasProfiler attributing gas to no message.
+func NewGasProfiler() *GasProfiler {
+	return &GasProfiler{
+		msgIndex: -1,
+		entries:  make(map[gasProfileKey]uint64),
+	}
+}
+
+// SetMsg attributes the gas consumed from now on to the message with the given
+// index and type URL. A negative index attributes it to no message.
+func (p *GasProfiler) SetMsg(index int, typeURL string) {
+	if index < 0 {
+		p.msgIndex, p.msgTypeURL = -1, ""
+		return
+	}
+
+	p.msgIndex, p.msgTypeURL = int32(index), typeURL
+}
+
+// record adds gas consumed on a store, empty for no store.
+func (p *GasProfiler) record(storeKey, descriptor string, amount Gas) {
+	operation := descriptor
+	if storeKey != "" {
+		if op, ok := gasOperations[descriptor]; ok {
+			operation = op
+		}
+	}
+
+	key := gasProfileKey{
+		msgIndex:   p.msgIndex,
+		msgTypeURL: p.msgTypeURL,
+		storeKey:   storeKey,
+		operation:  operation,
+	}
+	p.entries[key] += amount
+}
+
+// Profile returns the gas recorded, sorted by message, store key and operation.
+func (p *GasProfiler) Profile() []GasProfileEntry {
+	profile := make([]GasProfileEntry, 0, len(p.entries))
+	for key, gas := range p.entries {
+		profile = append(profile, GasProfileEntry{
+			MsgIndex:   key.msgIndex,
+			MsgTypeURL: key.msgTypeURL,
+			StoreKey:   key.storeKey,
+			Operation:  key.operation,
+			GasUsed:    gas,
+		})
+	}
+
+	sort.Slice(profile, func(i, j int) bool {
+		a, b := profile[i], profile[j]
+		switch {
+		case a.MsgIndex != b.MsgIndex:
+			return a.MsgIndex < b.MsgIndex
+		case a.StoreKey != b.StoreKey:
+			return a.StoreKey < b.StoreKey
+		default:
+			return a.Operation < b.Operation
+		}
+	})
+
+	return profile
+}
+
+// GasMeter returns a GasMeter consuming gas from the given meter and reporting
+// it to the profiler as gas not consumed on a store.
+func (p *GasProfiler) GasMeter(meter GasMeter) GasMeter {
+	return p.StoreGasMeter(meter, "")
+}
+
+// StoreGasMeter returns a GasMeter consuming gas from the given meter and
+// reporting it to the profiler as gas consumed on the store with the given key.
+func (p *GasProfiler) StoreGasMeter(meter GasMeter, storeKey string) GasMeter {
+	// do not report the gas twice
+	if pm, ok := meter.(*profilingGasMeter); ok {
+		if pm.profiler == p && pm.storeKey == storeKey {
+			return pm
+		}
+		meter = pm.GasMeter
+	}
+
+	return &profilingGasMeter{
+		GasMeter: meter,
+		profiler: p,
+		storeKey: storeKey,
+	}
+}
+
+// profilingGasMeter is a GasMeter reporting the gas consumed to a GasProfiler.
+type profilingGasMeter struct {
+	GasMeter
+
+	profiler *GasProfiler
+	storeKey string
+}
+
+// ConsumeGas reports the gas to the profiler before consuming it, so that the
+// gas of the operation running out of gas is included in the profile.
+func (m *profilingGasMeter) ConsumeGas(amount Gas, descriptor string) {
+	m.profiler.record(m.storeKey, descriptor, amount)
+	m.GasMeter.ConsumeGas(amount, descriptor)
+}
@@ -20,6 +20,7 @@ type (
 	MultiStorePersistentCache = types.MultiStorePersistentCache
 	KVStore                   = types.KVStore
 	Iterator                  = types.Iterator
+	WriteListener             = types.WriteListener
 )
 
 // StoreDecoderRegistry defines each of the modules store decoders. Used for ImportExport
@@ -70,6 +71,7 @@ const (
 	StoreTypeIAVL      = types.StoreTypeIAVL
 	StoreTypeTransient = types.StoreTypeTransient
 	StoreTypeMemory    = types.StoreTypeMemory
+	StoreTypeSMT       = types.StoreTypeSMT
 )
 
 type (
@@ -298,6 +298,9 @@ func (m *BroadcastTxResponse) GetTxResponse() *types.TxResponse {
 type SimulateRequest struct {
 	// tx is the transaction to simulate.
 	Tx *Tx `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	// profile requests the breakdown of the gas used by store key, operation
+	// and message in the returned gas_info.
+	Profile bool `protobuf:"varint,2,opt,name=profile,proto3" json:"profile,omitempty"`
 }
 
 func (m *SimulateRequest) Reset()         { *m = SimulateRequest{} }
@@ -340,6 +343,13 @@ func (m *SimulateRequest) GetTx() *Tx {
 	return nil
 }
 
+func (m *SimulateRequest) GetProfile() bool {
+	if m != nil {
+		return m.Profile
+	}
+	return false
+}
+
 // SimulateResponse is the response type for the
 // Service.SimulateRPC method.
 type SimulateResponse struct {
@@ -513,54 +523,54 @@ func init() {
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/service.proto", fileDescriptor_e0b00a618705eca7) }
 
 var fileDescriptor_e0b00a618705eca7 = []byte{
-	// 737 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcd, 0x4f, 0x13, 0x41,
-	0x14, 0xef, 0xb6, 0xc8, 0xc7, 0x2b, 0x68, 0x1d, 0x10, 0x6b, 0xd1, 0xa5, 0x2c, 0x16, 0x08, 0x89,
-	0xbb, 0xa1, 0x7a, 0x20, 0xc6, 0xc4, 0xd0, 0x52, 0x08, 0x51, 0x3e, 0xb2, 0xc5, 0x83, 0xc6, 0xa4,
-	0x99, 0xb6, 0xc3, 0xb2, 0x91, 0xee, 0x94, 0xce, 0x94, 0x2c, 0x01, 0x62, 0xe2, 0xd1, 0x93, 0x89,
-	0xff, 0x94, 0x47, 0x12, 0x2f, 0x1e, 0x0d, 0xf8, 0x47, 0x78, 0x34, 0x3b, 0x3b, 0x6d, 0xb7, 0x65,
-	0x0b, 0xc4, 0x13, 0x33, 0xcc, 0xef, 0xfd, 0x3e, 0xde, 0x9b, 0x9d, 0xc2, 0x74, 0x85, 0xb2, 0x1a,
-	0x65, 0x06, 0x77, 0x8d, 0xa3, 0xa5, 0x32, 0xe1, 0x78, 0xc9, 0x60, 0xa4, 0x71, 0x64, 0x57, 0x88,
-	0x5e, 0x6f, 0x50, 0x4e, 0xd1, 0x7d, 0x1f, 0xa0, 0x73, 0x57, 0x97, 0x80, 0xd4, 0x63, 0x8b, 0x52,
-	0xeb, 0x80, 0x18, 0xb8, 0x6e, 0x1b, 0xd8, 0x71, 0x28, 0xc7, 0xdc, 0xa6, 0x0e, 0xf3, 0x0b, 0x52,
-	0xb3, 0x92, 0xb1, 0x8c, 0x19, 0x31, 0x70, 0xb9, 0x62, 0xb7, 0x89, 0xbd, 0x8d, 0x04, 0xa5, 0xae,
-	0xca, 0x72, 0x57, 0x9e, 0x4d, 0x58, 0xd4, 0xa2, 0x62, 0x69, 0x78, 0x2b, 0xf9, 0xdf, 0xc5, 0x20,
-	0xed, 0x61, 0x93, 0x34, 0x8e, 0xdb, 0x95, 0x75, 0x6c, 0xd9, 0x8e, 0xf0, 0xe0, 0x63, 0x35, 0x0e,
-	0x68, 0x9d, 0xf0, 0x5d, 0x97, 0x15, 0x8e, 0x88, 0xc3, 0x4d, 0x72, 0xd8, 0x24, 0x8c, 0xa3, 0x49,
-	0x18, 0x24, 0xde, 0x9e, 0x25, 0x95, 0x74, 0x6c, 0x61, 0xc4, 0x94, 0x3b, 0xb4, 0x06, 0xd0, 0x61,
-	0x48, 0x46, 0xd3, 0xca, 0x42, 0x3c, 0x3b, 0xa7, 0xcb, 0xd8, 0x9e, 0x9c, 0x2e, 0xe4, 0x5a, 0xf1,
-	0xf5, 0x1d, 0x6c, 0x11, 0xc9, 0x69, 0x06, 0x2a, 0xb5, 0x73, 0x05, 0xc6, 0xbb, 0x64, 0x59, 0x9d,
-	0x3a, 0x8c, 0xa0, 0x79, 0x88, 0x71, 0xd7, 0x17, 0x8d, 0x67, 0x1f, 0xe8, 0x57, 0xfa, 0xa9, 0xef,
-	0xba, 0xa6, 0x87, 0x40, 0xeb, 0x30, 0xca, 0xdd, 0x52, 0x43, 0xd6, 0xb1, 0x64, 0x54, 0x54, 0x3c,
-	0xed, 0xb2, 0x22, 0x7a, 0x18, 0x28, 0x94, 0x60, 0x33, 0xce, 0xdb, 0x6b, 0x8f, 0x28, 0x98, 0x28,
-	0x26, 0x12, 0xcd, 0xdf, 0x98, 0x48, 0x32, 0x05, 0x23, 0x11, 0x40, 0xb9, 0x06, 0xc5, 0xd5, 0x0a,
-	0x66, 0xdc, 0x13, 0xf3, 0x1b, 0xf9, 0x08, 0x86, 0xb9, 0x5b, 0x2a, 0x1f, 0x73, 0xe2, 0xa5, 0x52,
-	0x16, 0x46, 0xcd, 0x21, 0xee, 0xe6, 0xbc, 0x2d, 0x7a, 0x01, 0x03, 0x35, 0x5a, 0x25, 0xa2, 0x8b,
-	0x77, 0xb3, 0xe9, 0x90, 0xb0, 0x6d, 0xbe, 0x4d, 0x5a, 0x25, 0xa6, 0x40, 0x6b, 0x1f, 0x61, 0xbc,
-	0x4b, 0x46, 0x36, 0xae, 0x00, 0xf1, 0x40, 0x3f, 0x84, 0xd4, 0x6d, 0xdb, 0x01, 0x9d, 0x76, 0x68,
-	0xcb, 0x70, 0xaf, 0x68, 0xd7, 0x9a, 0x07, 0x98, 0xb7, 0xc6, 0x86, 0x32, 0x10, 0xe5, 0xae, 0x24,
-	0xec, 0x33, 0x91, 0x28, 0x77, 0xb5, 0xaf, 0x0a, 0x24, 0x3a, 0xa5, 0xd2, 0xd5, 0x2b, 0x18, 0xb6,
-	0x30, 0x2b, 0xd9, 0xce, 0x1e, 0x95, 0x0c, 0x33, 0xfd, 0x2d, 0xad, 0x63, 0xb6, 0xe1, 0xec, 0x51,
-	0x73, 0xc8, 0xf2, 0x17, 0x68, 0x19, 0x06, 0x1b, 0x84, 0x35, 0x0f, 0xb8, 0xbc, 0x68, 0xe9, 0xfe,
-	0xb5, 0xa6, 0xc0, 0x99, 0x12, 0xaf, 0x69, 0x30, 0x2a, 0x6e, 0x57, 0x2b, 0x03, 0x82, 0x81, 0x7d,
-	0xcc, 0xf6, 0x85, 0x87, 0x11, 0x53, 0xac, 0xb5, 0x33, 0x18, 0x93, 0x18, 0x69, 0xf6, 0x76, 0x41,
-	0x7b, 0x3b, 0x1d, 0xfd, 0xbf, 0x4e, 0x2f, 0x9e, 0xc2, 0x58, 0xd7, 0x78, 0x91, 0x0a, 0xa9, 0x9c,
-	0xb9, 0xbd, 0xb2, 0x9a, 0x5f, 0x29, 0xee, 0x96, 0x36, 0xb7, 0x57, 0x0b, 0xa5, 0x77, 0x5b, 0xc5,
-	0x9d, 0x42, 0x7e, 0x63, 0x6d, 0xa3, 0xb0, 0x9a, 0x88, 0xa0, 0x24, 0x4c, 0xf4, 0x9c, 0xe7, 0xde,
-	0x6e, 0xe7, 0xdf, 0x24, 0x14, 0xf4, 0x10, 0xc6, 0x7b, 0x4e, 0x8a, 0xef, 0xb7, 0xf2, 0x89, 0x68,
-	0x48, 0xc9, 0x8a, 0x38, 0x89, 0x65, 0xff, 0xc6, 0x60, 0xa8, 0xe8, 0xbf, 0x5d, 0xe8, 0x04, 0x86,
-	0x5b, 0x83, 0x43, 0x5a, 0x48, 0xee, 0x9e, 0x0b, 0x91, 0x9a, 0xbd, 0x16, 0x23, 0x2f, 0xd2, 0xdc,
-	0x97, 0x9f, 0x7f, 0xbe, 0x47, 0xd3, 0xda, 0x94, 0x11, 0xf2, 0x68, 0x4a, 0xf0, 0x4b, 0x65, 0x11,
-	0x1d, 0xc2, 0x1d, 0x31, 0x05, 0x34, 0x1d, 0xc2, 0x1a, 0x9c, 0x61, 0x2a, 0xdd, 0x1f, 0x20, 0x35,
-	0x33, 0x42, 0x73, 0x1a, 0x3d, 0x31, 0xc2, 0x5e, 0x4c, 0x66, 0x9c, 0x78, 0x73, 0x3f, 0x43, 0x9f,
-	0x21, 0x1e, 0xf8, 0x82, 0x50, 0xe6, 0xba, 0x0f, 0xaf, 0x23, 0x3f, 0x77, 0x13, 0x4c, 0x9a, 0x98,
-	0x11, 0x26, 0xa6, 0xb4, 0xc9, 0x70, 0x13, 0x5e, 0xe6, 0x53, 0x88, 0x07, 0xde, 0xbe, 0x50, 0x03,
-	0x57, 0x9f, 0xe4, 0x50, 0x03, 0x21, 0x4f, 0xa8, 0xa6, 0x0a, 0x03, 0x49, 0xd4, 0xc7, 0x40, 0xee,
-	0xf5, 0x8f, 0x0b, 0x55, 0x39, 0xbf, 0x50, 0x95, 0xdf, 0x17, 0xaa, 0xf2, 0xed, 0x52, 0x8d, 0x9c,
-	0x5f, 0xaa, 0x91, 0x5f, 0x97, 0x6a, 0xe4, 0x43, 0xc6, 0xb2, 0xf9, 0x7e, 0xb3, 0xac, 0x57, 0x68,
-	0xad, 0x55, 0xeb, 0xff, 0x79, 0xc6, 0xaa, 0x9f, 0x0c, 0x7e, 0x5c, 0x27, 0x1e, 0x59, 0x79, 0x50,
-	0xfc, 0x70, 0x3c, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff, 0x96, 0xba, 0xfb, 0xcb, 0x0f, 0x07, 0x00,
-	0x00,
+	// 750 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x4f, 0x13, 0x4f,
+	0x14, 0xef, 0xb6, 0x7c, 0x69, 0x79, 0x85, 0xaf, 0x75, 0x40, 0xac, 0x45, 0x97, 0xb2, 0xd8, 0x42,
+	0x48, 0xdc, 0x0d, 0xd5, 0x83, 0x31, 0x26, 0x86, 0x96, 0x42, 0x88, 0xf2, 0x23, 0xdb, 0x7a, 0xd0,
+	0x98, 0x34, 0xdb, 0x76, 0x58, 0x36, 0xb6, 0x3b, 0xa5, 0x33, 0x25, 0x4b, 0x80, 0x98, 0x78, 0xf4,
+	0x64, 0xe2, 0x3f, 0xe5, 0x91, 0xc4, 0x8b, 0x47, 0x03, 0xfe, 0x11, 0x1e, 0xcd, 0xce, 0x4e, 0xdb,
+	0x6d, 0xd9, 0x02, 0xf1, 0xc4, 0x0c, 0xf3, 0x79, 0x9f, 0x1f, 0xef, 0xcd, 0x4e, 0x61, 0xbe, 0x46,
+	0x68, 0x93, 0x50, 0x8d, 0x39, 0xda, 0xd1, 0x6a, 0x15, 0x33, 0x63, 0x55, 0xa3, 0xb8, 0x7d, 0x64,
+	0xd5, 0xb0, 0xda, 0x6a, 0x13, 0x46, 0xd0, 0x5d, 0x0f, 0xa0, 0x32, 0x47, 0x15, 0x80, 0xd4, 0x43,
+	0x93, 0x10, 0xb3, 0x81, 0x35, 0xa3, 0x65, 0x69, 0x86, 0x6d, 0x13, 0x66, 0x30, 0x8b, 0xd8, 0xd4,
+	0x2b, 0x48, 0x2d, 0x0a, 0xc6, 0xaa, 0x41, 0xb1, 0x66, 0x54, 0x6b, 0x56, 0x8f, 0xd8, 0xdd, 0x08,
+	0x50, 0xea, 0xaa, 0x2c, 0x73, 0xc4, 0xd9, 0x8c, 0x49, 0x4c, 0xc2, 0x97, 0x9a, 0xbb, 0x12, 0xff,
+	0x5d, 0xf1, 0xd3, 0x1e, 0x76, 0x70, 0xfb, 0xb8, 0x57, 0xd9, 0x32, 0x4c, 0xcb, 0xe6, 0x1e, 0x3c,
+	0xac, 0xc2, 0x00, 0x6d, 0x62, 0x56, 0x76, 0x68, 0xf1, 0x08, 0xdb, 0x4c, 0xc7, 0x87, 0x1d, 0x4c,
+	0x19, 0x9a, 0x85, 0x71, 0xec, 0xee, 0x69, 0x52, 0x4a, 0x47, 0x96, 0x27, 0x74, 0xb1, 0x43, 0x1b,
+	0x00, 0x7d, 0x86, 0x64, 0x38, 0x2d, 0x2d, 0xc7, 0x73, 0x59, 0x55, 0xc4, 0x76, 0xe5, 0x54, 0x2e,
+	0xd7, 0x8d, 0xaf, 0xee, 0x19, 0x26, 0x16, 0x9c, 0xba, 0xaf, 0x52, 0x39, 0x97, 0x60, 0x7a, 0x40,
+	0x96, 0xb6, 0x88, 0x4d, 0x31, 0x5a, 0x82, 0x08, 0x73, 0x3c, 0xd1, 0x78, 0xee, 0x9e, 0x7a, 0xa5,
+	0x9f, 0x6a, 0xd9, 0xd1, 0x5d, 0x04, 0xda, 0x84, 0x49, 0xe6, 0x54, 0xda, 0xa2, 0x8e, 0x26, 0xc3,
+	0xbc, 0xe2, 0xf1, 0x80, 0x15, 0xde, 0x43, 0x5f, 0xa1, 0x00, 0xeb, 0x71, 0xd6, 0x5b, 0xbb, 0x44,
+	0xfe, 0x44, 0x11, 0x9e, 0x68, 0xe9, 0xc6, 0x44, 0x82, 0xc9, 0x1f, 0x09, 0x03, 0xca, 0xb7, 0x89,
+	0x51, 0xaf, 0x19, 0x94, 0x95, 0x1d, 0x11, 0x1a, 0x3d, 0x80, 0x18, 0x73, 0x2a, 0xd5, 0x63, 0x86,
+	0xdd, 0x54, 0xd2, 0xf2, 0xa4, 0x1e, 0x65, 0x4e, 0xde, 0xdd, 0xa2, 0x67, 0x30, 0xd6, 0x24, 0x75,
+	0xcc, 0xbb, 0xf8, 0x7f, 0x2e, 0x1d, 0x10, 0xb6, 0xc7, 0xb7, 0x4d, 0xea, 0x58, 0xe7, 0x68, 0xe5,
+	0x03, 0x4c, 0x0f, 0xc8, 0x88, 0xc6, 0x15, 0x21, 0xee, 0xeb, 0x07, 0x97, 0xba, 0x6d, 0x3b, 0xa0,
+	0xdf, 0x0e, 0x45, 0x87, 0x3b, 0x25, 0xab, 0xd9, 0x69, 0x18, 0xac, 0x3b, 0x36, 0x94, 0x81, 0x30,
+	0x73, 0x04, 0xe1, 0x88, 0x89, 0x84, 0x99, 0x83, 0x92, 0x10, 0x6d, 0xb5, 0xc9, 0xbe, 0xd5, 0xf0,
+	0x02, 0xc5, 0xf4, 0xee, 0x56, 0xf9, 0x22, 0x41, 0xa2, 0x4f, 0x2a, 0xfc, 0xbe, 0x84, 0x98, 0x69,
+	0xd0, 0x8a, 0x65, 0xef, 0x13, 0xc1, 0xbd, 0x30, 0xda, 0xec, 0xa6, 0x41, 0xb7, 0xec, 0x7d, 0xa2,
+	0x47, 0x4d, 0x6f, 0x81, 0x9e, 0xc3, 0x78, 0x1b, 0xd3, 0x4e, 0x83, 0x89, 0x2b, 0x98, 0x1e, 0x5d,
+	0xab, 0x73, 0x9c, 0x2e, 0xf0, 0x8a, 0x02, 0x93, 0xfc, 0xde, 0x75, 0xd3, 0x21, 0x18, 0x3b, 0x30,
+	0xe8, 0x01, 0xf7, 0x30, 0xa1, 0xf3, 0xb5, 0x72, 0x06, 0x53, 0x02, 0x23, 0xcc, 0xde, 0xb2, 0x05,
+	0x43, 0x33, 0x08, 0xff, 0xdb, 0x0c, 0x56, 0x4e, 0x61, 0x6a, 0x60, 0xf0, 0x48, 0x86, 0x54, 0x5e,
+	0xdf, 0x5d, 0x5b, 0x2f, 0xac, 0x95, 0xca, 0x95, 0xed, 0xdd, 0xf5, 0x62, 0xe5, 0xed, 0x4e, 0x69,
+	0xaf, 0x58, 0xd8, 0xda, 0xd8, 0x2a, 0xae, 0x27, 0x42, 0x28, 0x09, 0x33, 0x43, 0xe7, 0xf9, 0x37,
+	0xbb, 0x85, 0xd7, 0x09, 0x09, 0xdd, 0x87, 0xe9, 0xa1, 0x93, 0xd2, 0xbb, 0x9d, 0x42, 0x22, 0x1c,
+	0x50, 0xb2, 0xc6, 0x4f, 0x22, 0xb9, 0x3f, 0x11, 0x88, 0x96, 0xbc, 0x57, 0x0d, 0x9d, 0x40, 0xac,
+	0x3b, 0x38, 0xa4, 0x04, 0xe4, 0x1e, 0xba, 0x2a, 0xa9, 0xc5, 0x6b, 0x31, 0xe2, 0x8a, 0x65, 0x3f,
+	0xff, 0xf8, 0xfd, 0x2d, 0x9c, 0x56, 0xe6, 0xb4, 0x80, 0xe7, 0x54, 0x80, 0x5f, 0x48, 0x2b, 0xe8,
+	0x10, 0xfe, 0xe3, 0x53, 0x40, 0xf3, 0x01, 0xac, 0xfe, 0x19, 0xa6, 0xd2, 0xa3, 0x01, 0x42, 0x33,
+	0xc3, 0x35, 0xe7, 0xd1, 0x23, 0x2d, 0xe8, 0x2d, 0xa5, 0xda, 0x89, 0x3b, 0xf7, 0x33, 0xf4, 0x09,
+	0xe2, 0xbe, 0x6f, 0x0b, 0x65, 0xae, 0xfb, 0x24, 0xfb, 0xf2, 0xd9, 0x9b, 0x60, 0xc2, 0xc4, 0x02,
+	0x37, 0x31, 0xa7, 0xcc, 0x06, 0x9b, 0x70, 0x33, 0x9f, 0x42, 0xdc, 0xf7, 0x2a, 0x06, 0x1a, 0xb8,
+	0xfa, 0x58, 0xa7, 0xb2, 0x37, 0xc1, 0x84, 0x01, 0x99, 0x1b, 0x48, 0xa2, 0x11, 0x06, 0xf2, 0xaf,
+	0xbe, 0x5f, 0xc8, 0xd2, 0xf9, 0x85, 0x2c, 0xfd, 0xba, 0x90, 0xa5, 0xaf, 0x97, 0x72, 0xe8, 0xfc,
+	0x52, 0x0e, 0xfd, 0xbc, 0x94, 0x43, 0xef, 0x33, 0xa6, 0xc5, 0x0e, 0x3a, 0x55, 0xb5, 0x46, 0x9a,
+	0xdd, 0x5a, 0xef, 0xcf, 0x13, 0x5a, 0xff, 0xa8, 0xb1, 0xe3, 0x16, 0x76, 0xc9, 0xaa, 0xe3, 0xfc,
+	0x27, 0xe5, 0xe9, 0xdf, 0x01, 0x00, 0x2e, 0x0c, 0x60, 0x9f, 0x29, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -956,6 +966,16 @@ func (m *SimulateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Profile {
+		i--
+		if m.Profile {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Tx != nil {
 		{
 			size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
@@ -1189,6 +1209,9 @@ func (m *SimulateRequest) Size() (n int) {
 		l = m.Tx.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
+	if m.Profile {
+		n += 2
+	}
 	return n
 }
 
@@ -1771,6 +1794,26 @@ func (m *SimulateRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Profile = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -259,6 +259,13 @@ type TxBody struct {
 	// timeout is the block height after which this transaction will not
 	// be processed by the chain
 	TimeoutHeight uint64 `protobuf:"varint,3,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
+	// unordered, when set to true, indicates that the transaction signer(s)
+	// intend for the transaction to be evaluated and executed in an un-ordered
+	// fashion. The sequence numbers of the signers are neither checked nor
+	// incremented. Instead, replay protection relies on a non-zero
+	// timeout_height, bounded by the chain, and on the hash of the transaction,
+	// which is recorded until the timeout height is reached.
+	Unordered bool `protobuf:"varint,4,opt,name=unordered,proto3" json:"unordered,omitempty"`
 	// extension_options are arbitrary options that can be added by chains
 	// when the default options are not sufficient. If any of these are present
 	// and can't be handled, the transaction will be rejected
@@ -323,6 +330,13 @@ func (m *TxBody) GetTimeoutHeight() uint64 {
 	return 0
 }
 
+func (m *TxBody) GetUnordered() bool {
+	if m != nil {
+		return m.Unordered
+	}
+	return false
+}
+
 func (m *TxBody) GetExtensionOptions() []*types.Any {
 	if m != nil {
 		return m.ExtensionOptions
@@ -758,60 +772,61 @@ func init() {
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/tx.proto", fileDescriptor_96d1575ffde80842) }
 
 var fileDescriptor_96d1575ffde80842 = []byte{
-	// 843 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xdd, 0x6e, 0xdc, 0x44,
-	0x14, 0x5e, 0xef, 0x5f, 0xd6, 0x27, 0x49, 0x4b, 0x47, 0x11, 0xda, 0x6c, 0x54, 0x37, 0x18, 0x15,
-	0xf6, 0x26, 0x76, 0x9b, 0x5e, 0xf0, 0x23, 0x24, 0xc8, 0x16, 0xaa, 0x54, 0xa5, 0x20, 0x4d, 0x72,
-	0xd5, 0x1b, 0x6b, 0xec, 0x9d, 0x78, 0x47, 0x5d, 0xcf, 0x2c, 0x9e, 0x71, 0xb1, 0x1f, 0x02, 0xa9,
-	0x42, 0x42, 0xbc, 0x03, 0x2f, 0xc0, 0x2b, 0xf4, 0xb2, 0x97, 0x5c, 0x41, 0x95, 0x3c, 0x08, 0x68,
-	0xc6, 0x63, 0x27, 0x82, 0x55, 0x72, 0xc3, 0x95, 0xe7, 0x9c, 0xf9, 0xce, 0x37, 0x9f, 0xcf, 0x1f,
-	0x4c, 0x12, 0x21, 0x33, 0x21, 0x43, 0x55, 0x86, 0xaf, 0x1e, 0xc6, 0x54, 0x91, 0x87, 0xa1, 0x2a,
-	0x83, 0x55, 0x2e, 0x94, 0x40, 0x77, 0xea, 0xbb, 0x40, 0x95, 0x81, 0xbd, 0x9b, 0xec, 0xa4, 0x22,
-	0x15, 0xe6, 0x36, 0xd4, 0xa7, 0x1a, 0x38, 0x39, 0xb0, 0x24, 0x49, 0x5e, 0xad, 0x94, 0x08, 0xb3,
-	0x62, 0xa9, 0x98, 0x64, 0x69, 0xcb, 0xd8, 0x38, 0x2c, 0xdc, 0xb3, 0xf0, 0x98, 0x48, 0xda, 0x62,
-	0x12, 0xc1, 0xb8, 0xbd, 0xff, 0xf8, 0x52, 0x93, 0x64, 0x29, 0x67, 0xfc, 0x92, 0xc9, 0xda, 0x16,
-	0xb8, 0x9b, 0x0a, 0x91, 0x2e, 0x69, 0x68, 0xac, 0xb8, 0x38, 0x0b, 0x09, 0xaf, 0xea, 0x2b, 0xff,
-	0x27, 0x07, 0xba, 0xa7, 0x25, 0x3a, 0x80, 0x7e, 0x2c, 0xe6, 0xd5, 0xd8, 0xd9, 0x77, 0xa6, 0x9b,
-	0x87, 0xbb, 0xc1, 0x7f, 0xfe, 0x28, 0x38, 0x2d, 0x67, 0x62, 0x5e, 0x61, 0x03, 0x43, 0x9f, 0x82,
-	0x4b, 0x0a, 0xb5, 0x88, 0x18, 0x3f, 0x13, 0xe3, 0xae, 0x89, 0xd9, 0x5b, 0x13, 0x73, 0x54, 0xa8,
-	0xc5, 0x53, 0x7e, 0x26, 0xf0, 0x88, 0xd8, 0x13, 0xf2, 0x00, 0xb4, 0x36, 0xa2, 0x8a, 0x9c, 0xca,
-	0x71, 0x6f, 0xbf, 0x37, 0xdd, 0xc2, 0x57, 0x3c, 0x3e, 0x87, 0xc1, 0x69, 0x89, 0xc9, 0x8f, 0xe8,
-	0x2e, 0x80, 0x7e, 0x2a, 0x8a, 0x2b, 0x45, 0xa5, 0xd1, 0xb5, 0x85, 0x5d, 0xed, 0x99, 0x69, 0x07,
-	0xfa, 0x08, 0x6e, 0xb7, 0x0a, 0x2c, 0xa6, 0x6b, 0x30, 0xdb, 0xcd, 0x53, 0x35, 0xee, 0xa6, 0xf7,
-	0x7e, 0x76, 0x60, 0xe3, 0x84, 0xa5, 0xfc, 0x6b, 0x91, 0xfc, 0x5f, 0x4f, 0xee, 0xc2, 0x28, 0x59,
-	0x10, 0xc6, 0x23, 0x36, 0x1f, 0xf7, 0xf6, 0x9d, 0xa9, 0x8b, 0x37, 0x8c, 0xfd, 0x74, 0x8e, 0xee,
-	0xc3, 0x2d, 0x92, 0x24, 0xa2, 0xe0, 0x2a, 0xe2, 0x45, 0x16, 0xd3, 0x7c, 0xdc, 0xdf, 0x77, 0xa6,
-	0x7d, 0xbc, 0x6d, 0xbd, 0xdf, 0x19, 0xa7, 0xff, 0x4b, 0x17, 0x86, 0x75, 0xbe, 0xd1, 0x03, 0x18,
-	0x65, 0x54, 0x4a, 0x92, 0x1a, 0x45, 0xbd, 0xe9, 0xe6, 0xe1, 0x4e, 0x50, 0x57, 0x33, 0x68, 0xaa,
-	0x19, 0x1c, 0xf1, 0x0a, 0xb7, 0x28, 0x84, 0xa0, 0x9f, 0xd1, 0xac, 0x2e, 0x8b, 0x8b, 0xcd, 0x59,
-	0xbf, 0xab, 0x58, 0x46, 0x45, 0xa1, 0xa2, 0x05, 0x65, 0xe9, 0x42, 0x19, 0x61, 0x7d, 0xbc, 0x6d,
-	0xbd, 0xc7, 0xc6, 0x89, 0x66, 0x70, 0x87, 0x96, 0x8a, 0x72, 0xc9, 0x04, 0x8f, 0xc4, 0x4a, 0x31,
-	0xc1, 0xe5, 0xf8, 0xef, 0x8d, 0x6b, 0x9e, 0x7d, 0xaf, 0xc5, 0x7f, 0x5f, 0xc3, 0xd1, 0x0b, 0xf0,
-	0xb8, 0xe0, 0x51, 0x92, 0x33, 0xc5, 0x12, 0xb2, 0x8c, 0xd6, 0x10, 0xde, 0xbe, 0x86, 0x70, 0x8f,
-	0x0b, 0xfe, 0xd8, 0xc6, 0x7e, 0xf3, 0x2f, 0x6e, 0xff, 0x15, 0x8c, 0x9a, 0x96, 0x42, 0x5f, 0xc1,
-	0x96, 0x2e, 0x23, 0xcd, 0x4d, 0x3d, 0x9a, 0xe4, 0xdc, 0x5d, 0xd3, 0x85, 0x27, 0x06, 0x66, 0xfa,
-	0x70, 0x53, 0xb6, 0x67, 0x89, 0xa6, 0xd0, 0x3b, 0xa3, 0xd4, 0xb6, 0xef, 0xfb, 0x6b, 0x02, 0x9f,
-	0x50, 0x8a, 0x35, 0xc4, 0xff, 0xd5, 0x01, 0xb8, 0x64, 0x41, 0x8f, 0x00, 0x56, 0x45, 0xbc, 0x64,
-	0x49, 0xf4, 0x92, 0x36, 0x23, 0xb3, 0xfe, 0x6f, 0xdc, 0x1a, 0xf7, 0x8c, 0x9a, 0x91, 0xc9, 0xc4,
-	0x9c, 0xde, 0x34, 0x32, 0xcf, 0xc5, 0x9c, 0xd6, 0x23, 0x93, 0xd9, 0x13, 0x9a, 0xc0, 0x48, 0xd2,
-	0x1f, 0x0a, 0xca, 0x13, 0x6a, 0xcb, 0xd6, 0xda, 0xfe, 0xbb, 0x2e, 0x8c, 0x9a, 0x10, 0xf4, 0x05,
-	0x0c, 0x25, 0xe3, 0xe9, 0x92, 0x5a, 0x4d, 0xfe, 0x35, 0xfc, 0xc1, 0x89, 0x41, 0x1e, 0x77, 0xb0,
-	0x8d, 0x41, 0x9f, 0xc1, 0xc0, 0xec, 0x1f, 0x2b, 0xee, 0x83, 0xeb, 0x82, 0x9f, 0x6b, 0xe0, 0x71,
-	0x07, 0xd7, 0x11, 0x93, 0x23, 0x18, 0xd6, 0x74, 0xe8, 0x13, 0xe8, 0x6b, 0xdd, 0x46, 0xc0, 0xad,
-	0xc3, 0x0f, 0xaf, 0x70, 0x34, 0x1b, 0xe9, 0x6a, 0x55, 0x34, 0x1f, 0x36, 0x01, 0x93, 0xd7, 0x0e,
-	0x0c, 0x0c, 0x2b, 0x7a, 0x06, 0xa3, 0x98, 0x29, 0x92, 0xe7, 0xa4, 0xc9, 0x6d, 0xd8, 0xd0, 0xd4,
-	0x7b, 0x33, 0x68, 0xd7, 0x64, 0xc3, 0xf5, 0x58, 0x64, 0x2b, 0x92, 0xa8, 0x19, 0x53, 0x47, 0x3a,
-	0x0c, 0xb7, 0x04, 0xe8, 0x73, 0x80, 0x36, 0xeb, 0x7a, 0x5c, 0x7b, 0x37, 0xa5, 0xdd, 0x6d, 0xd2,
-	0x2e, 0x67, 0x03, 0xe8, 0xc9, 0x22, 0xf3, 0x7f, 0x77, 0xa0, 0xf7, 0x84, 0x52, 0x94, 0xc0, 0x90,
-	0x64, 0x7a, 0x48, 0x6d, 0xab, 0xb5, 0x4b, 0x52, 0xaf, 0xe7, 0x2b, 0x52, 0x18, 0x9f, 0x3d, 0x78,
-	0xf3, 0xe7, 0xbd, 0xce, 0x6f, 0x7f, 0xdd, 0x9b, 0xa6, 0x4c, 0x2d, 0x8a, 0x38, 0x48, 0x44, 0x16,
-	0x36, 0xab, 0xdf, 0x7c, 0x0e, 0xe4, 0xfc, 0x65, 0xa8, 0xaa, 0x15, 0x95, 0x26, 0x40, 0x62, 0x4b,
-	0x8d, 0xf6, 0xc0, 0x4d, 0x89, 0x8c, 0x96, 0x2c, 0x63, 0xca, 0x14, 0xa2, 0x8f, 0x47, 0x29, 0x91,
-	0xdf, 0x6a, 0x1b, 0xed, 0xc0, 0x60, 0x45, 0x2a, 0x9a, 0xdb, 0xad, 0x52, 0x1b, 0x68, 0x0c, 0x1b,
-	0x69, 0x4e, 0xb8, 0xb2, 0xcb, 0xc4, 0xc5, 0x8d, 0x39, 0xfb, 0xf2, 0xcd, 0xb9, 0xe7, 0xbc, 0x3d,
-	0xf7, 0x9c, 0x77, 0xe7, 0x9e, 0xf3, 0xfa, 0xc2, 0xeb, 0xbc, 0xbd, 0xf0, 0x3a, 0x7f, 0x5c, 0x78,
-	0x9d, 0x17, 0xf7, 0x6f, 0x16, 0x16, 0xaa, 0x32, 0x1e, 0x9a, 0x66, 0x7e, 0xf4, 0x4f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xd4, 0xb7, 0x75, 0x7d, 0xfd, 0x06, 0x00, 0x00,
+	// 855 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4d, 0x6f, 0xdc, 0x44,
+	0x18, 0x5e, 0xef, 0x57, 0xec, 0x37, 0x49, 0x4b, 0x47, 0x11, 0xda, 0x6c, 0xa8, 0x1b, 0x16, 0x15,
+	0xf6, 0x12, 0xbb, 0x4d, 0x0f, 0x7c, 0x08, 0x09, 0xb2, 0x85, 0x2a, 0x55, 0x29, 0x48, 0x93, 0x9c,
+	0x7a, 0xb1, 0xc6, 0xf6, 0xc4, 0x3b, 0xea, 0x7a, 0x66, 0xf1, 0x8c, 0xcb, 0xee, 0x8f, 0x40, 0xaa,
+	0xb8, 0xf0, 0x1f, 0xb8, 0x72, 0xe0, 0x2f, 0xf4, 0xd8, 0x23, 0x27, 0x88, 0x92, 0x1f, 0x02, 0x9a,
+	0xf1, 0xd8, 0x89, 0x60, 0x95, 0xbd, 0xf4, 0xb4, 0xf3, 0xbe, 0xf3, 0xbc, 0xcf, 0x3c, 0x7e, 0xbf,
+	0x16, 0x86, 0x89, 0x90, 0xb9, 0x90, 0xa1, 0x5a, 0x84, 0xaf, 0x1e, 0xc6, 0x54, 0x91, 0x87, 0xa1,
+	0x5a, 0x04, 0xf3, 0x42, 0x28, 0x81, 0xee, 0x54, 0x77, 0x81, 0x5a, 0x04, 0xf6, 0x6e, 0xb8, 0x93,
+	0x89, 0x4c, 0x98, 0xdb, 0x50, 0x9f, 0x2a, 0xe0, 0xf0, 0xc0, 0x92, 0x24, 0xc5, 0x72, 0xae, 0x44,
+	0x98, 0x97, 0x33, 0xc5, 0x24, 0xcb, 0x1a, 0xc6, 0xda, 0x61, 0xe1, 0xbe, 0x85, 0xc7, 0x44, 0xd2,
+	0x06, 0x93, 0x08, 0xc6, 0xed, 0xfd, 0x27, 0x57, 0x9a, 0x24, 0xcb, 0x38, 0xe3, 0x57, 0x4c, 0xd6,
+	0xb6, 0xc0, 0xdd, 0x4c, 0x88, 0x6c, 0x46, 0x43, 0x63, 0xc5, 0xe5, 0x59, 0x48, 0xf8, 0xb2, 0xba,
+	0x1a, 0xfd, 0xec, 0x40, 0xfb, 0x74, 0x81, 0x0e, 0xa0, 0x1b, 0x8b, 0x74, 0x39, 0x70, 0xf6, 0x9d,
+	0xf1, 0xe6, 0xe1, 0x6e, 0xf0, 0xbf, 0x2f, 0x0a, 0x4e, 0x17, 0x13, 0x91, 0x2e, 0xb1, 0x81, 0xa1,
+	0xcf, 0xc0, 0x23, 0xa5, 0x9a, 0x46, 0x8c, 0x9f, 0x89, 0x41, 0xdb, 0xc4, 0xec, 0xad, 0x88, 0x39,
+	0x2a, 0xd5, 0xf4, 0x29, 0x3f, 0x13, 0xd8, 0x25, 0xf6, 0x84, 0x7c, 0x00, 0xad, 0x8d, 0xa8, 0xb2,
+	0xa0, 0x72, 0xd0, 0xd9, 0xef, 0x8c, 0xb7, 0xf0, 0x35, 0xcf, 0x88, 0x43, 0xef, 0x74, 0x81, 0xc9,
+	0x4f, 0xe8, 0x2e, 0x80, 0x7e, 0x2a, 0x8a, 0x97, 0x8a, 0x4a, 0xa3, 0x6b, 0x0b, 0x7b, 0xda, 0x33,
+	0xd1, 0x0e, 0xf4, 0x31, 0xdc, 0x6e, 0x14, 0x58, 0x4c, 0xdb, 0x60, 0xb6, 0xeb, 0xa7, 0x2a, 0xdc,
+	0xba, 0xf7, 0x7e, 0x71, 0x60, 0xe3, 0x84, 0x65, 0xfc, 0x1b, 0x91, 0xbc, 0xab, 0x27, 0x77, 0xc1,
+	0x4d, 0xa6, 0x84, 0xf1, 0x88, 0xa5, 0x83, 0xce, 0xbe, 0x33, 0xf6, 0xf0, 0x86, 0xb1, 0x9f, 0xa6,
+	0xe8, 0x3e, 0xdc, 0x22, 0x49, 0x22, 0x4a, 0xae, 0x22, 0x5e, 0xe6, 0x31, 0x2d, 0x06, 0xdd, 0x7d,
+	0x67, 0xdc, 0xc5, 0xdb, 0xd6, 0xfb, 0xbd, 0x71, 0x8e, 0x7e, 0x6f, 0x43, 0xbf, 0xca, 0x37, 0x7a,
+	0x00, 0x6e, 0x4e, 0xa5, 0x24, 0x99, 0x51, 0xd4, 0x19, 0x6f, 0x1e, 0xee, 0x04, 0x55, 0x35, 0x83,
+	0xba, 0x9a, 0xc1, 0x11, 0x5f, 0xe2, 0x06, 0x85, 0x10, 0x74, 0x73, 0x9a, 0x57, 0x65, 0xf1, 0xb0,
+	0x39, 0xeb, 0x77, 0x15, 0xcb, 0xa9, 0x28, 0x55, 0x34, 0xa5, 0x2c, 0x9b, 0x2a, 0x23, 0xac, 0x8b,
+	0xb7, 0xad, 0xf7, 0xd8, 0x38, 0xd1, 0x07, 0xe0, 0x95, 0x5c, 0x14, 0x29, 0x2d, 0x68, 0x6a, 0x94,
+	0xb9, 0xf8, 0xca, 0x81, 0x26, 0x70, 0x87, 0x2e, 0x14, 0xe5, 0x92, 0x09, 0x1e, 0x89, 0xb9, 0x62,
+	0x82, 0xcb, 0xc1, 0x3f, 0x1b, 0x37, 0x88, 0x7a, 0xaf, 0xc1, 0xff, 0x50, 0xc1, 0xd1, 0x0b, 0xf0,
+	0xb9, 0xe0, 0x51, 0x52, 0x30, 0xc5, 0x12, 0x32, 0x8b, 0x56, 0x10, 0xde, 0xbe, 0x81, 0x70, 0x8f,
+	0x0b, 0xfe, 0xd8, 0xc6, 0x7e, 0xfb, 0x1f, 0xee, 0xd1, 0x2b, 0x70, 0xeb, 0x86, 0x43, 0x5f, 0xc3,
+	0x96, 0x2e, 0x32, 0x2d, 0x4c, 0xb5, 0xea, 0xd4, 0xdd, 0x5d, 0xd1, 0xa3, 0x27, 0x06, 0x66, 0xba,
+	0x74, 0x53, 0x36, 0x67, 0x89, 0xc6, 0xd0, 0x39, 0xa3, 0xd4, 0x36, 0xf7, 0xfb, 0x2b, 0x02, 0x9f,
+	0x50, 0x8a, 0x35, 0x64, 0xf4, 0xab, 0x03, 0x70, 0xc5, 0x82, 0x1e, 0x01, 0xcc, 0xcb, 0x78, 0xc6,
+	0x92, 0xe8, 0x25, 0xad, 0x07, 0x6a, 0xf5, 0xd7, 0x78, 0x15, 0xee, 0x19, 0x35, 0x03, 0x95, 0x8b,
+	0x94, 0xae, 0x1b, 0xa8, 0xe7, 0x22, 0xa5, 0xd5, 0x40, 0xe5, 0xf6, 0x84, 0x86, 0xe0, 0x4a, 0xfa,
+	0x63, 0x49, 0x79, 0x42, 0x6d, 0x51, 0x1b, 0x7b, 0x74, 0xde, 0x06, 0xb7, 0x0e, 0x41, 0x5f, 0x42,
+	0x5f, 0x32, 0x9e, 0xcd, 0xa8, 0xd5, 0x34, 0xba, 0x81, 0x3f, 0x38, 0x31, 0xc8, 0xe3, 0x16, 0xb6,
+	0x31, 0xe8, 0x73, 0xe8, 0x99, 0xed, 0x64, 0xc5, 0x7d, 0x78, 0x53, 0xf0, 0x73, 0x0d, 0x3c, 0x6e,
+	0xe1, 0x2a, 0x62, 0x78, 0x04, 0xfd, 0x8a, 0x0e, 0x7d, 0x0a, 0x5d, 0xad, 0xdb, 0x08, 0xb8, 0x75,
+	0xf8, 0xd1, 0x35, 0x8e, 0x7a, 0x5f, 0x5d, 0xaf, 0x8a, 0xe6, 0xc3, 0x26, 0x60, 0xf8, 0xda, 0x81,
+	0x9e, 0x61, 0x45, 0xcf, 0xc0, 0x8d, 0x99, 0x22, 0x45, 0x41, 0xea, 0xdc, 0x86, 0x35, 0x4d, 0xb5,
+	0x55, 0x83, 0x66, 0x89, 0xd6, 0x5c, 0x8f, 0x45, 0x3e, 0x27, 0x89, 0x9a, 0x30, 0x75, 0xa4, 0xc3,
+	0x70, 0x43, 0x80, 0xbe, 0x00, 0x68, 0xb2, 0xae, 0x87, 0xb9, 0xb3, 0x2e, 0xed, 0x5e, 0x9d, 0x76,
+	0x39, 0xe9, 0x41, 0x47, 0x96, 0xf9, 0xe8, 0x0f, 0x07, 0x3a, 0x4f, 0x28, 0x45, 0x09, 0xf4, 0x49,
+	0xae, 0x47, 0xd8, 0xb6, 0x5a, 0xb3, 0x42, 0xf5, 0xf2, 0xbe, 0x26, 0x85, 0xf1, 0xc9, 0x83, 0x37,
+	0x7f, 0xdd, 0x6b, 0xfd, 0xf6, 0xf7, 0xbd, 0x71, 0xc6, 0xd4, 0xb4, 0x8c, 0x83, 0x44, 0xe4, 0x61,
+	0xfd, 0xc7, 0x60, 0x7e, 0x0e, 0x64, 0xfa, 0x32, 0x54, 0xcb, 0x39, 0x95, 0x26, 0x40, 0x62, 0x4b,
+	0x8d, 0xf6, 0xc0, 0xcb, 0x88, 0x8c, 0x66, 0x2c, 0x67, 0xca, 0x14, 0xa2, 0x8b, 0xdd, 0x8c, 0xc8,
+	0xef, 0xb4, 0x8d, 0x76, 0xa0, 0x37, 0x27, 0x4b, 0x5a, 0xd8, 0x9d, 0x53, 0x19, 0x68, 0x00, 0x1b,
+	0x59, 0x41, 0xb8, 0xb2, 0xab, 0xc6, 0xc3, 0xb5, 0x39, 0xf9, 0xea, 0xcd, 0x85, 0xef, 0xbc, 0xbd,
+	0xf0, 0x9d, 0xf3, 0x0b, 0xdf, 0x79, 0x7d, 0xe9, 0xb7, 0xde, 0x5e, 0xfa, 0xad, 0x3f, 0x2f, 0xfd,
+	0xd6, 0x8b, 0xfb, 0xeb, 0x85, 0x85, 0x6a, 0x11, 0xf7, 0x4d, 0x33, 0x3f, 0xfa, 0x77, 0x00, 0xde,
+	0x1e, 0x01, 0x8f, 0x1b, 0x07, 0x00, 0x00,
 }
 
 func (m *Tx) Marshal() (dAtA []byte, err error) {
@@ -1017,6 +1032,16 @@ func (m *TxBody) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0xfa
 		}
 	}
+	if m.Unordered {
+		i--
+		if m.Unordered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.TimeoutHeight != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutHeight))
 		i--
@@ -1454,6 +1479,9 @@ func (m *TxBody) Size() (n int) {
 	if m.TimeoutHeight != 0 {
 		n += 1 + sovTx(uint64(m.TimeoutHeight))
 	}
+	if m.Unordered {
+		n += 2
+	}
 	if len(m.ExtensionOptions) > 0 {
 		for _, e := range m.ExtensionOptions {
 			l = e.Size()
@@ -2194,6 +2222,26 @@ func (m *TxBody) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unordered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unordered = bool(v != 0)
 		case 1023:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionOptions", wireType)
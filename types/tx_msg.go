@@ -78,6 +78,14 @@ type (
 
 		GetTimeoutHeight() uint64
 	}
+
+	// TxWithUnordered extends the Tx interface by allowing a transaction to be
+	// unordered, i.e. to skip the sequence checks of its signers.
+	TxWithUnordered interface {
+		Tx
+
+		GetUnordered() bool
+	}
 )
 
 // TxDecoder unmarshals transaction bytes
@@ -85,3 +93,13 @@ type TxDecoder func(txBytes []byte) (Tx, error)
 
 // TxEncoder marshals transaction to bytes
 type TxEncoder func(tx Tx) ([]byte, error)
+
+// MsgTypeURL returns the type URL of a Msg, the one of its request for a
+// ServiceMsg (ex. `/cosmos.bank.v1beta1.MsgSend`).
+func MsgTypeURL(msg Msg) string {
+	if svcMsg, ok := msg.(ServiceMsg); ok {
+		return "/" + proto.MessageName(svcMsg.Request)
+	}
+
+	return "/" + proto.MessageName(msg)
+}
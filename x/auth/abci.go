@@ -0,0 +1,18 @@
+package auth
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// EndBlocker removes the unordered txs which time out at the end of the block
+// from the replay protection store.
+func EndBlocker(ctx sdk.Context, ak keeper.AccountKeeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	ak.RemoveExpiredUnorderedTxs(ctx)
+}
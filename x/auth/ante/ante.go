@@ -7,8 +7,8 @@ import (
 )
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
-// numbers, checks signatures & account numbers, and deducts fees from the first
-// signer.
+// numbers, or the replay protection of unordered txs, checks signatures &
+// account numbers, and deducts fees from the first signer.
 func NewAnteHandler(
 	ak AccountKeeper, bankKeeper types.BankKeeper,
 	sigGasConsumer SignatureVerificationGasConsumer,
@@ -20,6 +20,7 @@ func NewAnteHandler(
 		NewMempoolFeeDecorator(),
 		NewValidateBasicDecorator(),
 		TxTimeoutHeightDecorator{},
+		NewUnorderedTxDecorator(ak, DefaultMaxUnorderedTimeoutDelta),
 		NewValidateMemoDecorator(ak),
 		NewConsumeGasForTxSizeDecorator(ak),
 		NewRejectFeeGranterDecorator(),
@@ -601,7 +601,8 @@ func (suite *AnteTestSuite) TestAnteHandlerMultiSigner() {
 	msg2 := testdata.NewTestMsg(accounts[2].acc.GetAddress(), accounts[0].acc.GetAddress())
 	msg3 := testdata.NewTestMsg(accounts[1].acc.GetAddress(), accounts[2].acc.GetAddress())
 	feeAmount := testdata.NewTestFeeAmount()
-	gasLimit := testdata.NewTestGasLimit()
+	// setting the pubkeys of the three signers needs more than the default gas limit
+	gasLimit := 2 * testdata.NewTestGasLimit()
 
 	// Variable data per test case
 	var (
@@ -899,6 +900,59 @@ func (suite *AnteTestSuite) TestAnteHandlerSetPubKey() {
 	}
 }
 
+func (suite *AnteTestSuite) TestAnteHandlerChangedPubKey() {
+	suite.SetupTest(false) // setup
+
+	// Same data for every test cases
+	accounts := suite.CreateTestAccounts(1)
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := testdata.NewTestGasLimit()
+	msgs := []sdk.Msg{testdata.NewTestMsg(accounts[0].acc.GetAddress())}
+
+	// Replace the public key of the account, as done by MsgChangePubKey.
+	newPriv := secp256k1.GenPrivKey()
+	acc0 := suite.app.AccountKeeper.GetAccount(suite.ctx, accounts[0].acc.GetAddress())
+	suite.Require().NoError(acc0.SetPubKey(newPriv.PubKey()))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc0)
+
+	// Variable data per test case
+	var (
+		accNums []uint64
+		privs   []cryptotypes.PrivKey
+		accSeqs []uint64
+	)
+
+	testCases := []TestCase{
+		{
+			"test tx signed with the previous key",
+			func() {
+				privs, accNums, accSeqs = []cryptotypes.PrivKey{accounts[0].priv}, []uint64{0}, []uint64{0}
+			},
+			false,
+			false,
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"test tx signed with the new key",
+			func() {
+				privs, accNums, accSeqs = []cryptotypes.PrivKey{newPriv}, []uint64{0}, []uint64{0}
+			},
+			false,
+			true,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.desc), func() {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			tc.malleate()
+
+			suite.RunTestCase(privs, msgs, feeAmount, gasLimit, accNums, accSeqs, suite.ctx.ChainID(), tc)
+		})
+	}
+}
+
 func generatePubKeysAndSignatures(n int, msg []byte, _ bool) (pubkeys []cryptotypes.PubKey, signatures [][]byte) {
 	pubkeys = make([]cryptotypes.PubKey, n)
 	signatures = make([][]byte, n)
@@ -1102,9 +1156,9 @@ func (suite *AnteTestSuite) TestAnteHandlerReCheck() {
 		name   string
 		params types.Params
 	}{
-		{"memo size check", types.NewParams(1, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte, types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1)},
-		{"txsize check", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, 10000000, types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1)},
-		{"sig verify cost check", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte, types.DefaultSigVerifyCostED25519, 100000000)},
+		{"memo size check", types.NewParams(1, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte, types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultEnableChangePubKey, types.DefaultPubKeyChangeCost)},
+		{"txsize check", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, 10000000, types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultEnableChangePubKey, types.DefaultPubKeyChangeCost)},
+		{"sig verify cost check", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte, types.DefaultSigVerifyCostED25519, 100000000, types.DefaultEnableChangePubKey, types.DefaultPubKeyChangeCost)},
 	}
 	for _, tc := range testCases {
 		// set testcase parameters
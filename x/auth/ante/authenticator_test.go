@@ -0,0 +1,111 @@
+package ante_test
+
+import (
+	"errors"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// sessionKeyAccount is an account whose txs are signed by a session key instead
+// of the key of the account.
+type sessionKeyAccount struct {
+	*types.BaseAccount
+
+	sessionKey cryptotypes.PubKey
+}
+
+var _ types.AuthenticatorAccountI = sessionKeyAccount{}
+
+func (acc sessionKeyAccount) Authenticate(
+	ctx sdk.Context, tx sdk.Tx, sig signing.SignatureV2, signerData authsigning.SignerData,
+	handler authsigning.SignModeHandler,
+) error {
+	if sig.PubKey == nil || !sig.PubKey.Equals(acc.sessionKey) {
+		return errors.New("tx is not signed by the session key")
+	}
+
+	return authsigning.VerifySignature(sdk.WrapSDKContext(ctx), sig.PubKey, signerData, sig.Data, handler, tx)
+}
+
+// sessionKeyAccountKeeper returns the accounts with a session key as
+// sessionKeyAccounts, which are stored as base accounts.
+type sessionKeyAccountKeeper struct {
+	authkeeper.AccountKeeper
+
+	sessionKeys map[string]cryptotypes.PubKey
+}
+
+func (k sessionKeyAccountKeeper) GetAccount(ctx sdk.Context, addr sdk.AccAddress) types.AccountI {
+	acc := k.AccountKeeper.GetAccount(ctx, addr)
+	if sessionKey, ok := k.sessionKeys[addr.String()]; ok && acc != nil {
+		return sessionKeyAccount{BaseAccount: acc.(*types.BaseAccount), sessionKey: sessionKey}
+	}
+
+	return acc
+}
+
+func (k sessionKeyAccountKeeper) SetAccount(ctx sdk.Context, acc types.AccountI) {
+	if sessionAcc, ok := acc.(sessionKeyAccount); ok {
+		acc = sessionAcc.BaseAccount
+	}
+
+	k.AccountKeeper.SetAccount(ctx, acc)
+}
+
+func (suite *AnteTestSuite) TestAnteHandlerAuthenticatorAccount() {
+	suite.SetupTest(false) // reset
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	accounts := suite.CreateTestAccounts(1)
+	priv, addr := accounts[0].priv, accounts[0].acc.GetAddress()
+	accNum := accounts[0].acc.GetAccountNumber()
+	sessionPriv, _, _ := testdata.KeyTestPubAddr()
+
+	ak := sessionKeyAccountKeeper{
+		AccountKeeper: suite.app.AccountKeeper,
+		sessionKeys:   map[string]cryptotypes.PubKey{addr.String(): sessionPriv.PubKey()},
+	}
+	anteHandler := ante.NewAnteHandler(ak, suite.app.BankKeeper, ante.DefaultSigVerificationGasConsumer, suite.clientCtx.TxConfig.SignModeHandler())
+
+	suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	feeAmount := testdata.NewTestFeeAmount()
+	suite.txBuilder.SetFeeAmount(feeAmount)
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	deliver := func(priv cryptotypes.PrivKey, seq uint64) error {
+		tx, err := suite.CreateTestTx([]cryptotypes.PrivKey{priv}, []uint64{accNum}, []uint64{seq}, suite.ctx.ChainID())
+		suite.Require().NoError(err)
+
+		_, err = anteHandler(suite.ctx, tx, false)
+		return err
+	}
+
+	// the account key does not authenticate the account
+	suite.Require().ErrorIs(deliver(priv, 0), sdkerrors.ErrUnauthorized)
+	balance := suite.app.BankKeeper.GetAllBalances(suite.ctx, addr)
+
+	// the session key does, without its pubkey being set on the account
+	suite.Require().NoError(deliver(sessionPriv, 0))
+	acc := suite.app.AccountKeeper.GetAccount(suite.ctx, addr)
+	suite.Require().Nil(acc.GetPubKey())
+	suite.Require().Equal(uint64(1), acc.GetSequence())
+	suite.Require().Equal(balance.Sub(feeAmount), suite.app.BankKeeper.GetAllBalances(suite.ctx, addr))
+
+	// the sequence is still checked
+	suite.Require().ErrorIs(deliver(sessionPriv, 0), sdkerrors.ErrWrongSequence)
+	suite.Require().NoError(deliver(sessionPriv, 1))
+
+	// simulations do not authenticate the account
+	tx, err := suite.CreateTestTx([]cryptotypes.PrivKey{priv}, []uint64{accNum}, []uint64{2}, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+	_, err = anteHandler(suite.ctx, tx, true)
+	suite.Require().NoError(err)
+}
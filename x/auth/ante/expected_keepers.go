@@ -12,4 +12,6 @@ type AccountKeeper interface {
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) types.AccountI
 	SetAccount(ctx sdk.Context, acc types.AccountI)
 	GetModuleAddress(moduleName string) sdk.AccAddress
+	ContainsUnorderedTx(ctx sdk.Context, txHash []byte) bool
+	AddUnorderedTx(ctx sdk.Context, txHash []byte, timeoutHeight uint64)
 }
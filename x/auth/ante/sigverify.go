@@ -42,6 +42,7 @@ type SignatureVerificationGasConsumer = func(meter sdk.GasMeter, sig signing.Sig
 
 // SetPubKeyDecorator sets PubKeys in context for any signer which does not already have pubkey set
 // PubKeys must be set in context for all signers before any other sigverify decorators run
+// The PubKeys of AuthenticatorAccountI signers are neither checked nor set.
 // CONTRACT: Tx must implement SigVerifiableTx interface
 type SetPubKeyDecorator struct {
 	ak AccountKeeper
@@ -70,16 +71,22 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 			}
 			pk = simSecp256k1Pubkey
 		}
-		// Only make check if simulate=false
-		if !simulate && !bytes.Equal(pk.Address(), signers[i]) {
-			return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey,
-				"pubKey does not match signer address %s with signer index: %d", signers[i], i)
-		}
 
 		acc, err := GetSignerAcc(ctx, spkd.ak, signers[i])
 		if err != nil {
 			return ctx, err
 		}
+		// the pubkey of the tx is checked by the authenticator of the account
+		if _, ok := acc.(types.AuthenticatorAccountI); ok {
+			continue
+		}
+		// Only make check if simulate=false. The pubkey of an account changed
+		// with MsgChangePubKey no longer matches its address.
+		if !simulate && !bytes.Equal(pk.Address(), signers[i]) &&
+			(acc.GetPubKey() == nil || !acc.GetPubKey().Equals(pk)) {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey,
+				"pubKey does not match signer address %s with signer index: %d", signers[i], i)
+		}
 		// account already has pubkey set,no need to reset
 		if acc.GetPubKey() != nil {
 			continue
@@ -96,6 +103,8 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 
 // Consume parameter-defined amount of gas for each signature according to the passed-in SignatureVerificationGasConsumer function
 // before calling the next AnteHandler
+// The gas of AuthenticatorAccountI signers is consumed according to the pubkey of the tx, if any,
+// their authenticator is responsible for any additional gas.
 // CONTRACT: Pubkeys are set in context for all signers before this decorator runs
 // CONTRACT: Tx must implement SigVerifiableTx interface
 type SigGasConsumeDecorator struct {
@@ -133,6 +142,10 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 		}
 
 		pubKey := signerAcc.GetPubKey()
+		_, isAuthenticator := signerAcc.(types.AuthenticatorAccountI)
+		if isAuthenticator {
+			pubKey = sig.PubKey
+		}
 
 		// In simulate mode the transaction comes with no signatures, thus if the
 		// account's pubkey is nil, both signature verification and gasKVStore.Set()
@@ -141,6 +154,9 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 		if simulate && pubKey == nil {
 			pubKey = simSecp256k1Pubkey
 		}
+		if isAuthenticator && pubKey == nil {
+			continue
+		}
 
 		// make a SignatureV2 with PubKey filled in from above
 		sig = signing.SignatureV2{
@@ -160,6 +176,8 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 
 // Verify all signatures for a tx and return an error if any are invalid. Note,
 // the SigVerificationDecorator decorator will not get executed on ReCheck.
+// The signatures of AuthenticatorAccountI signers are verified by their
+// authenticator.
 //
 // CONTRACT: Pubkeys are set in context for all signers before this decorator runs
 // CONTRACT: Tx must implement SigVerifiableTx interface
@@ -220,6 +238,8 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signerAddrs), len(sigs))
 	}
 
+	unordered := isUnorderedTx(tx)
+
 	for i, sig := range sigs {
 		acc, err := GetSignerAcc(ctx, svd.ak, signerAddrs[i])
 		if err != nil {
@@ -228,7 +248,8 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 
 		// retrieve pubkey
 		pubKey := acc.GetPubKey()
-		if !simulate && pubKey == nil {
+		authAcc, isAuthenticator := acc.(types.AuthenticatorAccountI)
+		if !simulate && pubKey == nil && !isAuthenticator {
 			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
 		}
 
@@ -237,8 +258,10 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		// the SignatureV2 struct (it's only in the SignDoc). In this case, we
 		// cannot check sequence directly, and must do it via signature
 		// verification (in the VerifySignature call below).
+		// The sequence of unordered txs is not checked, their replay protection
+		// is enforced by the UnorderedTxDecorator.
 		onlyAminoSigners := OnlyLegacyAminoSigners(sig.Data)
-		if !onlyAminoSigners {
+		if !onlyAminoSigners && !unordered {
 			if sig.Sequence != acc.GetSequence() {
 				return ctx, sdkerrors.Wrapf(
 					sdkerrors.ErrWrongSequence,
@@ -259,9 +282,16 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 			AccountNumber: accNum,
 			Sequence:      acc.GetSequence(),
 		}
+		if unordered {
+			signerData.Sequence = sig.Sequence
+		}
 
-		if !simulate {
-			err := authsigning.VerifySignature(pubKey, signerData, sig.Data, svd.signModeHandler, tx)
+		if !simulate && isAuthenticator {
+			if err := authAcc.Authenticate(ctx, tx, sig, signerData, svd.signModeHandler); err != nil {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "authentication of signer %s failed: %s", signerAddrs[i], err)
+			}
+		} else if !simulate {
+			err := authsigning.VerifySignature(sdk.WrapSDKContext(ctx), pubKey, signerData, sig.Data, svd.signModeHandler, tx)
 			if err != nil {
 				var errMsg string
 				if onlyAminoSigners {
@@ -283,7 +313,8 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 // IncrementSequenceDecorator handles incrementing sequences of all signers.
 // Use the IncrementSequenceDecorator decorator to prevent replay attacks. Note,
 // there is no need to execute IncrementSequenceDecorator on RecheckTX since
-// CheckTx would already bump the sequence number.
+// CheckTx would already bump the sequence number. The sequences are not
+// incremented for unordered txs.
 //
 // NOTE: Since CheckTx and DeliverTx state are managed separately, subsequent and
 // sequential txs orginating from the same account cannot be handled correctly in
@@ -305,6 +336,10 @@ func (isd IncrementSequenceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
 	}
 
+	if isUnorderedTx(tx) {
+		return next(ctx, tx, simulate)
+	}
+
 	// increment sequence of all signers
 	for _, addr := range sigTx.GetSigners() {
 		acc := isd.ak.GetAccount(ctx, addr)
@@ -0,0 +1,109 @@
+package ante
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DefaultMaxUnorderedTimeoutDelta is the default maximum number of blocks the
+// timeout height of an unordered tx can be ahead of the current block height.
+const DefaultMaxUnorderedTimeoutDelta uint64 = 1000
+
+// UnorderedTxDecorator enforces the replay protection of unordered txs, whose
+// signer sequences are neither checked nor incremented. An unordered tx must
+// set a timeout height at most maxTimeoutDelta blocks ahead of the current
+// block height, and its hash must not have been seen before. The hash is then
+// recorded until the timeout height, after which the tx is rejected by the
+// TxTimeoutHeightDecorator. Ordered txs are passed through unchanged.
+//
+// The hash covers the encoded body and auth info of the tx, which the
+// signatures are bound to by the sign modes supporting unordered txs, rather
+// than the tx bytes: the TxRaw encoding can be altered, e.g. by repeating a
+// field, without invalidating the signatures, which would let the tx be
+// replayed under another hash.
+// CONTRACT: UnorderedTxDecorator must be called after TxTimeoutHeightDecorator
+type UnorderedTxDecorator struct {
+	ak              AccountKeeper
+	maxTimeoutDelta uint64
+}
+
+func NewUnorderedTxDecorator(ak AccountKeeper, maxTimeoutDelta uint64) UnorderedTxDecorator {
+	return UnorderedTxDecorator{
+		ak:              ak,
+		maxTimeoutDelta: maxTimeoutDelta,
+	}
+}
+
+func (utd UnorderedTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !isUnorderedTx(tx) {
+		return next(ctx, tx, simulate)
+	}
+
+	signedTx, ok := tx.(TxWithSignedBytes)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "expected tx to implement TxWithSignedBytes")
+	}
+
+	timeoutTx, ok := tx.(TxWithTimeoutHeight)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "expected tx to implement TxWithTimeoutHeight")
+	}
+
+	timeoutHeight := timeoutTx.GetTimeoutHeight()
+	if timeoutHeight == 0 {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unordered tx must set a timeout height")
+	}
+
+	if maxTimeoutHeight := uint64(ctx.BlockHeight()) + utd.maxTimeoutDelta; timeoutHeight > maxTimeoutHeight {
+		return ctx, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "unordered tx timeout height %d exceeds the maximum timeout height %d", timeoutHeight, maxTimeoutHeight,
+		)
+	}
+
+	// simulated txs are not recorded
+	if simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	txHash := unorderedTxHash(signedTx)
+	if utd.ak.ContainsUnorderedTx(ctx, txHash) {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unordered tx %X has already been processed", txHash)
+	}
+
+	utd.ak.AddUnorderedTx(ctx, txHash, timeoutHeight)
+
+	return next(ctx, tx, simulate)
+}
+
+// TxWithSignedBytes is the interface of the txs exposing the encoded body and
+// auth info their signatures cover, as the protobuf txs do.
+type TxWithSignedBytes interface {
+	sdk.Tx
+
+	GetBodyBytes() []byte
+	GetAuthInfoBytes() []byte
+}
+
+// unorderedTxHash returns the hash identifying an unordered tx for its replay
+// protection, the SHA-256 hash of its length-prefixed encoded body and auth
+// info.
+func unorderedTxHash(tx TxWithSignedBytes) []byte {
+	hash := sha256.New()
+	for _, bz := range [][]byte{tx.GetBodyBytes(), tx.GetAuthInfoBytes()} {
+		var length [8]byte
+		binary.BigEndian.PutUint64(length[:], uint64(len(bz)))
+		hash.Write(length[:])
+		hash.Write(bz)
+	}
+
+	return hash.Sum(nil)
+}
+
+// isUnorderedTx returns true if the tx is an unordered tx.
+func isUnorderedTx(tx sdk.Tx) bool {
+	unorderedTx, ok := tx.(sdk.TxWithUnordered)
+	return ok && unorderedTx.GetUnordered()
+}
@@ -0,0 +1,157 @@
+package ante_test
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+func (suite *AnteTestSuite) TestUnorderedTxDecorator() {
+	suite.SetupTest(true) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	antehandler := sdk.ChainAnteDecorators(
+		ante.TxTimeoutHeightDecorator{},
+		ante.NewUnorderedTxDecorator(suite.app.AccountKeeper, 10),
+	)
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	newTx := func(unordered bool, timeout uint64) (sdk.Tx, []byte) {
+		suite.txBuilder.SetUnordered(unordered)
+		suite.txBuilder.SetTimeoutHeight(timeout)
+
+		tx, err := suite.CreateTestTx([]cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}, suite.ctx.ChainID())
+		suite.Require().NoError(err)
+		txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+		suite.Require().NoError(err)
+
+		return tx, txBytes
+	}
+
+	ctx := suite.ctx.WithBlockHeight(100)
+
+	testCases := []struct {
+		name      string
+		unordered bool
+		timeout   uint64
+		expErr    error
+	}{
+		{"ordered tx", false, 0, nil},
+		{"no timeout height", true, 0, sdkerrors.ErrInvalidRequest},
+		{"timeout height too far", true, 111, sdkerrors.ErrInvalidRequest},
+		{"timed out", true, 99, sdkerrors.ErrTxTimeoutHeight},
+		{"max timeout height", true, 110, nil},
+		{"current height", true, 100, nil},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			tx, txBytes := newTx(tc.unordered, tc.timeout)
+			txCtx := ctx.WithTxBytes(txBytes)
+
+			_, err := antehandler(txCtx, tx, false)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// an unordered tx cannot be replayed, an ordered one is left to the
+			// sequence checks
+			_, err = antehandler(txCtx, tx, false)
+			if tc.unordered {
+				suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+
+	// simulations do not record the tx
+	tx, txBytes := newTx(true, 105)
+	_, err := antehandler(ctx.WithTxBytes(txBytes), tx, true)
+	suite.Require().NoError(err)
+	_, err = antehandler(ctx.WithTxBytes(txBytes), tx, false)
+	suite.Require().NoError(err)
+
+	// the tx can be replayed once it timed out and has been removed
+	suite.app.AccountKeeper.RemoveExpiredUnorderedTxs(ctx.WithBlockHeight(105))
+	_, err = antehandler(ctx.WithTxBytes(txBytes), tx, false)
+	suite.Require().NoError(err)
+}
+
+func (suite *AnteTestSuite) TestAnteHandlerUnorderedTx() {
+	suite.SetupTest(false) // reset
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	accounts := suite.CreateTestAccounts(1)
+	priv, addr := accounts[0].priv, accounts[0].acc.GetAddress()
+	accNum := accounts[0].acc.GetAccountNumber()
+
+	suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	deliver := func(memo string, seq uint64) error {
+		suite.txBuilder.SetMemo(memo)
+		tx, err := suite.CreateTestTx([]cryptotypes.PrivKey{priv}, []uint64{accNum}, []uint64{seq}, suite.ctx.ChainID())
+		suite.Require().NoError(err)
+		txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+		suite.Require().NoError(err)
+
+		_, err = suite.anteHandler(suite.ctx.WithTxBytes(txBytes), tx, false)
+		return err
+	}
+
+	// an ordered tx with a wrong sequence is rejected
+	suite.Require().ErrorIs(deliver("ordered", 5), sdkerrors.ErrWrongSequence)
+
+	// unordered txs are accepted with any sequence, which is not incremented
+	suite.txBuilder.SetUnordered(true)
+	suite.txBuilder.SetTimeoutHeight(10)
+	suite.Require().NoError(deliver("first", 5))
+	suite.Require().NoError(deliver("second", 0))
+	suite.Require().Equal(uint64(0), suite.app.AccountKeeper.GetAccount(suite.ctx, addr).GetSequence())
+
+	// the same unordered tx cannot be delivered twice
+	suite.Require().ErrorIs(deliver("second", 0), sdkerrors.ErrInvalidRequest)
+
+	// nor re-encoded with a leading empty body_bytes field, which the decoder
+	// overrides with the following one, keeping the signatures valid
+	suite.txBuilder.SetMemo("third")
+	tx, err := suite.CreateTestTx([]cryptotypes.PrivKey{priv}, []uint64{accNum}, []uint64{0}, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+	txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+	suite.Require().NoError(err)
+	_, err = suite.anteHandler(suite.ctx.WithTxBytes(txBytes), tx, false)
+	suite.Require().NoError(err)
+
+	reencodedBytes := append([]byte{0x0a, 0x00}, txBytes...)
+	reencodedTx, err := suite.clientCtx.TxConfig.TxDecoder()(reencodedBytes)
+	suite.Require().NoError(err)
+	_, err = suite.anteHandler(suite.ctx.WithTxBytes(reencodedBytes), reencodedTx, false)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// the signature still covers the sequence of the signer info
+	tx, err = suite.CreateTestTx([]cryptotypes.PrivKey{priv}, []uint64{accNum}, []uint64{1}, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+	sigs, err := tx.GetSignaturesV2()
+	suite.Require().NoError(err)
+	sigs[0].Sequence = 2
+	suite.Require().NoError(suite.txBuilder.SetSignatures(sigs...))
+	_, err = suite.anteHandler(suite.ctx.WithTxBytes([]byte("tampered")), suite.txBuilder.GetTx(), false)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// ordered txs still check and increment the sequence
+	suite.txBuilder.SetUnordered(false)
+	suite.txBuilder.SetTimeoutHeight(0)
+	suite.Require().NoError(deliver("ordered", 0))
+	suite.Require().Equal(uint64(1), suite.app.AccountKeeper.GetAccount(suite.ctx, addr).GetSequence())
+}
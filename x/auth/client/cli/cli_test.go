@@ -443,6 +443,18 @@ func (s *IntegrationTestSuite) TestCLISendGenerateSignAndBroadcast() {
 	s.Require().NoError(err)
 	s.Require().Equal(startTokens, balRes.Balances.AmountOf(s.cfg.BondDenom))
 
+	// Test simulate
+
+	// Does not work in offline mode
+	res, err = authtest.TxSimulateExec(val1.ClientCtx, signedTxFile.Name(), "--offline")
+	s.Require().EqualError(err, "cannot simulate tx during offline mode")
+
+	res, err = authtest.TxSimulateExec(val1.ClientCtx, signedTxFile.Name(), "--profile")
+	s.Require().NoError(err)
+	var simRes tx.SimulateResponse
+	s.Require().NoError(val1.ClientCtx.JSONMarshaler.UnmarshalJSON(res.Bytes(), &simRes))
+	s.Require().NotEmpty(simRes.GasInfo.Profile)
+
 	// Test broadcast
 
 	// Does not work in offline mode
@@ -1102,7 +1114,7 @@ func (s *IntegrationTestSuite) TestSignWithMultiSigners_AminoJSON() {
 		banktypes.NewMsgSend(val1.Address, addr1, sdk.NewCoins(val1Coin)),
 	)
 	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))))
-	txBuilder.SetGasLimit(testdata.NewTestGasLimit()) // min required is 101892
+	txBuilder.SetGasLimit(2 * testdata.NewTestGasLimit())
 	require.Equal([]sdk.AccAddress{val0.Address, val1.Address}, txBuilder.GetTx().GetSigners())
 
 	// Write the unsigned tx into a file.
@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+const (
+	// FlagProfile requests the gas profile of the simulated transaction.
+	FlagProfile = "profile"
+)
+
+// GetSimulateCommand returns the tx simulate command.
+func GetSimulateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate [file_path]",
+		Short: "Simulate transactions generated offline",
+		Long: strings.TrimSpace(`Simulate transactions created with the --generate-only
+flag and signed with the sign command. Read a transaction from [file_path] and
+simulate it on a node, printing the gas it uses and the result of its messages.
+If you supply a dash (-) argument in place of an input filename, the command
+reads from standard input.
+
+With the --profile flag, the gas used is broken down by message, store key and
+store operation (read, write, iterate, has and delete).
+
+$ <appd> tx simulate ./mytxn.json --profile
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if offline, _ := cmd.Flags().GetBool(flags.FlagOffline); offline {
+				return errors.New("cannot simulate tx during offline mode")
+			}
+
+			stdTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			txBuilder, err := clientCtx.TxConfig.WrapTxBuilder(stdTx)
+			if err != nil {
+				return err
+			}
+
+			protoProvider, ok := txBuilder.(authtx.ProtoTxProvider)
+			if !ok {
+				return errors.New("cannot simulate amino tx")
+			}
+
+			profile, _ := cmd.Flags().GetBool(FlagProfile)
+
+			queryClient := txtypes.NewServiceClient(clientCtx)
+			res, err := queryClient.Simulate(context.Background(), &txtypes.SimulateRequest{
+				Tx:      protoProvider.GetProtoTx(),
+				Profile: profile,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(FlagProfile, false, "Break the gas used down by message, store key and operation")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// NewTxCmd returns the auth module's transaction commands.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Auth transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewChangePubKeyCmd(),
+	)
+
+	return txCmd
+}
+
+// NewChangePubKeyCmd returns a CLI command handler for creating a
+// MsgChangePubKey transaction.
+func NewChangePubKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "change-pubkey [pubkey]",
+		Short: "Replace the public key of the sender account.",
+		Long: `Replace the public key of the sender account with the given bech32 account
+public key, as displayed by 'keys show --pubkey'. The address of the account is left
+unchanged: once the transaction is committed, the transactions of the account must
+be signed with the private key matching the new public key.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pubKey, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeAccPub, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgChangePubKey(clientCtx.GetFromAddress(), pubKey)
+			if err != nil {
+				return err
+			}
+			svcMsgClientConn := &msgservice.ServiceMsgClientConn{}
+			msgClient := types.NewMsgClient(svcMsgClientConn)
+			_, err = msgClient.ChangePubKey(cmd.Context(), msg)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), svcMsgClientConn.GetMsgs()...)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -37,6 +37,14 @@ func TxBroadcastExec(clientCtx client.Context, filename string, extraArgs ...str
 	return clitestutil.ExecTestCLICmd(clientCtx, cli.GetBroadcastCommand(), append(args, extraArgs...))
 }
 
+func TxSimulateExec(clientCtx client.Context, filename string, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		filename,
+	}
+
+	return clitestutil.ExecTestCLICmd(clientCtx, cli.GetSimulateCommand(), append(args, extraArgs...))
+}
+
 func TxEncodeExec(clientCtx client.Context, filename string, extraArgs ...string) (testutil.BufferWriter, error) {
 	args := []string{
 		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
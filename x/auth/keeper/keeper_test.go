@@ -146,3 +146,25 @@ func TestSupply_ValidatePermissions(t *testing.T) {
 	err = app.AccountKeeper.ValidatePermissions(otherAcc)
 	require.Error(t, err)
 }
+
+func TestUnorderedTxs(t *testing.T) {
+	app, ctx := createTestApp(true)
+	ctx = ctx.WithBlockHeight(10)
+
+	hash1, hash2, hash3 := []byte("hash1"), []byte("hash2"), []byte("hash3")
+	app.AccountKeeper.AddUnorderedTx(ctx, hash1, 10)
+	app.AccountKeeper.AddUnorderedTx(ctx, hash2, 11)
+	app.AccountKeeper.AddUnorderedTx(ctx, hash3, 12)
+	require.True(t, app.AccountKeeper.ContainsUnorderedTx(ctx, hash1))
+	require.False(t, app.AccountKeeper.ContainsUnorderedTx(ctx, []byte("hash4")))
+
+	// the txs are removed at the end of their timeout height
+	app.AccountKeeper.RemoveExpiredUnorderedTxs(ctx)
+	require.False(t, app.AccountKeeper.ContainsUnorderedTx(ctx, hash1))
+	require.True(t, app.AccountKeeper.ContainsUnorderedTx(ctx, hash2))
+	require.True(t, app.AccountKeeper.ContainsUnorderedTx(ctx, hash3))
+
+	app.AccountKeeper.RemoveExpiredUnorderedTxs(ctx.WithBlockHeight(12))
+	require.False(t, app.AccountKeeper.ContainsUnorderedTx(ctx, hash2))
+	require.False(t, app.AccountKeeper.ContainsUnorderedTx(ctx, hash3))
+}
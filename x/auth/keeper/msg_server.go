@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+type msgServer struct {
+	AccountKeeper
+}
+
+// NewMsgServerImpl returns an implementation of the auth MsgServer interface
+// for the provided AccountKeeper.
+func NewMsgServerImpl(ak AccountKeeper) types.MsgServer {
+	return &msgServer{AccountKeeper: ak}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// ChangePubKey replaces the public key of an existing account. The address of
+// the account is left unchanged, subsequent transactions of the account must
+// be signed with the new key.
+func (k msgServer) ChangePubKey(goCtx context.Context, msg *types.MsgChangePubKey) (*types.MsgChangePubKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := k.GetParams(ctx)
+	if !params.EnableChangePubKey {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "public key change is disabled")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	acc := k.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", addr)
+	}
+
+	pubKey := msg.GetPubKey()
+	if pubKey == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "missing public key")
+	}
+
+	bech32PubKey, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, pubKey)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+
+	if err := acc.SetPubKey(pubKey); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+
+	ctx.GasMeter().ConsumeGas(params.PubKeyChangeCost, "pubkey change fee")
+	k.SetAccount(ctx, acc)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeChangePubKey,
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyPubKey, bech32PubKey),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address),
+		),
+	})
+
+	return &types.MsgChangePubKeyResponse{}, nil
+}
@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (suite *KeeperTestSuite) TestMsgChangePubKey() {
+	app, ctx := suite.app, suite.ctx
+	msgServer := keeper.NewMsgServerImpl(app.AccountKeeper)
+
+	_, oldPubKey, addr := testdata.KeyTestPubAddr()
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	suite.Require().NoError(acc.SetPubKey(oldPubKey))
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	newPubKey := secp256k1.GenPrivKey().PubKey()
+	multisigPubKey := multisig.NewLegacyAminoPubKey(2, []cryptotypes.PubKey{
+		secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey(),
+	})
+	unknownAddr := sdk.AccAddress([]byte("unknown-------------"))
+
+	testCases := []struct {
+		name    string
+		enabled bool
+		addr    sdk.AccAddress
+		pubKey  cryptotypes.PubKey
+		expErr  bool
+	}{
+		{"disabled", false, addr, newPubKey, true},
+		{"unknown account", true, unknownAddr, newPubKey, true},
+		{"single key", true, addr, newPubKey, false},
+		{"multisig key", true, addr, multisigPubKey, false},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			params := types.DefaultParams()
+			params.EnableChangePubKey = tc.enabled
+			app.AccountKeeper.SetParams(ctx, params)
+
+			msg, err := types.NewMsgChangePubKey(tc.addr, tc.pubKey)
+			suite.Require().NoError(err)
+
+			cacheCtx, _ := ctx.CacheContext()
+			gasBefore := cacheCtx.GasMeter().GasConsumed()
+			_, err = msgServer.ChangePubKey(sdk.WrapSDKContext(cacheCtx), msg)
+			if tc.expErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			suite.Require().GreaterOrEqual(cacheCtx.GasMeter().GasConsumed()-gasBefore, params.PubKeyChangeCost)
+			updated := app.AccountKeeper.GetAccount(cacheCtx, tc.addr)
+			suite.Require().Equal(tc.addr, updated.GetAddress())
+			suite.Require().Equal(acc.GetAccountNumber(), updated.GetAccountNumber())
+			suite.Require().True(tc.pubKey.Equals(updated.GetPubKey()))
+
+			events := cacheCtx.EventManager().ABCIEvents()
+			suite.Require().Equal(types.EventTypeChangePubKey, events[0].Type)
+			suite.Require().Equal(tc.addr.String(), string(events[0].Attributes[0].Value))
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// ContainsUnorderedTx returns true if an unordered tx with the given hash has
+// been recorded and has not timed out yet.
+func (ak AccountKeeper) ContainsUnorderedTx(ctx sdk.Context, txHash []byte) bool {
+	store := ctx.KVStore(ak.key)
+	return store.Has(types.UnorderedTxKey(txHash))
+}
+
+// AddUnorderedTx records the hash of an unordered tx until its timeout height,
+// so that it cannot be replayed.
+func (ak AccountKeeper) AddUnorderedTx(ctx sdk.Context, txHash []byte, timeoutHeight uint64) {
+	store := ctx.KVStore(ak.key)
+	store.Set(types.UnorderedTxKey(txHash), sdk.Uint64ToBigEndian(timeoutHeight))
+	store.Set(types.UnorderedTxQueueKey(timeoutHeight, txHash), []byte{})
+}
+
+// RemoveExpiredUnorderedTxs forgets the unordered txs whose timeout height is
+// at most the current block height. They are rejected by their timeout height
+// from the next block on, so their hashes are no longer needed to prevent
+// replays.
+func (ak AccountKeeper) RemoveExpiredUnorderedTxs(ctx sdk.Context) {
+	store := ctx.KVStore(ak.key)
+	end := types.UnorderedTxQueueHeightPrefix(uint64(ctx.BlockHeight()) + 1)
+	iterator := store.Iterator(types.UnorderedTxQueueKeyPrefix, end)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		txHash := key[len(types.UnorderedTxQueueKeyPrefix)+8:]
+		store.Delete(types.UnorderedTxKey(txHash))
+		store.Delete(key)
+	}
+}
@@ -233,7 +233,9 @@ func TestMigrate(t *testing.T) {
     }
   ],
   "params": {
+    "enable_change_pub_key": false,
     "max_memo_characters": "10",
+    "pub_key_change_cost": "0",
     "sig_verify_cost_ed25519": "40",
     "sig_verify_cost_secp256k1": "50",
     "tx_sig_limit": "20",
@@ -0,0 +1,19 @@
+package v043
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateParams performs in-place params migrations from v0.42 to v0.43. The
+// migration includes:
+//
+// - Add the EnableChangePubKey and PubKeyChangeCost params with their default
+//   values.
+func MigrateParams(ctx sdk.Context, paramSubspace paramtypes.Subspace) error {
+	paramSubspace.Set(ctx, types.KeyEnableChangePubKey, types.DefaultEnableChangePubKey)
+	paramSubspace.Set(ctx, types.KeyPubKeyChangeCost, types.DefaultPubKeyChangeCost)
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package v043_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v043auth "github.com/cosmos/cosmos-sdk/x/auth/legacy/v043"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	ctx := testutil.DefaultContext(paramsKey, paramsTKey)
+
+	subspace := paramtypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, paramsKey, paramsTKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	// Store the params known before the migration only.
+	old := types.DefaultParams()
+	for _, pair := range old.ParamSetPairs() {
+		if string(pair.Key) == string(types.KeyEnableChangePubKey) || string(pair.Key) == string(types.KeyPubKeyChangeCost) {
+			continue
+		}
+		subspace.Set(ctx, pair.Key, pair.Value)
+	}
+	require.False(t, subspace.Has(ctx, types.KeyPubKeyChangeCost))
+
+	require.NoError(t, v043auth.MigrateParams(ctx, subspace))
+
+	var params types.Params
+	subspace.GetParamSet(ctx, &params)
+	require.Equal(t, types.DefaultParams(), params)
+}
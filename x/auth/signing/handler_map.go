@@ -1,6 +1,7 @@
 package signing
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
@@ -58,3 +59,12 @@ func (h SignModeHandlerMap) GetSignBytes(mode signing.SignMode, data SignerData,
 	}
 	return handler.GetSignBytes(mode, data, tx)
 }
+
+// GetSignBytesWithContext implements SignModeHandlerWithContext.GetSignBytesWithContext
+func (h SignModeHandlerMap) GetSignBytesWithContext(ctx context.Context, mode signing.SignMode, data SignerData, tx sdk.Tx) ([]byte, error) {
+	handler, found := h.signModeHandlers[mode]
+	if !found {
+		return nil, fmt.Errorf("can't verify sign mode %s", mode.String())
+	}
+	return GetSignBytesWithContext(ctx, handler, mode, data, tx)
+}
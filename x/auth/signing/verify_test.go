@@ -62,7 +62,7 @@ func TestVerifySignature(t *testing.T) {
 	handler := MakeTestHandlerMap()
 	stdTx := legacytx.NewStdTx(msgs, fee, []legacytx.StdSignature{stdSig}, memo)
 	stdTx.TimeoutHeight = 10
-	err = signing.VerifySignature(pubKey, signerData, sigV2.Data, handler, stdTx)
+	err = signing.VerifySignature(sdk.WrapSDKContext(ctx), pubKey, signerData, sigV2.Data, handler, stdTx)
 	require.NoError(t, err)
 
 	pkSet := []cryptotypes.PubKey{pubKey, pubKey1}
@@ -91,7 +91,7 @@ func TestVerifySignature(t *testing.T) {
 	stdTx = legacytx.NewStdTx(msgs, fee, []legacytx.StdSignature{stdSig1, stdSig2}, memo)
 	stdTx.TimeoutHeight = 10
 
-	err = signing.VerifySignature(multisigKey, signerData, multisignature, handler, stdTx)
+	err = signing.VerifySignature(sdk.WrapSDKContext(ctx), multisigKey, signerData, multisignature, handler, stdTx)
 	require.NoError(t, err)
 }
 
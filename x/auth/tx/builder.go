@@ -33,6 +33,7 @@ var (
 	_ authsigning.Tx             = &wrapper{}
 	_ client.TxBuilder           = &wrapper{}
 	_ ante.HasExtensionOptionsTx = &wrapper{}
+	_ ante.TxWithSignedBytes     = &wrapper{}
 	_ ExtensionOptionsTxBuilder  = &wrapper{}
 	_ ProtoTxProvider            = &wrapper{}
 )
@@ -96,6 +97,17 @@ func (w *wrapper) getAuthInfoBytes() []byte {
 	return w.authInfoBz
 }
 
+// GetBodyBytes returns the encoded body of the tx, as covered by its signatures.
+func (w *wrapper) GetBodyBytes() []byte {
+	return w.getBodyBytes()
+}
+
+// GetAuthInfoBytes returns the encoded auth info of the tx, as covered by its
+// signatures.
+func (w *wrapper) GetAuthInfoBytes() []byte {
+	return w.getAuthInfoBytes()
+}
+
 func (w *wrapper) GetSigners() []sdk.AccAddress {
 	return w.tx.GetSigners()
 }
@@ -162,6 +174,11 @@ func (w *wrapper) GetTimeoutHeight() uint64 {
 	return w.tx.Body.TimeoutHeight
 }
 
+// GetUnordered returns true if the transaction is unordered.
+func (w *wrapper) GetUnordered() bool {
+	return w.tx.Body.Unordered
+}
+
 func (w *wrapper) GetSignaturesV2() ([]signing.SignatureV2, error) {
 	signerInfos := w.tx.AuthInfo.SignerInfos
 	sigs := w.tx.Signatures
@@ -225,6 +242,14 @@ func (w *wrapper) SetTimeoutHeight(height uint64) {
 	w.bodyBz = nil
 }
 
+// SetUnordered sets whether the transaction is unordered.
+func (w *wrapper) SetUnordered(unordered bool) {
+	w.tx.Body.Unordered = unordered
+
+	// set bodyBz to nil because the cached bodyBz no longer matches tx.Body
+	w.bodyBz = nil
+}
+
 func (w *wrapper) SetMemo(memo string) {
 	w.tx.Body.Memo = memo
 
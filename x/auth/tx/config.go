@@ -24,8 +24,19 @@ type config struct {
 // NewTxConfig returns a new protobuf TxConfig using the provided ProtoCodec and sign modes. The
 // first enabled sign mode will become the default sign mode.
 func NewTxConfig(protoCodec codec.ProtoCodecMarshaler, enabledSignModes []signingtypes.SignMode) client.TxConfig {
+	return NewTxConfigWithTextual(protoCodec, enabledSignModes, nil)
+}
+
+// NewTxConfigWithTextual returns a new protobuf TxConfig like NewTxConfig, whose
+// SIGN_MODE_TEXTUAL handler renders coins in the display denom of the metadata
+// returned by textualMetadataFn. Nodes should query the bank keeper, see
+// NewBankKeeperCoinMetadataQueryFn, and clients the node they sign txs for, see
+// ClientCoinMetadataQueryFn, so that both render txs identically.
+func NewTxConfigWithTextual(
+	protoCodec codec.ProtoCodecMarshaler, enabledSignModes []signingtypes.SignMode, textualMetadataFn CoinMetadataQueryFn,
+) client.TxConfig {
 	return &config{
-		handler:     makeSignModeHandler(enabledSignModes),
+		handler:     makeSignModeHandler(enabledSignModes, textualMetadataFn),
 		decoder:     DefaultTxDecoder(protoCodec),
 		encoder:     DefaultTxEncoder(),
 		jsonDecoder: DefaultJSONTxDecoder(protoCodec),
@@ -17,8 +17,8 @@ import (
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 )
 
-// baseAppSimulateFn is the signature of the Baseapp#Simulate function.
-type baseAppSimulateFn func(txBytes []byte) (sdk.GasInfo, *sdk.Result, error)
+// baseAppSimulateFn is the signature of the Baseapp#SimulateWithGasProfile function.
+type baseAppSimulateFn func(txBytes []byte, profile bool) (sdk.GasInfo, *sdk.Result, error)
 
 // txServer is the server for the protobuf Tx service.
 type txServer struct {
@@ -104,7 +104,7 @@ func (s txServer) Simulate(ctx context.Context, req *txtypes.SimulateRequest) (*
 		return nil, err
 	}
 
-	gasInfo, result, err := s.simulate(txBytes)
+	gasInfo, result, err := s.simulate(txBytes, req.Profile)
 	if err != nil {
 		return nil, err
 	}
@@ -93,6 +93,7 @@ func (s IntegrationTestSuite) TestSimulateTx_GRPC() {
 		{"nil request", nil, true, "request cannot be nil"},
 		{"empty request", &tx.SimulateRequest{}, true, "invalid empty tx"},
 		{"valid request", &tx.SimulateRequest{Tx: protoTx}, false, ""},
+		{"valid request with profile", &tx.SimulateRequest{Tx: protoTx, Profile: true}, false, ""},
 	}
 
 	for _, tc := range testCases {
@@ -109,6 +110,17 @@ func (s IntegrationTestSuite) TestSimulateTx_GRPC() {
 				// Check the result and gas used are correct.
 				s.Require().Equal(len(res.GetResult().GetEvents()), 6) // 1 coin recv 1 coin spent, 1 transfer, 3 messages.
 				s.Require().True(res.GetGasInfo().GetGasUsed() > 0)    // Gas used sometimes change, just check it's not empty.
+
+				// Check the profile adds up to the gas used.
+				if !tc.req.Profile {
+					s.Require().Empty(res.GetGasInfo().GetProfile())
+					return
+				}
+				var gasUsed uint64
+				for _, entry := range res.GetGasInfo().GetProfile() {
+					gasUsed += entry.GasUsed
+				}
+				s.Require().Equal(res.GetGasInfo().GetGasUsed(), gasUsed)
 			}
 		})
 	}
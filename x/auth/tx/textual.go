@@ -0,0 +1,223 @@
+package tx
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// CoinMetadataQueryFn returns the bank metadata of a denom, or nil if no
+// metadata is registered for it. It is used by SIGN_MODE_TEXTUAL to render
+// coin amounts in their display denom.
+type CoinMetadataQueryFn func(ctx context.Context, denom string) (*banktypes.Metadata, error)
+
+// BankKeeper defines the bank keeper method needed to render coins in
+// SIGN_MODE_TEXTUAL on a node.
+type BankKeeper interface {
+	GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool)
+}
+
+// NewBankKeeperCoinMetadataQueryFn returns a CoinMetadataQueryFn reading the
+// denom metadata from the bank keeper. The context passed to it must wrap an
+// sdk.Context, as done by the signature verification ante handler.
+func NewBankKeeperCoinMetadataQueryFn(bk BankKeeper) CoinMetadataQueryFn {
+	return func(ctx context.Context, denom string) (*banktypes.Metadata, error) {
+		metadata, found := bk.GetDenomMetaData(sdk.UnwrapSDKContext(ctx), denom)
+		if !found {
+			return nil, nil
+		}
+
+		return &metadata, nil
+	}
+}
+
+// ClientCoinMetadataQueryFn is a CoinMetadataQueryFn querying the denom
+// metadata through the gRPC connection of the client.Context stored under
+// client.ClientContextKey in the context, which is the case of the context of
+// CLI commands. No metadata is returned if the context holds no client.Context.
+func ClientCoinMetadataQueryFn(ctx context.Context, denom string) (*banktypes.Metadata, error) {
+	if ctx == nil {
+		return nil, nil
+	}
+
+	clientCtx, ok := ctx.Value(client.ClientContextKey).(*client.Context)
+	if !ok || clientCtx == nil {
+		return nil, nil
+	}
+
+	res, err := banktypes.NewQueryClient(*clientCtx).DenomMetadata(ctx, &banktypes.QueryDenomMetadataRequest{Denom: denom})
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &res.Metadata, nil
+}
+
+// signModeTextualHandler defines the SIGN_MODE_TEXTUAL SignModeHandler. The
+// sign bytes are the lines of a human-readable rendering of the tx, suited to
+// the screen of a hardware wallet.
+type signModeTextualHandler struct {
+	coinMetadata CoinMetadataQueryFn
+}
+
+var _ signing.SignModeHandlerWithContext = signModeTextualHandler{}
+
+// DefaultMode implements SignModeHandler.DefaultMode
+func (signModeTextualHandler) DefaultMode() signingtypes.SignMode {
+	return signingtypes.SignMode_SIGN_MODE_TEXTUAL
+}
+
+// Modes implements SignModeHandler.Modes
+func (signModeTextualHandler) Modes() []signingtypes.SignMode {
+	return []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL}
+}
+
+// GetSignBytes implements SignModeHandler.GetSignBytes
+func (h signModeTextualHandler) GetSignBytes(mode signingtypes.SignMode, data signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	return h.GetSignBytesWithContext(context.Background(), mode, data, tx)
+}
+
+// GetSignBytesWithContext implements SignModeHandlerWithContext.GetSignBytesWithContext
+func (h signModeTextualHandler) GetSignBytesWithContext(
+	ctx context.Context, mode signingtypes.SignMode, data signing.SignerData, tx sdk.Tx,
+) ([]byte, error) {
+	if mode != signingtypes.SignMode_SIGN_MODE_TEXTUAL {
+		return nil, fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_TEXTUAL, mode)
+	}
+
+	protoTx, ok := tx.(*wrapper)
+	if !ok {
+		return nil, fmt.Errorf("can only handle a protobuf Tx, got %T", tx)
+	}
+
+	screens, err := h.renderTx(ctx, data, protoTx)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]string, len(screens))
+	for i, s := range screens {
+		lines[i] = s.String()
+	}
+
+	return []byte(strings.Join(lines, "\n")), nil
+}
+
+// renderTx returns the screens of the SIGN_MODE_TEXTUAL rendering of a tx.
+// Screens which are only relevant to expert users, such as the gas limit or the
+// signer infos, come after the ones describing what the tx does.
+func (h signModeTextualHandler) renderTx(ctx context.Context, data signing.SignerData, protoTx *wrapper) ([]screen, error) {
+	r := newTextualRenderer(ctx, h.coinMetadata)
+	body := protoTx.tx.Body
+	authInfo := protoTx.tx.AuthInfo
+
+	screens := []screen{
+		{title: "Chain id", content: data.ChainID},
+		{title: "Account number", content: formatInteger(fmt.Sprint(data.AccountNumber))},
+		{title: "Sequence", content: formatInteger(fmt.Sprint(data.Sequence))},
+	}
+
+	msgs := body.Messages
+	screens = append(screens, screen{content: fmt.Sprintf("This transaction has %d %s", len(msgs), pluralize("Message", len(msgs)))})
+	for i, msg := range msgs {
+		msgScreens, err := r.renderAny(fmt.Sprintf("Message (%d/%d)", i+1, len(msgs)), msg, 0)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, msgScreens...)
+	}
+	if len(msgs) > 0 {
+		screens = append(screens, screen{content: "End of Messages"})
+	}
+
+	if body.Memo != "" {
+		screens = append(screens, screen{title: "Memo", content: body.Memo})
+	}
+
+	if authInfo.Fee != nil {
+		if !authInfo.Fee.Amount.IsZero() {
+			fees, err := r.formatCoins(authInfo.Fee.Amount)
+			if err != nil {
+				return nil, err
+			}
+			screens = append(screens, screen{title: "Fees", content: fees})
+		}
+		if authInfo.Fee.Payer != "" {
+			screens = append(screens, screen{title: "Fee payer", content: authInfo.Fee.Payer, expert: true})
+		}
+		if authInfo.Fee.Granter != "" {
+			screens = append(screens, screen{title: "Fee granter", content: authInfo.Fee.Granter, expert: true})
+		}
+		screens = append(screens, screen{title: "Gas limit", content: formatInteger(fmt.Sprint(authInfo.Fee.GasLimit)), expert: true})
+	}
+
+	if body.TimeoutHeight != 0 {
+		screens = append(screens, screen{title: "Timeout height", content: formatInteger(fmt.Sprint(body.TimeoutHeight)), expert: true})
+	}
+
+	if body.Unordered {
+		screens = append(screens, screen{title: "Unordered", content: "True", expert: true})
+	}
+
+	for _, group := range []struct {
+		title string
+		anys  []*codectypes.Any
+	}{
+		{"Extension options", body.ExtensionOptions},
+		{"Non critical extension options", body.NonCriticalExtensionOptions},
+	} {
+		for i, opt := range group.anys {
+			optScreens, err := r.renderAny(fmt.Sprintf("%s (%d/%d)", group.title, i+1, len(group.anys)), opt, 0)
+			if err != nil {
+				return nil, err
+			}
+			screens = append(screens, markExpert(optScreens)...)
+		}
+	}
+
+	signerInfos := authInfo.SignerInfos
+	for i, signerInfo := range signerInfos {
+		siScreens, err := r.renderValue(fmt.Sprintf("Signer (%d/%d)", i+1, len(signerInfos)), reflect.ValueOf(signerInfo), 0)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, markExpert(siScreens)...)
+	}
+
+	// The hash of the raw bytes binds the signature to the exact encoding of
+	// the tx, which the textual rendering alone does not capture, e.g. unknown
+	// fields.
+	rawBytes, err := DirectSignBytes(protoTx.getBodyBytes(), protoTx.getAuthInfoBytes(), data.ChainID, data.AccountNumber)
+	if err != nil {
+		return nil, err
+	}
+	screens = append(screens, screen{title: "Hash of raw bytes", content: fmt.Sprintf("%X", sha256.Sum256(rawBytes)), expert: true})
+
+	return screens, nil
+}
+
+func markExpert(screens []screen) []screen {
+	for i := range screens {
+		screens[i].expert = true
+	}
+	return screens
+}
+
+func pluralize(noun string, n int) string {
+	if n == 1 {
+		return noun
+	}
+	return noun + "s"
+}
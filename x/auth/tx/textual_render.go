@@ -0,0 +1,526 @@
+package tx
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// maxHexBytesLen is the length above which byte fields are rendered as their
+// SHA-256 hash rather than in hex.
+const maxHexBytesLen = 35
+
+var (
+	coinType     = reflect.TypeOf(sdk.Coin{})
+	coinsType    = reflect.TypeOf(sdk.Coins{})
+	decCoinType  = reflect.TypeOf(sdk.DecCoin{})
+	decCoinsType = reflect.TypeOf(sdk.DecCoins{})
+	intType      = reflect.TypeOf(sdk.Int{})
+	decType      = reflect.TypeOf(sdk.Dec{})
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+	anyType      = reflect.TypeOf(codectypes.Any{})
+	stringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+)
+
+// screen is a single line of the SIGN_MODE_TEXTUAL rendering of a tx.
+type screen struct {
+	// title describes the content, it is empty for plain text screens.
+	title string
+	// content is the rendered value.
+	content string
+	// indent is the nesting level of the screen, e.g. 1 for the fields of a
+	// message.
+	indent int
+	// expert screens are only displayed to users who opted in to see the
+	// details of the tx.
+	expert bool
+}
+
+// String returns the line of the screen in the sign bytes. Expert screens start
+// with a "*" and each indent level adds a "> " before the title. Titles and
+// contents are escaped so that every screen is a single line of printable ASCII
+// characters.
+func (s screen) String() string {
+	var b strings.Builder
+
+	if s.expert {
+		b.WriteString("*")
+	}
+	for i := 0; i < s.indent; i++ {
+		b.WriteString("> ")
+	}
+	if s.title != "" {
+		b.WriteString(escapeText(s.title))
+		b.WriteString(":")
+		if s.content != "" {
+			b.WriteString(" ")
+		}
+	}
+	b.WriteString(escapeText(s.content))
+
+	return b.String()
+}
+
+// escapeText escapes backslashes, and any character which is not printable
+// ASCII as \n, \uXXXX or \UXXXXXXXX.
+func escapeText(text string) string {
+	var b strings.Builder
+
+	for _, r := range text {
+		switch {
+		case r == '\\':
+			b.WriteString(`\\`)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r >= 0x20 && r <= 0x7E:
+			b.WriteRune(r)
+		case r <= 0xFFFF:
+			fmt.Fprintf(&b, `\u%04X`, r)
+		default:
+			fmt.Fprintf(&b, `\U%08X`, r)
+		}
+	}
+
+	return b.String()
+}
+
+// textualRenderer renders the values of a tx into screens. Coins are rendered
+// in the display denom of their bank metadata.
+type textualRenderer struct {
+	ctx          context.Context
+	coinMetadata CoinMetadataQueryFn
+	metadata     map[string]*banktypes.Metadata
+}
+
+func newTextualRenderer(ctx context.Context, coinMetadata CoinMetadataQueryFn) *textualRenderer {
+	return &textualRenderer{
+		ctx:          ctx,
+		coinMetadata: coinMetadata,
+		metadata:     make(map[string]*banktypes.Metadata),
+	}
+}
+
+// renderAny renders a message packed in an Any: a screen with its type URL
+// followed by the screens of its fields.
+func (r *textualRenderer) renderAny(title string, any *codectypes.Any, indent int) ([]screen, error) {
+	if any == nil {
+		return nil, nil
+	}
+
+	screens := []screen{{title: title, content: any.TypeUrl, indent: indent}}
+
+	cached := any.GetCachedValue()
+	if cached == nil {
+		// the value could not be unpacked, only its bytes can be shown
+		return append(screens, screen{title: "Value", content: formatBytes(any.Value), indent: indent + 1}), nil
+	}
+
+	fields, err := r.renderFields(reflect.ValueOf(cached), indent+1)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(screens, fields...), nil
+}
+
+// renderFields renders the fields of a protobuf message, in the order of their
+// declaration. Fields holding their default value are omitted.
+func (r *textualRenderer) renderFields(v reflect.Value, indent int) ([]screen, error) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil, nil
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return r.renderValue("", v, indent)
+	}
+
+	var screens []screen
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		if _, ok := field.Tag.Lookup("protobuf_oneof"); ok {
+			// the oneof interface holds a wrapper struct with the field set
+			fieldScreens, err := r.renderFields(v.Field(i), indent)
+			if err != nil {
+				return nil, err
+			}
+			screens = append(screens, fieldScreens...)
+			continue
+		}
+
+		name := protoFieldName(field)
+		if name == "" {
+			continue
+		}
+
+		fieldScreens, err := r.renderValue(fieldTitle(name), v.Field(i), indent)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, fieldScreens...)
+	}
+
+	return screens, nil
+}
+
+// renderValue renders a field value into one or more screens with the given
+// title.
+func (r *textualRenderer) renderValue(title string, v reflect.Value, indent int) ([]screen, error) {
+	if !v.IsValid() || v.IsZero() {
+		return nil, nil
+	}
+
+	content, ok, err := r.formatValue(v)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return []screen{{title: title, content: content, indent: indent}}, nil
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.Type() == reflect.PtrTo(anyType) {
+			return r.renderAny(title, v.Interface().(*codectypes.Any), indent)
+		}
+		return r.renderValue(title, v.Elem(), indent)
+
+	case reflect.Slice, reflect.Array:
+		n := v.Len()
+		var screens []screen
+		for i := 0; i < n; i++ {
+			elemScreens, err := r.renderElem(fmt.Sprintf("%s (%d/%d)", title, i+1, n), v.Index(i), indent)
+			if err != nil {
+				return nil, err
+			}
+			screens = append(screens, elemScreens...)
+		}
+		return screens, nil
+
+	case reflect.Map:
+		// map entries are shown sorted by key
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+		})
+		var screens []screen
+		for _, key := range keys {
+			entryScreens, err := r.renderElem(fmt.Sprintf("%s (%v)", title, key.Interface()), v.MapIndex(key), indent)
+			if err != nil {
+				return nil, err
+			}
+			screens = append(screens, entryScreens...)
+		}
+		return screens, nil
+
+	case reflect.Struct:
+		if v.Type() == anyType {
+			any := v.Interface().(codectypes.Any)
+			return r.renderAny(title, &any, indent)
+		}
+
+		screens := []screen{{title: title, content: messageName(v), indent: indent}}
+		fields, err := r.renderFields(v, indent+1)
+		if err != nil {
+			return nil, err
+		}
+		return append(screens, fields...), nil
+
+	default:
+		return nil, fmt.Errorf("cannot render value of type %s in %s", v.Type(), title)
+	}
+}
+
+// renderElem renders an element of a repeated field, which is shown even when
+// it holds its default value so that the number of elements is preserved.
+func (r *textualRenderer) renderElem(title string, v reflect.Value, indent int) ([]screen, error) {
+	if v.IsZero() {
+		return []screen{{title: title, indent: indent}}, nil
+	}
+
+	return r.renderValue(title, v, indent)
+}
+
+// formatValue formats scalar values and the values having a dedicated
+// renderer. It returns false if the value must be rendered on several screens.
+func (r *textualRenderer) formatValue(v reflect.Value) (string, bool, error) {
+	switch v.Type() {
+	case coinType:
+		content, err := r.formatCoins(sdk.Coins{v.Interface().(sdk.Coin)})
+		return content, true, err
+	case coinsType:
+		content, err := r.formatCoins(v.Interface().(sdk.Coins))
+		return content, true, err
+	case decCoinType:
+		content, err := r.formatDecCoins(sdk.DecCoins{v.Interface().(sdk.DecCoin)})
+		return content, true, err
+	case decCoinsType:
+		content, err := r.formatDecCoins(v.Interface().(sdk.DecCoins))
+		return content, true, err
+	case intType:
+		return formatInteger(v.Interface().(sdk.Int).String()), true, nil
+	case decType:
+		return formatDecimal(v.Interface().(sdk.Dec).String()), true, nil
+	case timeType:
+		return formatTime(v.Interface().(time.Time)), true, nil
+	case durationType:
+		return v.Interface().(time.Duration).String(), true, nil
+	}
+
+	switch v.Kind() {
+	case reflect.Slice:
+		if v.Type() == reflect.SliceOf(coinType) {
+			content, err := r.formatCoins(sdk.NewCoins(v.Interface().([]sdk.Coin)...))
+			return content, true, err
+		}
+		if v.Type().Elem().Kind() != reflect.Uint8 {
+			return "", false, nil
+		}
+		// addresses are shown in bech32
+		if v.Type().Implements(stringerType) {
+			return v.Interface().(fmt.Stringer).String(), true, nil
+		}
+		return formatBytes(v.Bytes()), true, nil
+
+	case reflect.String:
+		return v.String(), true, nil
+
+	case reflect.Bool:
+		if v.Bool() {
+			return "True", true, nil
+		}
+		return "False", true, nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		// enums are shown by name
+		if v.Type().Implements(stringerType) {
+			return v.Interface().(fmt.Stringer).String(), true, nil
+		}
+		return formatInteger(fmt.Sprint(v.Int())), true, nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return formatInteger(fmt.Sprint(v.Uint())), true, nil
+
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64), true, nil
+
+	case reflect.Ptr:
+		switch v.Type().Elem() {
+		case timeType, durationType, coinType, decCoinType:
+			return r.formatValue(v.Elem())
+		}
+	}
+
+	return "", false, nil
+}
+
+// formatCoins formats coins in the display denom of their metadata, sorted by
+// the displayed denom, e.g. "1.5 atom, 20 stake".
+func (r *textualRenderer) formatCoins(coins sdk.Coins) (string, error) {
+	decCoins := make(sdk.DecCoins, len(coins))
+	for i, coin := range coins {
+		decCoins[i] = sdk.DecCoin{Denom: coin.Denom, Amount: coin.Amount.ToDec()}
+	}
+
+	return r.formatDecCoins(decCoins)
+}
+
+func (r *textualRenderer) formatDecCoins(coins sdk.DecCoins) (string, error) {
+	formatted := make([]struct{ amount, denom string }, len(coins))
+	for i, coin := range coins {
+		amount, denom, err := r.toDisplay(coin.Amount, coin.Denom)
+		if err != nil {
+			return "", err
+		}
+		formatted[i].amount = formatDecimal(amount.String())
+		formatted[i].denom = denom
+	}
+
+	sort.SliceStable(formatted, func(i, j int) bool {
+		return formatted[i].denom < formatted[j].denom
+	})
+
+	parts := make([]string, len(formatted))
+	for i, f := range formatted {
+		parts[i] = f.amount + " " + f.denom
+	}
+
+	return strings.Join(parts, ", "), nil
+}
+
+// toDisplay converts an amount of denom to the display denom of its metadata.
+// The amount is left unchanged if the denom has no metadata or if the display
+// denom unit is missing.
+func (r *textualRenderer) toDisplay(amount sdk.Dec, denom string) (sdk.Dec, string, error) {
+	metadata, err := r.getMetadata(denom)
+	if err != nil {
+		return amount, denom, err
+	}
+	if metadata == nil || metadata.Display == "" || metadata.Display == denom {
+		return amount, denom, nil
+	}
+
+	var (
+		denomExp, displayExp     int64
+		foundDenom, foundDisplay bool
+	)
+	for _, unit := range metadata.DenomUnits {
+		if unit == nil {
+			continue
+		}
+		if unit.Denom == denom {
+			denomExp, foundDenom = int64(unit.Exponent), true
+		}
+		if unit.Denom == metadata.Display {
+			displayExp, foundDisplay = int64(unit.Exponent), true
+		}
+	}
+	if !foundDisplay || (!foundDenom && denom != metadata.Base) {
+		return amount, denom, nil
+	}
+
+	diff := displayExp - denomExp
+	switch {
+	case diff > sdk.Precision || diff < -sdk.Precision:
+		// the amount cannot be converted without losing precision
+		return amount, denom, nil
+	case diff > 0:
+		return amount.Quo(sdk.NewDecFromInt(sdk.NewIntWithDecimal(1, int(diff)))), metadata.Display, nil
+	case diff < 0:
+		return amount.MulInt(sdk.NewIntWithDecimal(1, int(-diff))), metadata.Display, nil
+	default:
+		return amount, metadata.Display, nil
+	}
+}
+
+func (r *textualRenderer) getMetadata(denom string) (*banktypes.Metadata, error) {
+	if r.coinMetadata == nil {
+		return nil, nil
+	}
+
+	if metadata, ok := r.metadata[denom]; ok {
+		return metadata, nil
+	}
+
+	metadata, err := r.coinMetadata(r.ctx, denom)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get metadata of denom %s: %w", denom, err)
+	}
+	r.metadata[denom] = metadata
+
+	return metadata, nil
+}
+
+// formatInteger formats a base 10 integer with a "'" thousands separator, e.g.
+// "1'000'000".
+func formatInteger(v string) string {
+	sign := ""
+	if strings.HasPrefix(v, "-") {
+		sign, v = "-", v[1:]
+	}
+
+	var b strings.Builder
+	for i, c := range v {
+		if i > 0 && (len(v)-i)%3 == 0 {
+			b.WriteRune('\'')
+		}
+		b.WriteRune(c)
+	}
+
+	return sign + b.String()
+}
+
+// formatDecimal formats a decimal with a "'" thousands separator and without
+// trailing zeros, e.g. "1'000.5".
+func formatDecimal(v string) string {
+	parts := strings.SplitN(v, ".", 2)
+	integer := formatInteger(parts[0])
+	if len(parts) == 1 {
+		return integer
+	}
+
+	fraction := strings.TrimRight(parts[1], "0")
+	if fraction == "" {
+		return integer
+	}
+
+	return integer + "." + fraction
+}
+
+// formatTime formats a time in UTC as RFC 3339, e.g. "2021-01-01T12:00:00Z".
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// formatBytes formats bytes in hex, or their SHA-256 hash if there are too
+// many of them to fit on a screen.
+func formatBytes(bz []byte) string {
+	if len(bz) <= maxHexBytesLen {
+		return fmt.Sprintf("%X", bz)
+	}
+
+	return fmt.Sprintf("SHA-256=%X", sha256.Sum256(bz))
+}
+
+// protoFieldName returns the protobuf name of a struct field generated from a
+// protobuf message, or an empty string for other fields.
+func protoFieldName(field reflect.StructField) string {
+	tag, ok := field.Tag.Lookup("protobuf")
+	if !ok {
+		return ""
+	}
+
+	for _, part := range strings.Split(tag, ",") {
+		if strings.HasPrefix(part, "name=") {
+			return strings.TrimPrefix(part, "name=")
+		}
+	}
+
+	return ""
+}
+
+// fieldTitle turns a protobuf field name into a title, e.g. "from_address"
+// becomes "From address".
+func fieldTitle(name string) string {
+	title := []rune(strings.ReplaceAll(name, "_", " "))
+	if len(title) > 0 {
+		title[0] = unicode.ToUpper(title[0])
+	}
+
+	return string(title)
+}
+
+// messageName returns the protobuf name of a message, or an empty string if
+// the value is not a protobuf message.
+func messageName(v reflect.Value) string {
+	if v.CanAddr() {
+		if msg, ok := v.Addr().Interface().(proto.Message); ok {
+			return proto.MessageName(msg)
+		}
+	}
+
+	ptr := reflect.New(v.Type())
+	if msg, ok := ptr.Interface().(proto.Message); ok {
+		return proto.MessageName(msg)
+	}
+
+	return ""
+}
@@ -0,0 +1,163 @@
+package tx
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func testCoinMetadata(_ context.Context, denom string) (*banktypes.Metadata, error) {
+	if denom != "uatom" {
+		return nil, nil
+	}
+
+	return &banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "uatom", Exponent: 0},
+			{Denom: "atom", Exponent: 6},
+		},
+		Base:    "uatom",
+		Display: "atom",
+	}, nil
+}
+
+func TestTextualModeHandler(t *testing.T) {
+	privKey, pubkey, addr := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(interfaceRegistry)
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+
+	txConfig := NewTxConfigWithTextual(marshaler, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL}, testCoinMetadata)
+	txBuilder := txConfig.NewTxBuilder()
+
+	accSeq := uint64(2)
+	amount := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1500000), sdk.NewInt64Coin("stake", 20))
+	require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(addr, addr2, amount)))
+	txBuilder.SetMemo("textual")
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("uatom", 2000)))
+	txBuilder.SetGasLimit(100000)
+
+	sig := signingtypes.SignatureV2{
+		PubKey:   pubkey,
+		Data:     &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_TEXTUAL},
+		Sequence: accSeq,
+	}
+	require.NoError(t, txBuilder.SetSignatures(sig))
+
+	modeHandler := txConfig.SignModeHandler()
+	require.Equal(t, signingtypes.SignMode_SIGN_MODE_TEXTUAL, modeHandler.DefaultMode())
+	require.Len(t, modeHandler.Modes(), 1)
+
+	signingData := signing.SignerData{
+		ChainID:       "test-chain",
+		AccountNumber: 1,
+		Sequence:      accSeq,
+	}
+
+	signBytes, err := modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+
+	protoTx := txBuilder.(*wrapper)
+	rawBytes, err := DirectSignBytes(protoTx.getBodyBytes(), protoTx.getAuthInfoBytes(), "test-chain", 1)
+	require.NoError(t, err)
+
+	expected := []string{
+		"Chain id: test-chain",
+		"Account number: 1",
+		"Sequence: 2",
+		"This transaction has 1 Message",
+		"Message (1/1): /cosmos.bank.v1beta1.MsgSend",
+		"> From address: " + addr.String(),
+		"> To address: " + addr2.String(),
+		"> Amount: 1.5 atom, 20 stake",
+		"End of Messages",
+		"Memo: textual",
+		"Fees: 0.002 atom",
+		"*Gas limit: 100'000",
+		"*Signer (1/1): cosmos.tx.v1beta1.SignerInfo",
+		"*> Public key: /cosmos.crypto.secp256k1.PubKey",
+		fmt.Sprintf("*> > Key: %X", pubkey.Bytes()),
+		"*> Mode info: cosmos.tx.v1beta1.ModeInfo",
+		"*> > Single: cosmos.tx.v1beta1.ModeInfo.Single",
+		"*> > > Mode: SIGN_MODE_TEXTUAL",
+		"*> Sequence: 2",
+		fmt.Sprintf("*Hash of raw bytes: %X", sha256.Sum256(rawBytes)),
+	}
+	require.Equal(t, strings.Join(expected, "\n"), string(signBytes))
+
+	t.Log("verify the signature of the textual sign bytes")
+	sigBytes, err := privKey.Sign(signBytes)
+	require.NoError(t, err)
+	sig.Data = &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_TEXTUAL, Signature: sigBytes}
+	require.NoError(t, txBuilder.SetSignatures(sig))
+	require.NoError(t, signing.VerifySignature(context.Background(), pubkey, signingData, sig.Data, modeHandler, txBuilder.GetTx()))
+
+	t.Log("verify the rendering without coin metadata")
+	txConfig = NewTxConfig(marshaler, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL})
+	signBytes, err = txConfig.SignModeHandler().GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.Contains(t, string(signBytes), "\n> Amount: 20 stake, 1'500'000 uatom\n")
+	require.Contains(t, string(signBytes), "\nFees: 2'000 uatom\n")
+
+	t.Log("verify GetSignBytes with unsupported modes")
+	_, err = modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT, signingData, txBuilder.GetTx())
+	require.Error(t, err)
+}
+
+func TestTextualScreenString(t *testing.T) {
+	testCases := []struct {
+		name     string
+		screen   screen
+		expected string
+	}{
+		{"title and content", screen{title: "Memo", content: "hello"}, "Memo: hello"},
+		{"content only", screen{content: "End of Messages"}, "End of Messages"},
+		{"title only", screen{title: "Messages (1/2)", indent: 1}, "> Messages (1/2):"},
+		{"expert", screen{title: "Gas limit", content: "1'000", indent: 2, expert: true}, "*> > Gas limit: 1'000"},
+		{"escaped", screen{title: "Memo", content: "a\nb\\cé\U0001F600"}, `Memo: a\nb\\c\u00E9\U0001F600`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.screen.String())
+		})
+	}
+}
+
+func TestTextualValueFormatting(t *testing.T) {
+	require.Equal(t, "0", formatInteger("0"))
+	require.Equal(t, "100", formatInteger("100"))
+	require.Equal(t, "1'000", formatInteger("1000"))
+	require.Equal(t, "-12'345'678", formatInteger("-12345678"))
+
+	require.Equal(t, "1'000", formatDecimal("1000.000000000000000000"))
+	require.Equal(t, "1'000.5", formatDecimal("1000.500000000000000000"))
+	require.Equal(t, "0.000001", formatDecimal("0.000001000000000000"))
+
+	require.Equal(t, "2021-01-02T03:04:05Z", formatTime(time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)))
+
+	require.Equal(t, "0102", formatBytes([]byte{1, 2}))
+	long := make([]byte, 64)
+	require.Equal(t, fmt.Sprintf("SHA-256=%X", sha256.Sum256(long)), formatBytes(long))
+
+	require.Equal(t, "From address", fieldTitle("from_address"))
+
+	r := newTextualRenderer(context.Background(), testCoinMetadata)
+	coins, err := r.formatCoins(sdk.NewCoins(sdk.NewInt64Coin("uatom", 1234567890), sdk.NewInt64Coin("btc", 3)))
+	require.NoError(t, err)
+	require.Equal(t, "1'234.56789 atom, 3 btc", coins)
+}
@@ -15,6 +15,8 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 )
 
 var (
@@ -329,6 +331,26 @@ type AccountI interface {
 	String() string
 }
 
+// AuthenticatorAccountI is an account whose signatures are verified by its own
+// authentication logic, e.g. session keys, spending limits or social recovery,
+// instead of by its public key. The ante handler neither sets nor checks the
+// public key of such an account, and delegates the verification of its
+// signatures to Authenticate. The sequence of the account is still checked and
+// incremented, and it can pay fees like any other account.
+type AuthenticatorAccountI interface {
+	AccountI
+
+	// Authenticate returns an error if the signature of the account for the tx
+	// is not valid. The signer data holds the chain ID, account number and
+	// sequence the signature must commit to, and the sign mode handler returns
+	// the bytes signed by the signature, e.g. through
+	// authsigning.VerifySignature.
+	Authenticate(
+		ctx sdk.Context, tx sdk.Tx, sig signing.SignatureV2, signerData authsigning.SignerData,
+		handler authsigning.SignModeHandler,
+	) error
+}
+
 // ModuleAccountI defines an account interface for modules that hold tokens in
 // an escrow.
 type ModuleAccountI interface {
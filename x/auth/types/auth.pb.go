@@ -113,6 +113,8 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty" yaml:"tx_size_cost_per_byte"`
 	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty" yaml:"sig_verify_cost_ed25519"`
 	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty" yaml:"sig_verify_cost_secp256k1"`
+	EnableChangePubKey     bool   `protobuf:"varint,6,opt,name=enable_change_pub_key,json=enableChangePubKey,proto3" json:"enable_change_pub_key,omitempty" yaml:"enable_change_pub_key"`
+	PubKeyChangeCost       uint64 `protobuf:"varint,7,opt,name=pub_key_change_cost,json=pubKeyChangeCost,proto3" json:"pub_key_change_cost,omitempty" yaml:"pub_key_change_cost"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -182,6 +184,20 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
+func (m *Params) GetEnableChangePubKey() bool {
+	if m != nil {
+		return m.EnableChangePubKey
+	}
+	return false
+}
+
+func (m *Params) GetPubKeyChangeCost() uint64 {
+	if m != nil {
+		return m.PubKeyChangeCost
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos.auth.v1beta1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos.auth.v1beta1.ModuleAccount")
@@ -191,50 +207,53 @@ func init() {
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/auth.proto", fileDescriptor_7e1f7e915d020d2d) }
 
 var fileDescriptor_7e1f7e915d020d2d = []byte{
-	// 674 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0x4d, 0x4f, 0xdb, 0x4a,
-	0x14, 0x8d, 0x5f, 0xf2, 0xf8, 0x98, 0x00, 0x12, 0x26, 0x80, 0x93, 0xf7, 0x64, 0x5b, 0x5e, 0xe5,
-	0x49, 0x2f, 0x8e, 0x92, 0x8a, 0x4a, 0x64, 0x51, 0x15, 0xd3, 0x2e, 0x50, 0x0b, 0x42, 0x46, 0xea,
-	0xa2, 0xaa, 0xe4, 0x8e, 0x9d, 0xc1, 0x58, 0x64, 0x32, 0xc6, 0x33, 0x46, 0x31, 0xbf, 0xa0, 0xcb,
-	0x2e, 0xbb, 0xe4, 0x47, 0xf0, 0x0f, 0xba, 0xe9, 0x12, 0xb1, 0xea, 0xca, 0xad, 0xc2, 0xa6, 0xea,
-	0x32, 0xfb, 0x4a, 0x95, 0x67, 0x9c, 0x90, 0xa0, 0x74, 0x95, 0xb9, 0xe7, 0x9c, 0x7b, 0xee, 0x9d,
-	0x7b, 0xe3, 0x01, 0xaa, 0x47, 0x28, 0x26, 0xb4, 0x09, 0x63, 0x76, 0xd6, 0xbc, 0x6c, 0xb9, 0x88,
-	0xc1, 0x16, 0x0f, 0xcc, 0x30, 0x22, 0x8c, 0xc8, 0x1b, 0x82, 0x37, 0x39, 0x94, 0xf3, 0xb5, 0xaa,
-	0x00, 0x1d, 0x2e, 0x69, 0xe6, 0x0a, 0x1e, 0xd4, 0x2a, 0x3e, 0xf1, 0x89, 0xc0, 0xb3, 0x53, 0x8e,
-	0x56, 0x7d, 0x42, 0xfc, 0x1e, 0x6a, 0xf2, 0xc8, 0x8d, 0x4f, 0x9b, 0xb0, 0x9f, 0x08, 0xca, 0xf8,
-	0x25, 0x81, 0xb2, 0x05, 0x29, 0xda, 0xf3, 0x3c, 0x12, 0xf7, 0x99, 0xac, 0x80, 0x45, 0xd8, 0xed,
-	0x46, 0x88, 0x52, 0x45, 0xd2, 0xa5, 0xfa, 0xb2, 0x3d, 0x0e, 0xe5, 0x77, 0x60, 0x31, 0x8c, 0x5d,
-	0xe7, 0x1c, 0x25, 0xca, 0x5f, 0xba, 0x54, 0x2f, 0xb7, 0x2b, 0xa6, 0xb0, 0x35, 0xc7, 0xb6, 0xe6,
-	0x5e, 0x3f, 0xb1, 0x1a, 0x3f, 0x53, 0xad, 0x12, 0xc6, 0x6e, 0x2f, 0xf0, 0x32, 0xed, 0xff, 0x04,
-	0x07, 0x0c, 0xe1, 0x90, 0x25, 0xa3, 0x54, 0x5b, 0x4f, 0x20, 0xee, 0x75, 0x8c, 0x07, 0xd6, 0xb0,
-	0x17, 0xc2, 0xd8, 0x7d, 0x85, 0x12, 0xf9, 0x39, 0x58, 0x83, 0xa2, 0x05, 0xa7, 0x1f, 0x63, 0x17,
-	0x45, 0x4a, 0x51, 0x97, 0xea, 0x25, 0xab, 0x3a, 0x4a, 0xb5, 0x4d, 0x91, 0x36, 0xcb, 0x1b, 0xf6,
-	0x6a, 0x0e, 0x1c, 0xf1, 0x58, 0xae, 0x81, 0x25, 0x8a, 0x2e, 0x62, 0xd4, 0xf7, 0x90, 0x52, 0xca,
-	0x72, 0xed, 0x49, 0xdc, 0x51, 0x3e, 0x5c, 0x6b, 0x85, 0x4f, 0xd7, 0x5a, 0xe1, 0xc7, 0xb5, 0x56,
-	0xb8, 0xbb, 0x69, 0x2c, 0xe5, 0xd7, 0x3d, 0x30, 0x3e, 0x4b, 0x60, 0xf5, 0x90, 0x74, 0xe3, 0xde,
-	0x64, 0x02, 0xef, 0xc1, 0x8a, 0x0b, 0x29, 0x72, 0x72, 0x77, 0x3e, 0x86, 0x72, 0x5b, 0x37, 0xe7,
-	0x6c, 0xc2, 0x9c, 0x9a, 0x9c, 0xf5, 0xcf, 0x6d, 0xaa, 0x49, 0xa3, 0x54, 0xdb, 0x10, 0xdd, 0x4e,
-	0x7b, 0x18, 0x76, 0xd9, 0x9d, 0x9a, 0xb1, 0x0c, 0x4a, 0x7d, 0x88, 0x11, 0x1f, 0xe3, 0xb2, 0xcd,
-	0xcf, 0xb2, 0x0e, 0xca, 0x21, 0x8a, 0x70, 0x40, 0x69, 0x40, 0xfa, 0x54, 0x29, 0xea, 0xc5, 0xfa,
-	0xb2, 0x3d, 0x0d, 0x75, 0x6a, 0xe3, 0x3b, 0xdc, 0xdd, 0x34, 0xd6, 0x66, 0x5a, 0x3e, 0x30, 0xbe,
-	0x15, 0xc1, 0xc2, 0x31, 0x8c, 0x20, 0xa6, 0xf2, 0x11, 0xd8, 0xc0, 0x70, 0xe0, 0x60, 0x84, 0x89,
-	0xe3, 0x9d, 0xc1, 0x08, 0x7a, 0x0c, 0x45, 0x62, 0x99, 0x25, 0x4b, 0x1d, 0xa5, 0x5a, 0x4d, 0xf4,
-	0x37, 0x47, 0x64, 0xd8, 0xeb, 0x18, 0x0e, 0x0e, 0x11, 0x26, 0xfb, 0x13, 0x4c, 0xde, 0x05, 0x2b,
-	0x6c, 0xe0, 0xd0, 0xc0, 0x77, 0x7a, 0x01, 0x0e, 0x18, 0x6f, 0xba, 0x64, 0x6d, 0x3f, 0x5c, 0x74,
-	0x9a, 0x35, 0x6c, 0xc0, 0x06, 0x27, 0x81, 0xff, 0x3a, 0x0b, 0x64, 0x1b, 0x6c, 0x72, 0xf2, 0x0a,
-	0x39, 0x1e, 0xa1, 0xcc, 0x09, 0x51, 0xe4, 0xb8, 0x09, 0x43, 0xf9, 0x6a, 0xf5, 0x51, 0xaa, 0xfd,
-	0x3b, 0xe5, 0xf1, 0x58, 0x66, 0xd8, 0xeb, 0x99, 0xd9, 0x15, 0xda, 0x27, 0x94, 0x1d, 0xa3, 0xc8,
-	0x4a, 0x18, 0x92, 0x2f, 0xc0, 0x76, 0x56, 0xed, 0x12, 0x45, 0xc1, 0x69, 0x22, 0xf4, 0xa8, 0xdb,
-	0xde, 0xd9, 0x69, 0xed, 0x8a, 0xa5, 0x5b, 0x9d, 0x61, 0xaa, 0x55, 0x4e, 0x02, 0xff, 0x0d, 0x57,
-	0x64, 0xa9, 0x2f, 0x5f, 0x70, 0x7e, 0x94, 0x6a, 0xaa, 0xa8, 0xf6, 0x07, 0x03, 0xc3, 0xae, 0xd0,
-	0x99, 0x3c, 0x01, 0xcb, 0x09, 0xa8, 0x3e, 0xce, 0xa0, 0xc8, 0x0b, 0xdb, 0x3b, 0x4f, 0xcf, 0x5b,
-	0xca, 0xdf, 0xbc, 0xe8, 0xb3, 0x61, 0xaa, 0x6d, 0xcd, 0x14, 0x3d, 0x19, 0x2b, 0x46, 0xa9, 0xa6,
-	0xcf, 0x2f, 0x3b, 0x31, 0x31, 0xec, 0x2d, 0x3a, 0x37, 0xb7, 0xb3, 0x94, 0xff, 0x67, 0x25, 0x6b,
-	0xff, 0xcb, 0x50, 0x95, 0x6e, 0x87, 0xaa, 0xf4, 0x7d, 0xa8, 0x4a, 0x1f, 0xef, 0xd5, 0xc2, 0xed,
-	0xbd, 0x5a, 0xf8, 0x7a, 0xaf, 0x16, 0xde, 0xfe, 0xe7, 0x07, 0xec, 0x2c, 0x76, 0x4d, 0x8f, 0xe0,
-	0xfc, 0x2d, 0xc8, 0x7f, 0x1a, 0xb4, 0x7b, 0xde, 0x1c, 0x88, 0xa7, 0x85, 0x25, 0x21, 0xa2, 0xee,
-	0x02, 0xff, 0x52, 0x9f, 0xfc, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x49, 0x90, 0x16, 0xd9, 0x76, 0x04,
-	0x00, 0x00,
+	// 729 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0x41, 0x4f, 0xe3, 0x46,
+	0x14, 0x8e, 0x4b, 0x08, 0x61, 0x02, 0xa8, 0x38, 0x01, 0x9c, 0xb4, 0xb2, 0x2d, 0x9f, 0x52, 0xa9,
+	0x71, 0x94, 0x54, 0x54, 0x22, 0x87, 0xaa, 0x38, 0xed, 0x01, 0xb5, 0x20, 0xe4, 0x48, 0x3d, 0x54,
+	0x95, 0xdc, 0xb1, 0x33, 0x18, 0x8b, 0xd8, 0x63, 0x3c, 0x63, 0x14, 0x73, 0xdd, 0xcb, 0x1e, 0xf7,
+	0xb8, 0x47, 0x7e, 0x04, 0xff, 0x60, 0x2f, 0x7b, 0x44, 0x9c, 0xf6, 0x64, 0xad, 0xc2, 0x65, 0xb5,
+	0xc7, 0xdc, 0x57, 0x5a, 0x79, 0xc6, 0x09, 0x09, 0xca, 0x9e, 0xe2, 0xf7, 0x7d, 0xdf, 0xfb, 0xde,
+	0x9b, 0xf7, 0x32, 0x03, 0x64, 0x07, 0x13, 0x1f, 0x93, 0x36, 0x8c, 0xe9, 0x65, 0xfb, 0xa6, 0x63,
+	0x23, 0x0a, 0x3b, 0x2c, 0xd0, 0xc3, 0x08, 0x53, 0x2c, 0x56, 0x39, 0xaf, 0x33, 0x28, 0xe7, 0x1b,
+	0x75, 0x0e, 0x5a, 0x4c, 0xd2, 0xce, 0x15, 0x2c, 0x68, 0xd4, 0x5c, 0xec, 0x62, 0x8e, 0x67, 0x5f,
+	0x39, 0x5a, 0x77, 0x31, 0x76, 0x47, 0xa8, 0xcd, 0x22, 0x3b, 0xbe, 0x68, 0xc3, 0x20, 0xe1, 0x94,
+	0xf6, 0x45, 0x00, 0x15, 0x03, 0x12, 0x74, 0xec, 0x38, 0x38, 0x0e, 0xa8, 0x28, 0x81, 0x0d, 0x38,
+	0x1c, 0x46, 0x88, 0x10, 0x49, 0x50, 0x85, 0xe6, 0xa6, 0x39, 0x0b, 0xc5, 0xff, 0xc0, 0x46, 0x18,
+	0xdb, 0xd6, 0x15, 0x4a, 0xa4, 0xef, 0x54, 0xa1, 0x59, 0xe9, 0xd6, 0x74, 0x6e, 0xab, 0xcf, 0x6c,
+	0xf5, 0xe3, 0x20, 0x31, 0x5a, 0x9f, 0x53, 0xa5, 0x16, 0xc6, 0xf6, 0xc8, 0x73, 0x32, 0xed, 0xcf,
+	0xd8, 0xf7, 0x28, 0xf2, 0x43, 0x9a, 0x4c, 0x53, 0x65, 0x37, 0x81, 0xfe, 0xa8, 0xa7, 0x3d, 0xb3,
+	0x9a, 0x59, 0x0a, 0x63, 0xfb, 0x2f, 0x94, 0x88, 0xbf, 0x83, 0x1d, 0xc8, 0x5b, 0xb0, 0x82, 0xd8,
+	0xb7, 0x51, 0x24, 0xad, 0xa9, 0x42, 0xb3, 0x68, 0xd4, 0xa7, 0xa9, 0xb2, 0xc7, 0xd3, 0x96, 0x79,
+	0xcd, 0xdc, 0xce, 0x81, 0x33, 0x16, 0x8b, 0x0d, 0x50, 0x26, 0xe8, 0x3a, 0x46, 0x81, 0x83, 0xa4,
+	0x62, 0x96, 0x6b, 0xce, 0xe3, 0x9e, 0xf4, 0xfa, 0x4e, 0x29, 0xbc, 0xbd, 0x53, 0x0a, 0x9f, 0xee,
+	0x94, 0xc2, 0xe3, 0x7d, 0xab, 0x9c, 0x1f, 0xf7, 0x44, 0x7b, 0x27, 0x80, 0xed, 0x53, 0x3c, 0x8c,
+	0x47, 0xf3, 0x09, 0xfc, 0x0f, 0xb6, 0x6c, 0x48, 0x90, 0x95, 0xbb, 0xb3, 0x31, 0x54, 0xba, 0xaa,
+	0xbe, 0x62, 0x13, 0xfa, 0xc2, 0xe4, 0x8c, 0x1f, 0x1e, 0x52, 0x45, 0x98, 0xa6, 0x4a, 0x95, 0x77,
+	0xbb, 0xe8, 0xa1, 0x99, 0x15, 0x7b, 0x61, 0xc6, 0x22, 0x28, 0x06, 0xd0, 0x47, 0x6c, 0x8c, 0x9b,
+	0x26, 0xfb, 0x16, 0x55, 0x50, 0x09, 0x51, 0xe4, 0x7b, 0x84, 0x78, 0x38, 0x20, 0xd2, 0x9a, 0xba,
+	0xd6, 0xdc, 0x34, 0x17, 0xa1, 0x5e, 0x63, 0x76, 0x86, 0xc7, 0xfb, 0xd6, 0xce, 0x52, 0xcb, 0x27,
+	0xda, 0xab, 0x75, 0x50, 0x3a, 0x87, 0x11, 0xf4, 0x89, 0x78, 0x06, 0xaa, 0x3e, 0x1c, 0x5b, 0x3e,
+	0xf2, 0xb1, 0xe5, 0x5c, 0xc2, 0x08, 0x3a, 0x14, 0x45, 0x7c, 0x99, 0x45, 0x43, 0x9e, 0xa6, 0x4a,
+	0x83, 0xf7, 0xb7, 0x42, 0xa4, 0x99, 0xbb, 0x3e, 0x1c, 0x9f, 0x22, 0x1f, 0xf7, 0xe7, 0x98, 0x78,
+	0x04, 0xb6, 0xe8, 0xd8, 0x22, 0x9e, 0x6b, 0x8d, 0x3c, 0xdf, 0xa3, 0xac, 0xe9, 0xa2, 0x71, 0xf0,
+	0x7c, 0xd0, 0x45, 0x56, 0x33, 0x01, 0x1d, 0x0f, 0x3c, 0xf7, 0xef, 0x2c, 0x10, 0x4d, 0xb0, 0xc7,
+	0xc8, 0x5b, 0x64, 0x39, 0x98, 0x50, 0x2b, 0x44, 0x91, 0x65, 0x27, 0x14, 0xe5, 0xab, 0x55, 0xa7,
+	0xa9, 0xf2, 0xe3, 0x82, 0xc7, 0x4b, 0x99, 0x66, 0xee, 0x66, 0x66, 0xb7, 0xa8, 0x8f, 0x09, 0x3d,
+	0x47, 0x91, 0x91, 0x50, 0x24, 0x5e, 0x83, 0x83, 0xac, 0xda, 0x0d, 0x8a, 0xbc, 0x8b, 0x84, 0xeb,
+	0xd1, 0xb0, 0x7b, 0x78, 0xd8, 0x39, 0xe2, 0x4b, 0x37, 0x7a, 0x93, 0x54, 0xa9, 0x0d, 0x3c, 0xf7,
+	0x1f, 0xa6, 0xc8, 0x52, 0xff, 0xfc, 0x83, 0xf1, 0xd3, 0x54, 0x91, 0x79, 0xb5, 0x6f, 0x18, 0x68,
+	0x66, 0x8d, 0x2c, 0xe5, 0x71, 0x58, 0x4c, 0x40, 0xfd, 0x65, 0x06, 0x41, 0x4e, 0xd8, 0x3d, 0xfc,
+	0xf5, 0xaa, 0x23, 0xad, 0xb3, 0xa2, 0xbf, 0x4d, 0x52, 0x65, 0x7f, 0xa9, 0xe8, 0x60, 0xa6, 0x98,
+	0xa6, 0x8a, 0xba, 0xba, 0xec, 0xdc, 0x44, 0x33, 0xf7, 0xc9, 0xca, 0x5c, 0x71, 0x00, 0xf6, 0x50,
+	0x00, 0xed, 0x11, 0xca, 0xb6, 0x14, 0xb8, 0xc8, 0x9a, 0xdd, 0xc0, 0x92, 0x2a, 0x34, 0xcb, 0x8b,
+	0x13, 0x5c, 0x29, 0xd3, 0x4c, 0x91, 0xe3, 0x7d, 0x06, 0x9f, 0xf3, 0xab, 0x76, 0x0a, 0xaa, 0x39,
+	0x3f, 0x93, 0x67, 0xed, 0x48, 0x1b, 0x2f, 0xff, 0x21, 0x2b, 0x44, 0x9a, 0xf9, 0x3d, 0xbf, 0xaf,
+	0xdc, 0x30, 0x6b, 0xb6, 0x57, 0xce, 0xef, 0x95, 0x60, 0xf4, 0xdf, 0x4f, 0x64, 0xe1, 0x61, 0x22,
+	0x0b, 0x1f, 0x27, 0xb2, 0xf0, 0xe6, 0x49, 0x2e, 0x3c, 0x3c, 0xc9, 0x85, 0x0f, 0x4f, 0x72, 0xe1,
+	0xdf, 0x9f, 0x5c, 0x8f, 0x5e, 0xc6, 0xb6, 0xee, 0x60, 0x3f, 0x7f, 0xaf, 0xf2, 0x9f, 0x16, 0x19,
+	0x5e, 0xb5, 0xc7, 0xfc, 0xf9, 0xa3, 0x49, 0x88, 0x88, 0x5d, 0x62, 0xaf, 0xc9, 0x2f, 0x5f, 0x07,
+	0x00, 0x40, 0xba, 0x81, 0xa7, 0x1a, 0x05, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -271,6 +290,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
 		return false
 	}
+	if this.EnableChangePubKey != that1.EnableChangePubKey {
+		return false
+	}
+	if this.PubKeyChangeCost != that1.PubKeyChangeCost {
+		return false
+	}
 	return true
 }
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
@@ -396,6 +421,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PubKeyChangeCost != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.PubKeyChangeCost))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.EnableChangePubKey {
+		i--
+		if m.EnableChangePubKey {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.SigVerifyCostSecp256k1 != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
 		i--
@@ -502,6 +542,12 @@ func (m *Params) Size() (n int) {
 	if m.SigVerifyCostSecp256k1 != 0 {
 		n += 1 + sovAuth(uint64(m.SigVerifyCostSecp256k1))
 	}
+	if m.EnableChangePubKey {
+		n += 2
+	}
+	if m.PubKeyChangeCost != 0 {
+		n += 1 + sovAuth(uint64(m.PubKeyChangeCost))
+	}
 	return n
 }
 
@@ -941,6 +987,45 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableChangePubKey", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableChangePubKey = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyChangeCost", wireType)
+			}
+			m.PubKeyChangeCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PubKeyChangeCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -24,9 +24,31 @@ var (
 
 	// param key for global account number
 	GlobalAccountNumberKey = []byte("globalAccountNumber")
+
+	// UnorderedTxKeyPrefix prefix for the timeout height of unordered txs by hash
+	UnorderedTxKeyPrefix = []byte{0x02}
+
+	// UnorderedTxQueueKeyPrefix prefix for the unordered txs by timeout height
+	UnorderedTxQueueKeyPrefix = []byte{0x03}
 )
 
 // AddressStoreKey turn an address to key used to get it from the account store
 func AddressStoreKey(addr sdk.AccAddress) []byte {
 	return append(AddressStoreKeyPrefix, addr.Bytes()...)
 }
+
+// UnorderedTxKey returns the key of the timeout height of an unordered tx.
+func UnorderedTxKey(txHash []byte) []byte {
+	return append(UnorderedTxKeyPrefix, txHash...)
+}
+
+// UnorderedTxQueueHeightPrefix returns the prefix of the unordered txs timing
+// out at the given height.
+func UnorderedTxQueueHeightPrefix(timeoutHeight uint64) []byte {
+	return append(UnorderedTxQueueKeyPrefix, sdk.Uint64ToBigEndian(timeoutHeight)...)
+}
+
+// UnorderedTxQueueKey returns the key of an unordered tx in the timeout queue.
+func UnorderedTxQueueKey(timeoutHeight uint64, txHash []byte) []byte {
+	return append(UnorderedTxQueueHeightPrefix(timeoutHeight), txHash...)
+}
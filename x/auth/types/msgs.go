@@ -0,0 +1,74 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.MsgRequest                     = &MsgChangePubKey{}
+	_ codectypes.UnpackInterfacesMessage = &MsgChangePubKey{}
+)
+
+// auth message types
+const (
+	TypeMsgChangePubKey = "change_pub_key"
+)
+
+// NewMsgChangePubKey returns a reference to a new MsgChangePubKey.
+//nolint:interfacer
+func NewMsgChangePubKey(address sdk.AccAddress, pubKey cryptotypes.PubKey) (*MsgChangePubKey, error) {
+	any, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgChangePubKey{
+		Address: address.String(),
+		PubKey:  any,
+	}, nil
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgChangePubKey) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address: %s", err)
+	}
+	if msg.GetPubKey() == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "missing public key")
+	}
+
+	return nil
+}
+
+// GetSigners implements the sdk.Msg interface. The account whose public key
+// is replaced is the only signer.
+func (msg MsgChangePubKey) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// GetPubKey returns the unpacked new public key, or nil if it cannot be
+// unpacked.
+func (msg MsgChangePubKey) GetPubKey() cryptotypes.PubKey {
+	if msg.PubKey == nil {
+		return nil
+	}
+	pk, ok := msg.PubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil
+	}
+
+	return pk
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgChangePubKey) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.PubKey, &pubKey)
+}
@@ -0,0 +1,30 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestMsgChangePubKey(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	pubKey := secp256k1.GenPrivKey().PubKey()
+
+	msg, err := types.NewMsgChangePubKey(addr, pubKey)
+	require.NoError(t, err)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+	require.Equal(t, pubKey, msg.GetPubKey())
+
+	invalidAddr := *msg
+	invalidAddr.Address = "invalid"
+	require.Error(t, invalidAddr.ValidateBasic())
+
+	noPubKey := *msg
+	noPubKey.PubKey = nil
+	require.Error(t, noPubKey.ValidateBasic())
+}
@@ -15,6 +15,8 @@ const (
 	DefaultTxSizeCostPerByte      uint64 = 10
 	DefaultSigVerifyCostED25519   uint64 = 590
 	DefaultSigVerifyCostSecp256k1 uint64 = 1000
+	DefaultEnableChangePubKey     bool   = false
+	DefaultPubKeyChangeCost       uint64 = 5000
 )
 
 // Parameter keys
@@ -24,6 +26,8 @@ var (
 	KeyTxSizeCostPerByte      = []byte("TxSizeCostPerByte")
 	KeySigVerifyCostED25519   = []byte("SigVerifyCostED25519")
 	KeySigVerifyCostSecp256k1 = []byte("SigVerifyCostSecp256k1")
+	KeyEnableChangePubKey     = []byte("EnableChangePubKey")
+	KeyPubKeyChangeCost       = []byte("PubKeyChangeCost")
 )
 
 var _ paramtypes.ParamSet = &Params{}
@@ -31,6 +35,7 @@ var _ paramtypes.ParamSet = &Params{}
 // NewParams creates a new Params object
 func NewParams(
 	maxMemoCharacters, txSigLimit, txSizeCostPerByte, sigVerifyCostED25519, sigVerifyCostSecp256k1 uint64,
+	enableChangePubKey bool, pubKeyChangeCost uint64,
 ) Params {
 	return Params{
 		MaxMemoCharacters:      maxMemoCharacters,
@@ -38,6 +43,8 @@ func NewParams(
 		TxSizeCostPerByte:      txSizeCostPerByte,
 		SigVerifyCostED25519:   sigVerifyCostED25519,
 		SigVerifyCostSecp256k1: sigVerifyCostSecp256k1,
+		EnableChangePubKey:     enableChangePubKey,
+		PubKeyChangeCost:       pubKeyChangeCost,
 	}
 }
 
@@ -55,6 +62,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyTxSizeCostPerByte, &p.TxSizeCostPerByte, validateTxSizeCostPerByte),
 		paramtypes.NewParamSetPair(KeySigVerifyCostED25519, &p.SigVerifyCostED25519, validateSigVerifyCostED25519),
 		paramtypes.NewParamSetPair(KeySigVerifyCostSecp256k1, &p.SigVerifyCostSecp256k1, validateSigVerifyCostSecp256k1),
+		paramtypes.NewParamSetPair(KeyEnableChangePubKey, &p.EnableChangePubKey, validateEnableChangePubKey),
+		paramtypes.NewParamSetPair(KeyPubKeyChangeCost, &p.PubKeyChangeCost, validatePubKeyChangeCost),
 	}
 }
 
@@ -66,6 +75,8 @@ func DefaultParams() Params {
 		TxSizeCostPerByte:      DefaultTxSizeCostPerByte,
 		SigVerifyCostED25519:   DefaultSigVerifyCostED25519,
 		SigVerifyCostSecp256k1: DefaultSigVerifyCostSecp256k1,
+		EnableChangePubKey:     DefaultEnableChangePubKey,
+		PubKeyChangeCost:       DefaultPubKeyChangeCost,
 	}
 }
 
@@ -150,6 +161,24 @@ func validateTxSizeCostPerByte(i interface{}) error {
 	return nil
 }
 
+func validateEnableChangePubKey(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validatePubKeyChangeCost(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
 // Validate checks that the parameters have valid values.
 func (p Params) Validate() error {
 	if err := validateTxSigLimit(p.TxSigLimit); err != nil {
@@ -30,6 +30,9 @@ func GetTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		NewMsgCreateVestingAccountCmd(),
+		NewMsgCreatePeriodicVestingAccountCmd(),
+		NewMsgCreateClawbackVestingAccountCmd(),
+		NewMsgClawbackCmd(),
 	)
 
 	return txCmd
@@ -86,3 +89,139 @@ timestamp.`,
 
 	return cmd
 }
+
+// NewMsgCreatePeriodicVestingAccountCmd returns a CLI command handler for creating a
+// MsgCreatePeriodicVestingAccount transaction.
+func NewMsgCreatePeriodicVestingAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-periodic-vesting-account [to_address] [periods_json_file]",
+		Short: "Create a new periodic vesting account funded with an allocation of tokens.",
+		Long: `Create a new periodic vesting account funded with an allocation of tokens. The
+vesting schedule is read from a JSON file holding the start time of the account as a
+UNIX epoch timestamp and a list of periods, each of them unlocking an amount of coins
+once its length in seconds has elapsed, e.g.:
+{
+  "start_time": 1625204910,
+  "periods": [
+    {
+      "coins": "10stake",
+      "length_seconds": 2592000
+    },
+    {
+      "coins": "10stake",
+      "length_seconds": 2592000
+    }
+  ]
+}`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			startTime, periods, err := readScheduleFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreatePeriodicVestingAccount(clientCtx.GetFromAddress(), toAddr, startTime, periods)
+			svcMsgClientConn := &msgservice.ServiceMsgClientConn{}
+			msgClient := types.NewMsgClient(svcMsgClientConn)
+			_, err = msgClient.CreatePeriodicVestingAccount(cmd.Context(), msg)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), svcMsgClientConn.GetMsgs()...)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMsgCreateClawbackVestingAccountCmd returns a CLI command handler for creating a
+// MsgCreateClawbackVestingAccount transaction.
+func NewMsgCreateClawbackVestingAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-clawback-vesting-account [to_address] [periods_json_file]",
+		Short: "Create a new vesting account whose unvested tokens can be clawed back by the sender.",
+		Long: `Create a new clawback vesting account funded with an allocation of tokens. The
+account vests like a periodic vesting account, see create-periodic-vesting-account for
+the format of the periods file, and the sender of the transaction may claw back the
+tokens which have not vested yet at any time.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			startTime, periods, err := readScheduleFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateClawbackVestingAccount(clientCtx.GetFromAddress(), toAddr, startTime, periods)
+			svcMsgClientConn := &msgservice.ServiceMsgClientConn{}
+			msgClient := types.NewMsgClient(svcMsgClientConn)
+			_, err = msgClient.CreateClawbackVestingAccount(cmd.Context(), msg)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), svcMsgClientConn.GetMsgs()...)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMsgClawbackCmd returns a CLI command handler for creating a MsgClawback
+// transaction.
+func NewMsgClawbackCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback [address]",
+		Short: "Transfer the unvested tokens of a clawback vesting account back to its funder.",
+		Long: `Transfer the unvested tokens of a clawback vesting account, including the
+delegated ones, back to its funder. Only the funder of the account may submit this
+transaction.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClawback(clientCtx.GetFromAddress(), addr)
+			svcMsgClientConn := &msgservice.ServiceMsgClientConn{}
+			msgClient := types.NewMsgClient(svcMsgClientConn)
+			_, err = msgClient.Clawback(cmd.Context(), msg)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), svcMsgClientConn.GetMsgs()...)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
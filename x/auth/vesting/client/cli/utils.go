@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// VestingData defines the vesting schedule read from a JSON file by the
+// commands creating a vesting account from a list of periods.
+type VestingData struct {
+	StartTime int64         `json:"start_time"`
+	Periods   []InputPeriod `json:"periods"`
+}
+
+// InputPeriod defines a single vesting period of a VestingData.
+type InputPeriod struct {
+	Coins  string `json:"coins"`
+	Length int64  `json:"length_seconds"`
+}
+
+// readScheduleFile reads the file at path and returns the start time and the
+// vesting periods it contains.
+func readScheduleFile(path string) (int64, types.Periods, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var data VestingData
+	if err := json.Unmarshal(contents, &data); err != nil {
+		return 0, nil, err
+	}
+
+	periods := make(types.Periods, len(data.Periods))
+	for i, p := range data.Periods {
+		amount, err := sdk.ParseCoinsNormalized(p.Coins)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		if p.Length < 1 {
+			return 0, nil, fmt.Errorf("invalid period length of %d in period %d, length must be greater than 0", p.Length, i)
+		}
+
+		periods[i] = types.Period{Length: p.Length, Amount: amount}
+	}
+
+	return data.StartTime, periods, nil
+}
@@ -2,6 +2,7 @@ package vesting_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -10,6 +11,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 )
 
 type HandlerTestSuite struct {
@@ -23,7 +25,7 @@ func (suite *HandlerTestSuite) SetupTest() {
 	checkTx := false
 	app := simapp.Setup(checkTx)
 
-	suite.handler = vesting.NewHandler(app.AccountKeeper, app.BankKeeper)
+	suite.handler = vesting.NewHandler(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
 	suite.app = app
 }
 
@@ -91,6 +93,234 @@ func (suite *HandlerTestSuite) TestMsgCreateVestingAccount() {
 	}
 }
 
+func (suite *HandlerTestSuite) TestMsgCreatePeriodicVestingAccount() {
+	now := time.Now()
+	ctx := suite.app.BaseApp.NewContext(false, tmproto.Header{Height: suite.app.LastBlockHeight() + 1, Time: now})
+
+	balances := sdk.NewCoins(sdk.NewInt64Coin("test", 1000))
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	addr3 := sdk.AccAddress([]byte("addr3_______________"))
+
+	acc1 := suite.app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	suite.app.AccountKeeper.SetAccount(ctx, acc1)
+	suite.Require().NoError(simapp.FundAccount(suite.app, ctx, addr1, balances))
+
+	periods := types.Periods{
+		{Length: 1000, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 50))},
+		{Length: 1000, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 50))},
+	}
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgCreatePeriodicVestingAccount
+		expectErr bool
+	}{
+		{
+			name:      "create periodic vesting account",
+			msg:       types.NewMsgCreatePeriodicVestingAccount(addr1, addr2, now.Unix(), periods),
+			expectErr: false,
+		},
+		{
+			name:      "periodic vesting account already exists",
+			msg:       types.NewMsgCreatePeriodicVestingAccount(addr1, addr2, now.Unix(), periods),
+			expectErr: true,
+		},
+		{
+			name: "insufficient funds",
+			msg: types.NewMsgCreatePeriodicVestingAccount(addr1, addr3, now.Unix(), types.Periods{
+				{Length: 1000, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 5000))},
+			}),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			res, err := suite.handler(ctx, tc.msg)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				toAddr, err := sdk.AccAddressFromBech32(tc.msg.ToAddress)
+				suite.Require().NoError(err)
+				accI := suite.app.AccountKeeper.GetAccount(ctx, toAddr)
+				suite.Require().NotNil(accI)
+
+				acc, ok := accI.(*types.PeriodicVestingAccount)
+				suite.Require().True(ok)
+				suite.Require().Equal(tc.msg.VestingPeriods, acc.VestingPeriods)
+				suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 100)), acc.GetVestingCoins(ctx.BlockTime()))
+			}
+		})
+	}
+}
+
+func (suite *HandlerTestSuite) TestMsgClawback() {
+	now := time.Now()
+	ctx := suite.app.BaseApp.NewContext(false, tmproto.Header{Height: suite.app.LastBlockHeight() + 1, Time: now})
+	bondDenom := suite.app.StakingKeeper.BondDenom(ctx)
+
+	balances := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))
+	funder := sdk.AccAddress([]byte("funder______________"))
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	valAddr := sdk.ValAddress([]byte("validator___________"))
+
+	suite.Require().NoError(simapp.FundAccount(suite.app, ctx, funder, balances))
+	suite.Require().NoError(simapp.FundAccount(suite.app, ctx, sdk.AccAddress(valAddr), balances))
+
+	sh := teststaking.NewHelper(suite.T(), ctx, suite.app.StakingKeeper)
+	sh.CreateValidator(valAddr, simapp.CreateTestPubKeys(1)[0], sdk.NewInt(100), true)
+
+	periods := types.Periods{
+		{Length: 500, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100))},
+		{Length: 500, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100))},
+	}
+
+	// a clawback vesting account delegating most of its coins
+	_, err := suite.handler(ctx, types.NewMsgCreateClawbackVestingAccount(funder, addr1, now.Unix(), periods))
+	suite.Require().NoError(err)
+	sh.Delegate(addr1, valAddr, sdk.NewInt(150))
+
+	// a vesting account which cannot be clawed back
+	_, err = suite.handler(ctx, types.NewMsgCreatePeriodicVestingAccount(funder, addr2, now.Unix(), periods))
+	suite.Require().NoError(err)
+
+	// half of the schedule has vested
+	ctx = ctx.WithBlockTime(now.Add(600 * time.Second))
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgClawback
+		expectErr bool
+	}{
+		{
+			name:      "not the funder",
+			msg:       types.NewMsgClawback(addr2, addr1),
+			expectErr: true,
+		},
+		{
+			name:      "not a clawback vesting account",
+			msg:       types.NewMsgClawback(funder, addr2),
+			expectErr: true,
+		},
+		{
+			name:      "account does not exist",
+			msg:       types.NewMsgClawback(funder, sdk.AccAddress([]byte("addr3_______________"))),
+			expectErr: true,
+		},
+		{
+			name:      "clawback unvested coins",
+			msg:       types.NewMsgClawback(funder, addr1),
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			res, err := suite.handler(ctx, tc.msg)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				accI := suite.app.AccountKeeper.GetAccount(ctx, addr1)
+				suite.Require().NotNil(accI)
+				acc, ok := accI.(*types.ClawbackVestingAccount)
+				suite.Require().True(ok)
+
+				// the account is left with the vested coins only, all of them delegated
+				vested := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100))
+				suite.Require().Equal(vested, acc.OriginalVesting)
+				suite.Require().True(acc.GetVestingCoins(ctx.BlockTime()).IsZero())
+				suite.Require().True(acc.DelegatedVesting.IsZero())
+				suite.Require().Equal(vested, acc.DelegatedFree)
+				suite.Require().True(suite.app.BankKeeper.GetAllBalances(ctx, addr1).IsZero())
+
+				delegation, found := suite.app.StakingKeeper.GetDelegation(ctx, addr1, valAddr)
+				suite.Require().True(found)
+				suite.Require().Equal(sdk.NewDec(100), delegation.Shares)
+
+				// the funder received the unbonded coins and the rest as a delegation
+				suite.Require().Equal(
+					sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 650)),
+					suite.app.BankKeeper.GetAllBalances(ctx, funder),
+				)
+				delegation, found = suite.app.StakingKeeper.GetDelegation(ctx, funder, valAddr)
+				suite.Require().True(found)
+				suite.Require().Equal(sdk.NewDec(50), delegation.Shares)
+			}
+		})
+	}
+}
+
+func (suite *HandlerTestSuite) TestMsgClawbackRedelegation() {
+	now := time.Now()
+	ctx := suite.app.BaseApp.NewContext(false, tmproto.Header{Height: suite.app.LastBlockHeight() + 1, Time: now})
+	bondDenom := suite.app.StakingKeeper.BondDenom(ctx)
+
+	balances := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))
+	funder := sdk.AccAddress([]byte("funder______________"))
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	valAddr1 := sdk.ValAddress([]byte("validator1__________"))
+	valAddr2 := sdk.ValAddress([]byte("validator2__________"))
+	pks := simapp.CreateTestPubKeys(2)
+
+	suite.Require().NoError(simapp.FundAccount(suite.app, ctx, funder, balances))
+	// the validators need voting power for the redelegation not to complete at once
+	valBalances := sdk.NewCoins(sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(10)))
+	suite.Require().NoError(simapp.FundAccount(suite.app, ctx, sdk.AccAddress(valAddr1), valBalances))
+	suite.Require().NoError(simapp.FundAccount(suite.app, ctx, sdk.AccAddress(valAddr2), valBalances))
+
+	sh := teststaking.NewHelper(suite.T(), ctx, suite.app.StakingKeeper)
+	sh.CreateValidatorWithValPower(valAddr1, pks[0], 10, true)
+	sh.CreateValidatorWithValPower(valAddr2, pks[1], 10, true)
+	ctx = sh.TurnBlock(now)
+
+	periods := types.Periods{
+		{Length: 500, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100))},
+		{Length: 500, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100))},
+	}
+	_, err := suite.handler(ctx, types.NewMsgCreateClawbackVestingAccount(funder, addr1, now.Unix(), periods))
+	suite.Require().NoError(err)
+	sh.Delegate(addr1, valAddr1, sdk.NewInt(150))
+
+	// half of the schedule has vested and the whole delegation is being redelegated
+	ctx = ctx.WithBlockTime(now.Add(600 * time.Second))
+	_, err = suite.app.StakingKeeper.BeginRedelegation(ctx, addr1, valAddr1, valAddr2, sdk.NewDec(150))
+	suite.Require().NoError(err)
+
+	_, err = suite.handler(ctx, types.NewMsgClawback(funder, addr1))
+	suite.Require().NoError(err)
+
+	acc, ok := suite.app.AccountKeeper.GetAccount(ctx, addr1).(*types.ClawbackVestingAccount)
+	suite.Require().True(ok)
+
+	// only the unbonded coins were clawed back, the redelegated ones keep vesting
+	suite.Require().Equal(
+		sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 850)),
+		suite.app.BankKeeper.GetAllBalances(ctx, funder),
+	)
+	suite.Require().NoError(acc.Validate())
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 150)), acc.OriginalVesting)
+	suite.Require().Equal(now.Unix()+1000, acc.EndTime)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 50)), acc.GetVestingCoins(ctx.BlockTime()))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 50)), acc.DelegatedVesting)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100)), acc.DelegatedFree)
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(ctx, addr1).IsZero())
+
+	// once the schedule ends the remaining coins are vested
+	suite.Require().True(acc.GetVestingCoins(now.Add(1000 * time.Second)).IsZero())
+}
+
 func TestHandlerTestSuite(t *testing.T) {
 	suite.Run(t, new(HandlerTestSuite))
 }
@@ -17,12 +17,13 @@ import (
 type msgServer struct {
 	keeper.AccountKeeper
 	types.BankKeeper
+	types.StakingKeeper
 }
 
 // NewMsgServerImpl returns an implementation of the vesting MsgServer interface,
-// wrapping the corresponding AccountKeeper and BankKeeper.
-func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper) types.MsgServer {
-	return &msgServer{AccountKeeper: k, BankKeeper: bk}
+// wrapping the corresponding AccountKeeper, BankKeeper and StakingKeeper.
+func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) types.MsgServer {
+	return &msgServer{AccountKeeper: k, BankKeeper: bk, StakingKeeper: sk}
 }
 
 var _ types.MsgServer = msgServer{}
@@ -98,3 +99,259 @@ func (s msgServer) CreateVestingAccount(goCtx context.Context, msg *types.MsgCre
 
 	return &types.MsgCreateVestingAccountResponse{}, nil
 }
+
+func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *types.MsgCreatePeriodicVestingAccount) (*types.MsgCreatePeriodicVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	from, to, baseAccount, totalCoins, err := s.prepareVestingAccount(ctx, msg.FromAddress, msg.ToAddress, msg.VestingPeriods)
+	if err != nil {
+		return nil, err
+	}
+
+	acc := types.NewPeriodicVestingAccount(baseAccount, totalCoins, msg.StartTime, msg.VestingPeriods)
+	if err := s.fundVestingAccount(ctx, acc, from, to, totalCoins, "create_periodic_vesting_account"); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreatePeriodicVestingAccountResponse{}, nil
+}
+
+func (s msgServer) CreateClawbackVestingAccount(goCtx context.Context, msg *types.MsgCreateClawbackVestingAccount) (*types.MsgCreateClawbackVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	from, to, baseAccount, totalCoins, err := s.prepareVestingAccount(ctx, msg.FromAddress, msg.ToAddress, msg.VestingPeriods)
+	if err != nil {
+		return nil, err
+	}
+
+	acc := types.NewClawbackVestingAccount(baseAccount, from, totalCoins, msg.StartTime, msg.VestingPeriods)
+	if err := s.fundVestingAccount(ctx, acc, from, to, totalCoins, "create_clawback_vesting_account"); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateClawbackVestingAccountResponse{}, nil
+}
+
+func (s msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+
+	funder, err := sdk.AccAddressFromBech32(msg.FunderAddress)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if bk.BlockedAddr(funder) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.FunderAddress)
+	}
+
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "account %s does not exist", msg.Address)
+	}
+
+	va, ok := acc.(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a clawback vesting account", msg.Address)
+	}
+
+	if va.FunderAddress != msg.FunderAddress {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "clawback can only be requested by the funder %s", va.FunderAddress)
+	}
+
+	clawedBack, err := s.clawback(ctx, va, funder)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClawback,
+			sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress),
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Address),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, clawedBack.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &types.MsgClawbackResponse{}, nil
+}
+
+// prepareVestingAccount performs the checks shared by the messages creating a
+// vesting account from a list of vesting periods and returns the base account
+// of the new vesting account along with the total amount to vest.
+func (s msgServer) prepareVestingAccount(
+	ctx sdk.Context, fromAddress, toAddress string, periods types.Periods,
+) (from, to sdk.AccAddress, baseAccount *authtypes.BaseAccount, totalCoins sdk.Coins, err error) {
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+
+	from, err = sdk.AccAddressFromBech32(fromAddress)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	to, err = sdk.AccAddressFromBech32(toAddress)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	for _, period := range periods {
+		totalCoins = totalCoins.Add(period.Amount...)
+	}
+
+	if err := bk.SendEnabledCoins(ctx, totalCoins...); err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	if bk.BlockedAddr(to) {
+		return nil, nil, nil, nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", toAddress)
+	}
+
+	if acc := ak.GetAccount(ctx, to); acc != nil {
+		return nil, nil, nil, nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", toAddress)
+	}
+
+	acc := ak.NewAccountWithAddress(ctx, to)
+	baseAccount, ok := acc.(*authtypes.BaseAccount)
+	if !ok {
+		return nil, nil, nil, nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid account type; expected: BaseAccount, got: %T", acc)
+	}
+
+	return from, to, baseAccount, totalCoins, nil
+}
+
+// fundVestingAccount stores the new vesting account and transfers the coins to
+// vest into it.
+func (s msgServer) fundVestingAccount(
+	ctx sdk.Context, acc authtypes.AccountI, from, to sdk.AccAddress, amount sdk.Coins, metric string,
+) error {
+	s.AccountKeeper.SetAccount(ctx, acc)
+
+	defer func() {
+		telemetry.IncrCounter(1, "new", "account")
+
+		for _, a := range amount {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", metric},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	if err := s.BankKeeper.SendCoins(ctx, from, to, amount); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return nil
+}
+
+// clawback removes the unvested coins of the account and sends them to dest.
+// The coins are taken from the unbonded balance of the account first, then
+// from its unbonding delegations and finally from its bonded delegations, which
+// change hands without being unbonded. Coins which cannot be transferred, e.g.
+// because they are part of an ongoing redelegation, are put back into the
+// vesting schedule. It returns the coins clawed back.
+func (s msgServer) clawback(ctx sdk.Context, va *types.ClawbackVestingAccount, dest sdk.AccAddress) (sdk.Coins, error) {
+	addr := va.GetAddress()
+	bondDenom := s.StakingKeeper.BondDenom(ctx)
+
+	periods := va.GetVestingPeriods()
+	toClawBack := va.ComputeClawback(ctx.BlockTime().Unix())
+	if toClawBack.IsZero() {
+		return toClawBack, nil
+	}
+	unvestedPeriods := periods[len(va.GetVestingPeriods()):]
+	delegatedVesting, delegatedFree := va.DelegatedVesting, va.DelegatedFree
+
+	bonded := sdk.NewCoins(sdk.NewCoin(bondDenom, s.StakingKeeper.GetDelegatorBonded(ctx, addr)))
+	unbonding := sdk.NewCoins(sdk.NewCoin(bondDenom, s.StakingKeeper.GetDelegatorUnbonding(ctx, addr)))
+	unbonded := s.BankKeeper.GetAllBalances(ctx, addr)
+
+	toClawBack = va.UpdateDelegation(va.GetVestingCoins(ctx.BlockTime()), toClawBack, bonded, unbonding, unbonded)
+	s.AccountKeeper.SetAccount(ctx, va)
+
+	// Only the bond denom can be delegated, so every other denom is covered by
+	// the unbonded balance.
+	toXfer := toClawBack
+	want := sdk.ZeroInt()
+	if amt := unbonded.AmountOf(bondDenom); toClawBack.AmountOf(bondDenom).GT(amt) {
+		want = toClawBack.AmountOf(bondDenom).Sub(amt)
+		toXfer = toClawBack.Sub(sdk.NewCoins(sdk.NewCoin(bondDenom, want)))
+	}
+
+	if !toXfer.IsZero() {
+		if err := s.BankKeeper.SendCoins(ctx, addr, dest, toXfer); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, ubd := range s.StakingKeeper.GetAllUnbondingDelegations(ctx, addr) {
+		if !want.IsPositive() {
+			break
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(ubd.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		transferred := s.StakingKeeper.TransferUnbonding(ctx, addr, dest, valAddr, want)
+		want = want.Sub(transferred)
+	}
+
+	for _, delegation := range s.StakingKeeper.GetAllDelegatorDelegations(ctx, addr) {
+		if !want.IsPositive() {
+			break
+		}
+
+		validator, found := s.StakingKeeper.GetValidator(ctx, delegation.GetValidatorAddr())
+		if !found {
+			continue
+		}
+
+		wantShares, err := validator.SharesFromTokensTruncated(want)
+		if err != nil {
+			// the validator has no tokens left
+			continue
+		}
+
+		transferredShares := s.StakingKeeper.TransferDelegation(ctx, addr, dest, delegation.GetValidatorAddr(), wantShares)
+
+		// round up so that we never claw back more than the unvested amount
+		transferred := validator.TokensFromSharesRoundUp(transferredShares).RoundInt()
+		want = want.Sub(transferred)
+	}
+
+	// Tokens which could not be transferred stay with the account but keep
+	// vesting, so the delegation tracking is recomputed for the coins actually
+	// clawed back.
+	if want.IsPositive() {
+		remaining := sdk.NewCoins(sdk.NewCoin(bondDenom, want))
+		toClawBack = toClawBack.Sub(remaining)
+
+		va.RestoreVesting(unvestedPeriods, remaining)
+		va.DelegatedVesting, va.DelegatedFree = delegatedVesting, delegatedFree
+		va.UpdateDelegation(va.GetVestingCoins(ctx.BlockTime()), toClawBack, bonded, unbonding, unbonded)
+		s.AccountKeeper.SetAccount(ctx, va)
+	}
+
+	return toClawBack, nil
+}
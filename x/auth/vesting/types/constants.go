@@ -9,4 +9,15 @@ const (
 
 	// RouterKey defines the module's message routing key
 	RouterKey = ModuleName
+
+	// EventTypeClawback defines the event type emitted on a clawback.
+	EventTypeClawback = "clawback"
+
+	// AttributeKeyFunder defines the event attribute holding the funder of
+	// the account.
+	AttributeKeyFunder = "funder"
+
+	// AttributeKeyAccount defines the event attribute holding the address of
+	// the account the coins are clawed back from.
+	AttributeKeyAccount = "account"
 )
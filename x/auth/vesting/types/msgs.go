@@ -5,10 +5,26 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// TypeMsgCreateVestingAccount defines the type value for a MsgCreateVestingAccount.
-const TypeMsgCreateVestingAccount = "msg_create_vesting_account"
+const (
+	// TypeMsgCreateVestingAccount defines the type value for a MsgCreateVestingAccount.
+	TypeMsgCreateVestingAccount = "msg_create_vesting_account"
 
-var _ sdk.Msg = &MsgCreateVestingAccount{}
+	// TypeMsgCreatePeriodicVestingAccount defines the type value for a MsgCreatePeriodicVestingAccount.
+	TypeMsgCreatePeriodicVestingAccount = "msg_create_periodic_vesting_account"
+
+	// TypeMsgCreateClawbackVestingAccount defines the type value for a MsgCreateClawbackVestingAccount.
+	TypeMsgCreateClawbackVestingAccount = "msg_create_clawback_vesting_account"
+
+	// TypeMsgClawback defines the type value for a MsgClawback.
+	TypeMsgClawback = "msg_clawback"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateVestingAccount{}
+	_ sdk.Msg = &MsgCreatePeriodicVestingAccount{}
+	_ sdk.Msg = &MsgCreateClawbackVestingAccount{}
+	_ sdk.Msg = &MsgClawback{}
+)
 
 // NewMsgCreateVestingAccount returns a reference to a new MsgCreateVestingAccount.
 //nolint:interfacer
@@ -75,3 +91,168 @@ func (msg MsgCreateVestingAccount) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{from}
 }
+
+// NewMsgCreatePeriodicVestingAccount returns a reference to a new MsgCreatePeriodicVestingAccount.
+//nolint:interfacer
+func NewMsgCreatePeriodicVestingAccount(fromAddr, toAddr sdk.AccAddress, startTime int64, periods Periods) *MsgCreatePeriodicVestingAccount {
+	return &MsgCreatePeriodicVestingAccount{
+		FromAddress:    fromAddr.String(),
+		ToAddress:      toAddr.String(),
+		StartTime:      startTime,
+		VestingPeriods: periods,
+	}
+}
+
+// Route returns the message route for a MsgCreatePeriodicVestingAccount.
+func (msg MsgCreatePeriodicVestingAccount) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgCreatePeriodicVestingAccount.
+func (msg MsgCreatePeriodicVestingAccount) Type() string { return TypeMsgCreatePeriodicVestingAccount }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreatePeriodicVestingAccount) ValidateBasic() error {
+	return validateVestingSchedule(msg.FromAddress, msg.ToAddress, msg.StartTime, msg.VestingPeriods)
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgCreatePeriodicVestingAccount.
+func (msg MsgCreatePeriodicVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgCreatePeriodicVestingAccount.
+func (msg MsgCreatePeriodicVestingAccount) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgCreateClawbackVestingAccount returns a reference to a new MsgCreateClawbackVestingAccount.
+//nolint:interfacer
+func NewMsgCreateClawbackVestingAccount(fromAddr, toAddr sdk.AccAddress, startTime int64, periods Periods) *MsgCreateClawbackVestingAccount {
+	return &MsgCreateClawbackVestingAccount{
+		FromAddress:    fromAddr.String(),
+		ToAddress:      toAddr.String(),
+		StartTime:      startTime,
+		VestingPeriods: periods,
+	}
+}
+
+// Route returns the message route for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) Type() string { return TypeMsgCreateClawbackVestingAccount }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateClawbackVestingAccount) ValidateBasic() error {
+	return validateVestingSchedule(msg.FromAddress, msg.ToAddress, msg.StartTime, msg.VestingPeriods)
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgClawback returns a reference to a new MsgClawback.
+//nolint:interfacer
+func NewMsgClawback(funder, addr sdk.AccAddress) *MsgClawback {
+	return &MsgClawback{
+		FunderAddress: funder.String(),
+		Address:       addr.String(),
+	}
+}
+
+// Route returns the message route for a MsgClawback.
+func (msg MsgClawback) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgClawback.
+func (msg MsgClawback) Type() string { return TypeMsgClawback }
+
+// ValidateBasic Implements Msg.
+func (msg MsgClawback) ValidateBasic() error {
+	funder, err := sdk.AccAddressFromBech32(msg.FunderAddress)
+	if err != nil {
+		return err
+	}
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return err
+	}
+	if err := sdk.VerifyAddressFormat(funder); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid funder address: %s", err)
+	}
+
+	if err := sdk.VerifyAddressFormat(addr); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid account address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgClawback.
+func (msg MsgClawback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgClawback.
+func (msg MsgClawback) GetSigners() []sdk.AccAddress {
+	funder, err := sdk.AccAddressFromBech32(msg.FunderAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{funder}
+}
+
+// validateVestingSchedule performs the stateless checks shared by the messages
+// creating a vesting account from a list of vesting periods.
+func validateVestingSchedule(fromAddress, toAddress string, startTime int64, periods Periods) error {
+	from, err := sdk.AccAddressFromBech32(fromAddress)
+	if err != nil {
+		return err
+	}
+	to, err := sdk.AccAddressFromBech32(toAddress)
+	if err != nil {
+		return err
+	}
+	if err := sdk.VerifyAddressFormat(from); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+
+	if err := sdk.VerifyAddressFormat(to); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address: %s", err)
+	}
+
+	if startTime < 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid start time")
+	}
+
+	if len(periods) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "vesting periods cannot be empty")
+	}
+
+	for i, period := range periods {
+		if period.Length < 1 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid period length of %d in period %d, length must be greater than 0", period.Length, i)
+		}
+
+		if !period.Amount.IsValid() || !period.Amount.IsAllPositive() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount %s in period %d", period.Amount, i)
+		}
+	}
+
+	return nil
+}
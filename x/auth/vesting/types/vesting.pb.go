@@ -238,12 +238,55 @@ func (m *PeriodicVestingAccount) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PeriodicVestingAccount proto.InternalMessageInfo
 
+// ClawbackVestingAccount implements the VestingAccount interface. It vests
+// periodically like a PeriodicVestingAccount, but the funder of the account
+// can claw back the coins which have not vested yet.
+type ClawbackVestingAccount struct {
+	*BaseVestingAccount `protobuf:"bytes,1,opt,name=base_vesting_account,json=baseVestingAccount,proto3,embedded=base_vesting_account" json:"base_vesting_account,omitempty"`
+	FunderAddress       string   `protobuf:"bytes,2,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty" yaml:"funder_address"`
+	StartTime           int64    `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty" yaml:"start_time"`
+	VestingPeriods      []Period `protobuf:"bytes,4,rep,name=vesting_periods,json=vestingPeriods,proto3" json:"vesting_periods" yaml:"vesting_periods"`
+}
+
+func (m *ClawbackVestingAccount) Reset()      { *m = ClawbackVestingAccount{} }
+func (*ClawbackVestingAccount) ProtoMessage() {}
+func (*ClawbackVestingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89e80273ca606d6e, []int{5}
+}
+func (m *ClawbackVestingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClawbackVestingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClawbackVestingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClawbackVestingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClawbackVestingAccount.Merge(m, src)
+}
+func (m *ClawbackVestingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClawbackVestingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClawbackVestingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClawbackVestingAccount proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*BaseVestingAccount)(nil), "cosmos.vesting.v1beta1.BaseVestingAccount")
 	proto.RegisterType((*ContinuousVestingAccount)(nil), "cosmos.vesting.v1beta1.ContinuousVestingAccount")
 	proto.RegisterType((*DelayedVestingAccount)(nil), "cosmos.vesting.v1beta1.DelayedVestingAccount")
 	proto.RegisterType((*Period)(nil), "cosmos.vesting.v1beta1.Period")
 	proto.RegisterType((*PeriodicVestingAccount)(nil), "cosmos.vesting.v1beta1.PeriodicVestingAccount")
+	proto.RegisterType((*ClawbackVestingAccount)(nil), "cosmos.vesting.v1beta1.ClawbackVestingAccount")
 }
 
 func init() {
@@ -251,45 +294,47 @@ func init() {
 }
 
 var fileDescriptor_89e80273ca606d6e = []byte{
-	// 593 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x95, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x7d, 0x49, 0x08, 0xe5, 0x02, 0x4d, 0x6b, 0x9a, 0x60, 0x3a, 0xd8, 0x91, 0xc5, 0x10,
-	0x21, 0xe1, 0x90, 0xc2, 0x94, 0x0d, 0x17, 0x21, 0x55, 0x65, 0x40, 0x16, 0x62, 0x60, 0x89, 0xfc,
-	0xe3, 0x70, 0x4e, 0xc4, 0xbe, 0xc8, 0x77, 0xa9, 0xc8, 0x1f, 0x80, 0x84, 0xd4, 0x05, 0x24, 0x06,
-	0xc6, 0x2e, 0x2c, 0xfc, 0x11, 0xcc, 0x1d, 0x23, 0x26, 0xa6, 0x80, 0x92, 0xff, 0x20, 0x7f, 0x01,
-	0xf2, 0xdd, 0xd9, 0x01, 0x17, 0x88, 0xca, 0x80, 0xd4, 0x29, 0x79, 0xf7, 0xde, 0xfb, 0xde, 0xe7,
-	0xbd, 0x7b, 0x77, 0x86, 0xb7, 0x7c, 0x42, 0x23, 0x42, 0x3b, 0x47, 0x88, 0x32, 0x1c, 0x87, 0x9d,
-	0xa3, 0xae, 0x87, 0x98, 0xdb, 0xcd, 0x6c, 0x6b, 0x94, 0x10, 0x46, 0xd4, 0xa6, 0x88, 0xb2, 0xb2,
-	0x55, 0x19, 0xb5, 0xbb, 0x13, 0x92, 0x90, 0xf0, 0x90, 0x4e, 0xfa, 0x4f, 0x44, 0xef, 0xea, 0x52,
-	0xd3, 0x73, 0x29, 0xca, 0x05, 0x7d, 0x82, 0xe3, 0x82, 0xdf, 0x1d, 0xb3, 0x41, 0xee, 0x4f, 0x0d,
-	0xe1, 0x37, 0xbf, 0x54, 0xa0, 0x6a, 0xbb, 0x14, 0x3d, 0x13, 0xbb, 0x3d, 0xf0, 0x7d, 0x32, 0x8e,
-	0x99, 0x7a, 0x00, 0xaf, 0xa6, 0x8a, 0x7d, 0x57, 0xd8, 0x1a, 0x68, 0x81, 0x76, 0x6d, 0xaf, 0x65,
-	0x49, 0x36, 0x2e, 0x20, 0xd5, 0xac, 0x34, 0x5d, 0xe6, 0xd9, 0x95, 0xe9, 0xcc, 0x00, 0x4e, 0xcd,
-	0x5b, 0x2d, 0xa9, 0xef, 0x00, 0xdc, 0x22, 0x09, 0x0e, 0x71, 0xec, 0x0e, 0xfb, 0xb2, 0x28, 0xad,
-	0xd4, 0x2a, 0xb7, 0x6b, 0x7b, 0x37, 0x33, 0xbd, 0x34, 0x3e, 0xd7, 0xdb, 0x27, 0x38, 0xb6, 0x0f,
-	0x4f, 0x67, 0x86, 0xb2, 0x9c, 0x19, 0x37, 0x26, 0x6e, 0x34, 0xec, 0x99, 0x45, 0x01, 0xf3, 0xd3,
-	0x37, 0xa3, 0x1d, 0x62, 0x36, 0x18, 0x7b, 0x96, 0x4f, 0xa2, 0x8e, 0xac, 0x52, 0xfc, 0xdc, 0xa1,
-	0xc1, 0xcb, 0x0e, 0x9b, 0x8c, 0x10, 0xe5, 0x5a, 0xd4, 0xa9, 0x67, 0xe9, 0xb2, 0x4a, 0xf5, 0x18,
-	0xc0, 0xcd, 0x00, 0x0d, 0x51, 0xe8, 0x32, 0x14, 0xf4, 0x5f, 0x24, 0x08, 0x69, 0xe5, 0x75, 0x44,
-	0x07, 0x92, 0xa8, 0x21, 0x88, 0x7e, 0x4d, 0x3f, 0x1f, 0xcf, 0xb5, 0x3c, 0xf9, 0x51, 0x82, 0x90,
-	0xfa, 0x1e, 0xc0, 0xed, 0x95, 0x5c, 0xd6, 0xa2, 0xca, 0x3a, 0xa0, 0xc7, 0x12, 0x48, 0x2b, 0x02,
-	0xfd, 0x53, 0x8f, 0xb6, 0xf2, 0xfc, 0xac, 0x49, 0x16, 0xdc, 0x40, 0x71, 0xd0, 0x67, 0x38, 0x42,
-	0xda, 0xa5, 0x16, 0x68, 0x97, 0xed, 0xeb, 0xcb, 0x99, 0x51, 0x17, 0xbb, 0x65, 0x1e, 0xd3, 0xb9,
-	0x8c, 0xe2, 0xe0, 0x29, 0x8e, 0x50, 0x6f, 0xe3, 0xcd, 0x89, 0xa1, 0x7c, 0x38, 0x31, 0x14, 0xf3,
-	0x33, 0x80, 0xda, 0x3e, 0x89, 0x19, 0x8e, 0xc7, 0x64, 0x4c, 0x0b, 0xa3, 0xe5, 0xc1, 0x1d, 0x3e,
-	0x5a, 0x92, 0xb2, 0x30, 0x62, 0xb7, 0xad, 0xdf, 0x8f, 0xbf, 0x75, 0x76, 0x48, 0xe5, 0xb0, 0xa9,
-	0xde, 0xd9, 0xf1, 0xbd, 0x0f, 0x21, 0x65, 0x6e, 0xc2, 0x04, 0x7c, 0x89, 0xc3, 0x37, 0x96, 0x33,
-	0x63, 0x5b, 0xc0, 0xaf, 0x7c, 0xa6, 0x73, 0x85, 0x1b, 0x85, 0x02, 0x5e, 0x03, 0xd8, 0x78, 0x88,
-	0x86, 0xee, 0x24, 0xef, 0xc6, 0x7f, 0xa4, 0xff, 0x89, 0xe3, 0x18, 0xc0, 0xea, 0x13, 0x94, 0x60,
-	0x12, 0xa8, 0x4d, 0x58, 0x1d, 0xa2, 0x38, 0x64, 0x03, 0xbe, 0x55, 0xd9, 0x91, 0x96, 0xea, 0xc3,
-	0xaa, 0x1b, 0x71, 0x84, 0xb5, 0x77, 0xea, 0x6e, 0x3a, 0x30, 0xe7, 0x1a, 0x0a, 0x29, 0xdd, 0xab,
-	0x70, 0x9a, 0x8f, 0x25, 0xd8, 0x14, 0x34, 0xd8, 0xbf, 0x28, 0x87, 0xaa, 0x86, 0xb0, 0x9e, 0x41,
-	0x8d, 0x38, 0x3b, 0x95, 0x57, 0x5d, 0xff, 0x13, 0x94, 0x28, 0xd1, 0xd6, 0xe5, 0xf5, 0x6a, 0x0a,
-	0xf9, 0x82, 0x88, 0xe9, 0x6c, 0xca, 0x15, 0x11, 0x4e, 0x57, 0xa7, 0x66, 0x1f, 0x9e, 0xce, 0x75,
-	0x30, 0x9d, 0xeb, 0xe0, 0xfb, 0x5c, 0x07, 0x6f, 0x17, 0xba, 0x32, 0x5d, 0xe8, 0xca, 0xd7, 0x85,
-	0xae, 0x3c, 0xef, 0xfe, 0xb5, 0xf3, 0xaf, 0xe4, 0x2b, 0x2d, 0x3f, 0x0f, 0xfc, 0x20, 0xbc, 0x2a,
-	0x7f, 0xa7, 0xef, 0xfd, 0x08, 0x00, 0x00, 0xff, 0xff, 0x97, 0xc5, 0xe2, 0xb4, 0x3d, 0x06, 0x00,
-	0x00,
+	// 631 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd5, 0x55, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0x8e, 0x93, 0x10, 0xda, 0x2b, 0x4d, 0x5a, 0xd3, 0x04, 0xb7, 0x43, 0x12, 0x59, 0x1d, 0x2a,
+	0x24, 0x1c, 0x02, 0x4c, 0x99, 0xa8, 0x83, 0x90, 0x2a, 0x18, 0x90, 0x85, 0x18, 0x58, 0xa2, 0xb3,
+	0x7d, 0x75, 0xac, 0x3a, 0xbe, 0xc8, 0x77, 0x2e, 0xe4, 0x07, 0x20, 0x21, 0xb1, 0x80, 0xc4, 0xc0,
+	0xc8, 0xc2, 0xc2, 0x8f, 0x60, 0x66, 0x8c, 0x98, 0x98, 0x02, 0x82, 0x81, 0x9d, 0x5f, 0xc0, 0xf9,
+	0xee, 0xec, 0xb4, 0x2e, 0x10, 0xb5, 0x12, 0x20, 0x86, 0x27, 0xdf, 0xbb, 0xf7, 0xde, 0x77, 0xdf,
+	0xbd, 0xfb, 0xce, 0x07, 0xb6, 0x1d, 0x4c, 0x46, 0x98, 0x74, 0x0e, 0x11, 0xa1, 0x7e, 0xe8, 0x75,
+	0x0e, 0xbb, 0x36, 0xa2, 0xb0, 0x9b, 0xfa, 0xc6, 0x38, 0xc2, 0x14, 0xab, 0x0d, 0x91, 0x65, 0xa4,
+	0xb3, 0x32, 0x6b, 0x6b, 0xc3, 0xc3, 0x1e, 0xe6, 0x29, 0x9d, 0x64, 0x24, 0xb2, 0xb7, 0x9a, 0x12,
+	0xd3, 0x86, 0x04, 0x65, 0x80, 0x0e, 0xf6, 0xc3, 0x5c, 0x1c, 0xc6, 0x74, 0x98, 0xc5, 0x13, 0x47,
+	0xc4, 0xf5, 0x0f, 0x65, 0xa0, 0x9a, 0xac, 0xf6, 0x81, 0x58, 0x6d, 0xd7, 0x71, 0x70, 0x1c, 0x52,
+	0x75, 0x0f, 0x5c, 0x48, 0x10, 0x07, 0x50, 0xf8, 0x9a, 0xd2, 0x56, 0x76, 0x56, 0xae, 0xb5, 0x0d,
+	0xc9, 0x8d, 0x03, 0x48, 0x34, 0x23, 0x29, 0x97, 0x75, 0x66, 0x79, 0x3a, 0x6b, 0x29, 0xd6, 0x8a,
+	0x3d, 0x9f, 0x52, 0x5f, 0x28, 0x60, 0x0d, 0x47, 0xbe, 0xe7, 0x87, 0x30, 0x18, 0xc8, 0x4d, 0x69,
+	0xc5, 0x76, 0x89, 0xe1, 0x6d, 0xa6, 0x78, 0x49, 0x7e, 0x86, 0xd7, 0x67, 0xec, 0xcd, 0x3b, 0xef,
+	0x67, 0xad, 0xc2, 0xf7, 0x59, 0xeb, 0xd2, 0x04, 0x8e, 0x82, 0x9e, 0x9e, 0x07, 0xd0, 0xdf, 0x7e,
+	0x6a, 0xed, 0x78, 0x3e, 0x1d, 0xc6, 0x36, 0xc3, 0x18, 0x75, 0xe4, 0x2e, 0xc5, 0xe7, 0x0a, 0x71,
+	0x0f, 0x3a, 0x74, 0x32, 0x46, 0x84, 0x63, 0x11, 0xab, 0x96, 0x96, 0xcb, 0x5d, 0xaa, 0xcf, 0x14,
+	0x50, 0x75, 0x51, 0x80, 0x3c, 0x48, 0x91, 0x3b, 0xd8, 0x8f, 0x10, 0xd2, 0x4a, 0x8b, 0x18, 0xed,
+	0x49, 0x46, 0x75, 0xc1, 0xe8, 0x78, 0xf9, 0xe9, 0xf8, 0xac, 0x66, 0xc5, 0xb7, 0x59, 0xad, 0xfa,
+	0x52, 0x01, 0xeb, 0x73, 0xb8, 0xb4, 0x45, 0xe5, 0x45, 0x84, 0xee, 0x4a, 0x42, 0x5a, 0x9e, 0xd0,
+	0x99, 0x7a, 0xb4, 0x96, 0xd5, 0xa7, 0x4d, 0x32, 0xc0, 0x12, 0x0a, 0xdd, 0x01, 0xf5, 0x47, 0x48,
+	0x3b, 0xc7, 0xce, 0xbf, 0x64, 0x5e, 0x64, 0xab, 0xd5, 0xc4, 0x6a, 0x69, 0x44, 0xb7, 0xce, 0xb3,
+	0xe1, 0x7d, 0x36, 0xea, 0x2d, 0x3d, 0x7d, 0xdd, 0x2a, 0xbc, 0x62, 0xa6, 0xbf, 0x53, 0x80, 0xd6,
+	0xc7, 0x21, 0x43, 0x89, 0x71, 0x4c, 0x72, 0xd2, 0xb2, 0xc1, 0x06, 0x97, 0x96, 0x64, 0x99, 0x93,
+	0xd8, 0x65, 0xe3, 0xe7, 0xf2, 0x37, 0x4e, 0x8a, 0x54, 0x8a, 0x4d, 0xb5, 0x4f, 0xca, 0xf7, 0x06,
+	0x00, 0x84, 0xc2, 0x88, 0x0a, 0xf2, 0x45, 0x4e, 0xbe, 0xce, 0xc8, 0xaf, 0x0b, 0xf2, 0xf3, 0x98,
+	0x6e, 0x2d, 0x73, 0x27, 0xb7, 0x81, 0x27, 0x0a, 0xa8, 0xdf, 0x42, 0x01, 0x9c, 0x64, 0xdd, 0xf8,
+	0x8b, 0xec, 0x8f, 0xf0, 0x60, 0x3a, 0xad, 0xdc, 0x43, 0x91, 0x8f, 0x5d, 0xb5, 0x01, 0x2a, 0x01,
+	0x0a, 0x3d, 0x3a, 0xe4, 0x4b, 0x95, 0x2c, 0xe9, 0xa9, 0x0e, 0xa8, 0xc0, 0x11, 0xa7, 0xb0, 0xf0,
+	0x4e, 0x5d, 0x4d, 0x04, 0x73, 0x2a, 0x51, 0x48, 0xe8, 0x5e, 0x99, 0xb3, 0x79, 0x53, 0x04, 0x0d,
+	0xc1, 0xc6, 0x77, 0xfe, 0x97, 0x43, 0x55, 0x3d, 0x50, 0x4b, 0x49, 0x8d, 0x39, 0x77, 0x22, 0xaf,
+	0x7a, 0xf3, 0x57, 0xa4, 0xc4, 0x16, 0xcd, 0xa6, 0xbc, 0x5e, 0x0d, 0x01, 0x9f, 0x03, 0xd1, 0xad,
+	0xaa, 0x9c, 0x11, 0xe9, 0xe4, 0xc8, 0xa9, 0x7d, 0x63, 0x7d, 0xea, 0x07, 0xf0, 0x91, 0x0d, 0x9d,
+	0x83, 0x7f, 0xd0, 0xa7, 0x9b, 0xa0, 0xba, 0x1f, 0x87, 0x2e, 0x8a, 0x06, 0xd0, 0x75, 0x23, 0x44,
+	0x08, 0xef, 0xd5, 0xb2, 0xb9, 0x39, 0xff, 0x79, 0x1d, 0x8f, 0xeb, 0xd6, 0xaa, 0x98, 0xd8, 0x15,
+	0x7e, 0xae, 0xd3, 0xa5, 0xb3, 0x77, 0xba, 0xfc, 0x67, 0x3b, 0xcd, 0x5e, 0x89, 0x2f, 0x4d, 0x65,
+	0xca, 0xec, 0x33, 0xb3, 0xe7, 0x5f, 0x9b, 0x85, 0x29, 0xb3, 0x8f, 0xcc, 0x1e, 0x76, 0x7f, 0xab,
+	0xf1, 0xc7, 0xf2, 0x3d, 0x94, 0x0f, 0x31, 0x97, 0xbc, 0x5d, 0xe1, 0x2f, 0xe2, 0xf5, 0x1f, 0xdb,
+	0x99, 0xad, 0xf6, 0xa7, 0x07, 0x00, 0x00,
 }
 
 func (m *BaseVestingAccount) Marshal() (dAtA []byte, err error) {
@@ -545,6 +590,67 @@ func (m *PeriodicVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ClawbackVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClawbackVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClawbackVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestingPeriods) > 0 {
+		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BaseVestingAccount != nil {
+		{
+			size, err := m.BaseVestingAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVesting(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintVesting(dAtA []byte, offset int, v uint64) int {
 	offset -= sovVesting(v)
 	base := offset
@@ -659,6 +765,32 @@ func (m *PeriodicVestingAccount) Size() (n int) {
 	return n
 }
 
+func (m *ClawbackVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseVestingAccount != nil {
+		l = m.BaseVestingAccount.Size()
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovVesting(uint64(m.StartTime))
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovVesting(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1305,6 +1437,177 @@ func (m *PeriodicVestingAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClawbackVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClawbackVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseVestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseVestingAccount == nil {
+				m.BaseVestingAccount = &BaseVestingAccount{}
+			}
+			if err := m.BaseVestingAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipVesting(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	yaml "gopkg.in/yaml.v2"
@@ -17,6 +18,7 @@ var (
 	_ vestexported.VestingAccount = (*ContinuousVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*PeriodicVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*DelayedVestingAccount)(nil)
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
 )
 
 // Base Vesting Account
@@ -173,6 +175,7 @@ type vestingAccountYAML struct {
 	// custom fields based on concrete vesting type which can be omitted
 	StartTime      int64   `json:"start_time,omitempty" yaml:"start_time,omitempty"`
 	VestingPeriods Periods `json:"vesting_periods,omitempty" yaml:"vesting_periods,omitempty"`
+	FunderAddress  string  `json:"funder_address,omitempty" yaml:"funder_address,omitempty"`
 }
 
 func (bva BaseVestingAccount) String() string {
@@ -566,3 +569,246 @@ func (dva DelayedVestingAccount) String() string {
 	out, _ := dva.MarshalYAML()
 	return out.(string)
 }
+
+// Clawback Vesting Account
+
+var _ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
+var _ authtypes.GenesisAccount = (*ClawbackVestingAccount)(nil)
+
+// NewClawbackVestingAccount returns a new ClawbackVestingAccount
+func NewClawbackVestingAccount(baseAcc *authtypes.BaseAccount, funder sdk.AccAddress, originalVesting sdk.Coins, startTime int64, periods Periods) *ClawbackVestingAccount {
+	endTime := startTime
+	for _, p := range periods {
+		endTime += p.Length
+	}
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: originalVesting,
+		EndTime:         endTime,
+	}
+
+	return &ClawbackVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		FunderAddress:      funder.String(),
+		StartTime:          startTime,
+		VestingPeriods:     periods,
+	}
+}
+
+// GetVestedCoins returns the total number of vested coins. If no coins are vested,
+// nil is returned.
+func (va ClawbackVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	var vestedCoins sdk.Coins
+
+	// We must handle the case where the start time for a vesting account has
+	// been set into the future or when the start of the chain is not exactly
+	// known.
+	if blockTime.Unix() <= va.StartTime {
+		return vestedCoins
+	} else if blockTime.Unix() >= va.EndTime {
+		return va.OriginalVesting
+	}
+
+	// track the start time of the next period
+	currentPeriodStartTime := va.StartTime
+
+	// for each period, if the period is over, add those coins as vested and check the next period.
+	for _, period := range va.VestingPeriods {
+		x := blockTime.Unix() - currentPeriodStartTime
+		if x < period.Length {
+			break
+		}
+
+		vestedCoins = vestedCoins.Add(period.Amount...)
+
+		// update the start time of the next period
+		currentPeriodStartTime += period.Length
+	}
+
+	return vestedCoins
+}
+
+// GetVestingCoins returns the total number of vesting coins. If no coins are
+// vesting, nil is returned.
+func (va ClawbackVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return va.OriginalVesting.Sub(va.GetVestedCoins(blockTime))
+}
+
+// LockedCoins returns the set of coins that are not spendable (i.e. locked).
+func (va ClawbackVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return va.BaseVestingAccount.LockedCoinsFromVesting(va.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (va *ClawbackVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	va.BaseVestingAccount.TrackDelegation(balance, va.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting starts for a clawback vesting
+// account.
+func (va ClawbackVestingAccount) GetStartTime() int64 {
+	return va.StartTime
+}
+
+// GetVestingPeriods returns vesting periods associated with clawback vesting account.
+func (va ClawbackVestingAccount) GetVestingPeriods() Periods {
+	return va.VestingPeriods
+}
+
+// ComputeClawback truncates the vesting schedule of the account to the periods
+// which have fully vested at clawbackTime and returns the coins of the removed
+// periods, i.e. the coins which are still vesting. The delegation tracking of
+// the account is left untouched, see UpdateDelegation.
+func (va *ClawbackVestingAccount) ComputeClawback(clawbackTime int64) sdk.Coins {
+	vested := sdk.NewCoins()
+	unvested := sdk.NewCoins()
+
+	endTime := va.StartTime
+	periodEnd := va.StartTime
+	vestedPeriods := 0
+
+	for _, period := range va.VestingPeriods {
+		periodEnd += period.Length
+		if clawbackTime <= va.StartTime || periodEnd > clawbackTime {
+			unvested = unvested.Add(period.Amount...)
+			continue
+		}
+
+		vested = vested.Add(period.Amount...)
+		endTime = periodEnd
+		vestedPeriods++
+	}
+
+	va.OriginalVesting = vested
+	va.EndTime = endTime
+	va.VestingPeriods = va.VestingPeriods[:vestedPeriods]
+
+	return unvested
+}
+
+// RestoreVesting puts coins which were removed from the vesting schedule by
+// ComputeClawback, but which could not be clawed back, back into it. The coins
+// are spread over the removed unvestedPeriods starting from the last one, so
+// that they vest no earlier than they would have without the clawback.
+func (va *ClawbackVestingAccount) RestoreVesting(unvestedPeriods Periods, coins sdk.Coins) {
+	restored := make(Periods, len(unvestedPeriods))
+	for i := len(unvestedPeriods) - 1; i >= 0; i-- {
+		amount := coinsMin(unvestedPeriods[i].Amount, coins)
+		coins = coins.Sub(amount)
+		restored[i] = Period{Length: unvestedPeriods[i].Length, Amount: amount}
+	}
+
+	periods := make(Periods, 0, len(va.VestingPeriods)+len(restored))
+	periods = append(periods, va.VestingPeriods...)
+	for _, p := range restored {
+		va.OriginalVesting = va.OriginalVesting.Add(p.Amount...)
+		va.EndTime += p.Length
+		periods = append(periods, p)
+	}
+	va.VestingPeriods = periods
+}
+
+// UpdateDelegation recomputes the delegated vesting and delegated free coins of
+// the account once toClawBack coins are about to be removed from it, given the
+// coins which are still encumbered by the vesting schedule and the coins the
+// account currently has bonded, unbonding and unbonded. Unbonded coins are
+// expected to be clawed back first. It returns the coins which can actually be
+// clawed back, which is less than toClawBack if the account has been slashed.
+func (va *ClawbackVestingAccount) UpdateDelegation(encumbered, toClawBack, bonded, unbonding, unbonded sdk.Coins) sdk.Coins {
+	delegated := bonded.Add(unbonding...)
+	oldDelegated := va.DelegatedVesting.Add(va.DelegatedFree...)
+
+	// slashed coins are never undelegated, so they keep being tracked as delegated
+	slashed := oldDelegated.Sub(coinsMin(delegated, oldDelegated))
+	total := delegated.Add(unbonded...)
+	toClawBack = coinsMin(toClawBack, total)
+
+	newDelegated := coinsMin(delegated, total.Sub(toClawBack)).Add(slashed...)
+	va.DelegatedVesting = coinsMin(encumbered, newDelegated)
+	va.DelegatedFree = newDelegated.Sub(va.DelegatedVesting)
+
+	return toClawBack
+}
+
+// Validate checks for errors on the account fields
+func (va ClawbackVestingAccount) Validate() error {
+	if va.GetStartTime() > va.GetEndTime() {
+		return errors.New("vesting start-time cannot be after end-time")
+	}
+	if _, err := sdk.AccAddressFromBech32(va.FunderAddress); err != nil {
+		return fmt.Errorf("invalid funder address: %w", err)
+	}
+	endTime := va.StartTime
+	originalVesting := sdk.NewCoins()
+	for _, p := range va.VestingPeriods {
+		endTime += p.Length
+		originalVesting = originalVesting.Add(p.Amount...)
+	}
+	if endTime != va.EndTime {
+		return errors.New("vesting end time does not match length of all vesting periods")
+	}
+	if !originalVesting.IsEqual(va.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in vesting periods")
+	}
+
+	return va.BaseVestingAccount.Validate()
+}
+
+func (va ClawbackVestingAccount) String() string {
+	out, _ := va.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a ClawbackVestingAccount.
+func (va ClawbackVestingAccount) MarshalYAML() (interface{}, error) {
+	accAddr, err := sdk.AccAddressFromBech32(va.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	alias := vestingAccountYAML{
+		Address:          accAddr,
+		AccountNumber:    va.AccountNumber,
+		Sequence:         va.Sequence,
+		OriginalVesting:  va.OriginalVesting,
+		DelegatedFree:    va.DelegatedFree,
+		DelegatedVesting: va.DelegatedVesting,
+		EndTime:          va.EndTime,
+		StartTime:        va.StartTime,
+		VestingPeriods:   va.VestingPeriods,
+		FunderAddress:    va.FunderAddress,
+	}
+
+	pk := va.GetPubKey()
+	if pk != nil {
+		pks, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, pk)
+		if err != nil {
+			return nil, err
+		}
+
+		alias.PubKey = pks
+	}
+
+	bz, err := yaml.Marshal(alias)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(bz), err
+}
+
+// coinsMin returns the minimum of a and b for every denomination in either of
+// them.
+func coinsMin(a, b sdk.Coins) sdk.Coins {
+	coins := sdk.NewCoins()
+	for _, coin := range a {
+		amt := sdk.MinInt(coin.Amount, b.AmountOf(coin.Denom))
+		if amt.IsPositive() {
+			coins = coins.Add(sdk.NewCoin(coin.Denom, amt))
+		}
+	}
+
+	return coins
+}
@@ -578,6 +578,115 @@ func TestTrackUndelegationPeriodicVestingAcc(t *testing.T) {
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, pva.DelegatedVesting)
 }
 
+func TestGetVestedCoinsClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	endTime := now.Add(24 * time.Hour)
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	_, _, funder := testdata.KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), periods)
+
+	// require no coins vested at the beginning of the vesting schedule
+	vestedCoins := va.GetVestedCoins(now)
+	require.Nil(t, vestedCoins)
+
+	// require all coins vested at the end of the vesting schedule
+	vestedCoins = va.GetVestedCoins(endTime)
+	require.Equal(t, origCoins, vestedCoins)
+
+	// require 50% of coins vested after period 1
+	vestedCoins = va.GetVestedCoins(now.Add(12 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, vestedCoins)
+
+	// require period 2 coins don't vest until period is over
+	vestedCoins = va.GetVestedCoins(now.Add(15 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, vestedCoins)
+
+	// require the locked coins to follow the vesting schedule
+	lockedCoins := va.LockedCoins(now.Add(18 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}, lockedCoins)
+}
+
+func TestComputeClawbackClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	_, _, funder := testdata.KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+
+	// clawing back before the start claws back everything
+	va := types.NewClawbackVestingAccount(authtypes.NewBaseAccountWithAddress(addr), funder, origCoins, now.Unix(), periods)
+	unvested := va.ComputeClawback(now.Unix())
+	require.Equal(t, origCoins, unvested)
+	require.True(t, va.OriginalVesting.IsZero())
+	require.Empty(t, va.VestingPeriods)
+	require.Equal(t, now.Unix(), va.EndTime)
+	require.NoError(t, va.Validate())
+
+	// clawing back during period 2 keeps period 1 only
+	va = types.NewClawbackVestingAccount(authtypes.NewBaseAccountWithAddress(addr), funder, origCoins, now.Unix(), periods)
+	unvested = va.ComputeClawback(now.Add(15 * time.Hour).Unix())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, unvested)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, va.OriginalVesting)
+	require.Equal(t, []types.Period(periods[:1]), va.VestingPeriods)
+	require.Equal(t, now.Add(12*time.Hour).Unix(), va.EndTime)
+	require.True(t, va.GetVestingCoins(now.Add(15*time.Hour)).IsZero())
+	require.NoError(t, va.Validate())
+
+	// clawing back once fully vested claws back nothing
+	va = types.NewClawbackVestingAccount(authtypes.NewBaseAccountWithAddress(addr), funder, origCoins, now.Unix(), periods)
+	unvested = va.ComputeClawback(now.Add(48 * time.Hour).Unix())
+	require.True(t, unvested.IsZero())
+	require.Equal(t, origCoins, va.OriginalVesting)
+	require.Equal(t, []types.Period(periods), va.VestingPeriods)
+}
+
+func TestUpdateDelegationClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	_, _, funder := testdata.KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+
+	// delegate everything before anything has vested
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), periods)
+	va.TrackDelegation(now, origCoins, origCoins)
+	require.Equal(t, origCoins, va.DelegatedVesting)
+
+	// claw back the second period, half of the delegation stays with the account
+	unvested := va.ComputeClawback(now.Add(12 * time.Hour).Unix())
+	toClawBack := va.UpdateDelegation(va.GetVestingCoins(now.Add(12*time.Hour)), unvested, origCoins, sdk.NewCoins(), sdk.NewCoins())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, toClawBack)
+	require.True(t, va.DelegatedVesting.IsZero())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, va.DelegatedFree)
+
+	// a slashed delegation limits the amount clawed back
+	va = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), periods)
+	va.TrackDelegation(now, origCoins, origCoins)
+	unvested = va.ComputeClawback(now.Unix())
+	toClawBack = va.UpdateDelegation(sdk.NewCoins(), unvested, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 80)}, sdk.NewCoins(), sdk.NewCoins())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 80)}, toClawBack)
+	require.True(t, va.DelegatedVesting.IsZero())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 20)}, va.DelegatedFree)
+}
+
 func TestGenesisAccountValidate(t *testing.T) {
 	pubkey := secp256k1.GenPrivKey().PubKey()
 	addr := sdk.AccAddress(pubkey.Address())
@@ -633,6 +742,19 @@ func TestGenesisAccountValidate(t *testing.T) {
 				0, types.Periods{types.Period{Length: int64(100), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 25)}}}),
 			true,
 		},
+		{
+			"valid clawback vesting account",
+			types.NewClawbackVestingAccount(baseAcc, addr, initialVesting, 0, types.Periods{types.Period{Length: int64(100), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)}}}),
+			false,
+		},
+		{
+			"invalid clawback vesting funder",
+			&types.ClawbackVestingAccount{
+				BaseVestingAccount: baseVestingWithCoins,
+				VestingPeriods:     types.Periods{types.Period{Length: int64(100), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)}}},
+			},
+			true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -707,3 +829,24 @@ func TestDelayedVestingAccountMarshal(t *testing.T) {
 	_, err = app.AccountKeeper.UnmarshalAccount(bz[:len(bz)/2])
 	require.NotNil(t, err)
 }
+
+func TestClawbackVestingAccountMarshal(t *testing.T) {
+	pubkey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubkey.Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("test", 5))
+	baseAcc := authtypes.NewBaseAccount(addr, pubkey, 10, 50)
+
+	acc := types.NewClawbackVestingAccount(baseAcc, addr, coins, time.Now().Unix(), types.Periods{types.Period{3600, coins}})
+
+	bz, err := app.AccountKeeper.MarshalAccount(acc)
+	require.Nil(t, err)
+
+	acc2, err := app.AccountKeeper.UnmarshalAccount(bz)
+	require.Nil(t, err)
+	require.IsType(t, &types.ClawbackVestingAccount{}, acc2)
+	require.Equal(t, acc.String(), acc2.String())
+
+	// error on bad bytes
+	_, err = app.AccountKeeper.UnmarshalAccount(bz[:len(bz)/2])
+	require.NotNil(t, err)
+}
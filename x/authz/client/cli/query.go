@@ -29,6 +29,8 @@ func GetQueryCmd() *cobra.Command {
 	authorizationQueryCmd.AddCommand(
 		GetCmdQueryAuthorization(),
 		GetCmdQueryAuthorizations(),
+		GetCmdQueryGranterGrants(),
+		GetCmdQueryGranteeGrants(),
 	)
 
 	return authorizationQueryCmd
@@ -137,3 +139,99 @@ $ %s query %s authorization cosmos1skjw.. cosmos1skjwj.. %s
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryGranterGrants implements the query granter-grants command.
+func GetCmdQueryGranterGrants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "granter-grants [granter-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query list of grants issued by a granter",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query list of grants issued by a granter to all grantees:
+Example:
+$ %s query %s granter-grants cosmos1skj..
+`, version.AppName, types.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			granterAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GranterGrants(
+				context.Background(),
+				&types.QueryGranterGrantsRequest{
+					Granter:    granterAddr.String(),
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "granter-grants")
+	return cmd
+}
+
+// GetCmdQueryGranteeGrants implements the query grantee-grants command.
+func GetCmdQueryGranteeGrants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grantee-grants [grantee-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query list of grants held by a grantee",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query list of grants held by a grantee from all granters:
+Example:
+$ %s query %s grantee-grants cosmos1skj..
+`, version.AppName, types.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			granteeAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GranteeGrants(
+				context.Background(),
+				&types.QueryGranteeGrantsRequest{
+					Grantee:    granteeAddr.String(),
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "grantee-grants")
+	return cmd
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/spf13/cobra"
 	tmcli "github.com/tendermint/tendermint/libs/cli"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -184,3 +185,92 @@ func (s *IntegrationTestSuite) TestQueryAuthorization() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestQueryGranterGranteeGrants() {
+	val := s.network.Validators[0]
+
+	grantee := s.grantee
+	twoHours := time.Now().Add(time.Minute * time.Duration(120)).Unix()
+
+	_, err := authztestutil.ExecGrantAuthorization(
+		val,
+		[]string{
+			grantee.String(),
+			"send",
+			fmt.Sprintf("--%s=100steak", cli.FlagSpendLimit),
+			fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+			fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+			fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+			fmt.Sprintf("--%s=%d", cli.FlagExpiration, twoHours),
+			fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+		},
+	)
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		cmd       func() *cobra.Command
+		args      []string
+		expectErr bool
+		expErrMsg string
+	}{
+		{
+			"Error: Invalid granter",
+			cli.GetCmdQueryGranterGrants,
+			[]string{
+				"invalid granter",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			"decoding bech32 failed: invalid character in string: ' '",
+		},
+		{
+			"Valid granter-grants (json)",
+			cli.GetCmdQueryGranterGrants,
+			[]string{
+				val.Address.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			``,
+		},
+		{
+			"Error: Invalid grantee",
+			cli.GetCmdQueryGranteeGrants,
+			[]string{
+				"invalid grantee",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			"decoding bech32 failed: invalid character in string: ' '",
+		},
+		{
+			"Valid grantee-grants (json)",
+			cli.GetCmdQueryGranteeGrants,
+			[]string{
+				grantee.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			``,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			clientCtx := val.ClientCtx
+			resp, err := clitestutil.ExecTestCLICmd(clientCtx, tc.cmd(), tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(string(resp.Bytes()), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+				var grants types.QueryGranterGrantsResponse
+				err = val.ClientCtx.JSONMarshaler.UnmarshalJSON(resp.Bytes(), &grants)
+				s.Require().NoError(err)
+				s.Require().NotEmpty(grants.Grants)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -19,6 +20,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/authz/exported"
 	"github.com/cosmos/cosmos-sdk/x/authz/types"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	gov "github.com/cosmos/cosmos-sdk/x/gov/types"
 	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -27,9 +29,14 @@ const FlagMsgType = "msg-type"
 const FlagExpiration = "expiration"
 const FlagAllowedValidators = "allowed-validators"
 const FlagDenyValidators = "deny-validators"
+const FlagProposalIDs = "proposal-ids"
+const FlagProposalTypes = "proposal-types"
+const FlagMaxVotes = "max-votes"
 const delegate = "delegate"
 const redelegate = "redelegate"
 const unbond = "unbond"
+const vote = "vote"
+const voteWeighted = "vote-weighted"
 
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
@@ -53,7 +60,7 @@ func GetTxCmd() *cobra.Command {
 
 func NewCmdGrantAuthorization() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "grant <grantee> <authorization_type=\"send\"|\"generic\"|\"delegate\"|\"unbond\"|\"redelegate\"> --from <granter>",
+		Use:   "grant <grantee> <authorization_type=\"send\"|\"generic\"|\"delegate\"|\"unbond\"|\"redelegate\"|\"vote\"|\"vote-weighted\"> --from <granter>",
 		Short: "Grant authorization to an address",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Grant authorization to an address to execute a transaction on your behalf:
@@ -61,7 +68,10 @@ func NewCmdGrantAuthorization() *cobra.Command {
 Examples:
  $ %s tx %s grant cosmos1skjw.. send %s --spend-limit=1000stake --from=cosmos1skl..
  $ %s tx %s grant cosmos1skjw.. generic --msg-type=/cosmos.gov.v1beta1.Msg/Vote --from=cosmos1sk..
-	`, version.AppName, types.ModuleName, bank.SendAuthorization{}.MethodName(), version.AppName, types.ModuleName),
+ $ %s tx %s grant cosmos1skjw.. vote --proposal-ids=1,2 --max-votes=1 --from=cosmos1sk..
+ $ %s tx %s grant cosmos1skjw.. vote --proposal-types=Text,ParameterChange --from=cosmos1sk..
+	`, version.AppName, types.ModuleName, bank.SendAuthorization{}.MethodName(), version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName),
 		),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -156,6 +166,32 @@ Examples:
 					return err
 				}
 
+			case vote, voteWeighted:
+				ids, err := cmd.Flags().GetStringSlice(FlagProposalIDs)
+				if err != nil {
+					return err
+				}
+
+				proposalIDs, err := parseProposalIDs(ids)
+				if err != nil {
+					return err
+				}
+
+				proposalTypes, err := cmd.Flags().GetStringSlice(FlagProposalTypes)
+				if err != nil {
+					return err
+				}
+
+				maxVotes, err := cmd.Flags().GetUint64(FlagMaxVotes)
+				if err != nil {
+					return err
+				}
+
+				authorization, err = gov.NewVoteAuthorization(proposalIDs, proposalTypes, maxVotes, args[1] == voteWeighted)
+				if err != nil {
+					return err
+				}
+
 			default:
 				return fmt.Errorf("invalid authorization type, %s", args[1])
 			}
@@ -180,6 +216,9 @@ Examples:
 	cmd.Flags().String(FlagSpendLimit, "", "SpendLimit for Send Authorization, an array of Coins allowed spend")
 	cmd.Flags().StringSlice(FlagAllowedValidators, []string{}, "Allowed validators addresses separated by ,")
 	cmd.Flags().StringSlice(FlagDenyValidators, []string{}, "Deny validators addresses separated by ,")
+	cmd.Flags().StringSlice(FlagProposalIDs, []string{}, "Proposal IDs the grantee can vote on separated by ,")
+	cmd.Flags().StringSlice(FlagProposalTypes, []string{}, "Types of the proposals the grantee can vote on separated by ,")
+	cmd.Flags().Uint64(FlagMaxVotes, 0, "Maximum number of votes the grantee can cast, 0 for no limit")
 	cmd.Flags().Int64(FlagExpiration, time.Now().AddDate(1, 0, 0).Unix(), "The Unix timestamp. Default is one year.")
 	return cmd
 }
@@ -292,3 +331,15 @@ func bech32toValidatorAddresses(validators []string) ([]sdk.ValAddress, error) {
 	}
 	return vals, nil
 }
+
+func parseProposalIDs(ids []string) ([]uint64, error) {
+	proposalIDs := make([]uint64, len(ids))
+	for i, id := range ids {
+		proposalID, err := strconv.ParseUint(id, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", id)
+		}
+		proposalIDs[i] = proposalID
+	}
+	return proposalIDs, nil
+}
@@ -1,6 +1,8 @@
 package exported
 
 import (
+	"context"
+
 	"github.com/gogo/protobuf/proto"
 
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -19,3 +21,20 @@ type Authorization interface {
 	// so provides an upgraded authorization instance.
 	Accept(msg sdk.ServiceMsg, block tmproto.Header) (updated Authorization, delete bool, err error)
 }
+
+type authorizationContextKey struct{}
+
+// WithAuthorization returns a copy of the context carrying the Authorization a
+// message is executed under, so that its handler can check the parts of the
+// grant Accept cannot check without the module state.
+func WithAuthorization(ctx sdk.Context, authorization Authorization) sdk.Context {
+	return ctx.WithContext(context.WithValue(ctx.Context(), authorizationContextKey{}, authorization))
+}
+
+// AuthorizationFromContext returns the Authorization the message handled with
+// the context is executed under, or nil if the message is not executed through
+// an authorization grant.
+func AuthorizationFromContext(ctx sdk.Context) Authorization {
+	authorization, _ := ctx.Context().Value(authorizationContextKey{}).(Authorization)
+	return authorization
+}
@@ -118,3 +118,96 @@ func (k Keeper) Authorization(c context.Context, req *types.QueryAuthorizationRe
 		},
 	}, nil
 }
+
+// GranterGrants implements the Query/GranterGrants gRPC method.
+func (k Keeper) GranterGrants(c context.Context, req *types.QueryGranterGrantsRequest) (*types.QueryGranterGrantsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	granter, err := sdk.AccAddressFromBech32(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	grantsStore := prefix.NewStore(store, types.GetGranterGrantsPrefix(granter))
+	var grants []*types.GrantAuthorization
+	pageRes, err := query.FilteredPaginate(grantsStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		grant, err := unmarshalAuthorization(k.cdc, value)
+		if err != nil {
+			return false, err
+		}
+		// expired grants are only pruned at the end of the block
+		if grant.Expiration.Before(ctx.BlockHeader().Time) {
+			return false, nil
+		}
+		if accumulate {
+			granteeLen := key[0]
+			grantee := sdk.AccAddress(key[1 : 1+granteeLen])
+			grants = append(grants, &types.GrantAuthorization{
+				Granter:       granter.String(),
+				Grantee:       grantee.String(),
+				Authorization: grant.Authorization,
+				Expiration:    grant.Expiration,
+			})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGranterGrantsResponse{
+		Grants:     grants,
+		Pagination: pageRes,
+	}, nil
+}
+
+// GranteeGrants implements the Query/GranteeGrants gRPC method.
+func (k Keeper) GranteeGrants(c context.Context, req *types.QueryGranteeGrantsRequest) (*types.QueryGranteeGrantsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(req.Grantee)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	indexStore := prefix.NewStore(store, types.GetGranteeIndexPrefix(grantee))
+	var grants []*types.GrantAuthorization
+	pageRes, err := query.FilteredPaginate(indexStore, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		granterLen := key[0]
+		granter := sdk.AccAddress(key[1 : 1+granterLen])
+		msgType := string(key[1+granterLen:])
+		grant, found := k.getAuthorizationGrant(ctx, types.GetAuthorizationStoreKey(grantee, granter, msgType))
+		if !found {
+			return false, status.Errorf(codes.Internal, "grant from %s to %s for %s not found", granter, grantee, msgType)
+		}
+		// expired grants are only pruned at the end of the block
+		if grant.Expiration.Before(ctx.BlockHeader().Time) {
+			return false, nil
+		}
+		if accumulate {
+			grants = append(grants, &types.GrantAuthorization{
+				Granter:       granter.String(),
+				Grantee:       grantee.String(),
+				Authorization: grant.Authorization,
+				Expiration:    grant.Expiration,
+			})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGranteeGrantsResponse{
+		Grants:     grants,
+		Pagination: pageRes,
+	}, nil
+}
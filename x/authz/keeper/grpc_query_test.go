@@ -157,3 +157,69 @@ func (suite *TestSuite) TestGRPCQueryAuthorizations() {
 		})
 	}
 }
+
+func (suite *TestSuite) TestGRPCQueryGranterGrants() {
+	app, ctx, queryClient, addrs := suite.app, suite.ctx, suite.queryClient, suite.addrs
+	now := ctx.BlockHeader().Time
+	newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
+	expAuthorization := &banktypes.SendAuthorization{SpendLimit: newCoins}
+
+	_, err := queryClient.GranterGrants(gocontext.Background(), &types.QueryGranterGrantsRequest{})
+	suite.Require().Error(err)
+
+	suite.Require().NoError(app.AuthzKeeper.Grant(ctx, addrs[1], addrs[0], expAuthorization, now.Add(time.Hour)))
+	suite.Require().NoError(app.AuthzKeeper.Grant(ctx, addrs[2], addrs[0], expAuthorization, now.Add(time.Hour)))
+	// expired grants are not returned
+	suite.Require().NoError(app.AuthzKeeper.Grant(ctx, addrs[2], addrs[0], types.NewGenericAuthorization("/cosmos.gov.v1beta1.Msg/Vote"), now.Add(-time.Hour)))
+	// grants issued by other granters are not returned
+	suite.Require().NoError(app.AuthzKeeper.Grant(ctx, addrs[2], addrs[1], expAuthorization, now.Add(time.Hour)))
+
+	res, err := queryClient.GranterGrants(gocontext.Background(), &types.QueryGranterGrantsRequest{
+		Granter: addrs[0].String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Grants, 2)
+	for i, grantee := range []sdk.AccAddress{addrs[1], addrs[2]} {
+		var auth exported.Authorization
+		suite.Require().Equal(addrs[0].String(), res.Grants[i].Granter)
+		suite.Require().Equal(grantee.String(), res.Grants[i].Grantee)
+		suite.Require().NoError(app.InterfaceRegistry().UnpackAny(res.Grants[i].Authorization, &auth))
+		suite.Require().Equal(expAuthorization.String(), auth.String())
+	}
+}
+
+func (suite *TestSuite) TestGRPCQueryGranteeGrants() {
+	app, ctx, queryClient, addrs := suite.app, suite.ctx, suite.queryClient, suite.addrs
+	now := ctx.BlockHeader().Time
+	newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
+	expAuthorization := &banktypes.SendAuthorization{SpendLimit: newCoins}
+
+	_, err := queryClient.GranteeGrants(gocontext.Background(), &types.QueryGranteeGrantsRequest{})
+	suite.Require().Error(err)
+
+	suite.Require().NoError(app.AuthzKeeper.Grant(ctx, addrs[2], addrs[0], expAuthorization, now.Add(time.Hour)))
+	suite.Require().NoError(app.AuthzKeeper.Grant(ctx, addrs[2], addrs[1], expAuthorization, now.Add(time.Hour)))
+	// expired grants are not returned
+	suite.Require().NoError(app.AuthzKeeper.Grant(ctx, addrs[2], addrs[1], types.NewGenericAuthorization("/cosmos.gov.v1beta1.Msg/Vote"), now.Add(-time.Hour)))
+	// grants held by other grantees are not returned
+	suite.Require().NoError(app.AuthzKeeper.Grant(ctx, addrs[1], addrs[0], expAuthorization, now.Add(time.Hour)))
+
+	res, err := queryClient.GranteeGrants(gocontext.Background(), &types.QueryGranteeGrantsRequest{
+		Grantee: addrs[2].String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Grants, 2)
+	for i, granter := range []sdk.AccAddress{addrs[0], addrs[1]} {
+		suite.Require().Equal(granter.String(), res.Grants[i].Granter)
+		suite.Require().Equal(addrs[2].String(), res.Grants[i].Grantee)
+	}
+
+	suite.T().Log("verify that revoked grants are removed from the grantee index")
+	suite.Require().NoError(app.AuthzKeeper.Revoke(ctx, addrs[2], addrs[0], expAuthorization.MethodName()))
+	res, err = queryClient.GranteeGrants(gocontext.Background(), &types.QueryGranteeGrantsRequest{
+		Grantee: addrs[2].String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Grants, 1)
+	suite.Require().Equal(addrs[1].String(), res.Grants[0].Granter)
+}
@@ -82,6 +82,7 @@ func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, service
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "authorization can be given to msg with only one signer")
 		}
 		granter := signers[0]
+		msgCtx := ctx
 		if !granter.Equals(grantee) {
 			authorization, _ := k.GetOrRevokeAuthorization(ctx, grantee, granter, serviceMsg.MethodName)
 			if authorization == nil {
@@ -99,6 +100,8 @@ func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, service
 					return nil, err
 				}
 			}
+
+			msgCtx = exported.WithAuthorization(ctx, authorization)
 		}
 		handler := k.router.Handler(serviceMsg.Route())
 
@@ -106,7 +109,7 @@ func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, service
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", serviceMsg.Route())
 		}
 
-		msgResult, err = handler(ctx, serviceMsg.Request)
+		msgResult, err = handler(msgCtx, serviceMsg.Request)
 		if err != nil {
 			return nil, sdkerrors.Wrapf(err, "failed to execute message; message %s", serviceMsg.MethodName)
 		}
@@ -126,14 +129,23 @@ func (k Keeper) Grant(ctx sdk.Context, grantee, granter sdk.AccAddress, authoriz
 		return err
 	}
 
+	msgType := authorization.MethodName()
+	grantStoreKey := types.GetAuthorizationStoreKey(grantee, granter, msgType)
+
+	// the overwritten grant may expire at another time, drop it from the queue
+	if oldGrant, found := k.getAuthorizationGrant(ctx, grantStoreKey); found {
+		store.Delete(types.GetGrantQueueKey(oldGrant.Expiration, granter, grantee, msgType))
+	}
+
 	bz := k.cdc.MustMarshalBinaryBare(&grant)
-	grantStoreKey := types.GetAuthorizationStoreKey(grantee, granter, authorization.MethodName())
 	store.Set(grantStoreKey, bz)
+	store.Set(types.GetGrantQueueKey(grant.Expiration, granter, grantee, msgType), []byte{})
+	store.Set(types.GetGranteeIndexKey(grantee, granter, msgType), []byte{})
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventGrantAuthorization,
-			sdk.NewAttribute(types.AttributeKeyGrantType, authorization.MethodName()),
+			sdk.NewAttribute(types.AttributeKeyGrantType, msgType),
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 			sdk.NewAttribute(types.AttributeKeyGranterAddress, granter.String()),
 			sdk.NewAttribute(types.AttributeKeyGranteeAddress, grantee.String()),
@@ -146,11 +158,13 @@ func (k Keeper) Grant(ctx sdk.Context, grantee, granter sdk.AccAddress, authoriz
 func (k Keeper) Revoke(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress, msgType string) error {
 	store := ctx.KVStore(k.storeKey)
 	grantStoreKey := types.GetAuthorizationStoreKey(grantee, granter, msgType)
-	_, found := k.getAuthorizationGrant(ctx, grantStoreKey)
+	grant, found := k.getAuthorizationGrant(ctx, grantStoreKey)
 	if !found {
 		return sdkerrors.Wrap(sdkerrors.ErrNotFound, "authorization not found")
 	}
 	store.Delete(grantStoreKey)
+	store.Delete(types.GetGrantQueueKey(grant.Expiration, granter, grantee, msgType))
+	store.Delete(types.GetGranteeIndexKey(grantee, granter, msgType))
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -209,3 +223,29 @@ func (k Keeper) IterateGrants(ctx sdk.Context,
 		}
 	}
 }
+
+// IterateExpiredGrantsQueue iterates over the grants in the grant queue that
+// expired before endTime and performs a callback function
+func (k Keeper) IterateExpiredGrantsQueue(ctx sdk.Context, endTime time.Time,
+	cb func(granterAddr sdk.AccAddress, granteeAddr sdk.AccAddress, msgType string) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.GrantQueuePrefix, types.GetGrantQueueTimeKey(endTime))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, granterAddr, granteeAddr, msgType := types.SplitGrantQueueKey(iter.Key())
+		if cb(granterAddr, granteeAddr, msgType) {
+			break
+		}
+	}
+}
+
+// DeleteExpiredGrants revokes all the grants that expired before the current
+// block time.
+func (k Keeper) DeleteExpiredGrants(ctx sdk.Context) {
+	k.IterateExpiredGrantsQueue(ctx, ctx.BlockHeader().Time, func(granterAddr, granteeAddr sdk.AccAddress, msgType string) bool {
+		if err := k.Revoke(ctx, granteeAddr, granterAddr, msgType); err != nil {
+			panic(fmt.Sprintf("expired grant from %s to %s for %s not found", granterAddr, granteeAddr, msgType))
+		}
+		return false
+	})
+}
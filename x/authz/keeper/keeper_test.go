@@ -17,6 +17,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/authz/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 type TestSuite struct {
@@ -205,6 +206,85 @@ func (s *TestSuite) TestKeeperFees() {
 	s.Require().NotNil(authorization)
 }
 
+func (s *TestSuite) TestKeeperVoteAuthorization() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+
+	granterAddr := addrs[0]
+	granteeAddr := addrs[1]
+	now := ctx.BlockHeader().Time
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, govtypes.NewTextProposal("Test", "description"))
+	s.Require().NoError(err)
+	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+
+	msgs := types.NewMsgExecAuthorized(granteeAddr, []sdk.ServiceMsg{
+		{
+			MethodName: govtypes.TypeVote,
+			Request:    govtypes.NewMsgVote(granterAddr, proposal.ProposalId, govtypes.OptionYes),
+		},
+	})
+	s.Require().NoError(msgs.UnpackInterfaces(app.AppCodec()))
+	executeMsgs, err := msgs.GetServiceMsgs()
+	s.Require().NoError(err)
+
+	s.T().Log("verify dispatch fails on a proposal type which is not allowed")
+	authorization, err := govtypes.NewVoteAuthorization([]uint64{proposal.ProposalId + 1}, []string{govtypes.ProposalTypeText + "s"}, 0, false)
+	s.Require().NoError(err)
+	s.Require().NoError(app.AuthzKeeper.Grant(ctx, granteeAddr, granterAddr, authorization, now.Add(time.Hour)))
+
+	result, err := app.AuthzKeeper.DispatchActions(ctx, granteeAddr, executeMsgs)
+	s.Require().Nil(result)
+	s.Require().Error(err)
+	_, found := app.GovKeeper.GetVote(ctx, proposal.ProposalId, granterAddr)
+	s.Require().False(found)
+
+	s.T().Log("verify dispatch executes on an allowed proposal type")
+	authorization, err = govtypes.NewVoteAuthorization(nil, []string{govtypes.ProposalTypeText}, 0, false)
+	s.Require().NoError(err)
+	s.Require().NoError(app.AuthzKeeper.Grant(ctx, granteeAddr, granterAddr, authorization, now.Add(time.Hour)))
+
+	result, err = app.AuthzKeeper.DispatchActions(ctx, granteeAddr, executeMsgs)
+	s.Require().NoError(err)
+	s.Require().NotNil(result)
+	_, found = app.GovKeeper.GetVote(ctx, proposal.ProposalId, granterAddr)
+	s.Require().True(found)
+}
+
+func (s *TestSuite) TestDeleteExpiredGrants() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+
+	granterAddr := addrs[0]
+	granteeAddr := addrs[1]
+	now := ctx.BlockHeader().Time
+	sendAuthz := &banktypes.SendAuthorization{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("steak", 100))}
+	genericAuthz := types.NewGenericAuthorization("/cosmos.gov.v1beta1.Msg/Vote")
+
+	s.Require().NoError(app.AuthzKeeper.Grant(ctx, granteeAddr, granterAddr, sendAuthz, now.Add(time.Hour)))
+	s.Require().NoError(app.AuthzKeeper.Grant(ctx, granteeAddr, granterAddr, genericAuthz, now.Add(2*time.Hour)))
+
+	s.T().Log("verify that an overwritten grant only expires at its new expiration")
+	s.Require().NoError(app.AuthzKeeper.Grant(ctx, granteeAddr, granterAddr, sendAuthz, now.Add(3*time.Hour)))
+	app.AuthzKeeper.DeleteExpiredGrants(ctx.WithBlockTime(now.Add(90 * time.Minute)))
+	authorization, _ := app.AuthzKeeper.GetOrRevokeAuthorization(ctx, granteeAddr, granterAddr, sendAuthz.MethodName())
+	s.Require().NotNil(authorization)
+
+	s.T().Log("verify that expired grants are deleted")
+	app.AuthzKeeper.DeleteExpiredGrants(ctx.WithBlockTime(now.Add(150 * time.Minute)))
+	authorization, _ = app.AuthzKeeper.GetOrRevokeAuthorization(ctx, granteeAddr, granterAddr, genericAuthz.MethodName())
+	s.Require().Nil(authorization)
+	authorization, _ = app.AuthzKeeper.GetOrRevokeAuthorization(ctx, granteeAddr, granterAddr, sendAuthz.MethodName())
+	s.Require().NotNil(authorization)
+
+	s.T().Log("verify that revoked grants are removed from the queue")
+	s.Require().NoError(app.AuthzKeeper.Revoke(ctx, granteeAddr, granterAddr, sendAuthz.MethodName()))
+	var queued int
+	app.AuthzKeeper.IterateExpiredGrantsQueue(ctx, now.Add(24*time.Hour), func(_, _ sdk.AccAddress, _ string) bool {
+		queued++
+		return false
+	})
+	s.Require().Zero(queued)
+}
+
 func TestTestSuite(t *testing.T) {
 	suite.Run(t, new(TestSuite))
 }
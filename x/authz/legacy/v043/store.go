@@ -0,0 +1,37 @@
+package v043
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// addGrantIndexes adds every stored grant to the grant expiration queue and
+// to the grantee index.
+func addGrantIndexes(store sdk.KVStore, cdc codec.BinaryMarshaler) error {
+	iterator := sdk.KVStorePrefixIterator(store, types.GrantKey)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.AuthorizationGrant
+		if err := cdc.UnmarshalBinaryBare(iterator.Value(), &grant); err != nil {
+			return err
+		}
+
+		granter, grantee, msgType := types.SplitGrantKey(iterator.Key())
+		store.Set(types.GetGrantQueueKey(grant.Expiration, granter, grantee, msgType), []byte{})
+		store.Set(types.GetGranteeIndexKey(grantee, granter, msgType), []byte{})
+	}
+
+	return nil
+}
+
+// MigrateStore performs in-place store migrations from v0.42 to v0.43. The
+// migration includes:
+//
+// - Add the grant expiration queue.
+// - Add the grantee index.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryMarshaler) error {
+	store := ctx.KVStore(storeKey)
+	return addGrantIndexes(store, cdc)
+}
@@ -0,0 +1,40 @@
+package v043_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v043authz "github.com/cosmos/cosmos-sdk/x/authz/legacy/v043"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Marshaler
+	authzKey := sdk.NewKVStoreKey("authz")
+	ctx := testutil.DefaultContext(authzKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(authzKey)
+
+	_, _, granter := testdata.KeyTestPubAddr()
+	_, _, grantee := testdata.KeyTestPubAddr()
+	expiration := time.Now().UTC()
+	authorization := banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	msgType := authorization.MethodName()
+
+	grant, err := types.NewAuthorizationGrant(authorization, expiration)
+	require.NoError(t, err)
+	store.Set(types.GetAuthorizationStoreKey(grantee, granter, msgType), cdc.MustMarshalBinaryBare(&grant))
+
+	// Run migrations.
+	err = v043authz.MigrateStore(ctx, authzKey, cdc)
+	require.NoError(t, err)
+
+	require.True(t, store.Has(types.GetGrantQueueKey(expiration, granter, grantee, msgType)))
+	require.True(t, store.Has(types.GetGranteeIndexKey(grantee, granter, msgType)))
+}
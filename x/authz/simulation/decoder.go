@@ -19,6 +19,9 @@ func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshalBinaryBare(kvA.Value, &grantA)
 			cdc.MustUnmarshalBinaryBare(kvB.Value, &grantB)
 			return fmt.Sprintf("%v\n%v", grantA, grantB)
+		case bytes.Equal(kvA.Key[:1], types.GrantQueuePrefix),
+			bytes.Equal(kvA.Key[:1], types.GranteeIndexPrefix):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
 		default:
 			panic(fmt.Sprintf("invalid authz key %X", kvA.Key))
 		}
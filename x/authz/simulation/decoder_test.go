@@ -25,6 +25,8 @@ func TestDecodeStore(t *testing.T) {
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: []byte(types.GrantKey), Value: grantBz},
+			{Key: []byte(types.GrantQueuePrefix), Value: []byte{}},
+			{Key: []byte(types.GranteeIndexPrefix), Value: []byte{}},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -34,6 +36,8 @@ func TestDecodeStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"Grant", fmt.Sprintf("%v\n%v", grant, grant)},
+		{"GrantQueue", fmt.Sprintf("%v\n%v", []byte{}, []byte{})},
+		{"GranteeIndex", fmt.Sprintf("%v\n%v", []byte{}, []byte{})},
 		{"other", ""},
 	}
 
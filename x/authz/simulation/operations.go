@@ -16,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	"github.com/cosmos/cosmos-sdk/x/authz/types"
 	banktype "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 )
 
@@ -28,9 +29,10 @@ const (
 
 // Simulation operation weights constants
 const (
-	OpWeightMsgGrantAuthorization = "op_weight_msg_grant_authorization"
-	OpWeightRevokeAuthorization   = "op_weight_msg_revoke_authorization"
-	OpWeightExecAuthorized        = "op_weight_msg_execute_authorized"
+	OpWeightMsgGrantAuthorization     = "op_weight_msg_grant_authorization"
+	OpWeightMsgGrantVoteAuthorization = "op_weight_msg_grant_vote_authorization"
+	OpWeightRevokeAuthorization       = "op_weight_msg_revoke_authorization"
+	OpWeightExecAuthorized            = "op_weight_msg_execute_authorized"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -38,9 +40,10 @@ func WeightedOperations(
 	appParams simtypes.AppParams, cdc codec.JSONMarshaler, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, appCdc cdctypes.AnyUnpacker, protoCdc *codec.ProtoCodec) simulation.WeightedOperations {
 
 	var (
-		weightMsgGrantAuthorization int
-		weightRevokeAuthorization   int
-		weightExecAuthorized        int
+		weightMsgGrantAuthorization     int
+		weightMsgGrantVoteAuthorization int
+		weightRevokeAuthorization       int
+		weightExecAuthorized            int
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgGrantAuthorization, &weightMsgGrantAuthorization, nil,
@@ -49,6 +52,12 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgGrantVoteAuthorization, &weightMsgGrantVoteAuthorization, nil,
+		func(_ *rand.Rand) {
+			weightMsgGrantVoteAuthorization = simappparams.DefaultWeightMsgVote
+		},
+	)
+
 	appParams.GetOrGenerate(cdc, OpWeightRevokeAuthorization, &weightRevokeAuthorization, nil,
 		func(_ *rand.Rand) {
 			weightRevokeAuthorization = simappparams.DefaultWeightMsgUndelegate
@@ -66,6 +75,10 @@ func WeightedOperations(
 			weightMsgGrantAuthorization,
 			SimulateMsgGrantAuthorization(ak, bk, k, protoCdc),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgGrantVoteAuthorization,
+			SimulateMsgGrantVoteAuthorization(ak, bk, k, protoCdc),
+		),
 		simulation.NewWeightedOperation(
 			weightRevokeAuthorization,
 			SimulateMsgRevokeAuthorization(ak, bk, k, protoCdc),
@@ -132,6 +145,75 @@ func SimulateMsgGrantAuthorization(ak types.AccountKeeper, bk types.BankKeeper,
 	}
 }
 
+// SimulateMsgGrantVoteAuthorization generates a MsgGrantAuthorization of a
+// VoteAuthorization with random values.
+// nolint: funlen
+func SimulateMsgGrantVoteAuthorization(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper,
+	protoCdc *codec.ProtoCodec) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		granter := accs[0]
+		grantee := accs[1]
+
+		account := ak.GetAccount(ctx, granter.Address)
+
+		spendableCoins := bk.SpendableCoins(ctx, account.GetAddress())
+		fees, err := simtypes.RandomFees(r, ctx, spendableCoins)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgGrantAuthorization, err.Error()), nil, err
+		}
+
+		proposalIDs := make([]uint64, simtypes.RandIntBetween(r, 1, 5))
+		for i := range proposalIDs {
+			proposalIDs[i] = uint64(simtypes.RandIntBetween(r, 1, 100))
+		}
+
+		var proposalTypes []string
+		if r.Intn(2) == 0 {
+			proposalTypes = []string{govtypes.ProposalTypeText}
+		}
+
+		authorization, err := govtypes.NewVoteAuthorization(proposalIDs, proposalTypes, uint64(r.Intn(5)), r.Intn(2) == 0)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgGrantAuthorization, err.Error()), nil, err
+		}
+
+		blockTime := ctx.BlockTime()
+		msg, err := types.NewMsgGrantAuthorization(granter.Address, grantee.Address, authorization, blockTime.AddDate(1, 0, 0))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgGrantAuthorization, err.Error()), nil, err
+		}
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		svcMsgClientConn := &msgservice.ServiceMsgClientConn{}
+		authzMsgClient := types.NewMsgClient(svcMsgClientConn)
+		_, err = authzMsgClient.GrantAuthorization(context.Background(), msg)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgGrantAuthorization, err.Error()), nil, err
+		}
+		tx, err := helpers.GenTx(
+			txGen,
+			svcMsgClientConn.GetMsgs(),
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			granter.PrivKey,
+		)
+
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgGrantAuthorization, "unable to generate mock tx"), nil, err
+		}
+
+		_, _, err = app.Deliver(txGen.TxEncoder(), tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, svcMsgClientConn.GetMsgs()[0].Type(), "unable to deliver tx"), nil, err
+		}
+		return simtypes.NewOperationMsg(svcMsgClientConn.GetMsgs()[0], true, "", protoCdc), nil, err
+	}
+}
+
 // SimulateMsgRevokeAuthorization generates a MsgRevokeAuthorization with random values.
 // nolint: funlen
 func SimulateMsgRevokeAuthorization(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, protoCdc *codec.ProtoCodec) simtypes.Operation {
@@ -207,6 +289,10 @@ func SimulateMsgExecuteAuthorized(ak types.AccountKeeper, bk types.BankKeeper, k
 		var granterAddr sdk.AccAddress
 		var granteeAddr sdk.AccAddress
 		k.IterateGrants(ctx, func(granter, grantee sdk.AccAddress, grant types.AuthorizationGrant) bool {
+			// only send authorizations are executed
+			if _, ok := grant.GetAuthorizationGrant().(*banktype.SendAuthorization); !ok {
+				return false
+			}
 			targetGrant = grant
 			granterAddr = granter
 			granteeAddr = grantee
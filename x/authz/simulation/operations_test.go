@@ -18,6 +18,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/authz/simulation"
 	"github.com/cosmos/cosmos-sdk/x/authz/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 type SimTestSuite struct {
@@ -55,6 +56,7 @@ func (suite *SimTestSuite) TestWeightedOperations() {
 		opMsgName  string
 	}{
 		{simappparams.DefaultWeightMsgDelegate, types.ModuleName, simulation.TypeMsgGrantAuthorization},
+		{simappparams.DefaultWeightMsgVote, types.ModuleName, simulation.TypeMsgGrantAuthorization},
 		{simappparams.DefaultWeightMsgUndelegate, types.ModuleName, simulation.TypeMsgRevokeAuthorization},
 		{simappparams.DefaultWeightMsgSend, types.ModuleName, simulation.TypeMsgExecDelegated},
 	}
@@ -119,6 +121,42 @@ func (suite *SimTestSuite) TestSimulateGrantAuthorization() {
 
 }
 
+func (suite *SimTestSuite) TestSimulateGrantVoteAuthorization() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 2)
+	ctx := suite.ctx.WithBlockTime(time.Now().UTC())
+
+	// begin a new block
+	suite.app.BeginBlock(abci.RequestBeginBlock{
+		Header: tmproto.Header{
+			Height:  suite.app.LastBlockHeight() + 1,
+			AppHash: suite.app.LastCommitID().Hash,
+		},
+	})
+
+	granter := accounts[0]
+	grantee := accounts[1]
+
+	// execute operation
+	op := simulation.SimulateMsgGrantVoteAuthorization(suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.AuthzKeeper, suite.protoCdc)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg types.MsgGrantAuthorizationRequest
+	suite.app.AppCodec().UnmarshalJSON(operationMsg.Msg, &msg)
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Equal(granter.Address.String(), msg.Granter)
+	suite.Require().Equal(grantee.Address.String(), msg.Grantee)
+	suite.Require().Len(futureOperations, 0)
+
+	authorization, _ := suite.app.AuthzKeeper.GetOrRevokeAuthorization(ctx, grantee.Address, granter.Address, govtypes.TypeVote)
+	if authorization == nil {
+		authorization, _ = suite.app.AuthzKeeper.GetOrRevokeAuthorization(ctx, grantee.Address, granter.Address, govtypes.TypeVoteWeighted)
+	}
+	suite.Require().IsType(&govtypes.VoteAuthorization{}, authorization)
+}
+
 func (suite *SimTestSuite) TestSimulateRevokeAuthorization() {
 	// setup 3 accounts
 	s := rand.NewSource(1)
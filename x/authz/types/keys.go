@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 )
@@ -23,12 +25,22 @@ const (
 // Items are stored with the following key: values
 //
 // - 0x01<granterAddressLen (1 Byte)><granterAddress_Bytes><granteeAddressLen (1 Byte)><granteeAddress_Bytes><msgType_Bytes>: Grant
+// - 0x02<expiration_Bytes><granterAddressLen (1 Byte)><granterAddress_Bytes><granteeAddressLen (1 Byte)><granteeAddress_Bytes><msgType_Bytes>: []byte{}
+// - 0x03<granteeAddressLen (1 Byte)><granteeAddress_Bytes><granterAddressLen (1 Byte)><granterAddress_Bytes><msgType_Bytes>: []byte{}
+//
+// Grants are keyed by granter first, so the grants issued by a granter are
+// found by iterating the grant prefix directly. The grantee index gives the
+// same access to the grants held by a grantee.
 
 var (
 	// Keys for store prefixes
-	GrantKey = []byte{0x01} // prefix for each key
+	GrantKey           = []byte{0x01} // prefix for each key
+	GrantQueuePrefix   = []byte{0x02} // prefix for the grant expiration queue
+	GranteeIndexPrefix = []byte{0x03} // prefix for the grantee index
 )
 
+var lenTime = len(sdk.FormatTimeBytes(time.Now()))
+
 // GetAuthorizationStoreKey - return authorization store key
 func GetAuthorizationStoreKey(grantee sdk.AccAddress, granter sdk.AccAddress, msgType string) []byte {
 	return append(append(append(
@@ -39,6 +51,12 @@ func GetAuthorizationStoreKey(grantee sdk.AccAddress, granter sdk.AccAddress, ms
 	)
 }
 
+// GetGranterGrantsPrefix returns the prefix of the grants issued by the given
+// granter.
+func GetGranterGrantsPrefix(granter sdk.AccAddress) []byte {
+	return append(GrantKey, address.MustLengthPrefix(granter)...)
+}
+
 // ExtractAddressesFromGrantKey - split granter & grantee address from the authorization key
 func ExtractAddressesFromGrantKey(key []byte) (granterAddr, granteeAddr sdk.AccAddress) {
 	// key if of format:
@@ -50,3 +68,64 @@ func ExtractAddressesFromGrantKey(key []byte) (granterAddr, granteeAddr sdk.AccA
 
 	return granterAddr, granteeAddr
 }
+
+// SplitGrantKey splits a grant key into its granter, grantee and msg type.
+func SplitGrantKey(key []byte) (granterAddr, granteeAddr sdk.AccAddress, msgType string) {
+	return splitAddressPair(key[1:])
+}
+
+// GetGrantQueueTimeKey returns the prefix of the grant queue entries expiring
+// at the given time.
+func GetGrantQueueTimeKey(expiration time.Time) []byte {
+	return append(GrantQueuePrefix, sdk.FormatTimeBytes(expiration)...)
+}
+
+// GetGrantQueueKey returns the grant queue key of the grant from granter to
+// grantee for the given msg type expiring at the given time.
+func GetGrantQueueKey(expiration time.Time, granter sdk.AccAddress, grantee sdk.AccAddress, msgType string) []byte {
+	return append(append(append(
+		GetGrantQueueTimeKey(expiration),
+		address.MustLengthPrefix(granter)...),
+		address.MustLengthPrefix(grantee)...),
+		[]byte(msgType)...,
+	)
+}
+
+// SplitGrantQueueKey splits a grant queue key into its expiration, granter,
+// grantee and msg type.
+func SplitGrantQueueKey(key []byte) (expiration time.Time, granterAddr, granteeAddr sdk.AccAddress, msgType string) {
+	expiration, err := sdk.ParseTimeBytes(key[1 : 1+lenTime])
+	if err != nil {
+		panic(err)
+	}
+	granterAddr, granteeAddr, msgType = splitAddressPair(key[1+lenTime:])
+
+	return expiration, granterAddr, granteeAddr, msgType
+}
+
+// GetGranteeIndexPrefix returns the prefix of the grantee index entries of
+// the given grantee.
+func GetGranteeIndexPrefix(grantee sdk.AccAddress) []byte {
+	return append(GranteeIndexPrefix, address.MustLengthPrefix(grantee)...)
+}
+
+// GetGranteeIndexKey returns the grantee index key of the grant from granter
+// to grantee for the given msg type.
+func GetGranteeIndexKey(grantee sdk.AccAddress, granter sdk.AccAddress, msgType string) []byte {
+	return append(append(
+		GetGranteeIndexPrefix(grantee),
+		address.MustLengthPrefix(granter)...),
+		[]byte(msgType)...,
+	)
+}
+
+// splitAddressPair splits <addr1Len (1 Byte)><addr1_Bytes><addr2Len (1 Byte)><addr2_Bytes><msgType_Bytes>.
+func splitAddressPair(bz []byte) (addr1, addr2 sdk.AccAddress, msgType string) {
+	addr1Len := int(bz[0])
+	addr1 = sdk.AccAddress(bz[1 : 1+addr1Len])
+	bz = bz[1+addr1Len:]
+	addr2Len := int(bz[0])
+	addr2 = sdk.AccAddress(bz[1 : 1+addr2Len])
+
+	return addr1, addr2, string(bz[1+addr2Len:])
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -19,3 +20,19 @@ func TestGrantkey(t *testing.T) {
 	require.Equal(t, granter, granter1)
 	require.Equal(t, grantee, grantee1)
 }
+
+func TestGrantQueueKey(t *testing.T) {
+	expiration := time.Now().UTC()
+	expiration1, granter1, grantee1, msgType1 := SplitGrantQueueKey(GetGrantQueueKey(expiration, granter, grantee, msgType))
+	require.True(t, expiration.Equal(expiration1))
+	require.Equal(t, granter, granter1)
+	require.Equal(t, grantee, grantee1)
+	require.Equal(t, msgType, msgType1)
+}
+
+func TestSplitGrantKey(t *testing.T) {
+	granter1, grantee1, msgType1 := SplitGrantKey(GetAuthorizationStoreKey(grantee, granter, msgType))
+	require.Equal(t, granter, granter1)
+	require.Equal(t, grantee, grantee1)
+	require.Equal(t, msgType, msgType1)
+}
@@ -31,6 +31,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryValidatorSlashes(),
 		GetCmdQueryDelegatorRewards(),
 		GetCmdQueryCommunityPool(),
+		GetCmdQueryAutoCompound(),
 	)
 
 	return distQueryCmd
@@ -319,3 +320,50 @@ $ %s query distribution community-pool
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryAutoCompound returns the command for fetching whether a delegator
+// opted in to auto-compounding.
+func GetCmdQueryAutoCompound() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "auto-compound [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query whether a delegator opted in to auto-compounding",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether the rewards of a delegator are periodically withdrawn and
+delegated back to the same validators.
+
+Example:
+$ %s query distribution auto-compound %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delegatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AutoCompound(
+				cmd.Context(),
+				&types.QueryAutoCompoundRequest{DelegatorAddress: delegatorAddr.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -42,6 +43,8 @@ func NewTxCmd() *cobra.Command {
 		NewWithdrawAllRewardsCmd(),
 		NewSetWithdrawAddrCmd(),
 		NewFundCommunityPoolCmd(),
+		NewWithdrawTokenizeShareRecordRewardCmd(),
+		NewSetAutoCompoundCmd(),
 	)
 
 	return distTxCmd
@@ -273,6 +276,93 @@ $ %s tx distribution fund-community-pool 100uatom --from mykey
 	return cmd
 }
 
+func NewWithdrawTokenizeShareRecordRewardCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-tokenize-share-rewards",
+		Args:  cobra.NoArgs,
+		Short: "Withdraw the rewards of the tokenize share records owned by an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw the rewards of the delegations of all the tokenize share records
+owned by an address.
+
+Example:
+$ %s tx distribution withdraw-tokenize-share-rewards --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			ownerAddr := clientCtx.GetFromAddress()
+
+			msg := types.NewMsgWithdrawTokenizeShareRecordReward(ownerAddr)
+			svcMsgClientConn := &msgservice.ServiceMsgClientConn{}
+			msgClient := types.NewMsgClient(svcMsgClientConn)
+			_, err = msgClient.WithdrawTokenizeShareRecordReward(cmd.Context(), msg)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), svcMsgClientConn.GetMsgs()...)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetAutoCompoundCmd returns a CLI command handler for creating a
+// MsgSetAutoCompound transaction.
+func NewSetAutoCompoundCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-auto-compound [true|false]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Opt in to or out of the auto-compounding of delegation rewards",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Opt in to or out of the auto-compounding of delegation rewards. The rewards
+of the delegators who opted in are periodically withdrawn and delegated back to
+the same validators. Delegators with a withdraw address other than their own
+address are not auto-compounded.
+
+Example:
+$ %s tx distribution set-auto-compound true --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+
+			enabled, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAutoCompound(delAddr, enabled)
+			svcMsgClientConn := &msgservice.ServiceMsgClientConn{}
+			msgClient := types.NewMsgClient(svcMsgClientConn)
+			_, err = msgClient.SetAutoCompound(cmd.Context(), msg)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), svcMsgClientConn.GetMsgs()...)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdSubmitProposal implements the command to submit a community-pool-spend proposal
 func GetCmdSubmitProposal() *cobra.Command {
 	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
@@ -0,0 +1,215 @@
+package keeper
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// autoCompoundTarget is a delegation to auto-compound, or a delegator without
+// delegations if valAddr is empty.
+type autoCompoundTarget struct {
+	delAddr sdk.AccAddress
+	valAddr sdk.ValAddress
+}
+
+// GetAutoCompound returns whether the delegator opted in to auto-compounding.
+func (k Keeper) GetAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetAutoCompoundKey(delAddr))
+}
+
+// SetAutoCompound opts the delegator in to or out of auto-compounding.
+func (k Keeper) SetAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, enabled bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	if !enabled {
+		store.Delete(types.GetAutoCompoundKey(delAddr))
+		return
+	}
+
+	store.Set(types.GetAutoCompoundKey(delAddr), []byte{0x01})
+}
+
+// IterateAutoCompoundDelegators iterates through the delegators opted in to
+// auto-compounding, starting from the given delegator if it is not empty. If
+// the callback returns true, the iteration stops.
+func (k Keeper) IterateAutoCompoundDelegators(ctx sdk.Context, start sdk.AccAddress, cb func(delAddr sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	startKey := types.AutoCompoundPrefix
+	if !start.Empty() {
+		startKey = types.GetAutoCompoundKey(start)
+	}
+
+	iterator := store.Iterator(startKey, sdk.PrefixEndBytes(types.AutoCompoundPrefix))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(types.GetAutoCompoundAddress(iterator.Key())) {
+			break
+		}
+	}
+}
+
+// getAutoCompoundCursor returns the next delegation of the auto-compounding
+// pass in progress, if any.
+func (k Keeper) getAutoCompoundCursor(ctx sdk.Context) (target autoCompoundTarget, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.AutoCompoundCursorKey)
+	if bz == nil {
+		return target, false
+	}
+
+	// the cursor is in the format, with an empty valAddr for a delegator
+	// without delegations:
+	// <accAddrLen (1 Byte)><accAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>
+	delAddrLen := int(bz[0])
+	target.delAddr = sdk.AccAddress(bz[1 : 1+delAddrLen])
+	target.valAddr = sdk.ValAddress(bz[2+delAddrLen:])
+	if len(target.valAddr) != int(bz[1+delAddrLen]) {
+		panic("unexpected auto-compound cursor length")
+	}
+
+	return target, true
+}
+
+// setAutoCompoundCursor sets the next delegation of the auto-compounding pass
+// in progress.
+func (k Keeper) setAutoCompoundCursor(ctx sdk.Context, target autoCompoundTarget) {
+	store := ctx.KVStore(k.storeKey)
+	bz := append(address.MustLengthPrefix(target.delAddr), byte(len(target.valAddr)))
+	bz = append(bz, target.valAddr...)
+	store.Set(types.AutoCompoundCursorKey, bz)
+}
+
+// deleteAutoCompoundCursor marks the auto-compounding pass in progress as
+// completed.
+func (k Keeper) deleteAutoCompoundCursor(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.AutoCompoundCursorKey)
+}
+
+// AutoCompoundRewards compounds the rewards of the delegators opted in to
+// auto-compounding. A pass over all their delegations starts every
+// AutoCompoundEpoch blocks and processes at most MaxAutoCompoundPerBlock
+// delegations per block, resuming in the next blocks until it completes. A
+// delegator without delegations counts as one delegation and is opted out, so
+// that the work per block stays bounded. A zero value of either param disables
+// auto-compounding.
+func (k Keeper) AutoCompoundRewards(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.AutoCompoundEpoch == 0 || params.MaxAutoCompoundPerBlock == 0 {
+		return
+	}
+
+	cursor, inProgress := k.getAutoCompoundCursor(ctx)
+	if !inProgress && uint64(ctx.BlockHeight())%params.AutoCompoundEpoch != 0 {
+		return
+	}
+
+	// collect one more delegation than processed to know where to resume
+	limit := int(params.MaxAutoCompoundPerBlock)
+	targets := make([]autoCompoundTarget, 0, limit+1)
+
+	k.IterateAutoCompoundDelegators(ctx, cursor.delAddr, func(delAddr sdk.AccAddress) bool {
+		hasDelegations := false
+		k.stakingKeeper.IterateDelegations(ctx, delAddr, func(_ int64, del stakingtypes.DelegationI) bool {
+			hasDelegations = true
+			valAddr := del.GetValidatorAddr()
+
+			// skip the delegations of the delegator of the cursor processed in
+			// the previous block, in the order of the staking store
+			if delAddr.Equals(cursor.delAddr) &&
+				bytes.Compare(address.MustLengthPrefix(valAddr), address.MustLengthPrefix(cursor.valAddr)) < 0 {
+				return false
+			}
+
+			targets = append(targets, autoCompoundTarget{delAddr: delAddr, valAddr: valAddr})
+			return len(targets) > limit
+		})
+
+		if !hasDelegations {
+			targets = append(targets, autoCompoundTarget{delAddr: delAddr})
+		}
+
+		return len(targets) > limit
+	})
+
+	if len(targets) > limit {
+		k.setAutoCompoundCursor(ctx, targets[limit])
+		targets = targets[:limit]
+	} else {
+		k.deleteAutoCompoundCursor(ctx)
+	}
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	for _, target := range targets {
+		if target.valAddr.Empty() {
+			k.SetAutoCompound(ctx, target.delAddr, false)
+			continue
+		}
+
+		// the rewards of delegators withdrawing to another address are not
+		// held by the delegators and cannot be compounded
+		if !k.GetDelegatorWithdrawAddr(ctx, target.delAddr).Equals(target.delAddr) {
+			continue
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		amount, err := k.compoundDelegationRewards(cacheCtx, target, bondDenom)
+		if err != nil {
+			k.Logger(ctx).Error(
+				"failed to auto-compound delegation rewards",
+				"delegator", target.delAddr.String(),
+				"validator", target.valAddr.String(),
+				"err", err,
+			)
+			continue
+		}
+
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+		if amount.IsPositive() {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeAutoCompound,
+					sdk.NewAttribute(types.AttributeKeyDelegator, target.delAddr.String()),
+					sdk.NewAttribute(types.AttributeKeyValidator, target.valAddr.String()),
+					sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(bondDenom, amount).String()),
+				),
+			)
+		}
+	}
+}
+
+// compoundDelegationRewards withdraws the rewards of a delegation and delegates
+// the withdrawn bond denom tokens back to the same validator. It returns the
+// amount of tokens delegated.
+func (k Keeper) compoundDelegationRewards(ctx sdk.Context, target autoCompoundTarget, bondDenom string) (sdk.Int, error) {
+	rewards, err := k.WithdrawDelegationRewards(ctx, target.delAddr, target.valAddr)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	amount := rewards.AmountOf(bondDenom)
+	if !amount.IsPositive() {
+		return amount, nil
+	}
+
+	validator, found := k.stakingKeeper.GetValidator(ctx, target.valAddr)
+	if !found {
+		return sdk.ZeroInt(), types.ErrNoValidatorExists
+	}
+
+	if _, err := k.stakingKeeper.Delegate(ctx, target.delAddr, amount, stakingtypes.Unbonded, validator, true); err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	return amount, nil
+}
@@ -0,0 +1,222 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestSetAutoCompound(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1000))
+	require.False(t, app.DistrKeeper.GetAutoCompound(ctx, addr[0]))
+
+	app.DistrKeeper.SetAutoCompound(ctx, addr[0], true)
+	app.DistrKeeper.SetAutoCompound(ctx, addr[1], true)
+	require.True(t, app.DistrKeeper.GetAutoCompound(ctx, addr[0]))
+
+	app.DistrKeeper.SetAutoCompound(ctx, addr[0], false)
+	require.False(t, app.DistrKeeper.GetAutoCompound(ctx, addr[0]))
+
+	var delegators []sdk.AccAddress
+	app.DistrKeeper.IterateAutoCompoundDelegators(ctx, nil, func(delAddr sdk.AccAddress) bool {
+		delegators = append(delegators, delAddr)
+		return false
+	})
+	require.Equal(t, []sdk.AccAddress{addr[1]}, delegators)
+
+	genState := app.DistrKeeper.ExportGenesis(ctx)
+	require.Equal(t, []string{addr[1].String()}, genState.AutoCompoundDelegators)
+}
+
+func TestAutoCompoundRewards(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// set module account coins
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, simapp.FundAccount(app, ctx, distrAcc.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(1000)))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// compound a single delegation per block every 10 blocks
+	params := app.DistrKeeper.GetParams(ctx)
+	params.AutoCompoundEpoch = 10
+	params.MaxAutoCompoundPerBlock = 1
+	app.DistrKeeper.SetParams(ctx, params)
+
+	// create two validators with 50% commission
+	power := int64(100)
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	valTokens := tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, power, true)
+	tstaking.CreateValidatorWithValPower(valAddrs[1], valConsPk2, power, true)
+
+	// end block to bond validators
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// next block
+	ctx = ctx.WithBlockHeight(9)
+	tstaking.Ctx = ctx
+
+	// delegate as much as the validators to both of them, with addr[2] opted in
+	// to auto-compounding
+	tstaking.Delegate(addr[2], valAddrs[0], valTokens)
+	tstaking.Delegate(addr[2], valAddrs[1], valTokens)
+	app.DistrKeeper.SetAutoCompound(ctx, addr[2], true)
+
+	// allocate some rewards, of which each delegation gets a quarter
+	initial := sdk.TokensFromConsensusPower(10)
+	tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)}
+	app.DistrKeeper.AllocateTokensToValidator(ctx, app.StakingKeeper.Validator(ctx, valAddrs[0]), tokens)
+	app.DistrKeeper.AllocateTokensToValidator(ctx, app.StakingKeeper.Validator(ctx, valAddrs[1]), tokens)
+
+	balance := app.BankKeeper.GetBalance(ctx, addr[2], sdk.DefaultBondDenom)
+	delegatedTokens := func(delAddr sdk.AccAddress) sdk.Int {
+		total := sdk.ZeroInt()
+		for _, del := range app.StakingKeeper.GetDelegatorDelegations(ctx, delAddr, 10) {
+			validator, found := app.StakingKeeper.GetValidator(ctx, del.GetValidatorAddr())
+			require.True(t, found)
+			total = total.Add(validator.TokensFromShares(del.Shares).TruncateInt())
+		}
+		return total
+	}
+
+	// no pass starts outside of an epoch
+	app.DistrKeeper.AutoCompoundRewards(ctx)
+	require.Equal(t, valTokens.MulRaw(2), delegatedTokens(addr[2]))
+
+	// a pass starts at the epoch and compounds a single delegation
+	ctx = ctx.WithBlockHeight(10)
+	app.DistrKeeper.AutoCompoundRewards(ctx)
+	require.Equal(t, valTokens.MulRaw(2).Add(initial.QuoRaw(4)), delegatedTokens(addr[2]))
+
+	// the pass resumes in the next block and compounds the other delegation
+	ctx = ctx.WithBlockHeight(11)
+	app.DistrKeeper.AutoCompoundRewards(ctx)
+	require.Equal(t, valTokens.MulRaw(2).Add(initial.QuoRaw(2)), delegatedTokens(addr[2]))
+
+	// the pass completed and does not resume anymore
+	ctx = ctx.WithBlockHeight(12)
+	app.DistrKeeper.AllocateTokensToValidator(ctx, app.StakingKeeper.Validator(ctx, valAddrs[0]), tokens)
+	app.DistrKeeper.AutoCompoundRewards(ctx)
+	require.Equal(t, valTokens.MulRaw(2).Add(initial.QuoRaw(2)), delegatedTokens(addr[2]))
+
+	// the rewards were delegated and not left in the account
+	require.Equal(t, balance, app.BankKeeper.GetBalance(ctx, addr[2], sdk.DefaultBondDenom))
+
+	// the self-delegation of the validator not opted in is not compounded
+	require.Equal(t, valTokens, delegatedTokens(addr[0]))
+}
+
+func TestAutoCompoundRewardsWithdrawAddress(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// set module account coins
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, simapp.FundAccount(app, ctx, distrAcc.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(1000)))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	params := app.DistrKeeper.GetParams(ctx)
+	params.AutoCompoundEpoch = 10
+	app.DistrKeeper.SetParams(ctx, params)
+
+	// create validator with 50% commission
+	power := int64(100)
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	valTokens := tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, power, true)
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// next block
+	ctx = ctx.WithBlockHeight(10)
+	tstaking.Ctx = ctx
+
+	// delegate as much as the validator, withdrawing the rewards to addr[2]
+	tstaking.Delegate(addr[1], valAddrs[0], valTokens)
+	require.NoError(t, app.DistrKeeper.SetWithdrawAddr(ctx, addr[1], addr[2]))
+	app.DistrKeeper.SetAutoCompound(ctx, addr[1], true)
+
+	// allocate some rewards
+	initial := sdk.TokensFromConsensusPower(10)
+	tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)}
+	app.DistrKeeper.AllocateTokensToValidator(ctx, app.StakingKeeper.Validator(ctx, valAddrs[0]), tokens)
+
+	// the rewards are neither compounded nor withdrawn
+	withdrawBalance := app.BankKeeper.GetBalance(ctx, addr[2], sdk.DefaultBondDenom)
+	app.DistrKeeper.AutoCompoundRewards(ctx)
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, addr[1], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, valTokens.ToDec(), delegation.Shares)
+	require.Equal(t, withdrawBalance, app.BankKeeper.GetBalance(ctx, addr[2], sdk.DefaultBondDenom))
+
+	// disabling auto-compounding stops the passes
+	params.AutoCompoundEpoch = 0
+	app.DistrKeeper.SetParams(ctx, params)
+	require.NoError(t, app.DistrKeeper.SetWithdrawAddr(ctx, addr[1], addr[1]))
+	app.DistrKeeper.AutoCompoundRewards(ctx)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, addr[1], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, valTokens.ToDec(), delegation.Shares)
+}
+
+func TestAutoCompoundRewardsWithoutDelegations(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000000000))
+
+	params := app.DistrKeeper.GetParams(ctx)
+	params.AutoCompoundEpoch = 10
+	params.MaxAutoCompoundPerBlock = 1
+	app.DistrKeeper.SetParams(ctx, params)
+
+	// opting in without a delegation is rejected
+	msgServer := keeper.NewMsgServerImpl(app.DistrKeeper)
+	_, err := msgServer.SetAutoCompound(sdk.WrapSDKContext(ctx), types.NewMsgSetAutoCompound(addr[0], true))
+	require.ErrorIs(t, err, types.ErrNoDelegationExists)
+	_, err = msgServer.SetAutoCompound(sdk.WrapSDKContext(ctx), types.NewMsgSetAutoCompound(addr[0], false))
+	require.NoError(t, err)
+
+	// delegators opted in without delegations, e.g. through genesis
+	for _, a := range addr {
+		app.DistrKeeper.SetAutoCompound(ctx, a, true)
+	}
+
+	optedIn := func() int {
+		count := 0
+		app.DistrKeeper.IterateAutoCompoundDelegators(ctx, nil, func(sdk.AccAddress) bool {
+			count++
+			return false
+		})
+		return count
+	}
+
+	// every delegator visited counts toward the limit and is opted out
+	for i, height := range []int64{10, 11, 12} {
+		ctx = ctx.WithBlockHeight(height)
+		app.DistrKeeper.AutoCompoundRewards(ctx)
+		require.Equal(t, len(addr)-i-1, optedIn())
+	}
+}
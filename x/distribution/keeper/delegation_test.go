@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -339,6 +340,79 @@ func TestWithdrawDelegationRewardsBasic(t *testing.T) {
 	)
 }
 
+func TestWithdrawTokenizeShareRecordReward(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// set module account coins
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, simapp.FundAccount(app, ctx, distrAcc.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(1000)))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create validator with 50% commission
+	power := int64(100)
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	valTokens := tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, power, true)
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// no tokenize share records owned
+	_, err := app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, addr[2])
+	require.ErrorIs(t, err, types.ErrNoTokenizeShareRecords)
+
+	// delegate as much as the validator and tokenize the delegation, with
+	// addr[2] owning the rewards
+	tstaking.Ctx = ctx
+	tstaking.Delegate(addr[1], valAddrs[0], valTokens)
+	shareToken, err := app.StakingKeeper.TokenizeShares(ctx, addr[1], valAddrs[0], valTokens, addr[2])
+	require.NoError(t, err)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	initial := sdk.TokensFromConsensusPower(10)
+	tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)}
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+
+	// the record gets half of the rewards left after commission
+	ownerBalance := app.BankKeeper.GetBalance(ctx, addr[2], sdk.DefaultBondDenom)
+	rewards, err := app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, addr[2])
+	require.NoError(t, err)
+	require.Equal(t, sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(4))}, rewards)
+	require.Equal(t,
+		ownerBalance.Add(sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(4))),
+		app.BankKeeper.GetBalance(ctx, addr[2], sdk.DefaultBondDenom),
+	)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards again
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+
+	// redeeming all the share tokens sends the pending rewards to the owner
+	ownerBalance = app.BankKeeper.GetBalance(ctx, addr[2], sdk.DefaultBondDenom)
+	_, err = app.StakingKeeper.RedeemTokensForShares(ctx, addr[1], shareToken)
+	require.NoError(t, err)
+	require.Equal(t,
+		ownerBalance.Add(sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(4))),
+		app.BankKeeper.GetBalance(ctx, addr[2], sdk.DefaultBondDenom),
+	)
+
+	_, err = app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, addr[2])
+	require.ErrorIs(t, err, types.ErrNoTokenizeShareRecords)
+}
+
 func TestCalculateRewardsAfterManySlashesInSameBlock(t *testing.T) {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
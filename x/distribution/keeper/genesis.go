@@ -38,6 +38,15 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 
 	k.SetPreviousProposerConsAddr(ctx, previousProposer)
 
+	for _, del := range data.AutoCompoundDelegators {
+		delAddr, err := sdk.AccAddressFromBech32(del)
+		if err != nil {
+			panic(err)
+		}
+
+		k.SetAutoCompound(ctx, delAddr, true)
+	}
+
 	for _, rew := range data.OutstandingRewards {
 		valAddr, err := sdk.ValAddressFromBech32(rew.ValidatorAddress)
 		if err != nil {
@@ -190,5 +199,14 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	autoCompound := make([]string, 0)
+	k.IterateAutoCompoundDelegators(ctx, nil, func(delAddr sdk.AccAddress) (stop bool) {
+		autoCompound = append(autoCompound, delAddr.String())
+		return false
+	})
+
+	genState := types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	genState.AutoCompoundDelegators = autoCompound
+
+	return genState
 }
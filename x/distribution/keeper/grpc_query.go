@@ -248,3 +248,22 @@ func (k Keeper) CommunityPool(c context.Context, req *types.QueryCommunityPoolRe
 
 	return &types.QueryCommunityPoolResponse{Pool: pool}, nil
 }
+
+// AutoCompound queries whether a delegator opted in to auto-compounding
+func (k Keeper) AutoCompound(c context.Context, req *types.QueryAutoCompoundRequest) (*types.QueryAutoCompoundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+	delAdr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryAutoCompoundResponse{Enabled: k.GetAutoCompound(ctx, delAdr)}, nil
+}
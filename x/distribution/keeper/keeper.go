@@ -112,6 +112,54 @@ func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddres
 	return rewards, nil
 }
 
+// WithdrawTokenizeShareRecordReward withdraws the rewards of the delegations
+// of all the tokenize share records of ownerAddr and sends them to ownerAddr,
+// along with the rewards previously withdrawn to the record accounts.
+func (k Keeper) WithdrawTokenizeShareRecordReward(ctx sdk.Context, ownerAddr sdk.AccAddress) (sdk.Coins, error) {
+	records := k.stakingKeeper.GetTokenizeShareRecordsByOwner(ctx, ownerAddr)
+	if len(records) == 0 {
+		return nil, types.ErrNoTokenizeShareRecords
+	}
+
+	totalRewards := sdk.Coins{}
+	for _, record := range records {
+		valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+		if err != nil {
+			return nil, err
+		}
+
+		// the record account cannot change its withdraw address, so the rewards
+		// are withdrawn to the record account itself
+		recordAddr := record.GetModuleAddress()
+		if k.stakingKeeper.Delegation(ctx, recordAddr, valAddr) != nil {
+			if _, err := k.WithdrawDelegationRewards(ctx, recordAddr, valAddr); err != nil {
+				return nil, err
+			}
+		}
+
+		rewards := k.bankKeeper.GetAllBalances(ctx, recordAddr)
+		if rewards.IsZero() {
+			continue
+		}
+
+		if err := k.bankKeeper.SendCoins(ctx, recordAddr, ownerAddr, rewards); err != nil {
+			return nil, err
+		}
+
+		totalRewards = totalRewards.Add(rewards...)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawTokenizeShareReward,
+			sdk.NewAttribute(types.AttributeKeyWithdrawAddress, ownerAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, totalRewards.String()),
+		),
+	)
+
+	return totalRewards, nil
+}
+
 // withdraw validator commission
 func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddress) (sdk.Coins, error) {
 	// fetch validator accumulated commission
@@ -0,0 +1,24 @@
+package v044
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateParams performs in-place params migrations from v0.43 to v0.44. The
+// migration includes:
+//
+// - Add the AutoCompoundEpoch and MaxAutoCompoundPerBlock params with their
+//   default values, unless they were already set by the upgrade handler.
+func MigrateParams(ctx sdk.Context, paramSubspace paramtypes.Subspace) error {
+	if !paramSubspace.Has(ctx, types.ParamStoreKeyAutoCompoundEpoch) {
+		paramSubspace.Set(ctx, types.ParamStoreKeyAutoCompoundEpoch, types.DefaultAutoCompoundEpoch)
+	}
+
+	if !paramSubspace.Has(ctx, types.ParamStoreKeyMaxAutoCompoundPerBlock) {
+		paramSubspace.Set(ctx, types.ParamStoreKeyMaxAutoCompoundPerBlock, types.DefaultMaxAutoCompoundPerBlock)
+	}
+
+	return nil
+}
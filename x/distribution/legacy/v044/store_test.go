@@ -0,0 +1,46 @@
+package v044_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v044distribution "github.com/cosmos/cosmos-sdk/x/distribution/legacy/v044"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	ctx := testutil.DefaultContext(paramsKey, paramsTKey)
+
+	subspace := paramtypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, paramsKey, paramsTKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	// Store the params known before the migration only.
+	old := types.DefaultParams()
+	for _, pair := range old.ParamSetPairs() {
+		if string(pair.Key) == string(types.ParamStoreKeyAutoCompoundEpoch) || string(pair.Key) == string(types.ParamStoreKeyMaxAutoCompoundPerBlock) {
+			continue
+		}
+		subspace.Set(ctx, pair.Key, pair.Value)
+	}
+	require.False(t, subspace.Has(ctx, types.ParamStoreKeyAutoCompoundEpoch))
+
+	// A param set by the upgrade handler is kept.
+	subspace.Set(ctx, types.ParamStoreKeyMaxAutoCompoundPerBlock, uint64(10))
+
+	require.NoError(t, v044distribution.MigrateParams(ctx, subspace))
+
+	var params types.Params
+	subspace.GetParamSet(ctx, &params)
+
+	expected := types.DefaultParams()
+	expected.MaxAutoCompoundPerBlock = 10
+	require.Equal(t, expected, params)
+}
@@ -9,6 +9,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
@@ -18,6 +19,9 @@ const (
 	BaseProposerReward  = "base_proposer_reward"
 	BonusProposerReward = "bonus_proposer_reward"
 	WithdrawEnabled     = "withdraw_enabled"
+
+	AutoCompoundEpoch       = "auto_compound_epoch"
+	MaxAutoCompoundPerBlock = "max_auto_compound_per_block"
 )
 
 // GenCommunityTax randomized CommunityTax
@@ -40,6 +44,17 @@ func GenWithdrawEnabled(r *rand.Rand) bool {
 	return r.Int63n(101) <= 95 // 95% chance of withdraws being enabled
 }
 
+// GenAutoCompoundEpoch returns a randomized AutoCompoundEpoch parameter.
+func GenAutoCompoundEpoch(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 50))
+}
+
+// GenMaxAutoCompoundPerBlock returns a randomized MaxAutoCompoundPerBlock
+// parameter.
+func GenMaxAutoCompoundPerBlock(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 100))
+}
+
 // RandomizedGenState generates a random GenesisState for distribution
 func RandomizedGenState(simState *module.SimulationState) {
 	var communityTax sdk.Dec
@@ -66,6 +81,18 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { withdrawEnabled = GenWithdrawEnabled(r) },
 	)
 
+	var autoCompoundEpoch uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AutoCompoundEpoch, &autoCompoundEpoch, simState.Rand,
+		func(r *rand.Rand) { autoCompoundEpoch = GenAutoCompoundEpoch(r) },
+	)
+
+	var maxAutoCompoundPerBlock uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxAutoCompoundPerBlock, &maxAutoCompoundPerBlock, simState.Rand,
+		func(r *rand.Rand) { maxAutoCompoundPerBlock = GenMaxAutoCompoundPerBlock(r) },
+	)
+
 	distrGenesis := types.GenesisState{
 		FeePool: types.InitialFeePool(),
 		Params: types.Params{
@@ -73,6 +100,9 @@ func RandomizedGenState(simState *module.SimulationState) {
 			BaseProposerReward:  baseProposerReward,
 			BonusProposerReward: bonusProposerReward,
 			WithdrawAddrEnabled: withdrawEnabled,
+
+			AutoCompoundEpoch:       autoCompoundEpoch,
+			MaxAutoCompoundPerBlock: maxAutoCompoundPerBlock,
 		},
 	}
 
@@ -47,6 +47,8 @@ func TestRandomizedGenState(t *testing.T) {
 	require.Equal(t, dec2, distrGenesis.Params.BonusProposerReward)
 	require.Equal(t, dec3, distrGenesis.Params.CommunityTax)
 	require.Equal(t, true, distrGenesis.Params.WithdrawAddrEnabled)
+	require.Equal(t, uint64(7), distrGenesis.Params.AutoCompoundEpoch)
+	require.Equal(t, uint64(22), distrGenesis.Params.MaxAutoCompoundPerBlock)
 	require.Len(t, distrGenesis.DelegatorStartingInfos, 0)
 	require.Len(t, distrGenesis.DelegatorWithdrawInfos, 0)
 	require.Len(t, distrGenesis.ValidatorSlashEvents, 0)
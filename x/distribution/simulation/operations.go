@@ -22,6 +22,7 @@ const (
 	OpWeightMsgWithdrawDelegationReward    = "op_weight_msg_withdraw_delegation_reward"
 	OpWeightMsgWithdrawValidatorCommission = "op_weight_msg_withdraw_validator_commission"
 	OpWeightMsgFundCommunityPool           = "op_weight_msg_fund_community_pool"
+	OpWeightMsgSetAutoCompound             = "op_weight_msg_set_auto_compound"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -58,6 +59,13 @@ func WeightedOperations(
 		},
 	)
 
+	var weightMsgSetAutoCompound int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetAutoCompound, &weightMsgSetAutoCompound, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetAutoCompound = simappparams.DefaultWeightMsgSetAutoCompound
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgSetWithdrawAddress,
@@ -75,6 +83,10 @@ func WeightedOperations(
 			weightMsgFundCommunityPool,
 			SimulateMsgFundCommunityPool(ak, bk, k, sk),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgSetAutoCompound,
+			SimulateMsgSetAutoCompound(ak, bk, k, sk),
+		),
 	}
 }
 
@@ -285,3 +297,49 @@ func SimulateMsgFundCommunityPool(ak types.AccountKeeper, bk types.BankKeeper, k
 		return simtypes.NewOperationMsg(msg, true, "", nil), nil, nil
 	}
 }
+
+// SimulateMsgSetAutoCompound generates a MsgSetAutoCompound with random values.
+func SimulateMsgSetAutoCompound(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, sk stakingkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		enabled := !k.GetAutoCompound(ctx, simAccount.Address)
+		if enabled && len(sk.GetAllDelegatorDelegations(ctx, simAccount.Address)) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetAutoCompound, "number of delegations equal 0"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetAutoCompound, "unable to generate fees"), nil, err
+		}
+
+		// toggle the current setting of the delegator
+		msg := types.NewMsgSetAutoCompound(simAccount.Address, enabled)
+
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+		}
+
+		_, _, err = app.Deliver(txGen.TxEncoder(), tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, "", nil), nil, nil
+	}
+}
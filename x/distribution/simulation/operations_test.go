@@ -41,6 +41,7 @@ func (suite *SimTestSuite) TestWeightedOperations() {
 		{simappparams.DefaultWeightMsgWithdrawDelegationReward, types.ModuleName, types.TypeMsgWithdrawDelegatorReward},
 		{simappparams.DefaultWeightMsgWithdrawValidatorCommission, types.ModuleName, types.TypeMsgWithdrawValidatorCommission},
 		{simappparams.DefaultWeightMsgFundCommunityPool, types.ModuleName, types.TypeMsgFundCommunityPool},
+		{simappparams.DefaultWeightMsgSetAutoCompound, types.ModuleName, types.TypeMsgSetAutoCompound},
 	}
 
 	for i, w := range weightesOps {
@@ -212,6 +213,41 @@ type SimTestSuite struct {
 	app *simapp.SimApp
 }
 
+// TestSimulateMsgSetAutoCompound tests the normal scenario of a valid message of type TypeMsgSetAutoCompound.
+// Abonormal scenarios, where the message is created by an errors, are not tested here.
+func (suite *SimTestSuite) TestSimulateMsgSetAutoCompound() {
+	// setup 3 accounts
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 3)
+
+	// setup accounts[0] as validator and a delegation of every account, as
+	// only delegators can opt in to auto-compounding
+	validator0 := suite.getTestingValidator0(accounts)
+	for _, account := range accounts {
+		delegation := stakingtypes.NewDelegation(account.Address, validator0.GetOperator(), sdk.NewDec(100))
+		suite.app.StakingKeeper.SetDelegation(suite.ctx, delegation)
+	}
+
+	// begin a new block
+	suite.app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: suite.app.LastBlockHeight() + 1, AppHash: suite.app.LastCommitID().Hash}})
+
+	// execute operation
+	op := simulation.SimulateMsgSetAutoCompound(suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.DistrKeeper, suite.app.StakingKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg types.MsgSetAutoCompound
+	types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Equal("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r", msg.DelegatorAddress)
+	suite.Require().True(msg.Enabled)
+	suite.Require().Equal(types.TypeMsgSetAutoCompound, msg.Type())
+	suite.Require().Equal(types.ModuleName, msg.Route())
+	suite.Require().Len(futureOperations, 0)
+}
+
 func (suite *SimTestSuite) SetupTest() {
 	checkTx := false
 	app := simapp.Setup(checkTx)
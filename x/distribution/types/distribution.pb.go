@@ -31,6 +31,13 @@ type Params struct {
 	BaseProposerReward  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_proposer_reward,json=baseProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_proposer_reward" yaml:"base_proposer_reward"`
 	BonusProposerReward github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonus_proposer_reward" yaml:"bonus_proposer_reward"`
 	WithdrawAddrEnabled bool                                   `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty" yaml:"withdraw_addr_enabled"`
+	// auto_compound_epoch is the number of blocks between two passes compounding
+	// the rewards of the delegators who opted in. Zero disables auto-compounding.
+	AutoCompoundEpoch uint64 `protobuf:"varint,5,opt,name=auto_compound_epoch,json=autoCompoundEpoch,proto3" json:"auto_compound_epoch,omitempty" yaml:"auto_compound_epoch"`
+	// max_auto_compound_per_block is the maximum number of delegations whose
+	// rewards are compounded in a block. A pass which does not fit in a block is
+	// continued in the next ones. Zero disables auto-compounding.
+	MaxAutoCompoundPerBlock uint64 `protobuf:"varint,6,opt,name=max_auto_compound_per_block,json=maxAutoCompoundPerBlock,proto3" json:"max_auto_compound_per_block,omitempty" yaml:"max_auto_compound_per_block"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -72,6 +79,20 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetAutoCompoundEpoch() uint64 {
+	if m != nil {
+		return m.AutoCompoundEpoch
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAutoCompoundPerBlock() uint64 {
+	if m != nil {
+		return m.MaxAutoCompoundPerBlock
+	}
+	return 0
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -625,76 +646,81 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 1102 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x24, 0x8e, 0x93, 0x4e, 0xf3, 0xab, 0x13, 0x27, 0x71, 0x93, 0xe0, 0x8d, 0x46, 0x6a,
-	0x15, 0x04, 0x75, 0x9a, 0xf6, 0x82, 0x72, 0x40, 0x8a, 0x9d, 0x44, 0x14, 0x01, 0x8d, 0xb6, 0x01,
-	0x24, 0x2e, 0xd6, 0x78, 0x77, 0x62, 0x8f, 0x62, 0xef, 0x2c, 0x33, 0x63, 0x27, 0x39, 0x20, 0x24,
-	0x4e, 0x5c, 0x10, 0x20, 0x2e, 0x1c, 0x00, 0xe5, 0xc8, 0xaf, 0x3f, 0xa4, 0xc7, 0xde, 0x40, 0x20,
-	0x2d, 0x28, 0x11, 0x12, 0xe2, 0xe8, 0x1b, 0x37, 0xb4, 0x3b, 0xb3, 0xbb, 0xb6, 0x6b, 0xaa, 0xb8,
-	0x52, 0x4f, 0xf6, 0x7e, 0xf3, 0xe6, 0xbd, 0xef, 0xbd, 0xf7, 0xed, 0x7b, 0x0b, 0x4b, 0x0e, 0x97,
-	0x2d, 0x2e, 0x37, 0x5d, 0x26, 0x95, 0x60, 0xb5, 0xb6, 0x62, 0xdc, 0xdb, 0xec, 0x6c, 0xd5, 0xa8,
-	0x22, 0x5b, 0x7d, 0x60, 0xc9, 0x17, 0x5c, 0x71, 0xb4, 0xaa, 0xed, 0x4b, 0x7d, 0x47, 0xc6, 0x7e,
-	0x25, 0x5f, 0xe7, 0x75, 0x1e, 0xd9, 0x6d, 0x86, 0xff, 0xf4, 0x95, 0x95, 0xa2, 0x09, 0x51, 0x23,
-	0x92, 0x26, 0xae, 0x1d, 0xce, 0x8c, 0x4b, 0xfc, 0xcb, 0x38, 0xcc, 0x1d, 0x10, 0x41, 0x5a, 0x12,
-	0x1d, 0xc3, 0x19, 0x87, 0xb7, 0x5a, 0x6d, 0x8f, 0xa9, 0xb3, 0xaa, 0x22, 0xa7, 0x05, 0xb0, 0x0e,
-	0x36, 0xae, 0x95, 0xf7, 0x1f, 0x07, 0x56, 0xe6, 0xb7, 0xc0, 0xba, 0x5d, 0x67, 0xaa, 0xd1, 0xae,
-	0x95, 0x1c, 0xde, 0xda, 0x34, 0x4e, 0xf5, 0xcf, 0x1d, 0xe9, 0x1e, 0x6f, 0xaa, 0x33, 0x9f, 0xca,
-	0xd2, 0x2e, 0x75, 0xba, 0x81, 0x95, 0x3f, 0x23, 0xad, 0xe6, 0x36, 0xee, 0x73, 0x86, 0xed, 0xe9,
-	0xe4, 0xf9, 0x90, 0x9c, 0xa2, 0x8f, 0x61, 0x3e, 0xa4, 0x54, 0xf5, 0x05, 0xf7, 0xb9, 0xa4, 0xa2,
-	0x2a, 0xe8, 0x09, 0x11, 0x6e, 0x61, 0x2c, 0x8a, 0xf9, 0xf6, 0xc8, 0x31, 0x57, 0x75, 0xcc, 0x61,
-	0x3e, 0xb1, 0x8d, 0x42, 0xf8, 0xc0, 0xa0, 0x76, 0x04, 0xa2, 0x4f, 0x00, 0x5c, 0xac, 0x71, 0xaf,
-	0x2d, 0x9f, 0xa2, 0x30, 0x1e, 0x51, 0x78, 0x67, 0x64, 0x0a, 0x6b, 0x86, 0xc2, 0x30, 0xa7, 0xd8,
-	0x5e, 0x88, 0xf0, 0x01, 0x12, 0x87, 0x70, 0xf1, 0x84, 0xa9, 0x86, 0x2b, 0xc8, 0x49, 0x95, 0xb8,
-	0xae, 0xa8, 0x52, 0x8f, 0xd4, 0x9a, 0xd4, 0x2d, 0x64, 0xd7, 0xc1, 0xc6, 0x54, 0x79, 0x3d, 0xf5,
-	0x3a, 0xd4, 0x0c, 0xdb, 0x0b, 0x31, 0xbe, 0xe3, 0xba, 0x62, 0x4f, 0xa3, 0xdb, 0xd9, 0xaf, 0xcf,
-	0xad, 0x0c, 0xfe, 0x7c, 0x0c, 0xae, 0xbc, 0x47, 0x9a, 0xcc, 0x25, 0x8a, 0x8b, 0x37, 0x98, 0x54,
-	0x5c, 0x30, 0x87, 0x34, 0x75, 0x64, 0x89, 0x7e, 0x02, 0x70, 0xd9, 0x69, 0xb7, 0xda, 0x4d, 0xa2,
-	0x58, 0x87, 0x1a, 0x9a, 0x55, 0x41, 0x14, 0xe3, 0x05, 0xb0, 0x3e, 0xbe, 0x71, 0xfd, 0xde, 0x9a,
-	0x91, 0x67, 0x29, 0xac, 0x5e, 0x2c, 0xb3, 0x30, 0xd7, 0x0a, 0x67, 0x5e, 0xf9, 0xdd, 0xb0, 0x3e,
-	0xdd, 0xc0, 0x2a, 0x9a, 0x66, 0x0f, 0x77, 0x85, 0x7f, 0xfc, 0xc3, 0x7a, 0xe5, 0x6a, 0x15, 0x0c,
-	0xbd, 0x4a, 0x7b, 0x31, 0x75, 0xa4, 0x99, 0xda, 0xa1, 0x1b, 0x54, 0x81, 0x73, 0x82, 0x1e, 0x51,
-	0x41, 0x3d, 0x87, 0x56, 0x1d, 0xde, 0xf6, 0x54, 0xa4, 0x94, 0x99, 0xf2, 0x4a, 0x37, 0xb0, 0x96,
-	0x34, 0x85, 0x01, 0x03, 0x6c, 0xcf, 0x26, 0x48, 0x25, 0x02, 0xbe, 0x03, 0x70, 0x39, 0xa9, 0x48,
-	0xa5, 0x2d, 0x04, 0xf5, 0x54, 0x5c, 0x8e, 0x63, 0x38, 0xa9, 0x79, 0xcb, 0x2b, 0x65, 0x7f, 0x3f,
-	0xcc, 0x7e, 0xd4, 0xdc, 0xe2, 0x08, 0x68, 0x09, 0xe6, 0x7c, 0x2a, 0x18, 0xd7, 0x72, 0xcf, 0xda,
-	0xe6, 0x09, 0x7f, 0x05, 0x60, 0x31, 0x21, 0xb8, 0xe3, 0x98, 0x52, 0x50, 0xb7, 0xc2, 0x5b, 0x2d,
-	0x26, 0x25, 0xe3, 0x1e, 0xfa, 0x10, 0x42, 0x27, 0x79, 0x7a, 0x71, 0x54, 0x7b, 0x82, 0xe0, 0x6f,
-	0x00, 0x5c, 0x4d, 0x58, 0x3d, 0x6c, 0x2b, 0xa9, 0x88, 0xe7, 0x32, 0xaf, 0x1e, 0x97, 0xee, 0xa3,
-	0xd1, 0x4a, 0xb7, 0x67, 0x84, 0x33, 0x1b, 0x77, 0x2d, 0xba, 0x8a, 0x9f, 0xb7, 0x98, 0xf8, 0x07,
-	0x00, 0x17, 0x12, 0x7a, 0x8f, 0x9a, 0x44, 0x36, 0xf6, 0x3a, 0xd4, 0x53, 0x68, 0x1f, 0xce, 0x77,
-	0x62, 0xb8, 0x6a, 0xca, 0x1d, 0x4e, 0xb4, 0x6c, 0x79, 0xb5, 0x1b, 0x58, 0xcb, 0x3a, 0xfa, 0xa0,
-	0x05, 0xb6, 0xe7, 0x12, 0xe8, 0x20, 0x42, 0xd0, 0x9b, 0x70, 0xea, 0x48, 0x10, 0x27, 0x9c, 0xb5,
-	0x66, 0x3a, 0x95, 0x46, 0x1b, 0x0d, 0x76, 0x72, 0x1f, 0xff, 0x0c, 0x60, 0x7e, 0x08, 0x57, 0x89,
-	0x3e, 0x03, 0x70, 0x29, 0xe5, 0x22, 0xc3, 0x93, 0x2a, 0x8d, 0x8e, 0x4c, 0x4d, 0xef, 0x96, 0x9e,
-	0x31, 0xfb, 0x4b, 0x43, 0x7c, 0x96, 0x6f, 0x99, 0x3a, 0xbf, 0x34, 0x98, 0x69, 0xaf, 0x77, 0x6c,
-	0xe7, 0x3b, 0x43, 0xf8, 0x98, 0x11, 0xf2, 0x2d, 0x80, 0x93, 0xfb, 0x94, 0x1e, 0x70, 0xde, 0x44,
-	0x5f, 0x02, 0x38, 0x9b, 0x4e, 0x74, 0x9f, 0xf3, 0xe6, 0x95, 0xba, 0xfd, 0x96, 0x61, 0xb1, 0x38,
-	0xb8, 0x13, 0x42, 0x0f, 0x23, 0x37, 0x3d, 0x5d, 0x50, 0x21, 0x27, 0xfc, 0x17, 0x80, 0x2b, 0x95,
-	0x5e, 0xe4, 0x91, 0x4f, 0x3d, 0x57, 0xcf, 0x58, 0xd2, 0x44, 0x79, 0x38, 0xa1, 0x98, 0x6a, 0x52,
-	0xbd, 0xc8, 0x6c, 0xfd, 0x80, 0xd6, 0xe1, 0x75, 0x97, 0x4a, 0x47, 0x30, 0x3f, 0x6d, 0xa9, 0xdd,
-	0x0b, 0xa1, 0x35, 0x78, 0x4d, 0x50, 0x87, 0xf9, 0x8c, 0x7a, 0x4a, 0x6f, 0x03, 0x3b, 0x05, 0x90,
-	0x03, 0x73, 0xa4, 0x15, 0x4d, 0xa0, 0x6c, 0x94, 0xff, 0xcd, 0xa1, 0xf9, 0x47, 0xc9, 0xdf, 0x35,
-	0xaf, 0xde, 0xc6, 0x15, 0x72, 0xd4, 0x09, 0x1a, 0xd7, 0xdb, 0xd3, 0x9f, 0x9e, 0x5b, 0x99, 0xb0,
-	0x07, 0x7f, 0x87, 0x7d, 0xf8, 0x17, 0xc0, 0xc5, 0x5d, 0xda, 0xa4, 0xf5, 0xa8, 0x4d, 0x8a, 0x08,
-	0xc5, 0xbc, 0xfa, 0x03, 0xef, 0x28, 0x9a, 0x8b, 0xbe, 0xa0, 0x1d, 0xc6, 0xc3, 0x95, 0xd3, 0xab,
-	0xf1, 0x9e, 0xb9, 0x38, 0x60, 0x80, 0xed, 0xd9, 0x18, 0x31, 0x0a, 0x3f, 0x84, 0x13, 0x52, 0x91,
-	0x63, 0x6a, 0xe4, 0xfd, 0xfa, 0xc8, 0x9b, 0x6f, 0x5a, 0x07, 0x8a, 0x9c, 0x60, 0x5b, 0x3b, 0x43,
-	0x7b, 0x30, 0xd7, 0xa0, 0xac, 0xde, 0xd0, 0x25, 0xcc, 0x96, 0xef, 0xfc, 0x13, 0x58, 0x73, 0x8e,
-	0xa0, 0xe1, 0x3c, 0xf7, 0xaa, 0xfa, 0x28, 0x25, 0x39, 0x70, 0x80, 0x6d, 0x73, 0x19, 0xff, 0x0e,
-	0xe0, 0x4d, 0x93, 0x3b, 0xe3, 0x5e, 0x52, 0x05, 0xb3, 0x40, 0x1f, 0xc0, 0x1b, 0xa9, 0xb0, 0xc3,
-	0xd5, 0x48, 0xa5, 0x34, 0xdf, 0x2d, 0x6b, 0xdd, 0xc0, 0x2a, 0x0c, 0x6a, 0xdf, 0x98, 0x60, 0x3b,
-	0x9d, 0x0d, 0x3b, 0x1a, 0x42, 0x0c, 0xe6, 0x92, 0x6f, 0x90, 0x17, 0x34, 0x55, 0x4d, 0x80, 0xed,
-	0x29, 0xd3, 0x5d, 0x80, 0xcf, 0xc7, 0xe0, 0xad, 0xff, 0x57, 0xf0, 0xfb, 0x4c, 0x35, 0x76, 0xa9,
-	0xcf, 0x25, 0x53, 0xe8, 0x76, 0x9f, 0x98, 0xcb, 0xf3, 0x69, 0xd9, 0x23, 0x18, 0xc7, 0xf2, 0x7e,
-	0x6d, 0x88, 0xbc, 0xcb, 0x4b, 0xdd, 0xc0, 0x42, 0xda, 0xba, 0xe7, 0x10, 0xf7, 0xcb, 0xfe, 0xde,
-	0x53, 0xb2, 0x2f, 0xe7, 0xbb, 0x81, 0x35, 0x1f, 0xcf, 0x69, 0x73, 0x84, 0x7b, 0x5f, 0x86, 0x97,
-	0x7b, 0x5e, 0x86, 0xf0, 0xc2, 0x8d, 0x6e, 0x60, 0xcd, 0xe8, 0x0b, 0x1a, 0xc7, 0xb1, 0xa4, 0xd1,
-	0xab, 0x70, 0xd2, 0xd5, 0xb9, 0x14, 0x26, 0x22, 0x5b, 0x94, 0x2e, 0x01, 0x73, 0x80, 0xed, 0xd8,
-	0x24, 0x2d, 0x51, 0xf9, 0xe1, 0xf7, 0x17, 0x45, 0xf0, 0xf8, 0xa2, 0x08, 0x9e, 0x5c, 0x14, 0xc1,
-	0x9f, 0x17, 0x45, 0xf0, 0xc5, 0x65, 0x31, 0xf3, 0xe4, 0xb2, 0x98, 0xf9, 0xf5, 0xb2, 0x98, 0xf9,
-	0x60, 0xeb, 0x99, 0xf5, 0x3f, 0xed, 0xff, 0xb4, 0x8e, 0xda, 0x51, 0xcb, 0x45, 0x5f, 0xbe, 0xf7,
-	0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x45, 0x1d, 0xb8, 0x42, 0x7e, 0x0b, 0x00, 0x00,
+	// 1181 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xb4, 0x89, 0xdb, 0x4e, 0xdb, 0xb4, 0x9d, 0x38, 0x89, 0xeb, 0x04, 0xaf, 0x35, 0x52,
+	0xab, 0x20, 0xa8, 0xd3, 0x8f, 0x0b, 0xca, 0x01, 0x29, 0xeb, 0xa6, 0xa2, 0x08, 0xda, 0x68, 0x5b,
+	0x40, 0xe2, 0xb2, 0x1a, 0xef, 0x4e, 0xed, 0x51, 0x76, 0x77, 0x96, 0x99, 0xb1, 0x9b, 0x1e, 0x10,
+	0x12, 0x27, 0x2e, 0x15, 0x20, 0x2e, 0x1c, 0x00, 0xf5, 0xc8, 0xd7, 0x1f, 0xd2, 0x63, 0x8f, 0x08,
+	0xa4, 0x05, 0xa5, 0x42, 0x42, 0x1c, 0x7d, 0xe3, 0x86, 0x76, 0x67, 0x76, 0xd7, 0x76, 0x4d, 0x14,
+	0x23, 0xf5, 0x94, 0xec, 0x6f, 0xde, 0xfe, 0xde, 0xef, 0x7d, 0xcc, 0x7b, 0x6b, 0xd8, 0xf2, 0xb8,
+	0x0c, 0xb9, 0xdc, 0xf4, 0x99, 0x54, 0x82, 0x75, 0xfa, 0x8a, 0xf1, 0x68, 0x73, 0x70, 0xad, 0x43,
+	0x15, 0xb9, 0x36, 0x06, 0xb6, 0x62, 0xc1, 0x15, 0x47, 0x6b, 0xda, 0xbe, 0x35, 0x76, 0x64, 0xec,
+	0xeb, 0xd5, 0x2e, 0xef, 0xf2, 0xcc, 0x6e, 0x33, 0xfd, 0x4f, 0xbf, 0x52, 0x6f, 0x18, 0x17, 0x1d,
+	0x22, 0x69, 0x41, 0xed, 0x71, 0x66, 0x28, 0xf1, 0xe3, 0x05, 0x58, 0xd9, 0x25, 0x82, 0x84, 0x12,
+	0xed, 0xc1, 0xb3, 0x1e, 0x0f, 0xc3, 0x7e, 0xc4, 0xd4, 0x23, 0x57, 0x91, 0xfd, 0x1a, 0x68, 0x82,
+	0x8d, 0x53, 0xf6, 0xad, 0xa7, 0x89, 0x35, 0xf7, 0x6b, 0x62, 0x5d, 0xee, 0x32, 0xd5, 0xeb, 0x77,
+	0x5a, 0x1e, 0x0f, 0x37, 0x0d, 0xa9, 0xfe, 0x73, 0x45, 0xfa, 0x7b, 0x9b, 0xea, 0x51, 0x4c, 0x65,
+	0xeb, 0x26, 0xf5, 0x86, 0x89, 0x55, 0x7d, 0x44, 0xc2, 0x60, 0x0b, 0x8f, 0x91, 0x61, 0xe7, 0x4c,
+	0xf1, 0x7c, 0x9f, 0xec, 0xa3, 0x4f, 0x60, 0x35, 0x95, 0xe4, 0xc6, 0x82, 0xc7, 0x5c, 0x52, 0xe1,
+	0x0a, 0xfa, 0x90, 0x08, 0xbf, 0x76, 0x2c, 0xf3, 0xf9, 0xee, 0xcc, 0x3e, 0xd7, 0xb4, 0xcf, 0x69,
+	0x9c, 0xd8, 0x41, 0x29, 0xbc, 0x6b, 0x50, 0x27, 0x03, 0xd1, 0xa7, 0x00, 0x2e, 0x77, 0x78, 0xd4,
+	0x97, 0x2f, 0x48, 0x38, 0x9e, 0x49, 0xb8, 0x33, 0xb3, 0x84, 0x75, 0x23, 0x61, 0x1a, 0x29, 0x76,
+	0x96, 0x32, 0x7c, 0x42, 0xc4, 0x7d, 0xb8, 0xfc, 0x90, 0xa9, 0x9e, 0x2f, 0xc8, 0x43, 0x97, 0xf8,
+	0xbe, 0x70, 0x69, 0x44, 0x3a, 0x01, 0xf5, 0x6b, 0xf3, 0x4d, 0xb0, 0x71, 0xd2, 0x6e, 0x96, 0xac,
+	0x53, 0xcd, 0xb0, 0xb3, 0x94, 0xe3, 0xdb, 0xbe, 0x2f, 0x76, 0x34, 0x8a, 0xee, 0xc0, 0x25, 0xd2,
+	0x57, 0xdc, 0xf5, 0x78, 0x18, 0xf3, 0x7e, 0xe4, 0xbb, 0x34, 0xe6, 0x5e, 0xaf, 0xb6, 0xd0, 0x04,
+	0x1b, 0xf3, 0x76, 0x63, 0x98, 0x58, 0x75, 0xcd, 0x39, 0xc5, 0x08, 0x3b, 0x17, 0x52, 0xb4, 0x6d,
+	0xc0, 0x9d, 0x14, 0x43, 0x3e, 0x5c, 0x0b, 0xc9, 0xbe, 0x3b, 0x6e, 0x1e, 0x53, 0xe1, 0x76, 0x02,
+	0xee, 0xed, 0xd5, 0x2a, 0x19, 0xef, 0xe5, 0x61, 0x62, 0x61, 0xcd, 0x7b, 0x88, 0x31, 0x76, 0x56,
+	0x43, 0xb2, 0xbf, 0x3d, 0xe2, 0x62, 0x97, 0x0a, 0x3b, 0x3d, 0xd9, 0x9a, 0xff, 0xfa, 0x89, 0x35,
+	0x87, 0x3f, 0x3f, 0x06, 0xeb, 0xef, 0x93, 0x80, 0xf9, 0x44, 0x71, 0xf1, 0x16, 0x93, 0x8a, 0x0b,
+	0xe6, 0x91, 0x40, 0xe7, 0x4b, 0xa2, 0x9f, 0x00, 0x5c, 0xf5, 0xfa, 0x61, 0x3f, 0x20, 0x8a, 0x0d,
+	0xa8, 0x49, 0xae, 0x2b, 0x88, 0x62, 0xbc, 0x06, 0x9a, 0xc7, 0x37, 0x4e, 0x5f, 0x5f, 0x37, 0x97,
+	0xaa, 0x95, 0xd6, 0x3c, 0xbf, 0x1c, 0x69, 0x85, 0xda, 0x9c, 0x45, 0xf6, 0x7b, 0x69, 0x55, 0x87,
+	0x89, 0xd5, 0x30, 0x2d, 0x3a, 0x9d, 0x0a, 0xff, 0xf8, 0xbb, 0xf5, 0xda, 0xd1, 0xea, 0x9e, 0xb2,
+	0x4a, 0x67, 0xb9, 0x24, 0xd2, 0x4a, 0x9d, 0x94, 0x06, 0xb5, 0xe1, 0x39, 0x41, 0x1f, 0x50, 0x41,
+	0x23, 0x8f, 0xba, 0x1e, 0xef, 0x47, 0x2a, 0xeb, 0xef, 0xb3, 0x76, 0x7d, 0x98, 0x58, 0x2b, 0x5a,
+	0xc2, 0x84, 0x01, 0x76, 0x16, 0x0b, 0xa4, 0x9d, 0x01, 0xdf, 0x01, 0xb8, 0x5a, 0x64, 0xa4, 0xdd,
+	0x17, 0x82, 0x46, 0x2a, 0x4f, 0xc7, 0x1e, 0x3c, 0xa1, 0x75, 0xcb, 0x23, 0x45, 0x7f, 0x23, 0x8d,
+	0x7e, 0xd6, 0xd8, 0x72, 0x0f, 0x68, 0x05, 0x56, 0x62, 0x2a, 0x18, 0xd7, 0x97, 0x74, 0xde, 0x31,
+	0x4f, 0xf8, 0x2b, 0x00, 0x1b, 0x85, 0xc0, 0x6d, 0xcf, 0xa4, 0x82, 0xfa, 0x6d, 0x1e, 0x86, 0x4c,
+	0x4a, 0xc6, 0x23, 0xf4, 0x11, 0x84, 0x5e, 0xf1, 0xf4, 0xf2, 0xa4, 0x8e, 0x38, 0xc1, 0xdf, 0x00,
+	0xb8, 0x56, 0xa8, 0xba, 0xdb, 0x57, 0x52, 0x91, 0xc8, 0x67, 0x51, 0x37, 0x4f, 0xdd, 0xc7, 0xb3,
+	0xa5, 0x6e, 0xc7, 0x34, 0xce, 0x62, 0x5e, 0xb5, 0xec, 0x55, 0xfc, 0x7f, 0x93, 0x89, 0x7f, 0x00,
+	0x70, 0xa9, 0x90, 0x77, 0x2f, 0x20, 0xb2, 0xb7, 0x33, 0xa0, 0x91, 0x42, 0xb7, 0xe0, 0xf9, 0x41,
+	0x0e, 0xbb, 0x26, 0xdd, 0x20, 0xbb, 0x60, 0x6b, 0xc3, 0xc4, 0x5a, 0xd5, 0xde, 0x27, 0x2d, 0xb0,
+	0x73, 0xae, 0x80, 0x76, 0x33, 0x04, 0xbd, 0x0d, 0x4f, 0x3e, 0x10, 0xc4, 0x4b, 0x37, 0x84, 0x99,
+	0xa9, 0xad, 0xd9, 0x06, 0x9a, 0x53, 0xbc, 0x8f, 0x7f, 0x06, 0xb0, 0x3a, 0x45, 0xab, 0x44, 0x8f,
+	0x01, 0x5c, 0x29, 0xb5, 0xc8, 0xf4, 0xc4, 0xa5, 0xd9, 0x91, 0xc9, 0xe9, 0xd5, 0xd6, 0x21, 0x1b,
+	0xab, 0x35, 0x85, 0xd3, 0xbe, 0x64, 0xf2, 0xfc, 0xca, 0x64, 0xa4, 0xa3, 0xec, 0xd8, 0xa9, 0x0e,
+	0xa6, 0xe8, 0x31, 0x23, 0xe4, 0x5b, 0x00, 0x4f, 0xdc, 0xa2, 0x74, 0x97, 0xf3, 0x00, 0x7d, 0x09,
+	0xe0, 0x62, 0xb9, 0x87, 0x62, 0xce, 0x83, 0x23, 0x55, 0xfb, 0x1d, 0xa3, 0x62, 0x79, 0x72, 0x93,
+	0xa5, 0x0c, 0x33, 0x17, 0xbd, 0x5c, 0xab, 0xa9, 0x26, 0xfc, 0x27, 0x80, 0xf5, 0xf6, 0x28, 0x72,
+	0x2f, 0xa6, 0x91, 0xaf, 0x37, 0x03, 0x09, 0x50, 0x15, 0x2e, 0x28, 0xa6, 0x02, 0xaa, 0xd7, 0xaf,
+	0xa3, 0x1f, 0x50, 0x13, 0x9e, 0xf6, 0xa9, 0xf4, 0x04, 0x8b, 0xcb, 0x92, 0x3a, 0xa3, 0x10, 0x5a,
+	0x87, 0xa7, 0x04, 0xf5, 0x58, 0xcc, 0x68, 0xa4, 0xf4, 0x0e, 0x73, 0x4a, 0x00, 0x79, 0xb0, 0x42,
+	0xc2, 0x6c, 0x02, 0xcd, 0x67, 0xf1, 0x5f, 0x9c, 0x1a, 0x7f, 0x16, 0xfc, 0x55, 0x73, 0xf5, 0x36,
+	0x8e, 0x10, 0xa3, 0x0e, 0xd0, 0x50, 0x6f, 0x9d, 0xf9, 0xec, 0x89, 0x35, 0x97, 0xd6, 0xe0, 0xaf,
+	0xb4, 0x0e, 0xff, 0x00, 0xb8, 0x7c, 0x93, 0x06, 0xb4, 0x9b, 0x95, 0x49, 0x11, 0xa1, 0x58, 0xd4,
+	0xbd, 0x1d, 0x3d, 0xc8, 0xe6, 0x62, 0x2c, 0xe8, 0x80, 0xf1, 0x74, 0x51, 0x8e, 0xf6, 0xf8, 0xc8,
+	0x5c, 0x9c, 0x30, 0xc0, 0xce, 0x62, 0x8e, 0x98, 0x0e, 0xbf, 0x0f, 0x17, 0xa4, 0x22, 0x7b, 0xd4,
+	0xb4, 0xf7, 0x9b, 0x33, 0xef, 0xeb, 0x33, 0xda, 0x51, 0x46, 0x82, 0x1d, 0x4d, 0x86, 0x76, 0x60,
+	0xa5, 0x47, 0x59, 0xb7, 0xa7, 0x53, 0x38, 0x6f, 0x5f, 0xf9, 0x3b, 0xb1, 0xce, 0x79, 0x82, 0xa6,
+	0xf3, 0x3c, 0x72, 0xf5, 0x51, 0x29, 0x72, 0xe2, 0x00, 0x3b, 0xe6, 0x65, 0xfc, 0x1b, 0x80, 0x17,
+	0x4d, 0xec, 0x8c, 0x47, 0x45, 0x16, 0xcc, 0xda, 0xbf, 0x0d, 0x2f, 0x94, 0x8d, 0x9d, 0x2e, 0x74,
+	0x2a, 0xa5, 0xf9, 0xda, 0x5a, 0x1f, 0x26, 0x56, 0x6d, 0xb2, 0xf7, 0x8d, 0x09, 0x76, 0xca, 0xd9,
+	0xb0, 0xad, 0x21, 0xc4, 0x60, 0xa5, 0xf8, 0x72, 0x7a, 0x49, 0x53, 0xd5, 0x38, 0xd8, 0x3a, 0x69,
+	0xaa, 0x0b, 0xf0, 0x93, 0x63, 0xf0, 0xd2, 0x7f, 0x77, 0xf0, 0x07, 0x4c, 0xf5, 0x6e, 0xd2, 0x98,
+	0x4b, 0xa6, 0xd0, 0xe5, 0xb1, 0x66, 0xb6, 0xcf, 0x97, 0x69, 0xcf, 0x60, 0x9c, 0xb7, 0xf7, 0x1b,
+	0x53, 0xda, 0xdb, 0x5e, 0x19, 0x26, 0x16, 0xd2, 0xd6, 0x23, 0x87, 0x78, 0xbc, 0xed, 0xaf, 0xbf,
+	0xd0, 0xf6, 0x76, 0x75, 0x98, 0x58, 0xe7, 0xf3, 0x39, 0x6d, 0x8e, 0xf0, 0xe8, 0x65, 0x78, 0x75,
+	0xe4, 0x32, 0xa4, 0x2f, 0x5c, 0x18, 0x26, 0xd6, 0x59, 0xfd, 0x82, 0xc6, 0x71, 0xde, 0xd2, 0xe8,
+	0x75, 0x78, 0xc2, 0xd7, 0xb1, 0x64, 0xdf, 0x4f, 0xa7, 0x6c, 0x54, 0x2e, 0x01, 0x73, 0x80, 0x9d,
+	0xdc, 0xa4, 0x4c, 0x91, 0x7d, 0xf7, 0xfb, 0x83, 0x06, 0x78, 0x7a, 0xd0, 0x00, 0xcf, 0x0e, 0x1a,
+	0xe0, 0x8f, 0x83, 0x06, 0xf8, 0xe2, 0x79, 0x63, 0xee, 0xd9, 0xf3, 0xc6, 0xdc, 0x2f, 0xcf, 0x1b,
+	0x73, 0x1f, 0x5e, 0x3b, 0x34, 0xff, 0xfb, 0xe3, 0x3f, 0x08, 0xb2, 0x72, 0x74, 0x2a, 0xd9, 0xf7,
+	0xfa, 0x8d, 0x7f, 0x07, 0x00, 0xee, 0x46, 0xf0, 0x2a, 0x34, 0x0c, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -728,6 +754,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if this.AutoCompoundEpoch != that1.AutoCompoundEpoch {
+		return false
+	}
+	if this.MaxAutoCompoundPerBlock != that1.MaxAutoCompoundPerBlock {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1055,6 +1087,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxAutoCompoundPerBlock != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.MaxAutoCompoundPerBlock))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AutoCompoundEpoch != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1597,6 +1639,12 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	if m.AutoCompoundEpoch != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundEpoch))
+	}
+	if m.MaxAutoCompoundPerBlock != 0 {
+		n += 1 + sovDistribution(uint64(m.MaxAutoCompoundPerBlock))
+	}
 	return n
 }
 
@@ -1959,6 +2007,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundEpoch", wireType)
+			}
+			m.AutoCompoundEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAutoCompoundPerBlock", wireType)
+			}
+			m.MaxAutoCompoundPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAutoCompoundPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -346,6 +346,9 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos" yaml:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events" yaml:"validator_slash_events"`
+	// auto_compound_delegators defines the delegators who opted in to
+	// auto-compounding at genesis.
+	AutoCompoundDelegators []string `protobuf:"bytes,11,rep,name=auto_compound_delegators,json=autoCompoundDelegators,proto3" json:"auto_compound_delegators,omitempty" yaml:"auto_compound_delegators"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -397,71 +400,74 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 1024 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
-	0x1c, 0xf5, 0x3a, 0x25, 0x49, 0x27, 0x29, 0x0d, 0xdb, 0x7c, 0xb8, 0x4e, 0xea, 0x4d, 0xa7, 0x45,
-	0x04, 0x55, 0xac, 0x9b, 0x80, 0x00, 0x05, 0x81, 0x94, 0x4d, 0x29, 0xf4, 0xd4, 0x30, 0x91, 0x00,
-	0x71, 0xb1, 0xd6, 0xbb, 0x63, 0x7b, 0x84, 0xbd, 0x63, 0xcd, 0x8c, 0x1d, 0xc2, 0x3f, 0x00, 0x47,
-	0x24, 0xc4, 0xa9, 0x1c, 0x72, 0x44, 0x88, 0x63, 0xef, 0x5c, 0x7b, 0xec, 0x91, 0x03, 0x0a, 0x28,
-	0xb9, 0x70, 0xce, 0x81, 0x03, 0x27, 0xb4, 0x33, 0xb3, 0x5f, 0xf6, 0xda, 0x38, 0xa1, 0x39, 0x25,
-	0x1e, 0xff, 0xf6, 0xbd, 0xf7, 0x7b, 0xf3, 0xfb, 0x58, 0x83, 0xd7, 0x3d, 0xca, 0x3b, 0x94, 0x57,
-	0x7d, 0xc2, 0x05, 0x23, 0xf5, 0x9e, 0x20, 0x34, 0xa8, 0xf6, 0x37, 0xeb, 0x58, 0xb8, 0x9b, 0xd5,
-	0x26, 0x0e, 0x30, 0x27, 0xdc, 0xee, 0x32, 0x2a, 0xa8, 0xb9, 0xaa, 0x42, 0xed, 0x74, 0xa8, 0xad,
-	0x43, 0xcb, 0x8b, 0x4d, 0xda, 0xa4, 0x32, 0xae, 0x1a, 0xfe, 0xa7, 0x1e, 0x29, 0x57, 0x34, 0x7a,
-	0xdd, 0xe5, 0x38, 0x46, 0xf5, 0x28, 0x09, 0xf4, 0xf7, 0xf6, 0x38, 0xf6, 0x0c, 0x8f, 0x8c, 0x87,
-	0x4f, 0x0d, 0xb0, 0xf4, 0x00, 0xb7, 0x71, 0xd3, 0x15, 0x94, 0x7d, 0x46, 0x44, 0xcb, 0x67, 0xee,
-	0xc1, 0xa3, 0xa0, 0x41, 0xcd, 0x47, 0xe0, 0x15, 0x3f, 0xfa, 0xa2, 0xe6, 0xfa, 0x3e, 0xc3, 0x9c,
-	0x97, 0x8c, 0x75, 0x63, 0xe3, 0xaa, 0xb3, 0x76, 0x76, 0x6c, 0x95, 0x0e, 0xdd, 0x4e, 0x7b, 0x1b,
-	0x0e, 0x85, 0x40, 0xb4, 0x10, 0x9f, 0xed, 0xa8, 0x23, 0xf3, 0x21, 0x58, 0x38, 0xd0, 0xd0, 0x31,
-	0x52, 0x51, 0x22, 0xad, 0x9e, 0x1d, 0x5b, 0x2b, 0x0a, 0x69, 0x30, 0x02, 0xa2, 0xeb, 0xd1, 0x91,
-	0xc6, 0xd9, 0x9e, 0xfd, 0xf6, 0xc8, 0x2a, 0xfc, 0x75, 0x64, 0x15, 0xe0, 0x93, 0x22, 0xb8, 0xfd,
-	0xa9, 0xdb, 0x26, 0x7e, 0x48, 0xf3, 0xb8, 0x27, 0xb8, 0x70, 0x03, 0x9f, 0x04, 0x4d, 0x84, 0x0f,
-	0x5c, 0xe6, 0x73, 0x84, 0x3d, 0xca, 0xfc, 0x30, 0x85, 0x7e, 0x14, 0x34, 0x3a, 0x85, 0xa1, 0x10,
-	0x88, 0x16, 0xe2, 0xb3, 0x28, 0x85, 0x23, 0x03, 0xdc, 0xa0, 0x09, 0x4f, 0x8d, 0x29, 0xa2, 0x52,
-	0x71, 0x7d, 0x6a, 0x63, 0x6e, 0x6b, 0x4d, 0xdb, 0x6e, 0x87, 0xd7, 0x12, 0xdd, 0xa0, 0xfd, 0x00,
-	0x7b, 0xbb, 0x94, 0x04, 0xce, 0x27, 0xcf, 0x8e, 0xad, 0xc2, 0xd9, 0xb1, 0x55, 0x56, 0x7c, 0x39,
-	0x30, 0xf0, 0xe7, 0x3f, 0xac, 0x7b, 0x4d, 0x22, 0x5a, 0xbd, 0xba, 0xed, 0xd1, 0x4e, 0x55, 0x5f,
-	0xa2, 0xfa, 0xf3, 0x06, 0xf7, 0xbf, 0xac, 0x8a, 0xc3, 0x2e, 0xe6, 0x11, 0x22, 0x47, 0x26, 0x1d,
-	0xca, 0x39, 0xe5, 0xce, 0xdf, 0x06, 0xb8, 0x1b, 0xbb, 0xb3, 0xe3, 0x79, 0xbd, 0x4e, 0xaf, 0xed,
-	0x0a, 0xec, 0xef, 0xd2, 0x4e, 0x87, 0x70, 0x4e, 0x68, 0xf0, 0xe2, 0x0d, 0x3a, 0x04, 0x73, 0x6e,
-	0xc2, 0x24, 0xaf, 0x77, 0x6e, 0xeb, 0x3d, 0x7b, 0x4c, 0x85, 0xdb, 0xe3, 0x25, 0x3a, 0x65, 0x6d,
-	0x9b, 0xa9, 0x54, 0xa4, 0xd0, 0x21, 0x4a, 0x73, 0xa5, 0x12, 0xff, 0xc7, 0x00, 0xeb, 0x31, 0xea,
-	0xc7, 0x84, 0x0b, 0xca, 0x88, 0xe7, 0xb6, 0x2f, 0xad, 0x2a, 0x96, 0xc1, 0x74, 0x17, 0x33, 0x42,
-	0x55, 0xbe, 0x57, 0x90, 0xfe, 0x64, 0x12, 0x30, 0x13, 0x15, 0xc8, 0x94, 0x34, 0xe2, 0x9d, 0xc9,
-	0x8c, 0x18, 0x92, 0xec, 0x2c, 0x6b, 0x13, 0x5e, 0x56, 0xaa, 0xa2, 0x7a, 0x41, 0x11, 0x7e, 0x2a,
-	0xf9, 0xdf, 0x0d, 0x70, 0x2b, 0x46, 0xda, 0xed, 0x31, 0x86, 0x03, 0x71, 0x69, 0x99, 0x37, 0x92,
-	0x0c, 0xd5, 0x55, 0xbf, 0x35, 0x59, 0x86, 0x59, 0x5d, 0xe7, 0x49, 0xef, 0x69, 0x11, 0xac, 0xc6,
-	0x93, 0x6a, 0x5f, 0xb8, 0x4c, 0x90, 0xa0, 0x19, 0x4e, 0xaa, 0x24, 0xb9, 0x17, 0x35, 0xaf, 0x72,
-	0x7d, 0x2a, 0x5e, 0xc8, 0xa7, 0x1e, 0xb8, 0xc6, 0xb5, 0xd6, 0x1a, 0x09, 0x1a, 0x54, 0xd7, 0xc3,
-	0xd6, 0x58, 0xb7, 0x72, 0xd3, 0x74, 0xd6, 0xb4, 0x57, 0x8b, 0x8a, 0x3e, 0x03, 0x0b, 0xd1, 0x3c,
-	0x4f, 0xc5, 0xa6, 0x6c, 0xfb, 0xb1, 0x08, 0x6e, 0xc6, 0xee, 0xef, 0xb7, 0x5d, 0xde, 0xfa, 0xb0,
-	0x2f, 0x2f, 0xe0, 0x12, 0x7a, 0xa1, 0x85, 0x49, 0xb3, 0x25, 0xa2, 0x5e, 0x50, 0x9f, 0x52, 0x3d,
-	0x32, 0x95, 0xe9, 0x91, 0xaf, 0xc1, 0x52, 0x82, 0xcb, 0x43, 0x61, 0x35, 0x1c, 0x2a, 0x2b, 0x5d,
-	0x91, 0x0e, 0xdd, 0x9f, 0xac, 0x9e, 0x92, 0x8c, 0x9c, 0x45, 0xed, 0xcf, 0xbc, 0x12, 0x2d, 0xc1,
-	0x20, 0xba, 0xd1, 0x1f, 0x0e, 0x4d, 0xd9, 0xf3, 0xcd, 0x1c, 0x98, 0xff, 0x48, 0x2d, 0xe5, 0x7d,
-	0xe1, 0x0a, 0x6c, 0x22, 0x30, 0xdd, 0x75, 0x99, 0xdb, 0x51, 0x36, 0xcc, 0x6d, 0xdd, 0x19, 0xab,
-	0x63, 0x4f, 0x86, 0x3a, 0x4b, 0x9a, 0xfa, 0x9a, 0xa2, 0x56, 0x00, 0x10, 0x69, 0x24, 0xf3, 0x73,
-	0x30, 0xdb, 0xc0, 0xb8, 0xd6, 0xa5, 0xb4, 0xad, 0xbb, 0xe5, 0xee, 0x58, 0xd4, 0x87, 0x18, 0xef,
-	0x51, 0xda, 0x76, 0x56, 0x34, 0xec, 0x75, 0x05, 0x1b, 0x61, 0x40, 0x34, 0xd3, 0x50, 0x11, 0xe6,
-	0x0f, 0x06, 0x28, 0x25, 0x25, 0x1d, 0xaf, 0xd0, 0xb0, 0x24, 0xc2, 0xd1, 0x33, 0x35, 0x79, 0xa9,
-	0xa5, 0x77, 0xbf, 0xf3, 0x9a, 0x26, 0xb6, 0x06, 0x9b, 0x26, 0xcb, 0x00, 0xd1, 0xb2, 0x9f, 0xf7,
-	0xbc, 0xec, 0xa0, 0x2e, 0xc3, 0x7d, 0x42, 0x7b, 0xbc, 0xd6, 0x65, 0xb4, 0x4b, 0x39, 0x66, 0xf2,
-	0x62, 0x33, 0x75, 0x35, 0x14, 0x02, 0xd1, 0x42, 0x74, 0xb6, 0xa7, 0x8f, 0xcc, 0xef, 0x47, 0x6c,
-	0xde, 0x97, 0x64, 0x76, 0x1f, 0x4c, 0x56, 0x26, 0xa3, 0x5e, 0x11, 0x1c, 0xf8, 0xdf, 0xbb, 0x39,
-	0x6f, 0xd9, 0x9a, 0xbf, 0x1a, 0xe0, 0x76, 0xaa, 0x2d, 0x92, 0x6d, 0x54, 0xf3, 0xe2, 0x0d, 0xc6,
-	0x4b, 0xd3, 0x52, 0xe3, 0xce, 0xff, 0xd8, 0x82, 0x5a, 0xe6, 0x7d, 0x2d, 0x73, 0x63, 0xa8, 0x21,
-	0xf3, 0x99, 0x21, 0xb2, 0xfa, 0x63, 0x71, 0xb9, 0xf9, 0x8b, 0x01, 0xd6, 0x12, 0x9c, 0x56, 0xbc,
-	0x79, 0x62, 0x83, 0x67, 0xa4, 0xf8, 0xf7, 0x2f, 0xb8, 0xb9, 0xb4, 0xf0, 0x7b, 0x5a, 0xf8, 0x9d,
-	0x41, 0xe1, 0xc3, 0x84, 0x10, 0x95, 0xfb, 0x23, 0xe1, 0xc2, 0x17, 0xb0, 0x9b, 0xc9, 0xd3, 0x9e,
-	0x5a, 0x23, 0xb1, 0xd6, 0x59, 0xa9, 0x75, 0xfb, 0x22, 0x3b, 0x48, 0x0b, 0xdd, 0xd0, 0x42, 0xd7,
-	0x07, 0x85, 0x0e, 0x50, 0x41, 0xb4, 0xd2, 0xcf, 0x07, 0x32, 0x9f, 0x64, 0x9a, 0x31, 0x33, 0x9f,
-	0x79, 0xe9, 0xaa, 0x54, 0xf8, 0xee, 0xf9, 0xe7, 0xbe, 0xd6, 0x37, 0xb2, 0x25, 0xb3, 0x3c, 0xe9,
-	0x96, 0x4c, 0xa3, 0xf0, 0xb0, 0x8f, 0x96, 0x73, 0x07, 0x2e, 0x2f, 0x01, 0xa9, 0xed, 0xed, 0xf3,
-	0x4e, 0x5c, 0xad, 0xec, 0x55, 0xad, 0xec, 0xd6, 0xa0, 0x73, 0x69, 0x0e, 0x88, 0x16, 0x73, 0x06,
-	0x71, 0x6a, 0xbf, 0x3b, 0x8f, 0x7f, 0x3a, 0xa9, 0x18, 0xcf, 0x4e, 0x2a, 0xc6, 0xf3, 0x93, 0x8a,
-	0xf1, 0xe7, 0x49, 0xc5, 0xf8, 0xee, 0xb4, 0x52, 0x78, 0x7e, 0x5a, 0x29, 0xfc, 0x76, 0x5a, 0x29,
-	0x7c, 0xb1, 0x39, 0xf6, 0xed, 0xf8, 0xab, 0xec, 0xef, 0x1d, 0xf9, 0xb2, 0x5c, 0x9f, 0x96, 0xbf,
-	0x70, 0xde, 0xfc, 0x37, 0x00, 0x00, 0xff, 0xff, 0xa8, 0xee, 0xfe, 0x4a, 0x91, 0x0d, 0x00, 0x00,
+	// 1060 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x3a, 0x21, 0x3f, 0xc6, 0x29, 0x0d, 0xdb, 0xc4, 0xd9, 0x3a, 0xa9, 0xed, 0x4e, 0x8a,
+	0x30, 0xaa, 0xb0, 0x9b, 0x80, 0x00, 0x05, 0x81, 0x94, 0x4d, 0x29, 0xf4, 0xd4, 0x30, 0x91, 0x00,
+	0x21, 0x21, 0x6b, 0xbd, 0x3b, 0xb6, 0x47, 0xd8, 0x3b, 0xd6, 0xce, 0xac, 0x43, 0xf8, 0x0b, 0x38,
+	0x22, 0x21, 0x4e, 0xe5, 0x90, 0x23, 0x42, 0x1c, 0x7b, 0xe7, 0xda, 0x63, 0x8f, 0x1c, 0x50, 0x40,
+	0x89, 0x84, 0x38, 0xe7, 0xc0, 0x81, 0x13, 0xda, 0x99, 0xd9, 0x1f, 0xb6, 0xd7, 0xc6, 0x49, 0x9b,
+	0x53, 0xe2, 0xd9, 0xb7, 0xdf, 0xf7, 0xbd, 0x6f, 0xde, 0x9b, 0x37, 0x0b, 0x5e, 0xb7, 0x29, 0xeb,
+	0x52, 0x56, 0x73, 0x08, 0xe3, 0x1e, 0x69, 0xf8, 0x9c, 0x50, 0xb7, 0xd6, 0xdf, 0x6a, 0x60, 0x6e,
+	0x6d, 0xd5, 0x5a, 0xd8, 0xc5, 0x8c, 0xb0, 0x6a, 0xcf, 0xa3, 0x9c, 0xea, 0xeb, 0x32, 0xb4, 0x9a,
+	0x0c, 0xad, 0xaa, 0xd0, 0xc2, 0x4a, 0x8b, 0xb6, 0xa8, 0x88, 0xab, 0x05, 0xff, 0xc9, 0x57, 0x0a,
+	0x45, 0x85, 0xde, 0xb0, 0x18, 0x8e, 0x50, 0x6d, 0x4a, 0x5c, 0xf5, 0xbc, 0x3a, 0x89, 0x7d, 0x80,
+	0x47, 0xc4, 0xc3, 0x27, 0x1a, 0x58, 0xbd, 0x8f, 0x3b, 0xb8, 0x65, 0x71, 0xea, 0x7d, 0x46, 0x78,
+	0xdb, 0xf1, 0xac, 0xc3, 0x87, 0x6e, 0x93, 0xea, 0x0f, 0xc1, 0x2b, 0x4e, 0xf8, 0xa0, 0x6e, 0x39,
+	0x8e, 0x87, 0x19, 0x33, 0xb4, 0xb2, 0x56, 0x59, 0x34, 0x37, 0xce, 0x4f, 0x4a, 0xc6, 0x91, 0xd5,
+	0xed, 0xec, 0xc0, 0x91, 0x10, 0x88, 0x96, 0xa3, 0xb5, 0x5d, 0xb9, 0xa4, 0x3f, 0x00, 0xcb, 0x87,
+	0x0a, 0x3a, 0x42, 0xca, 0x0a, 0xa4, 0xf5, 0xf3, 0x93, 0xd2, 0x9a, 0x44, 0x1a, 0x8e, 0x80, 0xe8,
+	0x7a, 0xb8, 0xa4, 0x70, 0x76, 0x16, 0xbe, 0x3d, 0x2e, 0x65, 0xfe, 0x3e, 0x2e, 0x65, 0xe0, 0xe3,
+	0x2c, 0xb8, 0xfd, 0xa9, 0xd5, 0x21, 0x4e, 0x40, 0xf3, 0xc8, 0xe7, 0x8c, 0x5b, 0xae, 0x43, 0xdc,
+	0x16, 0xc2, 0x87, 0x96, 0xe7, 0x30, 0x84, 0x6d, 0xea, 0x39, 0x41, 0x0a, 0xfd, 0x30, 0x68, 0x7c,
+	0x0a, 0x23, 0x21, 0x10, 0x2d, 0x47, 0x6b, 0x61, 0x0a, 0xc7, 0x1a, 0xb8, 0x41, 0x63, 0x9e, 0xba,
+	0x27, 0x89, 0x8c, 0x6c, 0x79, 0xa6, 0x92, 0xdb, 0xde, 0x50, 0xb6, 0x57, 0x83, 0x6d, 0x09, 0x77,
+	0xb0, 0x7a, 0x1f, 0xdb, 0x7b, 0x94, 0xb8, 0xe6, 0x27, 0x4f, 0x4f, 0x4a, 0x99, 0xf3, 0x93, 0x52,
+	0x41, 0xf2, 0xa5, 0xc0, 0xc0, 0x9f, 0xff, 0x28, 0xdd, 0x6d, 0x11, 0xde, 0xf6, 0x1b, 0x55, 0x9b,
+	0x76, 0x6b, 0x6a, 0x13, 0xe5, 0x9f, 0x37, 0x98, 0xf3, 0x55, 0x8d, 0x1f, 0xf5, 0x30, 0x0b, 0x11,
+	0x19, 0xd2, 0xe9, 0x48, 0xce, 0x09, 0x77, 0xfe, 0xd1, 0xc0, 0x9d, 0xc8, 0x9d, 0x5d, 0xdb, 0xf6,
+	0xbb, 0x7e, 0xc7, 0xe2, 0xd8, 0xd9, 0xa3, 0xdd, 0x2e, 0x61, 0x8c, 0x50, 0xf7, 0xc5, 0x1b, 0x74,
+	0x04, 0x72, 0x56, 0xcc, 0x24, 0xb6, 0x37, 0xb7, 0xfd, 0x5e, 0x75, 0x42, 0x85, 0x57, 0x27, 0x4b,
+	0x34, 0x0b, 0xca, 0x36, 0x5d, 0xaa, 0x48, 0xa0, 0x43, 0x94, 0xe4, 0x4a, 0x24, 0xfe, 0xaf, 0x06,
+	0xca, 0x11, 0xea, 0xc7, 0x84, 0x71, 0xea, 0x11, 0xdb, 0xea, 0x5c, 0x59, 0x55, 0xe4, 0xc1, 0x5c,
+	0x0f, 0x7b, 0x84, 0xca, 0x7c, 0x67, 0x91, 0xfa, 0xa5, 0x13, 0x30, 0x1f, 0x16, 0xc8, 0x8c, 0x30,
+	0xe2, 0x9d, 0xe9, 0x8c, 0x18, 0x91, 0x6c, 0xe6, 0x95, 0x09, 0x2f, 0x4b, 0x55, 0x61, 0xbd, 0xa0,
+	0x10, 0x3f, 0x91, 0xfc, 0xef, 0x1a, 0xb8, 0x15, 0x21, 0xed, 0xf9, 0x9e, 0x87, 0x5d, 0x7e, 0x65,
+	0x99, 0x37, 0xe3, 0x0c, 0xe5, 0x56, 0xbf, 0x35, 0x5d, 0x86, 0x83, 0xba, 0x2e, 0x92, 0xde, 0x93,
+	0x2c, 0x58, 0x8f, 0x4e, 0xaa, 0x03, 0x6e, 0x79, 0x9c, 0xb8, 0xad, 0xe0, 0xa4, 0x8a, 0x93, 0x7b,
+	0x51, 0xe7, 0x55, 0xaa, 0x4f, 0xd9, 0x4b, 0xf9, 0xe4, 0x83, 0x6b, 0x4c, 0x69, 0xad, 0x13, 0xb7,
+	0x49, 0x55, 0x3d, 0x6c, 0x4f, 0x74, 0x2b, 0x35, 0x4d, 0x73, 0x43, 0x79, 0xb5, 0x22, 0xe9, 0x07,
+	0x60, 0x21, 0x5a, 0x62, 0x89, 0xd8, 0x84, 0x6d, 0x3f, 0x66, 0xc1, 0xcd, 0xc8, 0xfd, 0x83, 0x8e,
+	0xc5, 0xda, 0x1f, 0xf6, 0xc5, 0x06, 0x5c, 0x41, 0x2f, 0xb4, 0x31, 0x69, 0xb5, 0x79, 0xd8, 0x0b,
+	0xf2, 0x57, 0xa2, 0x47, 0x66, 0x06, 0x7a, 0xe4, 0x1b, 0xb0, 0x1a, 0xe3, 0xb2, 0x40, 0x58, 0x1d,
+	0x07, 0xca, 0x8c, 0x59, 0xe1, 0xd0, 0xbd, 0xe9, 0xea, 0x29, 0xce, 0xc8, 0x5c, 0x51, 0xfe, 0x2c,
+	0x49, 0xd1, 0x02, 0x0c, 0xa2, 0x1b, 0xfd, 0xd1, 0xd0, 0x84, 0x3d, 0x7f, 0xe5, 0xc0, 0xd2, 0x47,
+	0x72, 0x28, 0x1f, 0x70, 0x8b, 0x63, 0x1d, 0x81, 0xb9, 0x9e, 0xe5, 0x59, 0x5d, 0x69, 0x43, 0x6e,
+	0x7b, 0x73, 0xa2, 0x8e, 0x7d, 0x11, 0x6a, 0xae, 0x2a, 0xea, 0x6b, 0x92, 0x5a, 0x02, 0x40, 0xa4,
+	0x90, 0xf4, 0xcf, 0xc1, 0x42, 0x13, 0xe3, 0x7a, 0x8f, 0xd2, 0x8e, 0xea, 0x96, 0x3b, 0x13, 0x51,
+	0x1f, 0x60, 0xbc, 0x4f, 0x69, 0xc7, 0x5c, 0x53, 0xb0, 0xd7, 0x25, 0x6c, 0x88, 0x01, 0xd1, 0x7c,
+	0x53, 0x46, 0xe8, 0x3f, 0x68, 0xc0, 0x88, 0x4b, 0x3a, 0x1a, 0xa1, 0x41, 0x49, 0x04, 0x47, 0xcf,
+	0xcc, 0xf4, 0xa5, 0x96, 0x9c, 0xfd, 0xe6, 0x6b, 0x8a, 0xb8, 0x34, 0xdc, 0x34, 0x83, 0x0c, 0x10,
+	0xe5, 0x9d, 0xb4, 0xf7, 0x45, 0x07, 0xf5, 0x3c, 0xdc, 0x27, 0xd4, 0x67, 0xf5, 0x9e, 0x47, 0x7b,
+	0x94, 0x61, 0xcf, 0x98, 0x1d, 0xae, 0xab, 0x91, 0x10, 0x88, 0x96, 0xc3, 0xb5, 0x7d, 0xb5, 0xa4,
+	0x7f, 0x3f, 0x66, 0xf2, 0xbe, 0x24, 0xb2, 0xfb, 0x60, 0xba, 0x32, 0x19, 0x77, 0x45, 0x30, 0xe1,
+	0xff, 0xcf, 0xe6, 0xb4, 0x61, 0xab, 0xff, 0xaa, 0x81, 0xdb, 0x89, 0xb6, 0x88, 0xa7, 0x51, 0xdd,
+	0x8e, 0x26, 0x18, 0x33, 0xe6, 0x84, 0xc6, 0xdd, 0xe7, 0x98, 0x82, 0x4a, 0xe6, 0x3d, 0x25, 0xb3,
+	0x32, 0xd2, 0x90, 0xe9, 0xcc, 0x10, 0x95, 0xfa, 0x13, 0x71, 0x99, 0xfe, 0x8b, 0x06, 0x36, 0x62,
+	0x9c, 0x76, 0x34, 0x79, 0x22, 0x83, 0xe7, 0x85, 0xf8, 0xf7, 0x2f, 0x39, 0xb9, 0x94, 0xf0, 0xbb,
+	0x4a, 0xf8, 0xe6, 0xb0, 0xf0, 0x51, 0x42, 0x88, 0x0a, 0xfd, 0xb1, 0x70, 0xc1, 0x05, 0xec, 0x66,
+	0xfc, 0xb6, 0x2d, 0xc7, 0x48, 0xa4, 0x75, 0x41, 0x68, 0xdd, 0xb9, 0xcc, 0x0c, 0x52, 0x42, 0x2b,
+	0x4a, 0x68, 0x79, 0x58, 0xe8, 0x10, 0x15, 0x44, 0x6b, 0xfd, 0x74, 0x20, 0xfd, 0xf1, 0x40, 0x33,
+	0x0e, 0x9c, 0xcf, 0xcc, 0x58, 0x14, 0x0a, 0xdf, 0xbd, 0xf8, 0xb9, 0xaf, 0xf4, 0x8d, 0x6d, 0xc9,
+	0x41, 0x9e, 0x64, 0x4b, 0x26, 0x51, 0x58, 0xd0, 0x47, 0xf9, 0xd4, 0x03, 0x97, 0x19, 0x40, 0x68,
+	0x7b, 0xfb, 0xa2, 0x27, 0xae, 0x52, 0xf6, 0xaa, 0x52, 0x76, 0x6b, 0xd8, 0xb9, 0x24, 0x07, 0x44,
+	0x2b, 0x29, 0x07, 0x31, 0xd3, 0xbf, 0x04, 0x86, 0xe5, 0x73, 0x1a, 0xd4, 0x6e, 0x8f, 0xfa, 0xae,
+	0x53, 0x8f, 0xd4, 0x33, 0x23, 0x57, 0x9e, 0xa9, 0x2c, 0x9a, 0x9b, 0x71, 0xd2, 0xe3, 0x22, 0x21,
+	0xca, 0x07, 0x8f, 0xf6, 0xd4, 0x93, 0xc8, 0xc6, 0xc4, 0xf5, 0xc1, 0x7c, 0xf4, 0xd3, 0x69, 0x51,
+	0x7b, 0x7a, 0x5a, 0xd4, 0x9e, 0x9d, 0x16, 0xb5, 0x3f, 0x4f, 0x8b, 0xda, 0x77, 0x67, 0xc5, 0xcc,
+	0xb3, 0xb3, 0x62, 0xe6, 0xb7, 0xb3, 0x62, 0xe6, 0x8b, 0xad, 0x89, 0x97, 0xef, 0xaf, 0x07, 0x3f,
+	0xa7, 0xc4, 0x5d, 0xbc, 0x31, 0x27, 0x3e, 0xa0, 0xde, 0xfc, 0x6f, 0x00, 0x77, 0x2d, 0xd8, 0x49,
+	0xf0, 0x0d, 0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -787,6 +793,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AutoCompoundDelegators) > 0 {
+		for iNdEx := len(m.AutoCompoundDelegators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AutoCompoundDelegators[iNdEx])
+			copy(dAtA[i:], m.AutoCompoundDelegators[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AutoCompoundDelegators[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1106,6 +1121,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AutoCompoundDelegators) > 0 {
+		for _, s := range m.AutoCompoundDelegators {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2374,6 +2395,38 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundDelegators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundDelegators = append(m.AutoCompoundDelegators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
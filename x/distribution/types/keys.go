@@ -41,6 +41,10 @@ const (
 // - 0x07<valAddrLen (1 Byte)><valAddr_Bytes>: ValidatorCurrentRewards
 //
 // - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height>: ValidatorSlashEvent
+//
+// - 0x09<accAddrLen (1 Byte)><accAddr_Bytes>: []byte{0x01}
+//
+// - 0x0A: <accAddrLen (1 Byte)><accAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -52,6 +56,8 @@ var (
 	ValidatorCurrentRewardsPrefix        = []byte{0x06} // key for current validator rewards
 	ValidatorAccumulatedCommissionPrefix = []byte{0x07} // key for accumulated validator commission
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
+	AutoCompoundPrefix                   = []byte{0x09} // key for delegators opted in to auto-compounding
+	AutoCompoundCursorKey                = []byte{0x0A} // key for the next delegation of the auto-compounding pass in progress
 )
 
 // GetValidatorOutstandingRewardsAddress creates an address from a validator's outstanding rewards key.
@@ -151,6 +157,20 @@ func GetValidatorSlashEventAddressHeight(key []byte) (valAddr sdk.ValAddress, he
 	return
 }
 
+// GetAutoCompoundAddress creates an address from a delegator's auto-compound key.
+func GetAutoCompoundAddress(key []byte) (delAddr sdk.AccAddress) {
+	// key is in the format:
+	// 0x09<accAddrLen (1 Byte)><accAddr_Bytes>
+
+	// Remove prefix and address length.
+	addr := key[2:]
+	if len(addr) != int(key[1]) {
+		panic("unexpected key length")
+	}
+
+	return sdk.AccAddress(addr)
+}
+
 // GetValidatorOutstandingRewardsKey creates the outstanding rewards key for a validator.
 func GetValidatorOutstandingRewardsKey(valAddr sdk.ValAddress) []byte {
 	return append(ValidatorOutstandingRewardsPrefix, address.MustLengthPrefix(valAddr.Bytes())...)
@@ -212,3 +232,8 @@ func GetValidatorSlashEventKey(v sdk.ValAddress, height, period uint64) []byte {
 
 	return append(prefix, periodBz...)
 }
+
+// GetAutoCompoundKey creates the key for a delegator opted in to auto-compounding.
+func GetAutoCompoundKey(delAddr sdk.AccAddress) []byte {
+	return append(AutoCompoundPrefix, address.MustLengthPrefix(delAddr.Bytes())...)
+}
@@ -73,6 +73,45 @@ func TestMsgWithdrawValidatorCommission(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for MsgWithdrawTokenizeShareRecordReward
+func TestMsgWithdrawTokenizeShareRecordReward(t *testing.T) {
+	tests := []struct {
+		ownerAddr  sdk.AccAddress
+		expectPass bool
+	}{
+		{delAddr1, true},
+		{emptyDelAddr, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgWithdrawTokenizeShareRecordReward(tc.ownerAddr)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+func TestMsgSetAutoCompound(t *testing.T) {
+	tests := []struct {
+		delegatorAddr sdk.AccAddress
+		enabled       bool
+		expectPass    bool
+	}{
+		{delAddr1, true, true},
+		{delAddr1, false, true},
+		{emptyDelAddr, true, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgSetAutoCompound(tc.delegatorAddr, tc.enabled)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
 // test ValidateBasic for MsgDepositIntoCommunityPool
 func TestMsgDepositIntoCommunityPool(t *testing.T) {
 	tests := []struct {
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"math"
 
 	yaml "gopkg.in/yaml.v2"
 
@@ -15,6 +16,24 @@ var (
 	ParamStoreKeyBaseProposerReward  = []byte("baseproposerreward")
 	ParamStoreKeyBonusProposerReward = []byte("bonusproposerreward")
 	ParamStoreKeyWithdrawAddrEnabled = []byte("withdrawaddrenabled")
+
+	ParamStoreKeyAutoCompoundEpoch       = []byte("autocompoundepoch")
+	ParamStoreKeyMaxAutoCompoundPerBlock = []byte("maxautocompoundperblock")
+)
+
+const (
+	// DefaultAutoCompoundEpoch is the default number of blocks between two
+	// auto-compounding passes.
+	DefaultAutoCompoundEpoch uint64 = 100
+
+	// DefaultMaxAutoCompoundPerBlock is the default maximum number of
+	// delegations auto-compounded in a single block.
+	DefaultMaxAutoCompoundPerBlock uint64 = 100
+
+	// MaxAutoCompoundPerBlockLimit is the upper bound of the
+	// MaxAutoCompoundPerBlock param, which keeps the work of the BeginBlocker
+	// bounded.
+	MaxAutoCompoundPerBlockLimit uint64 = 1000
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -29,6 +48,9 @@ func DefaultParams() Params {
 		BaseProposerReward:  sdk.NewDecWithPrec(1, 2), // 1%
 		BonusProposerReward: sdk.NewDecWithPrec(4, 2), // 4%
 		WithdrawAddrEnabled: true,
+
+		AutoCompoundEpoch:       DefaultAutoCompoundEpoch,
+		MaxAutoCompoundPerBlock: DefaultMaxAutoCompoundPerBlock,
 	}
 }
 
@@ -44,6 +66,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyBaseProposerReward, &p.BaseProposerReward, validateBaseProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyBonusProposerReward, &p.BonusProposerReward, validateBonusProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyWithdrawAddrEnabled, &p.WithdrawAddrEnabled, validateWithdrawAddrEnabled),
+		paramtypes.NewParamSetPair(ParamStoreKeyAutoCompoundEpoch, &p.AutoCompoundEpoch, validateAutoCompoundEpoch),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxAutoCompoundPerBlock, &p.MaxAutoCompoundPerBlock, validateMaxAutoCompoundPerBlock),
 	}
 }
 
@@ -69,6 +93,12 @@ func (p Params) ValidateBasic() error {
 			"sum of base and bonus proposer reward cannot greater than one: %s", v,
 		)
 	}
+	if err := validateAutoCompoundEpoch(p.AutoCompoundEpoch); err != nil {
+		return err
+	}
+	if err := validateMaxAutoCompoundPerBlock(p.MaxAutoCompoundPerBlock); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -138,3 +168,30 @@ func validateWithdrawAddrEnabled(i interface{}) error {
 
 	return nil
 }
+
+func validateAutoCompoundEpoch(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// block heights are int64, so a larger epoch would never be reached
+	if v > math.MaxInt64 {
+		return fmt.Errorf("auto-compound epoch too large: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxAutoCompoundPerBlock(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > MaxAutoCompoundPerBlockLimit {
+		return fmt.Errorf("max auto-compound per block must not exceed %d: %d", MaxAutoCompoundPerBlockLimit, v)
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -32,3 +33,15 @@ func Test_validateAuxFuncs(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateAutoCompoundParams(t *testing.T) {
+	require.Error(t, validateAutoCompoundEpoch(int64(10)))
+	require.NoError(t, validateAutoCompoundEpoch(uint64(0)))
+	require.NoError(t, validateAutoCompoundEpoch(uint64(100)))
+	require.Error(t, validateAutoCompoundEpoch(uint64(math.MaxInt64)+1))
+
+	require.Error(t, validateMaxAutoCompoundPerBlock(int64(10)))
+	require.NoError(t, validateMaxAutoCompoundPerBlock(uint64(0)))
+	require.NoError(t, validateMaxAutoCompoundPerBlock(MaxAutoCompoundPerBlockLimit))
+	require.Error(t, validateMaxAutoCompoundPerBlock(MaxAutoCompoundPerBlockLimit+1))
+}
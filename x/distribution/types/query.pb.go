@@ -838,6 +838,93 @@ func (m *QueryCommunityPoolResponse) GetPool() github_com_cosmos_cosmos_sdk_type
 	return nil
 }
 
+// QueryAutoCompoundRequest is the request type for the Query/AutoCompound RPC
+// method.
+type QueryAutoCompoundRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *QueryAutoCompoundRequest) Reset()         { *m = QueryAutoCompoundRequest{} }
+func (m *QueryAutoCompoundRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoCompoundRequest) ProtoMessage()    {}
+func (*QueryAutoCompoundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{18}
+}
+func (m *QueryAutoCompoundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoCompoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoCompoundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoCompoundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoCompoundRequest.Merge(m, src)
+}
+func (m *QueryAutoCompoundRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoCompoundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoCompoundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoCompoundRequest proto.InternalMessageInfo
+
+// QueryAutoCompoundResponse is the response type for the Query/AutoCompound
+// RPC method.
+type QueryAutoCompoundResponse struct {
+	// enabled defines whether the delegator opted in to auto-compounding.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *QueryAutoCompoundResponse) Reset()         { *m = QueryAutoCompoundResponse{} }
+func (m *QueryAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoCompoundResponse) ProtoMessage()    {}
+func (*QueryAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{19}
+}
+func (m *QueryAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoCompoundResponse.Merge(m, src)
+}
+func (m *QueryAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoCompoundResponse proto.InternalMessageInfo
+
+func (m *QueryAutoCompoundResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.distribution.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.distribution.v1beta1.QueryParamsResponse")
@@ -857,6 +944,8 @@ func init() {
 	proto.RegisterType((*QueryDelegatorWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressResponse")
 	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolRequest")
 	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolResponse")
+	proto.RegisterType((*QueryAutoCompoundRequest)(nil), "cosmos.distribution.v1beta1.QueryAutoCompoundRequest")
+	proto.RegisterType((*QueryAutoCompoundResponse)(nil), "cosmos.distribution.v1beta1.QueryAutoCompoundResponse")
 }
 
 func init() {
@@ -864,76 +953,80 @@ func init() {
 }
 
 var fileDescriptor_5efd02cbc06efdc9 = []byte{
-	// 1101 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x98, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x3d, 0x6e, 0xda, 0xd2, 0x57, 0x4a, 0xd2, 0x69, 0x85, 0xcc, 0x26, 0xd8, 0xd1, 0x86,
-	0x92, 0x40, 0x54, 0x6f, 0x93, 0x48, 0x05, 0x5a, 0x10, 0xe4, 0x57, 0xa9, 0xd4, 0x2a, 0x4d, 0x4d,
-	0x95, 0x84, 0x5f, 0x8a, 0x26, 0xde, 0xd1, 0x7a, 0x55, 0x7b, 0xc7, 0xdd, 0x19, 0x27, 0x44, 0x55,
-	0x2f, 0x04, 0x24, 0x2e, 0x48, 0x48, 0x5c, 0x7a, 0xcc, 0x99, 0x3b, 0x17, 0xfe, 0x82, 0x1e, 0x2b,
-	0x21, 0xa1, 0x9e, 0x00, 0x25, 0x08, 0x55, 0x42, 0x9c, 0xb9, 0x22, 0xcf, 0xcc, 0xda, 0xbb, 0xf6,
-	0x7a, 0xfd, 0x4b, 0x3d, 0xc5, 0x7a, 0x3b, 0xef, 0x3b, 0xef, 0xf3, 0x66, 0xde, 0xbc, 0xa7, 0xc0,
-	0x74, 0x91, 0xf1, 0x0a, 0xe3, 0x96, 0xed, 0x72, 0xe1, 0xbb, 0x3b, 0x35, 0xe1, 0x32, 0xcf, 0xda,
-	0x9d, 0xdb, 0xa1, 0x82, 0xcc, 0x59, 0x0f, 0x6a, 0xd4, 0xdf, 0xcf, 0x57, 0x7d, 0x26, 0x18, 0x1e,
-	0x57, 0x0b, 0xf3, 0xe1, 0x85, 0x79, 0xbd, 0xd0, 0x78, 0x5b, 0xab, 0xec, 0x10, 0x4e, 0x95, 0x57,
-	0x43, 0xa3, 0x4a, 0x1c, 0xd7, 0x23, 0x72, 0xb5, 0x14, 0x32, 0x2e, 0x3a, 0xcc, 0x61, 0xf2, 0xa7,
-	0x55, 0xff, 0xa5, 0xad, 0x13, 0x0e, 0x63, 0x4e, 0x99, 0x5a, 0xa4, 0xea, 0x5a, 0xc4, 0xf3, 0x98,
-	0x90, 0x2e, 0x5c, 0x7f, 0xcd, 0x86, 0xf5, 0x03, 0xe5, 0x22, 0x73, 0x03, 0xcd, 0x7c, 0x12, 0x45,
-	0x24, 0x62, 0xb9, 0xde, 0xbc, 0x08, 0xf8, 0x6e, 0x3d, 0xca, 0x75, 0xe2, 0x93, 0x0a, 0x2f, 0xd0,
-	0x07, 0x35, 0xca, 0x85, 0xb9, 0x05, 0x17, 0x22, 0x56, 0x5e, 0x65, 0x1e, 0xa7, 0x78, 0x11, 0x4e,
-	0x55, 0xa5, 0x25, 0x83, 0x26, 0xd1, 0xcc, 0xd9, 0xf9, 0xa9, 0x7c, 0x42, 0x2a, 0xf2, 0xca, 0x79,
-	0x69, 0xe4, 0xc9, 0xef, 0xb9, 0x54, 0x41, 0x3b, 0x9a, 0x1b, 0x30, 0x2d, 0x95, 0x37, 0x48, 0xd9,
-	0xb5, 0x89, 0x60, 0xfe, 0x9d, 0x9a, 0xe0, 0x82, 0x78, 0xb6, 0xeb, 0x39, 0x05, 0xba, 0x47, 0x7c,
-	0x3b, 0x08, 0x02, 0xcf, 0xc2, 0xf9, 0xdd, 0x60, 0xd5, 0x36, 0xb1, 0x6d, 0x9f, 0x72, 0xb5, 0xf1,
-	0x99, 0xc2, 0x58, 0xe3, 0xc3, 0xa2, 0xb2, 0x9b, 0xdf, 0x20, 0x98, 0xe9, 0x2e, 0xac, 0x39, 0xb6,
-	0xe0, 0xb4, 0xaf, 0x4c, 0x1a, 0xe4, 0xdd, 0x44, 0x90, 0x04, 0x49, 0x4d, 0x17, 0xc8, 0x99, 0x6b,
-	0x90, 0x8b, 0x46, 0xb1, 0xcc, 0x2a, 0x15, 0x97, 0x73, 0x97, 0x79, 0x03, 0x61, 0x7d, 0x8b, 0x60,
-	0xb2, 0xb3, 0xa0, 0xc6, 0x21, 0x00, 0xc5, 0x86, 0x55, 0x13, 0x5d, 0xef, 0x8d, 0x68, 0xb1, 0x58,
-	0xac, 0x55, 0x6a, 0x65, 0x22, 0xa8, 0xdd, 0x14, 0xd6, 0x50, 0x21, 0x51, 0xf3, 0x1f, 0x04, 0x13,
-	0xd1, 0x38, 0x3e, 0x29, 0x13, 0x5e, 0xa2, 0x03, 0x1d, 0x16, 0x9e, 0x86, 0x51, 0x2e, 0x88, 0x2f,
-	0x5c, 0xcf, 0xd9, 0x2e, 0x51, 0xd7, 0x29, 0x89, 0x4c, 0x7a, 0x12, 0xcd, 0x8c, 0x14, 0x5e, 0x09,
-	0xcc, 0x37, 0xa5, 0x15, 0x4f, 0xc1, 0x39, 0x2a, 0xd3, 0x1d, 0x2c, 0x3b, 0x21, 0x97, 0xbd, 0xac,
-	0x8c, 0x7a, 0xd1, 0x0d, 0x80, 0x66, 0x69, 0x65, 0x46, 0x24, 0xfe, 0x9b, 0x01, 0x7e, 0xbd, 0x4e,
-	0xf2, 0xaa, 0x7a, 0x9b, 0xf7, 0xd2, 0xa1, 0x3a, 0xec, 0x42, 0xc8, 0xf3, 0xda, 0x4b, 0xdf, 0x1d,
-	0xe6, 0x52, 0x8f, 0x0f, 0x73, 0xc8, 0xfc, 0x05, 0xc1, 0xeb, 0x1d, 0x68, 0x75, 0xca, 0xd7, 0xe1,
-	0x34, 0x57, 0xa6, 0x0c, 0x9a, 0x3c, 0x31, 0x73, 0x76, 0xfe, 0x4a, 0x6f, 0xf9, 0x96, 0x3a, 0xab,
-	0xbb, 0xd4, 0x13, 0xc1, 0xcd, 0xd1, 0x32, 0xf8, 0xe3, 0x08, 0x45, 0x5a, 0x52, 0x4c, 0x77, 0xa5,
-	0x50, 0xe1, 0x84, 0x31, 0xcc, 0x83, 0x20, 0xf8, 0x15, 0x5a, 0xa6, 0x8e, 0xb4, 0xb5, 0x17, 0x96,
-	0xad, 0xbe, 0xb5, 0x9f, 0x55, 0xe3, 0x43, 0x70, 0x56, 0xb1, 0x07, 0x9b, 0x8e, 0x3f, 0x58, 0x95,
-	0xc2, 0xe7, 0x87, 0xb9, 0x94, 0xf9, 0x3d, 0x82, 0x6c, 0xa7, 0x28, 0x74, 0x0e, 0xef, 0x87, 0xab,
-	0xb0, 0x9e, 0xc3, 0x89, 0x08, 0x6e, 0x00, 0xba, 0x42, 0x8b, 0xcb, 0xcc, 0xf5, 0x96, 0x16, 0xea,
-	0xf9, 0xfa, 0xe9, 0x8f, 0xdc, 0xac, 0xe3, 0x8a, 0x52, 0x6d, 0x27, 0x5f, 0x64, 0x15, 0x4b, 0x3f,
-	0x76, 0xea, 0xcf, 0x65, 0x6e, 0xdf, 0xb7, 0xc4, 0x7e, 0x95, 0xf2, 0xc0, 0x87, 0x37, 0x0b, 0xf3,
-	0x73, 0x30, 0x5b, 0xc2, 0xb9, 0xc7, 0x04, 0x29, 0x0f, 0x91, 0x99, 0x10, 0xec, 0xdf, 0x08, 0xa6,
-	0x12, 0xd5, 0x35, 0xf1, 0x46, 0x2b, 0xf1, 0xd5, 0xc4, 0x5b, 0xd3, 0x54, 0x5b, 0x09, 0xf6, 0x56,
-	0x8a, 0x2d, 0xaf, 0x0e, 0x76, 0xe0, 0xa4, 0xa8, 0xef, 0x97, 0x49, 0xbf, 0xa8, 0x3c, 0x2a, 0x7d,
-	0x73, 0x4b, 0x3f, 0x6f, 0x8d, 0x78, 0x1a, 0x17, 0x7b, 0xd8, 0x14, 0xde, 0xd6, 0xef, 0x5c, 0xac,
-	0xb2, 0x4e, 0x5f, 0x16, 0xa0, 0x71, 0xe3, 0x54, 0x06, 0xcf, 0x14, 0x42, 0x96, 0x90, 0xda, 0x97,
-	0xf0, 0x46, 0x54, 0x6d, 0xd3, 0x15, 0x25, 0xdb, 0x27, 0x7b, 0x7a, 0xe3, 0x21, 0x83, 0xfd, 0x02,
-	0x2e, 0x75, 0x91, 0xd7, 0x11, 0xbf, 0x05, 0x63, 0x7b, 0xfa, 0x53, 0x8b, 0xfc, 0xe8, 0x5e, 0xd4,
-	0x25, 0xa4, 0x3e, 0x0e, 0xaf, 0x49, 0xf5, 0xfa, 0x83, 0x5c, 0xf3, 0x5c, 0xb1, 0xbf, 0xce, 0x58,
-	0x39, 0xe8, 0xcc, 0x07, 0x08, 0x8c, 0xb8, 0xaf, 0x7a, 0x43, 0x0a, 0x23, 0x55, 0xc6, 0xca, 0x2f,
-	0xae, 0xa0, 0xa4, 0xfc, 0xfc, 0xb3, 0x51, 0x38, 0x29, 0xa3, 0xc0, 0x8f, 0x11, 0x9c, 0x52, 0x8d,
-	0x1e, 0x5b, 0x89, 0x97, 0xb9, 0x7d, 0xca, 0x30, 0xae, 0xf4, 0xee, 0xa0, 0xf0, 0xcc, 0xd9, 0xaf,
-	0x7f, 0xfd, 0xeb, 0xc7, 0xf4, 0x25, 0x3c, 0x65, 0x25, 0x8d, 0x39, 0x6a, 0xd4, 0xc0, 0x07, 0x69,
-	0x18, 0x4f, 0x68, 0xdd, 0x78, 0xa5, 0xfb, 0xf6, 0xdd, 0xa7, 0x14, 0x63, 0x75, 0x48, 0x15, 0x4d,
-	0xb6, 0x29, 0xc9, 0xee, 0xe2, 0x3b, 0x89, 0x64, 0xcd, 0xcb, 0x6e, 0x3d, 0x6c, 0x7b, 0x95, 0x1f,
-	0x59, 0xac, 0xa9, 0xbf, 0x1d, 0xbc, 0x0d, 0x47, 0x08, 0x2e, 0xc4, 0x0c, 0x0f, 0xf8, 0xfd, 0x3e,
-	0xe2, 0x6e, 0x1b, 0x62, 0x8c, 0x0f, 0x06, 0xf4, 0xd6, 0xb4, 0x6b, 0x92, 0xf6, 0x26, 0xbe, 0x31,
-	0x0c, 0x6d, 0x73, 0x3c, 0xc1, 0xbf, 0x21, 0x18, 0x6b, 0xed, 0xd5, 0xf8, 0xbd, 0x3e, 0x62, 0x8c,
-	0x4e, 0x33, 0xc6, 0xb5, 0x41, 0x5c, 0x35, 0xdb, 0x2d, 0xc9, 0xb6, 0x8a, 0x97, 0x87, 0x61, 0x0b,
-	0xa6, 0x82, 0x7f, 0x11, 0x9c, 0x6f, 0xeb, 0xa0, 0xb8, 0x87, 0xf0, 0x3a, 0x35, 0x7f, 0xe3, 0xfa,
-	0x40, 0xbe, 0x9a, 0x6d, 0x5b, 0xb2, 0x7d, 0x8a, 0x37, 0x13, 0xd9, 0x1a, 0x2f, 0x27, 0xb7, 0x1e,
-	0xb6, 0x3d, 0xaf, 0x8f, 0x2c, 0x7d, 0x33, 0xe3, 0xb8, 0xf1, 0x73, 0x04, 0xaf, 0xc6, 0x37, 0x51,
-	0xfc, 0x61, 0x3f, 0x81, 0xc7, 0x34, 0x77, 0xe3, 0xa3, 0xc1, 0x05, 0xfa, 0x3a, 0xda, 0xde, 0xf0,
-	0x65, 0x61, 0xc6, 0x74, 0xbb, 0x5e, 0x0a, 0xb3, 0x73, 0xfb, 0xed, 0xa5, 0x30, 0x13, 0x5a, 0x6c,
-	0x8f, 0x85, 0xd9, 0x85, 0xb0, 0x79, 0xb7, 0xf1, 0x7f, 0x08, 0x32, 0x9d, 0xba, 0x24, 0x5e, 0xec,
-	0x23, 0xd6, 0xf8, 0x06, 0x6e, 0x2c, 0x0d, 0x23, 0xa1, 0x99, 0xef, 0x49, 0xe6, 0x35, 0x7c, 0x7b,
-	0x18, 0xe6, 0xd6, 0x36, 0x8f, 0x7f, 0x46, 0x70, 0x2e, 0xd2, 0xa3, 0xf1, 0xd5, 0xee, 0xb1, 0xc6,
-	0xb5, 0x7c, 0xe3, 0x9d, 0xbe, 0xfd, 0x34, 0xd8, 0x82, 0x04, 0xbb, 0x8c, 0x67, 0x13, 0xc1, 0x8a,
-	0x81, 0xef, 0x76, 0xbd, 0xb5, 0x2f, 0xdd, 0x7a, 0x72, 0x94, 0x45, 0x4f, 0x8f, 0xb2, 0xe8, 0xcf,
-	0xa3, 0x2c, 0xfa, 0xe1, 0x38, 0x9b, 0x7a, 0x7a, 0x9c, 0x4d, 0x3d, 0x3b, 0xce, 0xa6, 0x3e, 0x9b,
-	0x4b, 0x9c, 0x13, 0xbe, 0x8a, 0xaa, 0xcb, 0xb1, 0x61, 0xe7, 0x94, 0xfc, 0x27, 0xc3, 0xc2, 0xff,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x68, 0x0b, 0x91, 0xae, 0x5c, 0x11, 0x00, 0x00,
+	// 1168 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xb8, 0x69, 0xd2, 0xbe, 0xb6, 0x34, 0x99, 0x56, 0xc8, 0xdd, 0x04, 0x3b, 0xda, 0x50,
+	0x12, 0x88, 0xea, 0x6d, 0x12, 0x35, 0x40, 0x4b, 0x05, 0xf9, 0x55, 0x8a, 0x52, 0xa5, 0x89, 0xa9,
+	0x92, 0xf0, 0x4b, 0xd6, 0xd8, 0x3b, 0xda, 0xac, 0x6a, 0xef, 0xb8, 0xde, 0xd9, 0x84, 0xa8, 0xea,
+	0x85, 0x80, 0xc4, 0x05, 0x09, 0x89, 0x4b, 0x8f, 0x39, 0xc3, 0x99, 0x0b, 0x7f, 0x41, 0x8f, 0x91,
+	0x90, 0x10, 0x27, 0x40, 0x09, 0x42, 0x95, 0x10, 0x67, 0xae, 0x95, 0x67, 0x67, 0xed, 0x5d, 0x7b,
+	0xbd, 0xfe, 0xb1, 0xea, 0x29, 0x9b, 0x37, 0xf3, 0xbe, 0xf9, 0xbe, 0x37, 0xf3, 0x66, 0x3e, 0x19,
+	0x26, 0x8b, 0xcc, 0x2e, 0x33, 0x5b, 0xd3, 0x4d, 0x9b, 0x57, 0xcd, 0x82, 0xc3, 0x4d, 0x66, 0x69,
+	0xbb, 0x33, 0x05, 0xca, 0xc9, 0x8c, 0xf6, 0xc8, 0xa1, 0xd5, 0xfd, 0x6c, 0xa5, 0xca, 0x38, 0xc3,
+	0xa3, 0xee, 0xc4, 0xac, 0x7f, 0x62, 0x56, 0x4e, 0x54, 0xde, 0x92, 0x28, 0x05, 0x62, 0x53, 0x37,
+	0xab, 0x8e, 0x51, 0x21, 0x86, 0x69, 0x11, 0x31, 0x5b, 0x00, 0x29, 0x97, 0x0d, 0x66, 0x30, 0xf1,
+	0xa9, 0xd5, 0xbe, 0x64, 0x74, 0xcc, 0x60, 0xcc, 0x28, 0x51, 0x8d, 0x54, 0x4c, 0x8d, 0x58, 0x16,
+	0xe3, 0x22, 0xc5, 0x96, 0xa3, 0x69, 0x3f, 0xbe, 0x87, 0x5c, 0x64, 0xa6, 0x87, 0x99, 0x8d, 0x52,
+	0x11, 0x60, 0x2c, 0xe6, 0xab, 0x97, 0x01, 0x6f, 0xd4, 0x58, 0xae, 0x93, 0x2a, 0x29, 0xdb, 0x39,
+	0xfa, 0xc8, 0xa1, 0x36, 0x57, 0xb7, 0xe1, 0x52, 0x20, 0x6a, 0x57, 0x98, 0x65, 0x53, 0xbc, 0x00,
+	0x83, 0x15, 0x11, 0x49, 0xa1, 0x71, 0x34, 0x75, 0x6e, 0x76, 0x22, 0x1b, 0x51, 0x8a, 0xac, 0x9b,
+	0xbc, 0x38, 0xf0, 0xec, 0x8f, 0x4c, 0x22, 0x27, 0x13, 0xd5, 0x4d, 0x98, 0x14, 0xc8, 0x9b, 0xa4,
+	0x64, 0xea, 0x84, 0xb3, 0xea, 0x7d, 0x87, 0xdb, 0x9c, 0x58, 0xba, 0x69, 0x19, 0x39, 0xba, 0x47,
+	0xaa, 0xba, 0x47, 0x02, 0x4f, 0xc3, 0xc8, 0xae, 0x37, 0x2b, 0x4f, 0x74, 0xbd, 0x4a, 0x6d, 0x77,
+	0xe1, 0xb3, 0xb9, 0xe1, 0xfa, 0xc0, 0x82, 0x1b, 0x57, 0xbf, 0x46, 0x30, 0xd5, 0x19, 0x58, 0xea,
+	0xd8, 0x86, 0xa1, 0xaa, 0x1b, 0x92, 0x42, 0xde, 0x89, 0x14, 0x12, 0x01, 0x29, 0xd5, 0x79, 0x70,
+	0xea, 0x1a, 0x64, 0x82, 0x2c, 0x96, 0x58, 0xb9, 0x6c, 0xda, 0xb6, 0xc9, 0xac, 0xbe, 0x64, 0x7d,
+	0x83, 0x60, 0xbc, 0x3d, 0xa0, 0x94, 0x43, 0x00, 0x8a, 0xf5, 0xa8, 0x54, 0x74, 0xab, 0x3b, 0x45,
+	0x0b, 0xc5, 0xa2, 0x53, 0x76, 0x4a, 0x84, 0x53, 0xbd, 0x01, 0x2c, 0x45, 0xf9, 0x40, 0xd5, 0x7f,
+	0x11, 0x8c, 0x05, 0x79, 0x7c, 0x5c, 0x22, 0xf6, 0x0e, 0xed, 0x6b, 0xb3, 0xf0, 0x24, 0x5c, 0xb4,
+	0x39, 0xa9, 0x72, 0xd3, 0x32, 0xf2, 0x3b, 0xd4, 0x34, 0x76, 0x78, 0x2a, 0x39, 0x8e, 0xa6, 0x06,
+	0x72, 0xaf, 0x78, 0xe1, 0xbb, 0x22, 0x8a, 0x27, 0xe0, 0x02, 0xb5, 0x74, 0xdf, 0xb4, 0x53, 0x62,
+	0xda, 0x79, 0x37, 0x28, 0x27, 0xdd, 0x01, 0x68, 0xb4, 0x56, 0x6a, 0x40, 0xc8, 0x7f, 0xc3, 0x93,
+	0x5f, 0xeb, 0x93, 0xac, 0xdb, 0xbd, 0x8d, 0x73, 0x69, 0x50, 0x49, 0x3b, 0xe7, 0xcb, 0xbc, 0x79,
+	0xe6, 0xdb, 0xc3, 0x4c, 0xe2, 0xe9, 0x61, 0x06, 0xa9, 0xbf, 0x20, 0x78, 0xad, 0x8d, 0x5a, 0x59,
+	0xf2, 0x75, 0x18, 0xb2, 0xdd, 0x50, 0x0a, 0x8d, 0x9f, 0x9a, 0x3a, 0x37, 0x7b, 0xbd, 0xbb, 0x7a,
+	0x0b, 0x9c, 0x95, 0x5d, 0x6a, 0x71, 0xef, 0xe4, 0x48, 0x18, 0xfc, 0x61, 0x40, 0x45, 0x52, 0xa8,
+	0x98, 0xec, 0xa8, 0xc2, 0xa5, 0xe3, 0x97, 0xa1, 0x1e, 0x78, 0xe4, 0x97, 0x69, 0x89, 0x1a, 0x22,
+	0xd6, 0xda, 0x58, 0xba, 0x3b, 0xd6, 0xba, 0x57, 0xf5, 0x01, 0x6f, 0xaf, 0x42, 0x37, 0x36, 0x19,
+	0xbe, 0xb1, 0x6e, 0x09, 0x9f, 0x1f, 0x66, 0x12, 0xea, 0x77, 0x08, 0xd2, 0xed, 0x58, 0xc8, 0x1a,
+	0x3e, 0xf4, 0x77, 0x61, 0xad, 0x86, 0x63, 0x01, 0xb9, 0x9e, 0xd0, 0x65, 0x5a, 0x5c, 0x62, 0xa6,
+	0xb5, 0x38, 0x57, 0xab, 0xd7, 0x8f, 0x7f, 0x66, 0xa6, 0x0d, 0x93, 0xef, 0x38, 0x85, 0x6c, 0x91,
+	0x95, 0x35, 0x79, 0xd9, 0xb9, 0x7f, 0xae, 0xd9, 0xfa, 0x43, 0x8d, 0xef, 0x57, 0xa8, 0xed, 0xe5,
+	0xd8, 0x8d, 0xc6, 0xfc, 0x0c, 0xd4, 0x26, 0x3a, 0x0f, 0x18, 0x27, 0xa5, 0x18, 0x95, 0xf1, 0x89,
+	0xfd, 0x07, 0xc1, 0x44, 0x24, 0xba, 0x54, 0xbc, 0xd9, 0xac, 0x78, 0x3e, 0xf2, 0xd4, 0x34, 0xd0,
+	0x96, 0xbd, 0xb5, 0x5d, 0xc4, 0xa6, 0x5b, 0x07, 0x1b, 0x70, 0x9a, 0xd7, 0xd6, 0x4b, 0x25, 0x5f,
+	0x56, 0x1d, 0x5d, 0x7c, 0x75, 0x5b, 0x5e, 0x6f, 0x75, 0x3e, 0xf5, 0x83, 0x1d, 0xb7, 0x84, 0xf7,
+	0x60, 0xbc, 0x3d, 0xb2, 0x2c, 0x5f, 0x1a, 0xa0, 0x7e, 0xe2, 0xdc, 0x0a, 0x9e, 0xcd, 0xf9, 0x22,
+	0x3e, 0xb4, 0x2f, 0xe0, 0xf5, 0x20, 0xda, 0x96, 0xc9, 0x77, 0xf4, 0x2a, 0xd9, 0x93, 0x0b, 0xc7,
+	0x24, 0xfb, 0x39, 0x5c, 0xed, 0x00, 0x2f, 0x19, 0xbf, 0x09, 0xc3, 0x7b, 0x72, 0xa8, 0x09, 0xfe,
+	0xe2, 0x5e, 0x30, 0xc5, 0x87, 0x3e, 0x0a, 0x57, 0x04, 0x7a, 0xed, 0x42, 0x76, 0x2c, 0x93, 0xef,
+	0xaf, 0x33, 0x56, 0xf2, 0x5e, 0xe6, 0x03, 0x04, 0x4a, 0xd8, 0xa8, 0x5c, 0x90, 0xc2, 0x40, 0x85,
+	0xb1, 0xd2, 0xcb, 0x6b, 0x28, 0x01, 0xaf, 0x6e, 0x40, 0x4a, 0x90, 0x58, 0x70, 0x38, 0x5b, 0x62,
+	0xe5, 0x0a, 0x73, 0x2c, 0x3d, 0x66, 0x4d, 0x6f, 0xc0, 0x95, 0x10, 0x48, 0x29, 0x2b, 0x05, 0x43,
+	0xd4, 0x22, 0x85, 0x12, 0xd5, 0x05, 0xd2, 0x99, 0x9c, 0xf7, 0xef, 0xec, 0x4f, 0x23, 0x70, 0x5a,
+	0xe4, 0xe1, 0xa7, 0x08, 0x06, 0x5d, 0xcb, 0x81, 0xb5, 0xc8, 0xb6, 0x6a, 0xf5, 0x3b, 0xca, 0xf5,
+	0xee, 0x13, 0x5c, 0x46, 0xea, 0xf4, 0x57, 0xbf, 0xfe, 0xfd, 0x43, 0xf2, 0x2a, 0x9e, 0xd0, 0xa2,
+	0x0c, 0x97, 0x6b, 0x7a, 0xf0, 0x41, 0x12, 0x46, 0x23, 0x4c, 0x04, 0x5e, 0xee, 0xbc, 0x7c, 0x67,
+	0xbf, 0xa4, 0xac, 0xc4, 0x44, 0x91, 0xca, 0xb6, 0x84, 0xb2, 0x0d, 0x7c, 0x3f, 0x52, 0x59, 0xa3,
+	0xed, 0xb4, 0xc7, 0x2d, 0xef, 0xc3, 0x13, 0x8d, 0x35, 0xf0, 0xf3, 0xde, 0x2d, 0x75, 0x8c, 0xe0,
+	0x52, 0x88, 0x8d, 0xc1, 0xef, 0xf5, 0xc0, 0xbb, 0xc5, 0x4e, 0x29, 0xb7, 0xfb, 0xcc, 0x96, 0x6a,
+	0xd7, 0x84, 0xda, 0xbb, 0xf8, 0x4e, 0x1c, 0xb5, 0x0d, 0xa3, 0x84, 0x7f, 0x43, 0x30, 0xdc, 0xec,
+	0x1a, 0xf0, 0xbb, 0x3d, 0x70, 0x0c, 0xfa, 0x2a, 0xe5, 0x66, 0x3f, 0xa9, 0x52, 0xdb, 0xaa, 0xd0,
+	0xb6, 0x82, 0x97, 0xe2, 0x68, 0xf3, 0xfc, 0xc9, 0x7f, 0x08, 0x46, 0x5a, 0xde, 0x72, 0xdc, 0x05,
+	0xbd, 0x76, 0x36, 0x44, 0xb9, 0xd5, 0x57, 0xae, 0xd4, 0x96, 0x17, 0xda, 0x3e, 0xc1, 0x5b, 0x91,
+	0xda, 0xea, 0xf7, 0x8d, 0xad, 0x3d, 0x6e, 0xb9, 0x94, 0x9e, 0x68, 0xf2, 0x64, 0x86, 0xe9, 0xc6,
+	0xcf, 0x11, 0xbc, 0x1a, 0xfe, 0x9c, 0xe3, 0xf7, 0x7b, 0x21, 0x1e, 0x62, 0x33, 0x94, 0x0f, 0xfa,
+	0x07, 0xe8, 0x69, 0x6b, 0xbb, 0x93, 0x2f, 0x1a, 0x33, 0xe4, 0xdd, 0xed, 0xa6, 0x31, 0xdb, 0x1b,
+	0x01, 0xe5, 0x76, 0x9f, 0xd9, 0x3d, 0x35, 0x66, 0x07, 0x85, 0x8d, 0xb3, 0x8d, 0xff, 0x47, 0x90,
+	0x6a, 0xf7, 0x5e, 0xe3, 0x85, 0x1e, 0xb8, 0x86, 0x5b, 0x09, 0x65, 0x31, 0x0e, 0x84, 0xd4, 0xfc,
+	0x40, 0x68, 0x5e, 0xc3, 0xf7, 0xe2, 0x68, 0x6e, 0x36, 0x1c, 0xf8, 0x67, 0x04, 0x17, 0x02, 0x6e,
+	0x01, 0xcf, 0x77, 0xe6, 0x1a, 0x66, 0x3e, 0x94, 0xb7, 0x7b, 0xce, 0x93, 0xc2, 0xe6, 0x84, 0xb0,
+	0x6b, 0x78, 0x3a, 0x52, 0x58, 0xd1, 0xcb, 0xcd, 0xd7, 0x4c, 0x06, 0x3e, 0x42, 0x70, 0xde, 0xef,
+	0x06, 0xf0, 0x8d, 0xce, 0xcb, 0x87, 0x18, 0x12, 0x65, 0xbe, 0xd7, 0x34, 0x49, 0x7a, 0x43, 0x90,
+	0x5e, 0xc5, 0x1f, 0xc5, 0xd9, 0x0d, 0xe2, 0x70, 0x96, 0x2f, 0x4a, 0xe8, 0xc5, 0xd5, 0x67, 0xc7,
+	0x69, 0x74, 0x74, 0x9c, 0x46, 0x7f, 0x1d, 0xa7, 0xd1, 0xf7, 0x27, 0xe9, 0xc4, 0xd1, 0x49, 0x3a,
+	0xf1, 0xfb, 0x49, 0x3a, 0xf1, 0xe9, 0x4c, 0xa4, 0x09, 0xfb, 0x32, 0xb8, 0xb6, 0xf0, 0x64, 0x85,
+	0x41, 0xf1, 0x0b, 0xce, 0xdc, 0x8b, 0x01, 0x00, 0xf0, 0xa9, 0xa2, 0x48, 0xb9, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -967,6 +1060,8 @@ type QueryClient interface {
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
+	// AutoCompound queries whether a delegator opted in to auto-compounding.
+	AutoCompound(ctx context.Context, in *QueryAutoCompoundRequest, opts ...grpc.CallOption) (*QueryAutoCompoundResponse, error)
 }
 
 type queryClient struct {
@@ -1058,6 +1153,15 @@ func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolR
 	return out, nil
 }
 
+func (c *queryClient) AutoCompound(ctx context.Context, in *QueryAutoCompoundRequest, opts ...grpc.CallOption) (*QueryAutoCompoundResponse, error) {
+	out := new(QueryAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/AutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries params of the distribution module.
@@ -1079,6 +1183,8 @@ type QueryServer interface {
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
+	// AutoCompound queries whether a delegator opted in to auto-compounding.
+	AutoCompound(context.Context, *QueryAutoCompoundRequest) (*QueryAutoCompoundResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1112,6 +1218,9 @@ func (*UnimplementedQueryServer) DelegatorWithdrawAddress(ctx context.Context, r
 func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
+func (*UnimplementedQueryServer) AutoCompound(ctx context.Context, req *QueryAutoCompoundRequest) (*QueryAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoCompound not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1279,6 +1388,24 @@ func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoCompoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/AutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoCompound(ctx, req.(*QueryAutoCompoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.distribution.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1319,6 +1446,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
 		},
+		{
+			MethodName: "AutoCompound",
+			Handler:    _Query_AutoCompound_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/query.proto",
@@ -1944,6 +2075,69 @@ func (m *QueryCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryAutoCompoundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoCompoundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoCompoundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2209,6 +2403,31 @@ func (m *QueryCommunityPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAutoCompoundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3814,6 +4033,158 @@ func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAutoCompoundRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoCompoundRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoCompoundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAutoCompoundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoCompoundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -13,6 +13,19 @@ Handler for a given Evidence type can perform any arbitrary state transitions
 such as slashing, jailing, and tombstoning. This provides developers with great
 flexibility in designing evidence handling.
 
+The evidence reported by Tendermint is not routed, but handled in BeginBlock.
+Duplicate votes are handled as Equivocation evidence, which slashes, jails and
+tombstones the validator. Light client attacks are handled as LightClientAttack
+evidence, which slashes the validator by the SlashFractionLightClientAttack
+param and jails it for the LightClientAttackJailDuration param.
+
+As an example of a custom Handler, the module provides the DoubleProposal
+evidence type, which Tendermint does not report, and its Handler returned by
+keeper.NewDoubleProposalHandler. Once its route is registered, anyone may submit
+DoubleProposal evidence with a MsgSubmitEvidence, which the Handler verifies
+against the consensus public key of the validator before handling it as a
+double sign.
+
 A full setup of the evidence module may look something as follows:
 
 	ModuleBasics = module.NewBasicManager(
@@ -21,13 +34,14 @@ A full setup of the evidence module may look something as follows:
 	)
 
 	// First, create the keeper
-	evidenceKeeper := evidence.NewKeeper(
-	  appCodec, keys[evidence.StoreKey], &app.StakingKeeper, app.SlashingKeeper,
+	evidenceKeeper := evidencekeeper.NewKeeper(
+	  appCodec, keys[evidencetypes.StoreKey], app.GetSubspace(evidencetypes.ModuleName),
+	  &app.StakingKeeper, app.SlashingKeeper,
 	)
 
 	// Second, create the evidence Handler and register all desired routes.
-	evidenceRouter := evidence.NewRouter().
-	  AddRoute(evidenceRoute, evidenceHandler).
+	evidenceRouter := evidencetypes.NewRouter().
+	  AddRoute(evidencetypes.RouteDoubleProposal, evidencekeeper.NewDoubleProposalHandler(*evidenceKeeper)).
 	  AddRoute(..., ...)
 
 	evidenceKeeper.SetRouter(evidenceRouter)
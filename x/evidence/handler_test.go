@@ -1,21 +1,27 @@
 package evidence_test
 
 import (
+	"bytes"
 	"fmt"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/evidence"
 	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
 	"github.com/cosmos/cosmos-sdk/x/evidence/keeper"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 )
 
 type HandlerTestSuite struct {
@@ -55,7 +61,7 @@ func (suite *HandlerTestSuite) SetupTest() {
 
 	// recreate keeper in order to use custom testing types
 	evidenceKeeper := keeper.NewKeeper(
-		app.AppCodec(), app.GetKey(types.StoreKey), app.StakingKeeper, app.SlashingKeeper,
+		app.AppCodec(), app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName), app.StakingKeeper, app.SlashingKeeper,
 	)
 	router := types.NewRouter()
 	router = router.AddRoute(types.RouteEquivocation, testEquivocationHandler(*evidenceKeeper))
@@ -125,3 +131,76 @@ func (suite *HandlerTestSuite) TestMsgSubmitEvidence() {
 func TestHandlerTestSuite(t *testing.T) {
 	suite.Run(t, new(HandlerTestSuite))
 }
+
+// testProposal returns a proposal for the block with the given hash signed by
+// the given key.
+func testProposal(t *testing.T, ctx sdk.Context, pk cryptotypes.PrivKey, height int64, hash byte) *tmproto.Proposal {
+	proposal := &tmproto.Proposal{
+		Type:     tmproto.ProposalType,
+		Height:   height,
+		PolRound: -1,
+		BlockID: tmproto.BlockID{
+			Hash:          bytes.Repeat([]byte{hash}, tmhash.Size),
+			PartSetHeader: tmproto.PartSetHeader{Total: 1, Hash: bytes.Repeat([]byte{hash}, tmhash.Size)},
+		},
+		Timestamp: ctx.BlockTime(),
+	}
+
+	sig, err := pk.Sign(tmtypes.ProposalSignBytes(ctx.ChainID(), proposal))
+	require.NoError(t, err)
+	proposal.Signature = sig
+
+	return proposal
+}
+
+// TestMsgSubmitDoubleProposal submits DoubleProposal evidence to the
+// DoubleProposal Handler registered on the evidence router of SimApp.
+func TestMsgSubmitDoubleProposal(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{ChainID: "test-chain", Height: 10, Time: time.Unix(100, 0)})
+	handler := evidence.NewHandler(app.EvidenceKeeper)
+
+	addrs := simapp.AddTestAddrs(app, ctx, 1, sdk.TokensFromConsensusPower(200))
+	operatorAddr := sdk.ValAddress(addrs[0])
+	consPk := ed25519.GenPrivKey()
+	consAddr := sdk.ConsAddress(consPk.PubKey().Address())
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	selfDelegation := tstaking.CreateValidatorWithValPower(operatorAddr, consPk.PubKey(), 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// a proposal not signed by the validator is rejected
+	otherPk := ed25519.GenPrivKey()
+	msg := testMsgSubmitEvidence(require.New(t), &types.DoubleProposal{
+		ConsensusAddress: consAddr.String(),
+		ProposalA:        testProposal(t, ctx, consPk, 9, 0x01),
+		ProposalB:        testProposal(t, ctx, otherPk, 9, 0x02),
+	}, addrs[0])
+	_, err := handler(ctx, msg)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), types.ErrInvalidEvidenceSignature.Error())
+	require.False(t, app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
+
+	// two proposals signed by the validator for different blocks
+	msg = testMsgSubmitEvidence(require.New(t), &types.DoubleProposal{
+		ConsensusAddress: consAddr.String(),
+		ProposalA:        testProposal(t, ctx, consPk, 9, 0x01),
+		ProposalB:        testProposal(t, ctx, consPk, 9, 0x02),
+	}, addrs[0])
+	_, err = handler(ctx, msg)
+	require.NoError(t, err)
+
+	// should be slashed as a double sign, jailed and tombstoned
+	validator := app.StakingKeeper.Validator(ctx, operatorAddr)
+	slashFraction := app.SlashingKeeper.SlashFractionDoubleSign(ctx)
+	require.Equal(t, selfDelegation.ToDec().Mul(sdk.OneDec().Sub(slashFraction)).TruncateInt(), validator.GetTokens())
+	require.True(t, validator.IsJailed())
+	require.True(t, app.SlashingKeeper.IsTombstoned(ctx, consAddr))
+
+	_, found := app.EvidenceKeeper.GetEvidence(ctx, msg.GetEvidence().Hash())
+	require.True(t, found)
+
+	// the same evidence cannot be submitted twice
+	_, err = handler(ctx, msg)
+	require.ErrorIs(t, err, types.ErrEvidenceExists)
+}
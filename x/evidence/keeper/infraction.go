@@ -2,9 +2,14 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
-	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
 )
 
@@ -20,8 +25,8 @@ import (
 // - the signing info does not exist (will panic)
 // - is already tombstoned
 //
-// TODO: Some of the invalid constraints listed above may need to be reconsidered
-// in the case of a lunatic attack.
+// Light client attacks, including lunatic attacks, are handled by
+// HandleLightClientAttackEvidence.
 func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equivocation) {
 	logger := k.Logger(ctx)
 	consAddr := evidence.GetConsensusAddress()
@@ -39,28 +44,18 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 		return
 	}
 
-	// calculate the age of the evidence
 	infractionHeight := evidence.GetHeight()
 	infractionTime := evidence.GetTime()
-	ageDuration := ctx.BlockHeader().Time.Sub(infractionTime)
-	ageBlocks := ctx.BlockHeader().Height - infractionHeight
 
-	// Reject evidence if the double-sign is too old. Evidence is considered stale
-	// if the difference in time and number of blocks is greater than the allowed
-	// parameters defined.
-	cp := ctx.ConsensusParams()
-	if cp != nil && cp.Evidence != nil {
-		if ageDuration > cp.Evidence.MaxAgeDuration && ageBlocks > cp.Evidence.MaxAgeNumBlocks {
-			logger.Info(
-				"ignored equivocation; evidence too old",
-				"validator", consAddr,
-				"infraction_height", infractionHeight,
-				"max_age_num_blocks", cp.Evidence.MaxAgeNumBlocks,
-				"infraction_time", infractionTime,
-				"max_age_duration", cp.Evidence.MaxAgeDuration,
-			)
-			return
-		}
+	// Reject evidence if the double-sign is too old.
+	if k.isEvidenceExpired(ctx, infractionHeight, infractionTime) {
+		logger.Info(
+			"ignored equivocation; evidence too old",
+			"validator", consAddr,
+			"infraction_height", infractionHeight,
+			"infraction_time", infractionTime,
+		)
+		return
 	}
 
 	validator := k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
@@ -121,3 +116,201 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 	k.slashingKeeper.Tombstone(ctx, consAddr)
 	k.SetEvidence(ctx, evidence)
 }
+
+// HandleLightClientAttackEvidence implements a light client attack evidence
+// handler. Assuming the evidence is valid, the validator which signed the
+// conflicting header will be slashed by the SlashFractionLightClientAttack param
+// and jailed for the LightClientAttackJailDuration param. Unlike an
+// equivocation, a light client attack does not tombstone the validator, which
+// may unjail once the jail duration has elapsed.
+//
+// The evidence is considered invalid if:
+// - the evidence is too old
+// - the evidence has already been handled
+// - the validator is unbonded or does not exist
+// - the signing info does not exist (will panic)
+// - is already tombstoned
+func (k Keeper) HandleLightClientAttackEvidence(ctx sdk.Context, evidence *types.LightClientAttack) {
+	logger := k.Logger(ctx)
+	consAddr := evidence.GetConsensusAddress()
+
+	if _, err := k.slashingKeeper.GetPubkey(ctx, consAddr.Bytes()); err != nil {
+		// Ignore evidence that cannot be handled, as for equivocations.
+		return
+	}
+
+	infractionHeight := evidence.GetHeight()
+	infractionTime := evidence.GetTime()
+
+	if k.isEvidenceExpired(ctx, infractionHeight, infractionTime) {
+		logger.Info(
+			"ignored light client attack; evidence too old",
+			"validator", consAddr,
+			"infraction_height", infractionHeight,
+			"infraction_time", infractionTime,
+		)
+		return
+	}
+
+	// the validator is not tombstoned, so the same evidence must not slash it
+	// twice
+	if _, ok := k.GetEvidence(ctx, evidence.Hash()); ok {
+		return
+	}
+
+	validator := k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
+	if validator == nil || validator.IsUnbonded() {
+		return
+	}
+
+	signInfo, ok := k.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	if !ok {
+		panic(fmt.Sprintf("expected signing info for validator %s but not found", consAddr))
+	}
+
+	// ignore if the validator is already tombstoned
+	if signInfo.Tombstoned {
+		logger.Info(
+			"ignored light client attack; validator already tombstoned",
+			"validator", consAddr,
+			"infraction_height", infractionHeight,
+			"infraction_time", infractionTime,
+		)
+		return
+	}
+
+	logger.Info(
+		"confirmed light client attack",
+		"validator", consAddr,
+		"infraction_height", infractionHeight,
+		"infraction_time", infractionTime,
+	)
+
+	// The stake distribution which signed the conflicting header is retrieved as
+	// for equivocations.
+	distributionHeight := infractionHeight - sdk.ValidatorUpdateDelay
+
+	k.slashingKeeper.Slash(
+		ctx,
+		consAddr,
+		k.SlashFractionLightClientAttack(ctx),
+		evidence.GetValidatorPower(), distributionHeight,
+	)
+
+	if !validator.IsJailed() {
+		k.slashingKeeper.Jail(ctx, consAddr)
+	}
+
+	// do not shorten a longer jail period, e.g. for downtime
+	jailEndTime := ctx.BlockHeader().Time.Add(k.LightClientAttackJailDuration(ctx))
+	if jailEndTime.After(signInfo.JailedUntil) {
+		k.slashingKeeper.JailUntil(ctx, consAddr, jailEndTime)
+	}
+
+	k.SetEvidence(ctx, evidence)
+}
+
+// NewDoubleProposalHandler returns an evidence Handler for the DoubleProposal
+// type, which is submitted through MsgSubmitEvidence as Tendermint does not
+// report double proposals. It is an example of a custom evidence Handler, which
+// applications register on the route of the evidence type:
+//
+//	evidenceRouter := types.NewRouter().
+//		AddRoute(types.RouteDoubleProposal, keeper.NewDoubleProposalHandler(*evidenceKeeper))
+//	evidenceKeeper.SetRouter(evidenceRouter)
+func NewDoubleProposalHandler(k Keeper) types.Handler {
+	return func(ctx sdk.Context, e exported.Evidence) error {
+		evidence, ok := e.(*types.DoubleProposal)
+		if !ok {
+			return fmt.Errorf("unexpected evidence type: %T", e)
+		}
+
+		return k.HandleDoubleProposalEvidence(ctx, evidence)
+	}
+}
+
+// HandleDoubleProposalEvidence handles a DoubleProposal evidence as a double
+// sign. Assuming the evidence is valid, the validator which signed both
+// proposals will be slashed by the SlashFractionDoubleSign param, jailed and
+// tombstoned. Note, the validator power at the time of the infraction is not
+// part of the evidence, so the current power of the validator is slashed.
+//
+// Unlike the evidence reported by Tendermint, the submitted evidence is not
+// trusted and an error is returned if:
+// - the evidence fails its stateless validation
+// - the validator does not exist or is unbonded
+// - a signature of the proposals is invalid
+// - the evidence is too old
+// - the validator is already tombstoned
+func (k Keeper) HandleDoubleProposalEvidence(ctx sdk.Context, evidence *types.DoubleProposal) error {
+	if err := evidence.ValidateBasic(); err != nil {
+		return err
+	}
+
+	consAddr := evidence.GetConsensusAddress()
+
+	pubKey, err := k.slashingKeeper.GetPubkey(ctx, consAddr.Bytes())
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrNoValidatorExists, consAddr.String())
+	}
+
+	validator := k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
+	if validator == nil || validator.IsUnbonded() {
+		return sdkerrors.Wrap(types.ErrNoValidatorExists, consAddr.String())
+	}
+
+	for _, proposal := range []*tmproto.Proposal{evidence.ProposalA, evidence.ProposalB} {
+		if !pubKey.VerifySignature(tmtypes.ProposalSignBytes(ctx.ChainID(), proposal), proposal.Signature) {
+			return sdkerrors.Wrapf(types.ErrInvalidEvidenceSignature, "proposal %d/%d", proposal.Height, proposal.Round)
+		}
+	}
+
+	infractionHeight := evidence.GetHeight()
+	infractionTime := evidence.GetTime()
+
+	if k.isEvidenceExpired(ctx, infractionHeight, infractionTime) {
+		return sdkerrors.Wrapf(types.ErrEvidenceTooOld, "height %d, time %s", infractionHeight, infractionTime)
+	}
+
+	if k.slashingKeeper.IsTombstoned(ctx, consAddr) {
+		return sdkerrors.Wrap(types.ErrValidatorTombstoned, consAddr.String())
+	}
+
+	k.Logger(ctx).Info(
+		"confirmed double proposal",
+		"validator", consAddr,
+		"infraction_height", infractionHeight,
+		"infraction_time", infractionTime,
+	)
+
+	k.slashingKeeper.Slash(
+		ctx,
+		consAddr,
+		k.slashingKeeper.SlashFractionDoubleSign(ctx),
+		validator.GetConsensusPower(), infractionHeight-sdk.ValidatorUpdateDelay,
+	)
+
+	if !validator.IsJailed() {
+		k.slashingKeeper.Jail(ctx, consAddr)
+	}
+
+	k.slashingKeeper.JailUntil(ctx, consAddr, types.DoubleSignJailEndTime)
+	k.slashingKeeper.Tombstone(ctx, consAddr)
+
+	return nil
+}
+
+// isEvidenceExpired returns true if the infraction is too old to be handled.
+// Evidence is considered stale if the difference in time and number of blocks
+// is greater than the allowed consensus parameters.
+func (k Keeper) isEvidenceExpired(ctx sdk.Context, infractionHeight int64, infractionTime time.Time) bool {
+	cp := ctx.ConsensusParams()
+	if cp == nil || cp.Evidence == nil {
+		return false
+	}
+
+	ageDuration := ctx.BlockHeader().Time.Sub(infractionTime)
+	ageBlocks := ctx.BlockHeader().Height - infractionHeight
+
+	return ageDuration > cp.Evidence.MaxAgeDuration && ageBlocks > cp.Evidence.MaxAgeNumBlocks
+}
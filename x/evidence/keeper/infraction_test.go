@@ -111,3 +111,84 @@ func (suite *KeeperTestSuite) TestHandleDoubleSign_TooOld() {
 	suite.False(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
 	suite.False(suite.app.SlashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(val.Address())))
 }
+
+func (suite *KeeperTestSuite) TestHandleLightClientAttack() {
+	ctx := suite.ctx.WithIsCheckTx(false).WithBlockHeight(1).WithBlockTime(time.Unix(100, 0))
+	suite.populateValidators(ctx)
+
+	power := int64(100)
+	operatorAddr, val := valAddresses[0], pubkeys[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	tstaking := teststaking.NewHelper(suite.T(), ctx, suite.app.StakingKeeper)
+
+	selfDelegation := tstaking.CreateValidatorWithValPower(operatorAddr, val, power, true)
+	staking.EndBlocker(ctx, suite.app.StakingKeeper)
+
+	// handle a signature to set signing info
+	suite.app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), selfDelegation.Int64(), true)
+
+	params := suite.app.EvidenceKeeper.GetParams(ctx)
+	params.SlashFractionLightClientAttack = sdk.NewDecWithPrec(1, 1)
+	params.LightClientAttackJailDuration = time.Hour
+	suite.app.EvidenceKeeper.SetParams(ctx, params)
+
+	evidence := &types.LightClientAttack{
+		Height:           1,
+		Time:             ctx.BlockTime(),
+		Power:            power,
+		ConsensusAddress: consAddr.String(),
+		TotalPower:       power,
+	}
+	suite.app.EvidenceKeeper.HandleLightClientAttackEvidence(ctx, evidence)
+
+	// should be slashed by the light client attack slash fraction
+	validator := suite.app.StakingKeeper.Validator(ctx, operatorAddr)
+	suite.Equal(selfDelegation.ToDec().Mul(sdk.NewDecWithPrec(9, 1)).TruncateInt(), validator.GetTokens())
+
+	// should be jailed for the light client attack jail duration, but not tombstoned
+	suite.True(validator.IsJailed())
+	suite.False(suite.app.SlashingKeeper.IsTombstoned(ctx, consAddr))
+	signInfo, found := suite.app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	suite.True(found)
+	suite.Equal(ctx.BlockTime().Add(time.Hour), signInfo.JailedUntil)
+
+	// the same evidence is not handled twice
+	suite.app.EvidenceKeeper.HandleLightClientAttackEvidence(ctx, evidence)
+	suite.Equal(validator.GetTokens(), suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens())
+	suite.Len(suite.app.EvidenceKeeper.GetAllEvidence(ctx), 1)
+
+	// the validator can unjail once the jail duration has elapsed
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	suite.NoError(suite.app.SlashingKeeper.Unjail(ctx, operatorAddr))
+	suite.False(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
+}
+
+func (suite *KeeperTestSuite) TestHandleLightClientAttack_TooOld() {
+	ctx := suite.ctx.WithIsCheckTx(false).WithBlockHeight(1).WithBlockTime(time.Now())
+	suite.populateValidators(ctx)
+
+	power := int64(100)
+	operatorAddr, val := valAddresses[0], pubkeys[0]
+	tstaking := teststaking.NewHelper(suite.T(), ctx, suite.app.StakingKeeper)
+
+	tstaking.CreateValidatorWithValPower(operatorAddr, val, power, true)
+	staking.EndBlocker(ctx, suite.app.StakingKeeper)
+
+	evidence := &types.LightClientAttack{
+		Height:           0,
+		Time:             ctx.BlockTime(),
+		Power:            power,
+		ConsensusAddress: sdk.ConsAddress(val.Address()).String(),
+		TotalPower:       power,
+	}
+
+	cp := suite.app.BaseApp.GetConsensusParams(ctx)
+
+	ctx = ctx.WithConsensusParams(cp)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(cp.Evidence.MaxAgeDuration + 1))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + cp.Evidence.MaxAgeNumBlocks + 1)
+	suite.app.EvidenceKeeper.HandleLightClientAttackEvidence(ctx, evidence)
+
+	suite.False(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
+	suite.Empty(suite.app.EvidenceKeeper.GetAllEvidence(ctx))
+}
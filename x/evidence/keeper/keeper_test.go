@@ -85,7 +85,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 
 	// recreate keeper in order to use custom testing types
 	evidenceKeeper := keeper.NewKeeper(
-		app.AppCodec(), app.GetKey(types.StoreKey), app.StakingKeeper, app.SlashingKeeper,
+		app.AppCodec(), app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName), app.StakingKeeper, app.SlashingKeeper,
 	)
 	router := types.NewRouter()
 	router = router.AddRoute(types.RouteEquivocation, testEquivocationHandler(*evidenceKeeper))
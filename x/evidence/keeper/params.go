@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+)
+
+// GetParams returns the total set of evidence parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the evidence parameters to the param space.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// SlashFractionLightClientAttack returns the fraction of the stake of a
+// validator slashed for a light client attack.
+func (k Keeper) SlashFractionLightClientAttack(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeySlashFractionLightClientAttack, &res)
+	return
+}
+
+// LightClientAttackJailDuration returns the duration for which a validator is
+// jailed for a light client attack.
+func (k Keeper) LightClientAttackJailDuration(ctx sdk.Context) (res time.Duration) {
+	k.paramSpace.Get(ctx, types.KeyLightClientAttackJailDuration, &res)
+	return
+}
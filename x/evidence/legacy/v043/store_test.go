@@ -0,0 +1,33 @@
+package v043_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v043evidence "github.com/cosmos/cosmos-sdk/x/evidence/legacy/v043"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	ctx := testutil.DefaultContext(paramsKey, paramsTKey)
+
+	subspace := paramtypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, paramsKey, paramsTKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	// The evidence module has no params before the migration.
+	require.False(t, subspace.Has(ctx, types.KeySlashFractionLightClientAttack))
+
+	require.NoError(t, v043evidence.MigrateParams(ctx, subspace))
+
+	var params types.Params
+	subspace.GetParamSet(ctx, &params)
+	require.Equal(t, types.DefaultParams(), params)
+}
@@ -6,7 +6,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
@@ -14,13 +16,27 @@ import (
 )
 
 // Simulation parameter constants
-const evidence = "evidence"
+const (
+	evidence                       = "evidence"
+	SlashFractionLightClientAttack = "slash_fraction_light_client_attack"
+	LightClientAttackJailDuration  = "light_client_attack_jail_duration"
+)
 
 // GenEvidences returns an empty slice of evidences.
 func GenEvidences(_ *rand.Rand, _ []simtypes.Account) []exported.Evidence {
 	return []exported.Evidence{}
 }
 
+// GenSlashFractionLightClientAttack randomized SlashFractionLightClientAttack
+func GenSlashFractionLightClientAttack(r *rand.Rand) sdk.Dec {
+	return sdk.NewDec(1).Quo(sdk.NewDec(int64(r.Intn(50) + 1)))
+}
+
+// GenLightClientAttackJailDuration randomized LightClientAttackJailDuration
+func GenLightClientAttackJailDuration(r *rand.Rand) time.Duration {
+	return time.Duration(simtypes.RandIntBetween(r, 60, 60*60*24)) * time.Second
+}
+
 // RandomizedGenState generates a random GenesisState for evidence
 func RandomizedGenState(simState *module.SimulationState) {
 	var ev []exported.Evidence
@@ -30,7 +46,20 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { ev = GenEvidences(r, simState.Accounts) },
 	)
 
-	evidenceGenesis := types.NewGenesisState(ev)
+	var slashFractionLightClientAttack sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SlashFractionLightClientAttack, &slashFractionLightClientAttack, simState.Rand,
+		func(r *rand.Rand) { slashFractionLightClientAttack = GenSlashFractionLightClientAttack(r) },
+	)
+
+	var lightClientAttackJailDuration time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, LightClientAttackJailDuration, &lightClientAttackJailDuration, simState.Rand,
+		func(r *rand.Rand) { lightClientAttackJailDuration = GenLightClientAttackJailDuration(r) },
+	)
+
+	params := types.NewParams(slashFractionLightClientAttack, lightClientAttackJailDuration)
+	evidenceGenesis := types.NewGenesisState(params, ev)
 
 	bz, err := json.MarshalIndent(&evidenceGenesis, "", " ")
 	if err != nil {
@@ -7,8 +7,12 @@ import (
 
 // x/evidence module sentinel errors
 var (
-	ErrNoEvidenceHandlerExists = sdkerrors.Register(ModuleName, 2, "unregistered handler for evidence type")
-	ErrInvalidEvidence         = sdkerrors.Register(ModuleName, 3, "invalid evidence")
-	ErrNoEvidenceExists        = sdkerrors.Register(ModuleName, 4, "evidence does not exist")
-	ErrEvidenceExists          = sdkerrors.Register(ModuleName, 5, "evidence already exists")
+	ErrNoEvidenceHandlerExists  = sdkerrors.Register(ModuleName, 2, "unregistered handler for evidence type")
+	ErrInvalidEvidence          = sdkerrors.Register(ModuleName, 3, "invalid evidence")
+	ErrNoEvidenceExists         = sdkerrors.Register(ModuleName, 4, "evidence does not exist")
+	ErrEvidenceExists           = sdkerrors.Register(ModuleName, 5, "evidence already exists")
+	ErrNoValidatorExists        = sdkerrors.Register(ModuleName, 6, "bonded validator does not exist")
+	ErrInvalidEvidenceSignature = sdkerrors.Register(ModuleName, 7, "invalid evidence signature")
+	ErrEvidenceTooOld           = sdkerrors.Register(ModuleName, 8, "evidence too old")
+	ErrValidatorTombstoned      = sdkerrors.Register(ModuleName, 9, "validator already tombstoned")
 )
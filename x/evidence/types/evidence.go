@@ -7,6 +7,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	tmtypes "github.com/tendermint/tendermint/types"
 	"gopkg.in/yaml.v2"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,11 +16,19 @@ import (
 
 // Evidence type constants
 const (
-	RouteEquivocation = "equivocation"
-	TypeEquivocation  = "equivocation"
+	RouteEquivocation      = "equivocation"
+	TypeEquivocation       = "equivocation"
+	RouteLightClientAttack = "lightclientattack"
+	TypeLightClientAttack  = "lightclientattack"
+	RouteDoubleProposal    = "doubleproposal"
+	TypeDoubleProposal     = "doubleproposal"
 )
 
-var _ exported.Evidence = &Equivocation{}
+var (
+	_ exported.ValidatorEvidence = &Equivocation{}
+	_ exported.ValidatorEvidence = &LightClientAttack{}
+	_ exported.Evidence          = &DoubleProposal{}
+)
 
 // Route returns the Evidence Handler route for an Equivocation type.
 func (e *Equivocation) Route() string { return RouteEquivocation }
@@ -85,8 +94,152 @@ func (e Equivocation) GetValidatorPower() int64 {
 // GetTotalPower is a no-op for the Equivocation type.
 func (e Equivocation) GetTotalPower() int64 { return 0 }
 
-// FromABCIEvidence converts a Tendermint concrete Evidence type to
-// SDK Evidence using Equivocation as the concrete type.
+// Route returns the Evidence Handler route for a LightClientAttack type.
+func (e *LightClientAttack) Route() string { return RouteLightClientAttack }
+
+// Type returns the Evidence Handler type for a LightClientAttack type.
+func (e *LightClientAttack) Type() string { return TypeLightClientAttack }
+
+func (e *LightClientAttack) String() string {
+	bz, _ := yaml.Marshal(e)
+	return string(bz)
+}
+
+// Hash returns the hash of a LightClientAttack object.
+func (e *LightClientAttack) Hash() tmbytes.HexBytes {
+	bz, err := e.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return tmhash.Sum(bz)
+}
+
+// ValidateBasic performs basic stateless validation checks on a
+// LightClientAttack object.
+func (e *LightClientAttack) ValidateBasic() error {
+	if e.Time.Unix() <= 0 {
+		return fmt.Errorf("invalid light client attack time: %s", e.Time)
+	}
+	if e.Height < 1 {
+		return fmt.Errorf("invalid light client attack height: %d", e.Height)
+	}
+	if e.Power < 1 {
+		return fmt.Errorf("invalid light client attack validator power: %d", e.Power)
+	}
+	if e.ConsensusAddress == "" {
+		return fmt.Errorf("invalid light client attack validator consensus address: %s", e.ConsensusAddress)
+	}
+	if e.TotalPower < e.Power {
+		return fmt.Errorf("invalid light client attack total power: %d", e.TotalPower)
+	}
+
+	return nil
+}
+
+// GetConsensusAddress returns the validator's consensus address at time of the
+// LightClientAttack infraction.
+func (e LightClientAttack) GetConsensusAddress() sdk.ConsAddress {
+	addr, _ := sdk.ConsAddressFromBech32(e.ConsensusAddress)
+	return addr
+}
+
+// GetHeight returns the height at time of the LightClientAttack infraction.
+func (e LightClientAttack) GetHeight() int64 {
+	return e.Height
+}
+
+// GetTime returns the time at time of the LightClientAttack infraction.
+func (e LightClientAttack) GetTime() time.Time {
+	return e.Time
+}
+
+// GetValidatorPower returns the validator's power at time of the
+// LightClientAttack infraction.
+func (e LightClientAttack) GetValidatorPower() int64 {
+	return e.Power
+}
+
+// GetTotalPower returns the total power of the validator set at time of the
+// LightClientAttack infraction.
+func (e LightClientAttack) GetTotalPower() int64 {
+	return e.TotalPower
+}
+
+// Route returns the Evidence Handler route for a DoubleProposal type.
+func (e *DoubleProposal) Route() string { return RouteDoubleProposal }
+
+// Type returns the Evidence Handler type for a DoubleProposal type.
+func (e *DoubleProposal) Type() string { return TypeDoubleProposal }
+
+func (e *DoubleProposal) String() string {
+	bz, _ := yaml.Marshal(e)
+	return string(bz)
+}
+
+// Hash returns the hash of a DoubleProposal object.
+func (e *DoubleProposal) Hash() tmbytes.HexBytes {
+	bz, err := e.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return tmhash.Sum(bz)
+}
+
+// ValidateBasic performs basic stateless validation checks on a DoubleProposal
+// object. Both proposals must be valid and signed, and propose different blocks
+// at the same height and round. The signatures are verified by the Handler, as
+// it requires the chain ID and the public key of the validator.
+func (e *DoubleProposal) ValidateBasic() error {
+	if e.ConsensusAddress == "" {
+		return fmt.Errorf("invalid double proposal validator consensus address: %s", e.ConsensusAddress)
+	}
+
+	proposalA, err := tmtypes.ProposalFromProto(e.ProposalA)
+	if err != nil {
+		return fmt.Errorf("invalid double proposal first proposal: %w", err)
+	}
+
+	proposalB, err := tmtypes.ProposalFromProto(e.ProposalB)
+	if err != nil {
+		return fmt.Errorf("invalid double proposal second proposal: %w", err)
+	}
+
+	if proposalA.Height < 1 {
+		return fmt.Errorf("invalid double proposal height: %d", proposalA.Height)
+	}
+	if proposalA.Height != proposalB.Height || proposalA.Round != proposalB.Round {
+		return fmt.Errorf(
+			"double proposal height and round mismatch: %d/%d != %d/%d",
+			proposalA.Height, proposalA.Round, proposalB.Height, proposalB.Round,
+		)
+	}
+	if proposalA.BlockID.Equals(proposalB.BlockID) {
+		return fmt.Errorf("double proposal of the same block: %s", proposalA.BlockID)
+	}
+
+	return nil
+}
+
+// GetConsensusAddress returns the consensus address of the validator which
+// signed both proposals.
+func (e DoubleProposal) GetConsensusAddress() sdk.ConsAddress {
+	addr, _ := sdk.ConsAddressFromBech32(e.ConsensusAddress)
+	return addr
+}
+
+// GetHeight returns the height of the proposals.
+func (e DoubleProposal) GetHeight() int64 {
+	return e.ProposalA.Height
+}
+
+// GetTime returns the time of the first proposal.
+func (e DoubleProposal) GetTime() time.Time {
+	return e.ProposalA.Timestamp
+}
+
+// FromABCIEvidence converts a Tendermint concrete Evidence type to SDK
+// Evidence, using LightClientAttack as the concrete type for light client
+// attacks and Equivocation for any other type.
 func FromABCIEvidence(e abci.Evidence) exported.Evidence {
 	bech32PrefixConsAddr := sdk.GetConfig().GetBech32ConsensusAddrPrefix()
 	consAddr, err := sdk.Bech32ifyAddressBytes(bech32PrefixConsAddr, e.Validator.Address)
@@ -94,6 +247,16 @@ func FromABCIEvidence(e abci.Evidence) exported.Evidence {
 		panic(err)
 	}
 
+	if e.Type == abci.EvidenceType_LIGHT_CLIENT_ATTACK {
+		return &LightClientAttack{
+			Height:           e.Height,
+			Power:            e.Validator.Power,
+			ConsensusAddress: consAddr,
+			Time:             e.Time,
+			TotalPower:       e.TotalVotingPower,
+		}
+	}
+
 	return &Equivocation{
 		Height:           e.Height,
 		Power:            e.Validator.Power,
@@ -5,9 +5,12 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	types "github.com/tendermint/tendermint/proto/tendermint/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -68,8 +71,144 @@ func (m *Equivocation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Equivocation proto.InternalMessageInfo
 
+// LightClientAttack implements the Evidence interface and defines evidence of a
+// validator which signed a header conflicting with the canonical chain in order
+// to deceive a light client.
+type LightClientAttack struct {
+	Height           int64     `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Time             time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	Power            int64     `protobuf:"varint,3,opt,name=power,proto3" json:"power,omitempty"`
+	ConsensusAddress string    `protobuf:"bytes,4,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty" yaml:"consensus_address"`
+	TotalPower       int64     `protobuf:"varint,5,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty" yaml:"total_power"`
+}
+
+func (m *LightClientAttack) Reset()      { *m = LightClientAttack{} }
+func (*LightClientAttack) ProtoMessage() {}
+func (*LightClientAttack) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{1}
+}
+func (m *LightClientAttack) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LightClientAttack) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LightClientAttack.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LightClientAttack) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightClientAttack.Merge(m, src)
+}
+func (m *LightClientAttack) XXX_Size() int {
+	return m.Size()
+}
+func (m *LightClientAttack) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightClientAttack.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LightClientAttack proto.InternalMessageInfo
+
+// DoubleProposal implements the Evidence interface and defines evidence of a
+// validator which signed two proposals for different blocks at the same height
+// and round.
+type DoubleProposal struct {
+	ConsensusAddress string          `protobuf:"bytes,1,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty" yaml:"consensus_address"`
+	ProposalA        *types.Proposal `protobuf:"bytes,2,opt,name=proposal_a,json=proposalA,proto3" json:"proposal_a,omitempty" yaml:"proposal_a"`
+	ProposalB        *types.Proposal `protobuf:"bytes,3,opt,name=proposal_b,json=proposalB,proto3" json:"proposal_b,omitempty" yaml:"proposal_b"`
+}
+
+func (m *DoubleProposal) Reset()      { *m = DoubleProposal{} }
+func (*DoubleProposal) ProtoMessage() {}
+func (*DoubleProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{2}
+}
+func (m *DoubleProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DoubleProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DoubleProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DoubleProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoubleProposal.Merge(m, src)
+}
+func (m *DoubleProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *DoubleProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoubleProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DoubleProposal proto.InternalMessageInfo
+
+// Params defines the parameters for the evidence module.
+type Params struct {
+	// slash_fraction_light_client_attack is the fraction of the stake of a
+	// validator slashed for a light client attack.
+	SlashFractionLightClientAttack github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=slash_fraction_light_client_attack,json=slashFractionLightClientAttack,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_light_client_attack" yaml:"slash_fraction_light_client_attack"`
+	// light_client_attack_jail_duration is the duration for which a validator is
+	// jailed for a light client attack.
+	LightClientAttackJailDuration time.Duration `protobuf:"bytes,2,opt,name=light_client_attack_jail_duration,json=lightClientAttackJailDuration,proto3,stdduration" json:"light_client_attack_jail_duration" yaml:"light_client_attack_jail_duration"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{3}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetLightClientAttackJailDuration() time.Duration {
+	if m != nil {
+		return m.LightClientAttackJailDuration
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Equivocation)(nil), "cosmos.evidence.v1beta1.Equivocation")
+	proto.RegisterType((*LightClientAttack)(nil), "cosmos.evidence.v1beta1.LightClientAttack")
+	proto.RegisterType((*DoubleProposal)(nil), "cosmos.evidence.v1beta1.DoubleProposal")
+	proto.RegisterType((*Params)(nil), "cosmos.evidence.v1beta1.Params")
 }
 
 func init() {
@@ -77,30 +216,73 @@ func init() {
 }
 
 var fileDescriptor_dd143e71a177f0dd = []byte{
-	// 324 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0x3f, 0x4f, 0x02, 0x31,
-	0x18, 0xc6, 0x5b, 0x41, 0xa2, 0x27, 0x83, 0x5e, 0x88, 0x5e, 0x88, 0x69, 0x09, 0x83, 0x61, 0xe1,
-	0x1a, 0x74, 0x31, 0x6c, 0x92, 0x38, 0x18, 0x37, 0xe2, 0xe4, 0x62, 0xee, 0x4f, 0x2d, 0x8d, 0xdc,
-	0xbd, 0x27, 0xed, 0xa1, 0x7c, 0x03, 0x47, 0x46, 0x47, 0x46, 0x3f, 0x0a, 0x9b, 0x8c, 0x4e, 0x68,
-	0x8e, 0xc5, 0xd9, 0x4f, 0x60, 0xb8, 0x02, 0x0e, 0x4e, 0xed, 0xf3, 0xe4, 0xf7, 0xfe, 0x92, 0xf7,
-	0xb5, 0x4e, 0x02, 0x50, 0x11, 0x28, 0xc6, 0x87, 0x32, 0xe4, 0x71, 0xc0, 0xd9, 0xb0, 0xe5, 0x73,
-	0xed, 0xb5, 0x36, 0x85, 0x9b, 0x0c, 0x40, 0x83, 0x7d, 0x64, 0x38, 0x77, 0x53, 0xaf, 0xb8, 0x6a,
-	0x45, 0x80, 0x80, 0x9c, 0x61, 0xcb, 0x9f, 0xc1, 0xab, 0x54, 0x00, 0x88, 0x3e, 0x67, 0x79, 0xf2,
-	0xd3, 0x7b, 0xa6, 0x65, 0xc4, 0x95, 0xf6, 0xa2, 0xc4, 0x00, 0xf5, 0x77, 0x6c, 0x95, 0x2f, 0x1f,
-	0x53, 0x39, 0x84, 0xc0, 0xd3, 0x12, 0x62, 0xfb, 0xd0, 0x2a, 0xf5, 0xb8, 0x14, 0x3d, 0xed, 0xe0,
-	0x1a, 0x6e, 0x14, 0xba, 0xab, 0x64, 0x9f, 0x5b, 0xc5, 0xe5, 0xac, 0xb3, 0x55, 0xc3, 0x8d, 0xbd,
-	0xd3, 0xaa, 0x6b, 0xc4, 0xee, 0x5a, 0xec, 0xde, 0xac, 0xc5, 0x9d, 0x9d, 0xe9, 0x9c, 0xa2, 0xf1,
-	0x27, 0xc5, 0xdd, 0x7c, 0xc2, 0xae, 0x58, 0xdb, 0x09, 0x3c, 0xf1, 0x81, 0x53, 0xc8, 0x85, 0x26,
-	0xd8, 0x57, 0xd6, 0x41, 0x00, 0xb1, 0xe2, 0xb1, 0x4a, 0xd5, 0x9d, 0x17, 0x86, 0x03, 0xae, 0x94,
-	0x53, 0xac, 0xe1, 0xc6, 0x6e, 0xe7, 0xf8, 0x67, 0x4e, 0x9d, 0x91, 0x17, 0xf5, 0xdb, 0xf5, 0x7f,
-	0x48, 0xbd, 0xbb, 0xbf, 0xe9, 0x2e, 0x4c, 0xd5, 0x2e, 0xbf, 0x4c, 0x28, 0x7a, 0x9d, 0x50, 0xf4,
-	0x3d, 0xa1, 0xa8, 0x73, 0xfd, 0x96, 0x11, 0x3c, 0xcd, 0x08, 0x9e, 0x65, 0x04, 0x7f, 0x65, 0x04,
-	0x8f, 0x17, 0x04, 0xcd, 0x16, 0x04, 0x7d, 0x2c, 0x08, 0xba, 0x6d, 0x0a, 0xa9, 0x7b, 0xa9, 0xef,
-	0x06, 0x10, 0xb1, 0xd5, 0xc9, 0xcd, 0xd3, 0x54, 0xe1, 0x03, 0x7b, 0xfe, 0xbb, 0xbf, 0x1e, 0x25,
-	0x5c, 0xf9, 0xa5, 0x7c, 0xbf, 0xb3, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x61, 0x33, 0x3a, 0x69,
-	0x9f, 0x01, 0x00, 0x00,
+	// 590 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xf6, 0xf5, 0x97, 0xe8, 0xb5, 0x42, 0xd4, 0x2a, 0xc5, 0x54, 0xc5, 0x57, 0x3c, 0x54, 0x61,
+	0xa8, 0xad, 0x02, 0x12, 0x28, 0x5b, 0x4c, 0x40, 0xe2, 0xc7, 0x10, 0x59, 0x4c, 0x30, 0x58, 0x67,
+	0xfb, 0xea, 0x98, 0xda, 0x3e, 0xe3, 0x3b, 0x07, 0xfa, 0x1f, 0xb0, 0x20, 0x75, 0x2c, 0x5b, 0x36,
+	0xf8, 0x53, 0xba, 0xd1, 0x81, 0x01, 0x31, 0x18, 0x94, 0x2c, 0xcc, 0xf9, 0x0b, 0x90, 0x7d, 0x76,
+	0x52, 0x92, 0x8a, 0x4a, 0xdd, 0x58, 0x6c, 0xbf, 0xf7, 0xbe, 0xfb, 0xde, 0xbb, 0xcf, 0xdf, 0x1d,
+	0xdc, 0x71, 0x29, 0x8b, 0x28, 0x33, 0x48, 0x2f, 0xf0, 0x48, 0xec, 0x12, 0xa3, 0xb7, 0xe7, 0x10,
+	0x8e, 0xf7, 0xc6, 0x09, 0x3d, 0x49, 0x29, 0xa7, 0xf2, 0x0d, 0x81, 0xd3, 0xc7, 0xe9, 0x0a, 0xb7,
+	0xb9, 0xee, 0x53, 0x9f, 0x96, 0x18, 0xa3, 0xf8, 0x12, 0xf0, 0x4d, 0xd5, 0xa7, 0xd4, 0x0f, 0x89,
+	0x51, 0x46, 0x4e, 0xb6, 0x6f, 0x78, 0x59, 0x8a, 0x79, 0x40, 0xe3, 0xaa, 0x8e, 0xa6, 0xeb, 0x3c,
+	0x88, 0x08, 0xe3, 0x38, 0x4a, 0x2a, 0xc0, 0x16, 0x27, 0xb1, 0x47, 0xd2, 0x28, 0x88, 0xb9, 0xc1,
+	0x0f, 0x13, 0xc2, 0xc4, 0x53, 0x54, 0xb5, 0xaf, 0x00, 0xae, 0x3e, 0x7e, 0x9b, 0x05, 0x3d, 0xea,
+	0x96, 0xac, 0xf2, 0x06, 0x5c, 0xea, 0x92, 0xc0, 0xef, 0x72, 0x05, 0x6c, 0x83, 0xc6, 0xbc, 0x55,
+	0x45, 0xf2, 0x43, 0xb8, 0x50, 0x30, 0x2b, 0x73, 0xdb, 0xa0, 0xb1, 0x72, 0x77, 0x53, 0x17, 0x6d,
+	0xf5, 0xba, 0xad, 0xfe, 0xb2, 0x6e, 0x6b, 0x5e, 0x39, 0xc9, 0x91, 0x74, 0xf4, 0x13, 0x01, 0xab,
+	0x5c, 0x21, 0xaf, 0xc3, 0xc5, 0x84, 0xbe, 0x23, 0xa9, 0x32, 0x5f, 0x12, 0x8a, 0x40, 0x7e, 0x0a,
+	0xd7, 0x5c, 0x1a, 0x33, 0x12, 0xb3, 0x8c, 0xd9, 0xd8, 0xf3, 0x52, 0xc2, 0x98, 0xb2, 0xb0, 0x0d,
+	0x1a, 0xcb, 0xe6, 0xd6, 0x28, 0x47, 0xca, 0x21, 0x8e, 0xc2, 0xa6, 0x36, 0x03, 0xd1, 0xac, 0x6b,
+	0xe3, 0x5c, 0x4b, 0xa4, 0x9a, 0xab, 0x1f, 0xfa, 0x48, 0x3a, 0xee, 0x23, 0xe9, 0x77, 0x1f, 0x49,
+	0xda, 0xc7, 0x39, 0xb8, 0xf6, 0xa2, 0x18, 0xf9, 0x51, 0x18, 0x90, 0x98, 0xb7, 0x38, 0xc7, 0xee,
+	0xc1, 0x7f, 0xb8, 0x2d, 0xf9, 0x01, 0x5c, 0xe1, 0x94, 0xe3, 0xd0, 0x16, 0x6d, 0x16, 0x8b, 0x36,
+	0xe6, 0xc6, 0x28, 0x47, 0xb2, 0x20, 0x39, 0x53, 0xd4, 0x2c, 0x58, 0x46, 0x9d, 0x22, 0x98, 0xd5,
+	0xe3, 0x6a, 0x9b, 0x66, 0x4e, 0x48, 0x3a, 0x29, 0x4d, 0x28, 0xc3, 0xe1, 0xf9, 0x43, 0x82, 0x4b,
+	0x0d, 0xd9, 0x81, 0x30, 0xa9, 0x68, 0x6d, 0x3c, 0x56, 0x71, 0x62, 0x39, 0x5d, 0x98, 0xad, 0x6e,
+	0x6d, 0x5e, 0x1f, 0xe5, 0x68, 0x4d, 0xf0, 0x4f, 0xd6, 0x69, 0xd6, 0x72, 0x1d, 0xb4, 0xfe, 0x62,
+	0x74, 0x94, 0xf9, 0x4b, 0x31, 0x3a, 0x67, 0x18, 0xcd, 0x29, 0x3d, 0xbe, 0xcd, 0xc1, 0xa5, 0x0e,
+	0x4e, 0x71, 0xc4, 0xe4, 0xcf, 0x00, 0x6a, 0x2c, 0xc4, 0xac, 0x6b, 0xef, 0xa7, 0xd8, 0x2d, 0xec,
+	0x6f, 0x87, 0x85, 0x2b, 0x6c, 0xb7, 0xb4, 0x8e, 0x8d, 0x4b, 0xef, 0x54, 0xca, 0xbc, 0x2e, 0xfe,
+	0xff, 0x8f, 0x1c, 0xed, 0xf8, 0x01, 0xef, 0x66, 0x8e, 0xee, 0xd2, 0xc8, 0xa8, 0x8e, 0xbc, 0x78,
+	0xed, 0x32, 0xef, 0xa0, 0x3a, 0x5b, 0x6d, 0xe2, 0x8e, 0x72, 0x74, 0x47, 0x4c, 0x75, 0x71, 0x07,
+	0xcd, 0x52, 0x4b, 0xd0, 0x93, 0x0a, 0x33, 0x6b, 0xdf, 0x4f, 0x00, 0xde, 0x3e, 0x67, 0xa1, 0xfd,
+	0x06, 0x07, 0xa1, 0x5d, 0xdf, 0x08, 0x95, 0xfc, 0x37, 0x67, 0x4c, 0xdc, 0xae, 0x00, 0xe6, 0xfd,
+	0x62, 0x0f, 0xa3, 0x1c, 0x35, 0xc4, 0x64, 0x17, 0x32, 0x6a, 0xc7, 0x85, 0xdf, 0x6f, 0x85, 0xd3,
+	0xf3, 0x3c, 0xc3, 0x41, 0x58, 0x93, 0x36, 0x17, 0x0a, 0x69, 0xcd, 0xe7, 0x5f, 0x06, 0x2a, 0x38,
+	0x19, 0xa8, 0xe0, 0x74, 0xa0, 0x82, 0x5f, 0x03, 0x15, 0x1c, 0x0d, 0x55, 0xe9, 0x74, 0xa8, 0x4a,
+	0xdf, 0x87, 0xaa, 0xf4, 0x6a, 0xf7, 0x9f, 0xa2, 0xbd, 0x9f, 0x5c, 0x9a, 0xa5, 0x7e, 0xce, 0x52,
+	0x39, 0xfa, 0xbd, 0x3f, 0x03, 0x00, 0xee, 0xb0, 0xec, 0xbb, 0x54, 0x05, 0x00, 0x00,
 }
 
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.SlashFractionLightClientAttack.Equal(that1.SlashFractionLightClientAttack) {
+		return false
+	}
+	if this.LightClientAttackJailDuration != that1.LightClientAttackJailDuration {
+		return false
+	}
+	return true
+}
 func (m *Equivocation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -149,6 +331,154 @@ func (m *Equivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LightClientAttack) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LightClientAttack) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LightClientAttack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalPower != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Power != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x18
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintEvidence(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoubleProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoubleProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoubleProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalB != nil {
+		{
+			size, err := m.ProposalB.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProposalA != nil {
+		{
+			size, err := m.ProposalA.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.LightClientAttackJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.LightClientAttackJailDuration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintEvidence(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.SlashFractionLightClientAttack.Size()
+		i -= size
+		if _, err := m.SlashFractionLightClientAttack.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvidence(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvidence(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvidence(v)
 	base := offset
@@ -181,6 +511,64 @@ func (m *Equivocation) Size() (n int) {
 	return n
 }
 
+func (m *LightClientAttack) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovEvidence(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovEvidence(uint64(l))
+	if m.Power != 0 {
+		n += 1 + sovEvidence(uint64(m.Power))
+	}
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovEvidence(uint64(m.TotalPower))
+	}
+	return n
+}
+
+func (m *DoubleProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.ProposalA != nil {
+		l = m.ProposalA.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.ProposalB != nil {
+		l = m.ProposalB.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SlashFractionLightClientAttack.Size()
+	n += 1 + l + sovEvidence(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.LightClientAttackJailDuration)
+	n += 1 + l + sovEvidence(uint64(l))
+	return n
+}
+
 func sovEvidence(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -340,6 +728,449 @@ func (m *Equivocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LightClientAttack) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LightClientAttack: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LightClientAttack: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			m.TotalPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DoubleProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoubleProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoubleProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalA", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProposalA == nil {
+				m.ProposalA = &types.Proposal{}
+			}
+			if err := m.ProposalA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProposalB == nil {
+				m.ProposalB = &types.Proposal{}
+			}
+			if err := m.ProposalB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionLightClientAttack", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionLightClientAttack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LightClientAttackJailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.LightClientAttackJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvidence(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,11 +1,14 @@
 package types_test
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
@@ -77,3 +80,93 @@ func TestEvidenceAddressConversion(t *testing.T) {
 	require.Equal(t, tmEvidence.Validator.Address, consAddr.Bytes())
 	sdk.GetConfig().SetBech32PrefixForConsensusNode(sdk.Bech32PrefixConsAddr, sdk.Bech32PrefixConsPub)
 }
+
+func TestLightClientAttackValidateBasic(t *testing.T) {
+	var zeroTime time.Time
+	addr := sdk.ConsAddress("foo_________________")
+
+	n, _ := time.Parse(time.RFC3339, "2006-01-02T15:04:05Z")
+	testCases := []struct {
+		name      string
+		e         types.LightClientAttack
+		expectErr bool
+	}{
+		{"valid", types.LightClientAttack{100, n, 1000000, addr.String(), 2000000}, false},
+		{"invalid time", types.LightClientAttack{100, zeroTime, 1000000, addr.String(), 2000000}, true},
+		{"invalid height", types.LightClientAttack{0, n, 1000000, addr.String(), 2000000}, true},
+		{"invalid power", types.LightClientAttack{100, n, 0, addr.String(), 2000000}, true},
+		{"invalid address", types.LightClientAttack{100, n, 1000000, "", 2000000}, true},
+		{"invalid total power", types.LightClientAttack{100, n, 1000000, addr.String(), 999999}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectErr, tc.e.ValidateBasic() != nil)
+		})
+	}
+}
+
+func TestLightClientAttackFromABCIEvidence(t *testing.T) {
+	tmEvidence := abci.Evidence{
+		Type: abci.EvidenceType_LIGHT_CLIENT_ATTACK,
+		Validator: abci.Validator{
+			Address: []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+			Power:   100,
+		},
+		Height:           1,
+		Time:             time.Now(),
+		TotalVotingPower: 300,
+	}
+
+	evidence, ok := types.FromABCIEvidence(tmEvidence).(*types.LightClientAttack)
+	require.True(t, ok)
+	require.Equal(t, tmEvidence.Validator.Address, evidence.GetConsensusAddress().Bytes())
+	require.Equal(t, tmEvidence.Validator.Power, evidence.GetValidatorPower())
+	require.Equal(t, tmEvidence.TotalVotingPower, evidence.GetTotalPower())
+	require.Equal(t, types.RouteLightClientAttack, evidence.Route())
+	require.NoError(t, evidence.ValidateBasic())
+}
+
+func TestDoubleProposalValidateBasic(t *testing.T) {
+	addr := sdk.ConsAddress("foo_________________")
+
+	newProposal := func(height int64, round int32, hash byte) *tmproto.Proposal {
+		return &tmproto.Proposal{
+			Type:     tmproto.ProposalType,
+			Height:   height,
+			Round:    round,
+			PolRound: -1,
+			BlockID: tmproto.BlockID{
+				Hash:          bytes.Repeat([]byte{hash}, tmhash.Size),
+				PartSetHeader: tmproto.PartSetHeader{Total: 1, Hash: bytes.Repeat([]byte{hash}, tmhash.Size)},
+			},
+			Signature: []byte("signature"),
+		}
+	}
+
+	unsigned := newProposal(100, 0, 0x02)
+	unsigned.Signature = nil
+
+	testCases := []struct {
+		name      string
+		e         types.DoubleProposal
+		expectErr bool
+	}{
+		{"valid", types.DoubleProposal{addr.String(), newProposal(100, 0, 0x01), newProposal(100, 0, 0x02)}, false},
+		{"invalid address", types.DoubleProposal{"", newProposal(100, 0, 0x01), newProposal(100, 0, 0x02)}, true},
+		{"missing proposal", types.DoubleProposal{addr.String(), newProposal(100, 0, 0x01), nil}, true},
+		{"missing signature", types.DoubleProposal{addr.String(), newProposal(100, 0, 0x01), unsigned}, true},
+		{"invalid height", types.DoubleProposal{addr.String(), newProposal(0, 0, 0x01), newProposal(0, 0, 0x02)}, true},
+		{"different heights", types.DoubleProposal{addr.String(), newProposal(100, 0, 0x01), newProposal(101, 0, 0x02)}, true},
+		{"different rounds", types.DoubleProposal{addr.String(), newProposal(100, 0, 0x01), newProposal(100, 1, 0x02)}, true},
+		{"same block", types.DoubleProposal{addr.String(), newProposal(100, 0, 0x01), newProposal(100, 0, 0x01)}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectErr, tc.e.ValidateBasic() != nil)
+		})
+	}
+}
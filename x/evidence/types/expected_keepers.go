@@ -5,6 +5,7 @@ import (
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -21,6 +22,7 @@ type (
 		GetPubkey(sdk.Context, cryptotypes.Address) (cryptotypes.PubKey, error)
 		IsTombstoned(sdk.Context, sdk.ConsAddress) bool
 		HasValidatorSigningInfo(sdk.Context, sdk.ConsAddress) bool
+		GetValidatorSigningInfo(sdk.Context, sdk.ConsAddress) (slashingtypes.ValidatorSigningInfo, bool)
 		Tombstone(sdk.Context, sdk.ConsAddress)
 		Slash(sdk.Context, sdk.ConsAddress, sdk.Dec, int64, int64)
 		SlashFractionDoubleSign(sdk.Context) sdk.Dec
@@ -12,7 +12,7 @@ import (
 var _ types.UnpackInterfacesMessage = GenesisState{}
 
 // NewGenesisState creates a new genesis state for the evidence module.
-func NewGenesisState(e []exported.Evidence) *GenesisState {
+func NewGenesisState(params Params, e []exported.Evidence) *GenesisState {
 	evidence := make([]*types.Any, len(e))
 	for i, evi := range e {
 		msg, ok := evi.(proto.Message)
@@ -27,6 +27,7 @@ func NewGenesisState(e []exported.Evidence) *GenesisState {
 	}
 	return &GenesisState{
 		Evidence: evidence,
+		Params:   params,
 	}
 }
 
@@ -34,12 +35,17 @@ func NewGenesisState(e []exported.Evidence) *GenesisState {
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
 		Evidence: []*types.Any{},
+		Params:   DefaultParams(),
 	}
 }
 
 // Validate performs basic gensis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
 	for _, e := range gs.Evidence {
 		evi, ok := e.GetCachedValue().(exported.Evidence)
 		if !ok {
@@ -19,6 +19,7 @@ func TestDefaultGenesisState(t *testing.T) {
 	gs := types.DefaultGenesisState()
 	require.NotNil(t, gs.Evidence)
 	require.Len(t, gs.Evidence, 0)
+	require.Equal(t, types.DefaultParams(), gs.Params)
 }
 
 func TestNewGenesisState(t *testing.T) {
@@ -46,11 +47,11 @@ func TestNewGenesisState(t *testing.T) {
 
 			if tc.expPass {
 				require.NotPanics(t, func() {
-					types.NewGenesisState(evidence)
+					types.NewGenesisState(types.DefaultParams(), evidence)
 				})
 			} else {
 				require.Panics(t, func() {
-					types.NewGenesisState(evidence)
+					types.NewGenesisState(types.DefaultParams(), evidence)
 				})
 			}
 		})
@@ -81,7 +82,7 @@ func TestGenesisStateValidate(t *testing.T) {
 						ConsensusAddress: pk.PubKey().Address().String(),
 					}
 				}
-				genesisState = types.NewGenesisState(testEvidence)
+				genesisState = types.NewGenesisState(types.DefaultParams(), testEvidence)
 			},
 			true,
 		},
@@ -97,7 +98,7 @@ func TestGenesisStateValidate(t *testing.T) {
 						ConsensusAddress: pk.PubKey().Address().String(),
 					}
 				}
-				genesisState = types.NewGenesisState(testEvidence)
+				genesisState = types.NewGenesisState(types.DefaultParams(), testEvidence)
 			},
 			false,
 		},
@@ -106,10 +107,20 @@ func TestGenesisStateValidate(t *testing.T) {
 			func() {
 				genesisState = &types.GenesisState{
 					Evidence: []*codectypes.Any{{}},
+					Params:   types.DefaultParams(),
 				}
 			},
 			false,
 		},
+		{
+			"invalid params",
+			func() {
+				params := types.DefaultParams()
+				params.LightClientAttackJailDuration = 0
+				genesisState = types.NewGenesisState(params, []exported.Evidence{})
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1,11 +1,100 @@
 package types
 
 import (
+	"fmt"
 	"time"
-)
 
-// DONTCOVER
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
 
 // DoubleSignJailEndTime period ends at Max Time supported by Amino
 // (Dec 31, 9999 - 23:59:59 GMT).
 var DoubleSignJailEndTime = time.Unix(253402300799, 0)
+
+// Default parameter values
+var (
+	DefaultSlashFractionLightClientAttack = sdk.NewDec(1).Quo(sdk.NewDec(20))
+	DefaultLightClientAttackJailDuration  = 60 * 60 * 24 * 21 * time.Second
+)
+
+// Parameter store keys
+var (
+	KeySlashFractionLightClientAttack = []byte("SlashFractionLightClientAttack")
+	KeyLightClientAttackJailDuration  = []byte("LightClientAttackJailDuration")
+)
+
+// ParamKeyTable returns the parameter key table for the evidence module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object.
+func NewParams(slashFractionLightClientAttack sdk.Dec, lightClientAttackJailDuration time.Duration) Params {
+	return Params{
+		SlashFractionLightClientAttack: slashFractionLightClientAttack,
+		LightClientAttackJailDuration:  lightClientAttackJailDuration,
+	}
+}
+
+// DefaultParams returns the default parameters for the evidence module.
+func DefaultParams() Params {
+	return NewParams(DefaultSlashFractionLightClientAttack, DefaultLightClientAttackJailDuration)
+}
+
+// ParamSetPairs implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeySlashFractionLightClientAttack, &p.SlashFractionLightClientAttack, validateSlashFractionLightClientAttack),
+		paramtypes.NewParamSetPair(KeyLightClientAttackJailDuration, &p.LightClientAttackJailDuration, validateLightClientAttackJailDuration),
+	}
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// Validate performs basic validation on the evidence parameters.
+func (p Params) Validate() error {
+	if err := validateSlashFractionLightClientAttack(p.SlashFractionLightClientAttack); err != nil {
+		return err
+	}
+
+	return validateLightClientAttackJailDuration(p.LightClientAttackJailDuration)
+}
+
+func validateSlashFractionLightClientAttack(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("light client attack slash fraction cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("light client attack slash fraction cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("light client attack slash fraction too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateLightClientAttackJailDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("light client attack jail duration must be positive: %s", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package feeconvert
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feeconvert/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feeconvert/types"
+)
+
+// EndBlocker routes the fees collected in an alternative fee denom according to
+// the alternative fee route.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	routed, err := k.RouteAltFees(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	if !routed.IsZero() {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAltFees,
+				sdk.NewAttribute(types.AttributeKeyRoute, types.AltFeeRouteCommunityPool),
+				sdk.NewAttribute(types.AttributeKeyAmount, routed.String()),
+			),
+		)
+	}
+}
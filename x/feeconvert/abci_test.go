@@ -0,0 +1,48 @@
+package feeconvert_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feeconvert"
+	"github.com/cosmos/cosmos-sdk/x/feeconvert/types"
+)
+
+func TestEndBlocker(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	app.FeeConvertKeeper.SetParams(ctx, types.NewParams(sdk.DefaultBondDenom, []types.FeeDenom{
+		types.NewFeeDenom("atom", sdk.NewDecWithPrec(5, 1)),
+	}, types.AltFeeRouteCommunityPool))
+
+	fee := sdk.NewInt64Coin("atom", 800)
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	require.NoError(t, simapp.FundAccount(app, ctx, feeCollector, sdk.NewCoins(fee)))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	feeconvert.EndBlocker(ctx, app.FeeConvertKeeper)
+
+	require.True(t, app.BankKeeper.GetBalance(ctx, feeCollector, "atom").IsZero())
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, types.EventTypeAltFees, events[len(events)-1].Type)
+}
+
+func TestGenesis(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	params := types.NewParams("atom", []types.FeeDenom{
+		types.NewFeeDenom("photon", sdk.NewDecWithPrec(25, 2)),
+	}, types.AltFeeRouteCommunityPool)
+	genesisState := types.NewGenesisState(params)
+	feeconvert.InitGenesis(ctx, app.FeeConvertKeeper, genesisState)
+
+	require.Equal(t, genesisState, feeconvert.ExportGenesis(ctx, app.FeeConvertKeeper))
+}
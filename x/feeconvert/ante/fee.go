@@ -0,0 +1,73 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feeconvert/keeper"
+)
+
+// MempoolFeeDecorator will check if the transaction's fee is at least as large
+// as the local validator's minimum gasFee (defined in validator config), like
+// the auth MempoolFeeDecorator. A fee that does not meet any minimum gas price
+// is still accepted when its value in the native denom, converted through the
+// feeconvert exchange rates, meets the minimum gas price of the native denom.
+// Note this only applies when ctx.CheckTx = true
+// CONTRACT: Tx must implement FeeTx to use MempoolFeeDecorator
+type MempoolFeeDecorator struct {
+	k keeper.Keeper
+}
+
+func NewMempoolFeeDecorator(k keeper.Keeper) MempoolFeeDecorator {
+	return MempoolFeeDecorator{
+		k: k,
+	}
+}
+
+func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	feeCoins := feeTx.GetFee()
+	gas := feeTx.GetGas()
+
+	if ctx.IsCheckTx() && !simulate {
+		minGasPrices := ctx.MinGasPrices()
+		if !minGasPrices.IsZero() {
+			requiredFees := make(sdk.Coins, len(minGasPrices))
+
+			// Determine the required fees by multiplying each required minimum gas
+			// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
+			glDec := sdk.NewDec(int64(gas))
+			for i, gp := range minGasPrices {
+				fee := gp.Amount.Mul(glDec)
+				requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
+			}
+
+			if !feeCoins.IsAnyGTE(requiredFees) && !mfd.coversNativeFee(ctx, feeCoins, requiredFees) {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// coversNativeFee returns true if the native value of the fees is at least the
+// required fee in the native denom. The params are only read when the fees
+// hold a coin that is not in the required fees.
+func (mfd MempoolFeeDecorator) coversNativeFee(ctx sdk.Context, feeCoins, requiredFees sdk.Coins) bool {
+	if feeCoins.DenomsSubsetOf(requiredFees) {
+		return false
+	}
+
+	params := mfd.k.GetParams(ctx)
+	required := requiredFees.AmountOf(params.NativeDenom)
+	if !required.IsPositive() {
+		return false
+	}
+
+	_, ok := params.CoverFee(feeCoins, sdk.NewCoin(params.NativeDenom, required))
+	return ok
+}
@@ -0,0 +1,76 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feeconvert/ante"
+	"github.com/cosmos/cosmos-sdk/x/feeconvert/types"
+)
+
+func TestMempoolFeeDecorator(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	txConfig := simapp.MakeTestEncodingConfig().TxConfig
+
+	app.FeeConvertKeeper.SetParams(ctx, types.NewParams(sdk.DefaultBondDenom, []types.FeeDenom{
+		types.NewFeeDenom("atom", sdk.NewDecWithPrec(5, 1)),
+	}, types.AltFeeRouteFeeCollector))
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	newTx := func(fee sdk.Coins) sdk.Tx {
+		txBuilder := txConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+		txBuilder.SetFeeAmount(fee)
+		txBuilder.SetGasLimit(100000)
+		return txBuilder.GetTx()
+	}
+
+	anteHandler := sdk.ChainAnteDecorators(ante.NewMempoolFeeDecorator(app.FeeConvertKeeper))
+
+	// the required fee is 1000stake
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(1, 2)))
+	checkCtx := ctx.WithIsCheckTx(true).WithMinGasPrices(minGasPrices)
+
+	testCases := []struct {
+		name   string
+		fee    sdk.Coins
+		expErr bool
+	}{
+		{"native fee", sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)), false},
+		{"low native fee", sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 999)), true},
+		{"alternative fee", sdk.NewCoins(sdk.NewInt64Coin("atom", 2000)), false},
+		{"low alternative fee", sdk.NewCoins(sdk.NewInt64Coin("atom", 1999)), true},
+		{"mixed fee", sdk.NewCoins(sdk.NewInt64Coin("atom", 1000), sdk.NewInt64Coin(sdk.DefaultBondDenom, 500)), false},
+		{"fee not accepted", sdk.NewCoins(sdk.NewInt64Coin("btc", 100000)), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := anteHandler(checkCtx, newTx(tc.fee), false)
+			if tc.expErr {
+				require.True(t, sdkerrors.ErrInsufficientFee.Is(err))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	// the minimum gas prices are only checked in CheckTx, and not when simulating
+	_, err := anteHandler(ctx.WithMinGasPrices(minGasPrices), newTx(nil), false)
+	require.NoError(t, err)
+	_, err = anteHandler(checkCtx, newTx(nil), true)
+	require.NoError(t, err)
+
+	// without a minimum gas price in the native denom, alternative fees are not accepted
+	photonGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("photon", sdk.NewDecWithPrec(1, 2)))
+	_, err = anteHandler(checkCtx.WithMinGasPrices(photonGasPrices), newTx(sdk.NewCoins(sdk.NewInt64Coin("atom", 100000))), false)
+	require.True(t, sdkerrors.ErrInsufficientFee.Is(err))
+}
@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/feeconvert/types"
+)
+
+// GetQueryCmd returns the cli query commands for the feeconvert module.
+func GetQueryCmd() *cobra.Command {
+	feeconvertQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the feeconvert module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	feeconvertQueryCmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQueryConvertFee(),
+	)
+
+	return feeconvertQueryCmd
+}
+
+// GetCmdQueryParams implements a command to return the current feeconvert
+// parameters.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the current feeconvert parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryConvertFee implements a command to return the value of a fee in
+// the native denom.
+func GetCmdQueryConvertFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-fee [fee]",
+		Short: "Query the value of a fee in the native denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the value of a fee in the native denom, converted through the
+exchange rates of the alternative fee denoms.
+
+Example:
+$ %s query %s convert-fee 100ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2,10stake
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ConvertFee(cmd.Context(), &types.QueryConvertFeeRequest{Fee: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
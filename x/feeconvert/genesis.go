@@ -0,0 +1,17 @@
+package feeconvert
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feeconvert/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feeconvert/types"
+)
+
+// InitGenesis initializes the feeconvert module's state from a given genesis state.
+func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(keeper.GetParams(ctx))
+}
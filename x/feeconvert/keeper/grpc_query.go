@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feeconvert/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns params of the feeconvert module.
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// ConvertFee returns the value of a fee in the native denom.
+func (k Keeper) ConvertFee(c context.Context, req *types.QueryConvertFeeRequest) (*types.QueryConvertFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	fees, err := sdk.ParseCoinsNormalized(req.Fee)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	for _, fee := range fees {
+		if _, ok := params.Rate(fee.Denom); !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "denom %s is not accepted to pay fees", fee.Denom)
+		}
+	}
+
+	return &types.QueryConvertFeeResponse{
+		Value: sdk.NewDecCoinFromDec(params.NativeDenom, params.NativeValue(fees)),
+	}, nil
+}
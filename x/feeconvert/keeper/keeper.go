@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feeconvert/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Keeper of the feeconvert module. The module keeps no state other than its
+// parameters.
+type Keeper struct {
+	paramSpace       paramtypes.Subspace
+	authKeeper       types.AccountKeeper
+	bankKeeper       types.BankKeeper
+	distrKeeper      types.DistributionKeeper
+	feeCollectorName string
+}
+
+// NewKeeper creates a new feeconvert Keeper instance
+func NewKeeper(
+	paramSpace paramtypes.Subspace, ak types.AccountKeeper, bk types.BankKeeper,
+	dk types.DistributionKeeper, feeCollectorName string,
+) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		paramSpace:       paramSpace,
+		authKeeper:       ak,
+		bankKeeper:       bk,
+		distrKeeper:      dk,
+		feeCollectorName: feeCollectorName,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetParams returns the total set of feeconvert parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of feeconvert parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// CoverFee returns the coins of the given fees which cover a fee in the native
+// denom, valued through the exchange rates of the params, and whether the fees
+// cover it.
+func (k Keeper) CoverFee(ctx sdk.Context, fees sdk.Coins, fee sdk.Coin) (sdk.Coins, bool) {
+	return k.GetParams(ctx).CoverFee(fees, fee)
+}
+
+// RouteAltFees moves the fees collected in an alternative fee denom according
+// to the alternative fee route. With the fee collector route, the fees are left
+// in the fee collector and distributed as any other fee. With the community pool
+// route, they are sent to the community pool. It returns the coins moved out of
+// the fee collector.
+func (k Keeper) RouteAltFees(ctx sdk.Context) (sdk.Coins, error) {
+	params := k.GetParams(ctx)
+	if params.AltFeeRoute != types.AltFeeRouteCommunityPool || len(params.FeeDenoms) == 0 {
+		return nil, nil
+	}
+
+	feeCollector := k.authKeeper.GetModuleAddress(k.feeCollectorName)
+	altFees := params.AltFees(k.bankKeeper.GetAllBalances(ctx, feeCollector))
+	if altFees.IsZero() {
+		return nil, nil
+	}
+
+	if err := k.distrKeeper.FundCommunityPool(ctx, altFees, feeCollector); err != nil {
+		return nil, err
+	}
+
+	return altFees, nil
+}
@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feeconvert/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.FeeConvertKeeper)
+
+	app.FeeConvertKeeper.SetParams(ctx, types.NewParams(sdk.DefaultBondDenom, []types.FeeDenom{
+		types.NewFeeDenom("atom", sdk.NewDecWithPrec(5, 1)),
+	}, types.AltFeeRouteFeeCollector))
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func (suite *KeeperTestSuite) TestRouteAltFees() {
+	app, ctx := suite.app, suite.ctx
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin("atom", 1000), sdk.NewInt64Coin("btc", 10), sdk.NewInt64Coin(sdk.DefaultBondDenom, 500))
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	suite.Require().NoError(simapp.FundAccount(app, ctx, feeCollector, fees))
+
+	// the fee collector route leaves the fees in the fee collector
+	routed, err := app.FeeConvertKeeper.RouteAltFees(ctx)
+	suite.Require().NoError(err)
+	suite.Require().True(routed.IsZero())
+	suite.Require().Equal(fees, app.BankKeeper.GetAllBalances(ctx, feeCollector))
+
+	params := app.FeeConvertKeeper.GetParams(ctx)
+	params.AltFeeRoute = types.AltFeeRouteCommunityPool
+	app.FeeConvertKeeper.SetParams(ctx, params)
+	communityPool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+
+	// the community pool route only moves the alternative fees
+	routed, err = app.FeeConvertKeeper.RouteAltFees(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)), routed)
+	suite.Require().Equal(fees.Sub(routed), app.BankKeeper.GetAllBalances(ctx, feeCollector))
+	suite.Require().Equal(
+		communityPool.Add(sdk.NewDecCoinsFromCoins(routed...)...),
+		app.DistrKeeper.GetFeePoolCommunityCoins(ctx),
+	)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueries() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	params, err := queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(app.FeeConvertKeeper.GetParams(ctx), params.Params)
+
+	res, err := queryClient.ConvertFee(gocontext.Background(), &types.QueryConvertFeeRequest{Fee: "101atom,5" + sdk.DefaultBondDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(555, 1)), res.Value)
+
+	_, err = queryClient.ConvertFee(gocontext.Background(), &types.QueryConvertFeeRequest{Fee: "10btc"})
+	suite.Require().Error(err)
+
+	_, err = queryClient.ConvertFee(gocontext.Background(), &types.QueryConvertFeeRequest{Fee: "atom"})
+	suite.Require().Error(err)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,9 @@
+package types
+
+// feeconvert module event types
+const (
+	EventTypeAltFees = "alt_fees"
+
+	AttributeKeyRoute  = "route"
+	AttributeKeyAmount = "amount"
+)
@@ -0,0 +1,9 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "feeconvert"
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
@@ -0,0 +1,229 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Routes of the alternative fees collected in a block
+const (
+	AltFeeRouteFeeCollector  = "fee_collector"
+	AltFeeRouteCommunityPool = "community_pool"
+)
+
+// Parameter store keys
+var (
+	KeyNativeDenom = []byte("NativeDenom")
+	KeyFeeDenoms   = []byte("FeeDenoms")
+	KeyAltFeeRoute = []byte("AltFeeRoute")
+)
+
+// ParamKeyTable for the feeconvert module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(nativeDenom string, feeDenoms []FeeDenom, altFeeRoute string) Params {
+	return Params{
+		NativeDenom: nativeDenom,
+		FeeDenoms:   feeDenoms,
+		AltFeeRoute: altFeeRoute,
+	}
+}
+
+// DefaultParams returns the default feeconvert module parameters, with no
+// alternative fee denom.
+func DefaultParams() Params {
+	return Params{
+		NativeDenom: sdk.DefaultBondDenom,
+		FeeDenoms:   []FeeDenom{},
+		AltFeeRoute: AltFeeRouteFeeCollector,
+	}
+}
+
+// NewFeeDenom creates a new FeeDenom object
+func NewFeeDenom(denom string, rate sdk.Dec) FeeDenom {
+	return FeeDenom{
+		Denom: denom,
+		Rate:  rate,
+	}
+}
+
+// Validate validates the set of params.
+func (p Params) Validate() error {
+	if err := validateNativeDenom(p.NativeDenom); err != nil {
+		return err
+	}
+	if err := validateFeeDenoms(p.FeeDenoms); err != nil {
+		return err
+	}
+	if err := validateAltFeeRoute(p.AltFeeRoute); err != nil {
+		return err
+	}
+
+	for _, fd := range p.FeeDenoms {
+		if fd.Denom == p.NativeDenom {
+			return fmt.Errorf("native denom %s cannot be an alternative fee denom", fd.Denom)
+		}
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyNativeDenom, &p.NativeDenom, validateNativeDenom),
+		paramtypes.NewParamSetPair(KeyFeeDenoms, &p.FeeDenoms, validateFeeDenoms),
+		paramtypes.NewParamSetPair(KeyAltFeeRoute, &p.AltFeeRoute, validateAltFeeRoute),
+	}
+}
+
+// Rate returns the exchange rate of the given denom against the native denom,
+// and false if the denom is not accepted to pay fees.
+func (p Params) Rate(denom string) (sdk.Dec, bool) {
+	if denom == p.NativeDenom {
+		return sdk.OneDec(), true
+	}
+
+	for _, fd := range p.FeeDenoms {
+		if fd.Denom == denom {
+			return fd.Rate, true
+		}
+	}
+
+	return sdk.Dec{}, false
+}
+
+// IsAltFeeDenom returns true if the given denom is an alternative fee denom.
+func (p Params) IsAltFeeDenom(denom string) bool {
+	for _, fd := range p.FeeDenoms {
+		if fd.Denom == denom {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NativeValue returns the value of the given fees in the native denom. Coins
+// in a denom that is not accepted to pay fees are not counted.
+func (p Params) NativeValue(fees sdk.Coins) sdk.Dec {
+	value := sdk.ZeroDec()
+	for _, fee := range fees {
+		rate, ok := p.Rate(fee.Denom)
+		if !ok {
+			continue
+		}
+
+		value = value.Add(fee.Amount.ToDec().Mul(rate))
+	}
+
+	return value
+}
+
+// CoverFee returns the coins of the given fees which cover a fee in the native
+// denom, starting with the native coins and then taking the alternative fee
+// coins in the order of their denoms, and whether the fees cover it.
+func (p Params) CoverFee(fees sdk.Coins, fee sdk.Coin) (sdk.Coins, bool) {
+	if fee.Denom != p.NativeDenom {
+		return nil, false
+	}
+
+	native := sdk.MinInt(fees.AmountOf(p.NativeDenom), fee.Amount)
+	covering := sdk.NewCoins(sdk.NewCoin(p.NativeDenom, native))
+	remaining := fee.Amount.Sub(native).ToDec()
+
+	for _, coin := range fees {
+		if !remaining.IsPositive() {
+			break
+		}
+
+		rate, ok := p.Rate(coin.Denom)
+		if !ok || coin.Denom == p.NativeDenom {
+			continue
+		}
+
+		amount := sdk.MinInt(remaining.Quo(rate).Ceil().TruncateInt(), coin.Amount)
+		covering = covering.Add(sdk.NewCoin(coin.Denom, amount))
+		remaining = remaining.Sub(amount.ToDec().Mul(rate))
+	}
+
+	return covering, !remaining.IsPositive()
+}
+
+// AltFees returns the coins of the given fees in an alternative fee denom.
+func (p Params) AltFees(fees sdk.Coins) sdk.Coins {
+	altFees := sdk.NewCoins()
+	for _, fee := range fees {
+		if p.IsAltFeeDenom(fee.Denom) {
+			altFees = altFees.Add(fee)
+		}
+	}
+
+	return altFees
+}
+
+func validateNativeDenom(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if strings.TrimSpace(v) == "" {
+		return errors.New("native denom cannot be blank")
+	}
+
+	return sdk.ValidateDenom(v)
+}
+
+func validateFeeDenoms(i interface{}) error {
+	v, ok := i.([]FeeDenom)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, fd := range v {
+		if err := sdk.ValidateDenom(fd.Denom); err != nil {
+			return err
+		}
+		if seen[fd.Denom] {
+			return fmt.Errorf("duplicate fee denom: %s", fd.Denom)
+		}
+		seen[fd.Denom] = true
+
+		if fd.Rate.IsNil() || !fd.Rate.IsPositive() {
+			return fmt.Errorf("fee denom %s rate must be positive: %s", fd.Denom, fd.Rate)
+		}
+	}
+
+	return nil
+}
+
+func validateAltFeeRoute(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	switch v {
+	case AltFeeRouteFeeCollector, AltFeeRouteCommunityPool:
+		return nil
+	default:
+		return fmt.Errorf("invalid alternative fee route: %s", v)
+	}
+}
@@ -0,0 +1,112 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feeconvert/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	atom := types.NewFeeDenom("atom", sdk.NewDecWithPrec(5, 1))
+	photon := types.NewFeeDenom("photon", sdk.NewDec(2))
+
+	testCases := []struct {
+		name    string
+		params  types.Params
+		expPass bool
+	}{
+		{"default", types.DefaultParams(), true},
+		{"fee denoms", types.NewParams("stake", []types.FeeDenom{atom, photon}, types.AltFeeRouteCommunityPool), true},
+		{"blank native denom", types.NewParams("", nil, types.AltFeeRouteFeeCollector), false},
+		{"invalid fee denom", types.NewParams("stake", []types.FeeDenom{types.NewFeeDenom("1", sdk.OneDec())}, types.AltFeeRouteFeeCollector), false},
+		{"duplicate fee denom", types.NewParams("stake", []types.FeeDenom{atom, atom}, types.AltFeeRouteFeeCollector), false},
+		{"native fee denom", types.NewParams("stake", []types.FeeDenom{types.NewFeeDenom("stake", sdk.OneDec())}, types.AltFeeRouteFeeCollector), false},
+		{"zero rate", types.NewParams("stake", []types.FeeDenom{types.NewFeeDenom("atom", sdk.ZeroDec())}, types.AltFeeRouteFeeCollector), false},
+		{"negative rate", types.NewParams("stake", []types.FeeDenom{types.NewFeeDenom("atom", sdk.NewDec(-1))}, types.AltFeeRouteFeeCollector), false},
+		{"invalid route", types.NewParams("stake", nil, "burn"), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestNativeValue(t *testing.T) {
+	params := types.NewParams("stake", []types.FeeDenom{
+		types.NewFeeDenom("atom", sdk.NewDecWithPrec(5, 1)),
+		types.NewFeeDenom("photon", sdk.NewDec(2)),
+	}, types.AltFeeRouteFeeCollector)
+
+	testCases := []struct {
+		name     string
+		fees     sdk.Coins
+		expected sdk.Dec
+	}{
+		{"no fees", nil, sdk.ZeroDec()},
+		{"native", sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), sdk.NewDec(100)},
+		{"alternative", sdk.NewCoins(sdk.NewInt64Coin("atom", 100)), sdk.NewDec(50)},
+		{"mixed", sdk.NewCoins(sdk.NewInt64Coin("atom", 101), sdk.NewInt64Coin("photon", 10), sdk.NewInt64Coin("stake", 5)), sdk.NewDecWithPrec(755, 1)},
+		{"not accepted", sdk.NewCoins(sdk.NewInt64Coin("btc", 100), sdk.NewInt64Coin("photon", 1)), sdk.NewDec(2)},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, params.NativeValue(tc.fees))
+		})
+	}
+
+	require.Equal(t,
+		sdk.NewCoins(sdk.NewInt64Coin("atom", 1), sdk.NewInt64Coin("photon", 2)),
+		params.AltFees(sdk.NewCoins(sdk.NewInt64Coin("atom", 1), sdk.NewInt64Coin("btc", 3), sdk.NewInt64Coin("photon", 2), sdk.NewInt64Coin("stake", 4))),
+	)
+}
+
+func TestCoverFee(t *testing.T) {
+	params := types.NewParams("stake", []types.FeeDenom{
+		types.NewFeeDenom("atom", sdk.NewDecWithPrec(5, 1)),
+		types.NewFeeDenom("photon", sdk.NewDec(2)),
+	}, types.AltFeeRouteFeeCollector)
+	fee := sdk.NewInt64Coin("stake", 100)
+
+	testCases := []struct {
+		name        string
+		fees        sdk.Coins
+		fee         sdk.Coin
+		expCovering sdk.Coins
+		expOk       bool
+	}{
+		{"native", sdk.NewCoins(sdk.NewInt64Coin("stake", 150)), fee, sdk.NewCoins(fee), true},
+		{"alternative", sdk.NewCoins(sdk.NewInt64Coin("atom", 300)), fee, sdk.NewCoins(sdk.NewInt64Coin("atom", 200)), true},
+		{"rounded up", sdk.NewCoins(sdk.NewInt64Coin("photon", 60)), sdk.NewInt64Coin("stake", 101), sdk.NewCoins(sdk.NewInt64Coin("photon", 51)), true},
+		{
+			"mixed", sdk.NewCoins(sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("photon", 100), sdk.NewInt64Coin("stake", 10)), fee,
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("photon", 20), sdk.NewInt64Coin("stake", 10)), true,
+		},
+		{"not covered", sdk.NewCoins(sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("stake", 49)), fee, nil, false},
+		{"not accepted", sdk.NewCoins(sdk.NewInt64Coin("btc", 1000)), fee, nil, false},
+		{"other denom", sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)), sdk.NewInt64Coin("atom", 100), nil, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			covering, ok := params.CoverFee(tc.fees, tc.fee)
+			require.Equal(t, tc.expOk, ok)
+			if tc.expOk {
+				require.Equal(t, tc.expCovering, covering)
+			}
+		})
+	}
+}
@@ -23,6 +23,7 @@ func NewAnteHandler(
 		authante.NewMempoolFeeDecorator(),
 		authante.NewValidateBasicDecorator(),
 		authante.TxTimeoutHeightDecorator{},
+		authante.NewUnorderedTxDecorator(ak, authante.DefaultMaxUnorderedTimeoutDelta),
 		authante.NewValidateMemoDecorator(ak),
 		authante.NewConsumeGasForTxSizeDecorator(ak),
 		NewDeductGrantedFeeDecorator(ak, bankKeeper, feeGrantKeeper),
@@ -53,7 +53,7 @@ func (d DeductGrantedFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 
 	// ensure the grant is allowed, if we request a different fee payer
 	if feeGranter != nil && !feeGranter.Equals(feePayer) {
-		err := d.k.UseGrantedFees(ctx, feeGranter, feePayer, fee)
+		err := d.k.UseGrantedFees(ctx, feeGranter, feePayer, fee, tx.GetMsgs())
 		if err != nil {
 			return ctx, sdkerrors.Wrapf(err, "%s not allowed to pay fees from %s", feeGranter, feePayer)
 		}
@@ -16,6 +16,7 @@ import (
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/client/testutil"
 	"github.com/cosmos/cosmos-sdk/x/feegrant/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
 	govtestutil "github.com/cosmos/cosmos-sdk/x/gov/client/testutil"
@@ -617,6 +618,66 @@ func (s *IntegrationTestSuite) TestTxWithFeeGrant() {
 	s.Require().Equal(uint32(0), resp.Code)
 }
 
+func (s *IntegrationTestSuite) TestAllowedMsgFeeAllowance() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+	granter := val.Address
+
+	info, _, err := val.ClientCtx.Keyring.NewMnemonic("grantee1", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	s.Require().NoError(err)
+	grantee := sdk.AccAddress(info.GetPubKey().Address())
+
+	commonFlags := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	args := append(
+		[]string{
+			granter.String(),
+			grantee.String(),
+			fmt.Sprintf("--%s=%s", cli.FlagSpendLimit, "100stake"),
+			fmt.Sprintf("--%s=%s", cli.FlagAllowedMsgs, "/cosmos.gov.v1beta1.MsgSubmitProposal"),
+			fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+		},
+		commonFlags...,
+	)
+
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, cli.NewCmdFeeGrant(), args)
+	s.Require().NoError(err)
+	_, err = s.network.WaitForHeight(1)
+	s.Require().NoError(err)
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryFeeGrant(), []string{
+		granter.String(), grantee.String(), fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	s.Require().NoError(err)
+	var grant types.FeeAllowanceGrant
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &grant), out.String())
+	allowance, ok := grant.GetFeeGrant().(*types.AllowedMsgFeeAllowance)
+	s.Require().True(ok)
+	s.Require().Equal([]string{"/cosmos.gov.v1beta1.MsgSubmitProposal"}, allowance.AllowedMessages)
+
+	// the fees of an allowed message are paid by the granter
+	out, err = govtestutil.MsgSubmitProposal(val.ClientCtx, grantee.String(),
+		"Text Proposal", "No desc", govtypes.ProposalTypeText,
+		fmt.Sprintf("--%s=%s", flags.FlagFeeAccount, granter.String()),
+	)
+	s.Require().NoError(err)
+	var resp sdk.TxResponse
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &resp), out.String())
+	s.Require().Equal(uint32(0), resp.Code, out.String())
+
+	// the fees of any other message are not
+	out, err = banktestutil.MsgSendExec(val.ClientCtx, grantee, granter, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 1)),
+		append(commonFlags, fmt.Sprintf("--%s=%s", flags.FlagFeeAccount, granter.String()))...,
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &resp), out.String())
+	s.Require().Equal(types.ErrMessageNotAllowed.ABCICode(), resp.Code, out.String())
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
@@ -22,6 +22,7 @@ const (
 	FlagPeriod      = "period"
 	FlagPeriodLimit = "period-limit"
 	FlagSpendLimit  = "spend-limit"
+	FlagAllowedMsgs = "allowed-messages"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -55,8 +56,9 @@ func NewCmdFeeGrant() *cobra.Command {
 
 Examples:
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 36000 or
-%s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --period 3600 --period-limit 10stake --expiration 36000
-				`, version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+%s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --period 3600 --period-limit 10stake --expiration 36000 or
+%s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote"
+				`, version.AppName, types.ModuleName, version.AppName, types.ModuleName, version.AppName, types.ModuleName,
 			),
 		),
 		Args: cobra.ExactArgs(2),
@@ -143,6 +145,18 @@ Examples:
 				}
 			}
 
+			allowedMsgs, err := cmd.Flags().GetStringSlice(FlagAllowedMsgs)
+			if err != nil {
+				return err
+			}
+
+			if len(allowedMsgs) > 0 {
+				grant, err = types.NewAllowedMsgFeeAllowance(grant, allowedMsgs)
+				if err != nil {
+					return err
+				}
+			}
+
 			msg, err := types.NewMsgGrantFeeAllowance(grant, granter, grantee)
 			if err != nil {
 				return err
@@ -164,6 +178,7 @@ Examples:
 	cmd.Flags().String(FlagSpendLimit, "", "Spend limit specifies the max limit can be used, if not mentioned there is no limit")
 	cmd.Flags().Int64(FlagPeriod, 0, "period specifies the time duration in which period_spend_limit coins can be spent before that allowance is reset")
 	cmd.Flags().String(FlagPeriodLimit, "", "// period limit specifies the maximum number of coins that can be spent in the period")
+	cmd.Flags().StringSlice(FlagAllowedMsgs, []string{}, "Set of allowed messages for fee allowance")
 
 	return cmd
 }
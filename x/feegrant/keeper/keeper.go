@@ -157,14 +157,15 @@ func (k Keeper) IterateAllFeeAllowances(ctx sdk.Context, cb func(types.FeeAllowa
 	return nil
 }
 
-// UseGrantedFees will try to pay the given fee from the granter's account as requested by the grantee
-func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) error {
+// UseGrantedFees will try to pay the given fee of a tx with the given messages from the granter's
+// account as requested by the grantee
+func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
 	grant, found := k.GetFeeGrant(ctx, granter, grantee)
 	if !found || grant.GetFeeGrant() == nil {
 		return sdkerrors.Wrapf(types.ErrNoAllowance, "grant missing")
 	}
 
-	remove, err := grant.GetFeeGrant().Accept(fee, ctx.BlockTime(), ctx.BlockHeight())
+	remove, err := grant.GetFeeGrant().Accept(fee, ctx.BlockTime(), ctx.BlockHeight(), msgs)
 	if err == nil {
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
@@ -7,7 +7,9 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
 )
 
@@ -242,7 +244,7 @@ func (suite *KeeperTestSuite) TestUseGrantedFee() {
 			err = k.GrantFeeAllowance(ctx, suite.addrs[0], suite.addrs[3], expired)
 			suite.Require().NoError(err)
 
-			err = k.UseGrantedFees(ctx, tc.granter, tc.grantee, tc.fee)
+			err = k.UseGrantedFees(ctx, tc.granter, tc.grantee, tc.fee, nil)
 			if tc.allowed {
 				suite.NoError(err)
 			} else {
@@ -255,3 +257,38 @@ func (suite *KeeperTestSuite) TestUseGrantedFee() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestUseGrantedFeesAllowedMsgs() {
+	ctx := suite.ctx
+	k := suite.app.FeeGrantKeeper
+
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 43))
+	allowance, err := types.NewAllowedMsgFeeAllowance(
+		&types.BasicFeeAllowance{SpendLimit: atom},
+		[]string{"/cosmos.bank.v1beta1.MsgSend"},
+	)
+	suite.Require().NoError(err)
+
+	err = k.GrantFeeAllowance(ctx, suite.addrs[0], suite.addrs[1], allowance)
+	suite.Require().NoError(err)
+
+	send := banktypes.NewMsgSend(suite.addrs[1], suite.addrs[2], smallAtom)
+	testMsg := testdata.NewTestMsg(suite.addrs[1])
+
+	// the fees of a tx with a message which is not allowed are not paid
+	err = k.UseGrantedFees(ctx, suite.addrs[0], suite.addrs[1], smallAtom, []sdk.Msg{send, testMsg})
+	suite.Require().True(types.ErrMessageNotAllowed.Is(err))
+
+	err = k.UseGrantedFees(ctx, suite.addrs[0], suite.addrs[1], smallAtom, []sdk.Msg{send})
+	suite.Require().NoError(err)
+
+	// the wrapped allowance is updated in the store
+	loaded, ok := k.GetFeeAllowance(ctx, suite.addrs[0], suite.addrs[1]).(*types.AllowedMsgFeeAllowance)
+	suite.Require().True(ok)
+	suite.Require().Equal(allowance.AllowedMessages, loaded.AllowedMessages)
+
+	basic, err := loaded.GetAllowance()
+	suite.Require().NoError(err)
+	suite.Require().Equal(atom.Sub(smallAtom), basic.(*types.BasicFeeAllowance).SpendLimit)
+}
@@ -0,0 +1,133 @@
+package types
+
+import (
+	"time"
+
+	proto "github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ FeeAllowanceI                 = (*AllowedMsgFeeAllowance)(nil)
+	_ types.UnpackInterfacesMessage = (*AllowedMsgFeeAllowance)(nil)
+)
+
+// NewAllowedMsgFeeAllowance creates a new AllowedMsgFeeAllowance paying the
+// fees of the txs whose messages all have one of the allowed type URLs.
+func NewAllowedMsgFeeAllowance(allowance FeeAllowanceI, allowedMsgs []string) (*AllowedMsgFeeAllowance, error) {
+	a := &AllowedMsgFeeAllowance{AllowedMessages: allowedMsgs}
+	if err := a.SetAllowance(allowance); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// GetAllowance unpacks the wrapped allowance.
+func (a *AllowedMsgFeeAllowance) GetAllowance() (FeeAllowanceI, error) {
+	allowance, ok := a.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// SetAllowance packs the wrapped allowance.
+func (a *AllowedMsgFeeAllowance) SetAllowance(allowance FeeAllowanceI) error {
+	msg, ok := allowance.(proto.Message)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", allowance)
+	}
+
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return err
+	}
+
+	a.Allowance = any
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *AllowedMsgFeeAllowance) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+// Accept rejects the fee payment if any message of the tx is not allowed, and
+// otherwise delegates to the wrapped allowance, storing its updated state.
+func (a *AllowedMsgFeeAllowance) Accept(fee sdk.Coins, blockTime time.Time, blockHeight int64, msgs []sdk.Msg) (bool, error) {
+	if !a.allMsgTypesAllowed(msgs) {
+		return false, sdkerrors.Wrap(ErrMessageNotAllowed, "message does not exist in allowed messages")
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return false, err
+	}
+
+	remove, err := allowance.Accept(fee, blockTime, blockHeight, msgs)
+	if err == nil {
+		if err := a.SetAllowance(allowance); err != nil {
+			return false, err
+		}
+	}
+
+	return remove, err
+}
+
+func (a *AllowedMsgFeeAllowance) allowedMsgsToMap() map[string]bool {
+	msgsMap := make(map[string]bool, len(a.AllowedMessages))
+	for _, msg := range a.AllowedMessages {
+		msgsMap[msg] = true
+	}
+
+	return msgsMap
+}
+
+func (a *AllowedMsgFeeAllowance) allMsgTypesAllowed(msgs []sdk.Msg) bool {
+	msgsMap := a.allowedMsgsToMap()
+	for _, msg := range msgs {
+		if !msgsMap[sdk.MsgTypeURL(msg)] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// PrepareForExport will adjust the wrapped allowance based on export time.
+func (a *AllowedMsgFeeAllowance) PrepareForExport(dumpTime time.Time, dumpHeight int64) FeeAllowanceI {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return nil
+	}
+
+	exported, err := NewAllowedMsgFeeAllowance(allowance.PrepareForExport(dumpTime, dumpHeight), a.AllowedMessages)
+	if err != nil {
+		return nil
+	}
+
+	return exported
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a *AllowedMsgFeeAllowance) ValidateBasic() error {
+	if a.Allowance == nil {
+		return sdkerrors.Wrap(ErrNoAllowance, "allowance should not be empty")
+	}
+	if len(a.AllowedMessages) == 0 {
+		return sdkerrors.Wrap(ErrMessageNotAllowed, "allowed messages shouldn't be empty")
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+
+	return allowance.ValidateBasic()
+}
@@ -0,0 +1,115 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+func TestAllowedMsgFeeAllowance(t *testing.T) {
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 43))
+	leftAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 512))
+
+	addr := sdk.AccAddress([]byte("addr________________"))
+	testMsg := testdata.NewTestMsg(addr)
+	dogMsg := sdk.ServiceMsg{
+		MethodName: "/testdata.Msg/CreateDog",
+		Request:    &testdata.MsgCreateDog{},
+	}
+	allowedMsgs := []string{"/testdata.TestMsg", "/testdata.MsgCreateDog"}
+
+	cases := map[string]struct {
+		allowance   types.FeeAllowanceI
+		allowedMsgs []string
+		// all other checks are ignored if valid=false
+		msgs        []sdk.Msg
+		fee         sdk.Coins
+		blockHeight int64
+		valid       bool
+		accept      bool
+		remove      bool
+		remains     sdk.Coins
+	}{
+		"no allowed messages": {
+			allowance: &types.BasicFeeAllowance{},
+			valid:     false,
+		},
+		"invalid allowance": {
+			allowance:   &types.BasicFeeAllowance{SpendLimit: sdk.Coins{sdk.Coin{Denom: "atom", Amount: sdk.ZeroInt()}}},
+			allowedMsgs: allowedMsgs,
+			valid:       false,
+		},
+		"allowed messages": {
+			allowance:   &types.BasicFeeAllowance{SpendLimit: atom},
+			allowedMsgs: allowedMsgs,
+			msgs:        []sdk.Msg{testMsg, dogMsg},
+			fee:         smallAtom,
+			valid:       true,
+			accept:      true,
+			remains:     leftAtom,
+		},
+		"message not allowed": {
+			allowance:   &types.BasicFeeAllowance{SpendLimit: atom},
+			allowedMsgs: []string{"/testdata.TestMsg"},
+			msgs:        []sdk.Msg{testMsg, dogMsg},
+			fee:         smallAtom,
+			valid:       true,
+			accept:      false,
+		},
+		"wrapped allowance used up": {
+			allowance:   &types.BasicFeeAllowance{SpendLimit: smallAtom},
+			allowedMsgs: allowedMsgs,
+			msgs:        []sdk.Msg{testMsg},
+			fee:         smallAtom,
+			valid:       true,
+			accept:      true,
+			remove:      true,
+		},
+		"wrapped allowance expired": {
+			allowance:   &types.BasicFeeAllowance{SpendLimit: atom, Expiration: types.ExpiresAtHeight(100)},
+			allowedMsgs: allowedMsgs,
+			msgs:        []sdk.Msg{testMsg},
+			fee:         smallAtom,
+			blockHeight: 121,
+			valid:       true,
+			accept:      false,
+			remove:      true,
+		},
+	}
+
+	for name, stc := range cases {
+		tc := stc // to make scopelint happy
+		t.Run(name, func(t *testing.T) {
+			allow, err := types.NewAllowedMsgFeeAllowance(tc.allowance, tc.allowedMsgs)
+			require.NoError(t, err)
+
+			err = allow.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			remove, err := allow.Accept(tc.fee, time.Time{}, tc.blockHeight, tc.msgs)
+			require.Equal(t, tc.remove, remove)
+			if !tc.accept {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			// the state of the wrapped allowance is updated
+			if !remove {
+				allowance, err := allow.GetAllowance()
+				require.NoError(t, err)
+				require.Equal(t, tc.remains, allowance.(*types.BasicFeeAllowance).SpendLimit)
+			}
+		})
+	}
+}
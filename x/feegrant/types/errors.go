@@ -18,4 +18,6 @@ var (
 	ErrInvalidDuration = sdkerrors.Register(DefaultCodespace, 4, "invalid duration")
 	// ErrNoAllowance error if there is no allowance for that pair
 	ErrNoAllowance = sdkerrors.Register(DefaultCodespace, 5, "no allowance")
+	// ErrMessageNotAllowed error if the allowance does not pay the fees of a message
+	ErrMessageNotAllowed = sdkerrors.Register(DefaultCodespace, 6, "message not allowed")
 )
@@ -177,6 +177,49 @@ func (m *PeriodicFeeAllowance) GetPeriodReset() ExpiresAt {
 	return ExpiresAt{}
 }
 
+// AllowedMsgFeeAllowance wraps a fee allowance so that it only pays the fees
+// of txs whose messages all have one of the allowed type URLs.
+type AllowedMsgFeeAllowance struct {
+	// allowance is the wrapped fee allowance.
+	Allowance *types1.Any `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// allowed_messages are the type URLs of the messages the allowance pays the
+	// fees for (ex. "/cosmos.bank.v1beta1.MsgSend").
+	AllowedMessages []string `protobuf:"bytes,2,rep,name=allowed_messages,json=allowedMessages,proto3" json:"allowed_messages,omitempty"`
+}
+
+func (m *AllowedMsgFeeAllowance) Reset()         { *m = AllowedMsgFeeAllowance{} }
+func (m *AllowedMsgFeeAllowance) String() string { return proto.CompactTextString(m) }
+func (*AllowedMsgFeeAllowance) ProtoMessage()    {}
+func (*AllowedMsgFeeAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{2}
+}
+func (m *AllowedMsgFeeAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowedMsgFeeAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowedMsgFeeAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllowedMsgFeeAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowedMsgFeeAllowance.Merge(m, src)
+}
+func (m *AllowedMsgFeeAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowedMsgFeeAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowedMsgFeeAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowedMsgFeeAllowance proto.InternalMessageInfo
+
 // Duration is a span of a clock time or number of blocks.
 // This is designed to be added to an ExpiresAt struct.
 type Duration struct {
@@ -192,7 +235,7 @@ func (m *Duration) Reset()         { *m = Duration{} }
 func (m *Duration) String() string { return proto.CompactTextString(m) }
 func (*Duration) ProtoMessage()    {}
 func (*Duration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7279582900c30aea, []int{2}
+	return fileDescriptor_7279582900c30aea, []int{3}
 }
 func (m *Duration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -281,7 +324,7 @@ func (m *ExpiresAt) Reset()         { *m = ExpiresAt{} }
 func (m *ExpiresAt) String() string { return proto.CompactTextString(m) }
 func (*ExpiresAt) ProtoMessage()    {}
 func (*ExpiresAt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7279582900c30aea, []int{3}
+	return fileDescriptor_7279582900c30aea, []int{4}
 }
 func (m *ExpiresAt) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -366,7 +409,7 @@ func (m *FeeAllowanceGrant) Reset()         { *m = FeeAllowanceGrant{} }
 func (m *FeeAllowanceGrant) String() string { return proto.CompactTextString(m) }
 func (*FeeAllowanceGrant) ProtoMessage()    {}
 func (*FeeAllowanceGrant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7279582900c30aea, []int{4}
+	return fileDescriptor_7279582900c30aea, []int{5}
 }
 func (m *FeeAllowanceGrant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -419,6 +462,7 @@ func (m *FeeAllowanceGrant) GetAllowance() *types1.Any {
 func init() {
 	proto.RegisterType((*BasicFeeAllowance)(nil), "cosmos.feegrant.v1beta1.BasicFeeAllowance")
 	proto.RegisterType((*PeriodicFeeAllowance)(nil), "cosmos.feegrant.v1beta1.PeriodicFeeAllowance")
+	proto.RegisterType((*AllowedMsgFeeAllowance)(nil), "cosmos.feegrant.v1beta1.AllowedMsgFeeAllowance")
 	proto.RegisterType((*Duration)(nil), "cosmos.feegrant.v1beta1.Duration")
 	proto.RegisterType((*ExpiresAt)(nil), "cosmos.feegrant.v1beta1.ExpiresAt")
 	proto.RegisterType((*FeeAllowanceGrant)(nil), "cosmos.feegrant.v1beta1.FeeAllowanceGrant")
@@ -429,45 +473,48 @@ func init() {
 }
 
 var fileDescriptor_7279582900c30aea = []byte{
-	// 599 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4f, 0x6e, 0xd3, 0x40,
-	0x14, 0xc6, 0x6d, 0x9c, 0x96, 0x76, 0x02, 0x88, 0x8c, 0x22, 0xe1, 0x64, 0xe1, 0x94, 0x2c, 0x50,
-	0x84, 0x14, 0x9b, 0x16, 0x89, 0x05, 0x12, 0x42, 0x71, 0x69, 0x1b, 0x04, 0x0b, 0x64, 0x58, 0xb1,
-	0x89, 0x6c, 0x67, 0xea, 0x0c, 0xb5, 0x3d, 0x96, 0x67, 0x02, 0xcd, 0x25, 0x50, 0x97, 0x9c, 0x81,
-	0x35, 0x87, 0xa8, 0x58, 0x55, 0xac, 0x58, 0xb5, 0x28, 0x39, 0x01, 0x37, 0x40, 0xf3, 0xc7, 0x4e,
-	0x94, 0x10, 0x24, 0xa4, 0xae, 0xe2, 0x99, 0x79, 0xef, 0xfb, 0xbd, 0xf7, 0xbd, 0x99, 0x80, 0x07,
-	0x21, 0xa1, 0x09, 0xa1, 0xce, 0x31, 0x42, 0x51, 0xee, 0xa7, 0xcc, 0xf9, 0xb8, 0x1b, 0x20, 0xe6,
-	0xef, 0x96, 0x1b, 0x76, 0x96, 0x13, 0x46, 0xe0, 0x3d, 0x19, 0x67, 0x97, 0xdb, 0x2a, 0xae, 0x59,
-	0x8f, 0x48, 0x44, 0x44, 0x8c, 0xc3, 0xbf, 0x64, 0x78, 0xb3, 0x11, 0x11, 0x12, 0xc5, 0xc8, 0x11,
-	0xab, 0x60, 0x7c, 0xec, 0xf8, 0xe9, 0xa4, 0x38, 0x92, 0x4a, 0x03, 0x99, 0xa3, 0x64, 0xe5, 0x91,
-	0xa5, 0x8a, 0x09, 0x7c, 0x8a, 0xca, 0x42, 0x42, 0x82, 0x53, 0x75, 0xde, 0x5a, 0x56, 0x65, 0x38,
-	0x41, 0x94, 0xf9, 0x49, 0x56, 0x08, 0x2c, 0x07, 0x0c, 0xc7, 0xb9, 0xcf, 0x30, 0x51, 0x02, 0xed,
-	0x4b, 0x1d, 0xd4, 0x5c, 0x9f, 0xe2, 0xf0, 0x10, 0xa1, 0x5e, 0x1c, 0x93, 0x4f, 0x7e, 0x1a, 0x22,
-	0x18, 0x83, 0x2a, 0xcd, 0x50, 0x3a, 0x1c, 0xc4, 0x38, 0xc1, 0xcc, 0xd4, 0x77, 0x8c, 0x4e, 0x75,
-	0xaf, 0x61, 0xab, 0xd2, 0x78, 0x31, 0x45, 0xb7, 0xf6, 0x3e, 0xc1, 0xa9, 0xfb, 0xe8, 0xfc, 0xb2,
-	0xa5, 0x7d, 0xbd, 0x6a, 0x75, 0x22, 0xcc, 0x46, 0xe3, 0xc0, 0x0e, 0x49, 0xa2, 0xfa, 0x50, 0x3f,
-	0x5d, 0x3a, 0x3c, 0x71, 0xd8, 0x24, 0x43, 0x54, 0x24, 0x50, 0x0f, 0x08, 0xfd, 0xd7, 0x5c, 0x1e,
-	0xf6, 0x01, 0x40, 0xa7, 0x19, 0x96, 0x75, 0x99, 0x37, 0x76, 0xf4, 0x4e, 0x75, 0xaf, 0x6d, 0xaf,
-	0xb1, 0xd7, 0x3e, 0xe0, 0xa1, 0x88, 0xf6, 0x98, 0x5b, 0xe1, 0x54, 0x6f, 0x21, 0xf7, 0x69, 0xed,
-	0xc7, 0xb7, 0xee, 0xed, 0xc5, 0x4e, 0x5e, 0xb6, 0x7f, 0x1b, 0xa0, 0xfe, 0x06, 0xe5, 0x98, 0x0c,
-	0x97, 0x7a, 0x3c, 0x04, 0x1b, 0x01, 0x6f, 0xdc, 0xd4, 0x05, 0xf0, 0xe1, 0x5a, 0xe0, 0x8a, 0x3d,
-	0x0a, 0x2c, 0xd3, 0xe1, 0x73, 0xb0, 0x99, 0x09, 0x7d, 0x55, 0xf9, 0xfd, 0xb5, 0x42, 0x2f, 0x94,
-	0xf5, 0x2a, 0x5f, 0xa5, 0xc1, 0x09, 0x80, 0xf2, 0x6b, 0xb0, 0xe8, 0xb9, 0x71, 0xfd, 0x9e, 0xdf,
-	0x95, 0x98, 0xb7, 0x73, 0xe7, 0xc7, 0x40, 0xed, 0x0d, 0x42, 0x3f, 0x95, 0x78, 0xb3, 0x72, 0xfd,
-	0xe0, 0x3b, 0x12, 0xb2, 0xef, 0xa7, 0x82, 0x0d, 0x5f, 0x81, 0x5b, 0x0a, 0x9b, 0x23, 0x8a, 0x98,
-	0xb9, 0xf1, 0x9f, 0x23, 0xaf, 0xca, 0x6c, 0x8f, 0x27, 0xff, 0x6d, 0xe6, 0x1f, 0xc0, 0x56, 0xe1,
-	0x35, 0x7c, 0x06, 0xb6, 0x8a, 0x2b, 0xaf, 0x26, 0xdd, 0xb0, 0xe5, 0x9b, 0xb0, 0x8b, 0x37, 0xb1,
-	0x30, 0x98, 0x2f, 0x57, 0x2d, 0xbd, 0xaf, 0x79, 0x65, 0x0a, 0x34, 0xc1, 0x66, 0x10, 0x93, 0xf0,
-	0x84, 0x8a, 0xe9, 0x56, 0xfa, 0x9a, 0xa7, 0xd6, 0xee, 0x06, 0x30, 0xe8, 0x38, 0x69, 0x0f, 0xc1,
-	0x76, 0x59, 0x1e, 0x7c, 0x02, 0x2a, 0xfc, 0x01, 0x2a, 0x50, 0x73, 0x05, 0xf4, 0xae, 0x78, 0x9d,
-	0x6e, 0xe5, 0x4c, 0x92, 0x44, 0x3c, 0xa7, 0x8c, 0x10, 0x8e, 0x46, 0x4c, 0x50, 0x0c, 0x4e, 0x91,
-	0xeb, 0x82, 0xf2, 0x59, 0x07, 0xb5, 0xc5, 0x1e, 0x8f, 0xb8, 0x3f, 0xd0, 0x04, 0x37, 0x85, 0x51,
-	0x28, 0x17, 0xc4, 0x6d, 0xaf, 0x58, 0xce, 0x4f, 0x90, 0x50, 0x2c, 0x4f, 0x10, 0x3c, 0x00, 0xdb,
-	0x7e, 0xa1, 0x62, 0x1a, 0xa2, 0xce, 0xfa, 0x4a, 0x9d, 0xbd, 0x74, 0xe2, 0xd6, 0xbe, 0x2f, 0xfb,
-	0xea, 0xcd, 0x33, 0xdd, 0xa3, 0xf3, 0xa9, 0xa5, 0x5f, 0x4c, 0x2d, 0xfd, 0xd7, 0xd4, 0xd2, 0xcf,
-	0x66, 0x96, 0x76, 0x31, 0xb3, 0xb4, 0x9f, 0x33, 0x4b, 0x7b, 0xdf, 0xfd, 0xe7, 0xb5, 0x38, 0x9d,
-	0xff, 0xaf, 0x8a, 0x1b, 0x12, 0x6c, 0x0a, 0xe8, 0xe3, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x74,
-	0x27, 0xe6, 0x00, 0x77, 0x05, 0x00, 0x00,
+	// 647 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4d, 0x6f, 0xd3, 0x30,
+	0x18, 0xc7, 0x93, 0xa5, 0x1b, 0xab, 0xcb, 0xcb, 0x6a, 0x0d, 0xc8, 0x76, 0x48, 0x47, 0x0f, 0xa8,
+	0x20, 0x2d, 0x61, 0x43, 0xe2, 0x30, 0x09, 0xa1, 0x66, 0x6c, 0x2b, 0x82, 0x49, 0x28, 0x70, 0xe2,
+	0x52, 0x39, 0x89, 0x97, 0x86, 0x25, 0x71, 0x14, 0xbb, 0xb0, 0x7e, 0x03, 0x4e, 0x68, 0x47, 0x8e,
+	0x70, 0xe5, 0xcc, 0x87, 0x98, 0x38, 0x4d, 0x9c, 0x38, 0x6d, 0xa8, 0xfd, 0x04, 0x7c, 0x03, 0x14,
+	0xdb, 0x69, 0x4b, 0x4b, 0x91, 0x40, 0x3b, 0x25, 0x8f, 0xfd, 0x3c, 0xff, 0xdf, 0xf3, 0x62, 0x1b,
+	0xdc, 0xf6, 0x08, 0x8d, 0x09, 0xb5, 0x0e, 0x30, 0x0e, 0x32, 0x94, 0x30, 0xeb, 0xcd, 0x86, 0x8b,
+	0x19, 0xda, 0x18, 0x2e, 0x98, 0x69, 0x46, 0x18, 0x81, 0x37, 0x85, 0x9f, 0x39, 0x5c, 0x96, 0x7e,
+	0xab, 0xcb, 0x01, 0x09, 0x08, 0xf7, 0xb1, 0xf2, 0x3f, 0xe1, 0xbe, 0xba, 0x12, 0x10, 0x12, 0x44,
+	0xd8, 0xe2, 0x96, 0xdb, 0x3d, 0xb0, 0x50, 0xd2, 0x2b, 0xb6, 0x84, 0x52, 0x5b, 0xc4, 0x48, 0x59,
+	0xb1, 0x65, 0xc8, 0x64, 0x5c, 0x44, 0xf1, 0x30, 0x11, 0x8f, 0x84, 0x89, 0xdc, 0xaf, 0x4d, 0xaa,
+	0xb2, 0x30, 0xc6, 0x94, 0xa1, 0x38, 0x2d, 0x04, 0x26, 0x1d, 0xfc, 0x6e, 0x86, 0x58, 0x48, 0xa4,
+	0x40, 0xfd, 0x4c, 0x05, 0x55, 0x1b, 0xd1, 0xd0, 0xdb, 0xc5, 0xb8, 0x19, 0x45, 0xe4, 0x2d, 0x4a,
+	0x3c, 0x0c, 0x23, 0x50, 0xa1, 0x29, 0x4e, 0xfc, 0x76, 0x14, 0xc6, 0x21, 0xd3, 0xd5, 0x35, 0xad,
+	0x51, 0xd9, 0x5c, 0x31, 0x65, 0x6a, 0x79, 0x32, 0x45, 0xb5, 0xe6, 0x36, 0x09, 0x13, 0xfb, 0xde,
+	0xc9, 0x59, 0x4d, 0xf9, 0x7c, 0x5e, 0x6b, 0x04, 0x21, 0xeb, 0x74, 0x5d, 0xd3, 0x23, 0xb1, 0xac,
+	0x43, 0x7e, 0xd6, 0xa9, 0x7f, 0x68, 0xb1, 0x5e, 0x8a, 0x29, 0x0f, 0xa0, 0x0e, 0xe0, 0xfa, 0xcf,
+	0x72, 0x79, 0xd8, 0x02, 0x00, 0x1f, 0xa5, 0xa1, 0xc8, 0x4b, 0x9f, 0x5b, 0x53, 0x1b, 0x95, 0xcd,
+	0xba, 0x39, 0xa3, 0xbd, 0xe6, 0x4e, 0xee, 0x8a, 0x69, 0x93, 0xd9, 0xa5, 0x9c, 0xea, 0x8c, 0xc5,
+	0x6e, 0x55, 0xbf, 0x7d, 0x59, 0xbf, 0x32, 0x5e, 0xc9, 0x93, 0xfa, 0x4f, 0x0d, 0x2c, 0x3f, 0xc7,
+	0x59, 0x48, 0xfc, 0x89, 0x1a, 0x77, 0xc1, 0xbc, 0x9b, 0x17, 0xae, 0xab, 0x1c, 0x78, 0x77, 0x26,
+	0x70, 0xaa, 0x3d, 0x12, 0x2c, 0xc2, 0xe1, 0x23, 0xb0, 0x90, 0x72, 0x7d, 0x99, 0xf9, 0xad, 0x99,
+	0x42, 0x8f, 0x65, 0xeb, 0x65, 0xbc, 0x0c, 0x83, 0x3d, 0x00, 0xc5, 0x5f, 0x7b, 0xbc, 0xe7, 0xda,
+	0xc5, 0xf7, 0x7c, 0x49, 0x60, 0x5e, 0x8c, 0x3a, 0xdf, 0x05, 0x72, 0xad, 0xed, 0xa1, 0x44, 0xe0,
+	0xf5, 0xd2, 0xc5, 0x83, 0xaf, 0x0a, 0xc8, 0x36, 0x4a, 0x38, 0x1b, 0x3e, 0x05, 0x97, 0x25, 0x36,
+	0xc3, 0x14, 0x33, 0x7d, 0xfe, 0x1f, 0x47, 0x5e, 0x11, 0xd1, 0x4e, 0x1e, 0xfc, 0xa7, 0x99, 0x7f,
+	0x52, 0xc1, 0x0d, 0x6e, 0x62, 0x7f, 0x9f, 0x06, 0xbf, 0x4d, 0x7d, 0x07, 0x94, 0x51, 0x61, 0xc8,
+	0xc9, 0x2f, 0x9b, 0xe2, 0x8e, 0x98, 0xc5, 0x1d, 0x31, 0x9b, 0x49, 0xcf, 0xae, 0x7e, 0x9d, 0x94,
+	0x75, 0x46, 0x91, 0xf0, 0x0e, 0x58, 0x42, 0x02, 0xd0, 0x8e, 0x31, 0xa5, 0x28, 0xc0, 0x54, 0x9f,
+	0x5b, 0xd3, 0x1a, 0x65, 0xe7, 0x9a, 0x5c, 0xdf, 0x97, 0xcb, 0x5b, 0xd7, 0xdf, 0x7d, 0xac, 0x29,
+	0xd3, 0x39, 0xbe, 0x06, 0x8b, 0xc5, 0x79, 0x80, 0x0f, 0xc1, 0x62, 0x71, 0x2d, 0x65, 0x4e, 0x2b,
+	0x53, 0x39, 0x8d, 0x0e, 0xcf, 0x87, 0xf3, 0x9a, 0xda, 0x52, 0x9c, 0x61, 0x08, 0xd4, 0xc1, 0x82,
+	0x1b, 0x11, 0xef, 0x90, 0xf2, 0x13, 0x58, 0x6a, 0x29, 0x8e, 0xb4, 0xed, 0x79, 0xa0, 0xd1, 0x6e,
+	0x5c, 0xf7, 0x41, 0x79, 0xd8, 0x42, 0xf8, 0x00, 0x94, 0xf2, 0x47, 0x42, 0x82, 0x56, 0xa7, 0x40,
+	0x2f, 0x8b, 0x17, 0xc4, 0x2e, 0x1d, 0x0b, 0x12, 0xf7, 0xcf, 0x29, 0x1d, 0x1c, 0x06, 0x1d, 0xc6,
+	0x29, 0x5a, 0x4e, 0x11, 0x76, 0x41, 0x79, 0xaf, 0x82, 0xea, 0x78, 0x8d, 0x7b, 0xf9, 0x0c, 0xa1,
+	0x0e, 0x2e, 0xf1, 0x61, 0xe2, 0x8c, 0x13, 0xcb, 0x4e, 0x61, 0x8e, 0x76, 0xb0, 0x3e, 0x37, 0xbe,
+	0x33, 0x31, 0x24, 0xed, 0x7f, 0x87, 0x64, 0xef, 0x9d, 0xf4, 0x0d, 0xf5, 0xb4, 0x6f, 0xa8, 0x3f,
+	0xfa, 0x86, 0x7a, 0x3c, 0x30, 0x94, 0xd3, 0x81, 0xa1, 0x7c, 0x1f, 0x18, 0xca, 0xab, 0xf5, 0xbf,
+	0x1e, 0xdd, 0xa3, 0xd1, 0xdb, 0xcf, 0x4f, 0xb1, 0xbb, 0xc0, 0xa1, 0xf7, 0x7f, 0x0d, 0x00, 0xfc,
+	0x05, 0xa9, 0xc7, 0x1b, 0x06, 0x00, 0x00,
 }
 
 func (m *BasicFeeAllowance) Marshal() (dAtA []byte, err error) {
@@ -598,6 +645,50 @@ func (m *PeriodicFeeAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AllowedMsgFeeAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowedMsgFeeAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowedMsgFeeAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedMessages) > 0 {
+		for iNdEx := len(m.AllowedMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMessages[iNdEx])
+			copy(dAtA[i:], m.AllowedMessages[iNdEx])
+			i = encodeVarintFeegrant(dAtA, i, uint64(len(m.AllowedMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFeegrant(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Duration) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -638,12 +729,12 @@ func (m *Duration_Duration) MarshalTo(dAtA []byte) (int, error) {
 func (m *Duration_Duration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	if m.Duration != nil {
-		n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Duration):])
-		if err5 != nil {
-			return 0, err5
+		n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Duration):])
+		if err6 != nil {
+			return 0, err6
 		}
-		i -= n5
-		i = encodeVarintFeegrant(dAtA, i, uint64(n5))
+		i -= n6
+		i = encodeVarintFeegrant(dAtA, i, uint64(n6))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -701,12 +792,12 @@ func (m *ExpiresAt_Time) MarshalTo(dAtA []byte) (int, error) {
 func (m *ExpiresAt_Time) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	if m.Time != nil {
-		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time):])
-		if err6 != nil {
-			return 0, err6
+		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time):])
+		if err7 != nil {
+			return 0, err7
 		}
-		i -= n6
-		i = encodeVarintFeegrant(dAtA, i, uint64(n6))
+		i -= n7
+		i = encodeVarintFeegrant(dAtA, i, uint64(n7))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -828,6 +919,25 @@ func (m *PeriodicFeeAllowance) Size() (n int) {
 	return n
 }
 
+func (m *AllowedMsgFeeAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.AllowedMessages) > 0 {
+		for _, s := range m.AllowedMessages {
+			l = len(s)
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Duration) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1255,6 +1365,124 @@ func (m *PeriodicFeeAllowance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AllowedMsgFeeAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedMsgFeeAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedMsgFeeAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &types1.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMessages = append(m.AllowedMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Duration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
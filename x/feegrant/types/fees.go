@@ -9,8 +9,8 @@ import (
 // FeeAllowance implementations are tied to a given fee delegator and delegatee,
 // and are used to enforce fee grant limits.
 type FeeAllowanceI interface {
-	// Accept can use fee payment requested as well as timestamp/height of the current block
-	// to determine whether or not to process this. This is checked in
+	// Accept can use fee payment requested, the messages of the tx as well as timestamp/height
+	// of the current block to determine whether or not to process this. This is checked in
 	// Keeper.UseGrantedFees and the return values should match how it is handled there.
 	//
 	// If it returns an error, the fee payment is rejected, otherwise it is accepted.
@@ -19,7 +19,7 @@ type FeeAllowanceI interface {
 	//
 	// If remove is true (regardless of the error), the FeeAllowance will be deleted from storage
 	// (eg. when it is used up). (See call to RevokeFeeAllowance in Keeper.UseGrantedFees)
-	Accept(fee sdk.Coins, blockTime time.Time, blockHeight int64) (remove bool, err error)
+	Accept(fee sdk.Coins, blockTime time.Time, blockHeight int64, msgs []sdk.Msg) (remove bool, err error)
 
 	// If we export fee allowances the timing info will be quite off (eg. go from height 100000 to 0)
 	// This callback allows the fee-allowance to change it's state and return a copy that is adjusted
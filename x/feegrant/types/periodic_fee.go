@@ -9,8 +9,8 @@ import (
 
 var _ FeeAllowanceI = (*PeriodicFeeAllowance)(nil)
 
-// Accept can use fee payment requested as well as timestamp/height of the current block
-// to determine whether or not to process this. This is checked in
+// Accept can use fee payment requested, the messages of the tx as well as timestamp/height
+// of the current block to determine whether or not to process this. This is checked in
 // Keeper.UseGrantedFees and the return values should match how it is handled there.
 //
 // If it returns an error, the fee payment is rejected, otherwise it is accepted.
@@ -19,7 +19,7 @@ var _ FeeAllowanceI = (*PeriodicFeeAllowance)(nil)
 //
 // If remove is true (regardless of the error), the FeeAllowance will be deleted from storage
 // (eg. when it is used up). (See call to RevokeFeeAllowance in Keeper.UseGrantedFees)
-func (a *PeriodicFeeAllowance) Accept(fee sdk.Coins, blockTime time.Time, blockHeight int64) (bool, error) {
+func (a *PeriodicFeeAllowance) Accept(fee sdk.Coins, blockTime time.Time, blockHeight int64, msgs []sdk.Msg) (bool, error) {
 	if a.Basic.Expiration.IsExpired(&blockTime, blockHeight) {
 		return true, sdkerrors.Wrap(ErrFeeLimitExpired, "absolute limit")
 	}
@@ -0,0 +1,43 @@
+package feemarket
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// EndBlocker routes the base fees paid in the block to a burn and the community
+// pool, and adjusts the base gas price of the next block to the gas used by the
+// block.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	burned, community, err := k.RouteBlockBaseFees(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	if !burned.IsZero() || !community.IsZero() {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeBaseFees,
+				sdk.NewAttribute(types.AttributeKeyBurned, burned.String()),
+				sdk.NewAttribute(types.AttributeKeyCommunity, community.String()),
+			),
+		)
+	}
+
+	price := k.UpdateBaseGasPrice(ctx)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBaseGasPrice,
+			sdk.NewAttribute(types.AttributeKeyBlockGasUsed, fmt.Sprintf("%d", ctx.BlockGasMeter().GasConsumed())),
+			sdk.NewAttribute(types.AttributeKeyBaseGasPrice, price.String()),
+		),
+	)
+}
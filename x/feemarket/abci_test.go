@@ -0,0 +1,65 @@
+package feemarket_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestEndBlocker(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	app.FeeMarketKeeper.SetBaseGasPrice(ctx, sdk.OneDec())
+
+	fee := sdk.NewInt64Coin(sdk.DefaultBondDenom, 800)
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	require.NoError(t, simapp.FundAccount(app, ctx, feeCollector, sdk.NewCoins(fee)))
+	app.FeeMarketKeeper.AddBlockBaseFee(ctx, fee)
+
+	blockGasMeter := sdk.NewGasMeter(1000)
+	blockGasMeter.ConsumeGas(1000, "block")
+	ctx = ctx.WithBlockGasMeter(blockGasMeter).WithEventManager(sdk.NewEventManager())
+
+	feemarket.EndBlocker(ctx, app.FeeMarketKeeper)
+
+	// the base fees are burned by default
+	require.True(t, app.BankKeeper.GetBalance(ctx, feeCollector, sdk.DefaultBondDenom).IsZero())
+	require.Equal(t, sdk.NewDecWithPrec(1125, 3), app.FeeMarketKeeper.GetBaseGasPrice(ctx))
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, types.EventTypeBaseFees, events[len(events)-2].Type)
+	require.Equal(t, types.EventTypeBaseGasPrice, events[len(events)-1].Type)
+}
+
+func TestEndBlockerWithoutMaxGas(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	app.FeeMarketKeeper.SetBaseGasPrice(ctx, sdk.OneDec())
+
+	// the blocks without a maximum gas target the target block gas
+	blockGasMeter := sdk.NewInfiniteGasMeter()
+	blockGasMeter.ConsumeGas(2*types.DefaultTargetBlockGas, "block")
+	feemarket.EndBlocker(ctx.WithBlockGasMeter(blockGasMeter), app.FeeMarketKeeper)
+
+	require.Equal(t, sdk.NewDecWithPrec(1125, 3), app.FeeMarketKeeper.GetBaseGasPrice(ctx))
+}
+
+func TestGenesis(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	params := types.NewParams("atom", sdk.NewDecWithPrec(1, 2), 4, 3, sdk.NewDecWithPrec(5, 1), 5000000)
+	genesisState := types.NewGenesisState(params, sdk.NewDecWithPrec(2, 2))
+	feemarket.InitGenesis(ctx, app.FeeMarketKeeper, app.AccountKeeper, genesisState)
+
+	require.Equal(t, genesisState, feemarket.ExportGenesis(ctx, app.FeeMarketKeeper))
+}
@@ -0,0 +1,76 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// BaseFeeDecorator checks that the fee of a tx is at least its base fee, the
+// consensus base gas price times its gas limit. Unlike the MempoolFeeDecorator,
+// the check is performed both in CheckTx and DeliverTx. In DeliverTx the base
+// fee is recorded, so that it is moved out of the fee collector at the end of
+// the block. The decorator must be called before the fees are deducted, so that
+// the recorded base fee is discarded whenever the ante handler fails.
+// If a FeeConverter is given, a fee that does not hold enough of the fee denom
+// still pays the base fee when its coins, converted by the FeeConverter, cover
+// it. The coins covering the base fee are then recorded in their own denoms.
+// CONTRACT: Tx must implement FeeTx to use BaseFeeDecorator
+type BaseFeeDecorator struct {
+	k  keeper.Keeper
+	fc types.FeeConverter
+}
+
+// NewBaseFeeDecorator returns a new BaseFeeDecorator. The FeeConverter may be
+// nil, in which case the base fee must be paid in the fee denom.
+func NewBaseFeeDecorator(k keeper.Keeper, fc types.FeeConverter) BaseFeeDecorator {
+	return BaseFeeDecorator{
+		k:  k,
+		fc: fc,
+	}
+}
+
+func (bfd BaseFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	baseFee := bfd.k.BaseFee(ctx, feeTx.GetGas())
+	if baseFee.IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	feeCoins := feeTx.GetFee()
+	paid, ok := bfd.coverBaseFee(ctx, feeCoins, baseFee)
+	if !ok {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required base fee: %s", feeCoins, baseFee)
+	}
+
+	if !ctx.IsCheckTx() {
+		for _, coin := range paid {
+			bfd.k.AddBlockBaseFee(ctx, coin)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// coverBaseFee returns the coins of the fees paying the base fee and whether
+// the fees cover it.
+func (bfd BaseFeeDecorator) coverBaseFee(ctx sdk.Context, feeCoins sdk.Coins, baseFee sdk.Coin) (sdk.Coins, bool) {
+	if feeCoins.AmountOf(baseFee.Denom).GTE(baseFee.Amount) {
+		return sdk.NewCoins(baseFee), true
+	}
+
+	if bfd.fc == nil {
+		return nil, false
+	}
+
+	return bfd.fc.CoverFee(ctx, feeCoins, baseFee)
+}
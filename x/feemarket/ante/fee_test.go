@@ -0,0 +1,136 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feeconvert"
+	feeconvertante "github.com/cosmos/cosmos-sdk/x/feeconvert/ante"
+	feeconverttypes "github.com/cosmos/cosmos-sdk/x/feeconvert/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/ante"
+)
+
+func TestBaseFeeDecorator(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	txConfig := simapp.MakeTestEncodingConfig().TxConfig
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	newTx := func(fee sdk.Coins) sdk.Tx {
+		txBuilder := txConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+		txBuilder.SetFeeAmount(fee)
+		txBuilder.SetGasLimit(100000)
+		return txBuilder.GetTx()
+	}
+
+	anteHandler := sdk.ChainAnteDecorators(ante.NewBaseFeeDecorator(app.FeeMarketKeeper, nil))
+
+	// a zero base gas price accepts txs without fees
+	_, err := anteHandler(ctx, newTx(nil), false)
+	require.NoError(t, err)
+
+	app.FeeMarketKeeper.SetBaseGasPrice(ctx, sdk.NewDecWithPrec(25, 3))
+	baseFee := sdk.NewInt64Coin(sdk.DefaultBondDenom, 2500)
+
+	// the base fee is enforced in both CheckTx and DeliverTx, but not when simulating
+	lowFee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 2499), sdk.NewInt64Coin("atom", 10000))
+	_, err = anteHandler(ctx.WithIsCheckTx(true), newTx(lowFee), false)
+	require.True(t, sdkerrors.ErrInsufficientFee.Is(err))
+	_, err = anteHandler(ctx, newTx(lowFee), false)
+	require.True(t, sdkerrors.ErrInsufficientFee.Is(err))
+	_, err = anteHandler(ctx, newTx(nil), true)
+	require.NoError(t, err)
+	require.True(t, app.FeeMarketKeeper.GetBlockBaseFees(ctx).IsZero())
+
+	// the base fee is only recorded in DeliverTx
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 3000))
+	_, err = anteHandler(ctx.WithIsCheckTx(true), newTx(fee), false)
+	require.NoError(t, err)
+	require.True(t, app.FeeMarketKeeper.GetBlockBaseFees(ctx).IsZero())
+
+	_, err = anteHandler(ctx, newTx(fee), false)
+	require.NoError(t, err)
+	_, err = anteHandler(ctx, newTx(fee), false)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(baseFee.Add(baseFee)), app.FeeMarketKeeper.GetBlockBaseFees(ctx))
+}
+
+func TestBaseFeeDecoratorWithFeeConvert(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	txConfig := simapp.MakeTestEncodingConfig().TxConfig
+
+	app.FeeConvertKeeper.SetParams(ctx, feeconverttypes.NewParams(sdk.DefaultBondDenom, []feeconverttypes.FeeDenom{
+		feeconverttypes.NewFeeDenom("atom", sdk.NewDecWithPrec(5, 1)),
+	}, feeconverttypes.AltFeeRouteCommunityPool))
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	newTx := func(fee sdk.Coins) sdk.Tx {
+		txBuilder := txConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+		txBuilder.SetFeeAmount(fee)
+		txBuilder.SetGasLimit(100000)
+		return txBuilder.GetTx()
+	}
+
+	// the decorators of both modules, as chained by the SimApp
+	anteHandler := sdk.ChainAnteDecorators(
+		feeconvertante.NewMempoolFeeDecorator(app.FeeConvertKeeper),
+		ante.NewBaseFeeDecorator(app.FeeMarketKeeper, app.FeeConvertKeeper),
+	)
+
+	// the base fee is 2500stake, worth 5000atom, and the minimum gas price
+	// requires 1000stake
+	app.FeeMarketKeeper.SetBaseGasPrice(ctx, sdk.NewDecWithPrec(25, 3))
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(1, 2)))
+	checkCtx := ctx.WithIsCheckTx(true).WithMinGasPrices(minGasPrices)
+
+	// fees not covering the base fee once converted are rejected
+	lowFee := sdk.NewCoins(sdk.NewInt64Coin("atom", 4000), sdk.NewInt64Coin(sdk.DefaultBondDenom, 499))
+	_, err := anteHandler(checkCtx, newTx(lowFee), false)
+	require.True(t, sdkerrors.ErrInsufficientFee.Is(err))
+	_, err = anteHandler(ctx, newTx(lowFee), false)
+	require.True(t, sdkerrors.ErrInsufficientFee.Is(err))
+
+	// fees paid in the alternative denom only are accepted in both CheckTx and
+	// DeliverTx, and the coins paying the base fee are recorded
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 6000))
+	_, err = anteHandler(checkCtx, newTx(fee), false)
+	require.NoError(t, err)
+	_, err = anteHandler(ctx, newTx(fee), false)
+	require.NoError(t, err)
+
+	mixedFee := sdk.NewCoins(sdk.NewInt64Coin("atom", 4000), sdk.NewInt64Coin(sdk.DefaultBondDenom, 500))
+	_, err = anteHandler(ctx, newTx(mixedFee), false)
+	require.NoError(t, err)
+
+	baseFees := sdk.NewCoins(sdk.NewInt64Coin("atom", 9000), sdk.NewInt64Coin(sdk.DefaultBondDenom, 500))
+	require.Equal(t, baseFees, app.FeeMarketKeeper.GetBlockBaseFees(ctx))
+
+	// at the end of the block the base fees are burned, then the rest of the
+	// alternative fees is sent to the community pool
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	require.NoError(t, simapp.FundAccount(app, ctx, feeCollector, fee.Add(mixedFee...)))
+	supply := app.BankKeeper.GetTotalSupply(ctx)
+
+	ctx = ctx.WithBlockGasMeter(sdk.NewInfiniteGasMeter())
+	feemarket.EndBlocker(ctx, app.FeeMarketKeeper)
+	feeconvert.EndBlocker(ctx, app.FeeConvertKeeper)
+
+	require.Equal(t, supply.Sub(baseFees), app.BankKeeper.GetTotalSupply(ctx))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, feeCollector).IsZero())
+	require.Equal(
+		t,
+		sdk.NewDecCoins(sdk.NewInt64DecCoin("atom", 1000)),
+		app.DistrKeeper.GetFeePoolCommunityCoins(ctx),
+	)
+}
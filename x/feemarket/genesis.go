@@ -0,0 +1,19 @@
+package feemarket
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// InitGenesis initializes the feemarket module's state from a given genesis state.
+func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, ak types.AccountKeeper, data *types.GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+	keeper.SetBaseGasPrice(ctx, data.BaseGasPrice)
+	ak.GetModuleAccount(ctx, types.ModuleName)
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(keeper.GetParams(ctx), keeper.GetBaseGasPrice(ctx))
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns params of the feemarket module.
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// BaseGasPrice returns the current base gas price of the feemarket module.
+func (k Keeper) BaseGasPrice(c context.Context, _ *types.QueryBaseGasPriceRequest) (*types.QueryBaseGasPriceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryBaseGasPriceResponse{
+		BaseGasPrice: k.GetBaseGasPrice(ctx),
+		FeeDenom:     k.GetParams(ctx).FeeDenom,
+	}, nil
+}
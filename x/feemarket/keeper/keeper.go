@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Keeper of the feemarket store
+type Keeper struct {
+	cdc              codec.BinaryMarshaler
+	storeKey         sdk.StoreKey
+	transientKey     sdk.StoreKey
+	paramSpace       paramtypes.Subspace
+	authKeeper       types.AccountKeeper
+	bankKeeper       types.BankKeeper
+	distrKeeper      types.DistributionKeeper
+	feeCollectorName string
+}
+
+// NewKeeper creates a new feemarket Keeper instance
+func NewKeeper(
+	cdc codec.BinaryMarshaler, key, tkey sdk.StoreKey, paramSpace paramtypes.Subspace,
+	ak types.AccountKeeper, bk types.BankKeeper, dk types.DistributionKeeper, feeCollectorName string,
+) Keeper {
+	// ensure feemarket module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("the %s module account has not been set", types.ModuleName))
+	}
+
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:              cdc,
+		storeKey:         key,
+		transientKey:     tkey,
+		paramSpace:       paramSpace,
+		authKeeper:       ak,
+		bankKeeper:       bk,
+		distrKeeper:      dk,
+		feeCollectorName: feeCollectorName,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetParams returns the total set of feemarket parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of feemarket parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetBaseGasPrice returns the gas price a tx must at least pay.
+func (k Keeper) GetBaseGasPrice(ctx sdk.Context) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BaseGasPriceKey)
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	var price sdk.DecProto
+	k.cdc.MustUnmarshalBinaryBare(bz, &price)
+	return price.Dec
+}
+
+// SetBaseGasPrice sets the gas price a tx must at least pay.
+func (k Keeper) SetBaseGasPrice(ctx sdk.Context, price sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&sdk.DecProto{Dec: price})
+	store.Set(types.BaseGasPriceKey, bz)
+}
+
+// GetFeeDenom returns the denom in which the base fee is paid.
+func (k Keeper) GetFeeDenom(ctx sdk.Context) (denom string) {
+	k.paramSpace.Get(ctx, types.KeyFeeDenom, &denom)
+	return denom
+}
+
+// BaseFee returns the base fee a tx with the given gas limit must at least pay.
+// The params are only read when the base gas price is positive.
+func (k Keeper) BaseFee(ctx sdk.Context, gasLimit uint64) sdk.Coin {
+	price := k.GetBaseGasPrice(ctx)
+	if price.IsZero() {
+		return sdk.Coin{Amount: sdk.ZeroInt()}
+	}
+
+	return types.BaseFee(k.GetFeeDenom(ctx), price, gasLimit)
+}
+
+// AddBlockBaseFee records a base fee paid in the current block. The base fees
+// are moved out of the fee collector at the end of the block.
+func (k Keeper) AddBlockBaseFee(ctx sdk.Context, fee sdk.Coin) {
+	if fee.IsZero() {
+		return
+	}
+
+	store := ctx.TransientStore(k.transientKey)
+	key := types.BlockBaseFeesKey(fee.Denom)
+
+	amount := sdk.IntProto{Int: sdk.ZeroInt()}
+	if bz := store.Get(key); bz != nil {
+		k.cdc.MustUnmarshalBinaryBare(bz, &amount)
+	}
+
+	amount.Int = amount.Int.Add(fee.Amount)
+	store.Set(key, k.cdc.MustMarshalBinaryBare(&amount))
+}
+
+// GetBlockBaseFees returns the base fees paid in the current block.
+func (k Keeper) GetBlockBaseFees(ctx sdk.Context) sdk.Coins {
+	store := ctx.TransientStore(k.transientKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.BlockBaseFeesPrefix)
+	defer iterator.Close()
+
+	fees := sdk.NewCoins()
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.IntProto
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &amount)
+
+		denom := string(iterator.Key()[len(types.BlockBaseFeesPrefix):])
+		fees = fees.Add(sdk.NewCoin(denom, amount.Int))
+	}
+
+	return fees
+}
+
+// UpdateBaseGasPrice sets the base gas price of the next block from the gas
+// used by the current block and returns it.
+func (k Keeper) UpdateBaseGasPrice(ctx sdk.Context) sdk.Dec {
+	params := k.GetParams(ctx)
+	blockGasMeter := ctx.BlockGasMeter()
+
+	price := params.NextBaseGasPrice(k.GetBaseGasPrice(ctx), blockGasMeter.GasConsumed(), blockGasMeter.Limit())
+	k.SetBaseGasPrice(ctx, price)
+
+	return price
+}
+
+// RouteBlockBaseFees moves the base fees paid in the current block out of the
+// fee collector, burning the burn ratio of them and sending the rest to the
+// community pool. It returns the coins burned and sent to the community pool.
+func (k Keeper) RouteBlockBaseFees(ctx sdk.Context) (burned, community sdk.Coins, err error) {
+	fees := k.GetBlockBaseFees(ctx)
+	if fees.IsZero() {
+		return nil, nil, nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, fees); err != nil {
+		return nil, nil, err
+	}
+
+	burnRatio := k.GetParams(ctx).BurnRatio
+	burnedCoins := make([]sdk.Coin, len(fees))
+	for i, fee := range fees {
+		burnedCoins[i] = sdk.NewCoin(fee.Denom, fee.Amount.ToDec().Mul(burnRatio).TruncateInt())
+	}
+	burned = sdk.NewCoins(burnedCoins...)
+	community = fees.Sub(burned)
+
+	if !burned.IsZero() {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burned); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if !community.IsZero() {
+		moduleAddr := k.authKeeper.GetModuleAddress(types.ModuleName)
+		if err := k.distrKeeper.FundCommunityPool(ctx, community, moduleAddr); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return burned, community, nil
+}
@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.FeeMarketKeeper)
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func (suite *KeeperTestSuite) TestBaseGasPrice() {
+	app, ctx := suite.app, suite.ctx
+
+	suite.Require().Equal(sdk.ZeroDec(), app.FeeMarketKeeper.GetBaseGasPrice(ctx))
+	suite.Require().True(app.FeeMarketKeeper.BaseFee(ctx, 100000).IsZero())
+
+	app.FeeMarketKeeper.SetBaseGasPrice(ctx, sdk.NewDecWithPrec(25, 3))
+	suite.Require().Equal(sdk.NewDecWithPrec(25, 3), app.FeeMarketKeeper.GetBaseGasPrice(ctx))
+	suite.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 2500), app.FeeMarketKeeper.BaseFee(ctx, 100000))
+}
+
+func (suite *KeeperTestSuite) TestUpdateBaseGasPrice() {
+	app, ctx := suite.app, suite.ctx
+
+	params := app.FeeMarketKeeper.GetParams(ctx)
+	params.MinBaseGasPrice = sdk.NewDecWithPrec(1, 2)
+	app.FeeMarketKeeper.SetParams(ctx, params)
+	app.FeeMarketKeeper.SetBaseGasPrice(ctx, sdk.OneDec())
+
+	// a full block raises the price by 1/8
+	blockGasMeter := sdk.NewGasMeter(1000)
+	blockGasMeter.ConsumeGas(1000, "block")
+	price := app.FeeMarketKeeper.UpdateBaseGasPrice(ctx.WithBlockGasMeter(blockGasMeter))
+	suite.Require().Equal(sdk.NewDecWithPrec(1125, 3), price)
+	suite.Require().Equal(price, app.FeeMarketKeeper.GetBaseGasPrice(ctx))
+
+	// empty blocks lower the price down to the floor
+	for i := 0; i < 100; i++ {
+		price = app.FeeMarketKeeper.UpdateBaseGasPrice(ctx.WithBlockGasMeter(sdk.NewGasMeter(1000)))
+	}
+	suite.Require().Equal(params.MinBaseGasPrice, price)
+}
+
+func (suite *KeeperTestSuite) TestRouteBlockBaseFees() {
+	app, ctx := suite.app, suite.ctx
+
+	params := app.FeeMarketKeeper.GetParams(ctx)
+	params.BurnRatio = sdk.NewDecWithPrec(75, 2)
+	app.FeeMarketKeeper.SetParams(ctx, params)
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	suite.Require().NoError(simapp.FundAccount(app, ctx, feeCollector, fees))
+
+	app.FeeMarketKeeper.AddBlockBaseFee(ctx, sdk.NewInt64Coin(sdk.DefaultBondDenom, 300))
+	app.FeeMarketKeeper.AddBlockBaseFee(ctx, sdk.NewInt64Coin(sdk.DefaultBondDenom, 0))
+	app.FeeMarketKeeper.AddBlockBaseFee(ctx, sdk.NewInt64Coin(sdk.DefaultBondDenom, 101))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 401)), app.FeeMarketKeeper.GetBlockBaseFees(ctx))
+
+	supply := app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom)
+	communityPool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+
+	burned, community, err := app.FeeMarketKeeper.RouteBlockBaseFees(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300)), burned)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 101)), community)
+
+	// the rest of the fees stays in the fee collector for the validators
+	suite.Require().Equal(
+		sdk.NewInt64Coin(sdk.DefaultBondDenom, 599),
+		app.BankKeeper.GetBalance(ctx, feeCollector, sdk.DefaultBondDenom),
+	)
+	suite.Require().Equal(supply.Sub(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300)), app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom))
+	suite.Require().Equal(
+		communityPool.Add(sdk.NewDecCoinsFromCoins(community...)...),
+		app.DistrKeeper.GetFeePoolCommunityCoins(ctx),
+	)
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, app.AccountKeeper.GetModuleAddress(types.ModuleName)).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueries() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	app.FeeMarketKeeper.SetBaseGasPrice(ctx, sdk.NewDecWithPrec(25, 3))
+
+	params, err := queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(app.FeeMarketKeeper.GetParams(ctx), params.Params)
+
+	price, err := queryClient.BaseGasPrice(gocontext.Background(), &types.QueryBaseGasPriceRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecWithPrec(25, 3), price.BaseGasPrice)
+	suite.Require().Equal(sdk.DefaultBondDenom, price.FeeDenom)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,12 @@
+package types
+
+// feemarket module event types
+const (
+	EventTypeBaseGasPrice = "base_gas_price"
+	EventTypeBaseFees     = "base_fees"
+
+	AttributeKeyBaseGasPrice = "base_gas_price"
+	AttributeKeyBlockGasUsed = "block_gas_used"
+	AttributeKeyBurned       = "burned"
+	AttributeKeyCommunity    = "community_pool"
+)
@@ -0,0 +1,31 @@
+package types // noalias
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// AccountKeeper defines the contract required for account APIs.
+type AccountKeeper interface {
+	GetModuleAddress(name string) sdk.AccAddress
+	GetModuleAccount(ctx sdk.Context, moduleName string) types.ModuleAccountI
+}
+
+// BankKeeper defines the contract needed to route the base fees.
+type BankKeeper interface {
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
+}
+
+// DistributionKeeper defines the contract needed to fund the community pool.
+type DistributionKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// FeeConverter defines the contract needed to accept base fees paid in other
+// denoms than the fee denom, e.g. through the x/feeconvert exchange rates.
+type FeeConverter interface {
+	// CoverFee returns the coins of fees which cover fee and whether the fees
+	// cover it.
+	CoverFee(ctx sdk.Context, fees sdk.Coins, fee sdk.Coin) (sdk.Coins, bool)
+}
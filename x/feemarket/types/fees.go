@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BaseFee returns the base fee of a tx with the given gas limit, the base gas
+// price times the gas limit rounded up, in the fee denom.
+func BaseFee(denom string, baseGasPrice sdk.Dec, gasLimit uint64) sdk.Coin {
+	amount := baseGasPrice.MulInt(sdk.NewIntFromUint64(gasLimit)).Ceil().RoundInt()
+	return sdk.NewCoin(denom, amount)
+}
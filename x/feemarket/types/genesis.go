@@ -0,0 +1,41 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, baseGasPrice sdk.Dec) *GenesisState {
+	return &GenesisState{
+		Params:       params,
+		BaseGasPrice: baseGasPrice,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object. The fee market is
+// inactive until a chain sets a positive base gas price.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:       DefaultParams(),
+		BaseGasPrice: sdk.ZeroDec(),
+	}
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	if data.BaseGasPrice.IsNil() || data.BaseGasPrice.LT(data.Params.MinBaseGasPrice) {
+		return fmt.Errorf(
+			"base gas price (%s) must be greater than or equal to min base gas price (%s)",
+			data.BaseGasPrice, data.Params.MinBaseGasPrice,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "feemarket"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// TStoreKey defines the transient store key, holding the base fees paid in
+	// the current block
+	TStoreKey = "transient_" + ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+var (
+	// BaseGasPriceKey is the key of the base gas price in the store.
+	BaseGasPriceKey = []byte{0x00}
+
+	// BlockBaseFeesPrefix is the prefix of the base fees paid in the current
+	// block, by denom, in the transient store.
+	BlockBaseFeesPrefix = []byte{0x01}
+)
+
+// BlockBaseFeesKey returns the key of the base fees paid in the given denom in
+// the current block.
+func BlockBaseFeesKey(denom string) []byte {
+	return append(BlockBaseFeesPrefix, []byte(denom)...)
+}
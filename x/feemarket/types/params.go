@@ -0,0 +1,215 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys
+var (
+	KeyFeeDenom                 = []byte("FeeDenom")
+	KeyMinBaseGasPrice          = []byte("MinBaseGasPrice")
+	KeyBaseFeeChangeDenominator = []byte("BaseFeeChangeDenominator")
+	KeyElasticityMultiplier     = []byte("ElasticityMultiplier")
+	KeyBurnRatio                = []byte("BurnRatio")
+	KeyTargetBlockGas           = []byte("TargetBlockGas")
+)
+
+// DefaultTargetBlockGas is the default gas targeted in the blocks without a
+// maximum gas.
+const DefaultTargetBlockGas uint64 = 10000000
+
+// ParamKeyTable for the feemarket module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(
+	feeDenom string, minBaseGasPrice sdk.Dec, baseFeeChangeDenominator, elasticityMultiplier uint32, burnRatio sdk.Dec,
+	targetBlockGas uint64,
+) Params {
+
+	return Params{
+		FeeDenom:                 feeDenom,
+		MinBaseGasPrice:          minBaseGasPrice,
+		BaseFeeChangeDenominator: baseFeeChangeDenominator,
+		ElasticityMultiplier:     elasticityMultiplier,
+		BurnRatio:                burnRatio,
+		TargetBlockGas:           targetBlockGas,
+	}
+}
+
+// DefaultParams returns the default feemarket module parameters, following
+// EIP-1559.
+func DefaultParams() Params {
+	return Params{
+		FeeDenom:                 sdk.DefaultBondDenom,
+		MinBaseGasPrice:          sdk.ZeroDec(),
+		BaseFeeChangeDenominator: 8,
+		ElasticityMultiplier:     2,
+		BurnRatio:                sdk.OneDec(),
+		TargetBlockGas:           DefaultTargetBlockGas,
+	}
+}
+
+// Validate validates the set of params.
+func (p Params) Validate() error {
+	if err := validateFeeDenom(p.FeeDenom); err != nil {
+		return err
+	}
+	if err := validateMinBaseGasPrice(p.MinBaseGasPrice); err != nil {
+		return err
+	}
+	if err := validateBaseFeeChangeDenominator(p.BaseFeeChangeDenominator); err != nil {
+		return err
+	}
+	if err := validateElasticityMultiplier(p.ElasticityMultiplier); err != nil {
+		return err
+	}
+
+	if err := validateBurnRatio(p.BurnRatio); err != nil {
+		return err
+	}
+
+	return validateTargetBlockGas(p.TargetBlockGas)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyFeeDenom, &p.FeeDenom, validateFeeDenom),
+		paramtypes.NewParamSetPair(KeyMinBaseGasPrice, &p.MinBaseGasPrice, validateMinBaseGasPrice),
+		paramtypes.NewParamSetPair(KeyBaseFeeChangeDenominator, &p.BaseFeeChangeDenominator, validateBaseFeeChangeDenominator),
+		paramtypes.NewParamSetPair(KeyElasticityMultiplier, &p.ElasticityMultiplier, validateElasticityMultiplier),
+		paramtypes.NewParamSetPair(KeyBurnRatio, &p.BurnRatio, validateBurnRatio),
+		paramtypes.NewParamSetPair(KeyTargetBlockGas, &p.TargetBlockGas, validateTargetBlockGas),
+	}
+}
+
+// NextBaseGasPrice returns the base gas price of the block following a block
+// with the given gas used and maximum gas, zero for no maximum. As in EIP-1559,
+// the price increases when the block used more than its gas target, the maximum
+// gas divided by the elasticity multiplier or, without a maximum, the target
+// block gas, and decreases when it used less, by at most
+// 1/BaseFeeChangeDenominator. An increase is of at least the smallest decimal,
+// so that a zero price can rise. The price never falls below the minimum.
+func (p Params) NextBaseGasPrice(price sdk.Dec, gasUsed, maxGas uint64) sdk.Dec {
+	target := p.TargetBlockGas
+	if maxGas != 0 {
+		target = maxGas / uint64(p.ElasticityMultiplier)
+	}
+	if target == 0 {
+		return sdk.MaxDec(price, p.MinBaseGasPrice)
+	}
+
+	var delta sdk.Dec
+	if gasUsed > target {
+		delta = price.MulInt(sdk.NewIntFromUint64(gasUsed - target)).
+			QuoInt(sdk.NewIntFromUint64(target)).QuoInt64(int64(p.BaseFeeChangeDenominator))
+		delta = sdk.MaxDec(delta, sdk.SmallestDec())
+	} else {
+		delta = price.MulInt(sdk.NewIntFromUint64(target - gasUsed)).
+			QuoInt(sdk.NewIntFromUint64(target)).QuoInt64(int64(p.BaseFeeChangeDenominator)).Neg()
+	}
+
+	return sdk.MaxDec(price.Add(delta), p.MinBaseGasPrice)
+}
+
+func validateFeeDenom(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if strings.TrimSpace(v) == "" {
+		return errors.New("fee denom cannot be blank")
+	}
+
+	return sdk.ValidateDenom(v)
+}
+
+func validateMinBaseGasPrice(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return errors.New("min base gas price cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("min base gas price cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateBaseFeeChangeDenominator(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return errors.New("base fee change denominator must be positive")
+	}
+
+	return nil
+}
+
+func validateElasticityMultiplier(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return errors.New("elasticity multiplier must be positive")
+	}
+
+	return nil
+}
+
+func validateBurnRatio(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return errors.New("burn ratio cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("burn ratio cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("burn ratio too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateTargetBlockGas(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return errors.New("target block gas must be positive")
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		params  types.Params
+		expPass bool
+	}{
+		{"default", types.DefaultParams(), true},
+		{"blank denom", types.NewParams("", sdk.ZeroDec(), 8, 2, sdk.OneDec(), 1000), false},
+		{"negative min price", types.NewParams("stake", sdk.NewDec(-1), 8, 2, sdk.OneDec(), 1000), false},
+		{"zero denominator", types.NewParams("stake", sdk.ZeroDec(), 0, 2, sdk.OneDec(), 1000), false},
+		{"zero elasticity", types.NewParams("stake", sdk.ZeroDec(), 8, 0, sdk.OneDec(), 1000), false},
+		{"negative burn ratio", types.NewParams("stake", sdk.ZeroDec(), 8, 2, sdk.NewDec(-1), 1000), false},
+		{"burn ratio too large", types.NewParams("stake", sdk.ZeroDec(), 8, 2, sdk.NewDec(2), 1000), false},
+		{"zero target block gas", types.NewParams("stake", sdk.ZeroDec(), 8, 2, sdk.OneDec(), 0), false},
+		{"partial burn", types.NewParams("stake", sdk.NewDecWithPrec(1, 2), 8, 2, sdk.NewDecWithPrec(5, 1), 1000), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestNextBaseGasPrice(t *testing.T) {
+	params := types.NewParams("stake", sdk.NewDecWithPrec(1, 2), 8, 2, sdk.OneDec(), 500)
+	price := sdk.OneDec()
+
+	testCases := []struct {
+		name     string
+		price    sdk.Dec
+		gasUsed  uint64
+		maxGas   uint64
+		expected sdk.Dec
+	}{
+		{"at target", price, 500, 1000, price},
+		{"full block", price, 1000, 1000, sdk.NewDecWithPrec(1125, 3)},
+		{"empty block", price, 0, 1000, sdk.NewDecWithPrec(875, 3)},
+		{"above target", price, 750, 1000, sdk.NewDecWithPrec(10625, 4)},
+		{"no maximum gas at target", price, 500, 0, price},
+		{"no maximum gas above target", price, 1000, 0, sdk.NewDecWithPrec(1125, 3)},
+		{"no maximum gas below target", price, 0, 0, sdk.NewDecWithPrec(875, 3)},
+		{"floored", sdk.NewDecWithPrec(1, 2), 0, 1000, sdk.NewDecWithPrec(1, 2)},
+		{"raised to floor", sdk.ZeroDec(), 0, 0, sdk.NewDecWithPrec(1, 2)},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, params.NextBaseGasPrice(tc.price, tc.gasUsed, tc.maxGas))
+		})
+	}
+
+	// without a minimum, a zero price rises by the smallest decimal, then
+	// grows from there
+	params.MinBaseGasPrice = sdk.ZeroDec()
+	testCases = []struct {
+		name     string
+		price    sdk.Dec
+		gasUsed  uint64
+		maxGas   uint64
+		expected sdk.Dec
+	}{
+		{"zero price above target", sdk.ZeroDec(), 1000, 1000, sdk.SmallestDec()},
+		{"zero price at target", sdk.ZeroDec(), 500, 1000, sdk.ZeroDec()},
+		{"zero price below target", sdk.ZeroDec(), 0, 1000, sdk.ZeroDec()},
+		{"tiny price above target", sdk.NewDecWithPrec(2, sdk.Precision), 1000, 1000, sdk.NewDecWithPrec(3, sdk.Precision)},
+		{"large price above target", price, 1000, 1000, sdk.NewDecWithPrec(1125, 3)},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, params.NextBaseGasPrice(tc.price, tc.gasUsed, tc.maxGas))
+		})
+	}
+}
+
+func TestBaseFee(t *testing.T) {
+	require.Equal(t, sdk.NewInt64Coin("stake", 3), types.BaseFee("stake", sdk.NewDecWithPrec(25, 3), 100))
+	require.Equal(t, sdk.NewInt64Coin("stake", 0), types.BaseFee("stake", sdk.ZeroDec(), 100))
+}
+
+func TestValidateGenesis(t *testing.T) {
+	require.NoError(t, types.ValidateGenesis(*types.DefaultGenesisState()))
+
+	params := types.NewParams("stake", sdk.OneDec(), 8, 2, sdk.OneDec(), 1000)
+	require.Error(t, types.ValidateGenesis(*types.NewGenesisState(params, sdk.NewDecWithPrec(5, 1))))
+	require.NoError(t, types.ValidateGenesis(*types.NewGenesisState(params, sdk.NewDec(2))))
+}
@@ -9,6 +9,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authz "github.com/cosmos/cosmos-sdk/x/authz/exported"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -65,6 +67,9 @@ func (k msgServer) Vote(goCtx context.Context, msg *types.MsgVote) (*types.MsgVo
 	if accErr != nil {
 		return nil, accErr
 	}
+	if err := k.checkVoteAuthorization(ctx, msg.ProposalId); err != nil {
+		return nil, err
+	}
 	err := k.Keeper.AddVote(ctx, msg.ProposalId, accAddr, types.NewNonSplitVoteOption(msg.Option))
 	if err != nil {
 		return nil, err
@@ -95,6 +100,9 @@ func (k msgServer) VoteWeighted(goCtx context.Context, msg *types.MsgVoteWeighte
 	if accErr != nil {
 		return nil, accErr
 	}
+	if err := k.checkVoteAuthorization(ctx, msg.ProposalId); err != nil {
+		return nil, err
+	}
 	err := k.Keeper.AddVote(ctx, msg.ProposalId, accAddr, msg.Options)
 	if err != nil {
 		return nil, err
@@ -157,3 +165,25 @@ func (k msgServer) Deposit(goCtx context.Context, msg *types.MsgDeposit) (*types
 
 	return &types.MsgDepositResponse{}, nil
 }
+
+// checkVoteAuthorization checks that a vote cast through a VoteAuthorization is
+// on a proposal the authorization allows. The authorization itself only checks
+// the proposal IDs, as the type of the proposal cannot be known without the gov
+// state.
+func (k msgServer) checkVoteAuthorization(ctx sdk.Context, proposalID uint64) error {
+	authorization, ok := authz.AuthorizationFromContext(ctx).(*types.VoteAuthorization)
+	if !ok {
+		return nil
+	}
+
+	proposal, ok := k.GetProposal(ctx, proposalID)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+
+	if !authorization.IsAllowedProposal(proposalID, proposal.ProposalType()) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "cannot vote on proposal %d of type %s", proposalID, proposal.ProposalType())
+	}
+
+	return nil
+}
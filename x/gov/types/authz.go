@@ -0,0 +1,116 @@
+package types
+
+import (
+	"strings"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authz "github.com/cosmos/cosmos-sdk/x/authz/exported"
+)
+
+var (
+	_                authz.Authorization = &VoteAuthorization{}
+	TypeVote                             = "/cosmos.gov.v1beta1.Msg/Vote"
+	TypeVoteWeighted                     = "/cosmos.gov.v1beta1.Msg/VoteWeighted"
+)
+
+// NewVoteAuthorization creates a new VoteAuthorization object. It authorizes
+// Msg/VoteWeighted if weighted is true and Msg/Vote otherwise, on the given
+// proposals and on the proposals of the given types. A maxVotes of zero does
+// not limit the number of votes.
+//
+// NOTE: the type of a proposal cannot be known when accepting a vote, so a
+// vote allowed by the proposal types only is accepted by the authorization
+// and its proposal type is checked by the gov Msg service.
+func NewVoteAuthorization(proposalIDs []uint64, proposalTypes []string, maxVotes uint64, weighted bool) (*VoteAuthorization, error) {
+	if len(proposalIDs) == 0 && len(proposalTypes) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "proposal ids and proposal types cannot be both empty")
+	}
+
+	for _, proposalType := range proposalTypes {
+		if strings.TrimSpace(proposalType) == "" {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "proposal type cannot be blank")
+		}
+	}
+
+	return &VoteAuthorization{
+		ProposalIds:   proposalIDs,
+		ProposalTypes: proposalTypes,
+		MaxVotes:      maxVotes,
+		Weighted:      weighted,
+	}, nil
+}
+
+// MethodName implements Authorization.MethodName.
+func (authorization VoteAuthorization) MethodName() string {
+	if authorization.Weighted {
+		return TypeVoteWeighted
+	}
+	return TypeVote
+}
+
+// Accept implements Authorization.Accept.
+func (authorization VoteAuthorization) Accept(msg sdk.ServiceMsg, block tmproto.Header) (updated authz.Authorization, delete bool, err error) {
+	var proposalID uint64
+
+	switch msg := msg.Request.(type) {
+	case *MsgVote:
+		if authorization.Weighted {
+			return nil, false, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type mismatch")
+		}
+		proposalID = msg.ProposalId
+	case *MsgVoteWeighted:
+		if !authorization.Weighted {
+			return nil, false, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type mismatch")
+		}
+		proposalID = msg.ProposalId
+	default:
+		return nil, false, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type mismatch")
+	}
+
+	// the proposal type of a proposal not allowed by its ID is checked by the
+	// gov Msg service
+	if !authorization.isAllowedProposalID(proposalID) && len(authorization.ProposalTypes) == 0 {
+		return nil, false, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "cannot vote on proposal %d", proposalID)
+	}
+
+	switch authorization.MaxVotes {
+	case 0:
+		return nil, false, nil
+	case 1:
+		return nil, true, nil
+	default:
+		return &VoteAuthorization{
+			ProposalIds:   authorization.ProposalIds,
+			ProposalTypes: authorization.ProposalTypes,
+			MaxVotes:      authorization.MaxVotes - 1,
+			Weighted:      authorization.Weighted,
+		}, false, nil
+	}
+}
+
+// IsAllowedProposal returns true if the authorization allows voting on the
+// proposal with the given ID and proposal type.
+func (authorization VoteAuthorization) IsAllowedProposal(proposalID uint64, proposalType string) bool {
+	if authorization.isAllowedProposalID(proposalID) {
+		return true
+	}
+
+	for _, t := range authorization.ProposalTypes {
+		if t == proposalType {
+			return true
+		}
+	}
+	return false
+}
+
+func (authorization VoteAuthorization) isAllowedProposalID(proposalID uint64) bool {
+	for _, id := range authorization.ProposalIds {
+		if id == proposalID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,114 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestVoteAuthorization(t *testing.T) {
+	voter := sdk.AccAddress("_______voter________")
+
+	// proposal ids or proposal types are required
+	_, err := types.NewVoteAuthorization(nil, nil, 0, false)
+	require.Error(t, err)
+	_, err = types.NewVoteAuthorization(nil, []string{" "}, 0, false)
+	require.Error(t, err)
+
+	// verify MethodName
+	voteAuth, err := types.NewVoteAuthorization([]uint64{1, 2}, nil, 0, false)
+	require.NoError(t, err)
+	require.Equal(t, types.TypeVote, voteAuth.MethodName())
+	weightedAuth, err := types.NewVoteAuthorization([]uint64{1, 2}, nil, 0, true)
+	require.NoError(t, err)
+	require.Equal(t, types.TypeVoteWeighted, weightedAuth.MethodName())
+
+	vote := func(proposalID uint64) sdk.ServiceMsg {
+		return sdk.ServiceMsg{
+			MethodName: types.TypeVote,
+			Request:    types.NewMsgVote(voter, proposalID, types.OptionYes),
+		}
+	}
+	voteWeighted := func(proposalID uint64) sdk.ServiceMsg {
+		return sdk.ServiceMsg{
+			MethodName: types.TypeVoteWeighted,
+			Request:    types.NewMsgVoteWeighted(voter, proposalID, types.NewNonSplitVoteOption(types.OptionYes)),
+		}
+	}
+
+	testCases := []struct {
+		msg                  string
+		weighted             bool
+		maxVotes             uint64
+		srvMsg               sdk.ServiceMsg
+		expectErr            bool
+		isDelete             bool
+		updatedAuthorization *types.VoteAuthorization
+	}{
+		{"vote: allowed proposal", false, 0, vote(1), false, false, nil},
+		{"vote: proposal not allowed", false, 0, vote(3), true, false, nil},
+		{"vote: type mismatch", false, 0, voteWeighted(1), true, false, nil},
+		{"vote: last vote", false, 1, vote(2), false, true, nil},
+		{
+			"vote: verify remaining votes", false, 3, vote(2), false, false,
+			&types.VoteAuthorization{ProposalIds: []uint64{1, 2}, MaxVotes: 2},
+		},
+		{"vote weighted: allowed proposal", true, 0, voteWeighted(2), false, false, nil},
+		{"vote weighted: proposal not allowed", true, 1, voteWeighted(3), true, false, nil},
+		{"vote weighted: type mismatch", true, 0, vote(1), true, false, nil},
+		{
+			"vote weighted: verify remaining votes", true, 2, voteWeighted(1), false, false,
+			&types.VoteAuthorization{ProposalIds: []uint64{1, 2}, MaxVotes: 1, Weighted: true},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.msg, func(t *testing.T) {
+			authorization, err := types.NewVoteAuthorization([]uint64{1, 2}, nil, tc.maxVotes, tc.weighted)
+			require.NoError(t, err)
+
+			updated, del, err := authorization.Accept(tc.srvMsg, tmproto.Header{})
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.isDelete, del)
+			if tc.updatedAuthorization == nil {
+				require.Nil(t, updated)
+			} else {
+				require.Equal(t, tc.updatedAuthorization, updated)
+			}
+		})
+	}
+}
+
+func TestVoteAuthorizationProposalTypes(t *testing.T) {
+	voter := sdk.AccAddress("_______voter________")
+	authorization, err := types.NewVoteAuthorization([]uint64{1}, []string{types.ProposalTypeText}, 2, false)
+	require.NoError(t, err)
+
+	// the proposal type is checked by the gov Msg service, so any proposal is
+	// accepted
+	updated, del, err := authorization.Accept(sdk.ServiceMsg{
+		MethodName: types.TypeVote,
+		Request:    types.NewMsgVote(voter, 3, types.OptionYes),
+	}, tmproto.Header{})
+	require.NoError(t, err)
+	require.False(t, del)
+	require.Equal(t, &types.VoteAuthorization{
+		ProposalIds:   []uint64{1},
+		ProposalTypes: []string{types.ProposalTypeText},
+		MaxVotes:      1,
+	}, updated)
+
+	require.True(t, authorization.IsAllowedProposal(1, "ParameterChange"))
+	require.True(t, authorization.IsAllowedProposal(3, types.ProposalTypeText))
+	require.False(t, authorization.IsAllowedProposal(3, "ParameterChange"))
+}
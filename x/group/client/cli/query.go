@@ -0,0 +1,480 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	groupQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the group module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	groupQueryCmd.AddCommand(
+		GetCmdQueryGroupInfo(),
+		GetCmdQueryGroupPolicyInfo(),
+		GetCmdQueryGroupMembers(),
+		GetCmdQueryGroupsByAdmin(),
+		GetCmdQueryGroupPoliciesByGroup(),
+		GetCmdQueryGroupPoliciesByAdmin(),
+		GetCmdQueryProposal(),
+		GetCmdQueryProposalsByGroupPolicy(),
+		GetCmdQueryVoteByProposalVoter(),
+		GetCmdQueryVotesByProposal(),
+		GetCmdQueryVotesByVoter(),
+	)
+
+	return groupQueryCmd
+}
+
+// GetCmdQueryGroupInfo implements the query group-info command.
+func GetCmdQueryGroupInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "group-info [group-id]",
+		Short: "Query the info of a group",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			groupId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GroupInfo(
+				context.Background(),
+				&types.QueryGroupInfoRequest{
+					GroupId: groupId,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryGroupPolicyInfo implements the query group-policy-info command.
+func GetCmdQueryGroupPolicyInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "group-policy-info [group-policy]",
+		Short: "Query the info of a group policy",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GroupPolicyInfo(
+				context.Background(),
+				&types.QueryGroupPolicyInfoRequest{
+					Address: address.String(),
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryGroupMembers implements the query group-members command.
+func GetCmdQueryGroupMembers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "group-members [group-id]",
+		Short: "Query the members of a group",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			groupId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GroupMembers(
+				context.Background(),
+				&types.QueryGroupMembersRequest{
+					GroupId:    groupId,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "group members")
+	return cmd
+}
+
+// GetCmdQueryGroupsByAdmin implements the query groups-by-admin command.
+func GetCmdQueryGroupsByAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "groups-by-admin [admin]",
+		Short: "Query the groups of an admin",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			admin, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GroupsByAdmin(
+				context.Background(),
+				&types.QueryGroupsByAdminRequest{
+					Admin:      admin.String(),
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "groups")
+	return cmd
+}
+
+// GetCmdQueryGroupPoliciesByGroup implements the query group-policies-by-group command.
+func GetCmdQueryGroupPoliciesByGroup() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "group-policies-by-group [group-id]",
+		Short: "Query the group policies of a group",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			groupId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GroupPoliciesByGroup(
+				context.Background(),
+				&types.QueryGroupPoliciesByGroupRequest{
+					GroupId:    groupId,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "group policies")
+	return cmd
+}
+
+// GetCmdQueryGroupPoliciesByAdmin implements the query group-policies-by-admin command.
+func GetCmdQueryGroupPoliciesByAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "group-policies-by-admin [admin]",
+		Short: "Query the group policies of an admin",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			admin, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GroupPoliciesByAdmin(
+				context.Background(),
+				&types.QueryGroupPoliciesByAdminRequest{
+					Admin:      admin.String(),
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "group policies")
+	return cmd
+}
+
+// GetCmdQueryProposal implements the query proposal command.
+func GetCmdQueryProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposal [proposal-id]",
+		Short: "Query a proposal",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			proposalId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Proposal(
+				context.Background(),
+				&types.QueryProposalRequest{
+					ProposalId: proposalId,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryProposalsByGroupPolicy implements the query proposals-by-group-policy command.
+func GetCmdQueryProposalsByGroupPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposals-by-group-policy [group-policy]",
+		Short: "Query the proposals of a group policy",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ProposalsByGroupPolicy(
+				context.Background(),
+				&types.QueryProposalsByGroupPolicyRequest{
+					Address:    address.String(),
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "proposals")
+	return cmd
+}
+
+// GetCmdQueryVoteByProposalVoter implements the query vote command.
+func GetCmdQueryVoteByProposalVoter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote [proposal-id] [voter]",
+		Short: "Query the vote of a voter on a proposal",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			proposalId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			voter, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VoteByProposalVoter(
+				context.Background(),
+				&types.QueryVoteByProposalVoterRequest{
+					ProposalId: proposalId,
+					Voter:      voter.String(),
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryVotesByProposal implements the query votes-by-proposal command.
+func GetCmdQueryVotesByProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "votes-by-proposal [proposal-id]",
+		Short: "Query the votes on a proposal",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			proposalId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VotesByProposal(
+				context.Background(),
+				&types.QueryVotesByProposalRequest{
+					ProposalId: proposalId,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "votes")
+	return cmd
+}
+
+// GetCmdQueryVotesByVoter implements the query votes-by-voter command.
+func GetCmdQueryVotesByVoter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "votes-by-voter [voter]",
+		Short: "Query the votes of a voter",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			voter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VotesByVoter(
+				context.Background(),
+				&types.QueryVotesByVoterRequest{
+					Voter:      voter.String(),
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "votes")
+	return cmd
+}
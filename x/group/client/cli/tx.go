@@ -0,0 +1,619 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+const FlagThreshold = "threshold"
+const FlagPercentage = "percentage"
+const FlagTimeout = "timeout"
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	groupTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Group transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	groupTxCmd.AddCommand(
+		NewCmdCreateGroup(),
+		NewCmdUpdateGroupMembers(),
+		NewCmdUpdateGroupAdmin(),
+		NewCmdUpdateGroupMetadata(),
+		NewCmdCreateGroupPolicy(),
+		NewCmdUpdateGroupPolicyAdmin(),
+		NewCmdUpdateGroupPolicyDecisionPolicy(),
+		NewCmdUpdateGroupPolicyMetadata(),
+		NewCmdCreateProposal(),
+		NewCmdVote(),
+		NewCmdExec(),
+	)
+
+	return groupTxCmd
+}
+
+func NewCmdCreateGroup() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-group [metadata] [members-json-file] --from [admin]",
+		Short: "Create a group with the given members",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a group administrated by the sender, with the members listed in a JSON file:
+Example:
+ $ %s tx %s create-group "my group" members.json --from cosmos1skj..
+
+Where members.json contains:
+{
+	"members": [
+		{"address": "cosmos1skj..", "weight": "1", "metadata": ""},
+		{"address": "cosmos1sk2..", "weight": "2", "metadata": ""}
+	]
+}
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			members, err := parseMembers(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCreateGroup{
+				Admin:    clientCtx.GetFromAddress().String(),
+				Members:  members,
+				Metadata: []byte(args[0]),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			svcMsgClientConn := &msgservice.ServiceMsgClientConn{}
+			msgClient := types.NewMsgClient(svcMsgClientConn)
+			_, err = msgClient.CreateGroup(context.Background(), msg)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), svcMsgClientConn.GetMsgs()...)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdUpdateGroupMembers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-members [group-id] [members-json-file] --from [admin]",
+		Short: "Update the members of a group",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Add, update or remove members of a group, listed in a JSON file with the same
+format as for create-group. A member with a zero weight is removed from the group:
+Example:
+ $ %s tx %s update-group-members 1 members.json --from cosmos1skj..
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			members, err := parseMembers(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateGroupMembers{
+				Admin:         clientCtx.GetFromAddress().String(),
+				GroupId:       groupID,
+				MemberUpdates: members,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			svcMsgClientConn := &msgservice.ServiceMsgClientConn{}
+			msgClient := types.NewMsgClient(svcMsgClientConn)
+			_, err = msgClient.UpdateGroupMembers(context.Background(), msg)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), svcMsgClientConn.GetMsgs()...)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdUpdateGroupAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-admin [group-id] [new-admin] --from [admin]",
+		Short: "Update the admin of a group",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateGroupAdmin{
+				Admin:    clientCtx.GetFromAddress().String(),
+				GroupId:  groupID,
+				NewAdmin: args[1],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			svcMsgClientConn := &msgservice.ServiceMsgClientConn{}
+			msgClient := types.NewMsgClient(svcMsgClientConn)
+			_, err = msgClient.UpdateGroupAdmin(context.Background(), msg)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), svcMsgClientConn.GetMsgs()...)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdUpdateGroupMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-metadata [group-id] [metadata] --from [admin]",
+		Short: "Update the metadata of a group",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateGroupMetadata{
+				Admin:    clientCtx.GetFromAddress().String(),
+				GroupId:  groupID,
+				Metadata: []byte(args[1]),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			svcMsgClientConn := &msgservice.ServiceMsgClientConn{}
+			msgClient := types.NewMsgClient(svcMsgClientConn)
+			_, err = msgClient.UpdateGroupMetadata(context.Background(), msg)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), svcMsgClientConn.GetMsgs()...)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdCreateGroupPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-group-policy [group-id] [metadata] --from [admin]",
+		Short: "Create a group policy account with a decision policy",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a group policy account for a group, with either a threshold or a
+percentage decision policy:
+Example:
+ $ %s tx %s create-group-policy 1 "my policy" --threshold=2 --timeout=72h --from cosmos1skj..
+ $ %s tx %s create-group-policy 1 "my policy" --percentage=0.5 --timeout=72h --from cosmos1skj..
+`, version.AppName, types.ModuleName, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			policy, err := parseDecisionPolicyFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgCreateGroupPolicy(clientCtx.GetFromAddress(), groupID, []byte(args[1]), policy)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			svcMsgClientConn := &msgservice.ServiceMsgClientConn{}
+			msgClient := types.NewMsgClient(svcMsgClientConn)
+			_, err = msgClient.CreateGroupPolicy(context.Background(), msg)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), svcMsgClientConn.GetMsgs()...)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	addDecisionPolicyFlags(cmd)
+	return cmd
+}
+
+func NewCmdUpdateGroupPolicyAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-policy-admin [group-policy] [new-admin] --from [admin]",
+		Short: "Update the admin of a group policy",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateGroupPolicyAdmin{
+				Admin:    clientCtx.GetFromAddress().String(),
+				Address:  args[0],
+				NewAdmin: args[1],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			svcMsgClientConn := &msgservice.ServiceMsgClientConn{}
+			msgClient := types.NewMsgClient(svcMsgClientConn)
+			_, err = msgClient.UpdateGroupPolicyAdmin(context.Background(), msg)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), svcMsgClientConn.GetMsgs()...)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdUpdateGroupPolicyDecisionPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-policy-decision-policy [group-policy] --from [admin]",
+		Short: "Update the decision policy of a group policy",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Replace the decision policy of a group policy:
+Example:
+ $ %s tx %s update-group-policy-decision-policy cosmos1skj.. --threshold=3 --timeout=24h --from cosmos1sk2..
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			policy, err := parseDecisionPolicyFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgUpdateGroupPolicyDecisionPolicy(clientCtx.GetFromAddress(), address, policy)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			svcMsgClientConn := &msgservice.ServiceMsgClientConn{}
+			msgClient := types.NewMsgClient(svcMsgClientConn)
+			_, err = msgClient.UpdateGroupPolicyDecisionPolicy(context.Background(), msg)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), svcMsgClientConn.GetMsgs()...)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	addDecisionPolicyFlags(cmd)
+	return cmd
+}
+
+func NewCmdUpdateGroupPolicyMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-policy-metadata [group-policy] [metadata] --from [admin]",
+		Short: "Update the metadata of a group policy",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateGroupPolicyMetadata{
+				Admin:    clientCtx.GetFromAddress().String(),
+				Address:  args[0],
+				Metadata: []byte(args[1]),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			svcMsgClientConn := &msgservice.ServiceMsgClientConn{}
+			msgClient := types.NewMsgClient(svcMsgClientConn)
+			_, err = msgClient.UpdateGroupPolicyMetadata(context.Background(), msg)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), svcMsgClientConn.GetMsgs()...)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdCreateProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-proposal [group-policy] [metadata] [msg_tx_json_file] --from [proposer]",
+		Short: "Submit a proposal to execute messages on behalf of a group policy account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal with the messages of a tx generated with the group policy
+account as signer:
+Example:
+ $ %s tx bank send <group-policy> <recipient> 10stake --generate-only > tx.json && %s tx %s create-proposal <group-policy> "my proposal" tx.json --from cosmos1skj..
+`, version.AppName, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			theTx, err := authclient.ReadTxFromFile(clientCtx, args[2])
+			if err != nil {
+				return err
+			}
+			msgs := theTx.GetMsgs()
+			serviceMsgs := make([]sdk.ServiceMsg, len(msgs))
+			for i, msg := range msgs {
+				srvMsg, ok := msg.(sdk.ServiceMsg)
+				if !ok {
+					return fmt.Errorf("tx contains %T which is not a sdk.ServiceMsg", msg)
+				}
+				serviceMsgs[i] = srvMsg
+			}
+
+			proposers := []string{clientCtx.GetFromAddress().String()}
+			msg, err := types.NewMsgCreateProposal(address, proposers, serviceMsgs, []byte(args[1]))
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			svcMsgClientConn := &msgservice.ServiceMsgClientConn{}
+			msgClient := types.NewMsgClient(svcMsgClientConn)
+			_, err = msgClient.CreateProposal(context.Background(), msg)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), svcMsgClientConn.GetMsgs()...)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote [proposal-id] [choice] [metadata] --from [voter]",
+		Short: "Vote on a proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Vote on a proposal as a group member. The choice is one of yes, no, abstain
+and no_with_veto:
+Example:
+ $ %s tx %s vote 1 yes "" --from cosmos1skj..
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			choice, err := types.VoteOptionFromString(normalizeVoteOption(args[1]))
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgVote{
+				ProposalId: proposalID,
+				Voter:      clientCtx.GetFromAddress().String(),
+				Choice:     choice,
+				Metadata:   []byte(args[2]),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			svcMsgClientConn := &msgservice.ServiceMsgClientConn{}
+			msgClient := types.NewMsgClient(svcMsgClientConn)
+			_, err = msgClient.Vote(context.Background(), msg)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), svcMsgClientConn.GetMsgs()...)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdExec() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec [proposal-id] --from [signer]",
+		Short: "Execute the messages of an accepted proposal",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgExec{
+				ProposalId: proposalID,
+				Signer:     clientCtx.GetFromAddress().String(),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			svcMsgClientConn := &msgservice.ServiceMsgClientConn{}
+			msgClient := types.NewMsgClient(svcMsgClientConn)
+			_, err = msgClient.Exec(context.Background(), msg)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), svcMsgClientConn.GetMsgs()...)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func addDecisionPolicyFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagThreshold, "", "Weight of yes votes needed to accept a proposal, for a threshold decision policy")
+	cmd.Flags().String(FlagPercentage, "", "Share of the total weight voting yes needed to accept a proposal, for a percentage decision policy")
+	cmd.Flags().Duration(FlagTimeout, 24*time.Hour, "Voting period of the proposals")
+}
+
+func parseDecisionPolicyFlags(fs *pflag.FlagSet) (types.DecisionPolicy, error) {
+	threshold, err := fs.GetString(FlagThreshold)
+	if err != nil {
+		return nil, err
+	}
+	percentage, err := fs.GetString(FlagPercentage)
+	if err != nil {
+		return nil, err
+	}
+	timeout, err := fs.GetDuration(FlagTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case threshold != "" && percentage != "":
+		return nil, fmt.Errorf("only one of --%s and --%s can be set", FlagThreshold, FlagPercentage)
+	case threshold != "":
+		return types.NewThresholdDecisionPolicy(threshold, timeout), nil
+	case percentage != "":
+		return types.NewPercentageDecisionPolicy(percentage, timeout), nil
+	default:
+		return nil, fmt.Errorf("one of --%s and --%s must be set", FlagThreshold, FlagPercentage)
+	}
+}
+
+// members is the format of the members JSON file.
+type members struct {
+	Members []types.Member `json:"members"`
+}
+
+func parseMembers(membersFile string) ([]types.Member, error) {
+	contents, err := ioutil.ReadFile(membersFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var m members
+	if err := json.Unmarshal(contents, &m); err != nil {
+		return nil, err
+	}
+	if len(m.Members) == 0 {
+		return nil, errors.New("no members in the members file")
+	}
+	return m.Members, nil
+}
+
+// normalizeVoteOption maps the short vote options accepted by the CLI to the
+// names of the VoteOption enum.
+func normalizeVoteOption(option string) string {
+	if strings.HasPrefix(option, "VOTE_OPTION_") {
+		return option
+	}
+	return "VOTE_OPTION_" + strings.ToUpper(option)
+}
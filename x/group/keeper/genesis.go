@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// InitGenesis initializes the group module's state from a given genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
+	store := ctx.KVStore(k.storeKey)
+
+	k.setSeq(ctx, types.GroupSeqKey, data.GroupSeq)
+	for _, info := range data.Groups {
+		k.setGroupInfo(ctx, *info)
+		store.Set(types.GetGroupByAdminKey(mustAccAddressFromBech32(info.Admin), info.GroupId), []byte{})
+	}
+	for _, member := range data.GroupMembers {
+		k.setGroupMember(ctx, *member)
+	}
+
+	k.setSeq(ctx, types.GroupPolicySeqKey, data.GroupPolicySeq)
+	for _, info := range data.GroupPolicies {
+		k.setGroupPolicyInfo(ctx, *info)
+		addr := mustAccAddressFromBech32(info.Address)
+		store.Set(types.GetPolicyByGroupKey(info.GroupId, addr), []byte{})
+		store.Set(types.GetPolicyByAdminKey(mustAccAddressFromBech32(info.Admin), addr), []byte{})
+	}
+
+	k.setSeq(ctx, types.ProposalSeqKey, data.ProposalSeq)
+	for _, proposal := range data.Proposals {
+		k.setProposal(ctx, *proposal)
+		store.Set(types.GetProposalByPolicyKey(mustAccAddressFromBech32(proposal.Address), proposal.ProposalId), []byte{})
+	}
+	for _, vote := range data.Votes {
+		k.setVote(ctx, *vote)
+	}
+}
+
+// ExportGenesis returns the group module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	genesis := &types.GenesisState{
+		GroupSeq:       k.getSeq(ctx, types.GroupSeqKey),
+		GroupPolicySeq: k.getSeq(ctx, types.GroupPolicySeqKey),
+		ProposalSeq:    k.getSeq(ctx, types.ProposalSeqKey),
+	}
+
+	k.IterateGroupInfos(ctx, func(info types.GroupInfo) bool {
+		genesis.Groups = append(genesis.Groups, &info)
+		return false
+	})
+	k.IterateGroupMembers(ctx, func(member types.GroupMember) bool {
+		genesis.GroupMembers = append(genesis.GroupMembers, &member)
+		return false
+	})
+	k.IterateGroupPolicyInfos(ctx, func(info types.GroupPolicyInfo) bool {
+		genesis.GroupPolicies = append(genesis.GroupPolicies, &info)
+		return false
+	})
+	k.IterateProposals(ctx, func(proposal types.Proposal) bool {
+		genesis.Proposals = append(genesis.Proposals, &proposal)
+		return false
+	})
+	k.IterateVotes(ctx, func(vote types.Vote) bool {
+		genesis.Votes = append(genesis.Votes, &vote)
+		return false
+	})
+
+	return genesis
+}
+
+func mustAccAddressFromBech32(address string) sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
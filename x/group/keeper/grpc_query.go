@@ -0,0 +1,282 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// GroupInfo implements the Query/GroupInfo gRPC method.
+func (k Keeper) GroupInfo(c context.Context, req *types.QueryGroupInfoRequest) (*types.QueryGroupInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	info, err := k.GetGroupInfo(ctx, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGroupInfoResponse{Info: &info}, nil
+}
+
+// GroupPolicyInfo implements the Query/GroupPolicyInfo gRPC method.
+func (k Keeper) GroupPolicyInfo(c context.Context, req *types.QueryGroupPolicyInfoRequest) (*types.QueryGroupPolicyInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	info, err := k.GetGroupPolicyInfo(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGroupPolicyInfoResponse{Info: &info}, nil
+}
+
+// GroupMembers implements the Query/GroupMembers gRPC method.
+func (k Keeper) GroupMembers(c context.Context, req *types.QueryGroupMembersRequest) (*types.QueryGroupMembersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetGroupMembersPrefix(req.GroupId))
+
+	var members []*types.GroupMember
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var member types.GroupMember
+		if err := k.cdc.UnmarshalBinaryBare(value, &member); err != nil {
+			return err
+		}
+		members = append(members, &member)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGroupMembersResponse{Members: members, Pagination: pageRes}, nil
+}
+
+// GroupsByAdmin implements the Query/GroupsByAdmin gRPC method.
+func (k Keeper) GroupsByAdmin(c context.Context, req *types.QueryGroupsByAdminRequest) (*types.QueryGroupsByAdminResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	admin, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetGroupsByAdminPrefix(admin))
+
+	var groups []*types.GroupInfo
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		info, err := k.GetGroupInfo(ctx, types.GetIDFromBytes(key))
+		if err != nil {
+			return err
+		}
+		groups = append(groups, &info)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGroupsByAdminResponse{Groups: groups, Pagination: pageRes}, nil
+}
+
+// GroupPoliciesByGroup implements the Query/GroupPoliciesByGroup gRPC method.
+func (k Keeper) GroupPoliciesByGroup(c context.Context, req *types.QueryGroupPoliciesByGroupRequest) (*types.QueryGroupPoliciesByGroupResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetPoliciesByGroupPrefix(req.GroupId))
+	policies, pageRes, err := k.paginateGroupPolicies(ctx, store, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGroupPoliciesByGroupResponse{GroupPolicies: policies, Pagination: pageRes}, nil
+}
+
+// GroupPoliciesByAdmin implements the Query/GroupPoliciesByAdmin gRPC method.
+func (k Keeper) GroupPoliciesByAdmin(c context.Context, req *types.QueryGroupPoliciesByAdminRequest) (*types.QueryGroupPoliciesByAdminResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	admin, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetPoliciesByAdminPrefix(admin))
+	policies, pageRes, err := k.paginateGroupPolicies(ctx, store, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGroupPoliciesByAdminResponse{GroupPolicies: policies, Pagination: pageRes}, nil
+}
+
+// Proposal implements the Query/Proposal gRPC method.
+func (k Keeper) Proposal(c context.Context, req *types.QueryProposalRequest) (*types.QueryProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	proposal, err := k.GetProposal(ctx, req.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryProposalResponse{Proposal: &proposal}, nil
+}
+
+// ProposalsByGroupPolicy implements the Query/ProposalsByGroupPolicy gRPC
+// method.
+func (k Keeper) ProposalsByGroupPolicy(c context.Context, req *types.QueryProposalsByGroupPolicyRequest) (*types.QueryProposalsByGroupPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetProposalsByPolicyPrefix(addr))
+
+	var proposals []*types.Proposal
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		proposal, err := k.GetProposal(ctx, types.GetIDFromBytes(key))
+		if err != nil {
+			return err
+		}
+		proposals = append(proposals, &proposal)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryProposalsByGroupPolicyResponse{Proposals: proposals, Pagination: pageRes}, nil
+}
+
+// VoteByProposalVoter implements the Query/VoteByProposalVoter gRPC method.
+func (k Keeper) VoteByProposalVoter(c context.Context, req *types.QueryVoteByProposalVoterRequest) (*types.QueryVoteByProposalVoterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	voter, err := sdk.AccAddressFromBech32(req.Voter)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	vote, err := k.GetVote(ctx, req.ProposalId, voter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryVoteByProposalVoterResponse{Vote: &vote}, nil
+}
+
+// VotesByProposal implements the Query/VotesByProposal gRPC method.
+func (k Keeper) VotesByProposal(c context.Context, req *types.QueryVotesByProposalRequest) (*types.QueryVotesByProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetVotesByProposalPrefix(req.ProposalId))
+
+	var votes []*types.Vote
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var vote types.Vote
+		if err := k.cdc.UnmarshalBinaryBare(value, &vote); err != nil {
+			return err
+		}
+		votes = append(votes, &vote)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVotesByProposalResponse{Votes: votes, Pagination: pageRes}, nil
+}
+
+// VotesByVoter implements the Query/VotesByVoter gRPC method.
+func (k Keeper) VotesByVoter(c context.Context, req *types.QueryVotesByVoterRequest) (*types.QueryVotesByVoterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	voter, err := sdk.AccAddressFromBech32(req.Voter)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetVotesByVoterPrefix(voter))
+
+	var votes []*types.Vote
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		vote, err := k.GetVote(ctx, types.GetIDFromBytes(key), voter)
+		if err != nil {
+			return err
+		}
+		votes = append(votes, &vote)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVotesByVoterResponse{Votes: votes, Pagination: pageRes}, nil
+}
+
+// paginateGroupPolicies paginates over an index store whose keys are
+// length-prefixed group policy addresses.
+func (k Keeper) paginateGroupPolicies(ctx sdk.Context, store prefix.Store, pageReq *query.PageRequest) ([]*types.GroupPolicyInfo, *query.PageResponse, error) {
+	var policies []*types.GroupPolicyInfo
+	pageRes, err := query.Paginate(store, pageReq, func(key []byte, _ []byte) error {
+		addr, _ := types.SplitLengthPrefixedAddress(key)
+		info, err := k.GetGroupPolicyInfo(ctx, addr)
+		if err != nil {
+			return err
+		}
+		policies = append(policies, &info)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+	return policies, pageRes, nil
+}
@@ -0,0 +1,283 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+type Keeper struct {
+	storeKey      sdk.StoreKey
+	cdc           codec.BinaryMarshaler
+	router        *baseapp.MsgServiceRouter
+	accountKeeper types.AccountKeeper
+}
+
+// NewKeeper constructs a group Keeper
+func NewKeeper(storeKey sdk.StoreKey, cdc codec.BinaryMarshaler, router *baseapp.MsgServiceRouter, ak types.AccountKeeper) Keeper {
+	return Keeper{
+		storeKey:      storeKey,
+		cdc:           cdc,
+		router:        router,
+		accountKeeper: ak,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// getSeq returns the current value of the sequence stored under key.
+func (k Keeper) getSeq(ctx sdk.Context, key []byte) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return 0
+	}
+	return types.GetIDFromBytes(bz)
+}
+
+func (k Keeper) setSeq(ctx sdk.Context, key []byte, seq uint64) {
+	ctx.KVStore(k.storeKey).Set(key, types.GetIDBytes(seq))
+}
+
+// nextSeq increments the sequence stored under key and returns its new value.
+func (k Keeper) nextSeq(ctx sdk.Context, key []byte) uint64 {
+	seq := k.getSeq(ctx, key) + 1
+	k.setSeq(ctx, key, seq)
+	return seq
+}
+
+// GetGroupInfo returns the info of the group with the given ID.
+func (k Keeper) GetGroupInfo(ctx sdk.Context, groupID uint64) (types.GroupInfo, error) {
+	var info types.GroupInfo
+	bz := ctx.KVStore(k.storeKey).Get(types.GetGroupInfoKey(groupID))
+	if bz == nil {
+		return info, sdkerrors.Wrapf(types.ErrGroupNotFound, "group id %d", groupID)
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &info)
+	return info, nil
+}
+
+func (k Keeper) setGroupInfo(ctx sdk.Context, info types.GroupInfo) {
+	ctx.KVStore(k.storeKey).Set(types.GetGroupInfoKey(info.GroupId), k.cdc.MustMarshalBinaryBare(&info))
+}
+
+// IterateGroupInfos iterates over all the groups.
+func (k Keeper) IterateGroupInfos(ctx sdk.Context, cb func(info types.GroupInfo) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GroupInfoPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var info types.GroupInfo
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &info)
+		if cb(info) {
+			break
+		}
+	}
+}
+
+// GetGroupMember returns the member of a group with the given address.
+func (k Keeper) GetGroupMember(ctx sdk.Context, groupID uint64, member sdk.AccAddress) (types.GroupMember, error) {
+	var groupMember types.GroupMember
+	bz := ctx.KVStore(k.storeKey).Get(types.GetGroupMemberKey(groupID, member))
+	if bz == nil {
+		return groupMember, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "%s is not a member of group %d", member, groupID)
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &groupMember)
+	return groupMember, nil
+}
+
+func (k Keeper) setGroupMember(ctx sdk.Context, groupMember types.GroupMember) {
+	addr, err := sdk.AccAddressFromBech32(groupMember.Member.Address)
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetGroupMemberKey(groupMember.GroupId, addr), k.cdc.MustMarshalBinaryBare(&groupMember))
+}
+
+// IterateGroupMembers iterates over the members of all the groups.
+func (k Keeper) IterateGroupMembers(ctx sdk.Context, cb func(groupMember types.GroupMember) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GroupMemberPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var groupMember types.GroupMember
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &groupMember)
+		if cb(groupMember) {
+			break
+		}
+	}
+}
+
+// GetGroupPolicyInfo returns the info of the group policy with the given
+// account address.
+func (k Keeper) GetGroupPolicyInfo(ctx sdk.Context, address sdk.AccAddress) (types.GroupPolicyInfo, error) {
+	var info types.GroupPolicyInfo
+	bz := ctx.KVStore(k.storeKey).Get(types.GetGroupPolicyKey(address))
+	if bz == nil {
+		return info, sdkerrors.Wrapf(types.ErrPolicyNotFound, "group policy %s", address)
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &info)
+	return info, nil
+}
+
+func (k Keeper) setGroupPolicyInfo(ctx sdk.Context, info types.GroupPolicyInfo) {
+	addr, err := sdk.AccAddressFromBech32(info.Address)
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetGroupPolicyKey(addr), k.cdc.MustMarshalBinaryBare(&info))
+}
+
+// IterateGroupPolicyInfos iterates over all the group policies.
+func (k Keeper) IterateGroupPolicyInfos(ctx sdk.Context, cb func(info types.GroupPolicyInfo) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GroupPolicyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var info types.GroupPolicyInfo
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &info)
+		if cb(info) {
+			break
+		}
+	}
+}
+
+// GetProposal returns the proposal with the given ID.
+func (k Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (types.Proposal, error) {
+	var proposal types.Proposal
+	bz := ctx.KVStore(k.storeKey).Get(types.GetProposalKey(proposalID))
+	if bz == nil {
+		return proposal, sdkerrors.Wrapf(types.ErrProposalNotFound, "proposal id %d", proposalID)
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &proposal)
+	return proposal, nil
+}
+
+func (k Keeper) setProposal(ctx sdk.Context, proposal types.Proposal) {
+	ctx.KVStore(k.storeKey).Set(types.GetProposalKey(proposal.ProposalId), k.cdc.MustMarshalBinaryBare(&proposal))
+}
+
+// IterateProposals iterates over all the proposals.
+func (k Keeper) IterateProposals(ctx sdk.Context, cb func(proposal types.Proposal) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ProposalPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var proposal types.Proposal
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &proposal)
+		if cb(proposal) {
+			break
+		}
+	}
+}
+
+// GetVote returns the vote of a voter on a proposal.
+func (k Keeper) GetVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress) (types.Vote, error) {
+	var vote types.Vote
+	bz := ctx.KVStore(k.storeKey).Get(types.GetVoteKey(proposalID, voter))
+	if bz == nil {
+		return vote, sdkerrors.Wrapf(types.ErrVoteNotFound, "proposal id %d, voter %s", proposalID, voter)
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &vote)
+	return vote, nil
+}
+
+func (k Keeper) setVote(ctx sdk.Context, vote types.Vote) {
+	voter, err := sdk.AccAddressFromBech32(vote.Voter)
+	if err != nil {
+		panic(err)
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetVoteKey(vote.ProposalId, voter), k.cdc.MustMarshalBinaryBare(&vote))
+	store.Set(types.GetVoteByVoterKey(voter, vote.ProposalId), []byte{})
+}
+
+// IterateVotes iterates over all the votes.
+func (k Keeper) IterateVotes(ctx sdk.Context, cb func(vote types.Vote) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.VotePrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var vote types.Vote
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &vote)
+		if cb(vote) {
+			break
+		}
+	}
+}
+
+// createGroupPolicyAccount creates the account of a new group policy. The
+// address is derived from the group policy sequence, skipping the addresses
+// which already have an account.
+func (k Keeper) createGroupPolicyAccount(ctx sdk.Context) sdk.AccAddress {
+	for {
+		addr := types.GetGroupPolicyAddress(k.nextSeq(ctx, types.GroupPolicySeqKey))
+		if k.accountKeeper.GetAccount(ctx, addr) != nil {
+			continue
+		}
+		acc := k.accountKeeper.NewAccount(ctx, authtypes.NewBaseAccountWithAddress(addr))
+		k.accountKeeper.SetAccount(ctx, acc)
+		return addr
+	}
+}
+
+// tally updates the status and the result of a submitted proposal according
+// to the decision policy of its group policy. The proposal is closed once the
+// result of the decision policy is final.
+func (k Keeper) tally(ctx sdk.Context, proposal *types.Proposal, group types.GroupInfo, policyInfo types.GroupPolicyInfo) error {
+	policy := policyInfo.GetDecisionPolicy()
+	if policy == nil {
+		return sdkerrors.Wrap(types.ErrEmpty, "decision policy")
+	}
+
+	result, err := policy.Allow(proposal.VoteState, group.TotalWeight, ctx.BlockTime().Sub(proposal.SubmittedAt))
+	if err != nil {
+		return err
+	}
+	if !result.Final {
+		return nil
+	}
+
+	proposal.Status = types.PROPOSAL_STATUS_CLOSED
+	if result.Allow {
+		proposal.Result = types.PROPOSAL_RESULT_ACCEPTED
+	} else {
+		proposal.Result = types.PROPOSAL_RESULT_REJECTED
+	}
+	return nil
+}
+
+// execMsgs executes the messages of an accepted proposal on behalf of its
+// group policy account.
+func (k Keeper) execMsgs(ctx sdk.Context, policy sdk.AccAddress, proposal types.Proposal) error {
+	msgs, err := proposal.GetServiceMsgs()
+	if err != nil {
+		return err
+	}
+
+	for i, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(policy) {
+			return sdkerrors.Wrapf(types.ErrUnauthorized, "msg %d must be signed by the group policy account only", i)
+		}
+
+		handler := k.router.Handler(msg.Route())
+		if handler == nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", msg.Route())
+		}
+
+		res, err := handler(ctx, msg.Request)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "failed to execute message; message %s", msg.MethodName)
+		}
+
+		// the handler runs with a new EventManager and returns its events
+		ctx.EventManager().EmitEvents(res.GetEvents())
+	}
+
+	return nil
+}
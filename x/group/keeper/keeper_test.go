@@ -0,0 +1,381 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+type TestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	queryClient types.QueryClient
+}
+
+func (s *TestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	ctx = ctx.WithBlockHeader(tmproto.Header{Time: tmtime.Now()})
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.GroupKeeper)
+
+	s.app = app
+	s.ctx = ctx
+	s.queryClient = types.NewQueryClient(queryHelper)
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 4, sdk.NewInt(30000000))
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+// createGroupAndPolicy creates a group with the first three test addresses as
+// members of weights 1, 2 and 3, and a group policy funded with 1000stake.
+func (s *TestSuite) createGroupAndPolicy(policy types.DecisionPolicy) (uint64, sdk.AccAddress) {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	groupRes, err := app.GroupKeeper.CreateGroup(goCtx, &types.MsgCreateGroup{
+		Admin: addrs[0].String(),
+		Members: []types.Member{
+			{Address: addrs[0].String(), Weight: "1"},
+			{Address: addrs[1].String(), Weight: "2"},
+			{Address: addrs[2].String(), Weight: "3"},
+		},
+		Metadata: []byte("group"),
+	})
+	s.Require().NoError(err)
+
+	msg, err := types.NewMsgCreateGroupPolicy(addrs[0], groupRes.GroupId, []byte("policy"), policy)
+	s.Require().NoError(err)
+	policyRes, err := app.GroupKeeper.CreateGroupPolicy(goCtx, msg)
+	s.Require().NoError(err)
+
+	policyAddr, err := sdk.AccAddressFromBech32(policyRes.Address)
+	s.Require().NoError(err)
+	s.Require().NoError(simapp.FundAccount(app, ctx, policyAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))))
+
+	return groupRes.GroupId, policyAddr
+}
+
+// createSendProposal submits a proposal sending 100stake from the group policy
+// account to the last test address.
+func (s *TestSuite) createSendProposal(policyAddr sdk.AccAddress) uint64 {
+	msgs := []sdk.ServiceMsg{{
+		MethodName: "/cosmos.bank.v1beta1.Msg/Send",
+		Request:    banktypes.NewMsgSend(policyAddr, s.addrs[3], sdk.NewCoins(sdk.NewInt64Coin("stake", 100))),
+	}}
+	msg, err := types.NewMsgCreateProposal(policyAddr, []string{s.addrs[0].String()}, msgs, nil)
+	s.Require().NoError(err)
+
+	res, err := s.app.GroupKeeper.CreateProposal(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+	return res.ProposalId
+}
+
+func (s *TestSuite) vote(proposalID uint64, voter sdk.AccAddress, choice types.VoteOption) error {
+	_, err := s.app.GroupKeeper.Vote(sdk.WrapSDKContext(s.ctx), &types.MsgVote{
+		ProposalId: proposalID,
+		Voter:      voter.String(),
+		Choice:     choice,
+	})
+	return err
+}
+
+// countEvents returns the number of events of the given type.
+func countEvents(events sdk.Events, eventType string) int {
+	n := 0
+	for _, event := range events {
+		if event.Type == eventType {
+			n++
+		}
+	}
+	return n
+}
+
+func (s *TestSuite) exec(proposalID uint64) {
+	_, err := s.app.GroupKeeper.Exec(sdk.WrapSDKContext(s.ctx), &types.MsgExec{
+		ProposalId: proposalID,
+		Signer:     s.addrs[0].String(),
+	})
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestCreateGroup() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	groupID, policyAddr := s.createGroupAndPolicy(types.NewThresholdDecisionPolicy("4", time.Hour))
+
+	info, err := app.GroupKeeper.GetGroupInfo(ctx, groupID)
+	s.Require().NoError(err)
+	s.Require().Equal(addrs[0].String(), info.Admin)
+	s.Require().Equal("6", info.TotalWeight)
+	s.Require().Equal(uint64(1), info.Version)
+
+	membersRes, err := s.queryClient.GroupMembers(ctx.Context(), &types.QueryGroupMembersRequest{GroupId: groupID})
+	s.Require().NoError(err)
+	s.Require().Len(membersRes.Members, 3)
+
+	groupsRes, err := s.queryClient.GroupsByAdmin(ctx.Context(), &types.QueryGroupsByAdminRequest{Admin: addrs[0].String()})
+	s.Require().NoError(err)
+	s.Require().Len(groupsRes.Groups, 1)
+	s.Require().Equal(groupID, groupsRes.Groups[0].GroupId)
+
+	s.Require().NotNil(app.AccountKeeper.GetAccount(ctx, policyAddr))
+	policyRes, err := s.queryClient.GroupPolicyInfo(ctx.Context(), &types.QueryGroupPolicyInfoRequest{Address: policyAddr.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(groupID, policyRes.Info.GroupId)
+	s.Require().Equal(types.NewThresholdDecisionPolicy("4", time.Hour), policyRes.Info.GetDecisionPolicy())
+
+	policiesRes, err := s.queryClient.GroupPoliciesByGroup(ctx.Context(), &types.QueryGroupPoliciesByGroupRequest{GroupId: groupID})
+	s.Require().NoError(err)
+	s.Require().Len(policiesRes.GroupPolicies, 1)
+	policiesRes2, err := s.queryClient.GroupPoliciesByAdmin(ctx.Context(), &types.QueryGroupPoliciesByAdminRequest{Admin: addrs[0].String()})
+	s.Require().NoError(err)
+	s.Require().Equal(policiesRes.GroupPolicies, policiesRes2.GroupPolicies)
+
+	// only the group admin can create a group policy
+	msg, err := types.NewMsgCreateGroupPolicy(addrs[1], groupID, nil, types.NewThresholdDecisionPolicy("1", time.Hour))
+	s.Require().NoError(err)
+	_, err = app.GroupKeeper.CreateGroupPolicy(sdk.WrapSDKContext(ctx), msg)
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+}
+
+func (s *TestSuite) TestUpdateGroupMembers() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	goCtx := sdk.WrapSDKContext(ctx)
+	groupID, _ := s.createGroupAndPolicy(types.NewThresholdDecisionPolicy("4", time.Hour))
+
+	_, err := app.GroupKeeper.UpdateGroupMembers(goCtx, &types.MsgUpdateGroupMembers{
+		Admin:   addrs[0].String(),
+		GroupId: groupID,
+		MemberUpdates: []types.Member{
+			{Address: addrs[1].String(), Weight: "0"},
+			{Address: addrs[2].String(), Weight: "1.5"},
+			{Address: addrs[3].String(), Weight: "4"},
+		},
+	})
+	s.Require().NoError(err)
+
+	info, err := app.GroupKeeper.GetGroupInfo(ctx, groupID)
+	s.Require().NoError(err)
+	s.Require().Equal("6.5", info.TotalWeight)
+	s.Require().Equal(uint64(2), info.Version)
+
+	_, err = app.GroupKeeper.GetGroupMember(ctx, groupID, addrs[1])
+	s.Require().Error(err)
+	member, err := app.GroupKeeper.GetGroupMember(ctx, groupID, addrs[2])
+	s.Require().NoError(err)
+	s.Require().Equal("1.5", member.Member.Weight)
+
+	// removing a non member fails
+	_, err = app.GroupKeeper.UpdateGroupMembers(goCtx, &types.MsgUpdateGroupMembers{
+		Admin:         addrs[0].String(),
+		GroupId:       groupID,
+		MemberUpdates: []types.Member{{Address: addrs[1].String(), Weight: "0"}},
+	})
+	s.Require().Error(err)
+
+	// only the group admin can update the group
+	_, err = app.GroupKeeper.UpdateGroupMetadata(goCtx, &types.MsgUpdateGroupMetadata{
+		Admin:   addrs[1].String(),
+		GroupId: groupID,
+	})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = app.GroupKeeper.UpdateGroupAdmin(goCtx, &types.MsgUpdateGroupAdmin{
+		Admin:    addrs[0].String(),
+		GroupId:  groupID,
+		NewAdmin: addrs[1].String(),
+	})
+	s.Require().NoError(err)
+	groupsRes, err := s.queryClient.GroupsByAdmin(ctx.Context(), &types.QueryGroupsByAdminRequest{Admin: addrs[0].String()})
+	s.Require().NoError(err)
+	s.Require().Empty(groupsRes.Groups)
+	groupsRes, err = s.queryClient.GroupsByAdmin(ctx.Context(), &types.QueryGroupsByAdminRequest{Admin: addrs[1].String()})
+	s.Require().NoError(err)
+	s.Require().Len(groupsRes.Groups, 1)
+}
+
+func (s *TestSuite) TestAcceptedProposal() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	_, policyAddr := s.createGroupAndPolicy(types.NewThresholdDecisionPolicy("4", time.Hour))
+	proposalID := s.createSendProposal(policyAddr)
+
+	// proposers must be group members
+	msgs := []sdk.ServiceMsg{{
+		MethodName: "/cosmos.bank.v1beta1.Msg/Send",
+		Request:    banktypes.NewMsgSend(policyAddr, addrs[3], sdk.NewCoins(sdk.NewInt64Coin("stake", 100))),
+	}}
+	msg, err := types.NewMsgCreateProposal(policyAddr, []string{addrs[3].String()}, msgs, nil)
+	s.Require().NoError(err)
+	_, err = app.GroupKeeper.CreateProposal(sdk.WrapSDKContext(ctx), msg)
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	s.Require().NoError(s.vote(proposalID, addrs[0], types.VOTE_OPTION_YES))
+	s.Require().ErrorIs(s.vote(proposalID, addrs[0], types.VOTE_OPTION_NO), types.ErrDuplicate)
+	s.Require().ErrorIs(s.vote(proposalID, addrs[3], types.VOTE_OPTION_YES), types.ErrUnauthorized)
+
+	proposal, err := app.GroupKeeper.GetProposal(ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.PROPOSAL_STATUS_SUBMITTED, proposal.Status)
+
+	s.Require().NoError(s.vote(proposalID, addrs[2], types.VOTE_OPTION_YES))
+	proposal, err = app.GroupKeeper.GetProposal(ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.PROPOSAL_STATUS_CLOSED, proposal.Status)
+	s.Require().Equal(types.PROPOSAL_RESULT_ACCEPTED, proposal.Result)
+	s.Require().Equal("4", proposal.VoteState.YesCount)
+
+	// the proposal is closed, no more votes are accepted
+	s.Require().ErrorIs(s.vote(proposalID, addrs[1], types.VOTE_OPTION_NO), types.ErrInvalid)
+
+	em := sdk.NewEventManager()
+	s.ctx = ctx.WithEventManager(em)
+	s.exec(proposalID)
+	proposal, err = app.GroupKeeper.GetProposal(ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.PROPOSAL_EXECUTOR_RESULT_SUCCESS, proposal.ExecutorResult)
+	s.Require().Equal(sdk.NewInt(900), app.BankKeeper.GetBalance(ctx, policyAddr, "stake").Amount)
+
+	// the events of the executed messages are emitted along with the exec event
+	s.Require().Equal(1, countEvents(em.Events(), banktypes.EventTypeTransfer))
+	s.Require().Equal(1, countEvents(em.Events(), types.EventTypeExec))
+
+	// executing again is a no-op
+	s.exec(proposalID)
+	s.Require().Equal(sdk.NewInt(900), app.BankKeeper.GetBalance(ctx, policyAddr, "stake").Amount)
+
+	votesRes, err := s.queryClient.VotesByProposal(ctx.Context(), &types.QueryVotesByProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Len(votesRes.Votes, 2)
+	voterVotesRes, err := s.queryClient.VotesByVoter(ctx.Context(), &types.QueryVotesByVoterRequest{Voter: addrs[2].String()})
+	s.Require().NoError(err)
+	s.Require().Len(voterVotesRes.Votes, 1)
+	voteRes, err := s.queryClient.VoteByProposalVoter(ctx.Context(), &types.QueryVoteByProposalVoterRequest{ProposalId: proposalID, Voter: addrs[0].String()})
+	s.Require().NoError(err)
+	s.Require().Equal(types.VOTE_OPTION_YES, voteRes.Vote.Choice)
+	proposalsRes, err := s.queryClient.ProposalsByGroupPolicy(ctx.Context(), &types.QueryProposalsByGroupPolicyRequest{Address: policyAddr.String()})
+	s.Require().NoError(err)
+	s.Require().Len(proposalsRes.Proposals, 1)
+	msgsRes, err := proposalsRes.Proposals[0].GetServiceMsgs()
+	s.Require().NoError(err)
+	s.Require().Equal("/cosmos.bank.v1beta1.Msg/Send", msgsRes[0].MethodName)
+}
+
+func (s *TestSuite) TestFailedExecution() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	_, policyAddr := s.createGroupAndPolicy(types.NewPercentageDecisionPolicy("0.5", time.Hour))
+	proposalID := s.createSendProposal(policyAddr)
+
+	// drain the group policy account so that the proposal can't be executed
+	s.Require().NoError(app.BankKeeper.SendCoins(ctx, policyAddr, addrs[3], sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))))
+
+	s.Require().NoError(s.vote(proposalID, addrs[2], types.VOTE_OPTION_YES))
+	em := sdk.NewEventManager()
+	s.ctx = ctx.WithEventManager(em)
+	s.exec(proposalID)
+	proposal, err := app.GroupKeeper.GetProposal(ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.PROPOSAL_RESULT_ACCEPTED, proposal.Result)
+	s.Require().Equal(types.PROPOSAL_EXECUTOR_RESULT_FAILURE, proposal.ExecutorResult)
+
+	// the events of the failed execution are dropped with its state changes
+	s.Require().Zero(countEvents(em.Events(), banktypes.EventTypeTransfer))
+	s.Require().Equal(1, countEvents(em.Events(), types.EventTypeExec))
+
+	// the execution can be retried once the account is funded
+	s.Require().NoError(simapp.FundAccount(app, ctx, policyAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+	s.exec(proposalID)
+	proposal, err = app.GroupKeeper.GetProposal(ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.PROPOSAL_EXECUTOR_RESULT_SUCCESS, proposal.ExecutorResult)
+	s.Require().True(app.BankKeeper.GetBalance(ctx, policyAddr, "stake").IsZero())
+}
+
+func (s *TestSuite) TestRejectedProposal() {
+	app, addrs := s.app, s.addrs
+	_, policyAddr := s.createGroupAndPolicy(types.NewThresholdDecisionPolicy("4", time.Hour))
+
+	// a threshold that can no longer be reached rejects the proposal
+	proposalID := s.createSendProposal(policyAddr)
+	s.Require().NoError(s.vote(proposalID, addrs[2], types.VOTE_OPTION_NO))
+	proposal, err := app.GroupKeeper.GetProposal(s.ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.PROPOSAL_STATUS_CLOSED, proposal.Status)
+	s.Require().Equal(types.PROPOSAL_RESULT_REJECTED, proposal.Result)
+
+	// a proposal without enough votes is rejected after its timeout
+	proposalID = s.createSendProposal(policyAddr)
+	s.Require().NoError(s.vote(proposalID, addrs[0], types.VOTE_OPTION_YES))
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(time.Hour))
+	s.Require().ErrorIs(s.vote(proposalID, addrs[2], types.VOTE_OPTION_YES), types.ErrExpired)
+	s.exec(proposalID)
+	proposal, err = app.GroupKeeper.GetProposal(s.ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.PROPOSAL_STATUS_CLOSED, proposal.Status)
+	s.Require().Equal(types.PROPOSAL_RESULT_REJECTED, proposal.Result)
+	s.Require().Equal(types.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, proposal.ExecutorResult)
+	s.Require().Equal(sdk.NewInt(1000), app.BankKeeper.GetBalance(s.ctx, policyAddr, "stake").Amount)
+}
+
+func (s *TestSuite) TestModifiedGroupPolicy() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	_, policyAddr := s.createGroupAndPolicy(types.NewThresholdDecisionPolicy("4", time.Hour))
+	proposalID := s.createSendProposal(policyAddr)
+
+	_, err := app.GroupKeeper.UpdateGroupPolicyMetadata(sdk.WrapSDKContext(ctx), &types.MsgUpdateGroupPolicyMetadata{
+		Admin:    addrs[0].String(),
+		Address:  policyAddr.String(),
+		Metadata: []byte("updated"),
+	})
+	s.Require().NoError(err)
+
+	s.Require().ErrorIs(s.vote(proposalID, addrs[0], types.VOTE_OPTION_YES), types.ErrModified)
+	s.exec(proposalID)
+	proposal, err := app.GroupKeeper.GetProposal(ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.PROPOSAL_STATUS_ABORTED, proposal.Status)
+}
+
+func (s *TestSuite) TestExportImportGenesis() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	_, policyAddr := s.createGroupAndPolicy(types.NewThresholdDecisionPolicy("4", time.Hour))
+	proposalID := s.createSendProposal(policyAddr)
+	s.Require().NoError(s.vote(proposalID, addrs[1], types.VOTE_OPTION_ABSTAIN))
+
+	genesis := app.GroupKeeper.ExportGenesis(ctx)
+	s.Require().NoError(types.ValidateGenesis(*genesis))
+	s.Require().Equal(uint64(1), genesis.GroupSeq)
+	s.Require().Len(genesis.Groups, 1)
+	s.Require().Len(genesis.GroupMembers, 3)
+	s.Require().Len(genesis.GroupPolicies, 1)
+	s.Require().Len(genesis.Proposals, 1)
+	s.Require().Len(genesis.Votes, 1)
+
+	app2 := simapp.Setup(false)
+	ctx2 := app2.BaseApp.NewContext(false, tmproto.Header{Time: ctx.BlockTime()})
+	app2.GroupKeeper.InitGenesis(ctx2, genesis)
+	s.Require().Equal(genesis, app2.GroupKeeper.ExportGenesis(ctx2))
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx2, app2.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app2.GroupKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+	policiesRes, err := queryClient.GroupPoliciesByAdmin(ctx2.Context(), &types.QueryGroupPoliciesByAdminRequest{Admin: addrs[0].String()})
+	s.Require().NoError(err)
+	s.Require().Len(policiesRes.GroupPolicies, 1)
+	proposalsRes, err := queryClient.ProposalsByGroupPolicy(ctx2.Context(), &types.QueryProposalsByGroupPolicyRequest{Address: policyAddr.String()})
+	s.Require().NoError(err)
+	s.Require().Len(proposalsRes.Proposals, 1)
+}
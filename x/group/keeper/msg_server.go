@@ -0,0 +1,542 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+var _ types.MsgServer = Keeper{}
+
+// CreateGroup implements the MsgServer.CreateGroup method.
+func (k Keeper) CreateGroup(goCtx context.Context, msg *types.MsgCreateGroup) (*types.MsgCreateGroupResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	admin, err := sdk.AccAddressFromBech32(msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	totalWeight := sdk.ZeroDec()
+	for _, m := range msg.Members {
+		weight, err := types.ParsePositiveDec(m.Weight, "member weight")
+		if err != nil {
+			return nil, err
+		}
+		totalWeight = totalWeight.Add(weight)
+	}
+
+	groupID := k.nextSeq(ctx, types.GroupSeqKey)
+	k.setGroupInfo(ctx, types.GroupInfo{
+		GroupId:     groupID,
+		Admin:       msg.Admin,
+		Metadata:    msg.Metadata,
+		Version:     1,
+		TotalWeight: types.DecString(totalWeight),
+	})
+	ctx.KVStore(k.storeKey).Set(types.GetGroupByAdminKey(admin, groupID), []byte{})
+
+	for _, m := range msg.Members {
+		k.setGroupMember(ctx, types.GroupMember{
+			GroupId: groupID,
+			Member: &types.Member{
+				Address:  m.Address,
+				Weight:   m.Weight,
+				Metadata: m.Metadata,
+				AddedAt:  ctx.BlockTime(),
+			},
+		})
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateGroup,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyGroupID, fmt.Sprintf("%d", groupID)),
+		),
+	)
+
+	return &types.MsgCreateGroupResponse{GroupId: groupID}, nil
+}
+
+// UpdateGroupMembers implements the MsgServer.UpdateGroupMembers method.
+func (k Keeper) UpdateGroupMembers(goCtx context.Context, msg *types.MsgUpdateGroupMembers) (*types.MsgUpdateGroupMembersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := k.doUpdateGroup(ctx, msg.GroupId, msg.Admin, func(info *types.GroupInfo) error {
+		totalWeight, err := types.ParseNonNegativeDec(info.TotalWeight, "total weight")
+		if err != nil {
+			return err
+		}
+
+		store := ctx.KVStore(k.storeKey)
+		for _, m := range msg.MemberUpdates {
+			addr, err := sdk.AccAddressFromBech32(m.Address)
+			if err != nil {
+				return err
+			}
+			weight, err := types.ParseNonNegativeDec(m.Weight, "member weight")
+			if err != nil {
+				return err
+			}
+
+			addedAt := ctx.BlockTime()
+			prev, err := k.GetGroupMember(ctx, info.GroupId, addr)
+			switch {
+			case err == nil:
+				prevWeight, err := types.ParseNonNegativeDec(prev.Member.Weight, "member weight")
+				if err != nil {
+					return err
+				}
+				totalWeight = totalWeight.Sub(prevWeight)
+				addedAt = prev.Member.AddedAt
+			case weight.IsZero():
+				return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "%s is not a member of group %d", m.Address, info.GroupId)
+			}
+
+			// a zero weight removes the member from the group
+			if weight.IsZero() {
+				store.Delete(types.GetGroupMemberKey(info.GroupId, addr))
+				continue
+			}
+
+			totalWeight = totalWeight.Add(weight)
+			k.setGroupMember(ctx, types.GroupMember{
+				GroupId: info.GroupId,
+				Member: &types.Member{
+					Address:  m.Address,
+					Weight:   m.Weight,
+					Metadata: m.Metadata,
+					AddedAt:  addedAt,
+				},
+			})
+		}
+
+		info.TotalWeight = types.DecString(totalWeight)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateGroupMembersResponse{}, nil
+}
+
+// UpdateGroupAdmin implements the MsgServer.UpdateGroupAdmin method.
+func (k Keeper) UpdateGroupAdmin(goCtx context.Context, msg *types.MsgUpdateGroupAdmin) (*types.MsgUpdateGroupAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := k.doUpdateGroup(ctx, msg.GroupId, msg.Admin, func(info *types.GroupInfo) error {
+		admin, err := sdk.AccAddressFromBech32(info.Admin)
+		if err != nil {
+			return err
+		}
+		newAdmin, err := sdk.AccAddressFromBech32(msg.NewAdmin)
+		if err != nil {
+			return err
+		}
+
+		store := ctx.KVStore(k.storeKey)
+		store.Delete(types.GetGroupByAdminKey(admin, info.GroupId))
+		store.Set(types.GetGroupByAdminKey(newAdmin, info.GroupId), []byte{})
+		info.Admin = msg.NewAdmin
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateGroupAdminResponse{}, nil
+}
+
+// UpdateGroupMetadata implements the MsgServer.UpdateGroupMetadata method.
+func (k Keeper) UpdateGroupMetadata(goCtx context.Context, msg *types.MsgUpdateGroupMetadata) (*types.MsgUpdateGroupMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := k.doUpdateGroup(ctx, msg.GroupId, msg.Admin, func(info *types.GroupInfo) error {
+		info.Metadata = msg.Metadata
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateGroupMetadataResponse{}, nil
+}
+
+// CreateGroupPolicy implements the MsgServer.CreateGroupPolicy method.
+func (k Keeper) CreateGroupPolicy(goCtx context.Context, msg *types.MsgCreateGroupPolicy) (*types.MsgCreateGroupPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	admin, err := sdk.AccAddressFromBech32(msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := k.GetGroupInfo(ctx, msg.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	if group.Admin != msg.Admin {
+		return nil, sdkerrors.Wrap(types.ErrUnauthorized, "not group admin")
+	}
+
+	addr := k.createGroupPolicyAccount(ctx)
+	info, err := types.NewGroupPolicyInfo(addr, msg.GroupId, admin, msg.Metadata, 1, msg.GetDecisionPolicy())
+	if err != nil {
+		return nil, err
+	}
+	k.setGroupPolicyInfo(ctx, info)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPolicyByGroupKey(msg.GroupId, addr), []byte{})
+	store.Set(types.GetPolicyByAdminKey(admin, addr), []byte{})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateGroupPolicy,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyGroupID, fmt.Sprintf("%d", msg.GroupId)),
+			sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+		),
+	)
+
+	return &types.MsgCreateGroupPolicyResponse{Address: addr.String()}, nil
+}
+
+// UpdateGroupPolicyAdmin implements the MsgServer.UpdateGroupPolicyAdmin method.
+func (k Keeper) UpdateGroupPolicyAdmin(goCtx context.Context, msg *types.MsgUpdateGroupPolicyAdmin) (*types.MsgUpdateGroupPolicyAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := k.doUpdateGroupPolicy(ctx, msg.Address, msg.Admin, func(info *types.GroupPolicyInfo) error {
+		policy, err := sdk.AccAddressFromBech32(info.Address)
+		if err != nil {
+			return err
+		}
+		admin, err := sdk.AccAddressFromBech32(info.Admin)
+		if err != nil {
+			return err
+		}
+		newAdmin, err := sdk.AccAddressFromBech32(msg.NewAdmin)
+		if err != nil {
+			return err
+		}
+
+		store := ctx.KVStore(k.storeKey)
+		store.Delete(types.GetPolicyByAdminKey(admin, policy))
+		store.Set(types.GetPolicyByAdminKey(newAdmin, policy), []byte{})
+		info.Admin = msg.NewAdmin
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateGroupPolicyAdminResponse{}, nil
+}
+
+// UpdateGroupPolicyDecisionPolicy implements the
+// MsgServer.UpdateGroupPolicyDecisionPolicy method.
+func (k Keeper) UpdateGroupPolicyDecisionPolicy(goCtx context.Context, msg *types.MsgUpdateGroupPolicyDecisionPolicy) (*types.MsgUpdateGroupPolicyDecisionPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := k.doUpdateGroupPolicy(ctx, msg.Address, msg.Admin, func(info *types.GroupPolicyInfo) error {
+		return info.SetDecisionPolicy(msg.GetDecisionPolicy())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateGroupPolicyDecisionPolicyResponse{}, nil
+}
+
+// UpdateGroupPolicyMetadata implements the MsgServer.UpdateGroupPolicyMetadata
+// method.
+func (k Keeper) UpdateGroupPolicyMetadata(goCtx context.Context, msg *types.MsgUpdateGroupPolicyMetadata) (*types.MsgUpdateGroupPolicyMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := k.doUpdateGroupPolicy(ctx, msg.Address, msg.Admin, func(info *types.GroupPolicyInfo) error {
+		info.Metadata = msg.Metadata
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateGroupPolicyMetadataResponse{}, nil
+}
+
+// CreateProposal implements the MsgServer.CreateProposal method.
+func (k Keeper) CreateProposal(goCtx context.Context, msg *types.MsgCreateProposal) (*types.MsgCreateProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	policy, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	policyInfo, err := k.GetGroupPolicyInfo(ctx, policy)
+	if err != nil {
+		return nil, err
+	}
+	decisionPolicy := policyInfo.GetDecisionPolicy()
+	if decisionPolicy == nil {
+		return nil, sdkerrors.Wrap(types.ErrEmpty, "decision policy")
+	}
+	group, err := k.GetGroupInfo(ctx, policyInfo.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, proposer := range msg.Proposers {
+		addr, err := sdk.AccAddressFromBech32(proposer)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := k.GetGroupMember(ctx, group.GroupId, addr); err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "proposer %s is not a group member", proposer)
+		}
+	}
+
+	msgs, err := msg.GetServiceMsgs()
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range msgs {
+		if k.router.Handler(m.Route()) == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", m.Route())
+		}
+	}
+
+	proposalID := k.nextSeq(ctx, types.ProposalSeqKey)
+	k.setProposal(ctx, types.Proposal{
+		ProposalId:         proposalID,
+		Address:            msg.Address,
+		Metadata:           msg.Metadata,
+		Proposers:          msg.Proposers,
+		SubmittedAt:        ctx.BlockTime(),
+		GroupVersion:       group.Version,
+		GroupPolicyVersion: policyInfo.Version,
+		Status:             types.PROPOSAL_STATUS_SUBMITTED,
+		Result:             types.PROPOSAL_RESULT_UNFINALIZED,
+		VoteState:          types.NewTally(),
+		Timeout:            ctx.BlockTime().Add(decisionPolicy.GetTimeout()),
+		ExecutorResult:     types.PROPOSAL_EXECUTOR_RESULT_NOT_RUN,
+		Msgs:               msg.Msgs,
+	})
+	ctx.KVStore(k.storeKey).Set(types.GetProposalByPolicyKey(policy, proposalID), []byte{})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateProposal,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address),
+		),
+	)
+
+	return &types.MsgCreateProposalResponse{ProposalId: proposalID}, nil
+}
+
+// Vote implements the MsgServer.Vote method. The proposal is closed as soon as
+// the votes make the result of its decision policy final.
+func (k Keeper) Vote(goCtx context.Context, msg *types.MsgVote) (*types.MsgVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	voter, err := sdk.AccAddressFromBech32(msg.Voter)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal, err := k.GetProposal(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+	if proposal.Status != types.PROPOSAL_STATUS_SUBMITTED {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "proposal %d is %s", proposal.ProposalId, proposal.Status)
+	}
+	if !ctx.BlockTime().Before(proposal.Timeout) {
+		return nil, sdkerrors.Wrapf(types.ErrExpired, "voting period of proposal %d has ended", proposal.ProposalId)
+	}
+
+	policyInfo, group, err := k.getProposalGroupPolicy(ctx, proposal)
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := k.GetGroupMember(ctx, group.GroupId, voter)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "voter %s is not a group member", msg.Voter)
+	}
+	if _, err := k.GetVote(ctx, proposal.ProposalId, voter); err == nil {
+		return nil, sdkerrors.Wrapf(types.ErrDuplicate, "voter %s has already voted on proposal %d", msg.Voter, proposal.ProposalId)
+	}
+
+	if err := proposal.VoteState.Add(msg.Choice, member.Member.Weight); err != nil {
+		return nil, err
+	}
+	k.setVote(ctx, types.Vote{
+		ProposalId:  proposal.ProposalId,
+		Voter:       msg.Voter,
+		Choice:      msg.Choice,
+		Metadata:    msg.Metadata,
+		SubmittedAt: ctx.BlockTime(),
+	})
+
+	if err := k.tally(ctx, &proposal, group, policyInfo); err != nil {
+		return nil, err
+	}
+	k.setProposal(ctx, proposal)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVote,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalId)),
+			sdk.NewAttribute(types.AttributeKeyVoter, msg.Voter),
+			sdk.NewAttribute(types.AttributeKeyResult, proposal.Result.String()),
+		),
+	)
+
+	return &types.MsgVoteResponse{}, nil
+}
+
+// Exec implements the MsgServer.Exec method. A submitted proposal is first
+// tallied, or aborted if its group or group policy was modified since its
+// submission. The messages of an accepted proposal are then executed
+// atomically; a failed execution can be retried.
+func (k Keeper) Exec(goCtx context.Context, msg *types.MsgExec) (*types.MsgExecResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	proposal, err := k.GetProposal(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+	if proposal.Status != types.PROPOSAL_STATUS_SUBMITTED && proposal.Status != types.PROPOSAL_STATUS_CLOSED {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "proposal %d is %s", proposal.ProposalId, proposal.Status)
+	}
+
+	policy, err := sdk.AccAddressFromBech32(proposal.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if proposal.Status == types.PROPOSAL_STATUS_SUBMITTED {
+		policyInfo, group, err := k.getProposalGroupPolicy(ctx, proposal)
+		switch {
+		case types.ErrModified.Is(err):
+			proposal.Status = types.PROPOSAL_STATUS_ABORTED
+		case err != nil:
+			return nil, err
+		default:
+			if err := k.tally(ctx, &proposal, group, policyInfo); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if proposal.Status == types.PROPOSAL_STATUS_CLOSED && proposal.Result == types.PROPOSAL_RESULT_ACCEPTED &&
+		proposal.ExecutorResult != types.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.execMsgs(cacheCtx, policy, proposal); err != nil {
+			proposal.ExecutorResult = types.PROPOSAL_EXECUTOR_RESULT_FAILURE
+			k.Logger(ctx).Info("proposal execution failed", "proposal", proposal.ProposalId, "err", err.Error())
+		} else {
+			proposal.ExecutorResult = types.PROPOSAL_EXECUTOR_RESULT_SUCCESS
+			writeCache()
+
+			// the cached context is created with a new EventManager, whose events
+			// are kept along with the state changes of the successful execution
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		}
+	}
+	k.setProposal(ctx, proposal)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExec,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalId)),
+			sdk.NewAttribute(types.AttributeKeyResult, proposal.Result.String()),
+			sdk.NewAttribute(types.AttributeKeyExecutorResult, proposal.ExecutorResult.String()),
+		),
+	)
+
+	return &types.MsgExecResponse{}, nil
+}
+
+// doUpdateGroup applies an update to a group after checking that it is
+// requested by the group admin, and bumps the group version.
+func (k Keeper) doUpdateGroup(ctx sdk.Context, groupID uint64, admin string, action func(info *types.GroupInfo) error) error {
+	info, err := k.GetGroupInfo(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	if info.Admin != admin {
+		return sdkerrors.Wrap(types.ErrUnauthorized, "not group admin")
+	}
+
+	if err := action(&info); err != nil {
+		return err
+	}
+	info.Version++
+	k.setGroupInfo(ctx, info)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateGroup,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyGroupID, fmt.Sprintf("%d", groupID)),
+		),
+	)
+	return nil
+}
+
+// doUpdateGroupPolicy applies an update to a group policy after checking that
+// it is requested by the group policy admin, and bumps the group policy
+// version.
+func (k Keeper) doUpdateGroupPolicy(ctx sdk.Context, address string, admin string, action func(info *types.GroupPolicyInfo) error) error {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return err
+	}
+	info, err := k.GetGroupPolicyInfo(ctx, addr)
+	if err != nil {
+		return err
+	}
+	if info.Admin != admin {
+		return sdkerrors.Wrap(types.ErrUnauthorized, "not group policy admin")
+	}
+
+	if err := action(&info); err != nil {
+		return err
+	}
+	info.Version++
+	k.setGroupPolicyInfo(ctx, info)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateGroupPolicy,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyAddress, address),
+		),
+	)
+	return nil
+}
+
+// getProposalGroupPolicy returns the group policy and the group of a proposal,
+// failing with ErrModified if either was updated since the proposal was
+// submitted.
+func (k Keeper) getProposalGroupPolicy(ctx sdk.Context, proposal types.Proposal) (types.GroupPolicyInfo, types.GroupInfo, error) {
+	policy, err := sdk.AccAddressFromBech32(proposal.Address)
+	if err != nil {
+		return types.GroupPolicyInfo{}, types.GroupInfo{}, err
+	}
+	policyInfo, err := k.GetGroupPolicyInfo(ctx, policy)
+	if err != nil {
+		return types.GroupPolicyInfo{}, types.GroupInfo{}, err
+	}
+	if policyInfo.Version != proposal.GroupPolicyVersion {
+		return types.GroupPolicyInfo{}, types.GroupInfo{}, sdkerrors.Wrap(types.ErrModified, "group policy was modified")
+	}
+	group, err := k.GetGroupInfo(ctx, policyInfo.GroupId)
+	if err != nil {
+		return types.GroupPolicyInfo{}, types.GroupInfo{}, err
+	}
+	if group.Version != proposal.GroupVersion {
+		return types.GroupPolicyInfo{}, types.GroupInfo{}, sdkerrors.Wrap(types.ErrModified, "group was modified")
+	}
+	return policyInfo, group, nil
+}
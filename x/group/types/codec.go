@@ -0,0 +1,33 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterInterfaces registers the interfaces types with the interface registry
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.MsgRequest)(nil),
+		&MsgCreateGroup{},
+		&MsgUpdateGroupMembers{},
+		&MsgUpdateGroupAdmin{},
+		&MsgUpdateGroupMetadata{},
+		&MsgCreateGroupPolicy{},
+		&MsgUpdateGroupPolicyAdmin{},
+		&MsgUpdateGroupPolicyDecisionPolicy{},
+		&MsgUpdateGroupPolicyMetadata{},
+		&MsgCreateProposal{},
+		&MsgVote{},
+		&MsgExec{},
+	)
+
+	registry.RegisterInterface(
+		"cosmos.group.v1beta1.DecisionPolicy",
+		(*DecisionPolicy)(nil),
+		&ThresholdDecisionPolicy{},
+		&PercentageDecisionPolicy{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/group module sentinel errors
+var (
+	ErrEmpty            = sdkerrors.Register(ModuleName, 2, "value is empty")
+	ErrDuplicate        = sdkerrors.Register(ModuleName, 3, "duplicate value")
+	ErrMaxLimit         = sdkerrors.Register(ModuleName, 4, "limit exceeded")
+	ErrType             = sdkerrors.Register(ModuleName, 5, "invalid type")
+	ErrInvalid          = sdkerrors.Register(ModuleName, 6, "invalid value")
+	ErrUnauthorized     = sdkerrors.Register(ModuleName, 7, "unauthorized")
+	ErrModified         = sdkerrors.Register(ModuleName, 8, "modified")
+	ErrExpired          = sdkerrors.Register(ModuleName, 9, "expired")
+	ErrGroupNotFound    = sdkerrors.Register(ModuleName, 10, "group not found")
+	ErrPolicyNotFound   = sdkerrors.Register(ModuleName, 11, "group policy not found")
+	ErrProposalNotFound = sdkerrors.Register(ModuleName, 12, "proposal not found")
+	ErrVoteNotFound     = sdkerrors.Register(ModuleName, 13, "vote not found")
+)
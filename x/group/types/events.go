@@ -0,0 +1,21 @@
+package types
+
+// group module events
+const (
+	EventTypeCreateGroup       = "create_group"
+	EventTypeUpdateGroup       = "update_group"
+	EventTypeCreateGroupPolicy = "create_group_policy"
+	EventTypeUpdateGroupPolicy = "update_group_policy"
+	EventTypeCreateProposal    = "create_proposal"
+	EventTypeVote              = "vote"
+	EventTypeExec              = "exec"
+
+	AttributeKeyGroupID        = "group_id"
+	AttributeKeyAddress        = "address"
+	AttributeKeyProposalID     = "proposal_id"
+	AttributeKeyVoter          = "voter"
+	AttributeKeyResult         = "result"
+	AttributeKeyExecutorResult = "executor_result"
+
+	AttributeValueCategory = ModuleName
+)
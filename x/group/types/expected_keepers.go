@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// AccountKeeper defines the expected account keeper, used to create the group
+// policy accounts.
+type AccountKeeper interface {
+	NewAccount(ctx sdk.Context, acc auth.AccountI) auth.AccountI
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) auth.AccountI
+	SetAccount(ctx sdk.Context, acc auth.AccountI)
+}
@@ -0,0 +1,103 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ types.UnpackInterfacesMessage = GenesisState{}
+
+// DefaultGenesisState returns default state for group module.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// ValidateGenesis checks that the genesis state is consistent: the IDs are
+// below their sequence, and members, group policies, proposals and votes
+// refer to existing groups, group policies and proposals.
+func ValidateGenesis(data GenesisState) error {
+	groups := make(map[uint64]bool, len(data.Groups))
+	for _, g := range data.Groups {
+		if g.GroupId == 0 || g.GroupId > data.GroupSeq {
+			return sdkerrors.Wrapf(ErrInvalid, "group id %d, group sequence %d", g.GroupId, data.GroupSeq)
+		}
+		if groups[g.GroupId] {
+			return sdkerrors.Wrapf(ErrDuplicate, "group id %d", g.GroupId)
+		}
+		groups[g.GroupId] = true
+		if err := validateAddress(g.Admin, "admin"); err != nil {
+			return err
+		}
+		if _, err := ParseNonNegativeDec(g.TotalWeight, "total weight"); err != nil {
+			return err
+		}
+	}
+
+	for _, m := range data.GroupMembers {
+		if !groups[m.GroupId] {
+			return sdkerrors.Wrapf(ErrGroupNotFound, "group id %d", m.GroupId)
+		}
+		if m.Member == nil {
+			return sdkerrors.Wrapf(ErrEmpty, "member of group %d", m.GroupId)
+		}
+		if err := m.Member.ValidateBasic(false); err != nil {
+			return err
+		}
+	}
+
+	policies := make(map[string]bool, len(data.GroupPolicies))
+	for _, p := range data.GroupPolicies {
+		if err := validateAddress(p.Address, "group policy"); err != nil {
+			return err
+		}
+		if policies[p.Address] {
+			return sdkerrors.Wrapf(ErrDuplicate, "group policy %s", p.Address)
+		}
+		policies[p.Address] = true
+		if !groups[p.GroupId] {
+			return sdkerrors.Wrapf(ErrGroupNotFound, "group id %d", p.GroupId)
+		}
+		if err := validateAddress(p.Admin, "admin"); err != nil {
+			return err
+		}
+		if err := validateDecisionPolicy(p.GetDecisionPolicy()); err != nil {
+			return err
+		}
+	}
+
+	proposals := make(map[uint64]bool, len(data.Proposals))
+	for _, p := range data.Proposals {
+		if p.ProposalId == 0 || p.ProposalId > data.ProposalSeq {
+			return sdkerrors.Wrapf(ErrInvalid, "proposal id %d, proposal sequence %d", p.ProposalId, data.ProposalSeq)
+		}
+		if proposals[p.ProposalId] {
+			return sdkerrors.Wrapf(ErrDuplicate, "proposal id %d", p.ProposalId)
+		}
+		proposals[p.ProposalId] = true
+		if !policies[p.Address] {
+			return sdkerrors.Wrapf(ErrPolicyNotFound, "group policy %s", p.Address)
+		}
+		if _, err := p.VoteState.TotalCounts(); err != nil {
+			return err
+		}
+	}
+
+	for _, v := range data.Votes {
+		if !proposals[v.ProposalId] {
+			return sdkerrors.Wrapf(ErrProposalNotFound, "proposal id %d", v.ProposalId)
+		}
+		if err := validateAddress(v.Voter, "voter"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (data GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	if err := unpackGroupPolicies(unpacker, data.GroupPolicies); err != nil {
+		return err
+	}
+	return unpackProposals(unpacker, data.Proposals)
+}
@@ -0,0 +1,179 @@
+package types
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "group"
+
+	// StoreKey is the store key string for group
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for group
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for group
+	QuerierRoute = ModuleName
+)
+
+// Keys for group store
+// Items are stored with the following key: values
+//
+// - 0x00: groupSeq (uint64)
+// - 0x01<groupID_Bytes>: GroupInfo
+// - 0x02<groupID_Bytes><memberAddressLen (1 Byte)><memberAddress_Bytes>: GroupMember
+// - 0x03<adminAddressLen (1 Byte)><adminAddress_Bytes><groupID_Bytes>: []byte{}
+//
+// - 0x10: groupPolicySeq (uint64)
+// - 0x11<policyAddressLen (1 Byte)><policyAddress_Bytes>: GroupPolicyInfo
+// - 0x12<groupID_Bytes><policyAddressLen (1 Byte)><policyAddress_Bytes>: []byte{}
+// - 0x13<adminAddressLen (1 Byte)><adminAddress_Bytes><policyAddressLen (1 Byte)><policyAddress_Bytes>: []byte{}
+//
+// - 0x20: proposalSeq (uint64)
+// - 0x21<proposalID_Bytes>: Proposal
+// - 0x22<policyAddressLen (1 Byte)><policyAddress_Bytes><proposalID_Bytes>: []byte{}
+//
+// - 0x30<proposalID_Bytes><voterAddressLen (1 Byte)><voterAddress_Bytes>: Vote
+// - 0x31<voterAddressLen (1 Byte)><voterAddress_Bytes><proposalID_Bytes>: []byte{}
+//
+// IDs are encoded as 8 bytes big endian, so that iterating a prefix returns
+// the entries in creation order.
+
+var (
+	GroupSeqKey            = []byte{0x00} // key for the group sequence
+	GroupInfoPrefix        = []byte{0x01} // prefix for the groups info
+	GroupMemberPrefix      = []byte{0x02} // prefix for the group members
+	GroupByAdminPrefix     = []byte{0x03} // prefix for the group by admin index
+	GroupPolicySeqKey      = []byte{0x10} // key for the group policy sequence
+	GroupPolicyPrefix      = []byte{0x11} // prefix for the group policies info
+	PolicyByGroupPrefix    = []byte{0x12} // prefix for the group policy by group index
+	PolicyByAdminPrefix    = []byte{0x13} // prefix for the group policy by admin index
+	ProposalSeqKey         = []byte{0x20} // key for the proposal sequence
+	ProposalPrefix         = []byte{0x21} // prefix for the proposals
+	ProposalByPolicyPrefix = []byte{0x22} // prefix for the proposal by group policy index
+	VotePrefix             = []byte{0x30} // prefix for the votes
+	VoteByVoterPrefix      = []byte{0x31} // prefix for the vote by voter index
+)
+
+// GetIDBytes returns the byte representation of a group or proposal ID.
+func GetIDBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
+
+// GetIDFromBytes returns the group or proposal ID of its byte representation.
+func GetIDFromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
+
+// GetGroupInfoKey returns the key of the info of a group.
+func GetGroupInfoKey(groupID uint64) []byte {
+	return append(GroupInfoPrefix, GetIDBytes(groupID)...)
+}
+
+// GetGroupMembersPrefix returns the prefix of the members of a group.
+func GetGroupMembersPrefix(groupID uint64) []byte {
+	return append(GroupMemberPrefix, GetIDBytes(groupID)...)
+}
+
+// GetGroupMemberKey returns the key of a member of a group.
+func GetGroupMemberKey(groupID uint64, member sdk.AccAddress) []byte {
+	return append(GetGroupMembersPrefix(groupID), address.MustLengthPrefix(member)...)
+}
+
+// GetGroupsByAdminPrefix returns the prefix of the group by admin index
+// entries of an admin.
+func GetGroupsByAdminPrefix(admin sdk.AccAddress) []byte {
+	return append(GroupByAdminPrefix, address.MustLengthPrefix(admin)...)
+}
+
+// GetGroupByAdminKey returns the group by admin index key of a group.
+func GetGroupByAdminKey(admin sdk.AccAddress, groupID uint64) []byte {
+	return append(GetGroupsByAdminPrefix(admin), GetIDBytes(groupID)...)
+}
+
+// GetGroupPolicyKey returns the key of the info of a group policy.
+func GetGroupPolicyKey(policy sdk.AccAddress) []byte {
+	return append(GroupPolicyPrefix, address.MustLengthPrefix(policy)...)
+}
+
+// GetPoliciesByGroupPrefix returns the prefix of the group policy by group
+// index entries of a group.
+func GetPoliciesByGroupPrefix(groupID uint64) []byte {
+	return append(PolicyByGroupPrefix, GetIDBytes(groupID)...)
+}
+
+// GetPolicyByGroupKey returns the group policy by group index key of a group
+// policy.
+func GetPolicyByGroupKey(groupID uint64, policy sdk.AccAddress) []byte {
+	return append(GetPoliciesByGroupPrefix(groupID), address.MustLengthPrefix(policy)...)
+}
+
+// GetPoliciesByAdminPrefix returns the prefix of the group policy by admin
+// index entries of an admin.
+func GetPoliciesByAdminPrefix(admin sdk.AccAddress) []byte {
+	return append(PolicyByAdminPrefix, address.MustLengthPrefix(admin)...)
+}
+
+// GetPolicyByAdminKey returns the group policy by admin index key of a group
+// policy.
+func GetPolicyByAdminKey(admin sdk.AccAddress, policy sdk.AccAddress) []byte {
+	return append(GetPoliciesByAdminPrefix(admin), address.MustLengthPrefix(policy)...)
+}
+
+// GetProposalKey returns the key of a proposal.
+func GetProposalKey(proposalID uint64) []byte {
+	return append(ProposalPrefix, GetIDBytes(proposalID)...)
+}
+
+// GetProposalsByPolicyPrefix returns the prefix of the proposal by group
+// policy index entries of a group policy.
+func GetProposalsByPolicyPrefix(policy sdk.AccAddress) []byte {
+	return append(ProposalByPolicyPrefix, address.MustLengthPrefix(policy)...)
+}
+
+// GetProposalByPolicyKey returns the proposal by group policy index key of a
+// proposal.
+func GetProposalByPolicyKey(policy sdk.AccAddress, proposalID uint64) []byte {
+	return append(GetProposalsByPolicyPrefix(policy), GetIDBytes(proposalID)...)
+}
+
+// GetVotesByProposalPrefix returns the prefix of the votes of a proposal.
+func GetVotesByProposalPrefix(proposalID uint64) []byte {
+	return append(VotePrefix, GetIDBytes(proposalID)...)
+}
+
+// GetVoteKey returns the key of the vote of a voter on a proposal.
+func GetVoteKey(proposalID uint64, voter sdk.AccAddress) []byte {
+	return append(GetVotesByProposalPrefix(proposalID), address.MustLengthPrefix(voter)...)
+}
+
+// GetVotesByVoterPrefix returns the prefix of the vote by voter index entries
+// of a voter.
+func GetVotesByVoterPrefix(voter sdk.AccAddress) []byte {
+	return append(VoteByVoterPrefix, address.MustLengthPrefix(voter)...)
+}
+
+// GetVoteByVoterKey returns the vote by voter index key of a vote.
+func GetVoteByVoterKey(voter sdk.AccAddress, proposalID uint64) []byte {
+	return append(GetVotesByVoterPrefix(voter), GetIDBytes(proposalID)...)
+}
+
+// SplitLengthPrefixedAddress splits <addressLen (1 Byte)><address_Bytes><rest>
+// into the address and the remaining bytes.
+func SplitLengthPrefixedAddress(bz []byte) (sdk.AccAddress, []byte) {
+	addrLen := int(bz[0])
+	return sdk.AccAddress(bz[1 : 1+addrLen]), bz[1+addrLen:]
+}
+
+// GetGroupPolicyAddress returns the account address of the group policy
+// derived from the given group policy sequence.
+func GetGroupPolicyAddress(seq uint64) sdk.AccAddress {
+	return address.Module(ModuleName, append([]byte("policy"), GetIDBytes(seq)...))
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Weights, thresholds and vote counts are stored as decimal strings. An empty
+// string is read as zero.
+
+// ParseDec parses a decimal string of the given field.
+func ParseDec(s string, field string) (sdk.Dec, error) {
+	if s == "" {
+		return sdk.ZeroDec(), nil
+	}
+	d, err := sdk.NewDecFromStr(s)
+	if err != nil {
+		return sdk.Dec{}, sdkerrors.Wrapf(ErrInvalid, "%s: %s", field, err)
+	}
+	return d, nil
+}
+
+// ParseNonNegativeDec parses a non-negative decimal string of the given field.
+func ParseNonNegativeDec(s string, field string) (sdk.Dec, error) {
+	d, err := ParseDec(s, field)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if d.IsNegative() {
+		return sdk.Dec{}, sdkerrors.Wrapf(ErrInvalid, "%s: expected a non-negative decimal, got %s", field, s)
+	}
+	return d, nil
+}
+
+// ParsePositiveDec parses a positive decimal string of the given field.
+func ParsePositiveDec(s string, field string) (sdk.Dec, error) {
+	d, err := ParseDec(s, field)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if !d.IsPositive() {
+		return sdk.Dec{}, sdkerrors.Wrapf(ErrInvalid, "%s: expected a positive decimal, got %q", field, s)
+	}
+	return d, nil
+}
+
+// DecString formats a decimal without its trailing zeros, e.g. "1.5" instead
+// of "1.500000000000000000".
+func DecString(d sdk.Dec) string {
+	s := d.String()
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	return s
+}
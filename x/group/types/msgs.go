@@ -0,0 +1,350 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.MsgRequest = &MsgCreateGroup{}
+	_ sdk.MsgRequest = &MsgUpdateGroupMembers{}
+	_ sdk.MsgRequest = &MsgUpdateGroupAdmin{}
+	_ sdk.MsgRequest = &MsgUpdateGroupMetadata{}
+	_ sdk.MsgRequest = &MsgCreateGroupPolicy{}
+	_ sdk.MsgRequest = &MsgUpdateGroupPolicyAdmin{}
+	_ sdk.MsgRequest = &MsgUpdateGroupPolicyDecisionPolicy{}
+	_ sdk.MsgRequest = &MsgUpdateGroupPolicyMetadata{}
+	_ sdk.MsgRequest = &MsgCreateProposal{}
+	_ sdk.MsgRequest = &MsgVote{}
+	_ sdk.MsgRequest = &MsgExec{}
+
+	_ codectypes.UnpackInterfacesMessage = MsgCreateGroupPolicy{}
+	_ codectypes.UnpackInterfacesMessage = MsgUpdateGroupPolicyDecisionPolicy{}
+	_ codectypes.UnpackInterfacesMessage = MsgCreateProposal{}
+)
+
+// GetSigners implements Msg
+func (msg MsgCreateGroup) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddressFromBech32(msg.Admin)}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgCreateGroup) ValidateBasic() error {
+	if err := validateAddress(msg.Admin, "admin"); err != nil {
+		return err
+	}
+	if err := validateMetadata(msg.Metadata, "group"); err != nil {
+		return err
+	}
+	return ValidateMembers(msg.Members, false)
+}
+
+// GetSigners implements Msg
+func (msg MsgUpdateGroupMembers) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddressFromBech32(msg.Admin)}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgUpdateGroupMembers) ValidateBasic() error {
+	if err := validateAddress(msg.Admin, "admin"); err != nil {
+		return err
+	}
+	if msg.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+	if len(msg.MemberUpdates) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "member updates")
+	}
+	return ValidateMembers(msg.MemberUpdates, true)
+}
+
+// GetSigners implements Msg
+func (msg MsgUpdateGroupAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddressFromBech32(msg.Admin)}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgUpdateGroupAdmin) ValidateBasic() error {
+	if msg.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+	return validateAdminUpdate(msg.Admin, msg.NewAdmin)
+}
+
+// GetSigners implements Msg
+func (msg MsgUpdateGroupMetadata) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddressFromBech32(msg.Admin)}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgUpdateGroupMetadata) ValidateBasic() error {
+	if err := validateAddress(msg.Admin, "admin"); err != nil {
+		return err
+	}
+	if msg.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+	return validateMetadata(msg.Metadata, "group")
+}
+
+// NewMsgCreateGroupPolicy creates a new MsgCreateGroupPolicy.
+//nolint:interfacer
+func NewMsgCreateGroupPolicy(admin sdk.AccAddress, groupID uint64, metadata []byte, decisionPolicy DecisionPolicy) (*MsgCreateGroupPolicy, error) {
+	any, err := decisionPolicyToAny(decisionPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgCreateGroupPolicy{
+		Admin:          admin.String(),
+		GroupId:        groupID,
+		Metadata:       metadata,
+		DecisionPolicy: any,
+	}, nil
+}
+
+// GetDecisionPolicy returns the unpacked decision policy of the message.
+func (msg MsgCreateGroupPolicy) GetDecisionPolicy() DecisionPolicy {
+	return decisionPolicyFromAny(msg.DecisionPolicy)
+}
+
+// GetSigners implements Msg
+func (msg MsgCreateGroupPolicy) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddressFromBech32(msg.Admin)}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgCreateGroupPolicy) ValidateBasic() error {
+	if err := validateAddress(msg.Admin, "admin"); err != nil {
+		return err
+	}
+	if msg.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+	if err := validateMetadata(msg.Metadata, "group policy"); err != nil {
+		return err
+	}
+	return validateDecisionPolicy(msg.GetDecisionPolicy())
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgCreateGroupPolicy) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var decisionPolicy DecisionPolicy
+	return unpacker.UnpackAny(msg.DecisionPolicy, &decisionPolicy)
+}
+
+// GetSigners implements Msg
+func (msg MsgUpdateGroupPolicyAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddressFromBech32(msg.Admin)}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgUpdateGroupPolicyAdmin) ValidateBasic() error {
+	if err := validateAddress(msg.Address, "group policy"); err != nil {
+		return err
+	}
+	return validateAdminUpdate(msg.Admin, msg.NewAdmin)
+}
+
+// NewMsgUpdateGroupPolicyDecisionPolicy creates a new
+// MsgUpdateGroupPolicyDecisionPolicy.
+//nolint:interfacer
+func NewMsgUpdateGroupPolicyDecisionPolicy(admin sdk.AccAddress, address sdk.AccAddress, decisionPolicy DecisionPolicy) (*MsgUpdateGroupPolicyDecisionPolicy, error) {
+	any, err := decisionPolicyToAny(decisionPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgUpdateGroupPolicyDecisionPolicy{
+		Admin:          admin.String(),
+		Address:        address.String(),
+		DecisionPolicy: any,
+	}, nil
+}
+
+// GetDecisionPolicy returns the unpacked decision policy of the message.
+func (msg MsgUpdateGroupPolicyDecisionPolicy) GetDecisionPolicy() DecisionPolicy {
+	return decisionPolicyFromAny(msg.DecisionPolicy)
+}
+
+// GetSigners implements Msg
+func (msg MsgUpdateGroupPolicyDecisionPolicy) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddressFromBech32(msg.Admin)}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgUpdateGroupPolicyDecisionPolicy) ValidateBasic() error {
+	if err := validateAddress(msg.Admin, "admin"); err != nil {
+		return err
+	}
+	if err := validateAddress(msg.Address, "group policy"); err != nil {
+		return err
+	}
+	return validateDecisionPolicy(msg.GetDecisionPolicy())
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgUpdateGroupPolicyDecisionPolicy) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var decisionPolicy DecisionPolicy
+	return unpacker.UnpackAny(msg.DecisionPolicy, &decisionPolicy)
+}
+
+// GetSigners implements Msg
+func (msg MsgUpdateGroupPolicyMetadata) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddressFromBech32(msg.Admin)}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgUpdateGroupPolicyMetadata) ValidateBasic() error {
+	if err := validateAddress(msg.Admin, "admin"); err != nil {
+		return err
+	}
+	if err := validateAddress(msg.Address, "group policy"); err != nil {
+		return err
+	}
+	return validateMetadata(msg.Metadata, "group policy")
+}
+
+// NewMsgCreateProposal creates a new MsgCreateProposal.
+//nolint:interfacer
+func NewMsgCreateProposal(address sdk.AccAddress, proposers []string, msgs []sdk.ServiceMsg, metadata []byte) (*MsgCreateProposal, error) {
+	anys, err := ServiceMsgsToAnys(msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgCreateProposal{
+		Address:   address.String(),
+		Proposers: proposers,
+		Metadata:  metadata,
+		Msgs:      anys,
+	}, nil
+}
+
+// GetServiceMsgs returns the unpacked messages of the proposal.
+func (msg MsgCreateProposal) GetServiceMsgs() ([]sdk.ServiceMsg, error) {
+	return serviceMsgsFromAnys(msg.Msgs)
+}
+
+// GetSigners implements Msg
+func (msg MsgCreateProposal) GetSigners() []sdk.AccAddress {
+	signers := make([]sdk.AccAddress, len(msg.Proposers))
+	for i, proposer := range msg.Proposers {
+		signers[i] = mustAccAddressFromBech32(proposer)
+	}
+	return signers
+}
+
+// ValidateBasic implements Msg. The messages of the proposal must be signed by
+// the group policy account only.
+func (msg MsgCreateProposal) ValidateBasic() error {
+	policy, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "group policy")
+	}
+	if len(msg.Proposers) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposers")
+	}
+	seen := make(map[string]bool, len(msg.Proposers))
+	for _, proposer := range msg.Proposers {
+		if err := validateAddress(proposer, "proposer"); err != nil {
+			return err
+		}
+		if seen[proposer] {
+			return sdkerrors.Wrapf(ErrDuplicate, "proposer %s", proposer)
+		}
+		seen[proposer] = true
+	}
+	if err := validateMetadata(msg.Metadata, "proposal"); err != nil {
+		return err
+	}
+
+	msgs, err := msg.GetServiceMsgs()
+	if err != nil {
+		return err
+	}
+	for i, m := range msgs {
+		if err := m.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "msg %d", i)
+		}
+		signers := m.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(policy) {
+			return sdkerrors.Wrapf(ErrUnauthorized, "msg %d must be signed by the group policy account only", i)
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgCreateProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackServiceMsgs(unpacker, msg.Msgs)
+}
+
+// GetSigners implements Msg
+func (msg MsgVote) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddressFromBech32(msg.Voter)}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgVote) ValidateBasic() error {
+	if msg.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal id")
+	}
+	if err := validateAddress(msg.Voter, "voter"); err != nil {
+		return err
+	}
+	if _, ok := VoteOption_name[int32(msg.Choice)]; !ok || msg.Choice == VOTE_OPTION_UNSPECIFIED {
+		return sdkerrors.Wrapf(ErrInvalid, "vote option %s", msg.Choice)
+	}
+	return validateMetadata(msg.Metadata, "vote")
+}
+
+// GetSigners implements Msg
+func (msg MsgExec) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddressFromBech32(msg.Signer)}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgExec) ValidateBasic() error {
+	if msg.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal id")
+	}
+	return validateAddress(msg.Signer, "signer")
+}
+
+func mustAccAddressFromBech32(address string) sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+func validateAddress(address string, description string) error {
+	if _, err := sdk.AccAddressFromBech32(address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid %s address", description)
+	}
+	return nil
+}
+
+func validateAdminUpdate(admin, newAdmin string) error {
+	if err := validateAddress(admin, "admin"); err != nil {
+		return err
+	}
+	if err := validateAddress(newAdmin, "new admin"); err != nil {
+		return err
+	}
+	if admin == newAdmin {
+		return sdkerrors.Wrap(ErrInvalid, "new and old admin are the same")
+	}
+	return nil
+}
+
+func validateDecisionPolicy(decisionPolicy DecisionPolicy) error {
+	if decisionPolicy == nil {
+		return sdkerrors.Wrap(ErrEmpty, "decision policy")
+	}
+	return decisionPolicy.ValidateBasic()
+}
@@ -0,0 +1,166 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+var (
+	admin    = sdk.AccAddress("_______admin________")
+	member1  = sdk.AccAddress("_______member1______")
+	member2  = sdk.AccAddress("_______member2______")
+	policy   = sdk.AccAddress("_______policy_______")
+	coinsPos = sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
+)
+
+func TestMsgCreateGroup(t *testing.T) {
+	tests := []struct {
+		title      string
+		admin      string
+		members    []types.Member
+		metadata   []byte
+		expectPass bool
+	}{
+		{"valid", admin.String(), []types.Member{{Address: member1.String(), Weight: "1"}, {Address: member2.String(), Weight: "0.5"}}, nil, true},
+		{"no members", admin.String(), nil, nil, true},
+		{"invalid admin", "invalid", []types.Member{{Address: member1.String(), Weight: "1"}}, nil, false},
+		{"invalid member", admin.String(), []types.Member{{Address: "invalid", Weight: "1"}}, nil, false},
+		{"zero weight", admin.String(), []types.Member{{Address: member1.String(), Weight: "0"}}, nil, false},
+		{"negative weight", admin.String(), []types.Member{{Address: member1.String(), Weight: "-1"}}, nil, false},
+		{"duplicate member", admin.String(), []types.Member{{Address: member1.String(), Weight: "1"}, {Address: member1.String(), Weight: "2"}}, nil, false},
+		{"metadata too long", admin.String(), nil, []byte(strings.Repeat("a", types.MaxMetadataLength+1)), false},
+	}
+	for _, tc := range tests {
+		msg := types.MsgCreateGroup{Admin: tc.admin, Members: tc.members, Metadata: tc.metadata}
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), tc.title)
+		} else {
+			require.Error(t, msg.ValidateBasic(), tc.title)
+		}
+	}
+}
+
+func TestMsgUpdateGroupMembers(t *testing.T) {
+	tests := []struct {
+		title      string
+		groupID    uint64
+		updates    []types.Member
+		expectPass bool
+	}{
+		{"valid", 1, []types.Member{{Address: member1.String(), Weight: "1"}}, true},
+		{"zero weight removes the member", 1, []types.Member{{Address: member1.String(), Weight: "0"}}, true},
+		{"no group id", 0, []types.Member{{Address: member1.String(), Weight: "1"}}, false},
+		{"no updates", 1, nil, false},
+		{"negative weight", 1, []types.Member{{Address: member1.String(), Weight: "-1"}}, false},
+	}
+	for _, tc := range tests {
+		msg := types.MsgUpdateGroupMembers{Admin: admin.String(), GroupId: tc.groupID, MemberUpdates: tc.updates}
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), tc.title)
+		} else {
+			require.Error(t, msg.ValidateBasic(), tc.title)
+		}
+	}
+}
+
+func TestMsgUpdateGroupAdmin(t *testing.T) {
+	msg := types.MsgUpdateGroupAdmin{Admin: admin.String(), GroupId: 1, NewAdmin: member1.String()}
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{admin}, msg.GetSigners())
+
+	msg.NewAdmin = admin.String()
+	require.Error(t, msg.ValidateBasic())
+}
+
+func TestMsgCreateGroupPolicy(t *testing.T) {
+	tests := []struct {
+		title      string
+		groupID    uint64
+		policy     types.DecisionPolicy
+		expectPass bool
+	}{
+		{"threshold policy", 1, types.NewThresholdDecisionPolicy("1", time.Hour), true},
+		{"percentage policy", 1, types.NewPercentageDecisionPolicy("0.5", time.Hour), true},
+		{"no group id", 0, types.NewThresholdDecisionPolicy("1", time.Hour), false},
+		{"zero threshold", 1, types.NewThresholdDecisionPolicy("0", time.Hour), false},
+		{"invalid threshold", 1, types.NewThresholdDecisionPolicy("one", time.Hour), false},
+		{"percentage above one", 1, types.NewPercentageDecisionPolicy("1.5", time.Hour), false},
+		{"zero timeout", 1, types.NewPercentageDecisionPolicy("0.5", 0), false},
+	}
+	for _, tc := range tests {
+		msg, err := types.NewMsgCreateGroupPolicy(admin, tc.groupID, nil, tc.policy)
+		require.NoError(t, err, tc.title)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), tc.title)
+			require.Equal(t, tc.policy, msg.GetDecisionPolicy(), tc.title)
+		} else {
+			require.Error(t, msg.ValidateBasic(), tc.title)
+		}
+	}
+}
+
+func TestMsgCreateProposal(t *testing.T) {
+	send := func(from sdk.AccAddress) sdk.ServiceMsg {
+		return sdk.ServiceMsg{
+			MethodName: "/cosmos.bank.v1beta1.Msg/Send",
+			Request:    banktypes.NewMsgSend(from, member1, coinsPos),
+		}
+	}
+
+	tests := []struct {
+		title      string
+		proposers  []string
+		msgs       []sdk.ServiceMsg
+		expectPass bool
+	}{
+		{"valid", []string{member1.String()}, []sdk.ServiceMsg{send(policy)}, true},
+		{"no msgs", []string{member1.String(), member2.String()}, nil, true},
+		{"no proposers", nil, []sdk.ServiceMsg{send(policy)}, false},
+		{"duplicate proposer", []string{member1.String(), member1.String()}, nil, false},
+		{"msg not signed by the group policy", []string{member1.String()}, []sdk.ServiceMsg{send(member1)}, false},
+	}
+	for _, tc := range tests {
+		msg, err := types.NewMsgCreateProposal(policy, tc.proposers, tc.msgs, nil)
+		require.NoError(t, err, tc.title)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), tc.title)
+			msgs, err := msg.GetServiceMsgs()
+			require.NoError(t, err)
+			require.Len(t, msgs, len(tc.msgs))
+			for i := range msgs {
+				require.Equal(t, tc.msgs[i], msgs[i])
+			}
+		} else {
+			require.Error(t, msg.ValidateBasic(), tc.title)
+		}
+	}
+}
+
+func TestMsgVote(t *testing.T) {
+	tests := []struct {
+		title      string
+		proposalID uint64
+		choice     types.VoteOption
+		expectPass bool
+	}{
+		{"valid", 1, types.VOTE_OPTION_YES, true},
+		{"no proposal id", 0, types.VOTE_OPTION_YES, false},
+		{"unspecified choice", 1, types.VOTE_OPTION_UNSPECIFIED, false},
+		{"unknown choice", 1, types.VoteOption(10), false},
+	}
+	for _, tc := range tests {
+		msg := types.MsgVote{ProposalId: tc.proposalID, Voter: member1.String(), Choice: tc.choice}
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), tc.title)
+		} else {
+			require.Error(t, msg.ValidateBasic(), tc.title)
+		}
+	}
+}
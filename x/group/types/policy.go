@@ -0,0 +1,211 @@
+package types
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DecisionPolicyResult is the result of the evaluation of a proposal by a
+// decision policy.
+type DecisionPolicyResult struct {
+	// Allow determines if the proposal is accepted.
+	Allow bool
+	// Final determines if the result can no longer change, in which case the
+	// proposal is closed.
+	Final bool
+}
+
+// DecisionPolicy is the persistent set of rules deciding on the result of the
+// proposals of a group policy.
+type DecisionPolicy interface {
+	codec.ProtoMarshaler
+
+	// GetTimeout returns the duration of the voting period.
+	GetTimeout() time.Duration
+	// Allow decides on a proposal given its tally, the total weight of the
+	// group and the time elapsed since the proposal was submitted.
+	Allow(tally Tally, totalWeight string, sinceSubmission time.Duration) (DecisionPolicyResult, error)
+	// ValidateBasic does a simple validation check of the decision policy.
+	ValidateBasic() error
+}
+
+var (
+	_ DecisionPolicy = &ThresholdDecisionPolicy{}
+	_ DecisionPolicy = &PercentageDecisionPolicy{}
+)
+
+// NewThresholdDecisionPolicy creates a new ThresholdDecisionPolicy.
+func NewThresholdDecisionPolicy(threshold string, timeout time.Duration) DecisionPolicy {
+	return &ThresholdDecisionPolicy{Threshold: threshold, Timeout: timeout}
+}
+
+// ValidateBasic implements DecisionPolicy.ValidateBasic
+func (p ThresholdDecisionPolicy) ValidateBasic() error {
+	if _, err := ParsePositiveDec(p.Threshold, "threshold"); err != nil {
+		return err
+	}
+	return validateTimeout(p.Timeout)
+}
+
+// Allow implements DecisionPolicy.Allow. A proposal is accepted once the
+// weight of the yes votes reaches the threshold, capped to the total weight of
+// the group, and rejected once that's no longer possible.
+func (p ThresholdDecisionPolicy) Allow(tally Tally, totalWeight string, sinceSubmission time.Duration) (DecisionPolicyResult, error) {
+	if sinceSubmission >= p.Timeout {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	threshold, err := ParsePositiveDec(p.Threshold, "threshold")
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	total, err := ParseNonNegativeDec(totalWeight, "total weight")
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	yes, undecided, err := tally.yesAndUndecided(total)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+
+	threshold = sdk.MinDec(threshold, total)
+	if yes.GTE(threshold) {
+		return DecisionPolicyResult{Allow: true, Final: true}, nil
+	}
+	if yes.Add(undecided).LT(threshold) {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	return DecisionPolicyResult{Allow: false, Final: false}, nil
+}
+
+// NewPercentageDecisionPolicy creates a new PercentageDecisionPolicy.
+func NewPercentageDecisionPolicy(percentage string, timeout time.Duration) DecisionPolicy {
+	return &PercentageDecisionPolicy{Percentage: percentage, Timeout: timeout}
+}
+
+// ValidateBasic implements DecisionPolicy.ValidateBasic
+func (p PercentageDecisionPolicy) ValidateBasic() error {
+	percentage, err := ParsePositiveDec(p.Percentage, "percentage")
+	if err != nil {
+		return err
+	}
+	if percentage.GT(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalid, "percentage must be at most 1, got %s", p.Percentage)
+	}
+	return validateTimeout(p.Timeout)
+}
+
+// Allow implements DecisionPolicy.Allow. A proposal is accepted once the share
+// of the total weight of the group voting yes reaches the percentage, and
+// rejected once that's no longer possible.
+func (p PercentageDecisionPolicy) Allow(tally Tally, totalWeight string, sinceSubmission time.Duration) (DecisionPolicyResult, error) {
+	if sinceSubmission >= p.Timeout {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	percentage, err := ParsePositiveDec(p.Percentage, "percentage")
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	total, err := ParseNonNegativeDec(totalWeight, "total weight")
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if total.IsZero() {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+	yes, undecided, err := tally.yesAndUndecided(total)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+
+	if yes.Quo(total).GTE(percentage) {
+		return DecisionPolicyResult{Allow: true, Final: true}, nil
+	}
+	if yes.Add(undecided).Quo(total).LT(percentage) {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	return DecisionPolicyResult{Allow: false, Final: false}, nil
+}
+
+func validateTimeout(timeout time.Duration) error {
+	if timeout <= 0 {
+		return sdkerrors.Wrapf(ErrInvalid, "timeout must be positive, got %s", timeout)
+	}
+	return nil
+}
+
+// NewTally returns a tally with all counts set to zero.
+func NewTally() Tally {
+	return Tally{YesCount: "0", NoCount: "0", AbstainCount: "0", VetoCount: "0"}
+}
+
+// TotalCounts returns the sum of the weights of all votes.
+func (t Tally) TotalCounts() (sdk.Dec, error) {
+	total := sdk.ZeroDec()
+	for _, c := range []struct{ count, field string }{
+		{t.YesCount, "yes count"},
+		{t.NoCount, "no count"},
+		{t.AbstainCount, "abstain count"},
+		{t.VetoCount, "veto count"},
+	} {
+		d, err := ParseNonNegativeDec(c.count, c.field)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		total = total.Add(d)
+	}
+	return total, nil
+}
+
+// Add adds the weight of a vote to the count of its choice.
+func (t *Tally) Add(choice VoteOption, weight string) error {
+	w, err := ParseNonNegativeDec(weight, "weight")
+	if err != nil {
+		return err
+	}
+
+	var count *string
+	switch choice {
+	case VOTE_OPTION_YES:
+		count = &t.YesCount
+	case VOTE_OPTION_NO:
+		count = &t.NoCount
+	case VOTE_OPTION_ABSTAIN:
+		count = &t.AbstainCount
+	case VOTE_OPTION_NO_WITH_VETO:
+		count = &t.VetoCount
+	default:
+		return sdkerrors.Wrapf(ErrInvalid, "unknown vote option %s", choice)
+	}
+
+	c, err := ParseNonNegativeDec(*count, "count")
+	if err != nil {
+		return err
+	}
+	*count = DecString(c.Add(w))
+	return nil
+}
+
+// yesAndUndecided returns the weight of the yes votes and the weight of the
+// members who haven't voted yet.
+func (t Tally) yesAndUndecided(totalWeight sdk.Dec) (yes sdk.Dec, undecided sdk.Dec, err error) {
+	yes, err = ParseNonNegativeDec(t.YesCount, "yes count")
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+	total, err := t.TotalCounts()
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+	undecided = totalWeight.Sub(total)
+	if undecided.IsNegative() {
+		undecided = sdk.ZeroDec()
+	}
+	return yes, undecided, nil
+}
@@ -0,0 +1,70 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+func TestThresholdDecisionPolicy(t *testing.T) {
+	policy := types.NewThresholdDecisionPolicy("3", time.Hour)
+
+	tests := []struct {
+		title           string
+		tally           types.Tally
+		totalWeight     string
+		sinceSubmission time.Duration
+		exp             types.DecisionPolicyResult
+	}{
+		{"threshold reached", types.Tally{YesCount: "3", NoCount: "1"}, "10", 0, types.DecisionPolicyResult{Allow: true, Final: true}},
+		{"threshold not reached yet", types.Tally{YesCount: "2"}, "10", 0, types.DecisionPolicyResult{Allow: false, Final: false}},
+		{"threshold no longer reachable", types.Tally{YesCount: "1", NoCount: "8"}, "10", 0, types.DecisionPolicyResult{Allow: false, Final: true}},
+		{"threshold capped to total weight", types.Tally{YesCount: "2"}, "2", 0, types.DecisionPolicyResult{Allow: true, Final: true}},
+		{"timed out", types.Tally{YesCount: "2"}, "10", time.Hour, types.DecisionPolicyResult{Allow: false, Final: true}},
+	}
+	for _, tc := range tests {
+		res, err := policy.Allow(tc.tally, tc.totalWeight, tc.sinceSubmission)
+		require.NoError(t, err, tc.title)
+		require.Equal(t, tc.exp, res, tc.title)
+	}
+}
+
+func TestPercentageDecisionPolicy(t *testing.T) {
+	policy := types.NewPercentageDecisionPolicy("0.5", time.Hour)
+
+	tests := []struct {
+		title           string
+		tally           types.Tally
+		totalWeight     string
+		sinceSubmission time.Duration
+		exp             types.DecisionPolicyResult
+	}{
+		{"percentage reached", types.Tally{YesCount: "5"}, "10", 0, types.DecisionPolicyResult{Allow: true, Final: true}},
+		{"percentage not reached yet", types.Tally{YesCount: "4", NoCount: "1"}, "10", 0, types.DecisionPolicyResult{Allow: false, Final: false}},
+		{"percentage no longer reachable", types.Tally{YesCount: "1", NoCount: "4", AbstainCount: "2"}, "10", 0, types.DecisionPolicyResult{Allow: false, Final: true}},
+		{"empty group", types.Tally{}, "0", 0, types.DecisionPolicyResult{Allow: false, Final: true}},
+		{"timed out", types.Tally{YesCount: "4"}, "10", 2 * time.Hour, types.DecisionPolicyResult{Allow: false, Final: true}},
+	}
+	for _, tc := range tests {
+		res, err := policy.Allow(tc.tally, tc.totalWeight, tc.sinceSubmission)
+		require.NoError(t, err, tc.title)
+		require.Equal(t, tc.exp, res, tc.title)
+	}
+}
+
+func TestTallyAdd(t *testing.T) {
+	tally := types.NewTally()
+	require.NoError(t, tally.Add(types.VOTE_OPTION_YES, "1.5"))
+	require.NoError(t, tally.Add(types.VOTE_OPTION_YES, "2"))
+	require.NoError(t, tally.Add(types.VOTE_OPTION_NO_WITH_VETO, "1"))
+	require.Error(t, tally.Add(types.VOTE_OPTION_UNSPECIFIED, "1"))
+	require.Error(t, tally.Add(types.VOTE_OPTION_NO, "-1"))
+
+	require.Equal(t, types.Tally{YesCount: "3.5", NoCount: "0", AbstainCount: "0", VetoCount: "1"}, tally)
+	total, err := tally.TotalCounts()
+	require.NoError(t, err)
+	require.Equal(t, "4.5", types.DecString(total))
+}
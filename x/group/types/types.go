@@ -0,0 +1,224 @@
+package types
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxMetadataLength is the maximum length of the metadata of groups, group
+// policies, members, proposals and votes.
+const MaxMetadataLength = 255
+
+var (
+	_ codectypes.UnpackInterfacesMessage = GroupPolicyInfo{}
+	_ codectypes.UnpackInterfacesMessage = Proposal{}
+)
+
+// ValidateBasic performs stateless validation of a member. A zero weight is
+// only valid if allowZeroWeight is set, as done for member updates where it
+// removes the member.
+func (m Member) ValidateBasic(allowZeroWeight bool) error {
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "member %s", m.Address)
+	}
+	if allowZeroWeight {
+		if _, err := ParseNonNegativeDec(m.Weight, "member weight"); err != nil {
+			return err
+		}
+	} else if _, err := ParsePositiveDec(m.Weight, "member weight"); err != nil {
+		return err
+	}
+	return validateMetadata(m.Metadata, "member")
+}
+
+// ValidateMembers performs stateless validation of a list of members, which
+// must have distinct addresses.
+func ValidateMembers(members []Member, allowZeroWeight bool) error {
+	seen := make(map[string]bool, len(members))
+	for _, m := range members {
+		if err := m.ValidateBasic(allowZeroWeight); err != nil {
+			return err
+		}
+		if seen[m.Address] {
+			return sdkerrors.Wrapf(ErrDuplicate, "member %s", m.Address)
+		}
+		seen[m.Address] = true
+	}
+	return nil
+}
+
+func validateMetadata(metadata []byte, description string) error {
+	if len(metadata) > MaxMetadataLength {
+		return sdkerrors.Wrapf(ErrMaxLimit, "%s metadata cannot be longer than %d bytes", description, MaxMetadataLength)
+	}
+	return nil
+}
+
+// VoteOptionFromString returns a VoteOption from a string. It returns an error
+// if the string is invalid.
+func VoteOptionFromString(str string) (VoteOption, error) {
+	option, ok := VoteOption_value[str]
+	if !ok || option == int32(VOTE_OPTION_UNSPECIFIED) {
+		return VOTE_OPTION_UNSPECIFIED, sdkerrors.Wrapf(ErrInvalid, "'%s' is not a valid vote option", str)
+	}
+	return VoteOption(option), nil
+}
+
+// NewGroupPolicyInfo creates a new GroupPolicyInfo.
+//nolint:interfacer
+func NewGroupPolicyInfo(address sdk.AccAddress, groupID uint64, admin sdk.AccAddress, metadata []byte,
+	version uint64, decisionPolicy DecisionPolicy) (GroupPolicyInfo, error) {
+	p := GroupPolicyInfo{
+		Address:  address.String(),
+		GroupId:  groupID,
+		Admin:    admin.String(),
+		Metadata: metadata,
+		Version:  version,
+	}
+
+	err := p.SetDecisionPolicy(decisionPolicy)
+	if err != nil {
+		return GroupPolicyInfo{}, err
+	}
+
+	return p, nil
+}
+
+// GetDecisionPolicy returns the unpacked decision policy of the group policy.
+func (p GroupPolicyInfo) GetDecisionPolicy() DecisionPolicy {
+	return decisionPolicyFromAny(p.DecisionPolicy)
+}
+
+// SetDecisionPolicy packs the decision policy of the group policy.
+func (p *GroupPolicyInfo) SetDecisionPolicy(decisionPolicy DecisionPolicy) error {
+	any, err := decisionPolicyToAny(decisionPolicy)
+	if err != nil {
+		return err
+	}
+	p.DecisionPolicy = any
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p GroupPolicyInfo) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var decisionPolicy DecisionPolicy
+	return unpacker.UnpackAny(p.DecisionPolicy, &decisionPolicy)
+}
+
+func decisionPolicyFromAny(any *codectypes.Any) DecisionPolicy {
+	decisionPolicy, ok := any.GetCachedValue().(DecisionPolicy)
+	if !ok {
+		return nil
+	}
+	return decisionPolicy
+}
+
+func decisionPolicyToAny(decisionPolicy DecisionPolicy) (*codectypes.Any, error) {
+	msg, ok := decisionPolicy.(proto.Message)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", decisionPolicy)
+	}
+	return codectypes.NewAnyWithValue(msg)
+}
+
+// GetServiceMsgs returns the unpacked messages of the proposal.
+func (p Proposal) GetServiceMsgs() ([]sdk.ServiceMsg, error) {
+	return serviceMsgsFromAnys(p.Msgs)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p Proposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackServiceMsgs(unpacker, p.Msgs)
+}
+
+// ServiceMsgsToAnys packs service messages into Anys whose type URL is the
+// method name of the message, as done for the messages of a tx.
+func ServiceMsgsToAnys(msgs []sdk.ServiceMsg) ([]*codectypes.Any, error) {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := codectypes.NewAnyWithValue(msg.Request)
+		if err != nil {
+			return nil, err
+		}
+		any.TypeUrl = msg.MethodName
+		anys[i] = any
+	}
+	return anys, nil
+}
+
+func serviceMsgsFromAnys(anys []*codectypes.Any) ([]sdk.ServiceMsg, error) {
+	msgs := make([]sdk.ServiceMsg, len(anys))
+	for i, any := range anys {
+		msgReq, ok := any.GetCachedValue().(sdk.MsgRequest)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "messages contains %T which is not a sdk.MsgRequest", any)
+		}
+		msgs[i] = sdk.ServiceMsg{
+			MethodName: any.TypeUrl,
+			Request:    msgReq,
+		}
+	}
+	return msgs, nil
+}
+
+func unpackServiceMsgs(unpacker codectypes.AnyUnpacker, anys []*codectypes.Any) error {
+	for _, any := range anys {
+		var msg sdk.MsgRequest
+		if err := unpacker.UnpackAny(any, &msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryGroupPolicyInfoResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if m.Info == nil {
+		return nil
+	}
+	return m.Info.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryGroupPoliciesByGroupResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackGroupPolicies(unpacker, m.GroupPolicies)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryGroupPoliciesByAdminResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackGroupPolicies(unpacker, m.GroupPolicies)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryProposalResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if m.Proposal == nil {
+		return nil
+	}
+	return m.Proposal.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryProposalsByGroupPolicyResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackProposals(unpacker, m.Proposals)
+}
+
+func unpackGroupPolicies(unpacker codectypes.AnyUnpacker, policies []*GroupPolicyInfo) error {
+	for _, p := range policies {
+		if err := p.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func unpackProposals(unpacker codectypes.AnyUnpacker, proposals []*Proposal) error {
+	for _, p := range proposals {
+		if err := p.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
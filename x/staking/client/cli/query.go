@@ -39,6 +39,9 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryHistoricalInfo(),
 		GetCmdQueryParams(),
 		GetCmdQueryPool(),
+		GetCmdQueryTokenizeShareRecordByID(),
+		GetCmdQueryTokenizeShareRecordsOwned(),
+		GetCmdQueryTotalLiquidStaked(),
 	)
 
 	return stakingQueryCmd
@@ -745,3 +748,126 @@ $ %s query staking params
 
 	return cmd
 }
+
+// GetCmdQueryTokenizeShareRecordByID implements the query command for a
+// tokenize share record by id.
+func GetCmdQueryTokenizeShareRecordByID() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tokenize-share-record [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a tokenize share record by id",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a tokenize share record by id.
+
+Example:
+$ %s query staking tokenize-share-record 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid tokenize share record id %s: %w", args[0], err)
+			}
+
+			res, err := queryClient.TokenizeShareRecordById(cmd.Context(), &types.QueryTokenizeShareRecordByIdRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Record)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTokenizeShareRecordsOwned implements the query command for the
+// tokenize share records owned by an address.
+func GetCmdQueryTokenizeShareRecordsOwned() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "tokenize-share-records-owned [owner]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the tokenize share records owned by an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the tokenize share records owned by an address.
+
+Example:
+$ %s query staking tokenize-share-records-owned %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TokenizeShareRecordsOwned(cmd.Context(), &types.QueryTokenizeShareRecordsOwnedRequest{Owner: owner.String()})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTotalLiquidStaked implements the query command for the amount of
+// tokens delegated through tokenize share records.
+func GetCmdQueryTotalLiquidStaked() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-liquid-staked",
+		Args:  cobra.NoArgs,
+		Short: "Query the total amount of liquid staked tokens",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the total amount of tokens delegated through tokenize share records.
+
+Example:
+$ %s query staking total-liquid-staked
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TotalLiquidStaked(cmd.Context(), &types.QueryTotalLiquidStakedRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
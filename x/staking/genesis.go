@@ -97,6 +97,22 @@ func InitGenesis(
 		}
 	}
 
+	for _, record := range data.TokenizeShareRecords {
+		keeper.SetTokenizeShareRecord(ctx, record)
+
+		valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+		if err != nil {
+			panic(err)
+		}
+
+		// the liquid shares of a validator are the shares delegated by its record accounts
+		if delegation, found := keeper.GetDelegation(ctx, record.GetModuleAddress(), valAddr); found {
+			keeper.SetValidatorLiquidShares(ctx, valAddr, keeper.GetValidatorLiquidShares(ctx, valAddr).Add(delegation.Shares))
+		}
+	}
+
+	keeper.SetLastTokenizeShareRecordID(ctx, data.LastTokenizeShareRecordId)
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -190,6 +206,9 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		UnbondingDelegations: unbondingDelegations,
 		Redelegations:        redelegations,
 		Exported:             true,
+
+		TokenizeShareRecords:      keeper.GetAllTokenizeShareRecords(ctx),
+		LastTokenizeShareRecordId: keeper.GetLastTokenizeShareRecordID(ctx),
 	}
 }
 
@@ -225,6 +244,10 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateTokenizeShareRecords(data.TokenizeShareRecords, data.LastTokenizeShareRecordId); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
@@ -260,3 +283,25 @@ func validateGenesisStateValidators(validators []types.Validator) error {
 
 	return nil
 }
+
+func validateGenesisStateTokenizeShareRecords(records []types.TokenizeShareRecord, lastID uint64) error {
+	ids := make(map[uint64]bool, len(records))
+
+	for _, record := range records {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+
+		if ids[record.Id] {
+			return fmt.Errorf("duplicate tokenize share record in genesis state: id %d", record.Id)
+		}
+
+		if record.Id > lastID {
+			return fmt.Errorf("tokenize share record id %d is greater than the last tokenize share record id %d", record.Id, lastID)
+		}
+
+		ids[record.Id] = true
+	}
+
+	return nil
+}
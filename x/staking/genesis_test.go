@@ -211,6 +211,7 @@ func TestValidateGenesis(t *testing.T) {
 	genValidators1[0] = teststaking.NewValidator(t, sdk.ValAddress(pk.Address()), pk)
 	genValidators1[0].Tokens = sdk.OneInt()
 	genValidators1[0].DelegatorShares = sdk.OneDec()
+	genRecord := types.NewTokenizeShareRecord(1, sdk.AccAddress(pk.Address()), sdk.ValAddress(pk.Address()))
 
 	tests := []struct {
 		name    string
@@ -232,6 +233,24 @@ func TestValidateGenesis(t *testing.T) {
 			data.Validators[0].Jailed = true
 			data.Validators[0].Status = types.Bonded
 		}, true},
+		// validate genesis tokenize share records
+		{"tokenize share record", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{genRecord}
+			data.LastTokenizeShareRecordId = 1
+		}, false},
+		{"duplicate tokenize share record", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{genRecord, genRecord}
+			data.LastTokenizeShareRecordId = 1
+		}, true},
+		{"tokenize share record id greater than last id", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{genRecord}
+		}, true},
+		{"invalid tokenize share record account", func(data *types.GenesisState) {
+			record := genRecord
+			record.ModuleAccount = "tokenizeshare_2"
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{record}
+			data.LastTokenizeShareRecordId = 1
+		}, true},
 	}
 
 	for _, tt := range tests {
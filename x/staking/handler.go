@@ -34,6 +34,18 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.Undelegate(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgCancelUnbondingDelegation:
+			res, err := msgServer.CancelUnbondingDelegation(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgTokenizeShares:
+			res, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgRedeemTokensForShares:
+			res, err := msgServer.RedeemTokensForShares(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
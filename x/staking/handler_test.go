@@ -382,6 +382,70 @@ func TestEditValidatorDecreaseMinSelfDelegation(t *testing.T) {
 	tstaking.Handle(msgEditValidator, false)
 }
 
+func TestCreateValidatorBelowMinimums(t *testing.T) {
+	initPower := int64(100)
+	initBond := sdk.TokensFromConsensusPower(100)
+	app, ctx, _, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 1, sdk.TokensFromConsensusPower(initPower))
+
+	validatorAddr := valAddrs[0]
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+	params.MinSelfDelegationFloor = sdk.NewInt(2)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// commission rate below the min commission rate
+	tstaking.Commission = types.NewCommissionRates(sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(1, 1))
+	msgCreateValidator := tstaking.CreateValidatorMsg(validatorAddr, PKs[0], initBond)
+	msgCreateValidator.MinSelfDelegation = sdk.NewInt(2)
+	tstaking.Handle(msgCreateValidator, false)
+
+	// min self delegation below the min self delegation floor
+	tstaking.Commission = types.NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(1, 1))
+	msgCreateValidator = tstaking.CreateValidatorMsg(validatorAddr, PKs[0], initBond)
+	tstaking.Handle(msgCreateValidator, false)
+
+	// both at the minimums
+	msgCreateValidator.MinSelfDelegation = sdk.NewInt(2)
+	tstaking.Handle(msgCreateValidator, true)
+}
+
+func TestEditValidatorBelowMinimums(t *testing.T) {
+	initPower := int64(100)
+	initBond := sdk.TokensFromConsensusPower(100)
+	app, ctx, _, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 1, sdk.TokensFromConsensusPower(initPower))
+
+	validatorAddr := valAddrs[0]
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// create validator
+	tstaking.Commission = types.NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(1, 1))
+	msgCreateValidator := tstaking.CreateValidatorMsg(validatorAddr, PKs[0], initBond)
+	msgCreateValidator.MinSelfDelegation = sdk.NewInt(2)
+	tstaking.Handle(msgCreateValidator, true)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+	params.MinSelfDelegationFloor = sdk.NewInt(5)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// commission rate below the min commission rate
+	newRate := sdk.NewDecWithPrec(1, 2)
+	msgEditValidator := types.NewMsgEditValidator(validatorAddr, types.Description{}, &newRate, nil)
+	tstaking.Handle(msgEditValidator, false)
+
+	// min self delegation increased, but below the min self delegation floor
+	newMinSelfDelegation := sdk.NewInt(3)
+	msgEditValidator = types.NewMsgEditValidator(validatorAddr, types.Description{}, nil, &newMinSelfDelegation)
+	tstaking.Handle(msgEditValidator, false)
+
+	// min self delegation at the min self delegation floor
+	newMinSelfDelegation = sdk.NewInt(5)
+	msgEditValidator = types.NewMsgEditValidator(validatorAddr, types.Description{}, nil, &newMinSelfDelegation)
+	tstaking.Handle(msgEditValidator, true)
+}
+
 func TestEditValidatorIncreaseMinSelfDelegationBeyondCurrentBond(t *testing.T) {
 	initPower := int64(100)
 	initBond := sdk.TokensFromConsensusPower(100)
@@ -1028,6 +1092,81 @@ func TestMultipleUnbondingDelegationAtUniqueTimes(t *testing.T) {
 	require.False(t, found)
 }
 
+func TestCancelUnbondingDelegation(t *testing.T) {
+	initPower := int64(1000)
+	app, ctx, delAddrs, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 2, sdk.TokensFromConsensusPower(initPower))
+	valAddr, delAddr := valAddrs[0], delAddrs[1]
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// set the unbonding time
+	params := app.StakingKeeper.GetParams(ctx)
+	params.UnbondingTime = 10 * time.Second
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// create the validator and a delegation to it
+	tstaking.CreateValidatorWithValPower(valAddr, PKs[0], 10, true)
+	delTokens := sdk.TokensFromConsensusPower(10)
+	tstaking.Delegate(delAddr, valAddr, delTokens)
+
+	// end block to bond
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// begin an unbonding delegation
+	creationHeight := int64(5)
+	ctx = ctx.WithBlockHeight(creationHeight)
+	tstaking.Ctx = ctx
+	tstaking.Undelegate(delAddr, valAddr, delTokens, true)
+	tstaking.CheckDelegator(delAddr, valAddr, false)
+
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	completionTime := ubd.Entries[0].CompletionTime
+
+	bondedPoolBalance := app.BankKeeper.GetBalance(ctx, app.StakingKeeper.GetBondedPool(ctx).GetAddress(), params.BondDenom)
+	notBondedPoolBalance := app.BankKeeper.GetBalance(ctx, app.StakingKeeper.GetNotBondedPool(ctx).GetAddress(), params.BondDenom)
+
+	quarter := sdk.NewCoin(params.BondDenom, delTokens.QuoRaw(4))
+	threeQuarters := sdk.NewCoin(params.BondDenom, delTokens.Sub(quarter.Amount))
+
+	// invalid denom, unknown entry and amount above the entry balance
+	tstaking.Handle(types.NewMsgCancelUnbondingDelegation(delAddr, valAddr, creationHeight, sdk.NewCoin("churros", quarter.Amount)), false)
+	tstaking.Handle(types.NewMsgCancelUnbondingDelegation(delAddr, valAddr, creationHeight+1, quarter), false)
+	tstaking.Handle(types.NewMsgCancelUnbondingDelegation(delAddr, valAddr, creationHeight, sdk.NewCoin(params.BondDenom, delTokens.AddRaw(1))), false)
+
+	// cancel part of the entry
+	tstaking.Handle(types.NewMsgCancelUnbondingDelegation(delAddr, valAddr, creationHeight, quarter), true)
+	tstaking.CheckDelegator(delAddr, valAddr, true)
+
+	ubd, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, threeQuarters.Amount, ubd.Entries[0].Balance)
+	require.Len(t, app.StakingKeeper.GetUBDQueueTimeSlice(ctx, completionTime), 1)
+
+	// the tokens are delegated back to the bonded validator
+	require.Equal(t, bondedPoolBalance.Add(quarter), app.BankKeeper.GetBalance(ctx, app.StakingKeeper.GetBondedPool(ctx).GetAddress(), params.BondDenom))
+	require.Equal(t, notBondedPoolBalance.Sub(quarter), app.BankKeeper.GetBalance(ctx, app.StakingKeeper.GetNotBondedPool(ctx).GetAddress(), params.BondDenom))
+
+	// cancel the rest of the entry, which removes it from the unbonding queue
+	tstaking.Handle(types.NewMsgCancelUnbondingDelegation(delAddr, valAddr, creationHeight, threeQuarters), true)
+
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	require.False(t, found)
+	require.Empty(t, app.StakingKeeper.GetUBDQueueTimeSlice(ctx, completionTime))
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	validator := tstaking.CheckValidator(valAddr, types.Bonded, false)
+	require.Equal(t, delTokens, validator.TokensFromShares(delegation.Shares).TruncateInt())
+
+	// nothing is left to cancel once the entry has been cancelled or has matured
+	tstaking.Handle(types.NewMsgCancelUnbondingDelegation(delAddr, valAddr, creationHeight, quarter), false)
+	tstaking.Undelegate(delAddr, valAddr, quarter.Amount, true)
+	tstaking.TurnBlockTimeDiff(10 * time.Second)
+	tstaking.Handle(types.NewMsgCancelUnbondingDelegation(delAddr, valAddr, creationHeight, quarter), false)
+}
+
 func TestUnbondingWhenExcessValidators(t *testing.T) {
 	initPower := int64(1000)
 	app, ctx, _, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 3, sdk.TokensFromConsensusPower(initPower))
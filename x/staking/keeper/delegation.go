@@ -3,6 +3,7 @@ package keeper
 import (
 	"bytes"
 	"fmt"
+	"math"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -285,6 +286,26 @@ func (k Keeper) InsertUBDQueue(ctx sdk.Context, ubd types.UnbondingDelegation,
 	}
 }
 
+// removeUBDQueueEntry removes one occurrence of an unbonding delegation from the
+// timeslice at completionTime in the unbonding queue, which holds one occurrence
+// per entry, and deletes the timeslice if it becomes empty.
+func (k Keeper) removeUBDQueueEntry(ctx sdk.Context, ubd types.UnbondingDelegation, completionTime time.Time) {
+	timeSlice := k.GetUBDQueueTimeSlice(ctx, completionTime)
+	for i, dvPair := range timeSlice {
+		if dvPair.DelegatorAddress == ubd.DelegatorAddress && dvPair.ValidatorAddress == ubd.ValidatorAddress {
+			timeSlice = append(timeSlice[:i], timeSlice[i+1:]...)
+			break
+		}
+	}
+
+	if len(timeSlice) == 0 {
+		store := ctx.KVStore(k.storeKey)
+		store.Delete(types.GetUnbondingDelegationTimeKey(completionTime))
+	} else {
+		k.SetUBDQueueTimeSlice(ctx, completionTime, timeSlice)
+	}
+}
+
 // Returns all the unbonding queue timeslices from time 0 until endTime
 func (k Keeper) UBDQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
 	store := ctx.KVStore(k.storeKey)
@@ -800,6 +821,208 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress, valAd
 	return balances, nil
 }
 
+// CancelUnbondingDelegation delegates amount of the unbonding delegation entry
+// created at creationHeight back to the validator. The entry keeps unbonding
+// the rest of its balance, and is removed from the unbonding delegation and the
+// unbonding queue once its whole balance has been delegated back.
+func (k Keeper) CancelUnbondingDelegation(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, creationHeight int64, amount sdk.Int,
+) error {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound
+	}
+
+	if validator.IsJailed() {
+		return types.ErrValidatorJailed
+	}
+
+	ubd, found := k.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return types.ErrNoUnbondingDelegation
+	}
+
+	// mature entries are completed by the EndBlocker of this block
+	entryIndex := -1
+	for i, entry := range ubd.Entries {
+		if entry.CreationHeight == creationHeight && !entry.IsMature(ctx.BlockHeader().Time) {
+			entryIndex = i
+			break
+		}
+	}
+
+	if entryIndex == -1 {
+		return sdkerrors.Wrapf(types.ErrNoUnbondingDelegationEntry, "creation height %d", creationHeight)
+	}
+
+	entry := ubd.Entries[entryIndex]
+	if amount.GT(entry.Balance) {
+		return sdkerrors.Wrapf(types.ErrBadCancelUnbondingAmount, "%s > %s", amount, entry.Balance)
+	}
+
+	// the unbonding tokens are held by the not bonded pool
+	if _, err := k.Delegate(ctx, delAddr, amount, types.Unbonding, validator, false); err != nil {
+		return err
+	}
+
+	entry.Balance = entry.Balance.Sub(amount)
+	entry.InitialBalance = entry.InitialBalance.Sub(amount)
+	if entry.Balance.IsZero() {
+		ubd.RemoveEntry(int64(entryIndex))
+		k.removeUBDQueueEntry(ctx, ubd, entry.CompletionTime)
+	} else {
+		ubd.Entries[entryIndex] = entry
+	}
+
+	// set the unbonding delegation or remove it if there are no more entries
+	if len(ubd.Entries) == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubd)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubd)
+	}
+
+	return nil
+}
+
+// TransferUnbonding moves at most wantAmt of the unbonding tokens of fromAddr
+// on the given validator to toAddr, preserving the creation height and
+// completion time of every entry so that the tokens remain slashable and
+// mature exactly as before. It returns the amount actually transferred, which
+// may be less than wantAmt if the recipient runs out of unbonding entries.
+func (k Keeper) TransferUnbonding(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantAmt sdk.Int,
+) sdk.Int {
+	transferred := sdk.ZeroInt()
+
+	ubdFrom, found := k.GetUnbondingDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return transferred
+	}
+
+	ubdTo, found := k.GetUnbondingDelegation(ctx, toAddr, valAddr)
+	if !found {
+		ubdTo = types.UnbondingDelegation{
+			DelegatorAddress: toAddr.String(),
+			ValidatorAddress: valAddr.String(),
+		}
+	}
+
+	maxEntries := int(k.MaxEntries(ctx))
+
+	for i := 0; i < len(ubdFrom.Entries) && wantAmt.GT(transferred); i++ {
+		if len(ubdTo.Entries) >= maxEntries {
+			break
+		}
+
+		entry := ubdFrom.Entries[i]
+		toXfer := sdk.MinInt(entry.Balance, wantAmt.Sub(transferred))
+		if !toXfer.IsPositive() {
+			continue
+		}
+
+		ubdTo.AddEntry(entry.CreationHeight, entry.CompletionTime, toXfer)
+		k.InsertUBDQueue(ctx, ubdTo, entry.CompletionTime)
+
+		entry.Balance = entry.Balance.Sub(toXfer)
+		entry.InitialBalance = entry.InitialBalance.Sub(toXfer)
+		if entry.Balance.IsZero() {
+			ubdFrom.RemoveEntry(int64(i))
+			k.removeUBDQueueEntry(ctx, ubdFrom, entry.CompletionTime)
+			i--
+		} else {
+			ubdFrom.Entries[i] = entry
+		}
+
+		transferred = transferred.Add(toXfer)
+	}
+
+	if transferred.IsZero() {
+		return transferred
+	}
+
+	// set the unbonding delegations or remove the sender's one if it has no more entries
+	if len(ubdFrom.Entries) == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubdFrom)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubdFrom)
+	}
+	k.SetUnbondingDelegation(ctx, ubdTo)
+
+	return transferred
+}
+
+// TransferDelegation moves at most wantShares of the delegation of fromAddr on
+// the given validator to toAddr, without unbonding the underlying tokens. Shares
+// received through a redelegation that has not completed yet remain slashable
+// for the source validator and are never transferred. It returns the number of
+// shares actually transferred.
+func (k Keeper) TransferDelegation(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantShares sdk.Dec,
+) sdk.Dec {
+	if !wantShares.IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.ZeroDec()
+	}
+
+	delFrom, found := k.GetDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return sdk.ZeroDec()
+	}
+
+	available := delFrom.Shares
+	for _, red := range k.GetRedelegations(ctx, fromAddr, math.MaxUint16) {
+		if red.ValidatorDstAddress != valAddr.String() {
+			continue
+		}
+
+		for _, entry := range red.Entries {
+			available = available.Sub(entry.SharesDst)
+		}
+	}
+
+	transferred := sdk.MinDec(wantShares, available)
+	if !transferred.IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	// update the sender's delegation
+	k.BeforeDelegationSharesModified(ctx, fromAddr, valAddr)
+	delFrom.Shares = delFrom.Shares.Sub(transferred)
+
+	// If the sender is the operator of the validator and the transfer decreases
+	// its self-delegation below the minimum, we jail the validator.
+	if fromAddr.Equals(validator.GetOperator()) && !validator.Jailed &&
+		validator.TokensFromShares(delFrom.Shares).TruncateInt().LT(validator.MinSelfDelegation) {
+		k.jailValidator(ctx, validator)
+	}
+
+	if delFrom.Shares.IsZero() {
+		k.RemoveDelegation(ctx, delFrom)
+	} else {
+		k.SetDelegation(ctx, delFrom)
+		k.AfterDelegationModified(ctx, fromAddr, valAddr)
+	}
+
+	// update the recipient's delegation
+	delTo, found := k.GetDelegation(ctx, toAddr, valAddr)
+	if found {
+		k.BeforeDelegationSharesModified(ctx, toAddr, valAddr)
+	} else {
+		delTo = types.NewDelegation(toAddr, valAddr, sdk.ZeroDec())
+		k.BeforeDelegationCreated(ctx, toAddr, valAddr)
+	}
+
+	delTo.Shares = delTo.Shares.Add(transferred)
+	k.SetDelegation(ctx, delTo)
+	k.AfterDelegationModified(ctx, toAddr, valAddr)
+
+	return transferred
+}
+
 // begin unbonding / redelegation; create a redelegation record
 func (k Keeper) BeginRedelegation(
 	ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress, sharesAmount sdk.Dec,
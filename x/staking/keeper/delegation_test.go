@@ -978,3 +978,113 @@ func TestRedelegateFromUnbondedValidator(t *testing.T) {
 	red, found := app.StakingKeeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
 	require.False(t, found, "%v", red)
 }
+
+func TestTransferUnbonding(t *testing.T) {
+	_, app, ctx := createTestInput()
+
+	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(delAddrs)
+
+	ubd := types.NewUnbondingDelegation(delAddrs[0], valAddrs[0], 10, time.Unix(100, 0).UTC(), sdk.NewInt(30))
+	ubd.AddEntry(11, time.Unix(200, 0).UTC(), sdk.NewInt(20))
+	app.StakingKeeper.SetUnbondingDelegation(ctx, ubd)
+	for _, entry := range ubd.Entries {
+		app.StakingKeeper.InsertUBDQueue(ctx, ubd, entry.CompletionTime)
+	}
+
+	// nothing to transfer from a delegator without unbonding delegations
+	transferred := app.StakingKeeper.TransferUnbonding(ctx, delAddrs[1], delAddrs[0], valAddrs[0], sdk.NewInt(10))
+	require.True(t, transferred.IsZero())
+
+	// transfer the first entry and part of the second one
+	transferred = app.StakingKeeper.TransferUnbonding(ctx, delAddrs[0], delAddrs[1], valAddrs[0], sdk.NewInt(35))
+	require.Equal(t, sdk.NewInt(35), transferred)
+
+	ubdFrom, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.True(t, found)
+	require.Len(t, ubdFrom.Entries, 1)
+	require.Equal(t, int64(11), ubdFrom.Entries[0].CreationHeight)
+	require.Equal(t, sdk.NewInt(15), ubdFrom.Entries[0].Balance)
+
+	ubdTo, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[1], valAddrs[0])
+	require.True(t, found)
+	require.Len(t, ubdTo.Entries, 2)
+	require.Equal(t, int64(10), ubdTo.Entries[0].CreationHeight)
+	require.True(t, ubdTo.Entries[0].CompletionTime.Equal(time.Unix(100, 0)))
+	require.Equal(t, sdk.NewInt(30), ubdTo.Entries[0].Balance)
+	require.Equal(t, int64(11), ubdTo.Entries[1].CreationHeight)
+	require.True(t, ubdTo.Entries[1].CompletionTime.Equal(time.Unix(200, 0)))
+	require.Equal(t, sdk.NewInt(5), ubdTo.Entries[1].Balance)
+
+	// the transferred entries are queued for completion at their original time,
+	// and the fully transferred entry of the sender is no longer queued
+	fromPair := types.DVPair{DelegatorAddress: delAddrs[0].String(), ValidatorAddress: valAddrs[0].String()}
+	toPair := types.DVPair{DelegatorAddress: delAddrs[1].String(), ValidatorAddress: valAddrs[0].String()}
+	require.Equal(t, []types.DVPair{toPair}, app.StakingKeeper.GetUBDQueueTimeSlice(ctx, time.Unix(100, 0).UTC()))
+	require.Equal(t, []types.DVPair{fromPair, toPair}, app.StakingKeeper.GetUBDQueueTimeSlice(ctx, time.Unix(200, 0).UTC()))
+
+	// requesting more than available only transfers the remaining balance
+	transferred = app.StakingKeeper.TransferUnbonding(ctx, delAddrs[0], delAddrs[1], valAddrs[0], sdk.NewInt(100))
+	require.Equal(t, sdk.NewInt(15), transferred)
+
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.False(t, found)
+	require.Equal(t, []types.DVPair{toPair, toPair}, app.StakingKeeper.GetUBDQueueTimeSlice(ctx, time.Unix(200, 0).UTC()))
+
+	// only the entries of the receiver mature
+	matureTime := time.Unix(200, 0)
+	mature := app.StakingKeeper.DequeueAllMatureUBDQueue(ctx.WithBlockTime(matureTime), matureTime)
+	require.Equal(t, []types.DVPair{toPair, toPair, toPair}, mature)
+}
+
+func TestTransferDelegation(t *testing.T) {
+	_, app, ctx := createTestInput()
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(10000))
+	addrVals := simapp.ConvertAddrsToValAddrs(addrDels)
+
+	// create a validator with a self-delegation and a delegation from addrDels[1]
+	validator := teststaking.NewValidator(t, addrVals[0], PKs[0])
+	validator.MinSelfDelegation = sdk.NewInt(10)
+	validator, selfShares := validator.AddTokensFromDel(sdk.NewInt(20))
+	validator, delShares := validator.AddTokensFromDel(sdk.NewInt(50))
+	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(addrDels[0], addrVals[0], selfShares))
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(addrDels[1], addrVals[0], delShares))
+
+	// nothing to transfer from a delegator without a delegation
+	transferred := app.StakingKeeper.TransferDelegation(ctx, addrDels[2], addrDels[1], addrVals[0], sdk.NewDec(10))
+	require.True(t, transferred.IsZero())
+
+	// transfer part of the delegation
+	transferred = app.StakingKeeper.TransferDelegation(ctx, addrDels[1], addrDels[2], addrVals[0], sdk.NewDec(30))
+	require.Equal(t, sdk.NewDec(30), transferred)
+
+	delFrom, found := app.StakingKeeper.GetDelegation(ctx, addrDels[1], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(20), delFrom.Shares)
+	delTo, found := app.StakingKeeper.GetDelegation(ctx, addrDels[2], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(30), delTo.Shares)
+
+	// the validator tokens are left untouched
+	validator, found = app.StakingKeeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(70), validator.Tokens)
+
+	// requesting more than available only transfers the remaining shares
+	transferred = app.StakingKeeper.TransferDelegation(ctx, addrDels[1], addrDels[2], addrVals[0], sdk.NewDec(100))
+	require.Equal(t, sdk.NewDec(20), transferred)
+	_, found = app.StakingKeeper.GetDelegation(ctx, addrDels[1], addrVals[0])
+	require.False(t, found)
+	delTo, found = app.StakingKeeper.GetDelegation(ctx, addrDels[2], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(50), delTo.Shares)
+
+	// transferring the self-delegation below the minimum jails the validator
+	transferred = app.StakingKeeper.TransferDelegation(ctx, addrDels[0], addrDels[2], addrVals[0], sdk.NewDec(15))
+	require.Equal(t, sdk.NewDec(15), transferred)
+	validator, found = app.StakingKeeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	require.True(t, validator.Jailed)
+}
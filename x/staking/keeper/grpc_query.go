@@ -473,6 +473,49 @@ func (k Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
+// TokenizeShareRecordById queries a tokenize share record by its id
+func (k Querier) TokenizeShareRecordById(c context.Context, req *types.QueryTokenizeShareRecordByIdRequest) (*types.QueryTokenizeShareRecordByIdResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	record, found := k.GetTokenizeShareRecord(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "tokenize share record %d not found", req.Id)
+	}
+
+	return &types.QueryTokenizeShareRecordByIdResponse{Record: record}, nil
+}
+
+// TokenizeShareRecordsOwned queries the tokenize share records owned by an address
+func (k Querier) TokenizeShareRecordsOwned(c context.Context, req *types.QueryTokenizeShareRecordsOwnedRequest) (*types.QueryTokenizeShareRecordsOwnedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Owner == "" {
+		return nil, status.Error(codes.InvalidArgument, "owner address cannot be empty")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	records := k.GetTokenizeShareRecordsByOwner(ctx, owner)
+
+	return &types.QueryTokenizeShareRecordsOwnedResponse{Records: records}, nil
+}
+
+// TotalLiquidStaked queries the amount of tokens delegated through tokenize share records
+func (k Querier) TotalLiquidStaked(c context.Context, _ *types.QueryTotalLiquidStakedRequest) (*types.QueryTotalLiquidStakedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryTotalLiquidStakedResponse{Tokens: k.GetTotalLiquidStakedTokens(ctx)}, nil
+}
+
 func queryRedelegation(ctx sdk.Context, k Querier, req *types.QueryRedelegationsRequest) (redels types.Redelegations, err error) {
 
 	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// TokenizeShares moves the shares worth amount of the delegation of delAddr to
+// the record account of a new tokenize share record owned by owner, and mints
+// share tokens to delAddr in a 1:1 ratio with the tokenized shares. The tokens
+// are not unbonded, and only whole shares are tokenized.
+//
+// Vesting accounts cannot tokenize their delegations, as the share tokens would
+// escape the vesting schedule, and neither can shares received through a
+// redelegation which has not completed yet, as they are slashable for the
+// source validator.
+func (k Keeper) TokenizeShares(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Int, owner sdk.AccAddress,
+) (sdk.Coin, error) {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidatorFound
+	}
+
+	if acc := k.authKeeper.GetAccount(ctx, delAddr); acc != nil {
+		if _, ok := acc.(vestexported.VestingAccount); ok {
+			return sdk.Coin{}, types.ErrTokenizeSharesVestingAccount
+		}
+	}
+
+	if k.HasReceivingRedelegation(ctx, delAddr, valAddr) {
+		return sdk.Coin{}, types.ErrTokenizeSharesRedelegation
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	shares = shares.TruncateDec()
+	if !shares.IsPositive() {
+		return sdk.Coin{}, types.ErrTinyTokenizeSharesAmount
+	}
+
+	if err := k.checkLiquidStakingCaps(ctx, validator, shares); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	id := k.GetLastTokenizeShareRecordID(ctx) + 1
+	record := types.NewTokenizeShareRecord(id, owner, valAddr)
+	k.SetTokenizeShareRecord(ctx, record)
+	k.SetLastTokenizeShareRecordID(ctx, id)
+
+	if transferred := k.TransferDelegation(ctx, delAddr, record.GetModuleAddress(), valAddr, shares); !transferred.Equal(shares) {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrNotEnoughDelegationShares, "%s < %s", transferred, shares)
+	}
+	k.SetValidatorLiquidShares(ctx, valAddr, k.GetValidatorLiquidShares(ctx, valAddr).Add(shares))
+
+	shareToken := sdk.NewCoin(record.GetShareTokenDenom(), shares.TruncateInt())
+	coins := sdk.NewCoins(shareToken)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, coins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return shareToken, nil
+}
+
+// RedeemTokensForShares burns the share tokens of delAddr and moves the shares
+// they represent from the record account to the delegation of delAddr. It
+// returns the amount of bond denom tokens the redeemed shares are worth.
+//
+// Once all the shares of a record are redeemed, the rewards withdrawn to its
+// record account are sent to its owner and the record is deleted.
+func (k Keeper) RedeemTokensForShares(ctx sdk.Context, delAddr sdk.AccAddress, shareToken sdk.Coin) (sdk.Coin, error) {
+	record, found := k.GetTokenizeShareRecordByDenom(ctx, shareToken.Denom)
+	if !found {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrTokenizeShareRecordNotExists, "denom %s", shareToken.Denom)
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, delAddr, shareToken.Denom)
+	if balance.Amount.LT(shareToken.Amount) {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInsufficientShareTokens, "%s < %s", balance, shareToken)
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidatorFound
+	}
+
+	coins := sdk.NewCoins(shareToken)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.ModuleName, coins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	recordAddr := record.GetModuleAddress()
+	shares := shareToken.Amount.ToDec()
+	if transferred := k.TransferDelegation(ctx, recordAddr, delAddr, valAddr, shares); !transferred.Equal(shares) {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrNotEnoughDelegationShares, "%s < %s", transferred, shares)
+	}
+
+	k.SetValidatorLiquidShares(ctx, valAddr, k.GetValidatorLiquidShares(ctx, valAddr).Sub(shares))
+
+	if _, found := k.GetDelegation(ctx, recordAddr, valAddr); !found {
+		owner, err := sdk.AccAddressFromBech32(record.Owner)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+
+		if rewards := k.bankKeeper.GetAllBalances(ctx, recordAddr); !rewards.IsZero() {
+			if err := k.bankKeeper.SendCoins(ctx, recordAddr, owner, rewards); err != nil {
+				return sdk.Coin{}, err
+			}
+		}
+
+		k.DeleteTokenizeShareRecord(ctx, record)
+	}
+
+	return sdk.NewCoin(k.BondDenom(ctx), validator.TokensFromShares(shares).TruncateInt()), nil
+}
+
+// checkLiquidStakingCaps returns an error if tokenizing shares of the validator
+// exceeds the validator or the global liquid staking cap. A cap of 100% is not
+// checked.
+func (k Keeper) checkLiquidStakingCaps(ctx sdk.Context, validator types.Validator, shares sdk.Dec) error {
+	if validatorCap := k.ValidatorLiquidStakingCap(ctx); validatorCap.LT(sdk.OneDec()) {
+		liquidShares := k.GetValidatorLiquidShares(ctx, validator.GetOperator()).Add(shares)
+		if liquidShares.GT(validator.DelegatorShares.Mul(validatorCap)) {
+			return types.ErrValidatorLiquidStakingCapExceeded
+		}
+	}
+
+	if globalCap := k.GlobalLiquidStakingCap(ctx); globalCap.LT(sdk.OneDec()) {
+		liquidTokens := k.GetTotalLiquidStakedTokens(ctx).ToDec().Add(validator.TokensFromShares(shares))
+		if liquidTokens.GT(globalCap.MulInt(k.TotalBondedTokens(ctx))) {
+			return types.ErrGlobalLiquidStakingCapExceeded
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,207 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// bootstrapLiquidStakeTest bootstraps the app with 3 validators and a
+// delegation of 1000 tokens from the first address to the first validator.
+func bootstrapLiquidStakeTest(t *testing.T) (*simapp.SimApp, sdk.Context, []sdk.AccAddress, []sdk.ValAddress) {
+	app, ctx, addrDels, addrVals := bootstrapSlashTest(t, 10)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	_, err := app.StakingKeeper.Delegate(ctx, addrDels[0], sdk.NewInt(1000), types.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	return app, ctx, addrDels, addrVals
+}
+
+func TestTokenizeSharesAndRedeemTokens(t *testing.T) {
+	app, ctx, addrDels, addrVals := bootstrapLiquidStakeTest(t)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+
+	// tokenize part of the delegation, with addrDels[1] owning the rewards
+	shareToken, err := app.StakingKeeper.TokenizeShares(ctx, addrDels[0], addrVals[0], sdk.NewInt(400), addrDels[1])
+	require.NoError(t, err)
+
+	record, found := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, types.NewTokenizeShareRecord(1, addrDels[1], addrVals[0]), record)
+	require.Equal(t, uint64(1), app.StakingKeeper.GetLastTokenizeShareRecordID(ctx))
+	require.Equal(t, []types.TokenizeShareRecord{record}, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, addrDels[1]))
+
+	require.Equal(t, sdk.NewCoin(record.GetShareTokenDenom(), sdk.NewInt(400)), shareToken)
+	require.Equal(t, shareToken, app.BankKeeper.GetBalance(ctx, addrDels[0], shareToken.Denom))
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(600), delegation.Shares)
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(400), delegation.Shares)
+
+	require.Equal(t, sdk.NewDec(400), app.StakingKeeper.GetValidatorLiquidShares(ctx, addrVals[0]))
+	require.Equal(t, sdk.NewInt(400), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	// the tokens are not unbonded
+	updatedValidator, found := app.StakingKeeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	require.Equal(t, validator.Tokens, updatedValidator.Tokens)
+	require.Equal(t, validator.DelegatorShares, updatedValidator.DelegatorShares)
+
+	// redeem part of the share tokens
+	redeemed, err := app.StakingKeeper.RedeemTokensForShares(ctx, addrDels[0], sdk.NewCoin(shareToken.Denom, sdk.NewInt(150)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(150)), redeemed)
+	require.Equal(t, sdk.NewInt(250), app.BankKeeper.GetBalance(ctx, addrDels[0], shareToken.Denom).Amount)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(750), delegation.Shares)
+	require.Equal(t, sdk.NewDec(250), app.StakingKeeper.GetValidatorLiquidShares(ctx, addrVals[0]))
+
+	// the share tokens are transferable and redeemable by their new holder
+	remaining := sdk.NewCoins(sdk.NewCoin(shareToken.Denom, sdk.NewInt(250)))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, addrDels[0], addrDels[2], remaining))
+
+	redeemed, err = app.StakingKeeper.RedeemTokensForShares(ctx, addrDels[2], remaining[0])
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(250)), redeemed)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, addrDels[2], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(250), delegation.Shares)
+
+	// the record is deleted once all its shares are redeemed
+	_, found = app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), addrVals[0])
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetTokenizeShareRecordByDenom(ctx, shareToken.Denom)
+	require.False(t, found)
+	require.Empty(t, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, addrDels[1]))
+	require.True(t, app.StakingKeeper.GetValidatorLiquidShares(ctx, addrVals[0]).IsZero())
+	require.True(t, app.BankKeeper.GetSupply(ctx, shareToken.Denom).IsZero())
+}
+
+func TestTokenizeSharesErrors(t *testing.T) {
+	app, ctx, addrDels, addrVals := bootstrapLiquidStakeTest(t)
+
+	// unknown validator
+	_, err := app.StakingKeeper.TokenizeShares(ctx, addrDels[0], addrVals[5], sdk.NewInt(100), addrDels[0])
+	require.ErrorIs(t, err, types.ErrNoValidatorFound)
+
+	// no delegation
+	_, err = app.StakingKeeper.TokenizeShares(ctx, addrDels[1], addrVals[0], sdk.NewInt(100), addrDels[1])
+	require.ErrorIs(t, err, types.ErrNoDelegation)
+
+	// more than delegated
+	_, err = app.StakingKeeper.TokenizeShares(ctx, addrDels[0], addrVals[0], sdk.NewInt(1001), addrDels[0])
+	require.ErrorIs(t, err, types.ErrBadSharesAmount)
+
+	// less than a share
+	_, err = app.StakingKeeper.TokenizeShares(ctx, addrDels[0], addrVals[0], sdk.ZeroInt(), addrDels[0])
+	require.ErrorIs(t, err, types.ErrTinyTokenizeSharesAmount)
+
+	// shares received through an incomplete redelegation
+	red := types.NewRedelegation(addrDels[0], addrVals[1], addrVals[0], 0, ctx.BlockTime().Add(types.DefaultUnbondingTime), sdk.NewInt(100), sdk.NewDec(100))
+	app.StakingKeeper.SetRedelegation(ctx, red)
+	_, err = app.StakingKeeper.TokenizeShares(ctx, addrDels[0], addrVals[0], sdk.NewInt(100), addrDels[0])
+	require.ErrorIs(t, err, types.ErrTokenizeSharesRedelegation)
+	app.StakingKeeper.RemoveRedelegation(ctx, red)
+
+	// vesting account
+	baseAcc := authtypes.NewBaseAccountWithAddress(addrDels[0])
+	originalVesting := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	vestingAcc := vestingtypes.NewContinuousVestingAccount(baseAcc, originalVesting, ctx.BlockTime().Unix(), ctx.BlockTime().Unix()+1000)
+	app.AccountKeeper.SetAccount(ctx, vestingAcc)
+	_, err = app.StakingKeeper.TokenizeShares(ctx, addrDels[0], addrVals[0], sdk.NewInt(100), addrDels[0])
+	require.ErrorIs(t, err, types.ErrTokenizeSharesVestingAccount)
+
+	// unknown share denom
+	_, err = app.StakingKeeper.RedeemTokensForShares(ctx, addrDels[0], sdk.NewInt64Coin(addrVals[0].String()+"/1", 100))
+	require.ErrorIs(t, err, types.ErrTokenizeShareRecordNotExists)
+}
+
+func TestRedeemTokensForSharesInsufficientTokens(t *testing.T) {
+	app, ctx, addrDels, addrVals := bootstrapLiquidStakeTest(t)
+
+	shareToken, err := app.StakingKeeper.TokenizeShares(ctx, addrDels[0], addrVals[0], sdk.NewInt(400), addrDels[0])
+	require.NoError(t, err)
+
+	_, err = app.StakingKeeper.RedeemTokensForShares(ctx, addrDels[0], sdk.NewCoin(shareToken.Denom, sdk.NewInt(401)))
+	require.ErrorIs(t, err, types.ErrInsufficientShareTokens)
+
+	_, err = app.StakingKeeper.RedeemTokensForShares(ctx, addrDels[1], shareToken)
+	require.ErrorIs(t, err, types.ErrInsufficientShareTokens)
+}
+
+func TestTokenizeSharesSlashing(t *testing.T) {
+	app, ctx, addrDels, addrVals := bootstrapLiquidStakeTest(t)
+
+	shareToken, err := app.StakingKeeper.TokenizeShares(ctx, addrDels[0], addrVals[0], sdk.NewInt(400), addrDels[0])
+	require.NoError(t, err)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+
+	// the tokenized delegation is slashed along with the others
+	app.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), validator.GetConsensusPower(), sdk.NewDecWithPrec(5, 1))
+
+	validator, found = app.StakingKeeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	expTokens := validator.TokensFromShares(sdk.NewDec(400)).TruncateInt()
+	require.Equal(t, expTokens, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	// the share tokens still redeem all the tokenized shares, now worth less
+	redeemed, err := app.StakingKeeper.RedeemTokensForShares(ctx, addrDels[0], shareToken)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin(sdk.DefaultBondDenom, expTokens), redeemed)
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(1000), delegation.Shares)
+}
+
+func TestLiquidStakingCaps(t *testing.T) {
+	app, ctx, addrDels, addrVals := bootstrapLiquidStakeTest(t)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+
+	// the validator cap allows tokenizing 550 of its shares
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorLiquidStakingCap = sdk.NewDec(550).Quo(validator.DelegatorShares)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	_, err := app.StakingKeeper.TokenizeShares(ctx, addrDels[0], addrVals[0], sdk.NewInt(400), addrDels[0])
+	require.NoError(t, err)
+	_, err = app.StakingKeeper.TokenizeShares(ctx, addrDels[0], addrVals[0], sdk.NewInt(151), addrDels[0])
+	require.ErrorIs(t, err, types.ErrValidatorLiquidStakingCapExceeded)
+	_, err = app.StakingKeeper.TokenizeShares(ctx, addrDels[0], addrVals[0], sdk.NewInt(100), addrDels[0])
+	require.NoError(t, err)
+
+	// the global cap allows tokenizing 650 of the bonded tokens
+	params.ValidatorLiquidStakingCap = sdk.OneDec()
+	params.GlobalLiquidStakingCap = sdk.NewDec(650).QuoInt(app.StakingKeeper.TotalBondedTokens(ctx))
+	app.StakingKeeper.SetParams(ctx, params)
+
+	_, err = app.StakingKeeper.TokenizeShares(ctx, addrDels[0], addrVals[0], sdk.NewInt(151), addrDels[0])
+	require.ErrorIs(t, err, types.ErrGlobalLiquidStakingCapExceeded)
+	_, err = app.StakingKeeper.TokenizeShares(ctx, addrDels[0], addrVals[0], sdk.NewInt(100), addrDels[0])
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(600), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+}
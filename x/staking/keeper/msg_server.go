@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	metrics "github.com/armon/go-metrics"
@@ -57,6 +58,14 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 		return nil, err
 	}
 
+	if minRate := k.MinCommissionRate(ctx); msg.Commission.Rate.LT(minRate) {
+		return nil, sdkerrors.Wrapf(types.ErrCommissionLTMinRate, "%s < %s", msg.Commission.Rate, minRate)
+	}
+
+	if floor := k.MinSelfDelegationFloor(ctx); msg.MinSelfDelegation.LT(floor) {
+		return nil, sdkerrors.Wrapf(types.ErrMinSelfDelegationBelowFloor, "%s < %s", msg.MinSelfDelegation, floor)
+	}
+
 	cp := ctx.ConsensusParams()
 	if cp != nil && cp.Validator != nil {
 		if !tmstrings.StringInSlice(pk.Type(), cp.Validator.PubKeyTypes) {
@@ -140,6 +149,10 @@ func (k msgServer) EditValidator(goCtx context.Context, msg *types.MsgEditValida
 	validator.Description = description
 
 	if msg.CommissionRate != nil {
+		if minRate := k.MinCommissionRate(ctx); msg.CommissionRate.LT(minRate) {
+			return nil, sdkerrors.Wrapf(types.ErrCommissionLTMinRate, "%s < %s", msg.CommissionRate, minRate)
+		}
+
 		commission, err := k.UpdateValidatorCommission(ctx, validator, *msg.CommissionRate)
 		if err != nil {
 			return nil, err
@@ -156,6 +169,10 @@ func (k msgServer) EditValidator(goCtx context.Context, msg *types.MsgEditValida
 			return nil, types.ErrMinSelfDelegationDecreased
 		}
 
+		if floor := k.MinSelfDelegationFloor(ctx); msg.MinSelfDelegation.LT(floor) {
+			return nil, sdkerrors.Wrapf(types.ErrMinSelfDelegationBelowFloor, "%s < %s", msg.MinSelfDelegation, floor)
+		}
+
 		if msg.MinSelfDelegation.GT(validator.Tokens) {
 			return nil, types.ErrSelfDelegationBelowMinimum
 		}
@@ -358,3 +375,161 @@ func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (
 		CompletionTime: completionTime,
 	}, nil
 }
+
+func (k msgServer) CancelUnbondingDelegation(goCtx context.Context, msg *types.MsgCancelUnbondingDelegation) (*types.MsgCancelUnbondingDelegationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	bondDenom := k.BondDenom(ctx)
+	if msg.Amount.Denom != bondDenom {
+		return nil, sdkerrors.Wrapf(types.ErrBadDenom, "got %s, expected %s", msg.Amount.Denom, bondDenom)
+	}
+
+	err = k.Keeper.CancelUnbondingDelegation(ctx, delegatorAddress, valAddr, msg.CreationHeight, msg.Amount.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Amount.Amount.IsInt64() {
+		defer func() {
+			telemetry.IncrCounter(1, types.ModuleName, "cancel_unbonding_delegation")
+			telemetry.SetGaugeWithLabels(
+				[]string{"tx", "msg", msg.Type()},
+				float32(msg.Amount.Amount.Int64()),
+				[]metrics.Label{telemetry.NewLabel("denom", msg.Amount.Denom)},
+			)
+		}()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelUnbondingDelegation,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCreationHeight, strconv.FormatInt(msg.CreationHeight, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+	})
+
+	return &types.MsgCancelUnbondingDelegationResponse{}, nil
+}
+
+func (k msgServer) TokenizeShares(goCtx context.Context, msg *types.MsgTokenizeShares) (*types.MsgTokenizeSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	ownerAddress, err := sdk.AccAddressFromBech32(msg.TokenizedShareOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	bondDenom := k.BondDenom(ctx)
+	if msg.Amount.Denom != bondDenom {
+		return nil, sdkerrors.Wrapf(types.ErrBadDenom, "got %s, expected %s", msg.Amount.Denom, bondDenom)
+	}
+
+	shareToken, err := k.Keeper.TokenizeShares(ctx, delegatorAddress, valAddr, msg.Amount.Amount, ownerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Amount.Amount.IsInt64() {
+		defer func() {
+			telemetry.IncrCounter(1, types.ModuleName, "tokenize_shares")
+			telemetry.SetGaugeWithLabels(
+				[]string{"tx", "msg", msg.Type()},
+				float32(msg.Amount.Amount.Int64()),
+				[]metrics.Label{telemetry.NewLabel("denom", msg.Amount.Denom)},
+			)
+		}()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTokenizeShares,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyShareOwner, msg.TokenizedShareOwner),
+			sdk.NewAttribute(types.AttributeKeyShareRecordID, strconv.FormatUint(k.GetLastTokenizeShareRecordID(ctx), 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, shareToken.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+	})
+
+	return &types.MsgTokenizeSharesResponse{
+		Amount: shareToken,
+	}, nil
+}
+
+func (k msgServer) RedeemTokensForShares(goCtx context.Context, msg *types.MsgRedeemTokensForShares) (*types.MsgRedeemTokensForSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	record, found := k.GetTokenizeShareRecordByDenom(ctx, msg.Amount.Denom)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrTokenizeShareRecordNotExists, "denom %s", msg.Amount.Denom)
+	}
+
+	returnAmount, err := k.Keeper.RedeemTokensForShares(ctx, delegatorAddress, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	if returnAmount.Amount.IsInt64() {
+		defer func() {
+			telemetry.IncrCounter(1, types.ModuleName, "redeem_tokens_for_shares")
+			telemetry.SetGaugeWithLabels(
+				[]string{"tx", "msg", msg.Type()},
+				float32(returnAmount.Amount.Int64()),
+				[]metrics.Label{telemetry.NewLabel("denom", returnAmount.Denom)},
+			)
+		}()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRedeemShares,
+			sdk.NewAttribute(types.AttributeKeyValidator, record.Validator),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyShareRecordID, strconv.FormatUint(record.Id, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, returnAmount.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+	})
+
+	return &types.MsgRedeemTokensForSharesResponse{
+		Amount: returnAmount,
+	}, nil
+}
@@ -39,6 +39,33 @@ func (k Keeper) BondDenom(ctx sdk.Context) (res string) {
 	return
 }
 
+// GlobalLiquidStakingCap - Maximum fraction of the total bonded tokens that
+// can be tokenized
+func (k Keeper) GlobalLiquidStakingCap(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyGlobalLiquidStakingCap, &res)
+	return
+}
+
+// ValidatorLiquidStakingCap - Maximum fraction of the delegator shares of a
+// validator that can be tokenized
+func (k Keeper) ValidatorLiquidStakingCap(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyValidatorLiquidStakingCap, &res)
+	return
+}
+
+// MinCommissionRate - Minimum commission rate of the validators
+func (k Keeper) MinCommissionRate(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyMinCommissionRate, &res)
+	return
+}
+
+// MinSelfDelegationFloor - Minimum value of the min self delegation of the
+// validators
+func (k Keeper) MinSelfDelegationFloor(ctx sdk.Context) (res sdk.Int) {
+	k.paramstore.Get(ctx, types.KeyMinSelfDelegationFloor, &res)
+	return
+}
+
 // Get all parameteras as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
@@ -47,6 +74,10 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.MaxEntries(ctx),
 		k.HistoricalEntries(ctx),
 		k.BondDenom(ctx),
+		k.GlobalLiquidStakingCap(ctx),
+		k.ValidatorLiquidStakingCap(ctx),
+		k.MinCommissionRate(ctx),
+		k.MinSelfDelegationFloor(ctx),
 	)
 }
 
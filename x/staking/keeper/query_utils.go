@@ -128,3 +128,33 @@ func (k Keeper) GetAllRedelegations(
 
 	return redelegations
 }
+
+// GetDelegatorBonded returns the amount of tokens a delegator has bonded to
+// validators, valued at the current exchange rate of each validator.
+func (k Keeper) GetDelegatorBonded(ctx sdk.Context, delegator sdk.AccAddress) sdk.Int {
+	bonded := sdk.ZeroDec()
+
+	k.IterateDelegations(ctx, delegator, func(_ int64, delegation types.DelegationI) bool {
+		validator, found := k.GetValidator(ctx, delegation.GetValidatorAddr())
+		if found {
+			bonded = bonded.Add(validator.TokensFromShares(delegation.GetShares()))
+		}
+		return false
+	})
+
+	return bonded.TruncateInt()
+}
+
+// GetDelegatorUnbonding returns the amount of tokens a delegator currently has
+// unbonding.
+func (k Keeper) GetDelegatorUnbonding(ctx sdk.Context, delegator sdk.AccAddress) sdk.Int {
+	unbonding := sdk.ZeroInt()
+
+	for _, ubd := range k.GetAllUnbondingDelegations(ctx, delegator) {
+		for _, entry := range ubd.Entries {
+			unbonding = unbonding.Add(entry.Balance)
+		}
+	}
+
+	return unbonding
+}
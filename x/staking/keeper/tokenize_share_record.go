@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetLastTokenizeShareRecordID returns the id of the last created tokenize
+// share record.
+func (k Keeper) GetLastTokenizeShareRecordID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastTokenizeShareRecordIDKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastTokenizeShareRecordID sets the id of the last created tokenize share
+// record.
+func (k Keeper) SetLastTokenizeShareRecordID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastTokenizeShareRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetTokenizeShareRecord gets the tokenize share record with the given id.
+func (k Keeper) GetTokenizeShareRecord(ctx sdk.Context, id uint64) (record types.TokenizeShareRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetTokenizeShareRecordByIndexKey(id))
+	if bz == nil {
+		return record, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &record)
+
+	return record, true
+}
+
+// GetTokenizeShareRecordByDenom gets the tokenize share record of the given
+// share denom.
+func (k Keeper) GetTokenizeShareRecordByDenom(ctx sdk.Context, denom string) (record types.TokenizeShareRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetTokenizeShareRecordIDByDenomKey(denom))
+	if bz == nil {
+		return record, false
+	}
+
+	return k.GetTokenizeShareRecord(ctx, sdk.BigEndianToUint64(bz))
+}
+
+// GetTokenizeShareRecordsByOwner returns all the tokenize share records owned
+// by the given address.
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx sdk.Context, owner sdk.AccAddress) (records []types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetTokenizeShareRecordIDsByOwnerPrefix(owner))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		record, found := k.GetTokenizeShareRecord(ctx, sdk.BigEndianToUint64(iterator.Value()))
+		if !found {
+			panic("tokenize share record indexed by owner not found")
+		}
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// GetAllTokenizeShareRecords returns all the tokenize share records.
+func (k Keeper) GetAllTokenizeShareRecords(ctx sdk.Context) (records []types.TokenizeShareRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TokenizeShareRecordPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TokenizeShareRecord
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &record)
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// SetTokenizeShareRecord sets a tokenize share record along with its owner and
+// share denom indexes.
+func (k Keeper) SetTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) {
+	owner, err := sdk.AccAddressFromBech32(record.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	idBz := sdk.Uint64ToBigEndian(record.Id)
+
+	store.Set(types.GetTokenizeShareRecordByIndexKey(record.Id), k.cdc.MustMarshalBinaryBare(&record))
+	store.Set(types.GetTokenizeShareRecordIDByOwnerAndIDKey(owner, record.Id), idBz)
+	store.Set(types.GetTokenizeShareRecordIDByDenomKey(record.GetShareTokenDenom()), idBz)
+}
+
+// DeleteTokenizeShareRecord deletes a tokenize share record along with its
+// owner and share denom indexes.
+func (k Keeper) DeleteTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) {
+	owner, err := sdk.AccAddressFromBech32(record.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.GetTokenizeShareRecordByIndexKey(record.Id))
+	store.Delete(types.GetTokenizeShareRecordIDByOwnerAndIDKey(owner, record.Id))
+	store.Delete(types.GetTokenizeShareRecordIDByDenomKey(record.GetShareTokenDenom()))
+}
+
+// GetValidatorLiquidShares returns the delegator shares of a validator which
+// are held by tokenize share records.
+func (k Keeper) GetValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetValidatorLiquidSharesKey(valAddr))
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	dp := sdk.DecProto{}
+	k.cdc.MustUnmarshalBinaryBare(bz, &dp)
+
+	return dp.Dec
+}
+
+// SetValidatorLiquidShares sets the delegator shares of a validator which are
+// held by tokenize share records, or removes them if they are zero.
+func (k Keeper) SetValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+
+	if shares.IsZero() {
+		store.Delete(types.GetValidatorLiquidSharesKey(valAddr))
+		return
+	}
+
+	bz := k.cdc.MustMarshalBinaryBare(&sdk.DecProto{Dec: shares})
+	store.Set(types.GetValidatorLiquidSharesKey(valAddr), bz)
+}
+
+// IterateValidatorLiquidShares iterates through the validators with tokenized
+// delegator shares. If the callback returns true, the iteration stops.
+func (k Keeper) IterateValidatorLiquidShares(ctx sdk.Context, cb func(valAddr sdk.ValAddress, shares sdk.Dec) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorLiquidSharesKey)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		dp := sdk.DecProto{}
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &dp)
+
+		// remove the address length prefix
+		if cb(sdk.ValAddress(iterator.Key()[1:]), dp.Dec) {
+			break
+		}
+	}
+}
+
+// GetTotalLiquidStakedTokens returns the amount of tokens delegated through
+// tokenize share records. As the tokens are derived from the tokenized shares,
+// the amount follows the slashes of the validators.
+func (k Keeper) GetTotalLiquidStakedTokens(ctx sdk.Context) sdk.Int {
+	total := sdk.ZeroDec()
+
+	k.IterateValidatorLiquidShares(ctx, func(valAddr sdk.ValAddress, shares sdk.Dec) bool {
+		validator, found := k.GetValidator(ctx, valAddr)
+		if !found {
+			panic("validator with liquid shares not found")
+		}
+
+		total = total.Add(validator.TokensFromShares(shares))
+		return false
+	})
+
+	return total.TruncateInt()
+}
@@ -48,16 +48,22 @@ func TestMigrate(t *testing.T) {
 	expected := `{
   "delegations": [],
   "exported": false,
+  "last_tokenize_share_record_id": "0",
   "last_total_power": "0",
   "last_validator_powers": [],
   "params": {
     "bond_denom": "",
+    "global_liquid_staking_cap": "0",
     "historical_entries": 0,
     "max_entries": 0,
     "max_validators": 0,
-    "unbonding_time": "0s"
+    "min_commission_rate": "0",
+    "min_self_delegation_floor": "0",
+    "unbonding_time": "0s",
+    "validator_liquid_staking_cap": "0"
   },
   "redelegations": [],
+  "tokenize_share_records": [],
   "unbonding_delegations": [],
   "validators": [
     {
@@ -0,0 +1,123 @@
+package v044
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateParams performs in-place params migrations from v0.43 to v0.44. The
+// migration includes:
+//
+// - Add the GlobalLiquidStakingCap and ValidatorLiquidStakingCap params with
+//   their default values, unless they were already set by the upgrade handler.
+func MigrateParams(ctx sdk.Context, paramSubspace paramtypes.Subspace) error {
+	if !paramSubspace.Has(ctx, types.KeyGlobalLiquidStakingCap) {
+		paramSubspace.Set(ctx, types.KeyGlobalLiquidStakingCap, types.DefaultGlobalLiquidStakingCap)
+	}
+
+	if !paramSubspace.Has(ctx, types.KeyValidatorLiquidStakingCap) {
+		paramSubspace.Set(ctx, types.KeyValidatorLiquidStakingCap, types.DefaultValidatorLiquidStakingCap)
+	}
+
+	return nil
+}
+
+// MigrateStore performs in-place store migrations from v0.43 to v0.44. The
+// migration includes:
+//
+// - Add the MinCommissionRate and MinSelfDelegationFloor params with their
+//   default values, unless they were already set by the upgrade handler.
+// - Raise the commission rate of the validators to the MinCommissionRate
+//   param, along with their max rate if needed.
+// - Raise the min self delegation of the validators to the
+//   MinSelfDelegationFloor param, and jail the validators whose
+//   self-delegation is then below it.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryMarshaler, paramSubspace paramtypes.Subspace) error {
+	if !paramSubspace.Has(ctx, types.KeyMinCommissionRate) {
+		paramSubspace.Set(ctx, types.KeyMinCommissionRate, types.DefaultMinCommissionRate)
+	}
+
+	if !paramSubspace.Has(ctx, types.KeyMinSelfDelegationFloor) {
+		paramSubspace.Set(ctx, types.KeyMinSelfDelegationFloor, types.DefaultMinSelfDelegationFloor)
+	}
+
+	var (
+		minCommissionRate      sdk.Dec
+		minSelfDelegationFloor sdk.Int
+	)
+
+	paramSubspace.Get(ctx, types.KeyMinCommissionRate, &minCommissionRate)
+	paramSubspace.Get(ctx, types.KeyMinSelfDelegationFloor, &minSelfDelegationFloor)
+
+	return migrateValidators(ctx.KVStore(storeKey), cdc, ctx.BlockTime(), minCommissionRate, minSelfDelegationFloor)
+}
+
+// migrateValidators raises the commission rate and the min self delegation of
+// the validators below the given minimums. As when a validator undelegates
+// below its min self delegation, the validators whose self-delegation is below
+// their new min self delegation are jailed.
+func migrateValidators(
+	store sdk.KVStore, cdc codec.BinaryMarshaler, blockTime time.Time, minCommissionRate sdk.Dec, minSelfDelegationFloor sdk.Int,
+) error {
+	// collect the validators first, as the store cannot be written to while
+	// iterating
+	var validators []types.Validator
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorsKey)
+	for ; iterator.Valid(); iterator.Next() {
+		validators = append(validators, types.MustUnmarshalValidator(cdc, iterator.Value()))
+	}
+	iterator.Close()
+
+	for _, validator := range validators {
+		modified := false
+
+		if validator.Commission.Rate.LT(minCommissionRate) {
+			validator.Commission.Rate = minCommissionRate
+			if validator.Commission.MaxRate.LT(minCommissionRate) {
+				validator.Commission.MaxRate = minCommissionRate
+			}
+			validator.Commission.UpdateTime = blockTime
+
+			modified = true
+		}
+
+		if validator.MinSelfDelegation.LT(minSelfDelegationFloor) {
+			validator.MinSelfDelegation = minSelfDelegationFloor
+			modified = true
+
+			if !validator.Jailed && selfDelegation(store, cdc, validator).LT(validator.MinSelfDelegation) {
+				// the power index key does not depend on the jailed status
+				store.Delete(types.GetValidatorsByPowerIndexKey(validator))
+				validator.Jailed = true
+			}
+		}
+
+		if !modified {
+			continue
+		}
+
+		if err := validator.Commission.Validate(); err != nil {
+			return err
+		}
+
+		store.Set(types.GetValidatorKey(validator.GetOperator()), types.MustMarshalValidator(cdc, &validator))
+	}
+
+	return nil
+}
+
+// selfDelegation returns the tokens self-delegated to the validator.
+func selfDelegation(store sdk.KVStore, cdc codec.BinaryMarshaler, validator types.Validator) sdk.Int {
+	bz := store.Get(types.GetDelegationKey(sdk.AccAddress(validator.GetOperator()), validator.GetOperator()))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	delegation := types.MustUnmarshalDelegation(cdc, bz)
+	return validator.TokensFromShares(delegation.Shares).TruncateInt()
+}
@@ -0,0 +1,119 @@
+package v044_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	v044staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v044"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(1000, 0).UTC()})
+	subspace := app.GetSubspace(types.ModuleName)
+
+	_, pk1, addr1 := testdata.KeyTestPubAddr()
+	_, pk2, addr2 := testdata.KeyTestPubAddr()
+	_, pk3, addr3 := testdata.KeyTestPubAddr()
+
+	// below the min commission rate, along with its max rate, and without
+	// self-delegation
+	valLow := teststaking.NewValidator(t, sdk.ValAddress(addr1), pk1)
+	valLow.Commission = types.NewCommission(sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(2, 2), sdk.NewDecWithPrec(1, 2))
+	valLow, _ = valLow.AddTokensFromDel(sdk.NewInt(1000))
+	app.StakingKeeper.SetValidator(ctx, valLow)
+	app.StakingKeeper.SetValidatorByPowerIndex(ctx, valLow)
+
+	// below the min commission rate only, self-delegating the min self
+	// delegation floor
+	valMid := teststaking.NewValidator(t, sdk.ValAddress(addr2), pk2)
+	valMid.Commission = types.NewCommission(sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2))
+	valMid, shares := valMid.AddTokensFromDel(sdk.NewInt(100))
+	app.StakingKeeper.SetValidator(ctx, valMid)
+	app.StakingKeeper.SetValidatorByPowerIndex(ctx, valMid)
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(addr2, valMid.GetOperator(), shares))
+
+	// above both minimums
+	valHigh := teststaking.NewValidator(t, sdk.ValAddress(addr3), pk3)
+	valHigh.Commission = types.NewCommission(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2))
+	valHigh.MinSelfDelegation = sdk.NewInt(200)
+	app.StakingKeeper.SetValidator(ctx, valHigh)
+
+	// the minimums are set by the upgrade handler before the migration
+	minCommissionRate := sdk.NewDecWithPrec(5, 2)
+	minSelfDelegationFloor := sdk.NewInt(100)
+	subspace.Set(ctx, types.KeyMinCommissionRate, minCommissionRate)
+	subspace.Set(ctx, types.KeyMinSelfDelegationFloor, minSelfDelegationFloor)
+
+	require.NoError(t, v044staking.MigrateStore(ctx, app.GetKey(types.StoreKey), app.AppCodec(), subspace))
+
+	powerIndexed := func(validator types.Validator) bool {
+		return ctx.KVStore(app.GetKey(types.StoreKey)).Has(types.GetValidatorsByPowerIndexKey(validator))
+	}
+
+	// the validator without self-delegation is jailed
+	validator, found := app.StakingKeeper.GetValidator(ctx, valLow.GetOperator())
+	require.True(t, found)
+	require.Equal(t, minCommissionRate, validator.Commission.Rate)
+	require.Equal(t, minCommissionRate, validator.Commission.MaxRate)
+	require.Equal(t, ctx.BlockTime(), validator.Commission.UpdateTime)
+	require.Equal(t, minSelfDelegationFloor, validator.MinSelfDelegation)
+	require.True(t, validator.Jailed)
+	require.False(t, powerIndexed(validator))
+
+	validator, found = app.StakingKeeper.GetValidator(ctx, valMid.GetOperator())
+	require.True(t, found)
+	require.Equal(t, minCommissionRate, validator.Commission.Rate)
+	require.Equal(t, valMid.Commission.MaxRate, validator.Commission.MaxRate)
+	require.Equal(t, ctx.BlockTime(), validator.Commission.UpdateTime)
+	require.Equal(t, minSelfDelegationFloor, validator.MinSelfDelegation)
+	require.False(t, validator.Jailed)
+	require.True(t, powerIndexed(validator))
+
+	validator, found = app.StakingKeeper.GetValidator(ctx, valHigh.GetOperator())
+	require.True(t, found)
+	require.Equal(t, valHigh.Commission, validator.Commission)
+	require.Equal(t, valHigh.MinSelfDelegation, validator.MinSelfDelegation)
+}
+
+func TestMigrateParams(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	ctx := testutil.DefaultContext(paramsKey, paramsTKey)
+
+	subspace := paramtypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, paramsKey, paramsTKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	// Store the params known before the migration only.
+	old := types.DefaultParams()
+	for _, pair := range old.ParamSetPairs() {
+		if string(pair.Key) == string(types.KeyGlobalLiquidStakingCap) || string(pair.Key) == string(types.KeyValidatorLiquidStakingCap) {
+			continue
+		}
+		subspace.Set(ctx, pair.Key, pair.Value)
+	}
+	require.False(t, subspace.Has(ctx, types.KeyGlobalLiquidStakingCap))
+
+	// A param set by the upgrade handler is kept.
+	subspace.Set(ctx, types.KeyValidatorLiquidStakingCap, sdk.NewDecWithPrec(5, 1))
+
+	require.NoError(t, v044staking.MigrateParams(ctx, subspace))
+
+	var params types.Params
+	subspace.GetParamSet(ctx, &params)
+
+	expected := types.DefaultParams()
+	expected.ValidatorLiquidStakingCap = sdk.NewDecWithPrec(5, 1)
+	require.Equal(t, expected, params)
+}
@@ -19,6 +19,9 @@ const (
 	unbondingTime     = "unbonding_time"
 	maxValidators     = "max_validators"
 	historicalEntries = "historical_entries"
+
+	globalLiquidStakingCap    = "global_liquid_staking_cap"
+	validatorLiquidStakingCap = "validator_liquid_staking_cap"
 )
 
 // GenUnbondingTime randomized UnbondingTime
@@ -36,13 +39,21 @@ func GetHistEntries(r *rand.Rand) uint32 {
 	return uint32(r.Intn(int(types.DefaultHistoricalEntries + 1)))
 }
 
+// GenLiquidStakingCap randomized GlobalLiquidStakingCap and
+// ValidatorLiquidStakingCap between 10% and 100%.
+func GenLiquidStakingCap(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 10, 101)), 2)
+}
+
 // RandomizedGenState generates a random GenesisState for staking
 func RandomizedGenState(simState *module.SimulationState) {
 	// params
 	var (
-		unbondTime  time.Duration
-		maxVals     uint32
-		histEntries uint32
+		unbondTime     time.Duration
+		maxVals        uint32
+		histEntries    uint32
+		globalLSCap    sdk.Dec
+		validatorLSCap sdk.Dec
 	)
 
 	simState.AppParams.GetOrGenerate(
@@ -60,10 +71,23 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { histEntries = GetHistEntries(r) },
 	)
 
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, globalLiquidStakingCap, &globalLSCap, simState.Rand,
+		func(r *rand.Rand) { globalLSCap = GenLiquidStakingCap(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, validatorLiquidStakingCap, &validatorLSCap, simState.Rand,
+		func(r *rand.Rand) { validatorLSCap = GenLiquidStakingCap(r) },
+	)
+
 	// NOTE: the slashing module need to be defined after the staking module on the
 	// NewSimulationManager constructor for this to work
 	simState.UnbondTime = unbondTime
-	params := types.NewParams(simState.UnbondTime, maxVals, 7, histEntries, sdk.DefaultBondDenom)
+	params := types.NewParams(
+		simState.UnbondTime, maxVals, 7, histEntries, sdk.DefaultBondDenom, globalLSCap, validatorLSCap,
+		types.DefaultMinCommissionRate, types.DefaultMinSelfDelegationFloor,
+	)
 
 	// validators & delegations
 	var (
@@ -10,6 +10,7 @@ import (
 	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -22,6 +23,10 @@ const (
 	OpWeightMsgDelegate        = "op_weight_msg_delegate"
 	OpWeightMsgUndelegate      = "op_weight_msg_undelegate"
 	OpWeightMsgBeginRedelegate = "op_weight_msg_begin_redelegate"
+
+	OpWeightMsgCancelUnbondingDelegation = "op_weight_msg_cancel_unbonding_delegation"
+	OpWeightMsgTokenizeShares            = "op_weight_msg_tokenize_shares"
+	OpWeightMsgRedeemTokensForShares     = "op_weight_msg_redeem_tokens_for_shares"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -35,6 +40,10 @@ func WeightedOperations(
 		weightMsgDelegate        int
 		weightMsgUndelegate      int
 		weightMsgBeginRedelegate int
+
+		weightMsgCancelUnbondingDelegation int
+		weightMsgTokenizeShares            int
+		weightMsgRedeemTokensForShares     int
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgCreateValidator, &weightMsgCreateValidator, nil,
@@ -67,6 +76,24 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgCancelUnbondingDelegation, &weightMsgCancelUnbondingDelegation, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelUnbondingDelegation = simappparams.DefaultWeightMsgCancelUnbondingDelegation
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgTokenizeShares, &weightMsgTokenizeShares, nil,
+		func(_ *rand.Rand) {
+			weightMsgTokenizeShares = simappparams.DefaultWeightMsgTokenizeShares
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgRedeemTokensForShares, &weightMsgRedeemTokensForShares, nil,
+		func(_ *rand.Rand) {
+			weightMsgRedeemTokensForShares = simappparams.DefaultWeightMsgRedeemTokensForShares
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgCreateValidator,
@@ -88,6 +115,18 @@ func WeightedOperations(
 			weightMsgBeginRedelegate,
 			SimulateMsgBeginRedelegate(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgCancelUnbondingDelegation,
+			SimulateMsgCancelUnbondingDelegation(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgTokenizeShares,
+			SimulateMsgTokenizeShares(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRedeemTokensForShares,
+			SimulateMsgRedeemTokensForShares(ak, bk, k),
+		),
 	}
 }
 
@@ -518,3 +557,271 @@ func SimulateMsgBeginRedelegate(ak types.AccountKeeper, bk types.BankKeeper, k k
 		return simtypes.NewOperationMsg(msg, true, "", nil), nil, nil
 	}
 }
+
+// SimulateMsgCancelUnbondingDelegation generates a MsgCancelUnbondingDelegation with random values
+func SimulateMsgCancelUnbondingDelegation(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		// get random validator
+		validator, ok := keeper.RandomValidator(r, k, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelUnbondingDelegation, "validator is not ok"), nil, nil
+		}
+
+		if validator.IsJailed() || validator.InvalidExRate() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelUnbondingDelegation, "validator is jailed or has an invalid exchange rate"), nil, nil
+		}
+
+		valAddr := validator.GetOperator()
+		ubds := k.GetUnbondingDelegationsFromValidator(ctx, valAddr)
+		if len(ubds) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelUnbondingDelegation, "keeper does have any unbonding delegation entries"), nil, nil
+		}
+
+		// get random unbonding delegation entry, the first entry created at its
+		// height being the one cancelled
+		ubd := ubds[r.Intn(len(ubds))]
+		creationHeight := ubd.Entries[r.Intn(len(ubd.Entries))].CreationHeight
+
+		var entry types.UnbondingDelegationEntry
+		for _, e := range ubd.Entries {
+			if e.CreationHeight == creationHeight {
+				entry = e
+				break
+			}
+		}
+
+		if entry.IsMature(ctx.BlockHeader().Time) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelUnbondingDelegation, "unbonding delegation entry is mature"), nil, nil
+		}
+
+		if !entry.Balance.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelUnbondingDelegation, "unbonding delegation entry balance is not positive"), nil, nil
+		}
+
+		cancelAmt, err := simtypes.RandPositiveInt(r, entry.Balance)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelUnbondingDelegation, "invalid cancel amount"), nil, err
+		}
+
+		delAddr, err := sdk.AccAddressFromBech32(ubd.DelegatorAddress)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelUnbondingDelegation, "invalid delegator address"), nil, err
+		}
+
+		msg := types.NewMsgCancelUnbondingDelegation(
+			delAddr, valAddr, creationHeight, sdk.NewCoin(k.BondDenom(ctx), cancelAmt),
+		)
+
+		// need to retrieve the simulation account associated with the unbonding delegation to retrieve PrivKey
+		simAccount, found := simtypes.FindAccount(accs, delAddr)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "account private key is nil"), nil, fmt.Errorf("delegation addr: %s does not exist in simulation accounts", delAddr)
+		}
+
+		account := ak.GetAccount(ctx, delAddr)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate fees"), nil, err
+		}
+
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+		}
+
+		_, _, err = app.Deliver(txGen.TxEncoder(), tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, "", nil), nil, nil
+	}
+}
+
+// SimulateMsgTokenizeShares generates a MsgTokenizeShares with random values
+func SimulateMsgTokenizeShares(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		// get random validator
+		validator, ok := keeper.RandomValidator(r, k, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "validator is not ok"), nil, nil
+		}
+
+		if validator.InvalidExRate() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "validator has an invalid exchange rate"), nil, nil
+		}
+
+		valAddr := validator.GetOperator()
+		delegations := k.GetValidatorDelegations(ctx, valAddr)
+		if delegations == nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "keeper does have any delegation entries"), nil, nil
+		}
+
+		// get random delegator from validator, which must be a simulation
+		// account and not a record account
+		delegation := delegations[r.Intn(len(delegations))]
+		delAddr := delegation.GetDelegatorAddr()
+
+		simAccount, found := simtypes.FindAccount(accs, delAddr)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "delegator is not a simulation account"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, delAddr)
+		if _, ok := account.(vestexported.VestingAccount); ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "delegator is a vesting account"), nil, nil
+		}
+
+		if k.HasReceivingRedelegation(ctx, delAddr, valAddr) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "delegation has a receiving redelegation"), nil, nil
+		}
+
+		totalBond := validator.TokensFromShares(delegation.GetShares()).TruncateInt()
+		if !totalBond.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "total bond is not positive"), nil, nil
+		}
+
+		tokenizeAmt, err := simtypes.RandPositiveInt(r, totalBond)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "invalid tokenize amount"), nil, err
+		}
+
+		shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, tokenizeAmt)
+		if err != nil || !shares.TruncateDec().IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "tokenize amount is too small"), nil, nil
+		}
+		shares = shares.TruncateDec()
+
+		// the liquid staking caps are checked the same way the keeper does
+		if validatorCap := k.ValidatorLiquidStakingCap(ctx); validatorCap.LT(sdk.OneDec()) {
+			liquidShares := k.GetValidatorLiquidShares(ctx, valAddr).Add(shares)
+			if liquidShares.GT(validator.DelegatorShares.Mul(validatorCap)) {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "validator liquid staking cap exceeded"), nil, nil
+			}
+		}
+
+		if globalCap := k.GlobalLiquidStakingCap(ctx); globalCap.LT(sdk.OneDec()) {
+			liquidTokens := k.GetTotalLiquidStakedTokens(ctx).ToDec().Add(validator.TokensFromShares(shares))
+			if liquidTokens.GT(globalCap.MulInt(k.TotalBondedTokens(ctx))) {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "global liquid staking cap exceeded"), nil, nil
+			}
+		}
+
+		owner, _ := simtypes.RandomAcc(r, accs)
+
+		msg := types.NewMsgTokenizeShares(
+			delAddr, valAddr, sdk.NewCoin(k.BondDenom(ctx), tokenizeAmt), owner.Address,
+		)
+
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate fees"), nil, err
+		}
+
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+		}
+
+		_, _, err = app.Deliver(txGen.TxEncoder(), tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, "", nil), nil, nil
+	}
+}
+
+// SimulateMsgRedeemTokensForShares generates a MsgRedeemTokensForShares with random values
+func SimulateMsgRedeemTokensForShares(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		// get random account holding share tokens
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		account := ak.GetAccount(ctx, simAccount.Address)
+		if account == nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRedeemTokensForShares, "account not found"), nil, nil
+		}
+
+		var shareTokens sdk.Coins
+		for _, coin := range bk.GetAllBalances(ctx, simAccount.Address) {
+			if _, found := k.GetTokenizeShareRecordByDenom(ctx, coin.Denom); found {
+				shareTokens = append(shareTokens, coin)
+			}
+		}
+
+		if len(shareTokens) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRedeemTokensForShares, "account does not hold any share tokens"), nil, nil
+		}
+
+		shareToken := shareTokens[r.Intn(len(shareTokens))]
+
+		redeemAmt, err := simtypes.RandPositiveInt(r, shareToken.Amount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRedeemTokensForShares, "invalid redeem amount"), nil, err
+		}
+
+		msg := types.NewMsgRedeemTokensForShares(simAccount.Address, sdk.NewCoin(shareToken.Denom, redeemAmt))
+
+		// share tokens are not used to pay the fees, as the redeemed amount
+		// must remain in the account
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+		spendable = sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), spendable.AmountOf(k.BondDenom(ctx))))
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate fees"), nil, err
+		}
+
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+		}
+
+		_, _, err = app.Deliver(txGen.TxEncoder(), tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, "", nil), nil, nil
+	}
+}
@@ -47,6 +47,9 @@ func TestWeightedOperations(t *testing.T) {
 		{simappparams.DefaultWeightMsgDelegate, types.ModuleName, types.TypeMsgDelegate},
 		{simappparams.DefaultWeightMsgUndelegate, types.ModuleName, types.TypeMsgUndelegate},
 		{simappparams.DefaultWeightMsgBeginRedelegate, types.ModuleName, types.TypeMsgBeginRedelegate},
+		{simappparams.DefaultWeightMsgCancelUnbondingDelegation, types.ModuleName, types.TypeMsgCancelUnbondingDelegation},
+		{simappparams.DefaultWeightMsgTokenizeShares, types.ModuleName, types.TypeMsgTokenizeShares},
+		{simappparams.DefaultWeightMsgRedeemTokensForShares, types.ModuleName, types.TypeMsgRedeemTokensForShares},
 	}
 
 	for i, w := range weightesOps {
@@ -261,6 +264,156 @@ func TestSimulateMsgBeginRedelegate(t *testing.T) {
 
 }
 
+// TestSimulateMsgCancelUnbondingDelegation tests the normal scenario of a valid message of type TypeMsgCancelUnbondingDelegation.
+// Abonormal scenarios, where the message is created by an errors, are not tested here.
+func TestSimulateMsgCancelUnbondingDelegation(t *testing.T) {
+	app, ctx := createTestApp(false)
+	blockTime := time.Now().UTC()
+	ctx = ctx.WithBlockTime(blockTime)
+
+	// setup 3 accounts
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := getTestingAccounts(t, r, app, ctx, 3)
+
+	// setup accounts[0] as validator
+	validator0 := getTestingValidator0(t, app, ctx, accounts)
+
+	// setup an unbonding delegation of accounts[1]
+	delegator := accounts[1]
+	unbondingTokens := sdk.TokensFromConsensusPower(2)
+	ubd := types.NewUnbondingDelegation(delegator.Address, validator0.GetOperator(), 1, blockTime.Add(time.Minute), unbondingTokens)
+	app.StakingKeeper.SetUnbondingDelegation(ctx, ubd)
+	app.StakingKeeper.InsertUBDQueue(ctx, ubd, blockTime.Add(time.Minute))
+
+	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
+	require.NoError(t, simapp.FundAccount(app, ctx, notBondedPool.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, unbondingTokens))))
+	app.AccountKeeper.SetModuleAccount(ctx, notBondedPool)
+
+	setupValidatorRewards(app, ctx, validator0.GetOperator())
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash, Time: blockTime}})
+
+	// execute operation
+	op := simulation.SimulateMsgCancelUnbondingDelegation(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+
+	var msg types.MsgCancelUnbondingDelegation
+	types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+
+	require.True(t, operationMsg.OK)
+	require.Equal(t, delegator.Address.String(), msg.DelegatorAddress)
+	require.Equal(t, validator0.GetOperator().String(), msg.ValidatorAddress)
+	require.Equal(t, int64(1), msg.CreationHeight)
+	require.Equal(t, "stake", msg.Amount.Denom)
+	require.True(t, msg.Amount.Amount.LTE(unbondingTokens))
+	require.Equal(t, types.TypeMsgCancelUnbondingDelegation, msg.Type())
+	require.Len(t, futureOperations, 0)
+}
+
+// TestSimulateMsgTokenizeShares tests the normal scenario of a valid message of type TypeMsgTokenizeShares.
+// Abonormal scenarios, where the message is created by an errors, are not tested here.
+func TestSimulateMsgTokenizeShares(t *testing.T) {
+	app, ctx := createTestApp(false)
+	blockTime := time.Now().UTC()
+	ctx = ctx.WithBlockTime(blockTime)
+
+	// setup 3 accounts
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := getTestingAccounts(t, r, app, ctx, 3)
+
+	// setup accounts[0] as validator, with one share per token so that the
+	// random amount is worth at least one share
+	validator0 := getTestingValidator0(t, app, ctx, accounts)
+	validator0.DelegatorShares = validator0.Tokens.ToDec()
+
+	// setup delegation
+	delTokens := sdk.TokensFromConsensusPower(2)
+	validator0, issuedShares := validator0.AddTokensFromDel(delTokens)
+	app.StakingKeeper.SetValidator(ctx, validator0)
+	delegator := accounts[1]
+	delegation := types.NewDelegation(delegator.Address, validator0.GetOperator(), issuedShares)
+	app.StakingKeeper.SetDelegation(ctx, delegation)
+	app.DistrKeeper.SetDelegatorStartingInfo(ctx, validator0.GetOperator(), delegator.Address, distrtypes.NewDelegatorStartingInfo(2, sdk.OneDec(), 200))
+
+	setupValidatorRewards(app, ctx, validator0.GetOperator())
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash, Time: blockTime}})
+
+	// execute operation
+	op := simulation.SimulateMsgTokenizeShares(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+
+	var msg types.MsgTokenizeShares
+	types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+
+	require.True(t, operationMsg.OK)
+	require.Equal(t, delegator.Address.String(), msg.DelegatorAddress)
+	require.Equal(t, validator0.GetOperator().String(), msg.ValidatorAddress)
+	require.Equal(t, "stake", msg.Amount.Denom)
+	require.True(t, msg.Amount.Amount.LTE(delTokens))
+	require.Equal(t, types.TypeMsgTokenizeShares, msg.Type())
+	require.Len(t, futureOperations, 0)
+}
+
+// TestSimulateMsgRedeemTokensForShares tests the normal scenario of a valid message of type TypeMsgRedeemTokensForShares.
+// Abonormal scenarios, where the message is created by an errors, are not tested here.
+func TestSimulateMsgRedeemTokensForShares(t *testing.T) {
+	app, ctx := createTestApp(false)
+	blockTime := time.Now().UTC()
+	ctx = ctx.WithBlockTime(blockTime)
+
+	// setup 1 account
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := getTestingAccounts(t, r, app, ctx, 1)
+
+	// setup accounts[0] as validator
+	validator0 := getTestingValidator0(t, app, ctx, accounts)
+
+	// setup the delegation of a tokenize share record and its share tokens
+	// held by accounts[0]
+	record := types.NewTokenizeShareRecord(1, accounts[0].Address, validator0.GetOperator())
+	app.StakingKeeper.SetTokenizeShareRecord(ctx, record)
+	app.StakingKeeper.SetLastTokenizeShareRecordID(ctx, 1)
+
+	delTokens := sdk.TokensFromConsensusPower(2)
+	validator0, issuedShares := validator0.AddTokensFromDel(delTokens)
+	app.StakingKeeper.SetValidator(ctx, validator0)
+	delegation := types.NewDelegation(record.GetModuleAddress(), validator0.GetOperator(), issuedShares)
+	app.StakingKeeper.SetDelegation(ctx, delegation)
+	app.StakingKeeper.SetValidatorLiquidShares(ctx, validator0.GetOperator(), issuedShares)
+	app.DistrKeeper.SetDelegatorStartingInfo(ctx, validator0.GetOperator(), record.GetModuleAddress(), distrtypes.NewDelegatorStartingInfo(2, sdk.OneDec(), 200))
+
+	shareTokens := sdk.NewCoins(sdk.NewCoin(record.GetShareTokenDenom(), issuedShares.TruncateInt()))
+	require.NoError(t, simapp.FundAccount(app, ctx, accounts[0].Address, shareTokens))
+
+	setupValidatorRewards(app, ctx, validator0.GetOperator())
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash, Time: blockTime}})
+
+	// execute operation
+	op := simulation.SimulateMsgRedeemTokensForShares(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+
+	var msg types.MsgRedeemTokensForShares
+	types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+
+	require.True(t, operationMsg.OK)
+	require.Equal(t, accounts[0].Address.String(), msg.DelegatorAddress)
+	require.Equal(t, record.GetShareTokenDenom(), msg.Amount.Denom)
+	require.True(t, msg.Amount.Amount.LTE(issuedShares.TruncateInt()))
+	require.Equal(t, types.TypeMsgRedeemTokensForShares, msg.Type())
+	require.Len(t, futureOperations, 0)
+}
+
 // returns context and an app with updated mint keeper
 func createTestApp(isCheckTx bool) (*simapp.SimApp, sdk.Context) {
 	// sdk.PowerReduction = sdk.NewIntFromBigInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil))
@@ -31,5 +31,15 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 				return fmt.Sprintf("%d", GetHistEntries(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyGlobalLiquidStakingCap),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenLiquidStakingCap(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyValidatorLiquidStakingCap),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenLiquidStakingCap(r))
+			},
+		),
 	}
 }
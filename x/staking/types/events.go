@@ -10,6 +10,10 @@ const (
 	EventTypeUnbond               = "unbond"
 	EventTypeRedelegate           = "redelegate"
 
+	EventTypeCancelUnbondingDelegation = "cancel_unbonding_delegation"
+	EventTypeTokenizeShares            = "tokenize_shares"
+	EventTypeRedeemShares              = "redeem_shares"
+
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
 	AttributeKeyMinSelfDelegation = "min_self_delegation"
@@ -17,5 +21,8 @@ const (
 	AttributeKeyDstValidator      = "destination_validator"
 	AttributeKeyDelegator         = "delegator"
 	AttributeKeyCompletionTime    = "completion_time"
+	AttributeKeyCreationHeight    = "creation_height"
+	AttributeKeyShareOwner        = "share_owner"
+	AttributeKeyShareRecordID     = "share_record_id"
 	AttributeValueCategory        = ModuleName
 )
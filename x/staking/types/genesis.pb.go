@@ -43,6 +43,11 @@ type GenesisState struct {
 	// redelegations defines the redelegations active at genesis.
 	Redelegations []Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
 	Exported      bool           `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// tokenize_share_records defines the tokenize share records active at genesis.
+	TokenizeShareRecords []TokenizeShareRecord `protobuf:"bytes,9,rep,name=tokenize_share_records,json=tokenizeShareRecords,proto3" json:"tokenize_share_records" yaml:"tokenize_share_records"`
+	// last_tokenize_share_record_id is the id of the last created tokenize share
+	// record.
+	LastTokenizeShareRecordId uint64 `protobuf:"varint,10,opt,name=last_tokenize_share_record_id,json=lastTokenizeShareRecordId,proto3" json:"last_tokenize_share_record_id,omitempty" yaml:"last_tokenize_share_record_id"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -127,6 +132,20 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetTokenizeShareRecords() []TokenizeShareRecord {
+	if m != nil {
+		return m.TokenizeShareRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastTokenizeShareRecordId() uint64 {
+	if m != nil {
+		return m.LastTokenizeShareRecordId
+	}
+	return 0
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -178,38 +197,43 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 493 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x3d, 0x6f, 0xd3, 0x40,
-	0x18, 0xc7, 0x7d, 0xa4, 0x49, 0xc3, 0xa5, 0x20, 0x74, 0xa4, 0x60, 0x45, 0xc8, 0x0e, 0x56, 0x84,
-	0x22, 0x5e, 0x6c, 0xb5, 0x6c, 0x15, 0x53, 0x84, 0xa8, 0x8a, 0x10, 0x8a, 0x8e, 0x97, 0x81, 0x25,
-	0xba, 0xd4, 0x27, 0x63, 0xd5, 0xf1, 0x59, 0x7e, 0x2e, 0xa5, 0xdd, 0x11, 0x62, 0xe4, 0x23, 0xf4,
-	0xe3, 0x74, 0xec, 0xc0, 0x80, 0x18, 0x2c, 0x94, 0x2c, 0xcc, 0xfd, 0x04, 0xc8, 0xe7, 0x17, 0x4c,
-	0x52, 0x33, 0x25, 0x77, 0xfa, 0xfd, 0x7f, 0x7f, 0xfb, 0xfc, 0x1c, 0x1e, 0x1c, 0x0a, 0x98, 0x09,
-	0x70, 0x40, 0xb2, 0x23, 0x3f, 0xf4, 0x9c, 0xe3, 0x9d, 0x29, 0x97, 0x6c, 0xc7, 0xf1, 0x78, 0xc8,
-	0xc1, 0x07, 0x3b, 0x8a, 0x85, 0x14, 0xe4, 0x4e, 0x46, 0xd9, 0x39, 0x65, 0xe7, 0x54, 0xaf, 0xeb,
-	0x09, 0x4f, 0x28, 0xc4, 0x49, 0xff, 0x65, 0x74, 0xaf, 0xce, 0x59, 0xa4, 0x15, 0x65, 0x7d, 0x6f,
-	0xe2, 0xad, 0xfd, 0xac, 0xe5, 0x8d, 0x64, 0x92, 0x93, 0x67, 0xb8, 0x15, 0xb1, 0x98, 0xcd, 0x40,
-	0x47, 0x7d, 0x34, 0xec, 0xec, 0x1a, 0xf6, 0xd5, 0xad, 0xf6, 0x58, 0x51, 0xa3, 0x8d, 0xf3, 0xc4,
-	0xd4, 0x68, 0x9e, 0x21, 0x80, 0x6f, 0x05, 0x0c, 0xe4, 0x44, 0x0a, 0xc9, 0x82, 0x49, 0x24, 0x3e,
-	0xf1, 0x58, 0xbf, 0xd6, 0x47, 0xc3, 0xad, 0xd1, 0x41, 0xca, 0xfd, 0x4c, 0xcc, 0x07, 0x9e, 0x2f,
-	0x3f, 0xce, 0xa7, 0xf6, 0xa1, 0x98, 0x39, 0xf9, 0x13, 0x66, 0x3f, 0x4f, 0xc0, 0x3d, 0x72, 0xe4,
-	0x69, 0xc4, 0xc1, 0x3e, 0x08, 0xe5, 0x65, 0x62, 0xde, 0x3d, 0x65, 0xb3, 0x60, 0xcf, 0x5a, 0xf5,
-	0x59, 0xf4, 0x66, 0xba, 0xf5, 0x36, 0xdd, 0x19, 0xa7, 0x1b, 0xe4, 0x33, 0xc2, 0xdb, 0x8a, 0x3a,
-	0x66, 0x81, 0xef, 0x32, 0x29, 0xe2, 0x8c, 0x04, 0xbd, 0xd1, 0x6f, 0x0c, 0x3b, 0xbb, 0x0f, 0xeb,
-	0x5e, 0xe1, 0x15, 0x03, 0xf9, 0xbe, 0xc8, 0x28, 0xd7, 0x68, 0x90, 0x3e, 0xe6, 0x65, 0x62, 0xde,
-	0xab, 0x94, 0xaf, 0x6a, 0x2d, 0x7a, 0x3b, 0x58, 0x4b, 0x02, 0xd9, 0xc7, 0xb8, 0x24, 0x41, 0xdf,
-	0x50, 0xd5, 0xf7, 0xeb, 0xaa, 0xcb, 0x70, 0x7e, 0x80, 0x95, 0x28, 0x79, 0x89, 0x3b, 0x2e, 0x0f,
-	0xb8, 0xc7, 0xa4, 0x2f, 0x42, 0xd0, 0x9b, 0xca, 0x64, 0xd5, 0x99, 0x9e, 0x97, 0x68, 0xae, 0xaa,
-	0x86, 0xc9, 0x17, 0x84, 0xb7, 0xe7, 0xe1, 0x54, 0x84, 0xae, 0x1f, 0x7a, 0x93, 0xaa, 0xb6, 0xa5,
-	0xb4, 0x8f, 0xea, 0xb4, 0xef, 0x8a, 0x50, 0xc5, 0xbf, 0x72, 0x38, 0x57, 0x7a, 0x2d, 0xda, 0x9d,
-	0xaf, 0x47, 0x81, 0x8c, 0xf1, 0x8d, 0x98, 0x57, 0xfb, 0x37, 0x55, 0xff, 0xa0, 0xae, 0x9f, 0x56,
-	0xe0, 0xfc, 0xc5, 0xfe, 0x15, 0x90, 0x1e, 0x6e, 0xf3, 0x93, 0x48, 0xc4, 0x92, 0xbb, 0x7a, 0xbb,
-	0x8f, 0x86, 0x6d, 0x5a, 0xae, 0xad, 0xd7, 0x98, 0xac, 0x7f, 0x5c, 0xa2, 0xe3, 0x4d, 0xe6, 0xba,
-	0x31, 0x87, 0x6c, 0xb8, 0xaf, 0xd3, 0x62, 0x49, 0xba, 0xb8, 0xf9, 0x77, 0x58, 0x1b, 0x34, 0x5b,
-	0xec, 0xb5, 0xbf, 0x9e, 0x99, 0xda, 0xef, 0x33, 0x53, 0x1b, 0xbd, 0x38, 0x5f, 0x18, 0xe8, 0x62,
-	0x61, 0xa0, 0x5f, 0x0b, 0x03, 0x7d, 0x5b, 0x1a, 0xda, 0xc5, 0xd2, 0xd0, 0x7e, 0x2c, 0x0d, 0xed,
-	0xc3, 0xe3, 0xff, 0xce, 0xf3, 0x49, 0x79, 0xfd, 0xd4, 0x64, 0x4f, 0x5b, 0xea, 0xd6, 0x3d, 0xfd,
-	0x13, 0x00, 0x00, 0xff, 0xff, 0xff, 0x85, 0xad, 0xc8, 0xf1, 0x03, 0x00, 0x00,
+	// 572 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x13, 0xf6, 0xa7, 0x9d, 0x3b, 0x10, 0x32, 0xdd, 0x08, 0x15, 0x4b, 0x4a, 0x54, 0x50,
+	0xc4, 0x9f, 0x44, 0x1b, 0xb7, 0x89, 0x53, 0x84, 0x98, 0x8a, 0x10, 0xaa, 0xbc, 0xc1, 0x81, 0x4b,
+	0xe4, 0xd6, 0x56, 0x16, 0x9a, 0xc6, 0x55, 0xec, 0x8e, 0x8d, 0x33, 0x42, 0x3b, 0xf2, 0x11, 0xf6,
+	0x71, 0x26, 0x71, 0xd9, 0x11, 0x71, 0xa8, 0x50, 0x7b, 0xe1, 0xdc, 0x4f, 0x80, 0xe2, 0xa4, 0x25,
+	0x6b, 0x9b, 0x9d, 0x12, 0x5b, 0xcf, 0xf3, 0x7b, 0xfc, 0x5a, 0xef, 0x6b, 0xd0, 0xe8, 0x30, 0xde,
+	0x63, 0xdc, 0xe1, 0x02, 0x77, 0x83, 0xc8, 0x77, 0x4e, 0x76, 0xdb, 0x54, 0xe0, 0x5d, 0xc7, 0xa7,
+	0x11, 0xe5, 0x01, 0xb7, 0xfb, 0x31, 0x13, 0x0c, 0x6e, 0xa7, 0x2a, 0x3b, 0x53, 0xd9, 0x99, 0xaa,
+	0x56, 0xf5, 0x99, 0xcf, 0xa4, 0xc4, 0x49, 0xfe, 0x52, 0x75, 0xad, 0x88, 0x39, 0x75, 0x4b, 0x95,
+	0xf9, 0xb3, 0x04, 0x36, 0x0f, 0xd2, 0x94, 0x43, 0x81, 0x05, 0x85, 0xaf, 0xc0, 0x7a, 0x1f, 0xc7,
+	0xb8, 0xc7, 0x35, 0xb5, 0xae, 0x5a, 0x95, 0x3d, 0xdd, 0x5e, 0x9e, 0x6a, 0xb7, 0xa4, 0xca, 0x5d,
+	0xbd, 0x1c, 0x1a, 0x0a, 0xca, 0x3c, 0x90, 0x83, 0xbb, 0x21, 0xe6, 0xc2, 0x13, 0x4c, 0xe0, 0xd0,
+	0xeb, 0xb3, 0x2f, 0x34, 0xd6, 0x6e, 0xd5, 0x55, 0x6b, 0xd3, 0x6d, 0x26, 0xba, 0xdf, 0x43, 0xe3,
+	0x89, 0x1f, 0x88, 0xe3, 0x41, 0xdb, 0xee, 0xb0, 0x9e, 0x93, 0x9d, 0x30, 0xfd, 0xbc, 0xe0, 0xa4,
+	0xeb, 0x88, 0xb3, 0x3e, 0xe5, 0x76, 0x33, 0x12, 0x93, 0xa1, 0x71, 0xff, 0x0c, 0xf7, 0xc2, 0x7d,
+	0x73, 0x9e, 0x67, 0xa2, 0x3b, 0xc9, 0xd6, 0x51, 0xb2, 0xd3, 0x4a, 0x36, 0xe0, 0x37, 0x15, 0x6c,
+	0x49, 0xd5, 0x09, 0x0e, 0x03, 0x82, 0x05, 0x8b, 0x53, 0x25, 0xd7, 0x56, 0xea, 0x2b, 0x56, 0x65,
+	0xef, 0x69, 0x51, 0x09, 0xef, 0x30, 0x17, 0x1f, 0xa7, 0x1e, 0xc9, 0x72, 0x1b, 0xc9, 0x31, 0x27,
+	0x43, 0xe3, 0x61, 0x2e, 0x7c, 0x1e, 0x6b, 0xa2, 0x7b, 0xe1, 0x82, 0x93, 0xc3, 0x03, 0x00, 0x66,
+	0x4a, 0xae, 0xad, 0xca, 0xe8, 0x47, 0x45, 0xd1, 0x33, 0x73, 0x76, 0x81, 0x39, 0x2b, 0x7c, 0x0b,
+	0x2a, 0x84, 0x86, 0xd4, 0xc7, 0x22, 0x60, 0x11, 0xd7, 0xd6, 0x24, 0xc9, 0x2c, 0x22, 0xbd, 0x9e,
+	0x49, 0x33, 0x54, 0xde, 0x0c, 0xbf, 0xab, 0x60, 0x6b, 0x10, 0xb5, 0x59, 0x44, 0x82, 0xc8, 0xf7,
+	0xf2, 0xd8, 0x75, 0x89, 0x7d, 0x56, 0x84, 0xfd, 0x30, 0x35, 0xe5, 0xf8, 0x73, 0x97, 0xb3, 0x94,
+	0x6b, 0xa2, 0xea, 0x60, 0xd1, 0xca, 0x61, 0x0b, 0xdc, 0x8e, 0x69, 0x3e, 0xbf, 0x24, 0xf3, 0x1b,
+	0x45, 0xf9, 0x88, 0x92, 0xf9, 0xc2, 0xae, 0x03, 0x60, 0x0d, 0x94, 0xe9, 0x69, 0x9f, 0xc5, 0x82,
+	0x12, 0xad, 0x5c, 0x57, 0xad, 0x32, 0x9a, 0xad, 0xe1, 0xb9, 0x0a, 0xb6, 0x05, 0xeb, 0xd2, 0x28,
+	0xf8, 0x4a, 0x3d, 0x7e, 0x8c, 0x63, 0xea, 0xc5, 0xb4, 0xc3, 0x62, 0xc2, 0xb5, 0x8d, 0x9b, 0xeb,
+	0x3e, 0xca, 0x5c, 0x87, 0x89, 0x09, 0x49, 0x8f, 0xfb, 0x38, 0xab, 0x7b, 0x27, 0xad, 0x7b, 0x39,
+	0xd8, 0x44, 0x55, 0xb1, 0xe8, 0xe5, 0xf0, 0x33, 0xd8, 0xc9, 0x5a, 0x78, 0x89, 0xcb, 0x0b, 0x88,
+	0x06, 0xea, 0xaa, 0xb5, 0xea, 0x5a, 0x93, 0xa1, 0xd1, 0xb8, 0xd6, 0xf1, 0xcb, 0xe5, 0x26, 0x7a,
+	0x90, 0xb6, 0xff, 0x42, 0x54, 0x93, 0x98, 0xef, 0x01, 0x5c, 0xec, 0x69, 0xa8, 0x81, 0x12, 0x26,
+	0x24, 0xa6, 0x3c, 0x9d, 0xe9, 0x0d, 0x34, 0x5d, 0xc2, 0x2a, 0x58, 0xfb, 0x3f, 0xa3, 0x2b, 0x28,
+	0x5d, 0xec, 0x97, 0xcf, 0x2f, 0x0c, 0xe5, 0xef, 0x85, 0xa1, 0xb8, 0x6f, 0x2e, 0x47, 0xba, 0x7a,
+	0x35, 0xd2, 0xd5, 0x3f, 0x23, 0x5d, 0xfd, 0x31, 0xd6, 0x95, 0xab, 0xb1, 0xae, 0xfc, 0x1a, 0xeb,
+	0xca, 0xa7, 0xe7, 0x37, 0x8e, 0xf1, 0xe9, 0xec, 0xd5, 0x91, 0x03, 0xdd, 0x5e, 0x97, 0x8f, 0xcd,
+	0xcb, 0x7f, 0x03, 0x00, 0x89, 0x9a, 0x59, 0x27, 0xe8, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -232,6 +256,25 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastTokenizeShareRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTokenizeShareRecordId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for iNdEx := len(m.TokenizeShareRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenizeShareRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -424,6 +467,15 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for _, e := range m.TokenizeShareRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastTokenizeShareRecordId))
+	}
 	return n
 }
 
@@ -734,6 +786,59 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizeShareRecords = append(m.TokenizeShareRecords, TokenizeShareRecord{})
+			if err := m.TokenizeShareRecords[len(m.TokenizeShareRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTokenizeShareRecordId", wireType)
+			}
+			m.LastTokenizeShareRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTokenizeShareRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -47,6 +47,12 @@ var (
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
 
 	HistoricalInfoKey = []byte{0x50} // prefix for the historical info
+
+	TokenizeShareRecordPrefix          = []byte{0x61} // key for tokenize share record with given id
+	TokenizeShareRecordIDByOwnerPrefix = []byte{0x62} // key for tokenize share record id by owner
+	TokenizeShareRecordIDByDenomPrefix = []byte{0x63} // key for tokenize share record id by denom
+	LastTokenizeShareRecordIDKey       = []byte{0x64} // key for last tokenize share record id
+	ValidatorLiquidSharesKey           = []byte{0x65} // prefix for the tokenized delegator shares of a validator
 )
 
 // GetValidatorKey creates the key for the validator with address
@@ -333,3 +339,32 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 func GetHistoricalInfoKey(height int64) []byte {
 	return append(HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
 }
+
+// GetTokenizeShareRecordByIndexKey returns the key of a tokenize share record.
+func GetTokenizeShareRecordByIndexKey(id uint64) []byte {
+	return append(TokenizeShareRecordPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDsByOwnerPrefix returns the key prefix for indexing
+// the tokenize share records of an owner.
+func GetTokenizeShareRecordIDsByOwnerPrefix(owner sdk.AccAddress) []byte {
+	return append(TokenizeShareRecordIDByOwnerPrefix, address.MustLengthPrefix(owner)...)
+}
+
+// GetTokenizeShareRecordIDByOwnerAndIDKey returns the key for indexing a
+// tokenize share record by its owner.
+func GetTokenizeShareRecordIDByOwnerAndIDKey(owner sdk.AccAddress, id uint64) []byte {
+	return append(GetTokenizeShareRecordIDsByOwnerPrefix(owner), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDByDenomKey returns the key for indexing a tokenize
+// share record by its share denom.
+func GetTokenizeShareRecordIDByDenomKey(denom string) []byte {
+	return append(TokenizeShareRecordIDByDenomPrefix, []byte(denom)...)
+}
+
+// GetValidatorLiquidSharesKey returns the key for the tokenized delegator
+// shares of a validator.
+func GetValidatorLiquidSharesKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorLiquidSharesKey, address.MustLengthPrefix(valAddr)...)
+}
@@ -25,12 +25,20 @@ const (
 	TypeMsgDelegate        = "delegate"
 	TypeMsgBeginRedelegate = "begin_redelegate"
 
+	TypeMsgCancelUnbondingDelegation = "cancel_unbonding_delegation"
+	TypeMsgTokenizeShares            = "tokenize_shares"
+	TypeMsgRedeemTokensForShares     = "redeem_tokens_for_shares"
+
 	// These are used for querying events by action.
 	TypeSvcMsgUndelegate      = "/cosmos.staking.v1beta1.Msg/Undelegate"
 	TypeSvcMsgEditValidator   = "/cosmos.staking.v1beta1.Msg/EditValidator"
 	TypeSvcMsgCreateValidator = "/cosmos.staking.v1beta1.Msg/CreateValidator"
 	TypeSvcMsgDelegate        = "/cosmos.staking.v1beta1.Msg/Delegate"
 	TypeSvcMsgBeginRedelegate = "/cosmos.staking.v1beta1.Msg/BeginRedelegate"
+
+	TypeSvcMsgCancelUnbondingDelegation = "/cosmos.staking.v1beta1.Msg/CancelUnbondingDelegation"
+	TypeSvcMsgTokenizeShares            = "/cosmos.staking.v1beta1.Msg/TokenizeShares"
+	TypeSvcMsgRedeemTokensForShares     = "/cosmos.staking.v1beta1.Msg/RedeemTokensForShares"
 )
 
 var (
@@ -41,6 +49,9 @@ var (
 	_ sdk.Msg                            = &MsgDelegate{}
 	_ sdk.Msg                            = &MsgUndelegate{}
 	_ sdk.Msg                            = &MsgBeginRedelegate{}
+	_ sdk.Msg                            = &MsgCancelUnbondingDelegation{}
+	_ sdk.Msg                            = &MsgTokenizeShares{}
+	_ sdk.Msg                            = &MsgRedeemTokensForShares{}
 )
 
 // NewMsgCreateValidator creates a new MsgCreateValidator instance.
@@ -632,3 +643,156 @@ func (msg *MsgUndelegate) FromOperations(ops []*rosettatypes.Operation) (sdk.Msg
 
 	return NewMsgUndelegate(delAddr, valAddr, undelAmt), nil
 }
+
+// NewMsgCancelUnbondingDelegation creates a new MsgCancelUnbondingDelegation instance.
+//nolint:interfacer
+func NewMsgCancelUnbondingDelegation(
+	delAddr sdk.AccAddress, valAddr sdk.ValAddress, creationHeight int64, amount sdk.Coin,
+) *MsgCancelUnbondingDelegation {
+	return &MsgCancelUnbondingDelegation{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Amount:           amount,
+		CreationHeight:   creationHeight,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgCancelUnbondingDelegation) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgCancelUnbondingDelegation) Type() string { return TypeMsgCancelUnbondingDelegation }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgCancelUnbondingDelegation) GetSigners() []sdk.AccAddress {
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delAddr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgCancelUnbondingDelegation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCancelUnbondingDelegation) ValidateBasic() error {
+	if msg.DelegatorAddress == "" {
+		return ErrEmptyDelegatorAddr
+	}
+
+	if msg.ValidatorAddress == "" {
+		return ErrEmptyValidatorAddr
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return ErrBadSharesAmount
+	}
+
+	if msg.CreationHeight <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid creation height")
+	}
+
+	return nil
+}
+
+// NewMsgTokenizeShares creates a new MsgTokenizeShares instance.
+//nolint:interfacer
+func NewMsgTokenizeShares(
+	delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin, owner sdk.AccAddress,
+) *MsgTokenizeShares {
+	return &MsgTokenizeShares{
+		DelegatorAddress:    delAddr.String(),
+		ValidatorAddress:    valAddr.String(),
+		Amount:              amount,
+		TokenizedShareOwner: owner.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgTokenizeShares) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgTokenizeShares) Type() string { return TypeMsgTokenizeShares }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgTokenizeShares) GetSigners() []sdk.AccAddress {
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delAddr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgTokenizeShares) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgTokenizeShares) ValidateBasic() error {
+	if msg.DelegatorAddress == "" {
+		return ErrEmptyDelegatorAddr
+	}
+
+	if msg.ValidatorAddress == "" {
+		return ErrEmptyValidatorAddr
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.TokenizedShareOwner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid tokenized share owner: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return ErrBadSharesAmount
+	}
+
+	return nil
+}
+
+// NewMsgRedeemTokensForShares creates a new MsgRedeemTokensForShares instance.
+//nolint:interfacer
+func NewMsgRedeemTokensForShares(delAddr sdk.AccAddress, amount sdk.Coin) *MsgRedeemTokensForShares {
+	return &MsgRedeemTokensForShares{
+		DelegatorAddress: delAddr.String(),
+		Amount:           amount,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgRedeemTokensForShares) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgRedeemTokensForShares) Type() string { return TypeMsgRedeemTokensForShares }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRedeemTokensForShares) GetSigners() []sdk.AccAddress {
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delAddr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgRedeemTokensForShares) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRedeemTokensForShares) ValidateBasic() error {
+	if msg.DelegatorAddress == "" {
+		return ErrEmptyDelegatorAddr
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return ErrBadSharesAmount
+	}
+
+	return nil
+}
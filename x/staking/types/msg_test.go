@@ -198,3 +198,82 @@ func TestMsgUndelegate(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgCancelUnbondingDelegation(t *testing.T) {
+	tests := []struct {
+		name           string
+		delegatorAddr  sdk.AccAddress
+		validatorAddr  sdk.ValAddress
+		creationHeight int64
+		amount         sdk.Coin
+		expectPass     bool
+	}{
+		{"regular", sdk.AccAddress(valAddr1), valAddr2, 1, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), true},
+		{"zero amount", sdk.AccAddress(valAddr1), valAddr2, 1, sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), false},
+		{"nil amount", sdk.AccAddress(valAddr1), valAddr2, 1, sdk.Coin{}, false},
+		{"zero creation height", sdk.AccAddress(valAddr1), valAddr2, 0, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+		{"empty delegator", sdk.AccAddress(emptyAddr), valAddr1, 1, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+		{"empty validator", sdk.AccAddress(valAddr1), emptyAddr, 1, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+	}
+
+	for _, tc := range tests {
+		msg := types.NewMsgCancelUnbondingDelegation(tc.delegatorAddr, tc.validatorAddr, tc.creationHeight, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+func TestMsgTokenizeShares(t *testing.T) {
+	tests := []struct {
+		name          string
+		delegatorAddr sdk.AccAddress
+		validatorAddr sdk.ValAddress
+		amount        sdk.Coin
+		owner         sdk.AccAddress
+		expectPass    bool
+	}{
+		{"regular", sdk.AccAddress(valAddr1), valAddr2, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), sdk.AccAddress(valAddr3), true},
+		{"zero amount", sdk.AccAddress(valAddr1), valAddr2, sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), sdk.AccAddress(valAddr3), false},
+		{"nil amount", sdk.AccAddress(valAddr1), valAddr2, sdk.Coin{}, sdk.AccAddress(valAddr3), false},
+		{"empty delegator", sdk.AccAddress(emptyAddr), valAddr1, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), sdk.AccAddress(valAddr3), false},
+		{"empty validator", sdk.AccAddress(valAddr1), emptyAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), sdk.AccAddress(valAddr3), false},
+		{"empty owner", sdk.AccAddress(valAddr1), valAddr2, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), sdk.AccAddress(emptyAddr), false},
+	}
+
+	for _, tc := range tests {
+		msg := types.NewMsgTokenizeShares(tc.delegatorAddr, tc.validatorAddr, tc.amount, tc.owner)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+func TestMsgRedeemTokensForShares(t *testing.T) {
+	shareDenom := valAddr2.String() + "/1"
+
+	tests := []struct {
+		name          string
+		delegatorAddr sdk.AccAddress
+		amount        sdk.Coin
+		expectPass    bool
+	}{
+		{"regular", sdk.AccAddress(valAddr1), sdk.NewInt64Coin(shareDenom, 1), true},
+		{"zero amount", sdk.AccAddress(valAddr1), sdk.NewInt64Coin(shareDenom, 0), false},
+		{"nil amount", sdk.AccAddress(valAddr1), sdk.Coin{}, false},
+		{"empty delegator", sdk.AccAddress(emptyAddr), sdk.NewInt64Coin(shareDenom, 1), false},
+	}
+
+	for _, tc := range tests {
+		msg := types.NewMsgRedeemTokensForShares(tc.delegatorAddr, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
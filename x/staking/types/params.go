@@ -32,12 +32,36 @@ const (
 	DefaultHistoricalEntries uint32 = 10000
 )
 
+var (
+	// DefaultGlobalLiquidStakingCap is 100%, i.e. all the bonded tokens can be
+	// tokenized.
+	DefaultGlobalLiquidStakingCap = sdk.OneDec()
+
+	// DefaultValidatorLiquidStakingCap is 100%, i.e. all the delegator shares of
+	// a validator can be tokenized.
+	DefaultValidatorLiquidStakingCap = sdk.OneDec()
+
+	// DefaultMinCommissionRate is 0%, i.e. validators can run without
+	// commission.
+	DefaultMinCommissionRate = sdk.ZeroDec()
+
+	// DefaultMinSelfDelegationFloor is zero, i.e. the min self delegation of
+	// validators is only required to be positive.
+	DefaultMinSelfDelegationFloor = sdk.ZeroInt()
+)
+
 var (
 	KeyUnbondingTime     = []byte("UnbondingTime")
 	KeyMaxValidators     = []byte("MaxValidators")
 	KeyMaxEntries        = []byte("MaxEntries")
 	KeyBondDenom         = []byte("BondDenom")
 	KeyHistoricalEntries = []byte("HistoricalEntries")
+
+	KeyGlobalLiquidStakingCap    = []byte("GlobalLiquidStakingCap")
+	KeyValidatorLiquidStakingCap = []byte("ValidatorLiquidStakingCap")
+
+	KeyMinCommissionRate      = []byte("MinCommissionRate")
+	KeyMinSelfDelegationFloor = []byte("MinSelfDelegationFloor")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -48,13 +72,20 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string) Params {
+func NewParams(
+	unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string,
+	globalLiquidStakingCap, validatorLiquidStakingCap, minCommissionRate sdk.Dec, minSelfDelegationFloor sdk.Int,
+) Params {
 	return Params{
-		UnbondingTime:     unbondingTime,
-		MaxValidators:     maxValidators,
-		MaxEntries:        maxEntries,
-		HistoricalEntries: historicalEntries,
-		BondDenom:         bondDenom,
+		UnbondingTime:             unbondingTime,
+		MaxValidators:             maxValidators,
+		MaxEntries:                maxEntries,
+		HistoricalEntries:         historicalEntries,
+		BondDenom:                 bondDenom,
+		GlobalLiquidStakingCap:    globalLiquidStakingCap,
+		ValidatorLiquidStakingCap: validatorLiquidStakingCap,
+		MinCommissionRate:         minCommissionRate,
+		MinSelfDelegationFloor:    minSelfDelegationFloor,
 	}
 }
 
@@ -66,6 +97,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyMaxEntries, &p.MaxEntries, validateMaxEntries),
 		paramtypes.NewParamSetPair(KeyHistoricalEntries, &p.HistoricalEntries, validateHistoricalEntries),
 		paramtypes.NewParamSetPair(KeyBondDenom, &p.BondDenom, validateBondDenom),
+		paramtypes.NewParamSetPair(KeyGlobalLiquidStakingCap, &p.GlobalLiquidStakingCap, validateLiquidStakingCap),
+		paramtypes.NewParamSetPair(KeyValidatorLiquidStakingCap, &p.ValidatorLiquidStakingCap, validateLiquidStakingCap),
+		paramtypes.NewParamSetPair(KeyMinCommissionRate, &p.MinCommissionRate, validateMinCommissionRate),
+		paramtypes.NewParamSetPair(KeyMinSelfDelegationFloor, &p.MinSelfDelegationFloor, validateMinSelfDelegationFloor),
 	}
 }
 
@@ -77,6 +112,10 @@ func DefaultParams() Params {
 		DefaultMaxEntries,
 		DefaultHistoricalEntries,
 		sdk.DefaultBondDenom,
+		DefaultGlobalLiquidStakingCap,
+		DefaultValidatorLiquidStakingCap,
+		DefaultMinCommissionRate,
+		DefaultMinSelfDelegationFloor,
 	)
 }
 
@@ -124,6 +163,22 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateLiquidStakingCap(p.GlobalLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap(p.ValidatorLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateMinCommissionRate(p.MinCommissionRate); err != nil {
+		return err
+	}
+
+	if err := validateMinSelfDelegationFloor(p.MinSelfDelegationFloor); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -191,3 +246,62 @@ func validateBondDenom(i interface{}) error {
 
 	return nil
 }
+
+func validateLiquidStakingCap(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return errors.New("liquid staking cap cannot be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("liquid staking cap cannot be negative: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("liquid staking cap too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateMinCommissionRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return errors.New("min commission rate cannot be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("min commission rate cannot be negative: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("min commission rate too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateMinSelfDelegationFloor(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return errors.New("min self delegation floor cannot be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("min self delegation floor cannot be negative: %s", v)
+	}
+
+	return nil
+}
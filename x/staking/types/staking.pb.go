@@ -844,6 +844,17 @@ type Params struct {
 	HistoricalEntries uint32 `protobuf:"varint,4,opt,name=historical_entries,json=historicalEntries,proto3" json:"historical_entries,omitempty" yaml:"historical_entries"`
 	// bond_denom defines the bondable coin denomination.
 	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty" yaml:"bond_denom"`
+	// global_liquid_staking_cap is the maximum fraction of the total bonded
+	// tokens that can be tokenized.
+	GlobalLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"global_liquid_staking_cap" yaml:"global_liquid_staking_cap"`
+	// validator_liquid_staking_cap is the maximum fraction of the delegator
+	// shares of a validator that can be tokenized.
+	ValidatorLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_liquid_staking_cap" yaml:"validator_liquid_staking_cap"`
+	// min_commission_rate is the minimum commission rate of the validators.
+	MinCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_commission_rate" yaml:"min_commission_rate"`
+	// min_self_delegation_floor is the minimum value of the min self delegation
+	// of the validators.
+	MinSelfDelegationFloor github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=min_self_delegation_floor,json=minSelfDelegationFloor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_self_delegation_floor" yaml:"min_self_delegation_floor"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -1109,6 +1120,81 @@ func (m *Pool) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Pool proto.InternalMessageInfo
 
+// TokenizeShareRecord represents the delegation of tokenized shares. The
+// delegation is held by the record account, and the shares are represented by
+// the share denom of the record, minted in a 1:1 ratio.
+type TokenizeShareRecord struct {
+	// id is the unique identifier of the record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address which can withdraw the rewards of the delegation.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// module_account is the name of the record account holding the delegation.
+	ModuleAccount string `protobuf:"bytes,3,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty" yaml:"module_account"`
+	// validator is the operator address of the validator delegated to.
+	Validator string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *TokenizeShareRecord) Reset()         { *m = TokenizeShareRecord{} }
+func (m *TokenizeShareRecord) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecord) ProtoMessage()    {}
+func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{20}
+}
+func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecord.Merge(m, src)
+}
+func (m *TokenizeShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecord proto.InternalMessageInfo
+
+func (m *TokenizeShareRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TokenizeShareRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetModuleAccount() string {
+	if m != nil {
+		return m.ModuleAccount
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
 	proto.RegisterType((*HistoricalInfo)(nil), "cosmos.staking.v1beta1.HistoricalInfo")
@@ -1131,6 +1217,7 @@ func init() {
 	proto.RegisterType((*RedelegationEntryResponse)(nil), "cosmos.staking.v1beta1.RedelegationEntryResponse")
 	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.v1beta1.RedelegationResponse")
 	proto.RegisterType((*Pool)(nil), "cosmos.staking.v1beta1.Pool")
+	proto.RegisterType((*TokenizeShareRecord)(nil), "cosmos.staking.v1beta1.TokenizeShareRecord")
 }
 
 func init() {
@@ -1138,120 +1225,132 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 1796 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4d, 0x6c, 0x23, 0x49,
-	0x15, 0x76, 0x3b, 0x5e, 0xc7, 0x7e, 0x4e, 0xe2, 0xa4, 0x26, 0x33, 0xeb, 0x98, 0xc1, 0xed, 0x6d,
-	0x56, 0x4b, 0x40, 0xbb, 0x0e, 0x93, 0x45, 0x8b, 0xc8, 0x05, 0xc6, 0x71, 0x86, 0x58, 0xbb, 0x0c,
-	0xa1, 0x93, 0x09, 0x12, 0xac, 0xb0, 0xca, 0xdd, 0x15, 0xa7, 0x89, 0xbb, 0xdb, 0x74, 0x95, 0x87,
-	0x58, 0xda, 0x03, 0xc7, 0x65, 0x10, 0x62, 0xb9, 0xed, 0x65, 0xa4, 0x91, 0xf6, 0xba, 0x12, 0x17,
-	0xc4, 0x95, 0xeb, 0x02, 0x97, 0xe1, 0x86, 0x10, 0x32, 0x68, 0xe6, 0x82, 0x38, 0x21, 0x1f, 0x10,
-	0x37, 0x50, 0xfd, 0xf4, 0x4f, 0xda, 0xf1, 0xcc, 0x78, 0xb4, 0x87, 0x91, 0xd8, 0x4b, 0xe2, 0x7a,
-	0xf5, 0xde, 0xf7, 0xea, 0xfd, 0xd6, 0xab, 0x86, 0x57, 0x2d, 0x9f, 0xba, 0x3e, 0xdd, 0xa2, 0x0c,
-	0x9f, 0x39, 0x5e, 0x6f, 0xeb, 0xee, 0x8d, 0x2e, 0x61, 0xf8, 0x46, 0xb8, 0x6e, 0x0c, 0x02, 0x9f,
-	0xf9, 0xe8, 0x9a, 0xe4, 0x6a, 0x84, 0x54, 0xc5, 0x55, 0x5d, 0xef, 0xf9, 0x3d, 0x5f, 0xb0, 0x6c,
-	0xf1, 0x5f, 0x92, 0xbb, 0xba, 0xd1, 0xf3, 0xfd, 0x5e, 0x9f, 0x6c, 0x89, 0x55, 0x77, 0x78, 0xb2,
-	0x85, 0xbd, 0x91, 0xda, 0xaa, 0xa5, 0xb7, 0xec, 0x61, 0x80, 0x99, 0xe3, 0x7b, 0x6a, 0x5f, 0x4f,
-	0xef, 0x33, 0xc7, 0x25, 0x94, 0x61, 0x77, 0x10, 0x62, 0xcb, 0x93, 0x74, 0xa4, 0x52, 0x75, 0x2c,
-	0x85, 0xad, 0x4c, 0xe9, 0x62, 0x4a, 0x22, 0x3b, 0x2c, 0xdf, 0x09, 0xb1, 0xaf, 0x33, 0xe2, 0xd9,
-	0x24, 0x70, 0x1d, 0x8f, 0x6d, 0xb1, 0xd1, 0x80, 0x50, 0xf9, 0x57, 0xee, 0x1a, 0x3f, 0xd3, 0x60,
-	0x65, 0xdf, 0xa1, 0xcc, 0x0f, 0x1c, 0x0b, 0xf7, 0xdb, 0xde, 0x89, 0x8f, 0xde, 0x82, 0xfc, 0x29,
-	0xc1, 0x36, 0x09, 0x2a, 0x5a, 0x5d, 0xdb, 0x2c, 0x6d, 0x57, 0x1a, 0x31, 0x42, 0x43, 0xca, 0xee,
-	0x8b, 0xfd, 0x66, 0xee, 0x93, 0xb1, 0x9e, 0x31, 0x15, 0x37, 0xfa, 0x06, 0xe4, 0xef, 0xe2, 0x3e,
-	0x25, 0xac, 0x92, 0xad, 0x2f, 0x6c, 0x96, 0xb6, 0x5f, 0x69, 0x5c, 0xee, 0xbe, 0xc6, 0x31, 0xee,
-	0x3b, 0x36, 0x66, 0x7e, 0x04, 0x20, 0xc5, 0x8c, 0x5f, 0x67, 0xa1, 0xbc, 0xeb, 0xbb, 0xae, 0x43,
-	0xa9, 0xe3, 0x7b, 0x26, 0x66, 0x84, 0xa2, 0x26, 0xe4, 0x02, 0xcc, 0x88, 0x38, 0x4a, 0xb1, 0xd9,
-	0xe0, 0xfc, 0x7f, 0x19, 0xeb, 0xaf, 0xf5, 0x1c, 0x76, 0x3a, 0xec, 0x36, 0x2c, 0xdf, 0x55, 0xce,
-	0x50, 0xff, 0xde, 0xa0, 0xf6, 0x99, 0xb2, 0xaf, 0x45, 0x2c, 0x53, 0xc8, 0xa2, 0x77, 0xa1, 0xe0,
-	0xe2, 0xf3, 0x8e, 0xc0, 0xc9, 0x0a, 0x9c, 0x9b, 0xf3, 0xe1, 0x4c, 0xc6, 0x7a, 0x79, 0x84, 0xdd,
-	0xfe, 0x8e, 0x11, 0xe2, 0x18, 0xe6, 0xa2, 0x8b, 0xcf, 0xf9, 0x11, 0xd1, 0x00, 0xca, 0x9c, 0x6a,
-	0x9d, 0x62, 0xaf, 0x47, 0xa4, 0x92, 0x05, 0xa1, 0x64, 0x7f, 0x6e, 0x25, 0xd7, 0x62, 0x25, 0x09,
-	0x38, 0xc3, 0x5c, 0x76, 0xf1, 0xf9, 0xae, 0x20, 0x70, 0x8d, 0x3b, 0x85, 0x0f, 0x1f, 0xe8, 0x99,
-	0x7f, 0x3c, 0xd0, 0x35, 0xe3, 0x4f, 0x1a, 0x40, 0xec, 0x31, 0xf4, 0x2e, 0xac, 0x5a, 0xd1, 0x4a,
-	0xc8, 0x52, 0x15, 0xc3, 0x2f, 0xce, 0x8a, 0x45, 0xca, 0xdf, 0xcd, 0x02, 0x3f, 0xf4, 0xc3, 0xb1,
-	0xae, 0x99, 0x65, 0x2b, 0x15, 0x8a, 0x1f, 0x40, 0x69, 0x38, 0xb0, 0x31, 0x23, 0x1d, 0x9e, 0x9d,
-	0xc2, 0x93, 0xa5, 0xed, 0x6a, 0x43, 0xa6, 0x6e, 0x23, 0x4c, 0xdd, 0xc6, 0x51, 0x98, 0xba, 0xcd,
-	0x1a, 0xc7, 0x9a, 0x8c, 0x75, 0x24, 0xcd, 0x4a, 0x08, 0x1b, 0x1f, 0xfc, 0x4d, 0xd7, 0x4c, 0x90,
-	0x14, 0x2e, 0x90, 0xb0, 0xe9, 0xf7, 0x1a, 0x94, 0x5a, 0x84, 0x5a, 0x81, 0x33, 0xe0, 0x15, 0x82,
-	0x2a, 0xb0, 0xe8, 0xfa, 0x9e, 0x73, 0xa6, 0xf2, 0xb1, 0x68, 0x86, 0x4b, 0x54, 0x85, 0x82, 0x63,
-	0x13, 0x8f, 0x39, 0x6c, 0x24, 0xe3, 0x6a, 0x46, 0x6b, 0x2e, 0xf5, 0x13, 0xd2, 0xa5, 0x4e, 0x18,
-	0x0d, 0x33, 0x5c, 0xa2, 0x5b, 0xb0, 0x4a, 0x89, 0x35, 0x0c, 0x1c, 0x36, 0xea, 0x58, 0xbe, 0xc7,
-	0xb0, 0xc5, 0x2a, 0x39, 0x11, 0xb0, 0xcf, 0x4d, 0xc6, 0xfa, 0xcb, 0xf2, 0xac, 0x69, 0x0e, 0xc3,
-	0x2c, 0x87, 0xa4, 0x5d, 0x49, 0xe1, 0x1a, 0x6c, 0xc2, 0xb0, 0xd3, 0xa7, 0x95, 0x97, 0xa4, 0x06,
-	0xb5, 0x4c, 0xd8, 0xf2, 0xf1, 0x22, 0x14, 0xa3, 0x6c, 0xe7, 0x9a, 0xfd, 0x01, 0x09, 0xf8, 0xef,
-	0x0e, 0xb6, 0xed, 0x80, 0x50, 0xaa, 0xf2, 0x3a, 0xa1, 0x39, 0xcd, 0x61, 0x98, 0xe5, 0x90, 0x74,
-	0x53, 0x52, 0x10, 0xe3, 0x61, 0xf6, 0x28, 0xf1, 0xe8, 0x90, 0x76, 0x06, 0xc3, 0xee, 0x19, 0x19,
-	0xa9, 0x68, 0xac, 0x4f, 0x45, 0xe3, 0xa6, 0x37, 0x6a, 0xbe, 0x19, 0xa3, 0xa7, 0xe5, 0x8c, 0x3f,
-	0xfc, 0xe6, 0x8d, 0x75, 0x95, 0x1a, 0x56, 0x30, 0x1a, 0x30, 0xbf, 0x71, 0x30, 0xec, 0xbe, 0x4d,
-	0x46, 0x3c, 0xfc, 0x8a, 0xf5, 0x40, 0x70, 0xa2, 0x6b, 0x90, 0xff, 0x11, 0x76, 0xfa, 0xc4, 0x16,
-	0x0e, 0x2d, 0x98, 0x6a, 0x85, 0x76, 0x20, 0x4f, 0x19, 0x66, 0x43, 0x2a, 0xbc, 0xb8, 0xb2, 0x6d,
-	0xcc, 0x4a, 0xb5, 0xa6, 0xef, 0xd9, 0x87, 0x82, 0xd3, 0x54, 0x12, 0xe8, 0x16, 0xe4, 0x99, 0x7f,
-	0x46, 0x3c, 0xe5, 0xc2, 0xb9, 0xea, 0xbb, 0xed, 0x31, 0x53, 0x49, 0x73, 0x8f, 0xd8, 0xa4, 0x4f,
-	0x7a, 0xc2, 0x71, 0xf4, 0x14, 0x07, 0x84, 0x56, 0xf2, 0x02, 0xb1, 0x3d, 0x77, 0x11, 0x2a, 0x4f,
-	0xa5, 0xf1, 0x0c, 0xb3, 0x1c, 0x91, 0x0e, 0x05, 0x05, 0xbd, 0x0d, 0x25, 0x3b, 0x4e, 0xd4, 0xca,
-	0xa2, 0x08, 0xc1, 0x17, 0x66, 0x99, 0x9f, 0xc8, 0x69, 0xd5, 0xf7, 0x92, 0xd2, 0x3c, 0x39, 0x86,
-	0x5e, 0xd7, 0xf7, 0x6c, 0xc7, 0xeb, 0x75, 0x4e, 0x89, 0xd3, 0x3b, 0x65, 0x95, 0x42, 0x5d, 0xdb,
-	0x5c, 0x48, 0x26, 0x47, 0x9a, 0xc3, 0x30, 0xcb, 0x11, 0x69, 0x5f, 0x50, 0x90, 0x0d, 0x2b, 0x31,
-	0x97, 0x28, 0xd4, 0xe2, 0x53, 0x0b, 0xf5, 0x15, 0x55, 0xa8, 0x57, 0xd3, 0x5a, 0xe2, 0x5a, 0x5d,
-	0x8e, 0x88, 0x5c, 0x0c, 0xed, 0x03, 0xc4, 0xed, 0xa1, 0x02, 0x42, 0x83, 0xf1, 0xf4, 0x1e, 0xa3,
-	0x0c, 0x4f, 0xc8, 0xa2, 0xf7, 0xe0, 0x8a, 0xeb, 0x78, 0x1d, 0x4a, 0xfa, 0x27, 0x1d, 0xe5, 0x60,
-	0x0e, 0x59, 0x12, 0xd1, 0x7b, 0x67, 0xbe, 0x7c, 0x98, 0x8c, 0xf5, 0xaa, 0x6a, 0xa1, 0xd3, 0x90,
-	0x86, 0xb9, 0xe6, 0x3a, 0xde, 0x21, 0xe9, 0x9f, 0xb4, 0x22, 0xda, 0xce, 0xd2, 0xfb, 0x0f, 0xf4,
-	0x8c, 0x2a, 0xd7, 0x8c, 0xf1, 0x16, 0x2c, 0x1d, 0xe3, 0xbe, 0x2a, 0x33, 0x42, 0xd1, 0x75, 0x28,
-	0xe2, 0x70, 0x51, 0xd1, 0xea, 0x0b, 0x9b, 0x45, 0x33, 0x26, 0xc8, 0x32, 0xff, 0xe9, 0x5f, 0xeb,
-	0x9a, 0xf1, 0xb1, 0x06, 0xf9, 0xd6, 0xf1, 0x01, 0x76, 0x02, 0xd4, 0x86, 0xb5, 0x38, 0x73, 0x2e,
-	0x16, 0xf9, 0xf5, 0xc9, 0x58, 0xaf, 0xa4, 0x93, 0x2b, 0xaa, 0xf2, 0x38, 0x81, 0xc3, 0x32, 0x6f,
-	0xc3, 0xda, 0xdd, 0xb0, 0x77, 0x44, 0x50, 0xd9, 0x34, 0xd4, 0x14, 0x8b, 0x61, 0xae, 0x46, 0x34,
-	0x05, 0x95, 0x32, 0x73, 0x0f, 0x16, 0xe5, 0x69, 0x29, 0xda, 0x81, 0x97, 0x06, 0xfc, 0x87, 0xb0,
-	0xae, 0xb4, 0x5d, 0x9b, 0x99, 0xbc, 0x82, 0x5f, 0x85, 0x4f, 0x8a, 0x18, 0xbf, 0xca, 0x02, 0xb4,
-	0x8e, 0x8f, 0x8f, 0x02, 0x67, 0xd0, 0x27, 0xec, 0xd3, 0xb4, 0xfc, 0x08, 0xae, 0xc6, 0x66, 0xd1,
-	0xc0, 0x4a, 0x59, 0x5f, 0x9f, 0x8c, 0xf5, 0xeb, 0x69, 0xeb, 0x13, 0x6c, 0x86, 0x79, 0x25, 0xa2,
-	0x1f, 0x06, 0xd6, 0xa5, 0xa8, 0x36, 0x65, 0x11, 0xea, 0xc2, 0x6c, 0xd4, 0x04, 0x5b, 0x12, 0xb5,
-	0x45, 0xd9, 0xe5, 0xae, 0x3d, 0x84, 0x52, 0xec, 0x12, 0x8a, 0x5a, 0x50, 0x60, 0xea, 0xb7, 0xf2,
-	0xb0, 0x31, 0xdb, 0xc3, 0xa1, 0x98, 0xf2, 0x72, 0x24, 0x69, 0xfc, 0x47, 0x03, 0x88, 0x73, 0xf6,
-	0xc5, 0x4c, 0x31, 0xde, 0xca, 0x55, 0xe3, 0x5d, 0x78, 0xae, 0x51, 0x4d, 0x49, 0xa7, 0xfc, 0xf9,
-	0xf3, 0x2c, 0x5c, 0xb9, 0x13, 0x76, 0x9e, 0x17, 0xde, 0x07, 0x07, 0xb0, 0x48, 0x3c, 0x16, 0x38,
-	0xc2, 0x09, 0x3c, 0xda, 0x5f, 0x99, 0x15, 0xed, 0x4b, 0x6c, 0xda, 0xf3, 0x58, 0x30, 0x52, 0xb1,
-	0x0f, 0x61, 0x52, 0xde, 0xf8, 0xe5, 0x02, 0x54, 0x66, 0x49, 0xa2, 0x5d, 0x28, 0x5b, 0x01, 0x11,
-	0x84, 0xf0, 0xfe, 0xd0, 0xc4, 0xfd, 0x51, 0x8d, 0x27, 0xcb, 0x14, 0x83, 0x61, 0xae, 0x84, 0x14,
-	0x75, 0x7b, 0xf4, 0x80, 0x8f, 0x7d, 0x3c, 0xed, 0x38, 0xd7, 0x33, 0xce, 0x79, 0x86, 0xba, 0x3e,
-	0x42, 0x25, 0x17, 0x01, 0xe4, 0xfd, 0xb1, 0x12, 0x53, 0xc5, 0x05, 0xf2, 0x63, 0x28, 0x3b, 0x9e,
-	0xc3, 0x1c, 0xdc, 0xef, 0x74, 0x71, 0x1f, 0x7b, 0xd6, 0xf3, 0x4c, 0xcd, 0xb2, 0xe5, 0x2b, 0xb5,
-	0x29, 0x38, 0xc3, 0x5c, 0x51, 0x94, 0xa6, 0x24, 0xa0, 0x7d, 0x58, 0x0c, 0x55, 0xe5, 0x9e, 0x6b,
-	0xda, 0x08, 0xc5, 0x13, 0x03, 0xde, 0x2f, 0x16, 0x60, 0xcd, 0x24, 0xf6, 0x67, 0xa1, 0x98, 0x2f,
-	0x14, 0xdf, 0x06, 0x90, 0xe5, 0xce, 0x1b, 0xec, 0x73, 0x44, 0x83, 0x37, 0x8c, 0xa2, 0x44, 0x68,
-	0x51, 0x96, 0x88, 0xc7, 0x38, 0x0b, 0x4b, 0xc9, 0x78, 0xfc, 0x9f, 0xde, 0x4a, 0xa8, 0x1d, 0x77,
-	0xa2, 0x9c, 0xe8, 0x44, 0x5f, 0x9a, 0xd5, 0x89, 0xa6, 0xb2, 0xf7, 0xc9, 0x2d, 0xe8, 0xdf, 0x59,
-	0xc8, 0x1f, 0xe0, 0x00, 0xbb, 0x14, 0x59, 0x53, 0x93, 0xa6, 0x7c, 0x6b, 0x6e, 0x4c, 0xe5, 0x67,
-	0x4b, 0x7d, 0xed, 0x78, 0xca, 0xa0, 0xf9, 0xe1, 0x25, 0x83, 0xe6, 0x37, 0x61, 0x85, 0x3f, 0x87,
-	0x23, 0x1b, 0xa5, 0xb7, 0x97, 0x9b, 0x1b, 0x31, 0xca, 0xc5, 0x7d, 0xf9, 0x5a, 0x8e, 0x1e, 0x5d,
-	0x14, 0x7d, 0x0d, 0x4a, 0x9c, 0x23, 0x6e, 0xcc, 0x5c, 0xfc, 0x5a, 0xfc, 0x2c, 0x4d, 0x6c, 0x1a,
-	0x26, 0xb8, 0xf8, 0x7c, 0x4f, 0x2e, 0xd0, 0x3b, 0x80, 0x4e, 0xa3, 0x2f, 0x23, 0x9d, 0xd8, 0x9d,
-	0x5c, 0xfe, 0xf3, 0x93, 0xb1, 0xbe, 0x21, 0xe5, 0xa7, 0x79, 0x0c, 0x73, 0x2d, 0x26, 0x86, 0x68,
-	0x5f, 0x05, 0xe0, 0x76, 0x75, 0x6c, 0xe2, 0xf9, 0xae, 0x7a, 0xee, 0x5c, 0x9d, 0x8c, 0xf5, 0x35,
-	0x89, 0x12, 0xef, 0x19, 0x66, 0x91, 0x2f, 0x5a, 0xfc, 0x77, 0x22, 0xb3, 0x3f, 0xd2, 0x00, 0xc5,
-	0x2d, 0xdf, 0x24, 0x74, 0xc0, 0xdf, 0x67, 0x7c, 0x10, 0x4f, 0x4c, 0xcd, 0xda, 0x93, 0x07, 0xf1,
-	0x58, 0x3e, 0x1c, 0xc4, 0x13, 0x95, 0xf2, 0xf5, 0xb8, 0x3d, 0x66, 0x55, 0x1c, 0x15, 0x4c, 0x17,
-	0x53, 0x92, 0x18, 0xe6, 0x9d, 0x50, 0x7a, 0xaa, 0x1f, 0x66, 0x8c, 0x3f, 0x6a, 0xb0, 0x31, 0x95,
-	0x51, 0xd1, 0x61, 0x7f, 0x08, 0x28, 0x48, 0x6c, 0x0a, 0x7f, 0x8d, 0xd4, 0xa1, 0xe7, 0x4e, 0xd0,
-	0xb5, 0x60, 0xaa, 0xef, 0x7e, 0x7a, 0x1d, 0x3e, 0x27, 0x7c, 0xfe, 0x3b, 0x0d, 0xd6, 0x93, 0xea,
-	0x23, 0x43, 0x6e, 0xc3, 0x52, 0x52, 0xbb, 0x32, 0xe1, 0xd5, 0x67, 0x31, 0x41, 0x9d, 0xfe, 0x82,
-	0x3c, 0xfa, 0x6e, 0x5c, 0xae, 0xf2, 0xdb, 0xd9, 0x8d, 0x67, 0xf6, 0x46, 0x78, 0xa6, 0x74, 0xd9,
-	0xe6, 0x44, 0x3c, 0xfe, 0xab, 0x41, 0xee, 0xc0, 0xf7, 0xfb, 0xc8, 0x87, 0x35, 0xcf, 0x67, 0x1d,
-	0x9e, 0x59, 0xc4, 0xee, 0xa8, 0x47, 0xb7, 0xec, 0x83, 0xbb, 0xf3, 0x39, 0xe9, 0x9f, 0x63, 0x7d,
-	0x1a, 0xca, 0x2c, 0x7b, 0x3e, 0x6b, 0x0a, 0xca, 0x91, 0x7c, 0x92, 0xbf, 0x07, 0xcb, 0x17, 0x95,
-	0xc9, 0x2e, 0xf9, 0xbd, 0xb9, 0x95, 0x5d, 0x84, 0x99, 0x8c, 0xf5, 0xf5, 0xb8, 0x62, 0x22, 0xb2,
-	0x61, 0x2e, 0x75, 0x13, 0xda, 0x77, 0x0a, 0x3c, 0x7e, 0xff, 0x7a, 0xa0, 0x6b, 0x5f, 0xfe, 0xad,
-	0x06, 0x10, 0x7f, 0x79, 0x40, 0xaf, 0xc3, 0xcb, 0xcd, 0xef, 0xdc, 0x6e, 0x75, 0x0e, 0x8f, 0x6e,
-	0x1e, 0xdd, 0x39, 0xec, 0xdc, 0xb9, 0x7d, 0x78, 0xb0, 0xb7, 0xdb, 0xbe, 0xd5, 0xde, 0x6b, 0xad,
-	0x66, 0xaa, 0xe5, 0x7b, 0xf7, 0xeb, 0xa5, 0x3b, 0x1e, 0x1d, 0x10, 0xcb, 0x39, 0x71, 0x88, 0x8d,
-	0x5e, 0x83, 0xf5, 0x8b, 0xdc, 0x7c, 0xb5, 0xd7, 0x5a, 0xd5, 0xaa, 0x4b, 0xf7, 0xee, 0xd7, 0x0b,
-	0x72, 0x16, 0x23, 0x36, 0xda, 0x84, 0xab, 0xd3, 0x7c, 0xed, 0xdb, 0xdf, 0x5a, 0xcd, 0x56, 0x97,
-	0xef, 0xdd, 0xaf, 0x17, 0xa3, 0xa1, 0x0d, 0x19, 0x80, 0x92, 0x9c, 0x0a, 0x6f, 0xa1, 0x0a, 0xf7,
-	0xee, 0xd7, 0xf3, 0xd2, 0x81, 0xd5, 0xdc, 0xfb, 0x1f, 0xd5, 0x32, 0xcd, 0x5b, 0x9f, 0x3c, 0xaa,
-	0x69, 0x0f, 0x1f, 0xd5, 0xb4, 0xbf, 0x3f, 0xaa, 0x69, 0x1f, 0x3c, 0xae, 0x65, 0x1e, 0x3e, 0xae,
-	0x65, 0xfe, 0xfc, 0xb8, 0x96, 0xf9, 0xfe, 0xeb, 0x4f, 0xf4, 0xdd, 0x79, 0xf4, 0x51, 0x5b, 0x78,
-	0xb1, 0x9b, 0x17, 0x6d, 0xf8, 0xcd, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xc2, 0x48, 0x4c, 0x86,
-	0xf3, 0x16, 0x00, 0x00,
+	// 1992 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4f, 0x6c, 0x23, 0x57,
+	0x19, 0xf7, 0x38, 0xae, 0x63, 0x7f, 0xce, 0xc6, 0xc9, 0xdb, 0x6c, 0xea, 0x98, 0xc5, 0x76, 0xa7,
+	0x55, 0x09, 0xa8, 0x75, 0xd8, 0x14, 0x15, 0x91, 0x0b, 0xc4, 0x71, 0x42, 0xa2, 0x2e, 0x4b, 0x98,
+	0xfc, 0x41, 0x82, 0x8a, 0xd1, 0xf3, 0xcc, 0x8b, 0x33, 0x64, 0x3c, 0xe3, 0xce, 0x7b, 0xde, 0xc6,
+	0xa8, 0x07, 0x8e, 0x65, 0x51, 0x45, 0xb9, 0xa0, 0x5e, 0x56, 0x5a, 0xd4, 0x6b, 0x11, 0x17, 0xc4,
+	0x95, 0x6b, 0x81, 0xcb, 0x72, 0x43, 0x08, 0x19, 0xb4, 0x7b, 0x41, 0x9c, 0x50, 0x4e, 0xdc, 0x40,
+	0xef, 0xcf, 0xfc, 0xc9, 0x38, 0xee, 0xae, 0x57, 0x3d, 0x54, 0x82, 0xcb, 0xae, 0xdf, 0xf7, 0xe7,
+	0xf7, 0xbd, 0xef, 0xcf, 0xfb, 0xde, 0xfb, 0x26, 0xf0, 0x92, 0xe5, 0xd3, 0x9e, 0x4f, 0xd7, 0x28,
+	0xc3, 0x67, 0x8e, 0xd7, 0x5d, 0xbb, 0x7b, 0xab, 0x43, 0x18, 0xbe, 0x15, 0xae, 0x9b, 0xfd, 0xc0,
+	0x67, 0x3e, 0x5a, 0x96, 0x52, 0xcd, 0x90, 0xaa, 0xa4, 0xaa, 0x4b, 0x5d, 0xbf, 0xeb, 0x0b, 0x91,
+	0x35, 0xfe, 0x4b, 0x4a, 0x57, 0x57, 0xba, 0xbe, 0xdf, 0x75, 0xc9, 0x9a, 0x58, 0x75, 0x06, 0x27,
+	0x6b, 0xd8, 0x1b, 0x2a, 0x56, 0x2d, 0xcd, 0xb2, 0x07, 0x01, 0x66, 0x8e, 0xef, 0x29, 0x7e, 0x3d,
+	0xcd, 0x67, 0x4e, 0x8f, 0x50, 0x86, 0x7b, 0xfd, 0x10, 0x5b, 0xee, 0xc4, 0x94, 0x46, 0xd5, 0xb6,
+	0x14, 0xb6, 0x72, 0xa5, 0x83, 0x29, 0x89, 0xfc, 0xb0, 0x7c, 0x27, 0xc4, 0xbe, 0xc9, 0x88, 0x67,
+	0x93, 0xa0, 0xe7, 0x78, 0x6c, 0x8d, 0x0d, 0xfb, 0x84, 0xca, 0x7f, 0x25, 0x57, 0xff, 0x89, 0x06,
+	0xf3, 0xbb, 0x0e, 0x65, 0x7e, 0xe0, 0x58, 0xd8, 0xdd, 0xf3, 0x4e, 0x7c, 0xf4, 0x3a, 0xe4, 0x4f,
+	0x09, 0xb6, 0x49, 0x50, 0xd1, 0x1a, 0xda, 0x6a, 0x69, 0xbd, 0xd2, 0x8c, 0x11, 0x9a, 0x52, 0x77,
+	0x57, 0xf0, 0x5b, 0xb9, 0x8f, 0x47, 0xf5, 0x8c, 0xa1, 0xa4, 0xd1, 0xd7, 0x21, 0x7f, 0x17, 0xbb,
+	0x94, 0xb0, 0x4a, 0xb6, 0x31, 0xb3, 0x5a, 0x5a, 0x7f, 0xa1, 0x79, 0x75, 0xf8, 0x9a, 0xc7, 0xd8,
+	0x75, 0x6c, 0xcc, 0xfc, 0x08, 0x40, 0xaa, 0xe9, 0xbf, 0xce, 0x42, 0x79, 0xcb, 0xef, 0xf5, 0x1c,
+	0x4a, 0x1d, 0xdf, 0x33, 0x30, 0x23, 0x14, 0xb5, 0x20, 0x17, 0x60, 0x46, 0xc4, 0x56, 0x8a, 0xad,
+	0x26, 0x97, 0xff, 0xcb, 0xa8, 0xfe, 0x72, 0xd7, 0x61, 0xa7, 0x83, 0x4e, 0xd3, 0xf2, 0x7b, 0x2a,
+	0x18, 0xea, 0xbf, 0x57, 0xa9, 0x7d, 0xa6, 0xfc, 0x6b, 0x13, 0xcb, 0x10, 0xba, 0xe8, 0x4d, 0x28,
+	0xf4, 0xf0, 0xb9, 0x29, 0x70, 0xb2, 0x02, 0x67, 0x73, 0x3a, 0x9c, 0x8b, 0x51, 0xbd, 0x3c, 0xc4,
+	0x3d, 0x77, 0x43, 0x0f, 0x71, 0x74, 0x63, 0xb6, 0x87, 0xcf, 0xf9, 0x16, 0x51, 0x1f, 0xca, 0x9c,
+	0x6a, 0x9d, 0x62, 0xaf, 0x4b, 0xa4, 0x91, 0x19, 0x61, 0x64, 0x77, 0x6a, 0x23, 0xcb, 0xb1, 0x91,
+	0x04, 0x9c, 0x6e, 0x5c, 0xeb, 0xe1, 0xf3, 0x2d, 0x41, 0xe0, 0x16, 0x37, 0x0a, 0x1f, 0x3c, 0xa8,
+	0x67, 0xfe, 0xf1, 0xa0, 0xae, 0xe9, 0x7f, 0xd2, 0x00, 0xe2, 0x88, 0xa1, 0x37, 0x61, 0xc1, 0x8a,
+	0x56, 0x42, 0x97, 0xaa, 0x1c, 0x7e, 0x61, 0x52, 0x2e, 0x52, 0xf1, 0x6e, 0x15, 0xf8, 0xa6, 0x1f,
+	0x8e, 0xea, 0x9a, 0x51, 0xb6, 0x52, 0xa9, 0xf8, 0x3e, 0x94, 0x06, 0x7d, 0x1b, 0x33, 0x62, 0xf2,
+	0xea, 0x14, 0x91, 0x2c, 0xad, 0x57, 0x9b, 0xb2, 0x74, 0x9b, 0x61, 0xe9, 0x36, 0x0f, 0xc3, 0xd2,
+	0x6d, 0xd5, 0x38, 0xd6, 0xc5, 0xa8, 0x8e, 0xa4, 0x5b, 0x09, 0x65, 0xfd, 0xfd, 0xbf, 0xd5, 0x35,
+	0x03, 0x24, 0x85, 0x2b, 0x24, 0x7c, 0xfa, 0xbd, 0x06, 0xa5, 0x36, 0xa1, 0x56, 0xe0, 0xf4, 0xf9,
+	0x09, 0x41, 0x15, 0x98, 0xed, 0xf9, 0x9e, 0x73, 0xa6, 0xea, 0xb1, 0x68, 0x84, 0x4b, 0x54, 0x85,
+	0x82, 0x63, 0x13, 0x8f, 0x39, 0x6c, 0x28, 0xf3, 0x6a, 0x44, 0x6b, 0xae, 0xf5, 0x36, 0xe9, 0x50,
+	0x27, 0xcc, 0x86, 0x11, 0x2e, 0xd1, 0x0e, 0x2c, 0x50, 0x62, 0x0d, 0x02, 0x87, 0x0d, 0x4d, 0xcb,
+	0xf7, 0x18, 0xb6, 0x58, 0x25, 0x27, 0x12, 0xf6, 0xb9, 0x8b, 0x51, 0xfd, 0x79, 0xb9, 0xd7, 0xb4,
+	0x84, 0x6e, 0x94, 0x43, 0xd2, 0x96, 0xa4, 0x70, 0x0b, 0x36, 0x61, 0xd8, 0x71, 0x69, 0xe5, 0x39,
+	0x69, 0x41, 0x2d, 0x13, 0xbe, 0x7c, 0x34, 0x0b, 0xc5, 0xa8, 0xda, 0xb9, 0x65, 0xbf, 0x4f, 0x02,
+	0xfe, 0xdb, 0xc4, 0xb6, 0x1d, 0x10, 0x4a, 0x2b, 0x5a, 0xda, 0x72, 0x5a, 0x42, 0x37, 0xca, 0x21,
+	0x69, 0x53, 0x52, 0x10, 0xe3, 0x69, 0xf6, 0x28, 0xf1, 0xe8, 0x80, 0x9a, 0xfd, 0x41, 0xe7, 0x8c,
+	0x0c, 0x55, 0x36, 0x96, 0xc6, 0xb2, 0xb1, 0xe9, 0x0d, 0x5b, 0xaf, 0xc5, 0xe8, 0x69, 0x3d, 0xfd,
+	0x0f, 0xbf, 0x79, 0x75, 0x49, 0x95, 0x86, 0x15, 0x0c, 0xfb, 0xcc, 0x6f, 0xee, 0x0f, 0x3a, 0x6f,
+	0x90, 0xa1, 0x51, 0x8e, 0x44, 0xf7, 0x85, 0x24, 0x5a, 0x86, 0xfc, 0x0f, 0xb1, 0xe3, 0x12, 0x5b,
+	0x04, 0xb4, 0x60, 0xa8, 0x15, 0xda, 0x80, 0x3c, 0x65, 0x98, 0x0d, 0xa8, 0x88, 0xe2, 0xfc, 0xba,
+	0x3e, 0xa9, 0xd4, 0x5a, 0xbe, 0x67, 0x1f, 0x08, 0x49, 0x43, 0x69, 0xa0, 0x1d, 0xc8, 0x33, 0xff,
+	0x8c, 0x78, 0x2a, 0x84, 0x53, 0x9d, 0xef, 0x3d, 0x8f, 0x19, 0x4a, 0x9b, 0x47, 0xc4, 0x26, 0x2e,
+	0xe9, 0x8a, 0xc0, 0xd1, 0x53, 0x1c, 0x10, 0x5a, 0xc9, 0x0b, 0xc4, 0xbd, 0xa9, 0x0f, 0xa1, 0x8a,
+	0x54, 0x1a, 0x4f, 0x37, 0xca, 0x11, 0xe9, 0x40, 0x50, 0xd0, 0x1b, 0x50, 0xb2, 0xe3, 0x42, 0xad,
+	0xcc, 0x8a, 0x14, 0xbc, 0x38, 0xc9, 0xfd, 0x44, 0x4d, 0xab, 0xbe, 0x97, 0xd4, 0xe6, 0xc5, 0x31,
+	0xf0, 0x3a, 0xbe, 0x67, 0x3b, 0x5e, 0xd7, 0x3c, 0x25, 0x4e, 0xf7, 0x94, 0x55, 0x0a, 0x0d, 0x6d,
+	0x75, 0x26, 0x59, 0x1c, 0x69, 0x09, 0xdd, 0x28, 0x47, 0xa4, 0x5d, 0x41, 0x41, 0x36, 0xcc, 0xc7,
+	0x52, 0xe2, 0xa0, 0x16, 0x9f, 0x78, 0x50, 0x5f, 0x50, 0x07, 0xf5, 0x46, 0xda, 0x4a, 0x7c, 0x56,
+	0xaf, 0x45, 0x44, 0xae, 0x86, 0x76, 0x01, 0xe2, 0xf6, 0x50, 0x01, 0x61, 0x41, 0x7f, 0x72, 0x8f,
+	0x51, 0x8e, 0x27, 0x74, 0xd1, 0x3b, 0x70, 0xbd, 0xe7, 0x78, 0x26, 0x25, 0xee, 0x89, 0xa9, 0x02,
+	0xcc, 0x21, 0x4b, 0x22, 0x7b, 0xb7, 0xa7, 0xab, 0x87, 0x8b, 0x51, 0xbd, 0xaa, 0x5a, 0xe8, 0x38,
+	0xa4, 0x6e, 0x2c, 0xf6, 0x1c, 0xef, 0x80, 0xb8, 0x27, 0xed, 0x88, 0xb6, 0x31, 0xf7, 0xee, 0x83,
+	0x7a, 0x46, 0x1d, 0xd7, 0x8c, 0xfe, 0x3a, 0xcc, 0x1d, 0x63, 0x57, 0x1d, 0x33, 0x42, 0xd1, 0x4d,
+	0x28, 0xe2, 0x70, 0x51, 0xd1, 0x1a, 0x33, 0xab, 0x45, 0x23, 0x26, 0xc8, 0x63, 0xfe, 0xe3, 0xbf,
+	0x36, 0x34, 0xfd, 0x23, 0x0d, 0xf2, 0xed, 0xe3, 0x7d, 0xec, 0x04, 0x68, 0x0f, 0x16, 0xe3, 0xca,
+	0xb9, 0x7c, 0xc8, 0x6f, 0x5e, 0x8c, 0xea, 0x95, 0x74, 0x71, 0x45, 0xa7, 0x3c, 0x2e, 0xe0, 0xf0,
+	0x98, 0xef, 0xc1, 0xe2, 0xdd, 0xb0, 0x77, 0x44, 0x50, 0xd9, 0x34, 0xd4, 0x98, 0x88, 0x6e, 0x2c,
+	0x44, 0x34, 0x05, 0x95, 0x72, 0x73, 0x1b, 0x66, 0xe5, 0x6e, 0x29, 0xda, 0x80, 0xe7, 0xfa, 0xfc,
+	0x87, 0xf0, 0xae, 0xb4, 0x5e, 0x9b, 0x58, 0xbc, 0x42, 0x5e, 0xa5, 0x4f, 0xaa, 0xe8, 0x3f, 0xcf,
+	0x02, 0xb4, 0x8f, 0x8f, 0x0f, 0x03, 0xa7, 0xef, 0x12, 0xf6, 0x69, 0x7a, 0x7e, 0x08, 0x37, 0x62,
+	0xb7, 0x68, 0x60, 0xa5, 0xbc, 0x6f, 0x5c, 0x8c, 0xea, 0x37, 0xd3, 0xde, 0x27, 0xc4, 0x74, 0xe3,
+	0x7a, 0x44, 0x3f, 0x08, 0xac, 0x2b, 0x51, 0x6d, 0xca, 0x22, 0xd4, 0x99, 0xc9, 0xa8, 0x09, 0xb1,
+	0x24, 0x6a, 0x9b, 0xb2, 0xab, 0x43, 0x7b, 0x00, 0xa5, 0x38, 0x24, 0x14, 0xb5, 0xa1, 0xc0, 0xd4,
+	0x6f, 0x15, 0x61, 0x7d, 0x72, 0x84, 0x43, 0x35, 0x15, 0xe5, 0x48, 0x53, 0xff, 0xb7, 0x06, 0x10,
+	0xd7, 0xec, 0x67, 0xb3, 0xc4, 0x78, 0x2b, 0x57, 0x8d, 0x77, 0xe6, 0x99, 0x9e, 0x6a, 0x4a, 0x3b,
+	0x15, 0xcf, 0x9f, 0x66, 0xe1, 0xfa, 0x51, 0xd8, 0x79, 0x3e, 0xf3, 0x31, 0xd8, 0x87, 0x59, 0xe2,
+	0xb1, 0xc0, 0x11, 0x41, 0xe0, 0xd9, 0xfe, 0xf2, 0xa4, 0x6c, 0x5f, 0xe1, 0xd3, 0xb6, 0xc7, 0x82,
+	0xa1, 0xca, 0x7d, 0x08, 0x93, 0x8a, 0xc6, 0xcf, 0x66, 0xa0, 0x32, 0x49, 0x13, 0x6d, 0x41, 0xd9,
+	0x0a, 0x88, 0x20, 0x84, 0xf7, 0x87, 0x26, 0xee, 0x8f, 0x6a, 0xfc, 0xb2, 0x4c, 0x09, 0xe8, 0xc6,
+	0x7c, 0x48, 0x51, 0xb7, 0x47, 0x17, 0xf8, 0xb3, 0x8f, 0x97, 0x1d, 0x97, 0x7a, 0xca, 0x77, 0x9e,
+	0xae, 0xae, 0x8f, 0xd0, 0xc8, 0x65, 0x00, 0x79, 0x7f, 0xcc, 0xc7, 0x54, 0x71, 0x81, 0xbc, 0x05,
+	0x65, 0xc7, 0x73, 0x98, 0x83, 0x5d, 0xb3, 0x83, 0x5d, 0xec, 0x59, 0xcf, 0xf2, 0x6a, 0x96, 0x2d,
+	0x5f, 0x99, 0x4d, 0xc1, 0xe9, 0xc6, 0xbc, 0xa2, 0xb4, 0x24, 0x01, 0xed, 0xc2, 0x6c, 0x68, 0x2a,
+	0xf7, 0x4c, 0xaf, 0x8d, 0x50, 0x3d, 0xf1, 0xc0, 0x7b, 0x6f, 0x06, 0x16, 0x0d, 0x62, 0xff, 0x3f,
+	0x15, 0xd3, 0xa5, 0xe2, 0x5b, 0x00, 0xf2, 0xb8, 0xf3, 0x06, 0x5b, 0xc9, 0x3d, 0x53, 0xc3, 0x28,
+	0x4a, 0x84, 0x36, 0x65, 0x89, 0x7c, 0x8c, 0xb2, 0x30, 0x97, 0xcc, 0xc7, 0xff, 0xe8, 0xad, 0x84,
+	0xf6, 0xe2, 0x4e, 0x94, 0x13, 0x9d, 0xe8, 0x8b, 0x93, 0x3a, 0xd1, 0x58, 0xf5, 0x7e, 0x72, 0x0b,
+	0xfa, 0xd5, 0x2c, 0xe4, 0xf7, 0x71, 0x80, 0x7b, 0x14, 0x59, 0x63, 0x2f, 0x4d, 0x39, 0x6b, 0xae,
+	0x8c, 0xd5, 0x67, 0x5b, 0x7d, 0xed, 0x78, 0xc2, 0x43, 0xf3, 0x83, 0x2b, 0x1e, 0x9a, 0xdf, 0x80,
+	0x79, 0x3e, 0x0e, 0x47, 0x3e, 0xca, 0x68, 0x5f, 0x6b, 0xad, 0xc4, 0x28, 0x97, 0xf9, 0x72, 0x5a,
+	0x8e, 0x86, 0x2e, 0x8a, 0xbe, 0x0a, 0x25, 0x2e, 0x11, 0x37, 0x66, 0xae, 0xbe, 0x1c, 0x8f, 0xa5,
+	0x09, 0xa6, 0x6e, 0x40, 0x0f, 0x9f, 0x6f, 0xcb, 0x05, 0xba, 0x0d, 0xe8, 0x34, 0xfa, 0x32, 0x62,
+	0xc6, 0xe1, 0xe4, 0xfa, 0x9f, 0xbf, 0x18, 0xd5, 0x57, 0xa4, 0xfe, 0xb8, 0x8c, 0x6e, 0x2c, 0xc6,
+	0xc4, 0x10, 0xed, 0x2b, 0x00, 0xdc, 0x2f, 0xd3, 0x26, 0x9e, 0xdf, 0x53, 0xe3, 0xce, 0x8d, 0x8b,
+	0x51, 0x7d, 0x51, 0xa2, 0xc4, 0x3c, 0xdd, 0x28, 0xf2, 0x45, 0x9b, 0xff, 0x46, 0xef, 0x69, 0xb0,
+	0xd2, 0x75, 0xfd, 0x0e, 0x76, 0x4d, 0xd7, 0x79, 0x6b, 0xe0, 0xd8, 0xa6, 0xca, 0x9f, 0x69, 0xe1,
+	0xbe, 0x1a, 0x71, 0x8c, 0xa9, 0x47, 0x9c, 0x86, 0xb4, 0x39, 0x11, 0x58, 0x37, 0x96, 0x25, 0xef,
+	0xb6, 0x60, 0x1d, 0x48, 0xce, 0x16, 0xee, 0xa3, 0x5f, 0x68, 0x70, 0x33, 0xae, 0xc3, 0x2b, 0xb6,
+	0x34, 0x2b, 0xb6, 0x74, 0x34, 0xf5, 0x96, 0x5e, 0x4c, 0xd7, 0xf8, 0x55, 0xbb, 0x5a, 0x89, 0xd8,
+	0x63, 0x1b, 0x53, 0x63, 0x44, 0xea, 0xf3, 0x47, 0xa5, 0x30, 0xf5, 0x18, 0x21, 0xb7, 0x93, 0x18,
+	0x23, 0x52, 0x90, 0x72, 0x8c, 0xb8, 0xfc, 0xd9, 0x44, 0xa4, 0xe9, 0x8a, 0x91, 0xc3, 0x3c, 0x71,
+	0x7d, 0x3f, 0xa8, 0x14, 0xa7, 0x4e, 0x93, 0xec, 0xa6, 0x8d, 0x89, 0xb3, 0x8c, 0x04, 0xd6, 0x8d,
+	0xe5, 0xb1, 0x89, 0x66, 0x87, 0x33, 0x12, 0x0d, 0xf1, 0x43, 0x0d, 0x50, 0xcc, 0x35, 0x08, 0xed,
+	0xfb, 0x1e, 0x15, 0xf3, 0x5b, 0x8c, 0xa6, 0xce, 0xed, 0xe4, 0xa7, 0x69, 0x24, 0x19, 0xce, 0x6f,
+	0xb1, 0x2e, 0xfa, 0x5a, 0x7c, 0xab, 0x66, 0xd5, 0xf1, 0x57, 0x30, 0x1d, 0x4c, 0x49, 0x62, 0x06,
+	0x74, 0x42, 0xed, 0xb1, 0x6b, 0x34, 0xa3, 0xff, 0x51, 0x83, 0x95, 0xb1, 0x46, 0x14, 0x6d, 0xf6,
+	0x07, 0x80, 0x82, 0x04, 0x53, 0x1c, 0xb3, 0xa1, 0xda, 0xf4, 0xd4, 0x7d, 0x6d, 0x31, 0x48, 0x33,
+	0x3e, 0xc5, 0x87, 0x41, 0x4e, 0xc4, 0xfc, 0x77, 0x1a, 0x2c, 0x25, 0xcd, 0x47, 0x8e, 0xdc, 0x81,
+	0xb9, 0xa4, 0x75, 0xe5, 0xc2, 0x4b, 0x4f, 0xe3, 0x82, 0xda, 0xfd, 0x25, 0x7d, 0xf4, 0x9d, 0xb8,
+	0xcb, 0xcb, 0x4f, 0xae, 0xb7, 0x9e, 0x3a, 0x1a, 0xe1, 0x9e, 0xd2, 0xdd, 0x3e, 0x27, 0xf2, 0xf1,
+	0x1f, 0x0d, 0x72, 0xfb, 0xbe, 0xef, 0x22, 0x1f, 0x16, 0x3d, 0x9f, 0x99, 0xbc, 0x21, 0x11, 0xdb,
+	0x54, 0xdf, 0x6a, 0xe4, 0xf5, 0xb9, 0x35, 0x5d, 0x90, 0xfe, 0x39, 0xaa, 0x8f, 0x43, 0x19, 0x65,
+	0xcf, 0x67, 0x2d, 0x41, 0x39, 0x14, 0x04, 0xf4, 0x0e, 0x5c, 0xbb, 0x6c, 0x4c, 0x5e, 0xae, 0xdf,
+	0x9d, 0xda, 0xd8, 0x65, 0x98, 0x8b, 0x51, 0x7d, 0x29, 0x6e, 0xb4, 0x11, 0x59, 0x37, 0xe6, 0x3a,
+	0x09, 0xeb, 0x1b, 0x05, 0x9e, 0xbf, 0x7f, 0xf1, 0x1c, 0xfe, 0x52, 0x83, 0xeb, 0x82, 0xe8, 0xfc,
+	0x88, 0x88, 0xcf, 0x3d, 0x06, 0xb1, 0xfc, 0xc0, 0x46, 0xf3, 0x90, 0x75, 0x6c, 0x11, 0x81, 0x9c,
+	0x91, 0x75, 0x6c, 0xb4, 0x04, 0xcf, 0xf9, 0x6f, 0x7b, 0x24, 0x50, 0x1f, 0x20, 0xe5, 0x42, 0xdc,
+	0x5a, 0xbe, 0x3d, 0x70, 0x89, 0x89, 0x2d, 0xcb, 0x1f, 0x78, 0x4c, 0xdd, 0xe6, 0xc9, 0x5b, 0xeb,
+	0x12, 0x9f, 0xdf, 0x5a, 0x82, 0xb0, 0x29, 0xd7, 0xfc, 0xd3, 0x43, 0xd4, 0xec, 0x64, 0x55, 0x1a,
+	0x31, 0x41, 0xd6, 0xd9, 0x97, 0x7e, 0xab, 0x01, 0xc4, 0x1f, 0xd5, 0xd0, 0x2b, 0xf0, 0x7c, 0xeb,
+	0xdb, 0x77, 0xda, 0xe6, 0xc1, 0xe1, 0xe6, 0xe1, 0xd1, 0x81, 0x79, 0x74, 0xe7, 0x60, 0x7f, 0x7b,
+	0x6b, 0x6f, 0x67, 0x6f, 0xbb, 0xbd, 0x90, 0xa9, 0x96, 0xef, 0xdd, 0x6f, 0x94, 0x8e, 0x3c, 0xda,
+	0x27, 0x96, 0x73, 0xe2, 0x10, 0x1b, 0xbd, 0x0c, 0x4b, 0x97, 0xa5, 0xf9, 0x6a, 0xbb, 0xbd, 0xa0,
+	0x55, 0xe7, 0xee, 0xdd, 0x6f, 0x14, 0xe4, 0x98, 0x41, 0x6c, 0xb4, 0x0a, 0x37, 0xc6, 0xe5, 0xf6,
+	0xee, 0x7c, 0x73, 0x21, 0x5b, 0xbd, 0x76, 0xef, 0x7e, 0xa3, 0x18, 0xcd, 0x23, 0x48, 0x07, 0x94,
+	0x94, 0x54, 0x78, 0x33, 0x55, 0xb8, 0x77, 0xbf, 0x91, 0x97, 0x49, 0xae, 0xe6, 0xde, 0xfd, 0xb0,
+	0x96, 0x69, 0xed, 0x7c, 0xfc, 0xa8, 0xa6, 0x3d, 0x7c, 0x54, 0xd3, 0xfe, 0xfe, 0xa8, 0xa6, 0xbd,
+	0xff, 0xb8, 0x96, 0x79, 0xf8, 0xb8, 0x96, 0xf9, 0xf3, 0xe3, 0x5a, 0xe6, 0x7b, 0xaf, 0x7c, 0x62,
+	0x7e, 0xcf, 0xa3, 0xbf, 0xd7, 0x88, 0x4c, 0x77, 0xf2, 0xe2, 0x85, 0xf1, 0xda, 0x7f, 0x07, 0x00,
+	0x22, 0x16, 0x9f, 0xe5, 0xce, 0x19, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1260,620 +1359,659 @@ func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_
 func StakingDescription() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 9808 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x70, 0x5c, 0xd7,
-		0x75, 0x18, 0xde, 0x7e, 0x00, 0xbb, 0x07, 0x0b, 0x60, 0x71, 0x01, 0x92, 0xcb, 0x25, 0x09, 0x40,
-		0x4f, 0x5f, 0x14, 0x25, 0x81, 0x12, 0x25, 0x52, 0xd2, 0xd2, 0xb6, 0xbc, 0x8b, 0x5d, 0x82, 0x10,
-		0xf1, 0xa5, 0x07, 0x80, 0x92, 0x65, 0xa7, 0x3b, 0x0f, 0xbb, 0x17, 0x8b, 0x27, 0xec, 0xbe, 0xf7,
-		0xf4, 0xde, 0x5b, 0x12, 0xa0, 0xed, 0x8e, 0x62, 0xbb, 0xae, 0xcd, 0x4c, 0x1a, 0xbb, 0xee, 0x34,
-		0xb6, 0x6c, 0xba, 0x76, 0x9c, 0xd6, 0xa9, 0xe3, 0x36, 0x71, 0xec, 0xba, 0x4d, 0xdb, 0x99, 0xda,
-		0x9d, 0xa6, 0xb1, 0xdd, 0x26, 0x63, 0xb7, 0x99, 0x36, 0xcd, 0xb4, 0x74, 0x2a, 0x7b, 0x52, 0xd5,
-		0x75, 0x1b, 0x87, 0x71, 0xda, 0x74, 0x3c, 0x9d, 0x76, 0xee, 0xd7, 0xfb, 0xda, 0x4f, 0x40, 0xa4,
-		0xe5, 0x34, 0xf9, 0x85, 0xbd, 0xe7, 0x9e, 0x73, 0xee, 0x39, 0xe7, 0x9e, 0x7b, 0xee, 0xb9, 0x5f,
-		0x0f, 0xf0, 0x85, 0xf3, 0x30, 0x53, 0x33, 0x8c, 0x5a, 0x1d, 0x9f, 0x36, 0x2d, 0xc3, 0x31, 0x36,
-		0x9b, 0x5b, 0xa7, 0xab, 0xd8, 0xae, 0x58, 0x9a, 0xe9, 0x18, 0xd6, 0x2c, 0x85, 0xa1, 0x31, 0x86,
-		0x31, 0x2b, 0x30, 0xe4, 0x25, 0x18, 0xbf, 0xa0, 0xd5, 0x71, 0xd1, 0x45, 0x5c, 0xc3, 0x0e, 0x7a,
-		0x12, 0x62, 0x5b, 0x5a, 0x1d, 0x67, 0xa4, 0x99, 0xe8, 0xc9, 0xe1, 0x33, 0xf7, 0xcc, 0x86, 0x88,
-		0x66, 0x83, 0x14, 0xab, 0x04, 0xac, 0x50, 0x0a, 0xf9, 0xbb, 0x31, 0x98, 0x68, 0x53, 0x8b, 0x10,
-		0xc4, 0x74, 0xb5, 0x41, 0x38, 0x4a, 0x27, 0x93, 0x0a, 0xfd, 0x8d, 0x32, 0x30, 0x64, 0xaa, 0x95,
-		0x1d, 0xb5, 0x86, 0x33, 0x11, 0x0a, 0x16, 0x45, 0x34, 0x05, 0x50, 0xc5, 0x26, 0xd6, 0xab, 0x58,
-		0xaf, 0xec, 0x65, 0xa2, 0x33, 0xd1, 0x93, 0x49, 0xc5, 0x07, 0x41, 0x0f, 0xc2, 0xb8, 0xd9, 0xdc,
-		0xac, 0x6b, 0x95, 0xb2, 0x0f, 0x0d, 0x66, 0xa2, 0x27, 0xe3, 0x4a, 0x9a, 0x55, 0x14, 0x3d, 0xe4,
-		0xfb, 0x61, 0xec, 0x2a, 0x56, 0x77, 0xfc, 0xa8, 0xc3, 0x14, 0x75, 0x94, 0x80, 0x7d, 0x88, 0x73,
-		0x90, 0x6a, 0x60, 0xdb, 0x56, 0x6b, 0xb8, 0xec, 0xec, 0x99, 0x38, 0x13, 0xa3, 0xda, 0xcf, 0xb4,
-		0x68, 0x1f, 0xd6, 0x7c, 0x98, 0x53, 0xad, 0xef, 0x99, 0x18, 0xe5, 0x21, 0x89, 0xf5, 0x66, 0x83,
-		0x71, 0x88, 0x77, 0xb0, 0x5f, 0x49, 0x6f, 0x36, 0xc2, 0x5c, 0x12, 0x84, 0x8c, 0xb3, 0x18, 0xb2,
-		0xb1, 0x75, 0x45, 0xab, 0xe0, 0xcc, 0x20, 0x65, 0x70, 0x7f, 0x0b, 0x83, 0x35, 0x56, 0x1f, 0xe6,
-		0x21, 0xe8, 0xd0, 0x1c, 0x24, 0xf1, 0xae, 0x83, 0x75, 0x5b, 0x33, 0xf4, 0xcc, 0x10, 0x65, 0x72,
-		0x6f, 0x9b, 0x5e, 0xc4, 0xf5, 0x6a, 0x98, 0x85, 0x47, 0x87, 0xce, 0xc1, 0x90, 0x61, 0x3a, 0x9a,
-		0xa1, 0xdb, 0x99, 0xc4, 0x8c, 0x74, 0x72, 0xf8, 0xcc, 0xf1, 0xb6, 0x8e, 0xb0, 0xc2, 0x70, 0x14,
-		0x81, 0x8c, 0x16, 0x20, 0x6d, 0x1b, 0x4d, 0xab, 0x82, 0xcb, 0x15, 0xa3, 0x8a, 0xcb, 0x9a, 0xbe,
-		0x65, 0x64, 0x92, 0x94, 0xc1, 0x74, 0xab, 0x22, 0x14, 0x71, 0xce, 0xa8, 0xe2, 0x05, 0x7d, 0xcb,
-		0x50, 0x46, 0xed, 0x40, 0x19, 0x1d, 0x86, 0x41, 0x7b, 0x4f, 0x77, 0xd4, 0xdd, 0x4c, 0x8a, 0x7a,
-		0x08, 0x2f, 0xc9, 0xbf, 0x3e, 0x08, 0x63, 0xfd, 0xb8, 0xd8, 0x79, 0x88, 0x6f, 0x11, 0x2d, 0x33,
-		0x91, 0xfd, 0xd8, 0x80, 0xd1, 0x04, 0x8d, 0x38, 0x78, 0x40, 0x23, 0xe6, 0x61, 0x58, 0xc7, 0xb6,
-		0x83, 0xab, 0xcc, 0x23, 0xa2, 0x7d, 0xfa, 0x14, 0x30, 0xa2, 0x56, 0x97, 0x8a, 0x1d, 0xc8, 0xa5,
-		0x9e, 0x87, 0x31, 0x57, 0xa4, 0xb2, 0xa5, 0xea, 0x35, 0xe1, 0x9b, 0xa7, 0x7b, 0x49, 0x32, 0x5b,
-		0x12, 0x74, 0x0a, 0x21, 0x53, 0x46, 0x71, 0xa0, 0x8c, 0x8a, 0x00, 0x86, 0x8e, 0x8d, 0xad, 0x72,
-		0x15, 0x57, 0xea, 0x99, 0x44, 0x07, 0x2b, 0xad, 0x10, 0x94, 0x16, 0x2b, 0x19, 0x0c, 0x5a, 0xa9,
-		0xa3, 0xa7, 0x3c, 0x57, 0x1b, 0xea, 0xe0, 0x29, 0x4b, 0x6c, 0x90, 0xb5, 0x78, 0xdb, 0x06, 0x8c,
-		0x5a, 0x98, 0xf8, 0x3d, 0xae, 0x72, 0xcd, 0x92, 0x54, 0x88, 0xd9, 0x9e, 0x9a, 0x29, 0x9c, 0x8c,
-		0x29, 0x36, 0x62, 0xf9, 0x8b, 0xe8, 0x6e, 0x70, 0x01, 0x65, 0xea, 0x56, 0x40, 0xa3, 0x50, 0x4a,
-		0x00, 0x97, 0xd5, 0x06, 0xce, 0x5e, 0x83, 0xd1, 0xa0, 0x79, 0xd0, 0x24, 0xc4, 0x6d, 0x47, 0xb5,
-		0x1c, 0xea, 0x85, 0x71, 0x85, 0x15, 0x50, 0x1a, 0xa2, 0x58, 0xaf, 0xd2, 0x28, 0x17, 0x57, 0xc8,
-		0x4f, 0xf4, 0x56, 0x4f, 0xe1, 0x28, 0x55, 0xf8, 0xbe, 0xd6, 0x1e, 0x0d, 0x70, 0x0e, 0xeb, 0x9d,
-		0x7d, 0x02, 0x46, 0x02, 0x0a, 0xf4, 0xdb, 0xb4, 0xfc, 0x2e, 0x38, 0xd4, 0x96, 0x35, 0x7a, 0x1e,
-		0x26, 0x9b, 0xba, 0xa6, 0x3b, 0xd8, 0x32, 0x2d, 0x4c, 0x3c, 0x96, 0x35, 0x95, 0xf9, 0x2f, 0x43,
-		0x1d, 0x7c, 0x6e, 0xc3, 0x8f, 0xcd, 0xb8, 0x28, 0x13, 0xcd, 0x56, 0xe0, 0xa9, 0x64, 0xe2, 0xb5,
-		0xa1, 0xf4, 0xcb, 0x2f, 0xbf, 0xfc, 0x72, 0x44, 0xfe, 0xea, 0x20, 0x4c, 0xb6, 0x1b, 0x33, 0x6d,
-		0x87, 0xef, 0x61, 0x18, 0xd4, 0x9b, 0x8d, 0x4d, 0x6c, 0x51, 0x23, 0xc5, 0x15, 0x5e, 0x42, 0x79,
-		0x88, 0xd7, 0xd5, 0x4d, 0x5c, 0xcf, 0xc4, 0x66, 0xa4, 0x93, 0xa3, 0x67, 0x1e, 0xec, 0x6b, 0x54,
-		0xce, 0x2e, 0x12, 0x12, 0x85, 0x51, 0xa2, 0xb7, 0x40, 0x8c, 0x87, 0x68, 0xc2, 0xe1, 0x54, 0x7f,
-		0x1c, 0xc8, 0x58, 0x52, 0x28, 0x1d, 0x3a, 0x06, 0x49, 0xf2, 0x97, 0xf9, 0xc6, 0x20, 0x95, 0x39,
-		0x41, 0x00, 0xc4, 0x2f, 0x50, 0x16, 0x12, 0x74, 0x98, 0x54, 0xb1, 0x98, 0xda, 0xdc, 0x32, 0x71,
-		0xac, 0x2a, 0xde, 0x52, 0x9b, 0x75, 0xa7, 0x7c, 0x45, 0xad, 0x37, 0x31, 0x75, 0xf8, 0xa4, 0x92,
-		0xe2, 0xc0, 0xcb, 0x04, 0x86, 0xa6, 0x61, 0x98, 0x8d, 0x2a, 0x4d, 0xaf, 0xe2, 0x5d, 0x1a, 0x3d,
-		0xe3, 0x0a, 0x1b, 0x68, 0x0b, 0x04, 0x42, 0x9a, 0x7f, 0xd1, 0x36, 0x74, 0xe1, 0x9a, 0xb4, 0x09,
-		0x02, 0xa0, 0xcd, 0x3f, 0x11, 0x0e, 0xdc, 0x27, 0xda, 0xab, 0xd7, 0x32, 0x96, 0xee, 0x87, 0x31,
-		0x8a, 0xf1, 0x18, 0xef, 0x7a, 0xb5, 0x9e, 0x19, 0x9f, 0x91, 0x4e, 0x26, 0x94, 0x51, 0x06, 0x5e,
-		0xe1, 0x50, 0xf9, 0xcb, 0x11, 0x88, 0xd1, 0xc0, 0x32, 0x06, 0xc3, 0xeb, 0x6f, 0x5b, 0x2d, 0x95,
-		0x8b, 0x2b, 0x1b, 0x85, 0xc5, 0x52, 0x5a, 0x42, 0xa3, 0x00, 0x14, 0x70, 0x61, 0x71, 0x25, 0xbf,
-		0x9e, 0x8e, 0xb8, 0xe5, 0x85, 0xe5, 0xf5, 0x73, 0x8f, 0xa7, 0xa3, 0x2e, 0xc1, 0x06, 0x03, 0xc4,
-		0xfc, 0x08, 0x8f, 0x9d, 0x49, 0xc7, 0x51, 0x1a, 0x52, 0x8c, 0xc1, 0xc2, 0xf3, 0xa5, 0xe2, 0xb9,
-		0xc7, 0xd3, 0x83, 0x41, 0xc8, 0x63, 0x67, 0xd2, 0x43, 0x68, 0x04, 0x92, 0x14, 0x52, 0x58, 0x59,
-		0x59, 0x4c, 0x27, 0x5c, 0x9e, 0x6b, 0xeb, 0xca, 0xc2, 0xf2, 0x7c, 0x3a, 0xe9, 0xf2, 0x9c, 0x57,
-		0x56, 0x36, 0x56, 0xd3, 0xe0, 0x72, 0x58, 0x2a, 0xad, 0xad, 0xe5, 0xe7, 0x4b, 0xe9, 0x61, 0x17,
-		0xa3, 0xf0, 0xb6, 0xf5, 0xd2, 0x5a, 0x3a, 0x15, 0x10, 0xeb, 0xb1, 0x33, 0xe9, 0x11, 0xb7, 0x89,
-		0xd2, 0xf2, 0xc6, 0x52, 0x7a, 0x14, 0x8d, 0xc3, 0x08, 0x6b, 0x42, 0x08, 0x31, 0x16, 0x02, 0x9d,
-		0x7b, 0x3c, 0x9d, 0xf6, 0x04, 0x61, 0x5c, 0xc6, 0x03, 0x80, 0x73, 0x8f, 0xa7, 0x91, 0x3c, 0x07,
-		0x71, 0xea, 0x86, 0x08, 0xc1, 0xe8, 0x62, 0xbe, 0x50, 0x5a, 0x2c, 0xaf, 0xac, 0xae, 0x2f, 0xac,
-		0x2c, 0xe7, 0x17, 0xd3, 0x92, 0x07, 0x53, 0x4a, 0xcf, 0x6e, 0x2c, 0x28, 0xa5, 0x62, 0x3a, 0xe2,
-		0x87, 0xad, 0x96, 0xf2, 0xeb, 0xa5, 0x62, 0x3a, 0x2a, 0x57, 0x60, 0xb2, 0x5d, 0x40, 0x6d, 0x3b,
-		0x84, 0x7c, 0xbe, 0x10, 0xe9, 0xe0, 0x0b, 0x94, 0x57, 0xd8, 0x17, 0xe4, 0xef, 0x44, 0x60, 0xa2,
-		0xcd, 0xa4, 0xd2, 0xb6, 0x91, 0xa7, 0x21, 0xce, 0x7c, 0x99, 0x4d, 0xb3, 0x0f, 0xb4, 0x9d, 0x9d,
-		0xa8, 0x67, 0xb7, 0x4c, 0xb5, 0x94, 0xce, 0x9f, 0x6a, 0x44, 0x3b, 0xa4, 0x1a, 0x84, 0x45, 0x8b,
-		0xc3, 0xfe, 0x54, 0x4b, 0xf0, 0x67, 0xf3, 0xe3, 0xb9, 0x7e, 0xe6, 0x47, 0x0a, 0xdb, 0xdf, 0x24,
-		0x10, 0x6f, 0x33, 0x09, 0x9c, 0x87, 0xf1, 0x16, 0x46, 0x7d, 0x07, 0xe3, 0xf7, 0x4a, 0x90, 0xe9,
-		0x64, 0x9c, 0x1e, 0x21, 0x31, 0x12, 0x08, 0x89, 0xe7, 0xc3, 0x16, 0xbc, 0xab, 0x73, 0x27, 0xb4,
-		0xf4, 0xf5, 0x67, 0x25, 0x38, 0xdc, 0x3e, 0xa5, 0x6c, 0x2b, 0xc3, 0x5b, 0x60, 0xb0, 0x81, 0x9d,
-		0x6d, 0x43, 0xa4, 0x55, 0xf7, 0xb5, 0x99, 0xac, 0x49, 0x75, 0xb8, 0xb3, 0x39, 0x95, 0x7f, 0xb6,
-		0x8f, 0x76, 0xca, 0x0b, 0x99, 0x34, 0x2d, 0x92, 0x7e, 0x30, 0x02, 0x87, 0xda, 0x32, 0x6f, 0x2b,
-		0xe8, 0x09, 0x00, 0x4d, 0x37, 0x9b, 0x0e, 0x4b, 0x9d, 0x58, 0x24, 0x4e, 0x52, 0x08, 0x0d, 0x5e,
-		0x24, 0xca, 0x36, 0x1d, 0xb7, 0x3e, 0x4a, 0xeb, 0x81, 0x81, 0x28, 0xc2, 0x93, 0x9e, 0xa0, 0x31,
-		0x2a, 0xe8, 0x54, 0x07, 0x4d, 0x5b, 0x1c, 0xf3, 0x11, 0x48, 0x57, 0xea, 0x1a, 0xd6, 0x9d, 0xb2,
-		0xed, 0x58, 0x58, 0x6d, 0x68, 0x7a, 0x8d, 0x4e, 0x35, 0x89, 0x5c, 0x7c, 0x4b, 0xad, 0xdb, 0x58,
-		0x19, 0x63, 0xd5, 0x6b, 0xa2, 0x96, 0x50, 0x50, 0x07, 0xb2, 0x7c, 0x14, 0x83, 0x01, 0x0a, 0x56,
-		0xed, 0x52, 0xc8, 0x1f, 0x4e, 0xc2, 0xb0, 0x2f, 0x01, 0x47, 0x77, 0x41, 0xea, 0x45, 0xf5, 0x8a,
-		0x5a, 0x16, 0x8b, 0x2a, 0x66, 0x89, 0x61, 0x02, 0x5b, 0xe5, 0x0b, 0xab, 0x47, 0x60, 0x92, 0xa2,
-		0x18, 0x4d, 0x07, 0x5b, 0xe5, 0x4a, 0x5d, 0xb5, 0x6d, 0x6a, 0xb4, 0x04, 0x45, 0x45, 0xa4, 0x6e,
-		0x85, 0x54, 0xcd, 0x89, 0x1a, 0x74, 0x16, 0x26, 0x28, 0x45, 0xa3, 0x59, 0x77, 0x34, 0xb3, 0x8e,
-		0xcb, 0x64, 0x99, 0x67, 0xd3, 0x29, 0xc7, 0x95, 0x6c, 0x9c, 0x60, 0x2c, 0x71, 0x04, 0x22, 0x91,
-		0x8d, 0x8a, 0x70, 0x82, 0x92, 0xd5, 0xb0, 0x8e, 0x2d, 0xd5, 0xc1, 0x65, 0xfc, 0x52, 0x53, 0xad,
-		0xdb, 0x65, 0x55, 0xaf, 0x96, 0xb7, 0x55, 0x7b, 0x3b, 0x33, 0x49, 0x18, 0x14, 0x22, 0x19, 0x49,
-		0x39, 0x4a, 0x10, 0xe7, 0x39, 0x5e, 0x89, 0xa2, 0xe5, 0xf5, 0xea, 0x45, 0xd5, 0xde, 0x46, 0x39,
-		0x38, 0x4c, 0xb9, 0xd8, 0x8e, 0xa5, 0xe9, 0xb5, 0x72, 0x65, 0x1b, 0x57, 0x76, 0xca, 0x4d, 0x67,
-		0xeb, 0xc9, 0xcc, 0x31, 0x7f, 0xfb, 0x54, 0xc2, 0x35, 0x8a, 0x33, 0x47, 0x50, 0x36, 0x9c, 0xad,
-		0x27, 0xd1, 0x1a, 0xa4, 0x48, 0x67, 0x34, 0xb4, 0x6b, 0xb8, 0xbc, 0x65, 0x58, 0x74, 0x0e, 0x1d,
-		0x6d, 0x13, 0x9a, 0x7c, 0x16, 0x9c, 0x5d, 0xe1, 0x04, 0x4b, 0x46, 0x15, 0xe7, 0xe2, 0x6b, 0xab,
-		0xa5, 0x52, 0x51, 0x19, 0x16, 0x5c, 0x2e, 0x18, 0x16, 0x71, 0xa8, 0x9a, 0xe1, 0x1a, 0x78, 0x98,
-		0x39, 0x54, 0xcd, 0x10, 0xe6, 0x3d, 0x0b, 0x13, 0x95, 0x0a, 0xd3, 0x59, 0xab, 0x94, 0xf9, 0x62,
-		0xcc, 0xce, 0xa4, 0x03, 0xc6, 0xaa, 0x54, 0xe6, 0x19, 0x02, 0xf7, 0x71, 0x1b, 0x3d, 0x05, 0x87,
-		0x3c, 0x63, 0xf9, 0x09, 0xc7, 0x5b, 0xb4, 0x0c, 0x93, 0x9e, 0x85, 0x09, 0x73, 0xaf, 0x95, 0x10,
-		0x05, 0x5a, 0x34, 0xf7, 0xc2, 0x64, 0x4f, 0xc0, 0xa4, 0xb9, 0x6d, 0xb6, 0xd2, 0x9d, 0xf2, 0xd3,
-		0x21, 0x73, 0xdb, 0x0c, 0x13, 0xde, 0x4b, 0x57, 0xe6, 0x16, 0xae, 0xa8, 0x0e, 0xae, 0x66, 0x8e,
-		0xf8, 0xd1, 0x7d, 0x15, 0x68, 0x16, 0xd2, 0x95, 0x4a, 0x19, 0xeb, 0xea, 0x66, 0x1d, 0x97, 0x55,
-		0x0b, 0xeb, 0xaa, 0x9d, 0x99, 0xa6, 0xc8, 0x31, 0xc7, 0x6a, 0x62, 0x65, 0xb4, 0x52, 0x29, 0xd1,
-		0xca, 0x3c, 0xad, 0x43, 0xa7, 0x60, 0xdc, 0xd8, 0x7c, 0xb1, 0xc2, 0x3c, 0xb2, 0x6c, 0x5a, 0x78,
-		0x4b, 0xdb, 0xcd, 0xdc, 0x43, 0xcd, 0x3b, 0x46, 0x2a, 0xa8, 0x3f, 0xae, 0x52, 0x30, 0x7a, 0x00,
-		0xd2, 0x15, 0x7b, 0x5b, 0xb5, 0x4c, 0x1a, 0x92, 0x6d, 0x53, 0xad, 0xe0, 0xcc, 0xbd, 0x0c, 0x95,
-		0xc1, 0x97, 0x05, 0x98, 0x8c, 0x08, 0xfb, 0xaa, 0xb6, 0xe5, 0x08, 0x8e, 0xf7, 0xb3, 0x11, 0x41,
-		0x61, 0x9c, 0xdb, 0x49, 0x48, 0x13, 0x4b, 0x04, 0x1a, 0x3e, 0x49, 0xd1, 0x46, 0xcd, 0x6d, 0xd3,
-		0xdf, 0xee, 0xdd, 0x30, 0x42, 0x30, 0xbd, 0x46, 0x1f, 0x60, 0x89, 0x9b, 0xb9, 0xed, 0x6b, 0xf1,
-		0x71, 0x38, 0x4c, 0x90, 0x1a, 0xd8, 0x51, 0xab, 0xaa, 0xa3, 0xfa, 0xb0, 0x1f, 0xa2, 0xd8, 0xc4,
-		0xec, 0x4b, 0xbc, 0x32, 0x20, 0xa7, 0xd5, 0xdc, 0xdc, 0x73, 0x1d, 0xeb, 0x61, 0x26, 0x27, 0x81,
-		0x09, 0xd7, 0xba, 0x63, 0xc9, 0xb9, 0x9c, 0x83, 0x94, 0xdf, 0xef, 0x51, 0x12, 0x98, 0xe7, 0xa7,
-		0x25, 0x92, 0x04, 0xcd, 0xad, 0x14, 0x49, 0xfa, 0xf2, 0x42, 0x29, 0x1d, 0x21, 0x69, 0xd4, 0xe2,
-		0xc2, 0x7a, 0xa9, 0xac, 0x6c, 0x2c, 0xaf, 0x2f, 0x2c, 0x95, 0xd2, 0x51, 0x5f, 0x62, 0xff, 0x4c,
-		0x2c, 0x71, 0x5f, 0xfa, 0x7e, 0xf9, 0x5b, 0x11, 0x18, 0x0d, 0xae, 0xd4, 0xd0, 0x9b, 0xe0, 0x88,
-		0xd8, 0x56, 0xb1, 0xb1, 0x53, 0xbe, 0xaa, 0x59, 0x74, 0x40, 0x36, 0x54, 0x36, 0x39, 0xba, 0xfe,
-		0x33, 0xc9, 0xb1, 0xd6, 0xb0, 0xf3, 0x9c, 0x66, 0x91, 0xe1, 0xd6, 0x50, 0x1d, 0xb4, 0x08, 0xd3,
-		0xba, 0x51, 0xb6, 0x1d, 0x55, 0xaf, 0xaa, 0x56, 0xb5, 0xec, 0x6d, 0x68, 0x95, 0xd5, 0x4a, 0x05,
-		0xdb, 0xb6, 0xc1, 0x26, 0x42, 0x97, 0xcb, 0x71, 0xdd, 0x58, 0xe3, 0xc8, 0xde, 0x0c, 0x91, 0xe7,
-		0xa8, 0x21, 0xf7, 0x8d, 0x76, 0x72, 0xdf, 0x63, 0x90, 0x6c, 0xa8, 0x66, 0x19, 0xeb, 0x8e, 0xb5,
-		0x47, 0xf3, 0xf3, 0x84, 0x92, 0x68, 0xa8, 0x66, 0x89, 0x94, 0x7f, 0x2c, 0xcb, 0xa4, 0x67, 0x62,
-		0x89, 0x44, 0x3a, 0xf9, 0x4c, 0x2c, 0x91, 0x4c, 0x83, 0xfc, 0x6a, 0x14, 0x52, 0xfe, 0x7c, 0x9d,
-		0x2c, 0x7f, 0x2a, 0x74, 0xc6, 0x92, 0x68, 0x4c, 0xbb, 0xbb, 0x6b, 0x76, 0x3f, 0x3b, 0x47, 0xa6,
-		0xb2, 0xdc, 0x20, 0x4b, 0x8e, 0x15, 0x46, 0x49, 0xd2, 0x08, 0xe2, 0x6c, 0x98, 0x25, 0x23, 0x09,
-		0x85, 0x97, 0xd0, 0x3c, 0x0c, 0xbe, 0x68, 0x53, 0xde, 0x83, 0x94, 0xf7, 0x3d, 0xdd, 0x79, 0x3f,
-		0xb3, 0x46, 0x99, 0x27, 0x9f, 0x59, 0x2b, 0x2f, 0xaf, 0x28, 0x4b, 0xf9, 0x45, 0x85, 0x93, 0xa3,
-		0xa3, 0x10, 0xab, 0xab, 0xd7, 0xf6, 0x82, 0x93, 0x1e, 0x05, 0xf5, 0xdb, 0x09, 0x47, 0x21, 0x76,
-		0x15, 0xab, 0x3b, 0xc1, 0xa9, 0x86, 0x82, 0xee, 0xe0, 0x60, 0x38, 0x0d, 0x71, 0x6a, 0x2f, 0x04,
-		0xc0, 0x2d, 0x96, 0x1e, 0x40, 0x09, 0x88, 0xcd, 0xad, 0x28, 0x64, 0x40, 0xa4, 0x21, 0xc5, 0xa0,
-		0xe5, 0xd5, 0x85, 0xd2, 0x5c, 0x29, 0x1d, 0x91, 0xcf, 0xc2, 0x20, 0x33, 0x02, 0x19, 0x2c, 0xae,
-		0x19, 0xd2, 0x03, 0xbc, 0xc8, 0x79, 0x48, 0xa2, 0x76, 0x63, 0xa9, 0x50, 0x52, 0xd2, 0x91, 0x60,
-		0x57, 0xc7, 0xd2, 0x71, 0xd9, 0x86, 0x94, 0x3f, 0x0f, 0xff, 0xf1, 0x2c, 0xc6, 0xbf, 0x22, 0xc1,
-		0xb0, 0x2f, 0xaf, 0x26, 0x09, 0x91, 0x5a, 0xaf, 0x1b, 0x57, 0xcb, 0x6a, 0x5d, 0x53, 0x6d, 0xee,
-		0x1a, 0x40, 0x41, 0x79, 0x02, 0xe9, 0xb7, 0xeb, 0x7e, 0x4c, 0x43, 0x24, 0x9e, 0x1e, 0x94, 0x3f,
-		0x29, 0x41, 0x3a, 0x9c, 0xd8, 0x86, 0xc4, 0x94, 0xde, 0x48, 0x31, 0xe5, 0x4f, 0x48, 0x30, 0x1a,
-		0xcc, 0x66, 0x43, 0xe2, 0xdd, 0xf5, 0x86, 0x8a, 0xf7, 0xfb, 0x11, 0x18, 0x09, 0xe4, 0xb0, 0xfd,
-		0x4a, 0xf7, 0x12, 0x8c, 0x6b, 0x55, 0xdc, 0x30, 0x0d, 0x07, 0xeb, 0x95, 0xbd, 0x72, 0x1d, 0x5f,
-		0xc1, 0xf5, 0x8c, 0x4c, 0x83, 0xc6, 0xe9, 0xee, 0x59, 0xf2, 0xec, 0x82, 0x47, 0xb7, 0x48, 0xc8,
-		0x72, 0x13, 0x0b, 0xc5, 0xd2, 0xd2, 0xea, 0xca, 0x7a, 0x69, 0x79, 0xee, 0x6d, 0xe5, 0x8d, 0xe5,
-		0x4b, 0xcb, 0x2b, 0xcf, 0x2d, 0x2b, 0x69, 0x2d, 0x84, 0x76, 0x07, 0x87, 0xfd, 0x2a, 0xa4, 0xc3,
-		0x42, 0xa1, 0x23, 0xd0, 0x4e, 0xac, 0xf4, 0x00, 0x9a, 0x80, 0xb1, 0xe5, 0x95, 0xf2, 0xda, 0x42,
-		0xb1, 0x54, 0x2e, 0x5d, 0xb8, 0x50, 0x9a, 0x5b, 0x5f, 0x63, 0xfb, 0x1e, 0x2e, 0xf6, 0x7a, 0x60,
-		0x80, 0xcb, 0xaf, 0x44, 0x61, 0xa2, 0x8d, 0x24, 0x28, 0xcf, 0x57, 0x2c, 0x6c, 0x11, 0xf5, 0x70,
-		0x3f, 0xd2, 0xcf, 0x92, 0x9c, 0x61, 0x55, 0xb5, 0x1c, 0xbe, 0xc0, 0x79, 0x00, 0x88, 0x95, 0x74,
-		0x47, 0xdb, 0xd2, 0xb0, 0xc5, 0xf7, 0x93, 0xd8, 0x32, 0x66, 0xcc, 0x83, 0xb3, 0x2d, 0xa5, 0x87,
-		0x00, 0x99, 0x86, 0xad, 0x39, 0xda, 0x15, 0x5c, 0xd6, 0x74, 0xb1, 0xf9, 0x44, 0x96, 0x35, 0x31,
-		0x25, 0x2d, 0x6a, 0x16, 0x74, 0xc7, 0xc5, 0xd6, 0x71, 0x4d, 0x0d, 0x61, 0x93, 0x60, 0x1e, 0x55,
-		0xd2, 0xa2, 0xc6, 0xc5, 0xbe, 0x0b, 0x52, 0x55, 0xa3, 0x49, 0x72, 0x3d, 0x86, 0x47, 0xe6, 0x0e,
-		0x49, 0x19, 0x66, 0x30, 0x17, 0x85, 0x67, 0xf1, 0xde, 0xae, 0x57, 0x4a, 0x19, 0x66, 0x30, 0x86,
-		0x72, 0x3f, 0x8c, 0xa9, 0xb5, 0x9a, 0x45, 0x98, 0x0b, 0x46, 0x6c, 0x5d, 0x32, 0xea, 0x82, 0x29,
-		0x62, 0xf6, 0x19, 0x48, 0x08, 0x3b, 0x90, 0xa9, 0x9a, 0x58, 0xa2, 0x6c, 0xb2, 0xc5, 0x76, 0xe4,
-		0x64, 0x52, 0x49, 0xe8, 0xa2, 0xf2, 0x2e, 0x48, 0x69, 0x76, 0xd9, 0xdb, 0xc4, 0x8f, 0xcc, 0x44,
-		0x4e, 0x26, 0x94, 0x61, 0xcd, 0x76, 0x37, 0x40, 0xe5, 0xcf, 0x46, 0x60, 0x34, 0x78, 0x08, 0x81,
-		0x8a, 0x90, 0xa8, 0x1b, 0x15, 0x95, 0xba, 0x16, 0x3b, 0x01, 0x3b, 0xd9, 0xe3, 0xdc, 0x62, 0x76,
-		0x91, 0xe3, 0x2b, 0x2e, 0x65, 0xf6, 0xb7, 0x25, 0x48, 0x08, 0x30, 0x3a, 0x0c, 0x31, 0x53, 0x75,
-		0xb6, 0x29, 0xbb, 0x78, 0x21, 0x92, 0x96, 0x14, 0x5a, 0x26, 0x70, 0xdb, 0x54, 0x75, 0xea, 0x02,
-		0x1c, 0x4e, 0xca, 0xa4, 0x5f, 0xeb, 0x58, 0xad, 0xd2, 0x45, 0x8f, 0xd1, 0x68, 0x60, 0xdd, 0xb1,
-		0x45, 0xbf, 0x72, 0xf8, 0x1c, 0x07, 0xa3, 0x07, 0x61, 0xdc, 0xb1, 0x54, 0xad, 0x1e, 0xc0, 0x8d,
-		0x51, 0xdc, 0xb4, 0xa8, 0x70, 0x91, 0x73, 0x70, 0x54, 0xf0, 0xad, 0x62, 0x47, 0xad, 0x6c, 0xe3,
-		0xaa, 0x47, 0x34, 0x48, 0x37, 0x37, 0x8e, 0x70, 0x84, 0x22, 0xaf, 0x17, 0xb4, 0xf2, 0xb7, 0x24,
-		0x18, 0x17, 0xcb, 0xb4, 0xaa, 0x6b, 0xac, 0x25, 0x00, 0x55, 0xd7, 0x0d, 0xc7, 0x6f, 0xae, 0x56,
-		0x57, 0x6e, 0xa1, 0x9b, 0xcd, 0xbb, 0x44, 0x8a, 0x8f, 0x41, 0xb6, 0x01, 0xe0, 0xd5, 0x74, 0x34,
-		0xdb, 0x34, 0x0c, 0xf3, 0x13, 0x26, 0x7a, 0x4c, 0xc9, 0x16, 0xf6, 0xc0, 0x40, 0x64, 0x3d, 0x87,
-		0x26, 0x21, 0xbe, 0x89, 0x6b, 0x9a, 0xce, 0xf7, 0x8d, 0x59, 0x41, 0x6c, 0xbf, 0xc4, 0xdc, 0xed,
-		0x97, 0xc2, 0x5f, 0x86, 0x89, 0x8a, 0xd1, 0x08, 0x8b, 0x5b, 0x48, 0x87, 0x36, 0x17, 0xec, 0x8b,
-		0xd2, 0x0b, 0x0f, 0x73, 0xa4, 0x9a, 0x51, 0x57, 0xf5, 0xda, 0xac, 0x61, 0xd5, 0xbc, 0x63, 0x56,
-		0x92, 0xf1, 0xd8, 0xbe, 0xc3, 0x56, 0x73, 0xf3, 0x4f, 0x25, 0xe9, 0x17, 0x22, 0xd1, 0xf9, 0xd5,
-		0xc2, 0xe7, 0x22, 0xd9, 0x79, 0x46, 0xb8, 0x2a, 0x8c, 0xa1, 0xe0, 0xad, 0x3a, 0xae, 0x10, 0x05,
-		0xe1, 0x7b, 0x0f, 0xc2, 0x64, 0xcd, 0xa8, 0x19, 0x94, 0xd3, 0x69, 0xf2, 0x8b, 0x9f, 0xd3, 0x26,
-		0x5d, 0x68, 0xb6, 0xe7, 0xa1, 0x6e, 0x6e, 0x19, 0x26, 0x38, 0x72, 0x99, 0x1e, 0x14, 0xb1, 0x65,
-		0x0c, 0xea, 0xba, 0x87, 0x96, 0xf9, 0xc2, 0x77, 0xe9, 0xf4, 0xad, 0x8c, 0x73, 0x52, 0x52, 0xc7,
-		0x56, 0x3a, 0x39, 0x05, 0x0e, 0x05, 0xf8, 0xb1, 0x41, 0x8a, 0xad, 0x1e, 0x1c, 0x7f, 0x83, 0x73,
-		0x9c, 0xf0, 0x71, 0x5c, 0xe3, 0xa4, 0xb9, 0x39, 0x18, 0xd9, 0x0f, 0xaf, 0x7f, 0xc9, 0x79, 0xa5,
-		0xb0, 0x9f, 0xc9, 0x3c, 0x8c, 0x51, 0x26, 0x95, 0xa6, 0xed, 0x18, 0x0d, 0x1a, 0x01, 0xbb, 0xb3,
-		0xf9, 0xcd, 0xef, 0xb2, 0x51, 0x33, 0x4a, 0xc8, 0xe6, 0x5c, 0xaa, 0x5c, 0x0e, 0xe8, 0xd9, 0x58,
-		0x15, 0x57, 0xea, 0x3d, 0x38, 0x7c, 0x8d, 0x0b, 0xe2, 0xe2, 0xe7, 0x2e, 0xc3, 0x24, 0xf9, 0x4d,
-		0x03, 0x94, 0x5f, 0x92, 0xde, 0x1b, 0x6e, 0x99, 0x6f, 0xbd, 0x97, 0x0d, 0xcc, 0x09, 0x97, 0x81,
-		0x4f, 0x26, 0x5f, 0x2f, 0xd6, 0xb0, 0xe3, 0x60, 0xcb, 0x2e, 0xab, 0xf5, 0x76, 0xe2, 0xf9, 0x76,
-		0x2c, 0x32, 0x1f, 0xfb, 0x7e, 0xb0, 0x17, 0xe7, 0x19, 0x65, 0xbe, 0x5e, 0xcf, 0x6d, 0xc0, 0x91,
-		0x36, 0x5e, 0xd1, 0x07, 0xcf, 0x57, 0x38, 0xcf, 0xc9, 0x16, 0xcf, 0x20, 0x6c, 0x57, 0x41, 0xc0,
-		0xdd, 0xbe, 0xec, 0x83, 0xe7, 0xc7, 0x39, 0x4f, 0xc4, 0x69, 0x45, 0x97, 0x12, 0x8e, 0xcf, 0xc0,
-		0xf8, 0x15, 0x6c, 0x6d, 0x1a, 0x36, 0xdf, 0x25, 0xea, 0x83, 0xdd, 0x27, 0x38, 0xbb, 0x31, 0x4e,
-		0x48, 0xb7, 0x8d, 0x08, 0xaf, 0xa7, 0x20, 0xb1, 0xa5, 0x56, 0x70, 0x1f, 0x2c, 0x6e, 0x70, 0x16,
-		0x43, 0x04, 0x9f, 0x90, 0xe6, 0x21, 0x55, 0x33, 0xf8, 0x1c, 0xd5, 0x9b, 0xfc, 0x93, 0x9c, 0x7c,
-		0x58, 0xd0, 0x70, 0x16, 0xa6, 0x61, 0x36, 0xeb, 0x64, 0x02, 0xeb, 0xcd, 0xe2, 0x6f, 0x09, 0x16,
-		0x82, 0x86, 0xb3, 0xd8, 0x87, 0x59, 0x3f, 0x25, 0x58, 0xd8, 0x3e, 0x7b, 0x3e, 0x0d, 0xc3, 0x86,
-		0x5e, 0xdf, 0x33, 0xf4, 0x7e, 0x84, 0xf8, 0x34, 0xe7, 0x00, 0x9c, 0x84, 0x30, 0x38, 0x0f, 0xc9,
-		0x7e, 0x3b, 0xe2, 0x6f, 0x7f, 0x5f, 0x0c, 0x0f, 0xd1, 0x03, 0xf3, 0x30, 0x26, 0x02, 0x94, 0x66,
-		0xe8, 0x7d, 0xb0, 0xf8, 0x3b, 0x9c, 0xc5, 0xa8, 0x8f, 0x8c, 0xab, 0xe1, 0x60, 0xdb, 0xa9, 0xe1,
-		0x7e, 0x98, 0x7c, 0x56, 0xa8, 0xc1, 0x49, 0xb8, 0x29, 0x37, 0xb1, 0x5e, 0xd9, 0xee, 0x8f, 0xc3,
-		0x2f, 0x09, 0x53, 0x0a, 0x1a, 0xc2, 0x62, 0x0e, 0x46, 0x1a, 0xaa, 0x65, 0x6f, 0xab, 0xf5, 0xbe,
-		0xba, 0xe3, 0xef, 0x72, 0x1e, 0x29, 0x97, 0x88, 0x5b, 0xa4, 0xa9, 0xef, 0x87, 0xcd, 0xe7, 0x84,
-		0x45, 0x7c, 0x64, 0x7c, 0xe8, 0xd9, 0x0e, 0xdd, 0x52, 0xdb, 0x0f, 0xb7, 0x5f, 0x16, 0x43, 0x8f,
-		0xd1, 0x2e, 0xf9, 0x39, 0x9e, 0x87, 0xa4, 0xad, 0x5d, 0xeb, 0x8b, 0xcd, 0xe7, 0x45, 0x4f, 0x53,
-		0x02, 0x42, 0xfc, 0x36, 0x38, 0xda, 0x76, 0x9a, 0xe8, 0x83, 0xd9, 0xdf, 0xe3, 0xcc, 0x0e, 0xb7,
-		0x99, 0x2a, 0x78, 0x48, 0xd8, 0x2f, 0xcb, 0xbf, 0x2f, 0x42, 0x02, 0x0e, 0xf1, 0x5a, 0x25, 0xab,
-		0x06, 0x5b, 0xdd, 0xda, 0x9f, 0xd5, 0x7e, 0x45, 0x58, 0x8d, 0xd1, 0x06, 0xac, 0xb6, 0x0e, 0x87,
-		0x39, 0xc7, 0xfd, 0xf5, 0xeb, 0xaf, 0x8a, 0xc0, 0xca, 0xa8, 0x37, 0x82, 0xbd, 0xfb, 0x76, 0xc8,
-		0xba, 0xe6, 0x14, 0xe9, 0xa9, 0x5d, 0x6e, 0xa8, 0x66, 0x1f, 0x9c, 0xbf, 0xc0, 0x39, 0x8b, 0x88,
-		0xef, 0xe6, 0xb7, 0xf6, 0x92, 0x6a, 0x12, 0xe6, 0xcf, 0x43, 0x46, 0x30, 0x6f, 0xea, 0x16, 0xae,
-		0x18, 0x35, 0x5d, 0xbb, 0x86, 0xab, 0x7d, 0xb0, 0xfe, 0xb5, 0x50, 0x57, 0x6d, 0xf8, 0xc8, 0x09,
-		0xe7, 0x05, 0x48, 0xbb, 0xb9, 0x4a, 0x59, 0x6b, 0x98, 0x86, 0xe5, 0xf4, 0xe0, 0xf8, 0x45, 0xd1,
-		0x53, 0x2e, 0xdd, 0x02, 0x25, 0xcb, 0x95, 0x80, 0x9d, 0x33, 0xf7, 0xeb, 0x92, 0x5f, 0xe2, 0x8c,
-		0x46, 0x3c, 0x2a, 0x1e, 0x38, 0x2a, 0x46, 0xc3, 0x54, 0xad, 0x7e, 0xe2, 0xdf, 0x3f, 0x10, 0x81,
-		0x83, 0x93, 0xf0, 0xc0, 0x41, 0x32, 0x3a, 0x32, 0xdb, 0xf7, 0xc1, 0xe1, 0xcb, 0x22, 0x70, 0x08,
-		0x1a, 0xce, 0x42, 0x24, 0x0c, 0x7d, 0xb0, 0xf8, 0x87, 0x82, 0x85, 0xa0, 0x21, 0x2c, 0x9e, 0xf5,
-		0x26, 0x5a, 0x0b, 0xd7, 0x34, 0xdb, 0xb1, 0x58, 0x52, 0xdc, 0x9d, 0xd5, 0x3f, 0xfa, 0x7e, 0x30,
-		0x09, 0x53, 0x7c, 0xa4, 0x24, 0x12, 0xf1, 0x4d, 0x56, 0xba, 0x66, 0xea, 0x2d, 0xd8, 0xaf, 0x8b,
-		0x48, 0xe4, 0x23, 0x23, 0xb2, 0xf9, 0x32, 0x44, 0x62, 0xf6, 0x0a, 0x59, 0x29, 0xf4, 0xc1, 0xee,
-		0x1f, 0x87, 0x84, 0x5b, 0x13, 0xb4, 0x84, 0xa7, 0x2f, 0xff, 0x69, 0xea, 0x3b, 0x78, 0xaf, 0x2f,
-		0xef, 0xfc, 0x27, 0xa1, 0xfc, 0x67, 0x83, 0x51, 0xb2, 0x18, 0x32, 0x16, 0xca, 0xa7, 0x50, 0xaf,
-		0x5b, 0x45, 0x99, 0x9f, 0xfe, 0x21, 0xd7, 0x37, 0x98, 0x4e, 0xe5, 0x16, 0x89, 0x93, 0x07, 0x93,
-		0x9e, 0xde, 0xcc, 0xde, 0xfb, 0x43, 0xd7, 0xcf, 0x03, 0x39, 0x4f, 0xee, 0x02, 0x8c, 0x04, 0x12,
-		0x9e, 0xde, 0xac, 0xde, 0xc7, 0x59, 0xa5, 0xfc, 0xf9, 0x4e, 0xee, 0x2c, 0xc4, 0x48, 0xf2, 0xd2,
-		0x9b, 0xfc, 0xaf, 0x70, 0x72, 0x8a, 0x9e, 0x7b, 0x33, 0x24, 0x44, 0xd2, 0xd2, 0x9b, 0xf4, 0xfd,
-		0x9c, 0xd4, 0x25, 0x21, 0xe4, 0x22, 0x61, 0xe9, 0x4d, 0xfe, 0x57, 0x05, 0xb9, 0x20, 0x21, 0xe4,
-		0xfd, 0x9b, 0xf0, 0x2b, 0x3f, 0x13, 0xe3, 0x93, 0x8e, 0xb0, 0xdd, 0x79, 0x18, 0xe2, 0x99, 0x4a,
-		0x6f, 0xea, 0x0f, 0xf2, 0xc6, 0x05, 0x45, 0xee, 0x09, 0x88, 0xf7, 0x69, 0xf0, 0x9f, 0xe5, 0xa4,
-		0x0c, 0x3f, 0x37, 0x07, 0xc3, 0xbe, 0xec, 0xa4, 0x37, 0xf9, 0x5f, 0xe3, 0xe4, 0x7e, 0x2a, 0x22,
-		0x3a, 0xcf, 0x4e, 0x7a, 0x33, 0xf8, 0x39, 0x21, 0x3a, 0xa7, 0x20, 0x66, 0x13, 0x89, 0x49, 0x6f,
-		0xea, 0x0f, 0x09, 0xab, 0x0b, 0x92, 0xdc, 0xd3, 0x90, 0x74, 0x27, 0x9b, 0xde, 0xf4, 0x1f, 0xe6,
-		0xf4, 0x1e, 0x0d, 0xb1, 0x80, 0x6f, 0xb2, 0xeb, 0xcd, 0xe2, 0xaf, 0x0b, 0x0b, 0xf8, 0xa8, 0xc8,
-		0x30, 0x0a, 0x27, 0x30, 0xbd, 0x39, 0x7d, 0x44, 0x0c, 0xa3, 0x50, 0xfe, 0x42, 0x7a, 0x93, 0xc6,
-		0xfc, 0xde, 0x2c, 0xfe, 0x86, 0xe8, 0x4d, 0x8a, 0x4f, 0xc4, 0x08, 0x67, 0x04, 0xbd, 0x79, 0xfc,
-		0xbc, 0x10, 0x23, 0x94, 0x10, 0xe4, 0x56, 0x01, 0xb5, 0x66, 0x03, 0xbd, 0xf9, 0x7d, 0x94, 0xf3,
-		0x1b, 0x6f, 0x49, 0x06, 0x72, 0xcf, 0xc1, 0xe1, 0xf6, 0x99, 0x40, 0x6f, 0xae, 0x1f, 0xfb, 0x61,
-		0x68, 0xed, 0xe6, 0x4f, 0x04, 0x72, 0xeb, 0xde, 0x94, 0xe2, 0xcf, 0x02, 0x7a, 0xb3, 0x7d, 0xe5,
-		0x87, 0xc1, 0xc0, 0xed, 0x4f, 0x02, 0x72, 0x79, 0x00, 0x6f, 0x02, 0xee, 0xcd, 0xeb, 0x13, 0x9c,
-		0x97, 0x8f, 0x88, 0x0c, 0x0d, 0x3e, 0xff, 0xf6, 0xa6, 0xbf, 0x21, 0x86, 0x06, 0xa7, 0x20, 0x43,
-		0x43, 0x4c, 0xbd, 0xbd, 0xa9, 0x3f, 0x29, 0x86, 0x86, 0x20, 0x21, 0x9e, 0xed, 0x9b, 0xdd, 0x7a,
-		0x73, 0xf8, 0xb4, 0xf0, 0x6c, 0x1f, 0x55, 0x6e, 0x19, 0xc6, 0x5b, 0x26, 0xc4, 0xde, 0xac, 0x7e,
-		0x81, 0xb3, 0x4a, 0x87, 0xe7, 0x43, 0xff, 0xe4, 0xc5, 0x27, 0xc3, 0xde, 0xdc, 0x3e, 0x13, 0x9a,
-		0xbc, 0xf8, 0x5c, 0x98, 0x3b, 0x0f, 0x09, 0xbd, 0x59, 0xaf, 0x93, 0xc1, 0x83, 0xba, 0xdf, 0x04,
-		0xcc, 0xfc, 0xd7, 0x1f, 0x71, 0xeb, 0x08, 0x82, 0xdc, 0x59, 0x88, 0xe3, 0xc6, 0x26, 0xae, 0xf6,
-		0xa2, 0xfc, 0xde, 0x8f, 0x44, 0xc0, 0x24, 0xd8, 0xb9, 0xa7, 0x01, 0xd8, 0xd6, 0x08, 0x3d, 0x0c,
-		0xec, 0x41, 0xfb, 0xdf, 0x7e, 0xc4, 0xaf, 0xde, 0x78, 0x24, 0x1e, 0x03, 0x76, 0x91, 0xa7, 0x3b,
-		0x83, 0xef, 0x07, 0x19, 0xd0, 0x1e, 0x79, 0x0a, 0x86, 0x5e, 0xb4, 0x0d, 0xdd, 0x51, 0x6b, 0xbd,
-		0xa8, 0xff, 0x3b, 0xa7, 0x16, 0xf8, 0xc4, 0x60, 0x0d, 0xc3, 0xc2, 0x8e, 0x5a, 0xb3, 0x7b, 0xd1,
-		0xfe, 0x0f, 0x4e, 0xeb, 0x12, 0x10, 0xe2, 0x8a, 0x6a, 0x3b, 0xfd, 0xe8, 0xfd, 0x87, 0x82, 0x58,
-		0x10, 0x10, 0xa1, 0xc9, 0xef, 0x1d, 0xbc, 0xd7, 0x8b, 0xf6, 0x07, 0x42, 0x68, 0x8e, 0x9f, 0x7b,
-		0x33, 0x24, 0xc9, 0x4f, 0x76, 0x9f, 0xae, 0x07, 0xf1, 0x1f, 0x71, 0x62, 0x8f, 0x82, 0xb4, 0x6c,
-		0x3b, 0x55, 0x47, 0xeb, 0x6d, 0xec, 0x5b, 0xbc, 0xa7, 0x05, 0x7e, 0x2e, 0x0f, 0xc3, 0xb6, 0x53,
-		0xad, 0x36, 0x79, 0x7e, 0xda, 0x83, 0xfc, 0x8f, 0x7f, 0xe4, 0x6e, 0x59, 0xb8, 0x34, 0xa4, 0xb7,
-		0xaf, 0xee, 0x38, 0xa6, 0x41, 0x0f, 0x3c, 0x7a, 0x71, 0xf8, 0x21, 0xe7, 0xe0, 0x23, 0xc9, 0xcd,
-		0x41, 0x8a, 0xe8, 0x62, 0x61, 0x13, 0xd3, 0xd3, 0xa9, 0x1e, 0x2c, 0xfe, 0x84, 0x1b, 0x20, 0x40,
-		0x54, 0xf8, 0xa9, 0xaf, 0xbd, 0x3a, 0x25, 0x7d, 0xf3, 0xd5, 0x29, 0xe9, 0xf7, 0x5f, 0x9d, 0x92,
-		0x3e, 0xf4, 0x9d, 0xa9, 0x81, 0x6f, 0x7e, 0x67, 0x6a, 0xe0, 0x77, 0xbf, 0x33, 0x35, 0xd0, 0x7e,
-		0x97, 0x18, 0xe6, 0x8d, 0x79, 0x83, 0xed, 0x0f, 0xbf, 0x20, 0xd7, 0x34, 0x67, 0xbb, 0xb9, 0x39,
-		0x5b, 0x31, 0x1a, 0x74, 0x1b, 0xd7, 0xdb, 0xad, 0x75, 0x17, 0x39, 0xf0, 0x9e, 0x28, 0x1c, 0xad,
-		0x18, 0x76, 0xc3, 0xb0, 0xcb, 0x6c, 0xbf, 0x97, 0x15, 0xf8, 0x8e, 0x6f, 0xca, 0x5f, 0xd5, 0xc7,
-		0xa6, 0xef, 0x45, 0x18, 0xa5, 0xaa, 0xd3, 0xed, 0x2e, 0xea, 0x6d, 0x3d, 0x03, 0xc4, 0xd7, 0xff,
-		0x5d, 0x9c, 0x6a, 0x3d, 0xe2, 0x12, 0xd2, 0xd3, 0xfb, 0x75, 0x98, 0xd4, 0x1a, 0x66, 0x1d, 0xd3,
-		0x6d, 0xfe, 0xb2, 0x5b, 0xd7, 0x9b, 0xdf, 0x37, 0x38, 0xbf, 0x09, 0x8f, 0x7c, 0x41, 0x50, 0xe7,
-		0x16, 0x61, 0x5c, 0xad, 0x54, 0xb0, 0x19, 0x60, 0xd9, 0xa3, 0x5b, 0x84, 0x80, 0x69, 0x4e, 0xe9,
-		0x72, 0x2b, 0x3c, 0xdd, 0xa9, 0x6b, 0x5e, 0xb8, 0xd7, 0x67, 0x79, 0x0b, 0xd7, 0xb0, 0xfe, 0xb0,
-		0x8e, 0x9d, 0xab, 0x86, 0xb5, 0xc3, 0xcd, 0xfb, 0x30, 0x6b, 0x6a, 0x90, 0xdd, 0x60, 0x86, 0xf7,
-		0x45, 0x61, 0x8a, 0x55, 0x9c, 0xde, 0x54, 0x6d, 0x7c, 0xfa, 0xca, 0xa3, 0x9b, 0xd8, 0x51, 0x1f,
-		0x3d, 0x5d, 0x31, 0x34, 0x9d, 0xf7, 0xc4, 0x04, 0xef, 0x17, 0x52, 0x3f, 0xcb, 0xeb, 0xb3, 0x6d,
-		0xb7, 0xe9, 0xe5, 0x79, 0x88, 0xcd, 0x19, 0x9a, 0x8e, 0x26, 0x21, 0x5e, 0xc5, 0xba, 0xd1, 0xe0,
-		0x77, 0xee, 0x58, 0x01, 0xdd, 0x0d, 0x83, 0x6a, 0xc3, 0x68, 0xea, 0x0e, 0x3b, 0xa1, 0x28, 0x0c,
-		0x7f, 0xed, 0xe6, 0xf4, 0xc0, 0xef, 0xdd, 0x9c, 0x8e, 0x2e, 0xe8, 0x8e, 0xc2, 0xab, 0x72, 0xb1,
-		0xd7, 0x3e, 0x35, 0x2d, 0xc9, 0xcf, 0xc0, 0x50, 0x11, 0x57, 0x0e, 0xc2, 0xab, 0x88, 0x2b, 0x21,
-		0x5e, 0x0f, 0x40, 0x62, 0x41, 0x77, 0xd8, 0xad, 0xc8, 0x13, 0x10, 0xd5, 0x74, 0x76, 0xd1, 0x26,
-		0xd4, 0x3e, 0x81, 0x13, 0xd4, 0x22, 0xae, 0xb8, 0xa8, 0x55, 0x5c, 0x09, 0xa3, 0x12, 0xf6, 0x04,
-		0x5e, 0x28, 0xfe, 0xee, 0x7f, 0x9e, 0x1a, 0x78, 0xf9, 0xd5, 0xa9, 0x81, 0x8e, 0x3d, 0xe1, 0x1f,
-		0x03, 0xdc, 0xc4, 0xbc, 0x0b, 0xec, 0xea, 0x0e, 0x3b, 0x23, 0x71, 0xbb, 0xe1, 0xb7, 0x06, 0x41,
-		0xe6, 0x38, 0xb6, 0xa3, 0xee, 0x68, 0x7a, 0xcd, 0xed, 0x09, 0xb5, 0xe9, 0x6c, 0x5f, 0xe3, 0x5d,
-		0x71, 0x98, 0x77, 0x05, 0xc7, 0xe9, 0xde, 0x1b, 0xd9, 0xce, 0xa3, 0x2b, 0xdb, 0xa3, 0xcf, 0xe5,
-		0x7f, 0x1d, 0x05, 0xb4, 0xe6, 0xa8, 0x3b, 0x38, 0xdf, 0x74, 0xb6, 0x0d, 0x4b, 0xbb, 0xc6, 0x62,
-		0x19, 0x06, 0x68, 0xa8, 0xbb, 0x65, 0xc7, 0xd8, 0xc1, 0xba, 0x4d, 0x4d, 0x33, 0x7c, 0xe6, 0xe8,
-		0x6c, 0x1b, 0xff, 0x98, 0x25, 0x5d, 0x57, 0x78, 0xf0, 0x73, 0xdf, 0x9e, 0xbe, 0xbf, 0xb7, 0x15,
-		0x28, 0x32, 0x49, 0xae, 0x77, 0xd7, 0x29, 0x63, 0x74, 0x19, 0xd8, 0x25, 0x8b, 0x72, 0x5d, 0xb3,
-		0x1d, 0x7e, 0x4f, 0xfb, 0xec, 0x6c, 0x7b, 0xdd, 0x67, 0x5b, 0xc5, 0x9c, 0xbd, 0xac, 0xd6, 0xb5,
-		0xaa, 0xea, 0x18, 0x96, 0x7d, 0x71, 0x40, 0x49, 0x52, 0x56, 0x8b, 0x9a, 0xed, 0xa0, 0x75, 0x48,
-		0x56, 0xb1, 0xbe, 0xc7, 0xd8, 0x46, 0x5f, 0x1f, 0xdb, 0x04, 0xe1, 0x44, 0xb9, 0x3e, 0x0f, 0x48,
-		0xf5, 0xe3, 0x89, 0x87, 0x49, 0xec, 0x7e, 0x65, 0x07, 0xf6, 0x01, 0xce, 0xf4, 0x1d, 0xc5, 0xb8,
-		0x1a, 0x06, 0x65, 0xef, 0x03, 0xf0, 0xda, 0x44, 0x19, 0x18, 0x52, 0xab, 0x55, 0x0b, 0xdb, 0x36,
-		0x3d, 0x00, 0x4c, 0x2a, 0xa2, 0x98, 0x1b, 0xff, 0x37, 0x5f, 0x7a, 0x78, 0x24, 0xc0, 0xb1, 0x90,
-		0x02, 0xb8, 0xe2, 0x92, 0x9e, 0xfa, 0xa4, 0x04, 0xe3, 0x2d, 0x2d, 0x22, 0x19, 0xa6, 0xf2, 0x1b,
-		0xeb, 0x17, 0x57, 0x94, 0x85, 0x17, 0xf2, 0xeb, 0x0b, 0x2b, 0xcb, 0x65, 0x76, 0xe5, 0x7f, 0x79,
-		0x6d, 0xb5, 0x34, 0xb7, 0x70, 0x61, 0xa1, 0x54, 0x4c, 0x0f, 0xa0, 0x69, 0x38, 0xd6, 0x06, 0xa7,
-		0x58, 0x5a, 0x2c, 0xcd, 0xe7, 0xd7, 0x4b, 0x69, 0x09, 0xdd, 0x05, 0x27, 0xda, 0x32, 0x71, 0x51,
-		0x22, 0x1d, 0x50, 0x94, 0x92, 0x8b, 0x12, 0x2d, 0x5c, 0xe8, 0x38, 0x8a, 0x1e, 0xea, 0xea, 0x3f,
-		0xbb, 0xee, 0x70, 0x09, 0x8e, 0xa7, 0x3f, 0x91, 0xe0, 0x68, 0x78, 0xca, 0x50, 0xf5, 0xbd, 0x0e,
-		0xaf, 0x3e, 0x3b, 0x44, 0xb3, 0x37, 0x41, 0x34, 0xaf, 0xef, 0xa1, 0xa3, 0x2c, 0x9f, 0x2e, 0x37,
-		0xad, 0x3a, 0x8f, 0x41, 0x43, 0xa4, 0xbc, 0x61, 0xd5, 0x49, 0x6c, 0x12, 0x17, 0xfd, 0xa5, 0x93,
-		0x29, 0x7e, 0x7b, 0x3f, 0x17, 0xfb, 0xc1, 0xa7, 0xa7, 0x07, 0x0a, 0x3b, 0x61, 0x95, 0xbe, 0xd2,
-		0x73, 0x06, 0x4d, 0xe4, 0xf5, 0x3d, 0x1a, 0x7c, 0x56, 0xa5, 0x17, 0xe2, 0x54, 0x21, 0x71, 0x68,
-		0x3a, 0x15, 0x3e, 0x34, 0x7d, 0x0e, 0xd7, 0xeb, 0x97, 0x74, 0xe3, 0x2a, 0xed, 0x49, 0x4f, 0xef,
-		0x8f, 0x44, 0x60, 0xaa, 0x65, 0xaa, 0xe4, 0x59, 0x45, 0xa7, 0x27, 0xaf, 0x39, 0x48, 0x14, 0x45,
-		0xb2, 0x92, 0x81, 0x21, 0x1b, 0x57, 0x0c, 0xbd, 0xca, 0x46, 0x77, 0x54, 0x11, 0x45, 0xa2, 0xaa,
-		0xae, 0xea, 0x86, 0xcd, 0xef, 0xd9, 0xb3, 0x42, 0xe1, 0xe3, 0xd2, 0xfe, 0x72, 0x84, 0x11, 0xd1,
-		0x92, 0x50, 0xf3, 0xd1, 0x9e, 0xc7, 0xc8, 0x3b, 0x44, 0x4b, 0x57, 0x89, 0xc0, 0x51, 0x72, 0xbf,
-		0x56, 0xf9, 0xf9, 0x08, 0x4c, 0x87, 0xad, 0x42, 0x52, 0x35, 0xdb, 0x51, 0x1b, 0x66, 0x27, 0xb3,
-		0x9c, 0x87, 0xe4, 0xba, 0xc0, 0xd9, 0xb7, 0x5d, 0x6e, 0xec, 0xd3, 0x2e, 0xa3, 0x6e, 0x53, 0xc2,
-		0x30, 0x67, 0xfa, 0x34, 0x8c, 0xab, 0xc7, 0x81, 0x2c, 0xf3, 0xb9, 0x18, 0x9c, 0xa0, 0x0f, 0xb1,
-		0xac, 0x86, 0xa6, 0x3b, 0xa7, 0x2b, 0xd6, 0x9e, 0xe9, 0xd0, 0x64, 0xcd, 0xd8, 0xe2, 0x76, 0x19,
-		0xf7, 0xaa, 0x67, 0x59, 0x75, 0x87, 0xd1, 0xb2, 0x05, 0xf1, 0x55, 0x42, 0x47, 0x2c, 0xe2, 0x18,
-		0x8e, 0x5a, 0xe7, 0x96, 0x62, 0x05, 0x02, 0x65, 0x8f, 0xb7, 0x22, 0x0c, 0xaa, 0x89, 0x77, 0x5b,
-		0x75, 0xac, 0x6e, 0xb1, 0x3b, 0xf0, 0x51, 0x3a, 0x88, 0x12, 0x04, 0x40, 0xaf, 0xbb, 0x4f, 0x42,
-		0x5c, 0x6d, 0xb2, 0xeb, 0x1b, 0x51, 0x32, 0xba, 0x68, 0x41, 0xbe, 0x04, 0x43, 0xfc, 0x10, 0x19,
-		0xa5, 0x21, 0xba, 0x83, 0xf7, 0x68, 0x3b, 0x29, 0x85, 0xfc, 0x44, 0xb3, 0x10, 0xa7, 0xc2, 0xf3,
-		0x49, 0x23, 0x33, 0xdb, 0x22, 0xfd, 0x2c, 0x15, 0x52, 0x61, 0x68, 0xf2, 0x33, 0x90, 0x28, 0x1a,
-		0x0d, 0x4d, 0x37, 0x82, 0xdc, 0x92, 0x8c, 0x1b, 0x95, 0xd9, 0x6c, 0xf2, 0x1c, 0x43, 0x61, 0x05,
-		0x74, 0x18, 0x06, 0xd9, 0x9b, 0x08, 0x7e, 0x05, 0x85, 0x97, 0xe4, 0x39, 0x18, 0xa2, 0xbc, 0x57,
-		0x4c, 0x84, 0xf8, 0x6b, 0x3a, 0xfe, 0xf8, 0x82, 0xa6, 0xa3, 0x9c, 0x7d, 0xc4, 0x13, 0x16, 0x41,
-		0xac, 0xaa, 0x3a, 0x2a, 0xd7, 0x9b, 0xfe, 0x96, 0xdf, 0x02, 0x09, 0xce, 0xc4, 0x46, 0x67, 0x20,
-		0x6a, 0x98, 0x36, 0xbf, 0x44, 0x92, 0xed, 0xa4, 0xca, 0x8a, 0x59, 0x88, 0x91, 0xec, 0x44, 0x21,
-		0xc8, 0x05, 0xa5, 0x63, 0x20, 0x7d, 0xd2, 0x17, 0x48, 0x7d, 0x5d, 0xee, 0xfb, 0xc9, 0xba, 0xb4,
-		0xc5, 0x1d, 0x5c, 0x67, 0xf9, 0x74, 0x04, 0xa6, 0x7c, 0xb5, 0x57, 0xb0, 0x65, 0x6b, 0x86, 0xce,
-		0xe7, 0x70, 0xe6, 0x2d, 0xc8, 0x27, 0x24, 0xaf, 0xef, 0xe0, 0x2e, 0x6f, 0x86, 0x68, 0xde, 0x34,
-		0x51, 0x16, 0x12, 0xb4, 0x5c, 0x31, 0x98, 0xbf, 0xc4, 0x14, 0xb7, 0x4c, 0xea, 0x6c, 0x63, 0xcb,
-		0xb9, 0xaa, 0x5a, 0xee, 0xb3, 0x41, 0x51, 0x96, 0x9f, 0x82, 0xe4, 0x9c, 0xa1, 0xdb, 0x58, 0xb7,
-		0x9b, 0x74, 0x0c, 0x6e, 0xd6, 0x8d, 0xca, 0x0e, 0xe7, 0xc0, 0x0a, 0xc4, 0xe0, 0xaa, 0x69, 0x52,
-		0xca, 0x98, 0x42, 0x7e, 0xb2, 0x7c, 0xb0, 0xb0, 0xd6, 0xd1, 0x44, 0x4f, 0xed, 0xdf, 0x44, 0x5c,
-		0x49, 0xd7, 0x46, 0xff, 0x47, 0x82, 0xe3, 0xad, 0x03, 0x6a, 0x07, 0xef, 0xd9, 0xfb, 0x1d, 0x4f,
-		0xcf, 0x43, 0x72, 0x95, 0xbe, 0xdd, 0xbf, 0x84, 0xf7, 0x50, 0x16, 0x86, 0x70, 0xf5, 0xcc, 0xd9,
-		0xb3, 0x8f, 0x3e, 0xc5, 0xbc, 0xfd, 0xe2, 0x80, 0x22, 0x00, 0x68, 0x0a, 0x92, 0x36, 0xae, 0x98,
-		0x67, 0xce, 0x9e, 0xdb, 0x79, 0x94, 0xb9, 0x17, 0xc9, 0x7a, 0x5c, 0x50, 0x2e, 0x41, 0xb4, 0x7e,
-		0xed, 0xd3, 0xd3, 0x52, 0x21, 0x0e, 0x51, 0xbb, 0xd9, 0xb8, 0xa3, 0x3e, 0xf2, 0x4a, 0x1c, 0x66,
-		0xfc, 0x94, 0x34, 0x52, 0xb9, 0x99, 0x08, 0xb7, 0x41, 0xda, 0x67, 0x03, 0x8a, 0xd1, 0x21, 0x81,
-		0xed, 0x6a, 0x49, 0xf9, 0xd7, 0x24, 0x48, 0xb9, 0xe9, 0xd1, 0x1a, 0x76, 0xd0, 0x79, 0x7f, 0xce,
-		0xc3, 0x87, 0xcd, 0xb1, 0xd9, 0x70, 0x5b, 0x5e, 0x1a, 0xa7, 0xf8, 0xd0, 0xd1, 0x13, 0xd4, 0x11,
-		0x4d, 0xc3, 0xe6, 0x4f, 0xc9, 0x7a, 0x90, 0xba, 0xc8, 0xe8, 0x21, 0x40, 0x34, 0xc2, 0x95, 0xaf,
-		0x18, 0x8e, 0xa6, 0xd7, 0xca, 0xa6, 0x71, 0x95, 0x3f, 0xd0, 0x8d, 0x2a, 0x69, 0x5a, 0x73, 0x99,
-		0x56, 0xac, 0x12, 0x38, 0x11, 0x3a, 0xe9, 0x72, 0x09, 0xa6, 0x74, 0x24, 0x08, 0x88, 0x22, 0x3a,
-		0x0f, 0x43, 0x66, 0x73, 0xb3, 0x2c, 0x22, 0xc6, 0xf0, 0x99, 0xe3, 0xed, 0xc6, 0xbf, 0xf0, 0x0f,
-		0x1e, 0x01, 0x06, 0xcd, 0xe6, 0x26, 0xf1, 0x96, 0xbb, 0x20, 0xd5, 0x46, 0x98, 0xe1, 0x2b, 0x9e,
-		0x1c, 0xf4, 0x93, 0x11, 0x5c, 0x83, 0xb2, 0x69, 0x69, 0x86, 0xa5, 0x39, 0x7b, 0x34, 0x67, 0x8d,
-		0x2a, 0x69, 0x51, 0xb1, 0xca, 0xe1, 0xf2, 0x0e, 0x8c, 0xad, 0xd1, 0x35, 0xad, 0x27, 0xf9, 0x59,
-		0x4f, 0x3e, 0xa9, 0xb7, 0x7c, 0x1d, 0x25, 0x8b, 0xb4, 0x48, 0x56, 0x78, 0xb6, 0xa3, 0x77, 0x3e,
-		0xb1, 0x7f, 0xef, 0x0c, 0x66, 0x85, 0x7f, 0x78, 0x34, 0x30, 0x38, 0x99, 0x73, 0xfa, 0xc3, 0x57,
-		0xbf, 0x8e, 0xd9, 0x2b, 0x9b, 0xc8, 0x76, 0x9f, 0x54, 0xb3, 0x3d, 0xc2, 0x68, 0xb6, 0xe7, 0x10,
-		0x92, 0x9f, 0x82, 0x91, 0x55, 0xd5, 0x72, 0xd6, 0xb0, 0x73, 0x11, 0xab, 0x55, 0x6c, 0x05, 0x67,
-		0xdd, 0x11, 0x31, 0xeb, 0x22, 0x88, 0xd1, 0xa9, 0x95, 0xcd, 0x3a, 0xf4, 0xb7, 0xbc, 0x0d, 0x31,
-		0x7a, 0x1b, 0xd4, 0x9d, 0x91, 0x39, 0x05, 0x9b, 0x91, 0x49, 0x2c, 0xdd, 0x73, 0xb0, 0x2d, 0x52,
-		0x5a, 0x5a, 0x40, 0x8f, 0x8b, 0x79, 0x35, 0xda, 0x7d, 0x5e, 0xe5, 0x8e, 0xc8, 0x67, 0xd7, 0x3a,
-		0x0c, 0x15, 0x48, 0x28, 0x5e, 0x28, 0xba, 0x82, 0x48, 0x9e, 0x20, 0x68, 0x09, 0xc6, 0x4c, 0xd5,
-		0x72, 0xe8, 0x33, 0x98, 0x6d, 0xaa, 0x05, 0xf7, 0xf5, 0xe9, 0xd6, 0x91, 0x17, 0x50, 0x96, 0xb7,
-		0x32, 0x62, 0xfa, 0x81, 0xf2, 0x1f, 0xc4, 0x60, 0x90, 0x1b, 0xe3, 0xcd, 0x30, 0xc4, 0xcd, 0xca,
-		0xbd, 0xf3, 0xc4, 0x6c, 0xeb, 0xc4, 0x34, 0xeb, 0x4e, 0x20, 0x9c, 0x9f, 0xa0, 0x41, 0xf7, 0x41,
-		0xa2, 0xb2, 0xad, 0x6a, 0x7a, 0x59, 0xab, 0x8a, 0xed, 0x85, 0x57, 0x6f, 0x4e, 0x0f, 0xcd, 0x11,
-		0xd8, 0x42, 0x51, 0x19, 0xa2, 0x95, 0x0b, 0x55, 0x92, 0x09, 0x6c, 0x63, 0xad, 0xb6, 0xed, 0xf0,
-		0x11, 0xc6, 0x4b, 0xe8, 0x49, 0x88, 0x11, 0x87, 0xe0, 0x8f, 0x24, 0xb3, 0x2d, 0x9b, 0x3c, 0x6e,
-		0xb2, 0x57, 0x48, 0x90, 0x86, 0x3f, 0xf4, 0xed, 0x69, 0x49, 0xa1, 0x14, 0x68, 0x0e, 0x46, 0xea,
-		0xaa, 0xed, 0x94, 0xe9, 0x0c, 0x46, 0x9a, 0x8f, 0xf3, 0x35, 0x76, 0x8b, 0x41, 0xb8, 0x61, 0xb9,
-		0xe8, 0xc3, 0x84, 0x8a, 0x81, 0xaa, 0xe8, 0x24, 0xa4, 0x29, 0x93, 0x8a, 0xd1, 0x68, 0x68, 0x0e,
-		0xcb, 0xad, 0x06, 0xa9, 0xdd, 0x47, 0x09, 0x7c, 0x8e, 0x82, 0x69, 0x86, 0x75, 0x0c, 0x92, 0xf4,
-		0x59, 0x16, 0x45, 0x61, 0x57, 0x90, 0x13, 0x04, 0x40, 0x2b, 0xef, 0x87, 0x31, 0x2f, 0x3e, 0x32,
-		0x94, 0x04, 0xe3, 0xe2, 0x81, 0x29, 0xe2, 0x23, 0x30, 0xa9, 0xe3, 0x5d, 0x7a, 0x29, 0x3a, 0x80,
-		0x9d, 0xa4, 0xd8, 0x88, 0xd4, 0x5d, 0x0e, 0x52, 0xdc, 0x0b, 0xa3, 0x15, 0x61, 0x7c, 0x86, 0x0b,
-		0x14, 0x77, 0xc4, 0x85, 0x52, 0xb4, 0xa3, 0x90, 0x50, 0x4d, 0x93, 0x21, 0x0c, 0xf3, 0xf8, 0x68,
-		0x9a, 0xb4, 0xea, 0x14, 0x8c, 0x53, 0x1d, 0x2d, 0x6c, 0x37, 0xeb, 0x0e, 0x67, 0x92, 0xa2, 0x38,
-		0x63, 0xa4, 0x42, 0x61, 0x70, 0x8a, 0x7b, 0x37, 0x8c, 0xe0, 0x2b, 0x5a, 0x15, 0xeb, 0x15, 0xcc,
-		0xf0, 0x46, 0x28, 0x5e, 0x4a, 0x00, 0x29, 0xd2, 0x03, 0xe0, 0xc6, 0xbd, 0xb2, 0x88, 0xc9, 0xa3,
-		0x8c, 0x9f, 0x80, 0xe7, 0x19, 0x58, 0xce, 0x40, 0xac, 0xa8, 0x3a, 0x2a, 0x49, 0x30, 0x9c, 0x5d,
-		0x36, 0xd1, 0xa4, 0x14, 0xf2, 0x53, 0x7e, 0x2d, 0x02, 0xb1, 0xcb, 0x86, 0x83, 0xd1, 0x63, 0xbe,
-		0x04, 0x70, 0xb4, 0x9d, 0x3f, 0xaf, 0x69, 0x35, 0x1d, 0x57, 0x97, 0xec, 0x9a, 0xef, 0x1b, 0x0a,
-		0x9e, 0x3b, 0x45, 0x02, 0xee, 0x34, 0x09, 0x71, 0xcb, 0x68, 0xea, 0x55, 0x71, 0x7b, 0x97, 0x16,
-		0x50, 0x09, 0x12, 0xae, 0x97, 0xc4, 0x7a, 0x79, 0xc9, 0x18, 0xf1, 0x12, 0xe2, 0xc3, 0x1c, 0xa0,
-		0x0c, 0x6d, 0x72, 0x67, 0x29, 0x40, 0xd2, 0x0d, 0x5e, 0xdc, 0xdb, 0xfa, 0x73, 0x58, 0x8f, 0x8c,
-		0x4c, 0x26, 0x6e, 0xdf, 0xbb, 0xc6, 0x63, 0x1e, 0x97, 0x76, 0x2b, 0xb8, 0xf5, 0x02, 0x6e, 0xc5,
-		0xbf, 0xe7, 0x30, 0x44, 0xf5, 0xf2, 0xdc, 0x8a, 0x7d, 0xd3, 0xe1, 0x38, 0x24, 0x6d, 0xad, 0xa6,
-		0xab, 0x4e, 0xd3, 0xc2, 0xdc, 0xf3, 0x3c, 0x80, 0xfc, 0x15, 0x09, 0x06, 0x99, 0x27, 0xfb, 0xec,
-		0x26, 0xb5, 0xb7, 0x5b, 0xa4, 0x93, 0xdd, 0xa2, 0x07, 0xb7, 0x5b, 0x1e, 0xc0, 0x15, 0xc6, 0xe6,
-		0xcf, 0xec, 0xdb, 0x64, 0x0c, 0x4c, 0xc4, 0x35, 0xad, 0xc6, 0x07, 0xaa, 0x8f, 0x48, 0xfe, 0x4f,
-		0x12, 0x49, 0x62, 0x79, 0x3d, 0xca, 0xc3, 0x88, 0x90, 0xab, 0xbc, 0x55, 0x57, 0x6b, 0xdc, 0x77,
-		0x4e, 0x74, 0x14, 0xee, 0x42, 0x5d, 0xad, 0x29, 0xc3, 0x5c, 0x1e, 0x52, 0x68, 0xdf, 0x0f, 0x91,
-		0x0e, 0xfd, 0x10, 0xe8, 0xf8, 0xe8, 0xc1, 0x3a, 0x3e, 0xd0, 0x45, 0xb1, 0x70, 0x17, 0x7d, 0x31,
-		0x42, 0x17, 0x33, 0xa6, 0x61, 0xab, 0xf5, 0x1f, 0xc7, 0x88, 0x38, 0x06, 0x49, 0xd3, 0xa8, 0x97,
-		0x59, 0x0d, 0xbb, 0xd5, 0x9e, 0x30, 0x8d, 0xba, 0xd2, 0xd2, 0xed, 0xf1, 0xdb, 0x34, 0x5c, 0x06,
-		0x6f, 0x83, 0xd5, 0x86, 0xc2, 0x56, 0xb3, 0x20, 0xc5, 0x4c, 0xc1, 0xe7, 0xb2, 0x47, 0x88, 0x0d,
-		0xe8, 0xe4, 0x28, 0xb5, 0xce, 0xbd, 0x4c, 0x6c, 0x86, 0xa9, 0x70, 0x3c, 0x42, 0xc1, 0x42, 0x7f,
-		0xbb, 0x55, 0xb0, 0xdf, 0x2d, 0x15, 0x8e, 0x27, 0xff, 0x4d, 0x09, 0x60, 0x91, 0x58, 0x96, 0xea,
-		0x4b, 0x66, 0x21, 0x9b, 0x8a, 0x50, 0x0e, 0xb4, 0x3c, 0xd5, 0xa9, 0xd3, 0x78, 0xfb, 0x29, 0xdb,
-		0x2f, 0xf7, 0x1c, 0x8c, 0x78, 0xce, 0x68, 0x63, 0x21, 0xcc, 0x54, 0x97, 0xac, 0x7a, 0x0d, 0x3b,
-		0x4a, 0xea, 0x8a, 0xaf, 0x24, 0xff, 0x73, 0x09, 0x92, 0x54, 0xa6, 0x25, 0xec, 0xa8, 0x81, 0x3e,
-		0x94, 0x0e, 0xde, 0x87, 0x27, 0x00, 0x18, 0x1b, 0x5b, 0xbb, 0x86, 0xb9, 0x67, 0x25, 0x29, 0x64,
-		0x4d, 0xbb, 0x86, 0xd1, 0x39, 0xd7, 0xe0, 0xd1, 0xee, 0x06, 0x17, 0x59, 0x37, 0x37, 0xfb, 0x11,
-		0x18, 0xa2, 0x9f, 0xa5, 0xda, 0xb5, 0x79, 0x22, 0x3d, 0xa8, 0x37, 0x1b, 0xeb, 0xbb, 0xb6, 0xfc,
-		0x22, 0x0c, 0xad, 0xef, 0xb2, 0xbd, 0x91, 0x63, 0x90, 0xb4, 0x0c, 0x83, 0xcf, 0xc9, 0x2c, 0x17,
-		0x4a, 0x10, 0x00, 0x9d, 0x82, 0xc4, 0x7e, 0x40, 0xc4, 0xdb, 0x0f, 0xf0, 0x36, 0x34, 0xa2, 0x7d,
-		0x6d, 0x68, 0x9c, 0xfa, 0xf7, 0x12, 0x0c, 0xfb, 0xe2, 0x03, 0x7a, 0x14, 0x0e, 0x15, 0x16, 0x57,
-		0xe6, 0x2e, 0x95, 0x17, 0x8a, 0xe5, 0x0b, 0x8b, 0xf9, 0x79, 0xef, 0xdd, 0x56, 0xf6, 0xf0, 0xf5,
-		0x1b, 0x33, 0xc8, 0x87, 0xbb, 0xa1, 0xd3, 0x1d, 0x25, 0x74, 0x1a, 0x26, 0x83, 0x24, 0xf9, 0xc2,
-		0x5a, 0x69, 0x79, 0x3d, 0x2d, 0x65, 0x0f, 0x5d, 0xbf, 0x31, 0x33, 0xee, 0xa3, 0xc8, 0x6f, 0xda,
-		0x58, 0x77, 0x5a, 0x09, 0xe6, 0x56, 0x96, 0x96, 0x16, 0xd6, 0xd3, 0x91, 0x16, 0x02, 0x1e, 0xb0,
-		0x1f, 0x80, 0xf1, 0x20, 0xc1, 0xf2, 0xc2, 0x62, 0x3a, 0x9a, 0x45, 0xd7, 0x6f, 0xcc, 0x8c, 0xfa,
-		0xb0, 0x97, 0xb5, 0x7a, 0x36, 0xf1, 0x81, 0xcf, 0x4c, 0x0d, 0xfc, 0xd2, 0x2f, 0x4e, 0x49, 0x44,
-		0xb3, 0x91, 0x40, 0x8c, 0x40, 0x0f, 0xc1, 0x91, 0xb5, 0x85, 0xf9, 0xe5, 0x52, 0xb1, 0xbc, 0xb4,
-		0x36, 0x2f, 0xf6, 0x9d, 0x85, 0x76, 0x63, 0xd7, 0x6f, 0xcc, 0x0c, 0x73, 0x95, 0x3a, 0x61, 0xaf,
-		0x2a, 0xa5, 0xcb, 0x2b, 0xeb, 0xa5, 0xb4, 0xc4, 0xb0, 0x57, 0x2d, 0x7c, 0xc5, 0x70, 0xd8, 0x77,
-		0xeb, 0x1e, 0x81, 0xa3, 0x6d, 0xb0, 0x5d, 0xc5, 0xc6, 0xaf, 0xdf, 0x98, 0x19, 0x59, 0xb5, 0x30,
-		0x1b, 0x3f, 0x94, 0x62, 0x16, 0x32, 0xad, 0x14, 0x2b, 0xab, 0x2b, 0x6b, 0xf9, 0xc5, 0xf4, 0x4c,
-		0x36, 0x7d, 0xfd, 0xc6, 0x4c, 0x4a, 0x04, 0x43, 0xba, 0xb9, 0xef, 0x6a, 0x76, 0x27, 0x57, 0x3c,
-		0x7f, 0xfc, 0x30, 0xdc, 0xd3, 0xe1, 0x5c, 0x49, 0x9c, 0x48, 0x1c, 0xe8, 0x64, 0xa9, 0xe3, 0xde,
-		0x7a, 0xb6, 0xc7, 0xf6, 0x73, 0xef, 0xa5, 0xd3, 0xc1, 0x4f, 0xad, 0xb2, 0x5d, 0x17, 0x77, 0xf2,
-		0x07, 0x25, 0x18, 0xbd, 0xa8, 0xd9, 0x8e, 0x61, 0x69, 0x15, 0xb5, 0x4e, 0x5f, 0x6b, 0x9d, 0xeb,
-		0x37, 0xb6, 0x86, 0x86, 0xfa, 0xd3, 0x30, 0x78, 0x45, 0xad, 0xb3, 0xa0, 0x16, 0xa5, 0x1f, 0x97,
-		0xe9, 0x70, 0xcc, 0xe3, 0x86, 0x36, 0xc1, 0x80, 0x91, 0xc9, 0xbf, 0x12, 0x81, 0x31, 0x3a, 0x18,
-		0x6c, 0xf6, 0xd9, 0x31, 0xb2, 0xc6, 0x2a, 0x40, 0xcc, 0x52, 0x1d, 0xbe, 0x69, 0x58, 0x98, 0xe5,
-		0x27, 0x8e, 0xf7, 0xf5, 0x71, 0x7e, 0x56, 0xc4, 0x15, 0x85, 0xd2, 0xa2, 0x77, 0x40, 0xa2, 0xa1,
-		0xee, 0x96, 0x29, 0x1f, 0xb6, 0x72, 0xc9, 0xef, 0x8f, 0xcf, 0xad, 0x9b, 0xd3, 0x63, 0x7b, 0x6a,
-		0xa3, 0x9e, 0x93, 0x05, 0x1f, 0x59, 0x19, 0x6a, 0xa8, 0xbb, 0x44, 0x44, 0x64, 0xc2, 0x18, 0x81,
-		0x56, 0xb6, 0x55, 0xbd, 0x86, 0x59, 0x23, 0x74, 0x0b, 0xb4, 0x70, 0x71, 0xdf, 0x8d, 0x1c, 0xf6,
-		0x1a, 0xf1, 0xb1, 0x93, 0x95, 0x91, 0x86, 0xba, 0x3b, 0x47, 0x01, 0xa4, 0xc5, 0x5c, 0xe2, 0xa3,
-		0x9f, 0x9a, 0x1e, 0xa0, 0xa7, 0xb8, 0xdf, 0x92, 0x00, 0x3c, 0x8b, 0xa1, 0x77, 0x40, 0xba, 0xe2,
-		0x96, 0x28, 0xad, 0x38, 0x8f, 0xbc, 0xbf, 0x53, 0x5f, 0x84, 0xec, 0xcd, 0xe6, 0xe6, 0x6f, 0xde,
-		0x9c, 0x96, 0x94, 0xb1, 0x4a, 0xa8, 0x2b, 0xde, 0x0e, 0xc3, 0x4d, 0xb3, 0xaa, 0x3a, 0xb8, 0x4c,
-		0xd7, 0x71, 0x91, 0x9e, 0xf3, 0xfc, 0x14, 0xe1, 0x75, 0xeb, 0xe6, 0x34, 0x62, 0x6a, 0xf9, 0x88,
-		0x65, 0x3a, 0xfb, 0x03, 0x83, 0x10, 0x02, 0x9f, 0x4e, 0x5f, 0x97, 0x60, 0xb8, 0xe8, 0xbb, 0x47,
-		0x99, 0x81, 0xa1, 0x86, 0xa1, 0x6b, 0x3b, 0xdc, 0x1f, 0x93, 0x8a, 0x28, 0xa2, 0x2c, 0x24, 0xd8,
-		0x03, 0x56, 0x67, 0x4f, 0x6c, 0x85, 0x8a, 0x32, 0xa1, 0xba, 0x8a, 0x37, 0x6d, 0x4d, 0xf4, 0x86,
-		0x22, 0x8a, 0xe8, 0x02, 0xa4, 0x6d, 0x5c, 0x69, 0x5a, 0x9a, 0xb3, 0x57, 0xae, 0x18, 0xba, 0xa3,
-		0x56, 0x1c, 0xf6, 0x14, 0xb2, 0x70, 0xec, 0xd6, 0xcd, 0xe9, 0x23, 0x4c, 0xd6, 0x30, 0x86, 0xac,
-		0x8c, 0x09, 0xd0, 0x1c, 0x83, 0x90, 0x16, 0xaa, 0xd8, 0x51, 0xb5, 0xba, 0x9d, 0x61, 0x17, 0x12,
-		0x44, 0xd1, 0xa7, 0xcb, 0xe7, 0x87, 0xfc, 0x1b, 0x5b, 0x17, 0x20, 0x6d, 0x98, 0xd8, 0x0a, 0x24,
-		0xa2, 0x52, 0xb8, 0xe5, 0x30, 0x86, 0xac, 0x8c, 0x09, 0x90, 0x48, 0x52, 0x1d, 0xd2, 0xcd, 0x62,
-		0xa1, 0x68, 0x36, 0x37, 0xbd, 0xfd, 0xb0, 0xc9, 0x96, 0xde, 0xc8, 0xeb, 0x7b, 0x85, 0xc7, 0x3c,
-		0xee, 0x61, 0x3a, 0xf9, 0x1b, 0x5f, 0x7a, 0x78, 0x92, 0xbb, 0x86, 0xb7, 0x3f, 0x75, 0x09, 0xef,
-		0x91, 0xee, 0xe7, 0xa8, 0xab, 0x14, 0x93, 0xa4, 0x9d, 0x2f, 0xaa, 0x5a, 0x5d, 0x3c, 0xe9, 0x57,
-		0x78, 0x09, 0xe5, 0x60, 0xd0, 0x76, 0x54, 0xa7, 0x69, 0xf3, 0xd3, 0x5d, 0xb9, 0x93, 0xab, 0x15,
-		0x0c, 0xbd, 0xba, 0x46, 0x31, 0x15, 0x4e, 0x81, 0x2e, 0xc0, 0x20, 0x3f, 0x36, 0x8f, 0xef, 0x7b,
-		0x7c, 0xd3, 0xfb, 0x11, 0x8c, 0x9a, 0x58, 0xa4, 0x8a, 0xeb, 0xb8, 0xc6, 0xd2, 0xaa, 0x6d, 0x95,
-		0xac, 0x3e, 0xe8, 0xf7, 0xf6, 0x0a, 0x0b, 0xfb, 0x1e, 0x84, 0xdc, 0x52, 0x61, 0x7e, 0xb2, 0x32,
-		0xe6, 0x82, 0xd6, 0x28, 0x04, 0x5d, 0x0a, 0x5c, 0xf8, 0xe5, 0x1f, 0xa5, 0xbc, 0xbb, 0x93, 0xfa,
-		0x3e, 0x9f, 0x16, 0xfb, 0x13, 0xfe, 0xeb, 0xc2, 0x17, 0x20, 0xdd, 0xd4, 0x37, 0x0d, 0x9d, 0xbe,
-		0xbb, 0xe5, 0xf9, 0x3d, 0x59, 0xdf, 0x45, 0xfd, 0xce, 0x11, 0xc6, 0x90, 0x95, 0x31, 0x17, 0x74,
-		0x91, 0xad, 0x02, 0xaa, 0x30, 0xea, 0x61, 0xd1, 0x81, 0x9a, 0xec, 0x39, 0x50, 0xef, 0xe2, 0x03,
-		0xf5, 0x50, 0xb8, 0x15, 0x6f, 0xac, 0x8e, 0xb8, 0x40, 0x42, 0x86, 0x2e, 0x02, 0x78, 0xe1, 0x81,
-		0xee, 0x53, 0x0c, 0x77, 0xee, 0x78, 0x2f, 0xc6, 0x88, 0xf5, 0x9e, 0x47, 0x8b, 0xde, 0x05, 0x13,
-		0x0d, 0x4d, 0x2f, 0xdb, 0xb8, 0xbe, 0x55, 0xe6, 0x06, 0x26, 0x2c, 0xe9, 0x67, 0x93, 0x0a, 0x8b,
-		0xfb, 0xf3, 0x87, 0x5b, 0x37, 0xa7, 0xb3, 0x3c, 0x84, 0xb6, 0xb2, 0x94, 0x95, 0xf1, 0x86, 0xa6,
-		0xaf, 0xe1, 0xfa, 0x56, 0xd1, 0x85, 0xe5, 0x52, 0x1f, 0xf8, 0xd4, 0xf4, 0x00, 0x1f, 0xae, 0x03,
-		0xf2, 0x39, 0xba, 0x77, 0xce, 0x87, 0x19, 0xb6, 0xc9, 0x9a, 0x44, 0x15, 0x05, 0x7e, 0xbd, 0xc0,
-		0x03, 0xb0, 0x61, 0xfe, 0xf2, 0x7f, 0x9c, 0x91, 0xe4, 0xcf, 0x4b, 0x30, 0x58, 0xbc, 0xbc, 0xaa,
-		0x6a, 0x16, 0x5a, 0x80, 0x71, 0xcf, 0x73, 0x82, 0x83, 0xfc, 0xf8, 0xad, 0x9b, 0xd3, 0x99, 0xb0,
-		0x73, 0xb9, 0xa3, 0xdc, 0x73, 0x60, 0x31, 0xcc, 0x17, 0x3a, 0x2d, 0x5c, 0x03, 0xac, 0x5a, 0x50,
-		0xe4, 0xd6, 0x65, 0x6d, 0x48, 0xcd, 0x12, 0x0c, 0x31, 0x69, 0x6d, 0x94, 0x83, 0xb8, 0x49, 0x7e,
-		0xf0, 0x83, 0x81, 0xa9, 0x8e, 0xce, 0x4b, 0xf1, 0xdd, 0x8d, 0x4c, 0x42, 0x22, 0x7f, 0x38, 0x02,
-		0x50, 0xbc, 0x7c, 0x79, 0xdd, 0xd2, 0xcc, 0x3a, 0x76, 0x6e, 0xa7, 0xe6, 0xeb, 0x70, 0xc8, 0xb7,
-		0x4a, 0xb2, 0x2a, 0x21, 0xed, 0x67, 0x6e, 0xdd, 0x9c, 0x3e, 0x1e, 0xd6, 0xde, 0x87, 0x26, 0x2b,
-		0x13, 0xde, 0x7a, 0xc9, 0xaa, 0xb4, 0xe5, 0x5a, 0xb5, 0x1d, 0x97, 0x6b, 0xb4, 0x33, 0x57, 0x1f,
-		0x9a, 0x9f, 0x6b, 0xd1, 0x76, 0xda, 0x9b, 0x76, 0x0d, 0x86, 0x3d, 0x93, 0xd8, 0xa8, 0x08, 0x09,
-		0x87, 0xff, 0xe6, 0x16, 0x96, 0x3b, 0x5b, 0x58, 0x90, 0x71, 0x2b, 0xbb, 0x94, 0xf2, 0x9f, 0x4a,
-		0x00, 0x9e, 0xcf, 0xfe, 0x64, 0xba, 0x18, 0x09, 0xe5, 0x3c, 0xf0, 0x46, 0x0f, 0x94, 0xaa, 0x71,
-		0xea, 0x90, 0x3d, 0x7f, 0x26, 0x02, 0x13, 0x1b, 0x22, 0xf2, 0xfc, 0xc4, 0xdb, 0x60, 0x15, 0x86,
-		0xb0, 0xee, 0x58, 0x1a, 0x35, 0x02, 0xe9, 0xed, 0x47, 0x3a, 0xf5, 0x76, 0x1b, 0x9d, 0xe8, 0x87,
-		0xa3, 0xc4, 0xa6, 0x3b, 0x67, 0x13, 0xb2, 0xc6, 0xcf, 0x45, 0x21, 0xd3, 0x89, 0x12, 0xcd, 0xc1,
-		0x58, 0xc5, 0xc2, 0xec, 0xb2, 0x95, 0x7f, 0xe7, 0xaf, 0x90, 0xf5, 0x32, 0xcb, 0x10, 0x82, 0xac,
-		0x8c, 0x0a, 0x08, 0x9f, 0x3d, 0x6a, 0x40, 0xd2, 0x3e, 0xe2, 0x76, 0xf4, 0xce, 0x56, 0x7f, 0x79,
-		0x9e, 0xcc, 0xa7, 0x0f, 0xd1, 0x48, 0x90, 0x01, 0x9b, 0x3f, 0x46, 0x3d, 0x28, 0x9d, 0x40, 0x5e,
-		0x82, 0x31, 0x4d, 0xd7, 0x1c, 0x4d, 0xad, 0x97, 0x37, 0xd5, 0xba, 0xaa, 0x57, 0x0e, 0x92, 0x35,
-		0xb3, 0x90, 0xcf, 0x9b, 0x0d, 0xb1, 0x93, 0x95, 0x51, 0x0e, 0x29, 0x30, 0x00, 0xba, 0x08, 0x43,
-		0xa2, 0xa9, 0xd8, 0x81, 0xb2, 0x0d, 0x41, 0xee, 0x4b, 0xf0, 0x7e, 0x36, 0x0a, 0xe3, 0x0a, 0xae,
-		0xfe, 0x45, 0x57, 0xec, 0xaf, 0x2b, 0x96, 0x00, 0xd8, 0x70, 0x27, 0x01, 0xf6, 0x00, 0xbd, 0x41,
-		0x02, 0x46, 0x92, 0x71, 0x28, 0xda, 0x8e, 0xaf, 0x3f, 0x6e, 0x46, 0x20, 0xe5, 0xef, 0x8f, 0x3f,
-		0xa7, 0xb3, 0x12, 0x5a, 0xf0, 0x22, 0x51, 0x8c, 0x7f, 0x6e, 0xb7, 0x43, 0x24, 0x6a, 0xf1, 0xde,
-		0xee, 0x21, 0xe8, 0x7f, 0x46, 0x60, 0x70, 0x55, 0xb5, 0xd4, 0x86, 0x8d, 0x2a, 0x2d, 0x99, 0xa6,
-		0xd8, 0x7e, 0x6c, 0xf9, 0xa8, 0x3a, 0xdf, 0xed, 0xe8, 0x91, 0x68, 0x7e, 0xb4, 0x4d, 0xa2, 0xf9,
-		0x56, 0x18, 0x25, 0xcb, 0x61, 0xdf, 0x15, 0x06, 0x62, 0xed, 0x91, 0xc2, 0x51, 0x8f, 0x4b, 0xb0,
-		0x9e, 0xad, 0x96, 0x2f, 0xfb, 0xef, 0x30, 0x0c, 0x13, 0x0c, 0x2f, 0x30, 0x13, 0xf2, 0xc3, 0xde,
-		0xb2, 0xd4, 0x57, 0x29, 0x2b, 0xd0, 0x50, 0x77, 0x4b, 0xac, 0x80, 0x16, 0x01, 0x6d, 0xbb, 0x3b,
-		0x23, 0x65, 0xcf, 0x9c, 0x84, 0xfe, 0xc4, 0xad, 0x9b, 0xd3, 0x47, 0x19, 0x7d, 0x2b, 0x8e, 0xac,
-		0x8c, 0x7b, 0x40, 0xc1, 0xed, 0x71, 0x00, 0xa2, 0x57, 0x99, 0x5d, 0xdb, 0x66, 0xcb, 0x9d, 0x43,
-		0xb7, 0x6e, 0x4e, 0x8f, 0x33, 0x2e, 0x5e, 0x9d, 0xac, 0x24, 0x49, 0xa1, 0x48, 0x7e, 0xfb, 0x3c,
-		0xfb, 0x33, 0x12, 0x20, 0x2f, 0xe4, 0x2b, 0xd8, 0x36, 0xc9, 0xfa, 0x8c, 0x24, 0xe2, 0xbe, 0xac,
-		0x59, 0xea, 0x9e, 0x88, 0x7b, 0xf4, 0x22, 0x11, 0xf7, 0x8d, 0x94, 0xa7, 0xbc, 0xf0, 0x18, 0xe9,
-		0x75, 0x87, 0x99, 0xbb, 0x48, 0x38, 0x1e, 0x0e, 0xc8, 0xff, 0x4a, 0x82, 0xa3, 0x2d, 0x1e, 0xe5,
-		0x0a, 0xfb, 0x97, 0x00, 0x59, 0xbe, 0x4a, 0xfe, 0xed, 0x44, 0x26, 0xf4, 0xbe, 0x1d, 0x74, 0xdc,
-		0x6a, 0x89, 0xbb, 0xb7, 0x2f, 0xc2, 0xb3, 0x4b, 0xf2, 0xff, 0x4c, 0x82, 0x49, 0x7f, 0xf3, 0xae,
-		0x22, 0xcb, 0x90, 0xf2, 0xb7, 0xce, 0x55, 0xb8, 0xa7, 0x1f, 0x15, 0xb8, 0xf4, 0x01, 0x7a, 0xf4,
-		0xac, 0x37, 0x5c, 0xd9, 0xde, 0xd9, 0xa3, 0x7d, 0x5b, 0x43, 0xc8, 0x14, 0x1e, 0xb6, 0x31, 0xda,
-		0x1f, 0xff, 0x57, 0x82, 0xd8, 0xaa, 0x61, 0xd4, 0x91, 0x01, 0xe3, 0xba, 0xe1, 0x94, 0x89, 0x67,
-		0xe1, 0xaa, 0xff, 0xae, 0x7a, 0xb2, 0x30, 0xb7, 0x3f, 0x23, 0x7d, 0xef, 0xe6, 0x74, 0x2b, 0x2b,
-		0x65, 0x4c, 0x37, 0x9c, 0x02, 0x85, 0xf0, 0xeb, 0xea, 0xef, 0x82, 0x91, 0x60, 0x63, 0x2c, 0x4a,
-		0x3e, 0xb7, 0xef, 0xc6, 0x82, 0x6c, 0x6e, 0xdd, 0x9c, 0x9e, 0xf4, 0x46, 0x8c, 0x0b, 0x96, 0x95,
-		0xd4, 0xa6, 0xaf, 0x75, 0x76, 0xbd, 0xeb, 0x07, 0x9f, 0x9a, 0x96, 0x4e, 0x7d, 0x59, 0x02, 0xf0,
-		0x76, 0x1e, 0xd0, 0x43, 0x70, 0xa4, 0xb0, 0xb2, 0x5c, 0x2c, 0xaf, 0xad, 0xe7, 0xd7, 0x37, 0xd6,
-		0x82, 0xf7, 0xba, 0xc5, 0xf6, 0xb8, 0x6d, 0xe2, 0x8a, 0xb6, 0xa5, 0xe1, 0x2a, 0xba, 0x0f, 0x26,
-		0x83, 0xd8, 0xa4, 0x54, 0x2a, 0xa6, 0xa5, 0x6c, 0xea, 0xfa, 0x8d, 0x99, 0x04, 0xcb, 0xc5, 0x70,
-		0x15, 0x9d, 0x84, 0x43, 0xad, 0x78, 0x0b, 0xcb, 0xf3, 0xe9, 0x48, 0x76, 0xe4, 0xfa, 0x8d, 0x99,
-		0xa4, 0x9b, 0xb4, 0x21, 0x19, 0x90, 0x1f, 0x93, 0xf3, 0x8b, 0x66, 0xe1, 0xfa, 0x8d, 0x99, 0x41,
-		0x66, 0xc0, 0x6c, 0xec, 0x03, 0x9f, 0x99, 0x1a, 0xb8, 0xed, 0xb7, 0xbf, 0xff, 0x68, 0xa8, 0xe3,
-		0xae, 0x77, 0x0d, 0xeb, 0xd8, 0xd6, 0xec, 0x03, 0xed, 0x7a, 0xf7, 0xb5, 0x93, 0x2e, 0xff, 0x4e,
-		0x1c, 0x52, 0xf3, 0xac, 0x15, 0xd2, 0x11, 0x18, 0xbd, 0x09, 0x06, 0x4d, 0x3a, 0x8d, 0xb8, 0xc7,
-		0x68, 0x1d, 0x1c, 0x9e, 0x4d, 0x36, 0xee, 0x5d, 0x2e, 0x36, 0xf5, 0xd8, 0xfc, 0x32, 0x07, 0xbb,
-		0x63, 0xe6, 0xdd, 0x9a, 0x4a, 0xed, 0x6b, 0xbf, 0x87, 0xe5, 0x2c, 0x7c, 0x6b, 0x25, 0xcc, 0x4f,
-		0x66, 0xf7, 0x42, 0xd6, 0x09, 0x84, 0xdd, 0x0e, 0x7b, 0x9f, 0x04, 0x87, 0x28, 0x96, 0x37, 0x11,
-		0x53, 0x4c, 0x91, 0xec, 0x9f, 0xea, 0xa4, 0xc2, 0xa2, 0x6a, 0x7b, 0x77, 0x3d, 0xd8, 0x7d, 0xae,
-		0x7b, 0xf8, 0x44, 0x78, 0xdc, 0xd7, 0x78, 0x98, 0xad, 0xac, 0x4c, 0xd4, 0x5b, 0x28, 0x6d, 0x34,
-		0x1f, 0xb8, 0xd0, 0x17, 0xdb, 0xdf, 0x56, 0xbb, 0xff, 0x72, 0xdf, 0x33, 0x30, 0xec, 0xc5, 0x12,
-		0x9b, 0xff, 0xaf, 0x97, 0xfe, 0xe7, 0x0e, 0x3f, 0x31, 0x7a, 0xbf, 0x04, 0x87, 0xbc, 0xd9, 0xdc,
-		0xcf, 0x96, 0xfd, 0x4f, 0x9c, 0x07, 0xf7, 0xb1, 0x10, 0x0a, 0x1b, 0xa7, 0x2d, 0x5f, 0x59, 0x99,
-		0x6c, 0xb6, 0x92, 0x92, 0x25, 0xd8, 0x88, 0x3f, 0xb2, 0xda, 0x19, 0xf1, 0xd9, 0xc7, 0xfe, 0x43,
-		0x73, 0x90, 0x01, 0xfb, 0x3f, 0x1d, 0xa6, 0x61, 0x39, 0xb8, 0x4a, 0x37, 0xe4, 0x12, 0x8a, 0x5b,
-		0x96, 0x97, 0x01, 0xb5, 0x76, 0x6e, 0xf8, 0x02, 0xa3, 0xf7, 0x26, 0x05, 0x4d, 0x42, 0xdc, 0x7f,
-		0xc5, 0x8f, 0x15, 0x72, 0x89, 0x0f, 0xf0, 0xe9, 0xf3, 0xb6, 0x8f, 0xf9, 0x7f, 0x11, 0x81, 0x53,
-		0xfe, 0xe3, 0xa1, 0x97, 0x9a, 0xd8, 0xda, 0x73, 0x87, 0xa8, 0xa9, 0xd6, 0x34, 0xdd, 0xff, 0x0a,
-		0xe2, 0xa8, 0x7f, 0xc2, 0xa7, 0xb8, 0xc2, 0x4e, 0xb2, 0x0e, 0xc3, 0xab, 0x6a, 0x0d, 0x2b, 0xf8,
-		0xa5, 0x26, 0xb6, 0x9d, 0x36, 0x97, 0xcc, 0x0f, 0xc3, 0xa0, 0xb1, 0xb5, 0x25, 0x8e, 0xb4, 0x63,
-		0x0a, 0x2f, 0x11, 0x95, 0xeb, 0x5a, 0x43, 0x63, 0xb7, 0xc1, 0x62, 0x0a, 0x2b, 0xa0, 0x69, 0x18,
-		0xae, 0x18, 0x4d, 0x9d, 0x8f, 0xb8, 0x4c, 0x4c, 0x7c, 0x5e, 0xa5, 0xa9, 0xb3, 0x11, 0x27, 0x3f,
-		0x0d, 0x29, 0xd6, 0x1e, 0x9f, 0x71, 0x8f, 0x42, 0x82, 0x5e, 0xa7, 0xf2, 0x5a, 0x1d, 0x22, 0xe5,
-		0x4b, 0xec, 0x42, 0x3a, 0xe3, 0xc2, 0x1a, 0x66, 0x85, 0x42, 0xa1, 0xa3, 0x29, 0x4f, 0xf6, 0x0e,
-		0x0d, 0xcc, 0x50, 0xae, 0x19, 0x7f, 0x23, 0x0e, 0x87, 0xf8, 0x09, 0x9d, 0x6a, 0x6a, 0xa7, 0xb7,
-		0x1d, 0x47, 0x3c, 0x90, 0x00, 0x9e, 0xea, 0xaa, 0xa6, 0x26, 0xef, 0x41, 0xec, 0xa2, 0xe3, 0x98,
-		0xe8, 0x14, 0xc4, 0xad, 0x66, 0x1d, 0x8b, 0x1d, 0x1f, 0x77, 0x4f, 0x5e, 0x35, 0xb5, 0x59, 0x82,
-		0xa0, 0x34, 0xeb, 0x58, 0x61, 0x28, 0xa8, 0x04, 0xd3, 0x5b, 0xcd, 0x7a, 0x7d, 0xaf, 0x5c, 0xc5,
-		0xf4, 0xff, 0x60, 0xb9, 0xff, 0x49, 0x02, 0xef, 0x9a, 0xaa, 0xf8, 0x1e, 0x25, 0xb1, 0xcd, 0x71,
-		0x8a, 0x56, 0xa4, 0x58, 0xe2, 0xbf, 0x48, 0x94, 0x04, 0x8e, 0xfc, 0x7b, 0x11, 0x48, 0x08, 0xd6,
-		0xf4, 0x86, 0x38, 0xae, 0xe3, 0x8a, 0x63, 0x88, 0x13, 0x13, 0xb7, 0x8c, 0x10, 0x44, 0x6b, 0xbc,
-		0x8b, 0x92, 0x17, 0x07, 0x14, 0x52, 0x20, 0x30, 0xf7, 0xde, 0x3e, 0x81, 0x99, 0x4d, 0xd2, 0x6b,
-		0x31, 0xd3, 0x10, 0x4b, 0xb3, 0x8b, 0x03, 0x0a, 0x2d, 0xa1, 0x0c, 0x0c, 0x92, 0x91, 0xe1, 0xb0,
-		0x8f, 0x7c, 0x12, 0x38, 0x2f, 0xa3, 0xc3, 0x10, 0x37, 0x55, 0xa7, 0xc2, 0xae, 0xd4, 0x91, 0x0a,
-		0x56, 0x44, 0x4f, 0xc0, 0x20, 0x7b, 0x6e, 0x1d, 0xfe, 0x27, 0x33, 0xc4, 0x18, 0xec, 0xbb, 0x76,
-		0x44, 0xee, 0x55, 0xd5, 0x71, 0xb0, 0xa5, 0x13, 0x86, 0x0c, 0x1d, 0x21, 0x88, 0x6d, 0x1a, 0xd5,
-		0x3d, 0xfe, 0x8f, 0x6f, 0xe8, 0x6f, 0xfe, 0x9f, 0x36, 0xa8, 0x3f, 0x94, 0x69, 0x25, 0xfb, 0x7f,
-		0x5f, 0x29, 0x01, 0x2c, 0x10, 0xa4, 0x12, 0x4c, 0xa8, 0xd5, 0xaa, 0xc6, 0xfe, 0x07, 0x4d, 0x79,
-		0x53, 0xa3, 0x11, 0xc2, 0xa6, 0xff, 0xcd, 0xad, 0x53, 0x5f, 0x20, 0x8f, 0xa0, 0xc0, 0xf1, 0x0b,
-		0x49, 0x18, 0x32, 0x99, 0x50, 0xf2, 0x79, 0x18, 0x6f, 0x91, 0x94, 0xc8, 0xb7, 0xa3, 0xe9, 0x55,
-		0xf1, 0x98, 0x81, 0xfc, 0x26, 0x30, 0xfa, 0x25, 0x4a, 0x76, 0x16, 0x45, 0x7f, 0x17, 0xde, 0xd3,
-		0xf9, 0xcd, 0xcb, 0xa8, 0xef, 0xcd, 0x8b, 0x6a, 0x6a, 0x85, 0x24, 0xe5, 0xcf, 0x5f, 0xba, 0xe4,
-		0x5b, 0x5f, 0xba, 0xd4, 0xb0, 0x2e, 0x66, 0x5f, 0x52, 0xa5, 0x9a, 0x9a, 0x4d, 0xdd, 0xd1, 0xfb,
-		0x32, 0xa6, 0x7d, 0xde, 0xf7, 0x9b, 0x3e, 0x7c, 0x89, 0xcd, 0xe7, 0x57, 0x17, 0x5c, 0x3f, 0xfe,
-		0x6a, 0x04, 0x8e, 0xfb, 0xfc, 0xd8, 0x87, 0xdc, 0xea, 0xce, 0xd9, 0xf6, 0x1e, 0xdf, 0xc7, 0xa3,
-		0xe3, 0x4b, 0x10, 0x23, 0xf8, 0xa8, 0xc7, 0xff, 0xc1, 0xc8, 0xfc, 0xea, 0x37, 0xfe, 0xa9, 0x1c,
-		0x3c, 0xb5, 0x0a, 0xf4, 0x0a, 0x65, 0x52, 0x78, 0x7f, 0xff, 0xf6, 0x4b, 0x7b, 0x1f, 0x05, 0xb5,
-		0x6f, 0x9f, 0x19, 0xc3, 0x36, 0xfc, 0xee, 0xd9, 0x8e, 0x8f, 0x52, 0x59, 0xc4, 0xec, 0x9e, 0x44,
-		0xed, 0x23, 0x1c, 0x77, 0xba, 0xff, 0xdf, 0xad, 0x07, 0xfb, 0x4c, 0xc7, 0x76, 0xe1, 0xf0, 0xb3,
-		0xa4, 0x6d, 0x6f, 0x99, 0x2c, 0x02, 0xfb, 0x61, 0xf7, 0x34, 0x4f, 0xe2, 0xff, 0x4c, 0x4f, 0x9c,
-		0xd4, 0x81, 0x27, 0x1f, 0x5f, 0x20, 0xde, 0x37, 0xdb, 0x71, 0xbe, 0x98, 0xf5, 0x4d, 0x16, 0x8a,
-		0x8f, 0x52, 0xfe, 0x65, 0x09, 0x8e, 0xb4, 0x34, 0xcd, 0x63, 0xfc, 0x7c, 0x9b, 0xa7, 0x0a, 0x07,
-		0xca, 0x6c, 0xe6, 0xdb, 0x08, 0x7b, 0x7f, 0x4f, 0x61, 0x99, 0x14, 0x01, 0x69, 0xdf, 0x02, 0x87,
-		0x82, 0xc2, 0x0a, 0x33, 0xdd, 0x0b, 0xa3, 0xc1, 0x1d, 0x61, 0x6e, 0xae, 0x91, 0xc0, 0x9e, 0xb0,
-		0x5c, 0x0e, 0xdb, 0xd9, 0xd5, 0xb5, 0x04, 0x49, 0x17, 0x95, 0xa7, 0xc0, 0x7d, 0xab, 0xea, 0x51,
-		0xca, 0x1f, 0x96, 0x60, 0x26, 0xd8, 0x82, 0x2f, 0x19, 0xda, 0x9f, 0xb0, 0xb7, 0xad, 0x8b, 0x5f,
-		0x93, 0xe0, 0xae, 0x2e, 0x32, 0x71, 0x03, 0x5c, 0x83, 0x49, 0xdf, 0x4e, 0x80, 0x08, 0xe1, 0xa2,
-		0xdb, 0x4f, 0xf5, 0x4e, 0x43, 0xdd, 0x85, 0xef, 0x31, 0x62, 0x94, 0xcf, 0x7d, 0x7b, 0x7a, 0xa2,
-		0xb5, 0xce, 0x56, 0x26, 0x5a, 0x57, 0xef, 0xb7, 0xd1, 0x3f, 0x5e, 0x91, 0xe0, 0x81, 0xa0, 0xaa,
-		0x6d, 0xf2, 0xd9, 0x37, 0xaa, 0x1f, 0xfe, 0x83, 0x04, 0xa7, 0xfa, 0x11, 0x8e, 0x77, 0xc8, 0x26,
-		0x4c, 0x78, 0x99, 0x76, 0xb8, 0x3f, 0xf6, 0x95, 0xbf, 0x33, 0x2f, 0x45, 0x2e, 0xb7, 0x3b, 0x60,
-		0x78, 0x93, 0x0f, 0x2c, 0x7f, 0x97, 0xbb, 0x46, 0x0e, 0xee, 0xe6, 0x0a, 0x23, 0x07, 0xf6, 0x73,
-		0xdb, 0xf4, 0x45, 0xa4, 0x4d, 0x5f, 0x78, 0xa9, 0xb9, 0x7c, 0x85, 0xc7, 0xad, 0x36, 0x7b, 0x70,
-		0x6f, 0x87, 0x89, 0x36, 0xae, 0xcc, 0x47, 0xf5, 0x3e, 0x3c, 0x59, 0x41, 0xad, 0xce, 0x2a, 0xef,
-		0xc1, 0x34, 0x6d, 0xb7, 0x8d, 0xa1, 0xef, 0xb4, 0xca, 0x0d, 0x1e, 0x5b, 0xda, 0x36, 0xcd, 0x75,
-		0x5f, 0x80, 0x41, 0xd6, 0xcf, 0x5c, 0xdd, 0x03, 0x38, 0x0a, 0x67, 0x20, 0x7f, 0x5c, 0xc4, 0xb2,
-		0xa2, 0x10, 0xbb, 0xfd, 0x18, 0xea, 0x47, 0xd7, 0xdb, 0x34, 0x86, 0x7c, 0xc6, 0xf8, 0x96, 0x88,
-		0x6a, 0xed, 0xa5, 0xe3, 0xe6, 0xa8, 0xdc, 0xb6, 0xa8, 0xc6, 0x6c, 0x73, 0x67, 0xc3, 0xd7, 0x2f,
-		0x8a, 0xf0, 0xe5, 0xea, 0xd4, 0x23, 0x7c, 0xbd, 0x31, 0xa6, 0x77, 0x03, 0x59, 0x0f, 0x31, 0xff,
-		0x2c, 0x06, 0xb2, 0x1f, 0x48, 0x70, 0x94, 0xea, 0xe6, 0xdf, 0x88, 0xd8, 0xaf, 0xc9, 0x1f, 0x02,
-		0x64, 0x5b, 0x95, 0x72, 0xdb, 0xd1, 0x9d, 0xb6, 0xad, 0xca, 0xe5, 0xc0, 0xfc, 0xf2, 0x10, 0xa0,
-		0x6a, 0x60, 0xbb, 0x89, 0x62, 0xb3, 0x5b, 0x72, 0xe9, 0xaa, 0x6f, 0x37, 0xa3, 0x4d, 0x77, 0xc6,
-		0x6e, 0x43, 0x77, 0x7e, 0x53, 0x82, 0x6c, 0x3b, 0x95, 0x79, 0xf7, 0x69, 0x70, 0x38, 0x70, 0x48,
-		0x10, 0xee, 0xc1, 0x87, 0xfa, 0xd9, 0xca, 0x09, 0x0d, 0xa3, 0x43, 0x16, 0xbe, 0xd3, 0x79, 0xc0,
-		0x74, 0xd0, 0x43, 0x5b, 0x33, 0xeb, 0x37, 0x6c, 0xf8, 0x7c, 0xa9, 0x25, 0xae, 0xfe, 0x99, 0xc8,
-		0xbd, 0x77, 0x61, 0xaa, 0x83, 0xd4, 0x77, 0x7a, 0xde, 0xdb, 0xee, 0xd8, 0x99, 0xb7, 0x3b, 0x7d,
-		0x7f, 0x9c, 0x8f, 0x84, 0xe0, 0x0d, 0x6c, 0xdf, 0x5a, 0xac, 0xdd, 0x13, 0x2e, 0xf9, 0x6d, 0x70,
-		0xac, 0x2d, 0x15, 0x97, 0x2d, 0x07, 0xb1, 0x6d, 0xcd, 0x76, 0xb8, 0x58, 0xf7, 0x75, 0x12, 0x2b,
-		0x44, 0x4d, 0x69, 0x64, 0x04, 0x69, 0xca, 0x7a, 0xd5, 0x30, 0xea, 0x5c, 0x0c, 0xf9, 0x12, 0x8c,
-		0xfb, 0x60, 0xbc, 0x91, 0x73, 0x10, 0x33, 0x0d, 0xfe, 0x79, 0x82, 0xe1, 0x33, 0xc7, 0x3b, 0xee,
-		0xde, 0x1b, 0x46, 0x9d, 0xab, 0x4d, 0xf1, 0xe5, 0x49, 0x40, 0x8c, 0x19, 0xdd, 0xc8, 0x17, 0x4d,
-		0xac, 0xc1, 0x44, 0x00, 0xca, 0x1b, 0x79, 0x5d, 0x87, 0x04, 0x67, 0xbe, 0x77, 0x08, 0xe2, 0x94,
-		0x2b, 0xfa, 0x98, 0x14, 0xf8, 0x66, 0xd0, 0x6c, 0x27, 0x36, 0xed, 0xd7, 0xc4, 0xd9, 0xd3, 0x7d,
-		0xe3, 0xf3, 0x9c, 0xed, 0xd4, 0x7b, 0xfe, 0xed, 0x77, 0x3f, 0x12, 0xb9, 0x07, 0xc9, 0xa7, 0x3b,
-		0xac, 0xc6, 0x7d, 0xe3, 0xe5, 0xb3, 0x81, 0xb7, 0xef, 0x0f, 0xf7, 0xd7, 0x94, 0x90, 0x6c, 0xb6,
-		0x5f, 0x74, 0x2e, 0xd8, 0x79, 0x2a, 0xd8, 0x59, 0xf4, 0x58, 0x6f, 0xc1, 0x4e, 0xbf, 0x33, 0x38,
-		0x68, 0xde, 0x8d, 0x7e, 0x47, 0x82, 0xc9, 0x76, 0x4b, 0x3a, 0xf4, 0x64, 0x7f, 0x52, 0xb4, 0xa6,
-		0x14, 0xd9, 0xa7, 0x0e, 0x40, 0xc9, 0x55, 0x99, 0xa7, 0xaa, 0xe4, 0xd1, 0xd3, 0x07, 0x50, 0xe5,
-		0xb4, 0x7f, 0x7f, 0xff, 0x7f, 0x4b, 0x70, 0xa2, 0xeb, 0x0a, 0x09, 0xe5, 0xfb, 0x93, 0xb2, 0x4b,
-		0xee, 0x94, 0x2d, 0xbc, 0x1e, 0x16, 0x5c, 0xe3, 0x67, 0xa9, 0xc6, 0x97, 0xd0, 0xc2, 0x41, 0x34,
-		0x6e, 0x7b, 0x88, 0x82, 0x7e, 0x33, 0x78, 0xb3, 0xb0, 0xbb, 0x3b, 0xb5, 0x2c, 0x3c, 0x7a, 0x0c,
-		0x8c, 0xd6, 0xa4, 0x56, 0x7e, 0x9e, 0xaa, 0xa0, 0xa0, 0xd5, 0xd7, 0xd9, 0x69, 0xa7, 0xdf, 0x19,
-		0x0c, 0xfc, 0xef, 0x46, 0xff, 0x4b, 0x6a, 0x7f, 0x51, 0xf0, 0x89, 0xae, 0x22, 0x76, 0x5e, 0x54,
-		0x65, 0x9f, 0xdc, 0x3f, 0x21, 0x57, 0xb2, 0x41, 0x95, 0xac, 0x21, 0x7c, 0xbb, 0x95, 0x6c, 0xdb,
-		0x89, 0xe8, 0xeb, 0x12, 0x4c, 0xb6, 0x5b, 0x93, 0xf4, 0x18, 0x96, 0x5d, 0x16, 0x59, 0x3d, 0x86,
-		0x65, 0xb7, 0x05, 0x90, 0xfc, 0x26, 0xaa, 0xfc, 0x39, 0xf4, 0x78, 0x27, 0xe5, 0xbb, 0xf6, 0x22,
-		0x19, 0x8b, 0x5d, 0x93, 0xfc, 0x1e, 0x63, 0xb1, 0x9f, 0x75, 0x4c, 0x8f, 0xb1, 0xd8, 0xd7, 0x1a,
-		0xa3, 0xf7, 0x58, 0x74, 0x35, 0xeb, 0xb3, 0x1b, 0x6d, 0xf4, 0x55, 0x09, 0x46, 0x02, 0x19, 0x31,
-		0x7a, 0xb4, 0xab, 0xa0, 0xed, 0x16, 0x0c, 0xd9, 0x33, 0xfb, 0x21, 0xe1, 0xba, 0x2c, 0x50, 0x5d,
-		0xe6, 0x50, 0xfe, 0x20, 0xba, 0x04, 0xcf, 0x4a, 0xbf, 0x29, 0xc1, 0x44, 0x9b, 0x2c, 0xb3, 0xc7,
-		0x28, 0xec, 0x9c, 0x34, 0x67, 0x9f, 0xdc, 0x3f, 0x21, 0xd7, 0xea, 0x02, 0xd5, 0xea, 0xad, 0xe8,
-		0x2d, 0x07, 0xd1, 0xca, 0x37, 0x3f, 0xdf, 0xf4, 0xee, 0x5d, 0xf9, 0xda, 0x41, 0xe7, 0xf6, 0x29,
-		0x98, 0x50, 0xe8, 0x89, 0x7d, 0xd3, 0x71, 0x7d, 0x9e, 0xa3, 0xfa, 0x3c, 0x8b, 0x56, 0x5e, 0x9f,
-		0x3e, 0xad, 0xd3, 0xfa, 0x17, 0x5b, 0x5f, 0x00, 0x76, 0xf7, 0xa2, 0xb6, 0xc9, 0x6a, 0xf6, 0xb1,
-		0x7d, 0xd1, 0x70, 0xa5, 0x9e, 0xa4, 0x4a, 0x9d, 0x41, 0x8f, 0x74, 0x52, 0xca, 0x77, 0xb9, 0x4e,
-		0xd3, 0xb7, 0x8c, 0xd3, 0xef, 0x64, 0x29, 0xf0, 0xbb, 0xd1, 0x4f, 0x8b, 0x8b, 0x4d, 0x27, 0xbb,
-		0xb6, 0xeb, 0xcb, 0x63, 0xb3, 0x0f, 0xf4, 0x81, 0xc9, 0xe5, 0xba, 0x87, 0xca, 0x35, 0x85, 0x8e,
-		0x77, 0x92, 0x8b, 0xe4, 0xb2, 0xe8, 0x83, 0x92, 0x7b, 0x17, 0xf2, 0x54, 0x77, 0xde, 0xfe, 0x64,
-		0x37, 0xfb, 0x60, 0x5f, 0xb8, 0x5c, 0x92, 0xfb, 0xa8, 0x24, 0x33, 0x68, 0xaa, 0xa3, 0x24, 0x2c,
-		0xf5, 0xbd, 0xdd, 0x37, 0x07, 0xae, 0x1f, 0x81, 0xe9, 0x0e, 0x2d, 0x3a, 0xbb, 0x3d, 0xce, 0xb8,
-		0xba, 0x3c, 0x84, 0xed, 0xf9, 0xd0, 0xf5, 0x76, 0x7f, 0xb4, 0xb5, 0xcf, 0x03, 0xb1, 0xdf, 0x8a,
-		0x01, 0x5a, 0xb2, 0x6b, 0x73, 0x16, 0x66, 0xff, 0x40, 0x92, 0x8f, 0xf2, 0xd0, 0x0b, 0x2f, 0xe9,
-		0x75, 0xbd, 0xf0, 0x5a, 0x0a, 0xbc, 0x99, 0x8a, 0xec, 0xef, 0x5d, 0x66, 0xdf, 0x0f, 0xa7, 0xa2,
-		0x3f, 0x96, 0x87, 0x53, 0xed, 0xef, 0x55, 0xc7, 0x6e, 0xdf, 0x03, 0x8c, 0xf8, 0x41, 0x1f, 0xa1,
-		0xf0, 0xf7, 0x90, 0x83, 0x5d, 0xde, 0x43, 0x66, 0x3a, 0x3e, 0x7a, 0xe4, 0xd4, 0xe8, 0xac, 0xf8,
-		0x84, 0xe9, 0x50, 0x7f, 0x37, 0x61, 0xf9, 0x37, 0x4e, 0xbd, 0x2d, 0x84, 0xe3, 0x90, 0x6d, 0x75,
-		0x27, 0x77, 0x50, 0x7f, 0x24, 0x0a, 0xe9, 0x25, 0xbb, 0x56, 0xaa, 0x6a, 0xce, 0x1d, 0xf2, 0xb5,
-		0xa7, 0x3b, 0x3f, 0x6a, 0x41, 0xb7, 0x6e, 0x4e, 0x8f, 0x32, 0x9b, 0x76, 0xb1, 0x64, 0x03, 0xc6,
-		0x42, 0x4f, 0x89, 0xb9, 0x67, 0x15, 0x0f, 0xf2, 0xa2, 0x39, 0xc4, 0x4a, 0xa6, 0x6f, 0x10, 0x7c,
-		0xfe, 0x8d, 0x76, 0xdb, 0x3b, 0x33, 0x73, 0xa8, 0x8b, 0x77, 0xf2, 0x05, 0xa0, 0xd7, 0x67, 0x59,
-		0xc8, 0x84, 0x3b, 0xc5, 0xed, 0xb1, 0x3f, 0x90, 0x60, 0x78, 0xc9, 0x16, 0xa9, 0x20, 0xfe, 0x09,
-		0x7d, 0x7f, 0xf4, 0x84, 0xfb, 0xfd, 0xef, 0x68, 0x7f, 0x7e, 0x2b, 0xbe, 0x09, 0xee, 0x19, 0xe1,
-		0x10, 0x4c, 0xf8, 0xf4, 0x74, 0xf5, 0xff, 0xed, 0x08, 0x8d, 0x8f, 0x05, 0x5c, 0xd3, 0x74, 0x37,
-		0x8b, 0xc4, 0x7f, 0x5e, 0x5f, 0x57, 0x78, 0x76, 0x8e, 0x1d, 0xd4, 0xce, 0x3b, 0x34, 0x40, 0x84,
-		0xec, 0xe9, 0x6e, 0x7c, 0x2d, 0xb5, 0xbe, 0xfd, 0x91, 0xf6, 0xf1, 0x59, 0x9d, 0xd0, 0x0b, 0x1f,
-		0xf9, 0x35, 0x09, 0x46, 0x96, 0xec, 0xda, 0x86, 0x5e, 0xfd, 0xff, 0xde, 0x7f, 0xb7, 0xe0, 0x50,
-		0x40, 0xd3, 0x3b, 0x64, 0xd2, 0x33, 0xaf, 0xc4, 0x20, 0xba, 0x64, 0xd7, 0xd0, 0x4b, 0x30, 0x16,
-		0x4e, 0x1a, 0x3a, 0xe6, 0x82, 0xad, 0x33, 0x42, 0xe7, 0xf5, 0x5a, 0xe7, 0xd9, 0x03, 0xed, 0xc0,
-		0x48, 0x70, 0xe6, 0x38, 0xd9, 0x85, 0x49, 0x00, 0x33, 0xfb, 0x48, 0xbf, 0x98, 0x6e, 0x63, 0xef,
-		0x80, 0x84, 0x1b, 0xf4, 0xee, 0xee, 0x42, 0x2d, 0x90, 0x3a, 0x67, 0xb7, 0x6d, 0xc2, 0x0a, 0xb1,
-		0x5e, 0x38, 0xa4, 0x74, 0xb3, 0x5e, 0x08, 0xb7, 0xab, 0xf5, 0x3a, 0x0d, 0xad, 0x4d, 0x00, 0xdf,
-		0x38, 0xb8, 0xb7, 0x0b, 0x07, 0x0f, 0x2d, 0xfb, 0x70, 0x5f, 0x68, 0xee, 0xa1, 0xd3, 0x6d, 0x4e,
-		0xc6, 0xff, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd4, 0x8f, 0xbc, 0xb2, 0xb6, 0x97, 0x00, 0x00,
+		// 10422 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6b, 0x74, 0x1c, 0xe7,
+		0x75, 0x18, 0x67, 0x77, 0x01, 0xec, 0x5e, 0xbc, 0x16, 0x1f, 0x40, 0x72, 0xb1, 0x24, 0x01, 0x68,
+		0x44, 0x51, 0x14, 0x25, 0x01, 0x12, 0x29, 0x92, 0xd2, 0x52, 0x0f, 0x63, 0x81, 0x25, 0x08, 0x12,
+		0x2f, 0x0d, 0x40, 0x4a, 0x96, 0x9d, 0xee, 0x19, 0xec, 0x7e, 0x58, 0x8c, 0xb0, 0x3b, 0x33, 0x9a,
+		0x99, 0x25, 0x09, 0xd9, 0xee, 0x51, 0x6c, 0xd7, 0xb5, 0x95, 0xe3, 0xd8, 0xae, 0x7b, 0x12, 0xbf,
+		0xe8, 0xfa, 0x91, 0xd6, 0xae, 0xe3, 0x34, 0x0f, 0xbb, 0x4e, 0xd3, 0xe6, 0x9c, 0xda, 0x69, 0xd3,
+		0xd8, 0x4e, 0xe3, 0x63, 0xb7, 0x3d, 0x6d, 0x9a, 0xb6, 0xb4, 0x2b, 0xfb, 0xa4, 0xaa, 0xeb, 0x36,
+		0x2e, 0xeb, 0x9e, 0xa6, 0xc7, 0xa7, 0xa7, 0x3d, 0xdf, 0x6b, 0x5e, 0x3b, 0xfb, 0x82, 0x48, 0xcb,
+		0x4e, 0xfa, 0x0b, 0xf8, 0xee, 0x77, 0xef, 0xfd, 0xee, 0xbd, 0xdf, 0xfd, 0xee, 0x77, 0xbf, 0xd7,
+		0x2c, 0xfc, 0x93, 0x73, 0x30, 0x55, 0x31, 0x8c, 0x4a, 0x15, 0xcf, 0x98, 0x96, 0xe1, 0x18, 0x9b,
+		0xf5, 0xad, 0x99, 0x32, 0xb6, 0x4b, 0x96, 0x66, 0x3a, 0x86, 0x35, 0x4d, 0x61, 0x68, 0x98, 0x61,
+		0x4c, 0x0b, 0x0c, 0x79, 0x19, 0x46, 0xce, 0x6b, 0x55, 0x3c, 0xef, 0x22, 0xae, 0x63, 0x07, 0x3d,
+		0x0a, 0x89, 0x2d, 0xad, 0x8a, 0x33, 0xd2, 0x54, 0xfc, 0x78, 0xff, 0xc9, 0xa3, 0xd3, 0x21, 0xa2,
+		0xe9, 0x20, 0xc5, 0x1a, 0x01, 0x2b, 0x94, 0x42, 0xfe, 0x5e, 0x02, 0x46, 0x23, 0x6a, 0x11, 0x82,
+		0x84, 0xae, 0xd6, 0x08, 0x47, 0xe9, 0x78, 0x4a, 0xa1, 0xff, 0xa3, 0x0c, 0xf4, 0x99, 0x6a, 0x69,
+		0x47, 0xad, 0xe0, 0x4c, 0x8c, 0x82, 0x45, 0x11, 0x4d, 0x00, 0x94, 0xb1, 0x89, 0xf5, 0x32, 0xd6,
+		0x4b, 0xbb, 0x99, 0xf8, 0x54, 0xfc, 0x78, 0x4a, 0xf1, 0x41, 0xd0, 0xfd, 0x30, 0x62, 0xd6, 0x37,
+		0xab, 0x5a, 0xa9, 0xe8, 0x43, 0x83, 0xa9, 0xf8, 0xf1, 0x1e, 0x25, 0xcd, 0x2a, 0xe6, 0x3d, 0xe4,
+		0x7b, 0x61, 0xf8, 0x1a, 0x56, 0x77, 0xfc, 0xa8, 0xfd, 0x14, 0x75, 0x88, 0x80, 0x7d, 0x88, 0x73,
+		0x30, 0x50, 0xc3, 0xb6, 0xad, 0x56, 0x70, 0xd1, 0xd9, 0x35, 0x71, 0x26, 0x41, 0xb5, 0x9f, 0x6a,
+		0xd0, 0x3e, 0xac, 0x79, 0x3f, 0xa7, 0xda, 0xd8, 0x35, 0x31, 0x9a, 0x85, 0x14, 0xd6, 0xeb, 0x35,
+		0xc6, 0xa1, 0xa7, 0x89, 0xfd, 0x0a, 0x7a, 0xbd, 0x16, 0xe6, 0x92, 0x24, 0x64, 0x9c, 0x45, 0x9f,
+		0x8d, 0xad, 0xab, 0x5a, 0x09, 0x67, 0x7a, 0x29, 0x83, 0x7b, 0x1b, 0x18, 0xac, 0xb3, 0xfa, 0x30,
+		0x0f, 0x41, 0x87, 0xe6, 0x20, 0x85, 0xaf, 0x3b, 0x58, 0xb7, 0x35, 0x43, 0xcf, 0xf4, 0x51, 0x26,
+		0xf7, 0x44, 0xf4, 0x22, 0xae, 0x96, 0xc3, 0x2c, 0x3c, 0x3a, 0x74, 0x06, 0xfa, 0x0c, 0xd3, 0xd1,
+		0x0c, 0xdd, 0xce, 0x24, 0xa7, 0xa4, 0xe3, 0xfd, 0x27, 0x0f, 0x47, 0x3a, 0xc2, 0x2a, 0xc3, 0x51,
+		0x04, 0x32, 0x5a, 0x84, 0xb4, 0x6d, 0xd4, 0xad, 0x12, 0x2e, 0x96, 0x8c, 0x32, 0x2e, 0x6a, 0xfa,
+		0x96, 0x91, 0x49, 0x51, 0x06, 0x93, 0x8d, 0x8a, 0x50, 0xc4, 0x39, 0xa3, 0x8c, 0x17, 0xf5, 0x2d,
+		0x43, 0x19, 0xb2, 0x03, 0x65, 0x74, 0x00, 0x7a, 0xed, 0x5d, 0xdd, 0x51, 0xaf, 0x67, 0x06, 0xa8,
+		0x87, 0xf0, 0x92, 0xfc, 0x3b, 0xbd, 0x30, 0xdc, 0x89, 0x8b, 0x9d, 0x83, 0x9e, 0x2d, 0xa2, 0x65,
+		0x26, 0xd6, 0x8d, 0x0d, 0x18, 0x4d, 0xd0, 0x88, 0xbd, 0x7b, 0x34, 0xe2, 0x2c, 0xf4, 0xeb, 0xd8,
+		0x76, 0x70, 0x99, 0x79, 0x44, 0xbc, 0x43, 0x9f, 0x02, 0x46, 0xd4, 0xe8, 0x52, 0x89, 0x3d, 0xb9,
+		0xd4, 0xb3, 0x30, 0xec, 0x8a, 0x54, 0xb4, 0x54, 0xbd, 0x22, 0x7c, 0x73, 0xa6, 0x9d, 0x24, 0xd3,
+		0x05, 0x41, 0xa7, 0x10, 0x32, 0x65, 0x08, 0x07, 0xca, 0x68, 0x1e, 0xc0, 0xd0, 0xb1, 0xb1, 0x55,
+		0x2c, 0xe3, 0x52, 0x35, 0x93, 0x6c, 0x62, 0xa5, 0x55, 0x82, 0xd2, 0x60, 0x25, 0x83, 0x41, 0x4b,
+		0x55, 0xf4, 0x98, 0xe7, 0x6a, 0x7d, 0x4d, 0x3c, 0x65, 0x99, 0x0d, 0xb2, 0x06, 0x6f, 0xbb, 0x0c,
+		0x43, 0x16, 0x26, 0x7e, 0x8f, 0xcb, 0x5c, 0xb3, 0x14, 0x15, 0x62, 0xba, 0xad, 0x66, 0x0a, 0x27,
+		0x63, 0x8a, 0x0d, 0x5a, 0xfe, 0x22, 0xba, 0x1b, 0x5c, 0x40, 0x91, 0xba, 0x15, 0xd0, 0x28, 0x34,
+		0x20, 0x80, 0x2b, 0x6a, 0x0d, 0x67, 0x5f, 0x84, 0xa1, 0xa0, 0x79, 0xd0, 0x18, 0xf4, 0xd8, 0x8e,
+		0x6a, 0x39, 0xd4, 0x0b, 0x7b, 0x14, 0x56, 0x40, 0x69, 0x88, 0x63, 0xbd, 0x4c, 0xa3, 0x5c, 0x8f,
+		0x42, 0xfe, 0x45, 0x6f, 0xf0, 0x14, 0x8e, 0x53, 0x85, 0x8f, 0x35, 0xf6, 0x68, 0x80, 0x73, 0x58,
+		0xef, 0xec, 0x59, 0x18, 0x0c, 0x28, 0xd0, 0x69, 0xd3, 0xf2, 0x5b, 0x61, 0x7f, 0x24, 0x6b, 0xf4,
+		0x2c, 0x8c, 0xd5, 0x75, 0x4d, 0x77, 0xb0, 0x65, 0x5a, 0x98, 0x78, 0x2c, 0x6b, 0x2a, 0xf3, 0x9f,
+		0xfa, 0x9a, 0xf8, 0xdc, 0x65, 0x3f, 0x36, 0xe3, 0xa2, 0x8c, 0xd6, 0x1b, 0x81, 0x27, 0x52, 0xc9,
+		0x57, 0xfb, 0xd2, 0x2f, 0xbd, 0xf4, 0xd2, 0x4b, 0x31, 0xf9, 0x2b, 0xbd, 0x30, 0x16, 0x35, 0x66,
+		0x22, 0x87, 0xef, 0x01, 0xe8, 0xd5, 0xeb, 0xb5, 0x4d, 0x6c, 0x51, 0x23, 0xf5, 0x28, 0xbc, 0x84,
+		0x66, 0xa1, 0xa7, 0xaa, 0x6e, 0xe2, 0x6a, 0x26, 0x31, 0x25, 0x1d, 0x1f, 0x3a, 0x79, 0x7f, 0x47,
+		0xa3, 0x72, 0x7a, 0x89, 0x90, 0x28, 0x8c, 0x12, 0x3d, 0x09, 0x09, 0x1e, 0xa2, 0x09, 0x87, 0x13,
+		0x9d, 0x71, 0x20, 0x63, 0x49, 0xa1, 0x74, 0xe8, 0x10, 0xa4, 0xc8, 0x5f, 0xe6, 0x1b, 0xbd, 0x54,
+		0xe6, 0x24, 0x01, 0x10, 0xbf, 0x40, 0x59, 0x48, 0xd2, 0x61, 0x52, 0xc6, 0x62, 0x6a, 0x73, 0xcb,
+		0xc4, 0xb1, 0xca, 0x78, 0x4b, 0xad, 0x57, 0x9d, 0xe2, 0x55, 0xb5, 0x5a, 0xc7, 0xd4, 0xe1, 0x53,
+		0xca, 0x00, 0x07, 0x5e, 0x21, 0x30, 0x34, 0x09, 0xfd, 0x6c, 0x54, 0x69, 0x7a, 0x19, 0x5f, 0xa7,
+		0xd1, 0xb3, 0x47, 0x61, 0x03, 0x6d, 0x91, 0x40, 0x48, 0xf3, 0xcf, 0xdb, 0x86, 0x2e, 0x5c, 0x93,
+		0x36, 0x41, 0x00, 0xb4, 0xf9, 0xb3, 0xe1, 0xc0, 0x7d, 0x24, 0x5a, 0xbd, 0x86, 0xb1, 0x74, 0x2f,
+		0x0c, 0x53, 0x8c, 0x53, 0xbc, 0xeb, 0xd5, 0x6a, 0x66, 0x64, 0x4a, 0x3a, 0x9e, 0x54, 0x86, 0x18,
+		0x78, 0x95, 0x43, 0xe5, 0x2f, 0xc5, 0x20, 0x41, 0x03, 0xcb, 0x30, 0xf4, 0x6f, 0xbc, 0x71, 0xad,
+		0x50, 0x9c, 0x5f, 0xbd, 0x9c, 0x5f, 0x2a, 0xa4, 0x25, 0x34, 0x04, 0x40, 0x01, 0xe7, 0x97, 0x56,
+		0x67, 0x37, 0xd2, 0x31, 0xb7, 0xbc, 0xb8, 0xb2, 0x71, 0xe6, 0x91, 0x74, 0xdc, 0x25, 0xb8, 0xcc,
+		0x00, 0x09, 0x3f, 0xc2, 0xa9, 0x93, 0xe9, 0x1e, 0x94, 0x86, 0x01, 0xc6, 0x60, 0xf1, 0xd9, 0xc2,
+		0xfc, 0x99, 0x47, 0xd2, 0xbd, 0x41, 0xc8, 0xa9, 0x93, 0xe9, 0x3e, 0x34, 0x08, 0x29, 0x0a, 0xc9,
+		0xaf, 0xae, 0x2e, 0xa5, 0x93, 0x2e, 0xcf, 0xf5, 0x0d, 0x65, 0x71, 0x65, 0x21, 0x9d, 0x72, 0x79,
+		0x2e, 0x28, 0xab, 0x97, 0xd7, 0xd2, 0xe0, 0x72, 0x58, 0x2e, 0xac, 0xaf, 0xcf, 0x2e, 0x14, 0xd2,
+		0xfd, 0x2e, 0x46, 0xfe, 0x8d, 0x1b, 0x85, 0xf5, 0xf4, 0x40, 0x40, 0xac, 0x53, 0x27, 0xd3, 0x83,
+		0x6e, 0x13, 0x85, 0x95, 0xcb, 0xcb, 0xe9, 0x21, 0x34, 0x02, 0x83, 0xac, 0x09, 0x21, 0xc4, 0x70,
+		0x08, 0x74, 0xe6, 0x91, 0x74, 0xda, 0x13, 0x84, 0x71, 0x19, 0x09, 0x00, 0xce, 0x3c, 0x92, 0x46,
+		0xf2, 0x1c, 0xf4, 0x50, 0x37, 0x44, 0x08, 0x86, 0x96, 0x66, 0xf3, 0x85, 0xa5, 0xe2, 0xea, 0xda,
+		0xc6, 0xe2, 0xea, 0xca, 0xec, 0x52, 0x5a, 0xf2, 0x60, 0x4a, 0xe1, 0xe9, 0xcb, 0x8b, 0x4a, 0x61,
+		0x3e, 0x1d, 0xf3, 0xc3, 0xd6, 0x0a, 0xb3, 0x1b, 0x85, 0xf9, 0x74, 0x5c, 0x2e, 0xc1, 0x58, 0x54,
+		0x40, 0x8d, 0x1c, 0x42, 0x3e, 0x5f, 0x88, 0x35, 0xf1, 0x05, 0xca, 0x2b, 0xec, 0x0b, 0xf2, 0x77,
+		0x63, 0x30, 0x1a, 0x31, 0xa9, 0x44, 0x36, 0xf2, 0x14, 0xf4, 0x30, 0x5f, 0x66, 0xd3, 0xec, 0x7d,
+		0x91, 0xb3, 0x13, 0xf5, 0xec, 0x86, 0xa9, 0x96, 0xd2, 0xf9, 0x53, 0x8d, 0x78, 0x93, 0x54, 0x83,
+		0xb0, 0x68, 0x70, 0xd8, 0x9f, 0x6b, 0x08, 0xfe, 0x6c, 0x7e, 0x3c, 0xd3, 0xc9, 0xfc, 0x48, 0x61,
+		0xdd, 0x4d, 0x02, 0x3d, 0x11, 0x93, 0xc0, 0x39, 0x18, 0x69, 0x60, 0xd4, 0x71, 0x30, 0x7e, 0x87,
+		0x04, 0x99, 0x66, 0xc6, 0x69, 0x13, 0x12, 0x63, 0x81, 0x90, 0x78, 0x2e, 0x6c, 0xc1, 0xbb, 0x9a,
+		0x77, 0x42, 0x43, 0x5f, 0x7f, 0x46, 0x82, 0x03, 0xd1, 0x29, 0x65, 0xa4, 0x0c, 0x4f, 0x42, 0x6f,
+		0x0d, 0x3b, 0xdb, 0x86, 0x48, 0xab, 0x8e, 0x45, 0x4c, 0xd6, 0xa4, 0x3a, 0xdc, 0xd9, 0x9c, 0x0a,
+		0x3d, 0x16, 0x96, 0x75, 0xb2, 0x59, 0x82, 0xdb, 0x20, 0xe9, 0x7b, 0x62, 0xb0, 0x3f, 0x92, 0x79,
+		0xa4, 0xa0, 0x47, 0x00, 0x34, 0xdd, 0xac, 0x3b, 0x2c, 0x75, 0x62, 0x91, 0x38, 0x45, 0x21, 0x34,
+		0x78, 0x91, 0x28, 0x5b, 0x77, 0xdc, 0xfa, 0x38, 0xad, 0x07, 0x06, 0xa2, 0x08, 0x8f, 0x7a, 0x82,
+		0x26, 0xa8, 0xa0, 0x13, 0x4d, 0x34, 0x6d, 0x70, 0xcc, 0x87, 0x20, 0x5d, 0xaa, 0x6a, 0x58, 0x77,
+		0x8a, 0xb6, 0x63, 0x61, 0xb5, 0xa6, 0xe9, 0x15, 0x3a, 0xd5, 0x24, 0x73, 0x3d, 0x5b, 0x6a, 0xd5,
+		0xc6, 0xca, 0x30, 0xab, 0x5e, 0x17, 0xb5, 0x84, 0x82, 0x3a, 0x90, 0xe5, 0xa3, 0xe8, 0x0d, 0x50,
+		0xb0, 0x6a, 0x97, 0x42, 0xfe, 0x40, 0x0a, 0xfa, 0x7d, 0x09, 0x38, 0xba, 0x0b, 0x06, 0x9e, 0x57,
+		0xaf, 0xaa, 0x45, 0xb1, 0xa8, 0x62, 0x96, 0xe8, 0x27, 0xb0, 0x35, 0x06, 0x42, 0x0f, 0xc1, 0x18,
+		0x45, 0x31, 0xea, 0x0e, 0xb6, 0x8a, 0xa5, 0xaa, 0x6a, 0xdb, 0xd4, 0x68, 0x49, 0x8a, 0x8a, 0x48,
+		0xdd, 0x2a, 0xa9, 0x9a, 0x13, 0x35, 0xe8, 0x34, 0x8c, 0x52, 0x8a, 0x5a, 0xbd, 0xea, 0x68, 0x66,
+		0x15, 0x17, 0xc9, 0x32, 0xcf, 0xce, 0x80, 0x5f, 0xb2, 0x11, 0x82, 0xb1, 0xcc, 0x11, 0x88, 0x44,
+		0x36, 0x9a, 0x87, 0x23, 0x94, 0xac, 0x82, 0x75, 0x6c, 0xa9, 0x0e, 0x2e, 0xe2, 0x17, 0xea, 0x6a,
+		0xd5, 0x2e, 0xaa, 0x7a, 0xb9, 0xb8, 0xad, 0xda, 0xdb, 0x99, 0x31, 0xc2, 0x20, 0x1f, 0xcb, 0x48,
+		0xca, 0x38, 0x41, 0x5c, 0xe0, 0x78, 0x05, 0x8a, 0x36, 0xab, 0x97, 0x2f, 0xa8, 0xf6, 0x36, 0xca,
+		0xc1, 0x01, 0xca, 0xc5, 0x76, 0x2c, 0x4d, 0xaf, 0x14, 0x4b, 0xdb, 0xb8, 0xb4, 0x53, 0xac, 0x3b,
+		0x5b, 0x8f, 0x66, 0x0e, 0xf9, 0xdb, 0xa7, 0x12, 0xae, 0x53, 0x9c, 0x39, 0x82, 0x72, 0xd9, 0xd9,
+		0x7a, 0x14, 0xad, 0xc3, 0x00, 0xe9, 0x8c, 0x9a, 0xf6, 0x22, 0x2e, 0x6e, 0x19, 0x16, 0x9d, 0x43,
+		0x87, 0x22, 0x42, 0x93, 0xcf, 0x82, 0xd3, 0xab, 0x9c, 0x60, 0xd9, 0x28, 0xe3, 0x5c, 0xcf, 0xfa,
+		0x5a, 0xa1, 0x30, 0xaf, 0xf4, 0x0b, 0x2e, 0xe7, 0x0d, 0x8b, 0x38, 0x54, 0xc5, 0x70, 0x0d, 0xdc,
+		0xcf, 0x1c, 0xaa, 0x62, 0x08, 0xf3, 0x9e, 0x86, 0xd1, 0x52, 0x89, 0xe9, 0xac, 0x95, 0x8a, 0x7c,
+		0x31, 0x66, 0x67, 0xd2, 0x01, 0x63, 0x95, 0x4a, 0x0b, 0x0c, 0x81, 0xfb, 0xb8, 0x8d, 0x1e, 0x83,
+		0xfd, 0x9e, 0xb1, 0xfc, 0x84, 0x23, 0x0d, 0x5a, 0x86, 0x49, 0x4f, 0xc3, 0xa8, 0xb9, 0xdb, 0x48,
+		0x88, 0x02, 0x2d, 0x9a, 0xbb, 0x61, 0xb2, 0xb3, 0x30, 0x66, 0x6e, 0x9b, 0x8d, 0x74, 0x27, 0xfc,
+		0x74, 0xc8, 0xdc, 0x36, 0xc3, 0x84, 0xf7, 0xd0, 0x95, 0xb9, 0x85, 0x4b, 0xaa, 0x83, 0xcb, 0x99,
+		0x83, 0x7e, 0x74, 0x5f, 0x05, 0x9a, 0x86, 0x74, 0xa9, 0x54, 0xc4, 0xba, 0xba, 0x59, 0xc5, 0x45,
+		0xd5, 0xc2, 0xba, 0x6a, 0x67, 0x26, 0x29, 0x72, 0xc2, 0xb1, 0xea, 0x58, 0x19, 0x2a, 0x95, 0x0a,
+		0xb4, 0x72, 0x96, 0xd6, 0xa1, 0x13, 0x30, 0x62, 0x6c, 0x3e, 0x5f, 0x62, 0x1e, 0x59, 0x34, 0x2d,
+		0xbc, 0xa5, 0x5d, 0xcf, 0x1c, 0xa5, 0xe6, 0x1d, 0x26, 0x15, 0xd4, 0x1f, 0xd7, 0x28, 0x18, 0xdd,
+		0x07, 0xe9, 0x92, 0xbd, 0xad, 0x5a, 0x26, 0x0d, 0xc9, 0xb6, 0xa9, 0x96, 0x70, 0xe6, 0x1e, 0x86,
+		0xca, 0xe0, 0x2b, 0x02, 0x4c, 0x46, 0x84, 0x7d, 0x4d, 0xdb, 0x72, 0x04, 0xc7, 0x7b, 0xd9, 0x88,
+		0xa0, 0x30, 0xce, 0xed, 0x38, 0xa4, 0x89, 0x25, 0x02, 0x0d, 0x1f, 0xa7, 0x68, 0x43, 0xe6, 0xb6,
+		0xe9, 0x6f, 0xf7, 0x6e, 0x18, 0x34, 0xb7, 0xfd, 0x8d, 0xde, 0xc7, 0x12, 0x37, 0x73, 0xdb, 0xd7,
+		0xe2, 0x23, 0x70, 0x80, 0x20, 0xd5, 0xb0, 0xa3, 0x96, 0x55, 0x47, 0xf5, 0x61, 0x3f, 0x40, 0xb1,
+		0x89, 0xd9, 0x97, 0x79, 0x65, 0x40, 0x4e, 0xab, 0xbe, 0xb9, 0xeb, 0x3a, 0xd6, 0x83, 0x4c, 0x4e,
+		0x02, 0x13, 0xae, 0x75, 0xc7, 0x92, 0x73, 0x39, 0x07, 0x03, 0x7e, 0xbf, 0x47, 0x29, 0x60, 0x9e,
+		0x9f, 0x96, 0x48, 0x12, 0x34, 0xb7, 0x3a, 0x4f, 0xd2, 0x97, 0xe7, 0x0a, 0xe9, 0x18, 0x49, 0xa3,
+		0x96, 0x16, 0x37, 0x0a, 0x45, 0xe5, 0xf2, 0xca, 0xc6, 0xe2, 0x72, 0x21, 0x1d, 0xf7, 0x25, 0xf6,
+		0x17, 0x13, 0xc9, 0x63, 0xe9, 0x7b, 0x49, 0xd6, 0x30, 0x14, 0x5c, 0xa9, 0xa1, 0xc7, 0xe1, 0xa0,
+		0xd8, 0x56, 0xb1, 0xb1, 0x53, 0xbc, 0xa6, 0x59, 0x74, 0x40, 0xd6, 0x54, 0x36, 0x39, 0xba, 0xfe,
+		0x33, 0xc6, 0xb1, 0xd6, 0xb1, 0xf3, 0x8c, 0x66, 0x91, 0xe1, 0x56, 0x53, 0x1d, 0xb4, 0x04, 0x93,
+		0xba, 0x51, 0xb4, 0x1d, 0x55, 0x2f, 0xab, 0x56, 0xb9, 0xe8, 0x6d, 0x68, 0x15, 0xd5, 0x52, 0x09,
+		0xdb, 0xb6, 0xc1, 0x26, 0x42, 0x97, 0xcb, 0x61, 0xdd, 0x58, 0xe7, 0xc8, 0xde, 0x0c, 0x31, 0xcb,
+		0x51, 0x43, 0xee, 0x1b, 0x6f, 0xe6, 0xbe, 0x87, 0x20, 0x55, 0x53, 0xcd, 0x22, 0xd6, 0x1d, 0x6b,
+		0x97, 0xe6, 0xe7, 0x49, 0x25, 0x59, 0x53, 0xcd, 0x02, 0x29, 0xff, 0x44, 0x96, 0x49, 0x17, 0x13,
+		0xc9, 0x44, 0xba, 0xe7, 0x62, 0x22, 0xd9, 0x93, 0xee, 0xbd, 0x98, 0x48, 0xf6, 0xa6, 0xfb, 0x2e,
+		0x26, 0x92, 0xc9, 0x74, 0xea, 0x62, 0x22, 0x99, 0x4a, 0x83, 0xfc, 0x4a, 0x1c, 0x06, 0xfc, 0x19,
+		0x3c, 0x59, 0x10, 0x95, 0xe8, 0x1c, 0x26, 0xd1, 0x28, 0x77, 0x77, 0xcb, 0x7c, 0x7f, 0x7a, 0x8e,
+		0x4c, 0x6e, 0xb9, 0x5e, 0x96, 0x2e, 0x2b, 0x8c, 0x92, 0x24, 0x16, 0xc4, 0xfd, 0x30, 0x4b, 0x4f,
+		0x92, 0x0a, 0x2f, 0xa1, 0x05, 0xe8, 0x7d, 0xde, 0xa6, 0xbc, 0x7b, 0x29, 0xef, 0xa3, 0xad, 0x79,
+		0x5f, 0x5c, 0xa7, 0xcc, 0x53, 0x17, 0xd7, 0x8b, 0x2b, 0xab, 0xca, 0xf2, 0xec, 0x92, 0xc2, 0xc9,
+		0xd1, 0x38, 0x24, 0xaa, 0xea, 0x8b, 0xbb, 0xc1, 0x69, 0x90, 0x82, 0x3a, 0xed, 0x96, 0x71, 0x48,
+		0x90, 0x2d, 0xbb, 0xe0, 0xe4, 0x43, 0x41, 0x77, 0x70, 0x78, 0xcc, 0x40, 0x0f, 0xb5, 0x17, 0x02,
+		0xe0, 0x16, 0x4b, 0xef, 0x43, 0x49, 0x48, 0xcc, 0xad, 0x2a, 0x64, 0x88, 0xa4, 0x61, 0x80, 0x41,
+		0x8b, 0x6b, 0x8b, 0x85, 0xb9, 0x42, 0x3a, 0x26, 0x9f, 0x86, 0x5e, 0x66, 0x04, 0x32, 0x7c, 0x5c,
+		0x33, 0xa4, 0xf7, 0xf1, 0x22, 0xe7, 0x21, 0x89, 0xda, 0xcb, 0xcb, 0xf9, 0x82, 0x92, 0x8e, 0x35,
+		0x74, 0xbe, 0x6c, 0xc3, 0x80, 0x3f, 0x33, 0xff, 0xc9, 0x2c, 0xcf, 0xbf, 0x2c, 0x41, 0xbf, 0x2f,
+		0xd3, 0x26, 0x29, 0x92, 0x5a, 0xad, 0x1a, 0xd7, 0x8a, 0x6a, 0x55, 0x53, 0x6d, 0xee, 0x1a, 0x40,
+		0x41, 0xb3, 0x04, 0xd2, 0x69, 0xd7, 0xfd, 0x84, 0x06, 0x4d, 0x4f, 0xba, 0x57, 0xfe, 0xb8, 0x04,
+		0xe9, 0x70, 0xaa, 0x1b, 0x12, 0x53, 0x7a, 0x3d, 0xc5, 0x94, 0x3f, 0x26, 0xc1, 0x50, 0x30, 0xbf,
+		0x0d, 0x89, 0x77, 0xd7, 0xeb, 0x2a, 0xde, 0x77, 0x62, 0x30, 0x18, 0xc8, 0x6a, 0x3b, 0x95, 0xee,
+		0x05, 0x18, 0xd1, 0xca, 0xb8, 0x66, 0x1a, 0x0e, 0xd9, 0x4e, 0x2f, 0x56, 0xf1, 0x55, 0x5c, 0xcd,
+		0xc8, 0x34, 0x68, 0xcc, 0xb4, 0xce, 0x9b, 0xa7, 0x17, 0x3d, 0xba, 0x25, 0x42, 0x96, 0x1b, 0x5d,
+		0x9c, 0x2f, 0x2c, 0xaf, 0xad, 0x6e, 0x14, 0x56, 0xe6, 0xde, 0x58, 0xbc, 0xbc, 0x72, 0x69, 0x65,
+		0xf5, 0x99, 0x15, 0x25, 0xad, 0x85, 0xd0, 0xee, 0xe0, 0xb0, 0x5f, 0x83, 0x74, 0x58, 0x28, 0x74,
+		0x10, 0xa2, 0xc4, 0x4a, 0xef, 0x43, 0xa3, 0x30, 0xbc, 0xb2, 0x5a, 0x5c, 0x5f, 0x9c, 0x2f, 0x14,
+		0x0b, 0xe7, 0xcf, 0x17, 0xe6, 0x36, 0xd6, 0xd9, 0x4e, 0x88, 0x8b, 0xbd, 0x11, 0x18, 0xe0, 0xf2,
+		0x47, 0xe2, 0x30, 0x1a, 0x21, 0x09, 0x9a, 0xe5, 0x6b, 0x18, 0xb6, 0xac, 0x7a, 0xb0, 0x13, 0xe9,
+		0xa7, 0x49, 0x16, 0xb1, 0xa6, 0x5a, 0x0e, 0x5f, 0xf2, 0xdc, 0x07, 0xc4, 0x4a, 0xba, 0xa3, 0x6d,
+		0x69, 0xd8, 0xe2, 0x3b, 0x4c, 0x6c, 0x61, 0x33, 0xec, 0xc1, 0xd9, 0x26, 0xd3, 0x03, 0x80, 0x4c,
+		0xc3, 0xd6, 0x1c, 0xed, 0x2a, 0xd9, 0xa4, 0x17, 0xdb, 0x51, 0x64, 0xa1, 0x93, 0x50, 0xd2, 0xa2,
+		0x66, 0x51, 0x77, 0x5c, 0x6c, 0x1d, 0x57, 0xd4, 0x10, 0x36, 0x09, 0xe6, 0x71, 0x25, 0x2d, 0x6a,
+		0x5c, 0xec, 0xbb, 0x60, 0xa0, 0x6c, 0xd4, 0x49, 0xf6, 0xc7, 0xf0, 0xc8, 0xdc, 0x21, 0x29, 0xfd,
+		0x0c, 0xe6, 0xa2, 0xf0, 0xbc, 0xde, 0xdb, 0x07, 0x1b, 0x50, 0xfa, 0x19, 0x8c, 0xa1, 0xdc, 0x0b,
+		0xc3, 0x6a, 0xa5, 0x62, 0x11, 0xe6, 0x82, 0x11, 0x5b, 0xa9, 0x0c, 0xb9, 0x60, 0x8a, 0x98, 0xbd,
+		0x08, 0x49, 0x61, 0x07, 0x32, 0x79, 0x13, 0x4b, 0x14, 0x4d, 0xb6, 0xfc, 0x8e, 0x91, 0xad, 0x31,
+		0x5d, 0x54, 0xde, 0x05, 0x03, 0x9a, 0x5d, 0xf4, 0xb6, 0xf5, 0x63, 0x53, 0xb1, 0xe3, 0x49, 0xa5,
+		0x5f, 0xb3, 0xdd, 0x2d, 0x51, 0xf9, 0x33, 0x31, 0x18, 0x0a, 0x1e, 0x4b, 0xa0, 0x79, 0x48, 0x56,
+		0x8d, 0x92, 0x4a, 0x5d, 0x8b, 0x9d, 0x89, 0x1d, 0x6f, 0x73, 0x92, 0x31, 0xbd, 0xc4, 0xf1, 0x15,
+		0x97, 0x32, 0xfb, 0x0d, 0x09, 0x92, 0x02, 0x8c, 0x0e, 0x40, 0xc2, 0x54, 0x9d, 0x6d, 0xca, 0xae,
+		0x27, 0x1f, 0x4b, 0x4b, 0x0a, 0x2d, 0x13, 0xb8, 0x6d, 0xaa, 0x7a, 0x26, 0xe6, 0xc1, 0x49, 0x99,
+		0xf4, 0x6b, 0x15, 0xab, 0x65, 0xba, 0x0c, 0x32, 0x6a, 0x35, 0xac, 0x3b, 0xb6, 0xe8, 0x57, 0x0e,
+		0x9f, 0xe3, 0x60, 0x72, 0x3a, 0xe6, 0x58, 0xaa, 0x56, 0x0d, 0xe0, 0x26, 0x28, 0x6e, 0x5a, 0x54,
+		0xb8, 0xc8, 0x39, 0x18, 0x17, 0x7c, 0xcb, 0xd8, 0x51, 0x4b, 0xdb, 0xb8, 0xec, 0x11, 0xf5, 0xd2,
+		0xed, 0x8e, 0x83, 0x1c, 0x61, 0x9e, 0xd7, 0x0b, 0x5a, 0xf9, 0x5b, 0x12, 0x8c, 0x88, 0x85, 0x5b,
+		0xd9, 0x35, 0xd6, 0x32, 0x80, 0xaa, 0xeb, 0x86, 0xe3, 0x37, 0x57, 0xa3, 0x2b, 0x37, 0xd0, 0x4d,
+		0xcf, 0xba, 0x44, 0x8a, 0x8f, 0x41, 0xb6, 0x06, 0xe0, 0xd5, 0x34, 0x35, 0xdb, 0x24, 0xf4, 0xf3,
+		0x33, 0x27, 0x7a, 0x70, 0xc9, 0x96, 0xfa, 0xc0, 0x40, 0x64, 0x85, 0x47, 0x36, 0x64, 0x36, 0x71,
+		0x45, 0xd3, 0xf9, 0x4e, 0x32, 0x2b, 0x88, 0x0d, 0x99, 0x84, 0xbb, 0x21, 0x93, 0xff, 0xab, 0x30,
+		0x5a, 0x32, 0x6a, 0x61, 0x71, 0xf3, 0xe9, 0xd0, 0x76, 0x83, 0x7d, 0x41, 0x7a, 0xee, 0x41, 0x8e,
+		0x54, 0x31, 0xaa, 0xaa, 0x5e, 0x99, 0x36, 0xac, 0x8a, 0x77, 0xf0, 0x4a, 0x32, 0x1e, 0xdb, 0x77,
+		0xfc, 0x6a, 0x6e, 0xfe, 0xb9, 0x24, 0x7d, 0x2a, 0x16, 0x5f, 0x58, 0xcb, 0x7f, 0x2e, 0x96, 0x5d,
+		0x60, 0x84, 0x6b, 0xc2, 0x18, 0x0a, 0xde, 0xaa, 0xe2, 0x12, 0x51, 0x10, 0xbe, 0x7f, 0x3f, 0x8c,
+		0x55, 0x8c, 0x8a, 0x41, 0x39, 0xcd, 0x90, 0xff, 0xf8, 0xc9, 0x6d, 0xca, 0x85, 0x66, 0xdb, 0x1e,
+		0xf3, 0xe6, 0x56, 0x60, 0x94, 0x23, 0x17, 0xe9, 0xd1, 0x11, 0x5b, 0xd8, 0xa0, 0x96, 0xbb, 0x6a,
+		0x99, 0xdf, 0xfc, 0x1e, 0x9d, 0xbe, 0x95, 0x11, 0x4e, 0x4a, 0xea, 0xd8, 0xda, 0x27, 0xa7, 0xc0,
+		0xfe, 0x00, 0x3f, 0x36, 0x48, 0xb1, 0xd5, 0x86, 0xe3, 0xef, 0x73, 0x8e, 0xa3, 0x3e, 0x8e, 0xeb,
+		0x9c, 0x34, 0x37, 0x07, 0x83, 0xdd, 0xf0, 0xfa, 0x67, 0x9c, 0xd7, 0x00, 0xf6, 0x33, 0x59, 0x80,
+		0x61, 0xca, 0xa4, 0x54, 0xb7, 0x1d, 0xa3, 0x46, 0x23, 0x60, 0x6b, 0x36, 0x7f, 0xf0, 0x3d, 0x36,
+		0x6a, 0x86, 0x08, 0xd9, 0x9c, 0x4b, 0x95, 0xcb, 0x01, 0x3d, 0x2d, 0x23, 0xa7, 0x58, 0x6d, 0x38,
+		0x7c, 0x95, 0x0b, 0xe2, 0xe2, 0xe7, 0xae, 0xc0, 0x18, 0xf9, 0x9f, 0x06, 0x28, 0xbf, 0x24, 0xed,
+		0xb7, 0xe0, 0x32, 0xdf, 0x7a, 0x07, 0x1b, 0x98, 0xa3, 0x2e, 0x03, 0x9f, 0x4c, 0xbe, 0x5e, 0xac,
+		0x60, 0xc7, 0xc1, 0x96, 0x5d, 0x54, 0xab, 0x51, 0xe2, 0xf9, 0xf6, 0x30, 0x32, 0x1f, 0xfe, 0x41,
+		0xb0, 0x17, 0x17, 0x18, 0xe5, 0x6c, 0xb5, 0x9a, 0xbb, 0x0c, 0x07, 0x23, 0xbc, 0xa2, 0x03, 0x9e,
+		0x1f, 0xe1, 0x3c, 0xc7, 0x1a, 0x3c, 0x83, 0xb0, 0x5d, 0x03, 0x01, 0x77, 0xfb, 0xb2, 0x03, 0x9e,
+		0x1f, 0xe5, 0x3c, 0x11, 0xa7, 0x15, 0x5d, 0x4a, 0x38, 0x5e, 0x84, 0x91, 0xab, 0xd8, 0xda, 0x34,
+		0x6c, 0xbe, 0x6f, 0xd4, 0x01, 0xbb, 0x8f, 0x71, 0x76, 0xc3, 0x9c, 0x90, 0x6e, 0x24, 0x11, 0x5e,
+		0x8f, 0x41, 0x72, 0x4b, 0x2d, 0xe1, 0x0e, 0x58, 0xdc, 0xe0, 0x2c, 0xfa, 0x08, 0x3e, 0x21, 0x9d,
+		0x85, 0x81, 0x8a, 0xc1, 0xe7, 0xa8, 0xf6, 0xe4, 0x1f, 0xe7, 0xe4, 0xfd, 0x82, 0x86, 0xb3, 0x30,
+		0x0d, 0xb3, 0x5e, 0x25, 0x13, 0x58, 0x7b, 0x16, 0x7f, 0x4b, 0xb0, 0x10, 0x34, 0x9c, 0x45, 0x17,
+		0x66, 0xfd, 0x84, 0x60, 0x61, 0xfb, 0xec, 0xf9, 0x14, 0x39, 0x4e, 0xaa, 0xee, 0x1a, 0x7a, 0x27,
+		0x42, 0x7c, 0x92, 0x73, 0x00, 0x4e, 0x42, 0x18, 0x9c, 0x83, 0x54, 0xa7, 0x1d, 0xf1, 0xb7, 0x7f,
+		0x20, 0x86, 0x87, 0xe8, 0x81, 0x05, 0x18, 0x16, 0x01, 0x8a, 0x1c, 0x3f, 0xb7, 0x67, 0xf1, 0x77,
+		0x38, 0x8b, 0x21, 0x1f, 0x19, 0x57, 0xc3, 0xc1, 0xb6, 0x53, 0xc1, 0x9d, 0x30, 0xf9, 0x8c, 0x50,
+		0x83, 0x93, 0x70, 0x53, 0x6e, 0x62, 0xbd, 0xb4, 0xdd, 0x19, 0x87, 0xcf, 0x0a, 0x53, 0x0a, 0x1a,
+		0xc2, 0x62, 0x0e, 0x06, 0x6b, 0xaa, 0x65, 0x6f, 0xab, 0xd5, 0x8e, 0xba, 0xe3, 0xef, 0x72, 0x1e,
+		0x03, 0x2e, 0x11, 0xb7, 0x48, 0x5d, 0xef, 0x86, 0xcd, 0xe7, 0x84, 0x45, 0xea, 0x7a, 0x80, 0xd1,
+		0x1a, 0x8c, 0xd9, 0x0e, 0xdd, 0x64, 0xeb, 0x86, 0xdb, 0xaf, 0x8a, 0xa1, 0xc7, 0x68, 0x97, 0xfd,
+		0x1c, 0xcf, 0x41, 0xca, 0xd6, 0x5e, 0xec, 0x88, 0xcd, 0xe7, 0x45, 0x4f, 0x53, 0x02, 0x42, 0xfc,
+		0x46, 0x18, 0x8f, 0x9c, 0x26, 0x3a, 0x60, 0xf6, 0x6b, 0x9c, 0xd9, 0x81, 0x88, 0xa9, 0x82, 0x87,
+		0x84, 0x6e, 0x59, 0xfe, 0x3d, 0x11, 0x12, 0x70, 0x88, 0xd7, 0x1a, 0x59, 0x35, 0xd8, 0xea, 0x56,
+		0x77, 0x56, 0xfb, 0x75, 0x61, 0x35, 0x46, 0x1b, 0xb0, 0xda, 0x06, 0x1c, 0xe0, 0x1c, 0xbb, 0xeb,
+		0xd7, 0xdf, 0x10, 0x81, 0x95, 0x51, 0x5f, 0x0e, 0xf6, 0xee, 0x9b, 0x20, 0xeb, 0x9a, 0x53, 0xa4,
+		0xa7, 0x76, 0x91, 0xec, 0x4c, 0xb5, 0xe7, 0xfc, 0x9b, 0x9c, 0xb3, 0x88, 0xf8, 0x6e, 0x7e, 0x6b,
+		0x2f, 0xab, 0x26, 0x61, 0xfe, 0x2c, 0x64, 0x04, 0xf3, 0xba, 0x6e, 0xe1, 0x92, 0x51, 0xd1, 0xb5,
+		0x17, 0x71, 0xb9, 0x03, 0xd6, 0xbf, 0x15, 0xea, 0xaa, 0xcb, 0x3e, 0x72, 0xc2, 0x79, 0x11, 0xd2,
+		0x6e, 0xae, 0x52, 0xd4, 0x6a, 0xa6, 0x61, 0x39, 0x6d, 0x38, 0x7e, 0x41, 0xf4, 0x94, 0x4b, 0xb7,
+		0x48, 0xc9, 0x72, 0x05, 0x60, 0x27, 0xcf, 0x9d, 0xba, 0xe4, 0x17, 0x39, 0xa3, 0x41, 0x8f, 0x8a,
+		0x07, 0x8e, 0x92, 0x51, 0x33, 0x55, 0xab, 0x93, 0xf8, 0xf7, 0xf7, 0x45, 0xe0, 0xe0, 0x24, 0x3c,
+		0x70, 0x90, 0x8c, 0x8e, 0xcc, 0xf6, 0x1d, 0x70, 0xf8, 0x92, 0x08, 0x1c, 0x82, 0x86, 0xb3, 0x10,
+		0x09, 0x43, 0x07, 0x2c, 0x7e, 0x5b, 0xb0, 0x10, 0x34, 0x84, 0xc5, 0xd3, 0xde, 0x44, 0x6b, 0xe1,
+		0x8a, 0x66, 0x3b, 0x16, 0x4b, 0x8a, 0x5b, 0xb3, 0xfa, 0x07, 0x3f, 0x08, 0x26, 0x61, 0x8a, 0x8f,
+		0x94, 0x44, 0x22, 0xbe, 0xed, 0x4a, 0xd7, 0x4c, 0xed, 0x05, 0xfb, 0x1d, 0x11, 0x89, 0x7c, 0x64,
+		0x44, 0x36, 0x5f, 0x86, 0x48, 0xcc, 0x5e, 0x22, 0x2b, 0x85, 0x0e, 0xd8, 0xfd, 0xc3, 0x90, 0x70,
+		0xeb, 0x82, 0x96, 0xf0, 0xf4, 0xe5, 0x3f, 0x75, 0x7d, 0x07, 0xef, 0x76, 0xe4, 0x9d, 0xff, 0x28,
+		0x94, 0xff, 0x5c, 0x66, 0x94, 0x2c, 0x86, 0x0c, 0x87, 0xf2, 0x29, 0xd4, 0xee, 0x9e, 0x51, 0xe6,
+		0xe7, 0x7f, 0xc4, 0xf5, 0x0d, 0xa6, 0x53, 0xb9, 0x25, 0x48, 0x73, 0x88, 0x97, 0xc0, 0xb6, 0x65,
+		0xf6, 0x8e, 0x1f, 0xb9, 0x7e, 0x1e, 0xc8, 0x79, 0x72, 0xe7, 0x61, 0x30, 0x90, 0xf0, 0xb4, 0x67,
+		0xf5, 0x4e, 0xce, 0x6a, 0xc0, 0x9f, 0xef, 0xe4, 0x4e, 0x43, 0x82, 0x24, 0x2f, 0xed, 0xc9, 0xff,
+		0x1a, 0x27, 0xa7, 0xe8, 0xb9, 0x27, 0x20, 0x29, 0x92, 0x96, 0xf6, 0xa4, 0xef, 0xe2, 0xa4, 0x2e,
+		0x09, 0x21, 0x17, 0x09, 0x4b, 0x7b, 0xf2, 0xbf, 0x2e, 0xc8, 0x05, 0x09, 0x21, 0xef, 0xdc, 0x84,
+		0x5f, 0xfe, 0x85, 0x04, 0x23, 0x17, 0x24, 0x39, 0x72, 0xf2, 0xcd, 0x32, 0x95, 0xf6, 0xd4, 0xef,
+		0xe1, 0x8d, 0x0b, 0x8a, 0xdc, 0x59, 0xe8, 0xe9, 0xd0, 0xe0, 0xef, 0xe5, 0xa4, 0x0c, 0x3f, 0x37,
+		0x07, 0xfd, 0xbe, 0xec, 0xa4, 0x3d, 0xf9, 0x2f, 0x72, 0x72, 0x3f, 0x15, 0x11, 0x9d, 0x67, 0x27,
+		0xed, 0x19, 0xbc, 0x4f, 0x88, 0xce, 0x29, 0x88, 0xd9, 0x44, 0x62, 0xd2, 0x9e, 0xfa, 0xfd, 0xc2,
+		0xea, 0x82, 0x24, 0xf7, 0x14, 0xa4, 0xdc, 0xc9, 0xa6, 0x3d, 0xfd, 0x07, 0x38, 0xbd, 0x47, 0x43,
+		0x2c, 0x50, 0xd7, 0xbb, 0x60, 0xf1, 0x37, 0x84, 0x05, 0x7c, 0x54, 0x64, 0x18, 0x85, 0x13, 0x98,
+		0xf6, 0x9c, 0x3e, 0x28, 0x86, 0x51, 0x28, 0x7f, 0x21, 0xbd, 0x49, 0x63, 0x7e, 0x7b, 0x16, 0x7f,
+		0x53, 0xf4, 0x26, 0xc5, 0x27, 0x62, 0x84, 0x33, 0x82, 0xf6, 0x3c, 0x7e, 0x59, 0x88, 0x11, 0x4a,
+		0x08, 0x72, 0x6b, 0x80, 0x1a, 0xb3, 0x81, 0xf6, 0xfc, 0x3e, 0xc4, 0xf9, 0x8d, 0x34, 0x24, 0x03,
+		0xb9, 0x67, 0xe0, 0x40, 0x74, 0x26, 0xd0, 0x9e, 0xeb, 0x87, 0x7f, 0x14, 0x5a, 0xbb, 0xf9, 0x13,
+		0x81, 0xdc, 0x06, 0x8c, 0x45, 0x65, 0x01, 0xed, 0xd9, 0x7e, 0xe4, 0x47, 0xc1, 0xc0, 0xed, 0x4f,
+		0x02, 0x72, 0xb3, 0x00, 0xde, 0x04, 0xdc, 0x9e, 0xd7, 0xc7, 0x38, 0x2f, 0x1f, 0x11, 0x19, 0x1a,
+		0x7c, 0xfe, 0x6d, 0x4f, 0x7f, 0x43, 0x0c, 0x0d, 0x4e, 0x41, 0x86, 0x86, 0x98, 0x7a, 0xdb, 0x53,
+		0x7f, 0x5c, 0x0c, 0x0d, 0x41, 0x42, 0x3c, 0xdb, 0x37, 0xbb, 0xb5, 0xe7, 0xf0, 0x49, 0xe1, 0xd9,
+		0x3e, 0xaa, 0xdc, 0x0a, 0x8c, 0x34, 0x4c, 0x88, 0xed, 0x59, 0x7d, 0x8a, 0xb3, 0x4a, 0x87, 0xe7,
+		0x43, 0xff, 0xe4, 0xc5, 0x27, 0xc3, 0xf6, 0xdc, 0x3e, 0x1d, 0x9a, 0xbc, 0xf8, 0x5c, 0x98, 0x3b,
+		0x07, 0x49, 0xbd, 0x5e, 0xad, 0x92, 0xc1, 0x83, 0x5a, 0xdf, 0x0d, 0xcc, 0xfc, 0xe7, 0x1f, 0x73,
+		0xeb, 0x08, 0x82, 0xdc, 0x69, 0xe8, 0xc1, 0xb5, 0x4d, 0x5c, 0x6e, 0x47, 0xf9, 0xfd, 0x1f, 0x8b,
+		0x80, 0x49, 0xb0, 0x73, 0x4f, 0x01, 0xb0, 0xad, 0x11, 0x7a, 0x18, 0xd8, 0x86, 0xf6, 0xbf, 0xfc,
+		0x98, 0x5f, 0xc6, 0xf1, 0x48, 0x3c, 0x06, 0xec, 0x6a, 0x4f, 0x6b, 0x06, 0x3f, 0x08, 0x32, 0xa0,
+		0x3d, 0xf2, 0x18, 0xf4, 0x91, 0x2b, 0x92, 0x8e, 0x5a, 0x69, 0x47, 0xfd, 0x5f, 0x39, 0xb5, 0xc0,
+		0x27, 0x06, 0xab, 0x19, 0x16, 0x76, 0xd4, 0x8a, 0xdd, 0x8e, 0xf6, 0xbf, 0x71, 0x5a, 0x97, 0x80,
+		0x10, 0x97, 0x54, 0xdb, 0xe9, 0x44, 0xef, 0x3f, 0x13, 0xc4, 0x82, 0x80, 0x08, 0x4d, 0xfe, 0xdf,
+		0xc1, 0xbb, 0xed, 0x68, 0x7f, 0x28, 0x84, 0xe6, 0xf8, 0xb9, 0x27, 0x20, 0x45, 0xfe, 0x65, 0x37,
+		0xec, 0xda, 0x10, 0xff, 0x77, 0x4e, 0xec, 0x51, 0x90, 0x96, 0x6d, 0xa7, 0xec, 0x68, 0xed, 0x8d,
+		0x7d, 0x8b, 0xf7, 0xb4, 0xc0, 0xcf, 0xcd, 0x42, 0xbf, 0xed, 0x94, 0xcb, 0x75, 0x9e, 0x9f, 0xb6,
+		0x21, 0xff, 0x1f, 0x3f, 0x76, 0xb7, 0x2c, 0x5c, 0x1a, 0xd2, 0xdb, 0xd7, 0x76, 0x1c, 0xd3, 0xa0,
+		0x07, 0x1e, 0xed, 0x38, 0xfc, 0x88, 0x73, 0xf0, 0x91, 0xe4, 0xe6, 0x60, 0x80, 0xe8, 0x62, 0x61,
+		0x13, 0xd3, 0xd3, 0xa9, 0x36, 0x2c, 0xfe, 0x27, 0x37, 0x40, 0x80, 0x28, 0xff, 0x73, 0x5f, 0x7d,
+		0x65, 0x42, 0xfa, 0xe6, 0x2b, 0x13, 0xd2, 0x77, 0x5e, 0x99, 0x90, 0xde, 0xff, 0xdd, 0x89, 0x7d,
+		0xdf, 0xfc, 0xee, 0xc4, 0xbe, 0x3f, 0xfe, 0xee, 0xc4, 0xbe, 0xe8, 0x5d, 0x62, 0x58, 0x30, 0x16,
+		0x0c, 0xb6, 0x3f, 0xfc, 0x9c, 0x5c, 0xd1, 0x9c, 0xed, 0xfa, 0xe6, 0x74, 0xc9, 0xa8, 0xd1, 0x6d,
+		0x5c, 0x6f, 0xb7, 0xd6, 0x5d, 0xe4, 0xc0, 0xdb, 0xe3, 0x30, 0x5e, 0x32, 0xec, 0x9a, 0x61, 0x17,
+		0xd9, 0x7e, 0x2f, 0x2b, 0x30, 0x86, 0x68, 0xc0, 0x5f, 0xd5, 0xc1, 0xa6, 0xef, 0x05, 0x18, 0xa2,
+		0xaa, 0xd3, 0xed, 0x2e, 0xea, 0x6d, 0x6d, 0x03, 0xc4, 0xd7, 0xfe, 0x75, 0x0f, 0xd5, 0x7a, 0xd0,
+		0x25, 0xa4, 0xa7, 0xf7, 0x1b, 0x30, 0xa6, 0xd5, 0xcc, 0x2a, 0xa6, 0xdb, 0xfc, 0x45, 0xb7, 0xae,
+		0x3d, 0xbf, 0xaf, 0x73, 0x7e, 0xa3, 0x1e, 0xf9, 0xa2, 0xa0, 0xce, 0x2d, 0xc1, 0x08, 0xb9, 0xb3,
+		0x61, 0x06, 0x58, 0xb6, 0xe9, 0x16, 0x21, 0x60, 0x9a, 0x53, 0xba, 0xdc, 0xf2, 0x4f, 0x35, 0xeb,
+		0x9a, 0xe7, 0xee, 0xf1, 0x59, 0xde, 0xc2, 0x15, 0xac, 0x3f, 0xa8, 0x63, 0xe7, 0x9a, 0x61, 0xed,
+		0x70, 0xf3, 0x3e, 0xc8, 0x9a, 0xea, 0xa5, 0x7f, 0x4e, 0xc1, 0x3b, 0xe3, 0x30, 0xc1, 0x2a, 0x66,
+		0x36, 0x55, 0x1b, 0xcf, 0x5c, 0x7d, 0x78, 0x13, 0x3b, 0xea, 0xc3, 0x33, 0x25, 0x43, 0xd3, 0x79,
+		0x4f, 0x8c, 0xf2, 0x7e, 0x21, 0xf5, 0xd3, 0xbc, 0x3e, 0x1b, 0xb9, 0x4d, 0x2f, 0x2f, 0x40, 0x62,
+		0xce, 0xd0, 0x74, 0x72, 0xde, 0x50, 0xc6, 0xba, 0x51, 0xe3, 0xb7, 0xf0, 0x58, 0x01, 0xdd, 0x0d,
+		0xbd, 0x6a, 0xcd, 0xa8, 0xeb, 0x0e, 0x3b, 0xa1, 0xc8, 0xf7, 0x7f, 0xf5, 0xe6, 0xe4, 0xbe, 0x3f,
+		0xb9, 0x39, 0x19, 0x5f, 0xd4, 0x1d, 0x85, 0x57, 0xe5, 0x12, 0xaf, 0x7e, 0x62, 0x52, 0x92, 0x2f,
+		0x42, 0xdf, 0x3c, 0x2e, 0xed, 0x85, 0xd7, 0x3c, 0x2e, 0x85, 0x78, 0xdd, 0x07, 0xc9, 0x45, 0xdd,
+		0x61, 0xf7, 0x24, 0x8f, 0x40, 0x5c, 0xd3, 0xd9, 0xd5, 0x9b, 0x50, 0xfb, 0x04, 0x4e, 0x50, 0xe7,
+		0x71, 0xc9, 0x45, 0x2d, 0xe3, 0x52, 0x46, 0x6a, 0x64, 0x4f, 0xe0, 0xf9, 0xf9, 0x3f, 0xfe, 0x8f,
+		0x13, 0xfb, 0x5e, 0x7a, 0x65, 0x62, 0x5f, 0xd3, 0x9e, 0xf0, 0x8f, 0x01, 0x6e, 0x62, 0xde, 0x05,
+		0x76, 0x79, 0x87, 0x9d, 0x91, 0xb8, 0xdd, 0xf0, 0x47, 0xbd, 0x20, 0x73, 0x1c, 0xdb, 0x51, 0x77,
+		0x34, 0xbd, 0xe2, 0xf6, 0x84, 0x5a, 0x77, 0xb6, 0x5f, 0xe4, 0x5d, 0x71, 0x80, 0x77, 0x05, 0xc7,
+		0x69, 0xdd, 0x1b, 0xd9, 0xe6, 0xa3, 0x2b, 0xdb, 0xa6, 0xcf, 0xe5, 0x7f, 0x1e, 0x07, 0xb4, 0xee,
+		0xa8, 0x3b, 0x78, 0xb6, 0xee, 0x6c, 0x1b, 0x96, 0xf6, 0x22, 0x8b, 0x65, 0x18, 0xa0, 0xa6, 0x5e,
+		0x2f, 0x3a, 0xc6, 0x0e, 0xd6, 0x6d, 0x6a, 0x9a, 0xfe, 0x93, 0xe3, 0xd3, 0x11, 0xfe, 0x31, 0x4d,
+		0xba, 0x2e, 0x7f, 0xff, 0xe7, 0xbe, 0x3d, 0x79, 0x6f, 0x7b, 0x2b, 0x50, 0x64, 0x92, 0x5c, 0x5f,
+		0xdf, 0xa0, 0x8c, 0xd1, 0x15, 0x60, 0x97, 0x2c, 0x8a, 0x55, 0xcd, 0x76, 0xf8, 0xcd, 0xed, 0xd3,
+		0xd3, 0xd1, 0xba, 0x4f, 0x37, 0x8a, 0x39, 0x7d, 0x45, 0xad, 0x6a, 0x65, 0xd5, 0x31, 0x2c, 0xfb,
+		0xc2, 0x3e, 0x25, 0x45, 0x59, 0x2d, 0x69, 0xb6, 0x83, 0x36, 0x20, 0x55, 0xc6, 0xfa, 0x2e, 0x63,
+		0x1b, 0x7f, 0x6d, 0x6c, 0x93, 0x84, 0x13, 0xe5, 0xfa, 0x2c, 0x20, 0xd5, 0x8f, 0x27, 0x9e, 0x2a,
+		0xb1, 0x1b, 0x97, 0x4d, 0xd8, 0x07, 0x38, 0xd3, 0x97, 0x15, 0x23, 0x6a, 0x18, 0x94, 0x3d, 0x06,
+		0xe0, 0xb5, 0x49, 0x5e, 0x0c, 0xaa, 0xe5, 0xb2, 0x85, 0x6d, 0x9b, 0x1e, 0x00, 0xa6, 0x14, 0x51,
+		0xcc, 0x8d, 0xfc, 0x8b, 0x2f, 0x3e, 0x38, 0x18, 0xe0, 0x98, 0x1f, 0x00, 0xb8, 0xea, 0x92, 0x9e,
+		0xf8, 0xb8, 0x04, 0x23, 0x0d, 0x2d, 0x22, 0x19, 0x26, 0x66, 0x2f, 0x6f, 0x5c, 0x58, 0x55, 0x16,
+		0x9f, 0x9b, 0x25, 0xd7, 0xf0, 0x8b, 0xec, 0x11, 0xc0, 0xca, 0xfa, 0x5a, 0x61, 0x6e, 0xf1, 0xfc,
+		0x62, 0x61, 0x3e, 0xbd, 0x0f, 0x4d, 0xc2, 0xa1, 0x08, 0x9c, 0xf9, 0xc2, 0x52, 0x61, 0x61, 0x76,
+		0x83, 0x3c, 0x79, 0xb8, 0x0b, 0x8e, 0x44, 0x32, 0x71, 0x51, 0x62, 0x4d, 0x50, 0x94, 0x82, 0x8b,
+		0x12, 0xcf, 0x9f, 0x6f, 0x3a, 0x8a, 0x1e, 0x68, 0xe9, 0x3f, 0xd7, 0xdd, 0xe1, 0x12, 0x1c, 0x4f,
+		0xff, 0x47, 0x82, 0x71, 0x16, 0x5a, 0xbd, 0x29, 0x43, 0xd5, 0x77, 0x9b, 0xbd, 0x03, 0x3d, 0x03,
+		0xf1, 0x59, 0x7d, 0x17, 0x8d, 0xb3, 0xcc, 0xb9, 0x58, 0xb7, 0xaa, 0x3c, 0xda, 0xf4, 0x91, 0xf2,
+		0x65, 0xab, 0x4a, 0xa2, 0x90, 0xb8, 0xe4, 0x4f, 0x0e, 0xea, 0x59, 0x21, 0xff, 0x8b, 0x52, 0x77,
+		0x53, 0x64, 0x72, 0x56, 0xdf, 0xa5, 0xd1, 0x65, 0x4d, 0x7a, 0xee, 0x81, 0xb6, 0x07, 0xa8, 0x3b,
+		0xba, 0x71, 0x4d, 0x27, 0x62, 0x9b, 0x9b, 0xe2, 0xf0, 0x74, 0x22, 0x7c, 0x78, 0xfa, 0x0c, 0xae,
+		0x56, 0x2f, 0x11, 0xbc, 0x8d, 0x80, 0xfe, 0x1f, 0x8c, 0xc1, 0x44, 0xc3, 0x94, 0xc9, 0xb3, 0x8b,
+		0x66, 0x46, 0xc8, 0x41, 0x72, 0x9e, 0xa3, 0x10, 0x5f, 0xb3, 0x71, 0xc9, 0xd0, 0xcb, 0x6c, 0x94,
+		0xc7, 0x15, 0x51, 0x24, 0x86, 0xd0, 0x55, 0xdd, 0xb0, 0xf9, 0x0d, 0x7c, 0x56, 0xc8, 0x7f, 0xb4,
+		0x4b, 0x43, 0x0c, 0x8a, 0x96, 0x84, 0x35, 0x1e, 0xee, 0xd0, 0x1a, 0x42, 0x89, 0xc0, 0x91, 0x72,
+		0xa7, 0x56, 0xf9, 0xe5, 0x18, 0x4c, 0x86, 0xad, 0x42, 0x52, 0x36, 0xdb, 0x51, 0x6b, 0x66, 0x33,
+		0xb3, 0x9c, 0x83, 0xd4, 0x86, 0xc0, 0xe9, 0xda, 0x2e, 0x37, 0xba, 0xb4, 0xcb, 0x90, 0xdb, 0x94,
+		0x30, 0xcc, 0xc9, 0x0e, 0x0d, 0xe3, 0xea, 0xb1, 0x27, 0xcb, 0x7c, 0x2e, 0x01, 0x47, 0xe8, 0x13,
+		0x2d, 0xab, 0xa6, 0xe9, 0xce, 0x4c, 0xc9, 0xda, 0x35, 0x1d, 0x9a, 0xb4, 0x19, 0x5b, 0xdc, 0x2e,
+		0x23, 0x5e, 0xf5, 0x34, 0xab, 0x6e, 0x92, 0x03, 0x6c, 0x41, 0xcf, 0x1a, 0xa1, 0x23, 0x16, 0x71,
+		0x0c, 0x47, 0xad, 0x72, 0x4b, 0xb1, 0x02, 0x81, 0xb2, 0x67, 0x5d, 0x31, 0x06, 0xd5, 0xc4, 0x8b,
+		0xae, 0x2a, 0x56, 0xb7, 0xd8, 0xed, 0xf8, 0x38, 0x1d, 0x62, 0x49, 0x02, 0xa0, 0x17, 0xe1, 0xc7,
+		0xa0, 0x47, 0xad, 0xb3, 0x6b, 0x1c, 0x71, 0x32, 0xf6, 0x68, 0x41, 0xbe, 0x04, 0x7d, 0xfc, 0x30,
+		0x99, 0x5c, 0x64, 0xd8, 0xc1, 0xbb, 0xb4, 0x9d, 0x01, 0x85, 0xfc, 0x8b, 0xa6, 0xa1, 0x87, 0x0a,
+		0xcf, 0x27, 0x8f, 0xcc, 0x74, 0x83, 0xf4, 0xd3, 0x54, 0x48, 0x85, 0xa1, 0xc9, 0x17, 0x21, 0x39,
+		0x6f, 0xd4, 0x34, 0xdd, 0x08, 0x72, 0x4b, 0x31, 0x6e, 0x54, 0x66, 0xb3, 0xce, 0x73, 0x0d, 0x85,
+		0x15, 0xc8, 0x9d, 0x51, 0xf6, 0x5a, 0x82, 0x5f, 0x45, 0xe1, 0x25, 0x79, 0x0e, 0xfa, 0x28, 0xef,
+		0x55, 0x93, 0x3c, 0xcb, 0x70, 0x2f, 0xa6, 0xa6, 0xf8, 0xdb, 0x39, 0xce, 0x3e, 0xe6, 0x09, 0x8b,
+		0x20, 0x51, 0x56, 0x1d, 0x95, 0xeb, 0x4d, 0xff, 0x97, 0x9f, 0x84, 0x24, 0x67, 0x62, 0xa3, 0x93,
+		0x10, 0x37, 0x4c, 0x9b, 0x5f, 0x26, 0xc9, 0x36, 0x53, 0x65, 0xd5, 0xcc, 0x27, 0x48, 0x96, 0xa2,
+		0x10, 0xe4, 0xbc, 0xd2, 0x34, 0xa0, 0x3e, 0xea, 0x0b, 0xa8, 0xbe, 0x2e, 0xf7, 0xfd, 0xcb, 0xba,
+		0xb4, 0xc1, 0x1d, 0x5c, 0x67, 0xf9, 0x64, 0x0c, 0x26, 0x7c, 0xb5, 0x57, 0xb1, 0x45, 0x76, 0x54,
+		0xf8, 0x5c, 0xce, 0xbc, 0x05, 0xf9, 0x84, 0xe4, 0xf5, 0x4d, 0xdc, 0xe5, 0x09, 0x88, 0xcf, 0x9a,
+		0x26, 0x79, 0x34, 0x48, 0xcb, 0x25, 0x83, 0xf9, 0x4b, 0x42, 0x71, 0xcb, 0xa4, 0xce, 0x36, 0xb6,
+		0x9c, 0x6b, 0xaa, 0xe5, 0x3e, 0x28, 0x14, 0x65, 0xf9, 0x31, 0x48, 0xcd, 0x19, 0xba, 0x8d, 0x75,
+		0xbb, 0x4e, 0xc7, 0xe0, 0x66, 0xd5, 0x28, 0xed, 0x70, 0x0e, 0xac, 0x40, 0x0c, 0xae, 0x9a, 0x26,
+		0xa5, 0x4c, 0x28, 0xe4, 0x5f, 0x96, 0x17, 0xe6, 0xd7, 0x9b, 0x9a, 0xe8, 0xb1, 0xee, 0x4d, 0xc4,
+		0x95, 0xf4, 0x4f, 0x40, 0x87, 0x1b, 0x07, 0xd4, 0x0e, 0xde, 0xb5, 0xbb, 0x1d, 0x4f, 0xcf, 0x42,
+		0x6a, 0x8d, 0xbe, 0xea, 0xbf, 0x84, 0x77, 0x51, 0x16, 0xfa, 0x70, 0xf9, 0xe4, 0xe9, 0xd3, 0x0f,
+		0x3f, 0xc6, 0xbc, 0xfd, 0xc2, 0x3e, 0x45, 0x00, 0xd0, 0x04, 0xa4, 0x6c, 0x5c, 0x32, 0x4f, 0x9e,
+		0x3e, 0xb3, 0xf3, 0x30, 0x73, 0x2f, 0x92, 0xfd, 0xb8, 0xa0, 0x5c, 0x92, 0x68, 0xfd, 0xea, 0x27,
+		0x27, 0xa5, 0x7c, 0x0f, 0xc4, 0xed, 0x7a, 0xed, 0x8e, 0xfa, 0xc8, 0x47, 0x7a, 0x60, 0xca, 0x4f,
+		0x49, 0x23, 0x95, 0x9b, 0x91, 0x70, 0x1b, 0xa4, 0x7d, 0x36, 0xa0, 0x18, 0x4d, 0x12, 0xd9, 0x96,
+		0x96, 0x94, 0x7f, 0x4b, 0x82, 0x01, 0x37, 0x4d, 0x22, 0x1f, 0x70, 0x38, 0xe7, 0xcf, 0x7d, 0xf8,
+		0xb0, 0x39, 0x34, 0x1d, 0x6e, 0xcb, 0x4b, 0xe7, 0x14, 0x1f, 0x3a, 0x3a, 0x4b, 0x1d, 0xd1, 0x34,
+		0x6c, 0xfe, 0xc8, 0xac, 0x0d, 0xa9, 0x8b, 0x4c, 0xae, 0x08, 0xd2, 0x08, 0x57, 0xbc, 0x6a, 0x38,
+		0xe4, 0xce, 0x84, 0x69, 0x5c, 0xe3, 0x4f, 0x77, 0xe3, 0x4a, 0x9a, 0xd6, 0x5c, 0xa1, 0x15, 0x6b,
+		0x04, 0x4e, 0x84, 0x4e, 0xb9, 0x5c, 0x82, 0xa9, 0x1d, 0x09, 0x02, 0xa2, 0x48, 0x5e, 0xb6, 0x99,
+		0xf5, 0xcd, 0xa2, 0x88, 0x18, 0xe4, 0x6d, 0x60, 0xc4, 0xf8, 0x17, 0xfe, 0xc1, 0x23, 0x40, 0xaf,
+		0x59, 0xdf, 0x24, 0xde, 0x72, 0x17, 0x0c, 0x44, 0x08, 0xd3, 0x7f, 0xd5, 0x93, 0x83, 0x7e, 0x4c,
+		0x82, 0x6b, 0x50, 0x34, 0x2d, 0xcd, 0xb0, 0x34, 0x67, 0x97, 0xe6, 0xae, 0x71, 0x25, 0x2d, 0x2a,
+		0xd6, 0x38, 0x5c, 0xde, 0x81, 0xe1, 0x75, 0xba, 0xb6, 0xf5, 0x24, 0x3f, 0xed, 0xc9, 0x27, 0xb5,
+		0x97, 0xaf, 0xa9, 0x64, 0xb1, 0x06, 0xc9, 0xf2, 0x4f, 0x37, 0xf5, 0xce, 0xb3, 0xdd, 0x7b, 0x67,
+		0x30, 0x3b, 0xfc, 0xb3, 0x71, 0x38, 0x1c, 0xae, 0x0c, 0x84, 0xaf, 0x4e, 0x1d, 0xb3, 0x5d, 0x36,
+		0x91, 0x6d, 0x3d, 0xa9, 0x66, 0xdb, 0x84, 0xd1, 0x6c, 0xdb, 0x21, 0x24, 0x3f, 0x06, 0x83, 0xe4,
+		0x6a, 0xe7, 0x3a, 0x76, 0x2e, 0x60, 0xb5, 0x8c, 0xad, 0xe0, 0xac, 0x3b, 0x28, 0x66, 0x5d, 0x04,
+		0x09, 0x3a, 0xb5, 0xb2, 0x59, 0x87, 0xfe, 0x2f, 0x6f, 0x43, 0x82, 0x90, 0x7a, 0x33, 0x32, 0xa7,
+		0xa0, 0x05, 0x02, 0xdd, 0xdc, 0x75, 0xb0, 0x2d, 0x12, 0x5e, 0x5a, 0x40, 0x8f, 0x88, 0x79, 0x35,
+		0xde, 0x7a, 0x5e, 0xe5, 0x8e, 0xc8, 0x67, 0xd7, 0x2a, 0xf4, 0xe5, 0x49, 0x28, 0x5e, 0x9c, 0x77,
+		0x05, 0x91, 0x3c, 0x41, 0xd0, 0x32, 0x0c, 0x9b, 0xaa, 0xe5, 0xd0, 0x07, 0x32, 0xdb, 0x54, 0x0b,
+		0xee, 0xeb, 0x93, 0x8d, 0x23, 0x2f, 0xa0, 0x2c, 0x6f, 0x65, 0xd0, 0xf4, 0x03, 0xe5, 0x3f, 0x4d,
+		0x40, 0x2f, 0x37, 0xc6, 0x13, 0xd0, 0xc7, 0xcd, 0xca, 0xbd, 0xf3, 0xc8, 0x74, 0xe3, 0xc4, 0x34,
+		0xed, 0x4e, 0x20, 0x9c, 0x9f, 0xa0, 0x41, 0xc7, 0x20, 0x59, 0xda, 0x56, 0x35, 0xbd, 0xa8, 0x95,
+		0xc5, 0x36, 0xc3, 0x2b, 0x37, 0x27, 0xfb, 0xe6, 0x08, 0x6c, 0x71, 0x5e, 0xe9, 0xa3, 0x95, 0x8b,
+		0x65, 0x92, 0x09, 0x6c, 0x63, 0xad, 0xb2, 0xed, 0xf0, 0x11, 0xc6, 0x4b, 0xe4, 0x4b, 0x32, 0xc4,
+		0x21, 0xf8, 0xf3, 0xc9, 0x6c, 0xc3, 0x66, 0x8f, 0x9b, 0xec, 0xe5, 0x93, 0xa4, 0xe1, 0xf7, 0x7f,
+		0x7b, 0x52, 0x52, 0x28, 0x05, 0x9a, 0x83, 0xc1, 0xaa, 0x6a, 0x3b, 0x45, 0x3a, 0x83, 0x91, 0xe6,
+		0x7b, 0xf8, 0x5a, 0xbb, 0xc1, 0x20, 0xdc, 0xb0, 0x5c, 0xf4, 0x7e, 0x42, 0xc5, 0x40, 0x65, 0xf2,
+		0xba, 0x8b, 0x32, 0x21, 0x37, 0x5a, 0x35, 0x87, 0xe5, 0x56, 0xbd, 0xd4, 0xee, 0x43, 0x04, 0x3e,
+		0x47, 0xc1, 0x34, 0xc3, 0x3a, 0x04, 0x29, 0xfa, 0x60, 0x8b, 0xa2, 0xb0, 0xab, 0xc8, 0x49, 0x02,
+		0xa0, 0x95, 0xf7, 0xc2, 0xb0, 0x17, 0x1f, 0x19, 0x4a, 0x92, 0x71, 0xf1, 0xc0, 0x14, 0xf1, 0x21,
+		0x18, 0xd3, 0xf1, 0x75, 0xa7, 0xe8, 0x81, 0x19, 0x76, 0x8a, 0x62, 0x23, 0x52, 0x77, 0x25, 0x48,
+		0x71, 0x0f, 0x0c, 0x95, 0x84, 0xf1, 0x19, 0x2e, 0x50, 0xdc, 0x41, 0x17, 0x4a, 0xd1, 0xc6, 0x21,
+		0xa9, 0x9a, 0x26, 0x43, 0xe8, 0xe7, 0xf1, 0xd1, 0x34, 0x69, 0xd5, 0x09, 0x18, 0xa1, 0x3a, 0x5a,
+		0xd8, 0xae, 0x57, 0x1d, 0xce, 0x64, 0x80, 0xe2, 0x0c, 0x93, 0x0a, 0x85, 0xc1, 0x29, 0xee, 0xdd,
+		0x30, 0x88, 0xaf, 0x6a, 0x65, 0xac, 0x97, 0x30, 0xc3, 0x1b, 0xa4, 0x78, 0x03, 0x02, 0x48, 0x91,
+		0xee, 0x03, 0x37, 0xee, 0x15, 0x45, 0x4c, 0x1e, 0x62, 0xfc, 0x04, 0x7c, 0x96, 0x81, 0xe5, 0x0c,
+		0x24, 0xe6, 0x55, 0x47, 0x25, 0x09, 0x86, 0x73, 0x9d, 0x4d, 0x34, 0x03, 0x0a, 0xf9, 0x57, 0x7e,
+		0x35, 0x06, 0x89, 0x2b, 0x86, 0x83, 0xd1, 0x29, 0x5f, 0x02, 0x38, 0x14, 0xe5, 0xcf, 0xeb, 0x5a,
+		0x45, 0xc7, 0xe5, 0x65, 0xbb, 0xe2, 0xfb, 0xba, 0x82, 0xe7, 0x4e, 0xb1, 0x80, 0x3b, 0x8d, 0x41,
+		0x8f, 0x65, 0xd4, 0xf5, 0xb2, 0xb8, 0xc5, 0x4b, 0x0b, 0xa8, 0x00, 0x49, 0xd7, 0x4b, 0x12, 0xed,
+		0xbc, 0x64, 0x98, 0x78, 0x09, 0xf1, 0x61, 0x0e, 0x50, 0xfa, 0x36, 0xb9, 0xb3, 0xe4, 0x21, 0xe5,
+		0x06, 0xaf, 0x4c, 0x4f, 0x17, 0x0e, 0xeb, 0x91, 0x91, 0xc9, 0xc4, 0xed, 0x7b, 0xd7, 0x78, 0xcc,
+		0xe3, 0xd2, 0x6e, 0x05, 0xb7, 0x5e, 0xc0, 0xad, 0xf8, 0x97, 0x1e, 0xfa, 0xa8, 0x5e, 0x9e, 0x5b,
+		0xb1, 0xaf, 0x3d, 0x1c, 0x26, 0x97, 0xb2, 0x2a, 0xba, 0xea, 0xd4, 0x2d, 0xcc, 0x3d, 0xcf, 0x03,
+		0x90, 0x37, 0x3b, 0xbd, 0xcc, 0x93, 0x7d, 0x76, 0x93, 0xa2, 0xed, 0x16, 0x6b, 0x66, 0xb7, 0xf8,
+		0xde, 0xed, 0x36, 0x0b, 0xe0, 0x0a, 0x63, 0xf3, 0x07, 0xf8, 0x11, 0x19, 0x03, 0x13, 0x71, 0x5d,
+		0xab, 0xf0, 0x81, 0xea, 0x23, 0x92, 0xff, 0x83, 0x04, 0x29, 0xb7, 0x1e, 0xcd, 0xc2, 0xa0, 0x90,
+		0xab, 0xb8, 0x55, 0x55, 0x2b, 0xdc, 0x77, 0x8e, 0x34, 0x15, 0xee, 0x7c, 0x55, 0xad, 0x28, 0xfd,
+		0x5c, 0x1e, 0x52, 0x88, 0xee, 0x87, 0x58, 0x93, 0x7e, 0x08, 0x74, 0x7c, 0x7c, 0x6f, 0x1d, 0x1f,
+		0xe8, 0xa2, 0x44, 0xb8, 0x8b, 0xbe, 0x10, 0xa3, 0x8b, 0x19, 0xd3, 0xb0, 0xd5, 0xea, 0x4f, 0x62,
+		0x44, 0x1c, 0x82, 0x94, 0x69, 0x54, 0x8b, 0xac, 0x86, 0xdd, 0x6e, 0x4f, 0x9a, 0x46, 0x55, 0x69,
+		0xe8, 0xf6, 0x9e, 0xdb, 0x34, 0x5c, 0x7a, 0x6f, 0x83, 0xd5, 0xfa, 0xc2, 0x56, 0xb3, 0x60, 0x80,
+		0x99, 0x82, 0xcf, 0x65, 0x0f, 0x11, 0x1b, 0x90, 0xff, 0x32, 0x52, 0xe3, 0xdc, 0xcb, 0xc4, 0x66,
+		0x98, 0x4a, 0xef, 0xb6, 0x4b, 0xc1, 0x42, 0x7f, 0x26, 0xd6, 0x8c, 0x82, 0xb9, 0x9d, 0xc2, 0xf1,
+		0xe4, 0x5f, 0x92, 0x00, 0x96, 0x88, 0x65, 0xa9, 0xbe, 0x64, 0x16, 0xb2, 0xa9, 0x08, 0xc5, 0x40,
+		0xcb, 0x13, 0xcd, 0x3a, 0x8d, 0xb7, 0x3f, 0x60, 0xfb, 0xe5, 0x9e, 0x83, 0x41, 0xcf, 0x19, 0x6d,
+		0x2c, 0x84, 0x99, 0x68, 0x91, 0x55, 0xaf, 0x63, 0x47, 0x19, 0xb8, 0xea, 0x2b, 0xc9, 0xbf, 0x27,
+		0x41, 0x8a, 0xca, 0x44, 0x9e, 0x0f, 0x07, 0xfa, 0x50, 0xda, 0x7b, 0x1f, 0x1e, 0x01, 0x60, 0x6c,
+		0xc8, 0x11, 0x35, 0xf7, 0xac, 0x14, 0x85, 0x90, 0x83, 0x67, 0x74, 0xc6, 0x35, 0x78, 0xbc, 0xb5,
+		0xc1, 0x45, 0xd6, 0xcd, 0xcd, 0x7e, 0x10, 0xfa, 0xe8, 0x07, 0xab, 0xae, 0xdb, 0x3c, 0x91, 0x26,
+		0x5f, 0xa9, 0xd8, 0xb8, 0x6e, 0xcb, 0xcf, 0x43, 0xdf, 0xc6, 0x75, 0xb6, 0x37, 0x72, 0x08, 0x52,
+		0x96, 0x61, 0xf0, 0x39, 0x99, 0xe5, 0x42, 0x49, 0x02, 0xa0, 0x53, 0x90, 0xd8, 0x0f, 0x88, 0x79,
+		0xfb, 0x01, 0xde, 0x86, 0x46, 0xbc, 0xa3, 0x0d, 0x8d, 0x13, 0xff, 0x46, 0x82, 0x7e, 0x5f, 0x7c,
+		0x40, 0x0f, 0xc3, 0xfe, 0xfc, 0xd2, 0xea, 0xdc, 0xa5, 0xe2, 0xe2, 0x7c, 0xf1, 0xfc, 0xd2, 0xec,
+		0x82, 0xf7, 0x7e, 0x2b, 0x7b, 0xe0, 0xe5, 0x1b, 0x53, 0xc8, 0x87, 0x7b, 0x59, 0xa7, 0x3b, 0x4a,
+		0x68, 0x06, 0xc6, 0x82, 0x24, 0xb3, 0xf9, 0x75, 0xf2, 0x98, 0x4b, 0xca, 0xee, 0x7f, 0xf9, 0xc6,
+		0xd4, 0x88, 0x8f, 0x62, 0x76, 0xd3, 0xc6, 0xba, 0xd3, 0x48, 0x30, 0xb7, 0xba, 0xbc, 0xbc, 0xb8,
+		0x91, 0x8e, 0x35, 0x10, 0xf0, 0x80, 0x7d, 0x1f, 0x8c, 0x04, 0x09, 0x56, 0x16, 0x97, 0xd2, 0xf1,
+		0x2c, 0x7a, 0xf9, 0xc6, 0xd4, 0x90, 0x0f, 0x7b, 0x45, 0xab, 0x66, 0x93, 0xef, 0xfe, 0xf4, 0xc4,
+		0xbe, 0xcf, 0xfe, 0xca, 0x84, 0x44, 0x34, 0x1b, 0x0c, 0xc4, 0x08, 0xf4, 0x00, 0x1c, 0x5c, 0x5f,
+		0x5c, 0x58, 0x29, 0xcc, 0x17, 0x97, 0xd7, 0x17, 0xc4, 0xfe, 0xb3, 0xd0, 0x6e, 0xf8, 0xe5, 0x1b,
+		0x53, 0xfd, 0x5c, 0xa5, 0x66, 0xd8, 0x6b, 0x4a, 0xe1, 0xca, 0x2a, 0xd9, 0xcd, 0x66, 0xd8, 0x6b,
+		0x16, 0xbe, 0x6a, 0x38, 0xec, 0x8b, 0x76, 0x0f, 0xc1, 0x78, 0x04, 0xb6, 0xab, 0xd8, 0xc8, 0xcb,
+		0x37, 0xa6, 0x06, 0xd7, 0x2c, 0xcc, 0xc6, 0x0f, 0xa5, 0x98, 0x86, 0x4c, 0x23, 0xc5, 0xea, 0xda,
+		0xea, 0xfa, 0xec, 0x52, 0x7a, 0x2a, 0x9b, 0x7e, 0xf9, 0xc6, 0xd4, 0x80, 0x08, 0x86, 0x74, 0x93,
+		0xdf, 0xd5, 0xec, 0x4e, 0xae, 0x78, 0xbe, 0x71, 0x0a, 0x8e, 0x36, 0x39, 0x5f, 0xe2, 0xe5, 0xbd,
+		0x9d, 0x30, 0x35, 0xdd, 0x63, 0xcf, 0xb6, 0xd9, 0x7e, 0x6e, 0xbf, 0x74, 0xda, 0xfb, 0xe9, 0x55,
+		0xb6, 0xe5, 0xe2, 0x4e, 0x7e, 0x8f, 0x04, 0x43, 0x17, 0x34, 0xdb, 0x31, 0x2c, 0xad, 0xa4, 0x56,
+		0xe9, 0xab, 0xad, 0x33, 0x9d, 0xc6, 0xd6, 0xd0, 0x50, 0x7f, 0x0a, 0x7a, 0xaf, 0xaa, 0x55, 0x16,
+		0xd4, 0xe2, 0xf4, 0xb3, 0x33, 0x4d, 0x8e, 0x7b, 0xdc, 0xd0, 0x26, 0x18, 0x30, 0x32, 0xf9, 0xd7,
+		0x63, 0x30, 0x4c, 0x07, 0x83, 0xcd, 0x3e, 0x48, 0x46, 0xd6, 0x58, 0x79, 0x48, 0x58, 0xaa, 0xc3,
+		0x37, 0x0d, 0xf3, 0xd3, 0xfc, 0xe4, 0xf1, 0x58, 0x07, 0xe7, 0x68, 0xe4, 0x70, 0x92, 0xd2, 0xa2,
+		0x37, 0x43, 0x92, 0x1c, 0xd4, 0x51, 0x3e, 0x6c, 0xe5, 0x32, 0xdb, 0x1d, 0x9f, 0x5b, 0x37, 0x27,
+		0x87, 0x77, 0xd5, 0x5a, 0x35, 0x27, 0x0b, 0x3e, 0xb2, 0xd2, 0x57, 0x53, 0xaf, 0x13, 0x11, 0x91,
+		0x09, 0xc3, 0x04, 0x5a, 0xda, 0x56, 0xf5, 0x0a, 0x66, 0x8d, 0xd0, 0x2d, 0xd0, 0xfc, 0x85, 0xae,
+		0x1b, 0x39, 0xe0, 0x35, 0xe2, 0x63, 0x27, 0x2b, 0x83, 0x35, 0xf5, 0xfa, 0x1c, 0x05, 0x90, 0x16,
+		0x73, 0xc9, 0x0f, 0x7d, 0x62, 0x72, 0x1f, 0x3d, 0xcd, 0xfd, 0x96, 0x04, 0xe0, 0x59, 0x0c, 0xbd,
+		0x19, 0xd2, 0x25, 0xb7, 0x44, 0x69, 0xc5, 0xb9, 0xe4, 0xbd, 0xcd, 0xfa, 0x22, 0x64, 0x6f, 0x36,
+		0x37, 0x7f, 0xf3, 0xe6, 0xa4, 0xa4, 0x0c, 0x97, 0x42, 0x5d, 0xf1, 0x26, 0xe8, 0xaf, 0x9b, 0x65,
+		0xd5, 0xc1, 0x45, 0xba, 0x8e, 0x8b, 0xb5, 0x9d, 0xe7, 0x27, 0x08, 0xaf, 0x5b, 0x37, 0x27, 0x11,
+		0x53, 0xcb, 0x47, 0x2c, 0xd3, 0xd9, 0x1f, 0x18, 0x84, 0x10, 0xf8, 0x74, 0xfa, 0x9a, 0x04, 0xfd,
+		0xf3, 0xbe, 0xfb, 0x94, 0x19, 0xe8, 0xab, 0x19, 0xba, 0xb6, 0xc3, 0xfd, 0x31, 0xa5, 0x88, 0x22,
+		0xd9, 0x0a, 0x65, 0x0f, 0x59, 0x9d, 0x5d, 0xb1, 0x15, 0x2a, 0xca, 0x84, 0xea, 0x1a, 0xde, 0xb4,
+		0x35, 0xd1, 0x1b, 0x8a, 0x28, 0xa2, 0xf3, 0xe4, 0xeb, 0x3a, 0xa5, 0x3a, 0xd9, 0xc3, 0x29, 0x96,
+		0x0c, 0xdd, 0x51, 0x4b, 0x0e, 0x7b, 0x12, 0x99, 0x3f, 0x74, 0xeb, 0xe6, 0xe4, 0x41, 0x26, 0x6b,
+		0x18, 0x43, 0x56, 0x86, 0x05, 0x68, 0x8e, 0x41, 0x48, 0x0b, 0x65, 0xec, 0xa8, 0x5a, 0xd5, 0xce,
+		0xb0, 0x8b, 0x09, 0xa2, 0xe8, 0xd3, 0xe5, 0xf3, 0x7d, 0xfe, 0x8d, 0xad, 0xf3, 0x90, 0x36, 0x4c,
+		0x6c, 0x05, 0x12, 0x51, 0x29, 0xdc, 0x72, 0x18, 0x43, 0x56, 0x86, 0x05, 0x48, 0x24, 0xa9, 0x0e,
+		0xa4, 0xdd, 0x25, 0x61, 0xd1, 0xac, 0x6f, 0x7a, 0xfb, 0x61, 0x63, 0x0d, 0xbd, 0x31, 0xab, 0xef,
+		0xe6, 0x4f, 0x79, 0xdc, 0xc3, 0x74, 0xf2, 0xd7, 0xbf, 0xf8, 0xe0, 0x18, 0x77, 0x0d, 0x6f, 0x7f,
+		0x8a, 0x6c, 0x4e, 0x0d, 0xbb, 0xa8, 0x6b, 0x14, 0x93, 0xa4, 0x9d, 0xcf, 0xab, 0x5a, 0x55, 0x3c,
+		0xed, 0x57, 0x78, 0x09, 0xe5, 0xa0, 0xd7, 0x76, 0x54, 0xa7, 0x6e, 0xf3, 0x53, 0x5e, 0xb9, 0x99,
+		0xab, 0xe5, 0x0d, 0xbd, 0xbc, 0x4e, 0x31, 0x15, 0x4e, 0x81, 0xce, 0x43, 0x2f, 0x3f, 0x3e, 0xef,
+		0xe9, 0x7a, 0x7c, 0xd3, 0x7b, 0x12, 0x8c, 0x9a, 0x58, 0xa4, 0x8c, 0xab, 0xb8, 0xc2, 0xd2, 0xaa,
+		0x6d, 0x95, 0xac, 0x3e, 0xe8, 0x97, 0xf8, 0xf2, 0x8b, 0x5d, 0x0f, 0x42, 0x6e, 0xa9, 0x30, 0x3f,
+		0x59, 0x19, 0x76, 0x41, 0xeb, 0x14, 0x82, 0x2e, 0x05, 0x2e, 0xfe, 0xf2, 0xcf, 0x55, 0xde, 0xdd,
+		0x4c, 0x7d, 0x9f, 0x4f, 0x8b, 0xfd, 0x09, 0x1f, 0x35, 0x71, 0x8e, 0xba, 0xbe, 0x69, 0xe8, 0xf4,
+		0xfd, 0x2d, 0xcf, 0xef, 0xc9, 0xfa, 0x2e, 0xee, 0x77, 0x8e, 0x30, 0x86, 0xac, 0x0c, 0xbb, 0xa0,
+		0x0b, 0x14, 0x82, 0xca, 0x30, 0xe4, 0x61, 0xd1, 0x81, 0x9a, 0x6a, 0x3b, 0x50, 0xef, 0xe2, 0x03,
+		0x75, 0x7f, 0xb8, 0x15, 0x6f, 0xac, 0x0e, 0xba, 0x40, 0x42, 0x86, 0x2e, 0x00, 0x78, 0xe1, 0x81,
+		0xee, 0x53, 0xf4, 0x9f, 0x94, 0xdb, 0xc7, 0x18, 0xb1, 0xde, 0xf3, 0x68, 0xd1, 0x5b, 0x61, 0xb4,
+		0xa6, 0xe9, 0x45, 0x1b, 0x57, 0xb7, 0x8a, 0xdc, 0xc0, 0x84, 0x25, 0xfd, 0xa0, 0x52, 0x7e, 0xa9,
+		0x3b, 0x7f, 0xb8, 0x75, 0x73, 0x32, 0xcb, 0x43, 0x68, 0x23, 0x4b, 0x59, 0x19, 0xa9, 0x69, 0xfa,
+		0x3a, 0xae, 0x6e, 0xcd, 0xbb, 0xb0, 0xdc, 0xc0, 0xbb, 0x3f, 0x31, 0xb9, 0x8f, 0x0f, 0xd7, 0x7d,
+		0xf2, 0x19, 0xba, 0x77, 0xce, 0x87, 0x19, 0xb6, 0xc9, 0x9a, 0x44, 0x15, 0x05, 0x7e, 0xcd, 0xc0,
+		0x03, 0xb0, 0x61, 0xfe, 0xd2, 0xbf, 0x9f, 0x92, 0xe4, 0xcf, 0x4b, 0xd0, 0x3b, 0x7f, 0x65, 0x4d,
+		0xd5, 0x2c, 0xb4, 0x08, 0x23, 0x9e, 0xe7, 0x04, 0x07, 0xf9, 0xe1, 0x5b, 0x37, 0x27, 0x33, 0x61,
+		0xe7, 0x72, 0x47, 0xb9, 0xe7, 0xc0, 0x62, 0x98, 0x2f, 0x36, 0x5b, 0xb8, 0x06, 0x58, 0x35, 0xa0,
+		0xc8, 0x8d, 0xcb, 0xda, 0x90, 0x9a, 0x05, 0xe8, 0x63, 0xd2, 0x92, 0x37, 0xdf, 0x3d, 0x26, 0xf9,
+		0x87, 0x1f, 0x0c, 0x4c, 0x34, 0x75, 0x5e, 0x8a, 0xef, 0x6e, 0x64, 0x12, 0x12, 0xf9, 0x03, 0x31,
+		0x80, 0xf9, 0x2b, 0x57, 0x36, 0x2c, 0xcd, 0xac, 0x62, 0xe7, 0x76, 0x6a, 0xbe, 0x01, 0xfb, 0x3d,
+		0xb5, 0x6c, 0xab, 0x14, 0xd2, 0x7e, 0xea, 0xd6, 0xcd, 0xc9, 0xc3, 0x61, 0xed, 0x7d, 0x68, 0xb2,
+		0x32, 0xea, 0xad, 0x97, 0xac, 0x52, 0x24, 0xd7, 0xb2, 0xed, 0xb8, 0x5c, 0xe3, 0xcd, 0xb9, 0xfa,
+		0xd0, 0xfc, 0x5c, 0xe7, 0x6d, 0x27, 0xda, 0xb4, 0xeb, 0xd0, 0xef, 0x99, 0x84, 0x7c, 0xfb, 0x2c,
+		0xe9, 0xf0, 0xff, 0xb9, 0x85, 0xe5, 0xe6, 0x16, 0x16, 0x64, 0xdc, 0xca, 0x2e, 0xa5, 0xfc, 0xe7,
+		0x12, 0x80, 0xe7, 0xb3, 0x3f, 0x9d, 0x2e, 0x46, 0x42, 0x39, 0x0f, 0xbc, 0xf1, 0x3d, 0xa5, 0x6a,
+		0x9c, 0x3a, 0x64, 0xcf, 0x5f, 0x88, 0x91, 0xcf, 0x63, 0xf0, 0xc8, 0xf3, 0x53, 0x6f, 0x83, 0x35,
+		0xe8, 0xc3, 0xba, 0x63, 0x69, 0xd4, 0x08, 0xa4, 0xb7, 0x1f, 0x6a, 0xd6, 0xdb, 0x11, 0x3a, 0xd1,
+		0x4f, 0x4a, 0x89, 0x4d, 0x77, 0xce, 0x26, 0x64, 0x8d, 0xf7, 0xc5, 0x21, 0xd3, 0x8c, 0x12, 0xcd,
+		0xc1, 0x70, 0xc9, 0xc2, 0x14, 0x50, 0xf4, 0xef, 0xfc, 0xe5, 0xb3, 0x5e, 0x66, 0x19, 0x42, 0x90,
+		0x95, 0x21, 0x01, 0xe1, 0xb3, 0x47, 0x05, 0x48, 0xda, 0x47, 0xdc, 0x8e, 0x60, 0x75, 0x98, 0xe7,
+		0xc9, 0x7c, 0xfa, 0x10, 0x8d, 0x04, 0x19, 0xb0, 0xf9, 0x63, 0xc8, 0x83, 0xd2, 0x09, 0xe4, 0x05,
+		0x18, 0xd6, 0x74, 0xcd, 0xd1, 0xd4, 0x6a, 0x71, 0x53, 0xad, 0xaa, 0x7a, 0x69, 0x2f, 0x59, 0x33,
+		0x0b, 0xf9, 0xbc, 0xd9, 0x10, 0x3b, 0x59, 0x19, 0xe2, 0x90, 0x3c, 0x03, 0xa0, 0x0b, 0xd0, 0x27,
+		0x9a, 0x4a, 0xec, 0x29, 0xdb, 0x10, 0xe4, 0xbe, 0x04, 0xef, 0xbd, 0x71, 0x18, 0x51, 0x70, 0xf9,
+		0xff, 0x77, 0x45, 0x77, 0x5d, 0xb1, 0x0c, 0xc0, 0x86, 0x3b, 0x09, 0xb0, 0x99, 0xc4, 0x9e, 0x02,
+		0x46, 0x8a, 0x71, 0x98, 0xb7, 0x1d, 0x5f, 0x7f, 0xdc, 0x8c, 0xc1, 0x80, 0xbf, 0x3f, 0xfe, 0x92,
+		0xce, 0x4a, 0x68, 0xd1, 0x8b, 0x44, 0x09, 0xfe, 0x21, 0xde, 0x26, 0x91, 0xa8, 0xc1, 0x7b, 0x5b,
+		0x87, 0xa0, 0x5f, 0xeb, 0x83, 0xde, 0x35, 0xd5, 0x52, 0x6b, 0x36, 0x2a, 0x35, 0x64, 0x9a, 0x62,
+		0xfb, 0xb1, 0xe1, 0x73, 0xeb, 0x7c, 0xb7, 0xa3, 0x4d, 0xa2, 0xf9, 0xa1, 0x88, 0x44, 0xf3, 0x0d,
+		0x30, 0x44, 0x96, 0xc3, 0xbe, 0x2b, 0x0c, 0xc4, 0xda, 0x83, 0xf9, 0x71, 0x8f, 0x4b, 0xb0, 0x9e,
+		0xad, 0x96, 0xaf, 0xf8, 0xef, 0x30, 0xf4, 0x13, 0x0c, 0x2f, 0x30, 0x13, 0xf2, 0x03, 0xde, 0xb2,
+		0xd4, 0x57, 0x29, 0x2b, 0xe4, 0x46, 0x6f, 0x81, 0x15, 0xd0, 0x12, 0xa0, 0x6d, 0x77, 0x67, 0xa4,
+		0xe8, 0x99, 0x93, 0xd0, 0x1f, 0xb9, 0x75, 0x73, 0x72, 0x9c, 0xd1, 0x37, 0xe2, 0xc8, 0xca, 0x88,
+		0x07, 0x14, 0xdc, 0x1e, 0x01, 0x20, 0x7a, 0x15, 0xd9, 0xf5, 0x6d, 0xb6, 0xdc, 0xd9, 0x7f, 0xeb,
+		0xe6, 0xe4, 0x08, 0xe3, 0xe2, 0xd5, 0xc9, 0x4a, 0x8a, 0x14, 0xe6, 0xc9, 0xff, 0xe8, 0xbd, 0xe4,
+		0xea, 0x66, 0xd5, 0xd8, 0x54, 0xab, 0xc5, 0xaa, 0xf6, 0x42, 0x5d, 0x2b, 0x17, 0x79, 0xff, 0x15,
+		0x4b, 0xaa, 0xc9, 0x97, 0x38, 0x4a, 0xd7, 0x4b, 0x9c, 0x29, 0xd6, 0x66, 0x53, 0xc6, 0xb2, 0x72,
+		0x80, 0xd5, 0x2d, 0xd1, 0xaa, 0x75, 0x56, 0x33, 0xa7, 0x9a, 0xe8, 0x97, 0x24, 0x38, 0xec, 0xf9,
+		0x61, 0x84, 0x48, 0xf4, 0x13, 0xe6, 0xf9, 0xcb, 0x5d, 0x8b, 0x74, 0x77, 0xd8, 0xc7, 0xa3, 0xa4,
+		0x1a, 0x77, 0xab, 0x1b, 0x04, 0xe3, 0xcb, 0x88, 0xd0, 0xf6, 0x47, 0x26, 0xd9, 0xf5, 0x32, 0x82,
+		0x89, 0xe3, 0x5b, 0x46, 0x84, 0x58, 0xb2, 0x65, 0x44, 0x70, 0xdb, 0x84, 0x76, 0x53, 0xc4, 0x92,
+		0xa3, 0xb8, 0x55, 0x35, 0xf8, 0xf7, 0x66, 0xbb, 0xeb, 0x26, 0x16, 0x4d, 0xa7, 0x9a, 0xae, 0x65,
+		0x18, 0x63, 0x59, 0x39, 0xd0, 0xb0, 0xa2, 0x39, 0x4f, 0x2a, 0x7c, 0x01, 0xf1, 0xd3, 0x12, 0x20,
+		0xaf, 0x56, 0xc1, 0xb6, 0x49, 0x96, 0xf5, 0x64, 0xfd, 0xe6, 0x71, 0xe3, 0xe3, 0xb6, 0x79, 0x6a,
+		0xea, 0x62, 0x8a, 0xf5, 0x9b, 0x47, 0x4b, 0xbe, 0xe0, 0x2c, 0x66, 0x8d, 0x58, 0xbb, 0x2b, 0xf0,
+		0x3c, 0xb2, 0x84, 0xa7, 0xd1, 0x7d, 0xf2, 0x1f, 0x4a, 0x30, 0xde, 0x10, 0x88, 0x5c, 0x61, 0xff,
+		0x0a, 0x20, 0xcb, 0x57, 0xc9, 0x3f, 0xc6, 0xc9, 0x84, 0xee, 0x3a, 0xae, 0x8d, 0x58, 0xe1, 0x8a,
+		0xdb, 0x98, 0x18, 0xb0, 0x37, 0x16, 0xff, 0x58, 0x82, 0x31, 0x7f, 0xf3, 0xae, 0x22, 0x2b, 0x30,
+		0xe0, 0x6f, 0x9d, 0xab, 0x70, 0xb4, 0x13, 0x15, 0xb8, 0xf4, 0x01, 0x7a, 0xf4, 0xb4, 0x17, 0xe5,
+		0xd9, 0x96, 0xeb, 0xc3, 0x1d, 0x5b, 0x43, 0xc8, 0x14, 0x8e, 0xf6, 0x09, 0xda, 0x1f, 0xff, 0x57,
+		0x82, 0xc4, 0x9a, 0x61, 0x54, 0x91, 0x01, 0x23, 0xba, 0xe1, 0x14, 0x49, 0x40, 0xc2, 0x65, 0xff,
+		0x53, 0x87, 0x54, 0x7e, 0xae, 0x3b, 0x23, 0x7d, 0xff, 0xe6, 0x64, 0x23, 0x2b, 0x65, 0x58, 0x37,
+		0x9c, 0x3c, 0x85, 0xf0, 0xd7, 0x0e, 0x6f, 0x85, 0xc1, 0x60, 0x63, 0x6c, 0x72, 0x7d, 0xa6, 0xeb,
+		0xc6, 0x82, 0x6c, 0x6e, 0xdd, 0x9c, 0x1c, 0xf3, 0x02, 0xad, 0x0b, 0x96, 0x95, 0x81, 0x4d, 0x5f,
+		0xeb, 0xec, 0x56, 0xe0, 0x0f, 0x49, 0x1f, 0x7e, 0x52, 0x82, 0x51, 0x0a, 0xd4, 0x5e, 0xc4, 0x74,
+		0xbb, 0x47, 0xc1, 0x25, 0xc3, 0x2a, 0xa3, 0x21, 0x88, 0xf1, 0x73, 0xb6, 0x84, 0x12, 0xd3, 0xca,
+		0xe4, 0xd0, 0xd5, 0xb8, 0xa6, 0xf3, 0x4b, 0x3a, 0x29, 0x85, 0x15, 0xe8, 0xac, 0x65, 0x94, 0xeb,
+		0x55, 0x4c, 0x3e, 0x4d, 0x4b, 0x1f, 0xe6, 0xb0, 0xd9, 0xdc, 0x3f, 0x6b, 0x05, 0xea, 0xc9, 0xac,
+		0x45, 0x01, 0xb3, 0xac, 0x4c, 0xb6, 0x1e, 0xdc, 0x60, 0xc7, 0xbf, 0xd8, 0xe6, 0x01, 0x98, 0x9f,
+		0x9d, 0xf8, 0x92, 0x04, 0xe0, 0x6d, 0xaa, 0x91, 0xb3, 0x9c, 0xfc, 0xea, 0xca, 0x7c, 0x71, 0x7d,
+		0x63, 0x76, 0xe3, 0xf2, 0x7a, 0xf0, 0xe9, 0x82, 0x38, 0xf9, 0xb1, 0x4d, 0x5c, 0x22, 0xdf, 0xfd,
+		0x2b, 0xa3, 0x63, 0x30, 0x16, 0xc4, 0x26, 0x25, 0xf2, 0xa9, 0xdf, 0xec, 0xc0, 0xcb, 0x37, 0xa6,
+		0x92, 0x6c, 0x99, 0x81, 0xc9, 0xbd, 0x99, 0xfd, 0x8d, 0x78, 0xe4, 0xb3, 0xa5, 0xb1, 0xec, 0xe0,
+		0xcb, 0x37, 0xa6, 0x52, 0xee, 0x7a, 0x04, 0xc9, 0x80, 0xfc, 0x98, 0x9c, 0x5f, 0x3c, 0x0b, 0x2f,
+		0xdf, 0x98, 0xea, 0x65, 0x9d, 0x9c, 0x4d, 0x90, 0xf3, 0x9d, 0xdb, 0xfe, 0xc0, 0xe1, 0xd5, 0x54,
+		0xd3, 0x03, 0x9d, 0x0a, 0xd6, 0xb1, 0xad, 0xd9, 0x7b, 0x3a, 0xd0, 0xe9, 0xe8, 0x90, 0x48, 0xfe,
+		0xc3, 0x3e, 0x18, 0x58, 0x60, 0xad, 0x90, 0x8e, 0xc0, 0xe8, 0x71, 0xf2, 0xf9, 0x5c, 0x92, 0x21,
+		0xb9, 0x27, 0xc4, 0x4d, 0x06, 0x25, 0xcb, 0xa3, 0xdc, 0x6b, 0x8a, 0xb4, 0x84, 0x6c, 0x7e, 0x4f,
+		0x89, 0x5d, 0x9f, 0xf4, 0x2e, 0x04, 0x0e, 0xe4, 0x17, 0xbb, 0x9e, 0x40, 0xf8, 0xae, 0x61, 0x98,
+		0x9f, 0xcc, 0xae, 0x3c, 0x6d, 0x10, 0x08, 0xbb, 0xf8, 0xf8, 0x4e, 0x09, 0xf6, 0x53, 0x2c, 0x6f,
+		0xfe, 0xa5, 0x98, 0x62, 0x1d, 0x7b, 0xa2, 0x99, 0x0a, 0x4b, 0xaa, 0xed, 0x5d, 0x63, 0xa2, 0xbc,
+		0xf2, 0x47, 0x79, 0x8e, 0x77, 0xd8, 0xd7, 0x78, 0x98, 0xad, 0xac, 0x8c, 0x56, 0x1b, 0x28, 0x6d,
+		0xb4, 0x10, 0xb8, 0xab, 0x9a, 0xe8, 0xee, 0x14, 0xc9, 0x47, 0x8a, 0x2e, 0x42, 0xbf, 0x17, 0xef,
+		0x6c, 0xfe, 0x03, 0x47, 0x9d, 0xcf, 0x6f, 0x7e, 0x62, 0xf4, 0x2e, 0x09, 0xf6, 0x7b, 0x89, 0xaa,
+		0x9f, 0x2d, 0xfb, 0x21, 0xa8, 0xfb, 0xbb, 0x58, 0xe3, 0x87, 0x8d, 0x13, 0xc9, 0x57, 0x56, 0xc6,
+		0x5c, 0xf8, 0xbc, 0x4f, 0x90, 0x35, 0xf2, 0x13, 0x14, 0xfe, 0xf6, 0xc5, 0x97, 0x4d, 0x3b, 0x9f,
+		0x3e, 0x82, 0x0c, 0xd8, 0x8f, 0xd3, 0x98, 0x86, 0xe5, 0xe0, 0x72, 0x26, 0xc9, 0x3f, 0xd5, 0xc5,
+		0xcb, 0xe8, 0xdd, 0x12, 0x1c, 0x70, 0x78, 0x00, 0x64, 0x5b, 0xe0, 0x45, 0x8b, 0x86, 0x40, 0x3b,
+		0x93, 0x6a, 0xad, 0x77, 0x44, 0xd8, 0xcc, 0xdf, 0xc3, 0xf5, 0x3e, 0xc2, 0xf4, 0x8e, 0x66, 0x2c,
+		0x2b, 0x63, 0x4e, 0x23, 0xad, 0x8d, 0x9e, 0x87, 0x23, 0xdc, 0x85, 0x23, 0xa8, 0xc8, 0xb5, 0x07,
+		0xb2, 0xff, 0x9c, 0xc8, 0x1f, 0xbf, 0x75, 0x73, 0xf2, 0x68, 0xc0, 0xe3, 0xa3, 0xd1, 0x65, 0x65,
+		0x9c, 0xb9, 0x7f, 0x43, 0x53, 0x8b, 0x65, 0x79, 0x05, 0x50, 0xa3, 0x4f, 0x87, 0xaf, 0x24, 0x7b,
+		0xaf, 0xcd, 0x48, 0xfc, 0xf7, 0x5f, 0xda, 0x65, 0x85, 0x5c, 0xf2, 0xdd, 0x3c, 0xb3, 0xb9, 0xed,
+		0xa1, 0xee, 0x9f, 0xc6, 0xe0, 0x84, 0xff, 0xc0, 0xf7, 0x85, 0x3a, 0xb6, 0x76, 0xdd, 0xc8, 0x64,
+		0xaa, 0x15, 0x4d, 0xf7, 0xbf, 0x6b, 0x1a, 0xf7, 0xe7, 0x62, 0x14, 0x57, 0x74, 0x93, 0xac, 0x43,
+		0xff, 0x9a, 0x5a, 0xc1, 0x0a, 0x7e, 0xa1, 0x8e, 0x6d, 0x27, 0xe2, 0xd9, 0x08, 0x79, 0xd2, 0xb1,
+		0xb5, 0x25, 0x2e, 0xa9, 0x24, 0x14, 0x5e, 0x22, 0x2a, 0x57, 0xb5, 0x9a, 0xc6, 0xe6, 0xb4, 0x84,
+		0xc2, 0x0a, 0xe4, 0xb3, 0x9b, 0x74, 0xe6, 0x62, 0x81, 0x26, 0x93, 0x10, 0x1f, 0x4e, 0xaa, 0xeb,
+		0x2c, 0xd0, 0xc8, 0x4f, 0xc1, 0x00, 0x6b, 0x8f, 0x27, 0x43, 0xe3, 0x90, 0xa4, 0x17, 0x24, 0xbd,
+		0x56, 0xfb, 0x48, 0xf9, 0x12, 0x7b, 0x62, 0xc2, 0xb8, 0xb0, 0x86, 0x59, 0x21, 0x9f, 0x6f, 0x6a,
+		0xca, 0xe3, 0xed, 0x23, 0x22, 0x33, 0x94, 0x6b, 0xc6, 0xdf, 0xef, 0x81, 0xfd, 0x6c, 0x95, 0x3a,
+		0xa3, 0x9a, 0xda, 0xcc, 0xb6, 0xe3, 0x88, 0x27, 0x4f, 0xc0, 0xc0, 0xd3, 0xaa, 0xa9, 0xc9, 0xbb,
+		0x90, 0xb8, 0xe0, 0x38, 0x26, 0x3a, 0x01, 0x3d, 0x56, 0xbd, 0x8a, 0xc5, 0x1e, 0xae, 0x7b, 0xca,
+		0xa6, 0x9a, 0xda, 0x34, 0x41, 0x50, 0xea, 0x55, 0xac, 0x30, 0x14, 0x54, 0x80, 0xc9, 0xad, 0x7a,
+		0xb5, 0xba, 0x4b, 0x7e, 0x05, 0xcd, 0x28, 0x13, 0xb7, 0xe3, 0xbf, 0x1a, 0x83, 0xaf, 0x9b, 0xaa,
+		0xf8, 0xd2, 0x2c, 0xb1, 0xcd, 0x61, 0x8a, 0x36, 0x4f, 0xb1, 0xc4, 0x2f, 0xc6, 0x14, 0x04, 0x8e,
+		0xfc, 0x27, 0x31, 0x48, 0x0a, 0xd6, 0xf4, 0xcd, 0x07, 0xae, 0xe2, 0x12, 0xc9, 0x05, 0x24, 0xfe,
+		0xe6, 0x83, 0x97, 0x11, 0x82, 0x78, 0x85, 0x77, 0x51, 0xea, 0xc2, 0x3e, 0x85, 0x14, 0x08, 0xcc,
+		0x7d, 0x89, 0x43, 0x60, 0xe4, 0x81, 0xce, 0x18, 0x24, 0x4c, 0x43, 0x6c, 0xb6, 0x5c, 0xd8, 0xa7,
+		0xd0, 0x12, 0xca, 0x40, 0x2f, 0x09, 0x08, 0x0e, 0xfb, 0x7c, 0x2f, 0x81, 0xf3, 0x32, 0x3a, 0x40,
+		0x4e, 0x06, 0x9c, 0x12, 0xbb, 0x24, 0x4b, 0x2a, 0x58, 0x11, 0x9d, 0x85, 0x5e, 0xf6, 0x21, 0x85,
+		0xf0, 0x0f, 0x4a, 0x11, 0x63, 0xb0, 0x2f, 0x56, 0x12, 0xb9, 0xd7, 0x54, 0xc7, 0xc1, 0x96, 0x4e,
+		0x18, 0x32, 0x74, 0x72, 0x91, 0x67, 0xd3, 0x28, 0xef, 0xf2, 0x1f, 0xb9, 0xa2, 0xff, 0xf3, 0x5f,
+		0xd5, 0xa1, 0xfe, 0x50, 0xa4, 0x95, 0xec, 0xb7, 0xfd, 0x06, 0x04, 0x30, 0x4f, 0x90, 0x0a, 0x30,
+		0xaa, 0x96, 0xcb, 0x1a, 0xfb, 0xbd, 0xa9, 0xe2, 0xa6, 0x46, 0x03, 0xa3, 0x9d, 0xe9, 0x6f, 0xd1,
+		0x17, 0xc8, 0x23, 0xc8, 0x73, 0xfc, 0x7c, 0x8a, 0xfc, 0xc6, 0x24, 0x15, 0x4a, 0x3e, 0x07, 0x23,
+		0x0d, 0x92, 0x12, 0xf9, 0x76, 0x34, 0xbd, 0x2c, 0x9e, 0x27, 0x91, 0xff, 0x09, 0x8c, 0x7e, 0x63,
+		0x96, 0x25, 0x77, 0xf4, 0xff, 0xfc, 0xdb, 0x9b, 0xbf, 0x62, 0x1b, 0xf2, 0xbd, 0x62, 0x53, 0x4d,
+		0x2d, 0x9f, 0xa2, 0xfc, 0xf9, 0xdb, 0xb5, 0xd9, 0xc6, 0xb7, 0x6b, 0x15, 0xac, 0x8b, 0xa4, 0x83,
+		0x54, 0xa9, 0xa6, 0x66, 0x53, 0x77, 0xf4, 0xbe, 0x79, 0x6b, 0x9f, 0xf3, 0xfd, 0x4f, 0x9f, 0xb2,
+		0x25, 0x16, 0x66, 0xd7, 0x16, 0x5d, 0x3f, 0xfe, 0x4a, 0x0c, 0x0e, 0xfb, 0xfc, 0xd8, 0x87, 0xdc,
+		0xe8, 0xce, 0xd9, 0x68, 0x8f, 0xef, 0xe0, 0x73, 0x02, 0x97, 0x20, 0x41, 0xf0, 0x51, 0x9b, 0xdf,
+		0xbc, 0xc9, 0xfc, 0xc6, 0xd7, 0x7f, 0x57, 0x9e, 0x92, 0x9a, 0xf6, 0x0a, 0x65, 0x92, 0x7f, 0x57,
+		0xe7, 0xf6, 0x4b, 0x7b, 0x9f, 0xfb, 0xb5, 0x6f, 0x9f, 0x19, 0xc3, 0x36, 0xfc, 0xf2, 0x93, 0x4d,
+		0x9f, 0x9b, 0xb3, 0x88, 0xd9, 0x3a, 0x77, 0xec, 0x22, 0x1c, 0x37, 0x7b, 0xd1, 0xd3, 0xaa, 0x07,
+		0x3b, 0xcc, 0x42, 0xaf, 0xc3, 0x81, 0xa7, 0x49, 0xdb, 0xde, 0xc6, 0x97, 0x08, 0xec, 0x07, 0xdc,
+		0xf3, 0x79, 0x89, 0xff, 0x70, 0xa6, 0x38, 0x7b, 0x07, 0x4f, 0x3e, 0xbe, 0x76, 0x3f, 0x36, 0xdd,
+		0x74, 0xbe, 0x98, 0xf6, 0x4d, 0x16, 0x8a, 0x8f, 0x52, 0xfe, 0x55, 0x09, 0x0e, 0x36, 0x34, 0xcd,
+		0x63, 0xfc, 0x42, 0xc4, 0xe3, 0xa3, 0x3d, 0x25, 0x74, 0x0b, 0x11, 0xc2, 0xde, 0xdb, 0x56, 0x58,
+		0x26, 0x45, 0x40, 0xda, 0x27, 0x61, 0x7f, 0x50, 0x58, 0x61, 0xa6, 0x7b, 0x60, 0x28, 0x78, 0xc6,
+		0xc3, 0xcd, 0x35, 0x18, 0x38, 0xe5, 0x91, 0x8b, 0x61, 0x3b, 0xbb, 0xba, 0x16, 0xfc, 0x2b, 0x36,
+		0x89, 0xff, 0xf0, 0x56, 0x87, 0xaa, 0x7a, 0x94, 0xf2, 0x07, 0x24, 0x98, 0x0a, 0xb6, 0xe0, 0xcb,
+		0x01, 0xbb, 0x13, 0xf6, 0xb6, 0x75, 0xf1, 0xab, 0x12, 0xdc, 0xd5, 0x42, 0x26, 0x6e, 0x80, 0x17,
+		0x61, 0xcc, 0xb7, 0x49, 0x23, 0x42, 0xb8, 0xe8, 0xf6, 0x13, 0xed, 0xb3, 0x6f, 0x77, 0x4f, 0xe2,
+		0x10, 0x31, 0xca, 0xe7, 0xbe, 0x3d, 0x39, 0xda, 0x58, 0x67, 0x2b, 0xa3, 0x8d, 0x1b, 0x2b, 0xb7,
+		0xd1, 0x3f, 0x3e, 0x22, 0xc1, 0x7d, 0x41, 0x55, 0x23, 0xd2, 0xf8, 0xd7, 0xab, 0x1f, 0xfe, 0xad,
+		0x04, 0x27, 0x3a, 0x11, 0x8e, 0x77, 0xc8, 0x26, 0x8c, 0x7a, 0x0b, 0x8c, 0x70, 0x7f, 0x74, 0xb5,
+		0x6c, 0x61, 0x5e, 0x8a, 0x5c, 0x6e, 0x77, 0xc0, 0xf0, 0x26, 0x1f, 0x58, 0xfe, 0x2e, 0x77, 0x8d,
+		0x1c, 0x3c, 0x9f, 0x11, 0x46, 0x0e, 0x9c, 0xd0, 0x44, 0xf4, 0x45, 0x2c, 0xa2, 0x2f, 0xbc, 0xd4,
+		0x5c, 0xbe, 0x0a, 0x07, 0x1b, 0x5a, 0xe4, 0x96, 0x7b, 0x13, 0x8c, 0x46, 0xb8, 0x32, 0x1f, 0xd5,
+		0x5d, 0x78, 0xb2, 0x82, 0x1a, 0x9d, 0x55, 0xde, 0x85, 0x49, 0xda, 0x6e, 0x84, 0xa1, 0xef, 0xb4,
+		0xca, 0x35, 0x98, 0x6a, 0xde, 0x34, 0xd7, 0x7d, 0x11, 0x7a, 0x59, 0x3f, 0x73, 0x75, 0xf7, 0xe0,
+		0x28, 0x9c, 0x81, 0xfc, 0x51, 0x11, 0xcb, 0xe6, 0x85, 0xd8, 0xd1, 0x63, 0xa8, 0x13, 0x5d, 0x6f,
+		0xd3, 0x18, 0xf2, 0x19, 0xe3, 0x5b, 0x22, 0xaa, 0x45, 0x4b, 0xc7, 0xcd, 0x51, 0xba, 0x6d, 0x51,
+		0x8d, 0xd9, 0xe6, 0xce, 0x86, 0xaf, 0x5f, 0x11, 0xe1, 0xcb, 0xd5, 0xa9, 0x4d, 0xf8, 0x7a, 0x7d,
+		0x4c, 0xef, 0x06, 0xb2, 0x36, 0x62, 0xfe, 0x2c, 0x06, 0xb2, 0x1f, 0x4a, 0x30, 0x4e, 0x75, 0xf3,
+		0xef, 0xbf, 0x74, 0x6b, 0xf2, 0x07, 0x00, 0x91, 0xa3, 0xe3, 0xc8, 0xd1, 0x9d, 0xb6, 0xad, 0xd2,
+		0x95, 0xc0, 0xfc, 0xf2, 0x00, 0xa0, 0xb2, 0xed, 0x84, 0xb1, 0xd9, 0xbd, 0xd7, 0x74, 0xd9, 0x76,
+		0x82, 0xd8, 0xc1, 0xee, 0x4c, 0xdc, 0x86, 0xee, 0xfc, 0xa6, 0x04, 0xd9, 0x28, 0x95, 0x79, 0xf7,
+		0x69, 0x70, 0x20, 0x70, 0x7e, 0x13, 0xee, 0xc1, 0x07, 0x3a, 0xd9, 0xc1, 0x0a, 0x0d, 0xa3, 0xfd,
+		0x16, 0xbe, 0xd3, 0x79, 0xc0, 0x64, 0xd0, 0x43, 0x1b, 0x33, 0xeb, 0xd7, 0x6d, 0xf8, 0x7c, 0xb1,
+		0x21, 0xae, 0xfe, 0x4c, 0xe4, 0xde, 0xd7, 0x61, 0xa2, 0x89, 0xd4, 0x77, 0x7a, 0xde, 0xdb, 0x6e,
+		0xda, 0x99, 0xb7, 0x3b, 0x7d, 0x7f, 0x84, 0x8f, 0x84, 0xe0, 0x9b, 0x0a, 0xdf, 0x5a, 0x2c, 0xea,
+		0x51, 0xa6, 0xfc, 0x46, 0x38, 0x14, 0x49, 0xc5, 0x65, 0xcb, 0x41, 0x82, 0x5c, 0x28, 0xc8, 0x48,
+		0x41, 0xdf, 0x09, 0x8b, 0x15, 0xa2, 0xa6, 0x34, 0x32, 0x82, 0x34, 0x65, 0x4d, 0x8e, 0xf3, 0xb8,
+		0x18, 0xf2, 0x25, 0x18, 0xf1, 0xc1, 0x78, 0x23, 0x67, 0xc8, 0x06, 0x91, 0x51, 0x75, 0xbf, 0x5c,
+		0xd0, 0xec, 0xd0, 0xc2, 0x30, 0xaa, 0x5c, 0x6d, 0x8a, 0x2f, 0x8f, 0x01, 0x62, 0xcc, 0xe8, 0xf9,
+		0x85, 0x68, 0x62, 0x1d, 0x46, 0x03, 0x50, 0xde, 0xc8, 0x6b, 0x3a, 0x1b, 0x91, 0x4f, 0xc3, 0xdd,
+		0x94, 0x69, 0xd4, 0x0e, 0xf3, 0xee, 0x62, 0x59, 0x58, 0x39, 0x74, 0x46, 0x27, 0xbf, 0x00, 0x47,
+		0x5b, 0x93, 0x79, 0x99, 0x0f, 0xdb, 0x24, 0x6e, 0x97, 0xf9, 0x44, 0x31, 0xe2, 0x92, 0x32, 0x06,
+		0xf2, 0x13, 0x70, 0x4f, 0xb3, 0x26, 0xed, 0xd5, 0x6b, 0x3a, 0x76, 0x65, 0x75, 0xcf, 0x0f, 0x25,
+		0xdf, 0xf9, 0xa1, 0x5c, 0x87, 0x63, 0xed, 0xc8, 0xb9, 0xcc, 0x97, 0xa0, 0x4f, 0x6c, 0xcb, 0x4b,
+		0x53, 0xf1, 0xbd, 0x09, 0x2d, 0x38, 0xc8, 0x93, 0x70, 0x84, 0x37, 0xeb, 0xf8, 0xef, 0x7e, 0xb8,
+		0xd2, 0xca, 0xdb, 0x30, 0xd1, 0x0c, 0x81, 0xcb, 0xe3, 0xdd, 0xe8, 0x97, 0x5e, 0xcb, 0x8d, 0xfe,
+		0x93, 0xff, 0x2e, 0x0b, 0x3d, 0xb4, 0x29, 0xf4, 0x61, 0x29, 0xf0, 0xe1, 0xb7, 0xe9, 0x66, 0xfa,
+		0x45, 0x6f, 0x7f, 0x64, 0x67, 0x3a, 0xc6, 0xe7, 0xe9, 0xf9, 0x89, 0xb7, 0xff, 0xcb, 0xef, 0x7d,
+		0x30, 0x76, 0x14, 0xc9, 0x33, 0x4d, 0x36, 0x5e, 0x7c, 0xa1, 0xf1, 0x33, 0x81, 0x0f, 0x97, 0x3c,
+		0xd8, 0x59, 0x53, 0x42, 0xb2, 0xe9, 0x4e, 0xd1, 0xb9, 0x60, 0xe7, 0xa8, 0x60, 0xa7, 0xd1, 0xa9,
+		0xf6, 0x82, 0xcd, 0xbc, 0x25, 0x18, 0x1f, 0xdf, 0x86, 0xfe, 0x95, 0x04, 0x63, 0x51, 0xab, 0x77,
+		0xf4, 0x68, 0x67, 0x52, 0x34, 0x66, 0x8f, 0xd9, 0xc7, 0xf6, 0x40, 0xc9, 0x55, 0x59, 0xa0, 0xaa,
+		0xcc, 0xa2, 0xa7, 0xf6, 0xa0, 0xca, 0x8c, 0xff, 0x04, 0xeb, 0x7f, 0x4b, 0x70, 0xa4, 0xe5, 0x62,
+		0x18, 0xcd, 0x76, 0x26, 0x65, 0x8b, 0x34, 0x39, 0x9b, 0x7f, 0x2d, 0x2c, 0xb8, 0xc6, 0x4f, 0x53,
+		0x8d, 0x2f, 0xa1, 0xc5, 0xbd, 0x68, 0x1c, 0x79, 0x4c, 0x88, 0xfe, 0x20, 0x78, 0x2d, 0xbc, 0xb5,
+		0x3b, 0x35, 0xac, 0x31, 0xb3, 0x33, 0x1d, 0xe3, 0x73, 0x15, 0x9e, 0xa5, 0x2a, 0x28, 0x68, 0xed,
+		0x35, 0x76, 0xda, 0xcc, 0x5b, 0x82, 0x73, 0xfc, 0xdb, 0xd0, 0xff, 0x92, 0xa2, 0x6f, 0x79, 0x9f,
+		0x6d, 0x29, 0x62, 0xf3, 0xf5, 0x73, 0xf6, 0xd1, 0xee, 0x09, 0xb9, 0x92, 0x35, 0xaa, 0x64, 0x05,
+		0xe1, 0xdb, 0xad, 0x64, 0x64, 0x27, 0xa2, 0xaf, 0x49, 0x30, 0x16, 0xb5, 0xfc, 0x6c, 0x33, 0x2c,
+		0x5b, 0xac, 0xa7, 0xdb, 0x0c, 0xcb, 0x56, 0x6b, 0x5d, 0xf9, 0x71, 0xaa, 0xfc, 0x19, 0xf4, 0x48,
+		0x33, 0xe5, 0x5b, 0xf6, 0x22, 0x19, 0x8b, 0x2d, 0xd7, 0x73, 0x6d, 0xc6, 0x62, 0x27, 0x4b, 0xd6,
+		0x36, 0x63, 0xb1, 0xa3, 0xe5, 0x64, 0xfb, 0xb1, 0xe8, 0x6a, 0xd6, 0x61, 0x37, 0xda, 0xe8, 0x2b,
+		0x12, 0x0c, 0x06, 0x16, 0x3f, 0xe8, 0xe1, 0x96, 0x82, 0x46, 0xad, 0x0d, 0xb3, 0x27, 0xbb, 0x21,
+		0xe1, 0xba, 0x2c, 0x52, 0x5d, 0xe6, 0xd0, 0xec, 0x5e, 0x74, 0x09, 0xde, 0x06, 0xf8, 0xa6, 0x04,
+		0xa3, 0x11, 0x0b, 0x8a, 0x36, 0xa3, 0xb0, 0xf9, 0xfa, 0x28, 0xfb, 0x68, 0xf7, 0x84, 0x5c, 0xab,
+		0xf3, 0x54, 0xab, 0x37, 0xa0, 0x27, 0xf7, 0xa2, 0x95, 0x6f, 0x7e, 0xbe, 0xe9, 0xdd, 0x7e, 0xf4,
+		0xb5, 0x83, 0xce, 0x74, 0x29, 0x98, 0x50, 0xe8, 0x6c, 0xd7, 0x74, 0x5c, 0x9f, 0x67, 0xa8, 0x3e,
+		0x4f, 0xa3, 0xd5, 0xd7, 0xa6, 0x4f, 0xe3, 0xb4, 0xfe, 0x85, 0xc6, 0xe7, 0xdb, 0xad, 0xbd, 0x28,
+		0x72, 0x5d, 0x92, 0x3d, 0xd5, 0x15, 0x0d, 0x57, 0xea, 0x51, 0xaa, 0xd4, 0x49, 0xf4, 0x50, 0x33,
+		0xa5, 0x7c, 0x37, 0xa3, 0x35, 0x7d, 0xcb, 0x98, 0x79, 0x0b, 0x5b, 0xed, 0xbc, 0x0d, 0xfd, 0xbc,
+		0xb8, 0x5e, 0x78, 0xbc, 0x65, 0xbb, 0xbe, 0x25, 0x4b, 0xf6, 0xbe, 0x0e, 0x30, 0xb9, 0x5c, 0x47,
+		0xa9, 0x5c, 0x13, 0xe8, 0x70, 0x33, 0xb9, 0xc8, 0xb2, 0x05, 0xbd, 0x47, 0x72, 0x2f, 0xb2, 0x9f,
+		0x68, 0xcd, 0xdb, 0xbf, 0xae, 0xc9, 0xde, 0xdf, 0x11, 0x2e, 0x97, 0xe4, 0x18, 0x95, 0x64, 0x0a,
+		0x4d, 0x34, 0x95, 0x84, 0x09, 0xf0, 0x2d, 0x09, 0x0e, 0x36, 0x59, 0x9c, 0xa0, 0x73, 0x2d, 0x1b,
+		0x6c, 0xbd, 0x12, 0xca, 0x3e, 0xbe, 0x37, 0xe2, 0x4e, 0x13, 0xce, 0xe8, 0x6b, 0x3c, 0x33, 0x6f,
+		0xd1, 0xca, 0x6f, 0x43, 0xdf, 0x91, 0x60, 0xbc, 0xe9, 0xf2, 0x05, 0x3d, 0xd1, 0xad, 0x60, 0x81,
+		0x55, 0x53, 0xf6, 0xc9, 0xbd, 0x92, 0x73, 0xcd, 0xe6, 0xa9, 0x66, 0x4f, 0xa2, 0xc7, 0xbb, 0xd4,
+		0x8c, 0xae, 0xce, 0x66, 0xde, 0x42, 0xff, 0xbc, 0x0d, 0xfd, 0xb6, 0x04, 0x23, 0x0d, 0x2b, 0x21,
+		0x74, 0xba, 0x8d, 0x6c, 0xd1, 0x4b, 0xab, 0xec, 0x99, 0x6e, 0xc9, 0xb8, 0x2a, 0xa7, 0xa8, 0x2a,
+		0x0f, 0xa2, 0xfb, 0x9b, 0xab, 0xe2, 0x04, 0x2f, 0xf9, 0xe3, 0xf2, 0x6d, 0xbf, 0x95, 0xf4, 0x31,
+		0x19, 0x26, 0x9b, 0x35, 0x7f, 0xbd, 0xcd, 0xf9, 0x79, 0x8b, 0xcf, 0x66, 0xb4, 0xfd, 0x2c, 0xc6,
+		0xed, 0xfe, 0xd4, 0x7b, 0x87, 0x87, 0xed, 0x7f, 0x94, 0x00, 0xb4, 0x6c, 0x57, 0xe6, 0x2c, 0xcc,
+		0x7e, 0x76, 0x9a, 0x4f, 0x2b, 0xa1, 0xf7, 0xe0, 0xd2, 0x6b, 0x7a, 0x0f, 0xbe, 0x1c, 0x78, 0x61,
+		0x1d, 0xeb, 0xee, 0x2b, 0x0e, 0x1d, 0x3f, 0xb3, 0x8e, 0xff, 0x44, 0x9e, 0x59, 0x47, 0xbf, 0xc2,
+		0x4a, 0xdc, 0xbe, 0xe7, 0x9a, 0x3d, 0x7b, 0x7d, 0xb2, 0xca, 0xbf, 0x9e, 0xd0, 0xdb, 0xe2, 0xeb,
+		0x09, 0x99, 0xa6, 0x9f, 0x48, 0xe0, 0xd4, 0xe8, 0xb4, 0xf8, 0x1c, 0x7a, 0x5f, 0x67, 0x0f, 0x20,
+		0x18, 0xb6, 0x6f, 0x7b, 0xf2, 0x30, 0x64, 0x1b, 0xdd, 0xc9, 0x1d, 0xe1, 0x1f, 0x8c, 0x43, 0x7a,
+		0xd9, 0xae, 0x14, 0xca, 0x9a, 0x73, 0x87, 0x7c, 0xed, 0xa9, 0xe6, 0x4f, 0x60, 0xd1, 0xad, 0x9b,
+		0x93, 0x43, 0xcc, 0xa6, 0x2d, 0x2c, 0x59, 0x83, 0xe1, 0xf0, 0xcb, 0x1b, 0xe6, 0x59, 0xf3, 0x7b,
+		0xf9, 0xfe, 0x49, 0xc3, 0x8b, 0x9b, 0xa1, 0xe0, 0xa7, 0x48, 0xd0, 0xf5, 0x68, 0x67, 0x66, 0x0e,
+		0x75, 0xe1, 0x4e, 0x7e, 0x2f, 0xc0, 0xeb, 0xb3, 0x2c, 0x64, 0xc2, 0x9d, 0xe2, 0xf6, 0xd8, 0x9f,
+		0x4a, 0xd0, 0xbf, 0x6c, 0x8b, 0xb5, 0x07, 0xfe, 0x29, 0x7d, 0xad, 0x7c, 0xd6, 0xfd, 0xd5, 0x90,
+		0x78, 0x67, 0x7e, 0xcb, 0xd1, 0x7d, 0x46, 0xd8, 0x0f, 0xa3, 0x3e, 0x3d, 0x5d, 0xfd, 0xbf, 0x11,
+		0xa3, 0xf1, 0x31, 0x8f, 0x2b, 0x9a, 0xee, 0x2e, 0x5b, 0xf0, 0x5f, 0xd6, 0xb7, 0x98, 0x9e, 0x9d,
+		0x13, 0x7b, 0xb5, 0xf3, 0x0e, 0x64, 0x1b, 0xed, 0xe9, 0xee, 0xb9, 0x2e, 0x37, 0xbe, 0x14, 0x96,
+		0xba, 0xf8, 0x08, 0x5f, 0xe8, 0x3d, 0x30, 0xb9, 0xed, 0x33, 0xb8, 0x6c, 0x57, 0x2e, 0xeb, 0xe5,
+		0xbf, 0xf0, 0xfe, 0xbb, 0x05, 0xfb, 0x03, 0x9a, 0xde, 0x29, 0x93, 0xfe, 0x6e, 0x0c, 0x0e, 0x93,
+		0x08, 0x4f, 0x1e, 0x89, 0x55, 0x7f, 0x76, 0xbe, 0x67, 0xb0, 0x57, 0x0b, 0x47, 0x3d, 0x80, 0x4f,
+		0x74, 0xfb, 0x00, 0xde, 0xd7, 0x4d, 0xc7, 0xe0, 0x68, 0x2b, 0xeb, 0xb9, 0x71, 0xe7, 0xf7, 0x62,
+		0x30, 0x42, 0xbe, 0x83, 0xe7, 0xcf, 0xff, 0xed, 0xbf, 0x68, 0xb6, 0xdd, 0x80, 0xfd, 0x62, 0x75,
+		0x52, 0xe6, 0xcb, 0x13, 0x76, 0x82, 0x94, 0x08, 0x07, 0xa9, 0x48, 0x34, 0x59, 0x19, 0x75, 0xe1,
+		0xd4, 0x40, 0x64, 0x65, 0xe4, 0x3f, 0x2b, 0xdd, 0x80, 0xf1, 0x06, 0x1b, 0xba, 0xe3, 0xc2, 0x93,
+		0x5a, 0xea, 0x4a, 0x6a, 0xf9, 0xb3, 0x12, 0x9d, 0x2f, 0x49, 0xf4, 0xc2, 0x35, 0xca, 0xdc, 0x3e,
+		0x6f, 0x58, 0xb7, 0xbf, 0x87, 0xce, 0x06, 0x7e, 0x0a, 0x6b, 0x4f, 0x41, 0xe1, 0x4d, 0x30, 0xd5,
+		0x4c, 0xd2, 0xd7, 0x6c, 0x87, 0x93, 0x9f, 0xea, 0x83, 0xf8, 0xb2, 0x5d, 0x21, 0x1f, 0x5d, 0x08,
+		0x2f, 0x1f, 0x9a, 0x6e, 0x43, 0x34, 0xe6, 0x86, 0xd9, 0x93, 0x9d, 0xe3, 0xba, 0x32, 0xef, 0xc0,
+		0x60, 0x30, 0x87, 0x3c, 0xde, 0x82, 0x49, 0x00, 0x33, 0xfb, 0x50, 0xa7, 0x98, 0x6e, 0x63, 0x6f,
+		0x26, 0x3f, 0x1e, 0xc6, 0xa7, 0x8f, 0xbb, 0x5b, 0x50, 0x0b, 0xa4, 0xec, 0xfd, 0x1d, 0x20, 0xb9,
+		0xdc, 0x5f, 0x80, 0xe1, 0x70, 0x72, 0xd1, 0xca, 0x7a, 0x21, 0xdc, 0xec, 0xc9, 0xce, 0x71, 0x7d,
+		0x77, 0x90, 0xc0, 0x37, 0x23, 0xde, 0xd3, 0x82, 0x83, 0x87, 0x96, 0x7d, 0xb0, 0x23, 0x34, 0xb7,
+		0x8d, 0xf7, 0x49, 0x30, 0xde, 0x7c, 0x8e, 0x78, 0xa4, 0x55, 0x9f, 0x37, 0xa3, 0xca, 0x3e, 0xbe,
+		0x17, 0x2a, 0x57, 0x22, 0x1d, 0x86, 0x42, 0xd1, 0xf4, 0xbe, 0x16, 0xfc, 0x82, 0xa8, 0xd9, 0x87,
+		0x3b, 0x46, 0x75, 0xdb, 0x7b, 0x87, 0x04, 0xfb, 0xa3, 0x63, 0x44, 0x2b, 0x17, 0x8c, 0xa4, 0xc8,
+		0x3e, 0xda, 0x2d, 0x85, 0x7b, 0xc5, 0xe8, 0x36, 0x6f, 0x8f, 0xfc, 0xbf, 0x01, 0x00, 0xd4, 0x6c,
+		0xa6, 0xd6, 0x90, 0xa5, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2082,6 +2220,18 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.BondDenom != that1.BondDenom {
 		return false
 	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
+		return false
+	}
+	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
+		return false
+	}
+	if !this.MinSelfDelegationFloor.Equal(that1.MinSelfDelegationFloor) {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -2138,6 +2288,39 @@ func (this *Pool) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TokenizeShareRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenizeShareRecord)
+	if !ok {
+		that2, ok := that.(TokenizeShareRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if this.ModuleAccount != that1.ModuleAccount {
+		return false
+	}
+	if this.Validator != that1.Validator {
+		return false
+	}
+	return true
+}
 func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2932,6 +3115,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MinSelfDelegationFloor.Size()
+		i -= size
+		if _, err := m.MinSelfDelegationFloor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.MinCommissionRate.Size()
+		i -= size
+		if _, err := m.MinCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.ValidatorLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if len(m.BondDenom) > 0 {
 		i -= len(m.BondDenom)
 		copy(dAtA[i:], m.BondDenom)
@@ -3141,6 +3364,55 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStaking(v)
 	base := offset
@@ -3473,6 +3745,14 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStaking(uint64(l))
 	}
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MinCommissionRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MinSelfDelegationFloor.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -3532,6 +3812,30 @@ func (m *Pool) Size() (n int) {
 	return n
 }
 
+func (m *TokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
 func sovStaking(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6005,6 +6309,142 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.BondDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegationFloor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSelfDelegationFloor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -6494,6 +6934,171 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStaking(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// TokenizeShareModuleAccountPrefix is the prefix of the names of the record
+// accounts holding the delegations of tokenize share records.
+const TokenizeShareModuleAccountPrefix = "tokenizeshare_"
+
+// NewTokenizeShareRecord creates a new tokenize share record, whose delegation
+// is held by a record account derived from its id.
+//nolint:interfacer
+func NewTokenizeShareRecord(id uint64, owner sdk.AccAddress, validator sdk.ValAddress) TokenizeShareRecord {
+	return TokenizeShareRecord{
+		Id:            id,
+		Owner:         owner.String(),
+		ModuleAccount: fmt.Sprintf("%s%d", TokenizeShareModuleAccountPrefix, id),
+		Validator:     validator.String(),
+	}
+}
+
+// GetModuleAddress returns the address of the record account holding the
+// delegation of the record.
+func (r TokenizeShareRecord) GetModuleAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(r.ModuleAccount)
+}
+
+// GetShareTokenDenom returns the denom of the share tokens of the record.
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return fmt.Sprintf("%s/%d", r.Validator, r.Id)
+}
+
+// Validate performs a stateless validation of the record.
+func (r TokenizeShareRecord) Validate() error {
+	if r.Id == 0 {
+		return fmt.Errorf("tokenize share record id cannot be zero")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(r.Owner); err != nil {
+		return err
+	}
+
+	if r.ModuleAccount != fmt.Sprintf("%s%d", TokenizeShareModuleAccountPrefix, r.Id) {
+		return fmt.Errorf("invalid record account %s for tokenize share record %d", r.ModuleAccount, r.Id)
+	}
+
+	if _, err := sdk.ValAddressFromBech32(r.Validator); err != nil {
+		return err
+	}
+
+	return sdk.ValidateDenom(r.GetShareTokenDenom())
+}